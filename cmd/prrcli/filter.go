@@ -0,0 +1,211 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+var filterHeaders = []string{"FILTER_ID", "NAME", "OWNER", "FILTER"}
+
+func filterRows(filters []api.SavedFilter) [][]string {
+	rows := make([][]string, len(filters))
+	for i, f := range filters {
+		var id int64
+		if f.FilterId != nil {
+			id = *f.FilterId
+		}
+		owner := "team:"
+		if f.TeamName != nil {
+			owner += *f.TeamName
+		}
+		if f.UserId != nil {
+			owner = *f.UserId
+		}
+		rows[i] = []string{strconv.FormatInt(id, 10), f.Name, owner, describeFilter(f.Filter)}
+	}
+	return rows
+}
+
+// describeFilter renders the set fields of a filter as list query parameters.
+func describeFilter(f api.PullRequestFilter) string {
+	var parts []string
+	add := func(name string, v *string) {
+		if v != nil {
+			parts = append(parts, name+"="+*v)
+		}
+	}
+	if f.Status != nil {
+		parts = append(parts, "status="+string(*f.Status))
+	}
+	add("author_id", f.AuthorId)
+	add("reviewer_id", f.ReviewerId)
+	add("team_name", f.TeamName)
+	add("repository_name", f.RepositoryName)
+	if f.Priority != nil {
+		parts = append(parts, "priority="+string(*f.Priority))
+	}
+	add("label", f.Label)
+	if f.MinAgeHours != nil {
+		parts = append(parts, "min_age_hours="+strconv.Itoa(*f.MinAgeHours))
+	}
+	if f.Unreviewed != nil && *f.Unreviewed {
+		parts = append(parts, "unreviewed")
+	}
+	return strings.Join(parts, " ")
+}
+
+// prFilterFlags are the filter flags shared by "pr list" and "filter save".
+type prFilterFlags struct {
+	status, author, reviewer, team, repository, priority, label string
+	minAgeHours                                                 int
+	unreviewed                                                  bool
+}
+
+func (f *prFilterFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.status, "status", "", "status: draft, open, in_review, approved, merged or closed")
+	cmd.Flags().StringVar(&f.author, "author", "", "author user ID, or @me")
+	cmd.Flags().StringVar(&f.reviewer, "reviewer", "", "reviewer user ID, or @me")
+	cmd.Flags().StringVar(&f.team, "team", "", "team of the author")
+	cmd.Flags().StringVar(&f.repository, "repository", "", "repository name")
+	cmd.Flags().StringVar(&f.priority, "priority", "", "priority: low, normal or urgent")
+	cmd.Flags().StringVar(&f.label, "label", "", "label the pull request has")
+	cmd.Flags().IntVar(&f.minAgeHours, "min-age-hours", 0, "only pull requests created at least this many hours ago")
+	cmd.Flags().BoolVar(&f.unreviewed, "unreviewed", false, "only pull requests without a verdict from the reviewer (or from anyone)")
+}
+
+// query returns the flags set on cmd as /pullRequest/list parameters.
+func (f *prFilterFlags) query(cmd *cobra.Command) url.Values {
+	query := url.Values{}
+	set := func(name, value string) {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	set("status", strings.ToUpper(f.status))
+	set("author_id", f.author)
+	set("reviewer_id", f.reviewer)
+	set("team_name", f.team)
+	set("repository_name", f.repository)
+	set("priority", strings.ToUpper(f.priority))
+	set("label", f.label)
+	if cmd.Flags().Changed("min-age-hours") {
+		query.Set("min_age_hours", strconv.Itoa(f.minAgeHours))
+	}
+	if cmd.Flags().Changed("unreviewed") {
+		query.Set("unreviewed", strconv.FormatBool(f.unreviewed))
+	}
+	return query
+}
+
+func (f *prFilterFlags) filter() api.PullRequestFilter {
+	var req api.PullRequestFilter
+	ptr := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	if f.status != "" {
+		status := api.PullRequestFilterStatus(strings.ToUpper(f.status))
+		req.Status = &status
+	}
+	req.AuthorId = ptr(f.author)
+	req.ReviewerId = ptr(f.reviewer)
+	req.TeamName = ptr(f.team)
+	req.RepositoryName = ptr(f.repository)
+	if f.priority != "" {
+		p := api.PullRequestPriority(strings.ToUpper(f.priority))
+		req.Priority = &p
+	}
+	req.Label = ptr(f.label)
+	if f.minAgeHours > 0 {
+		req.MinAgeHours = &f.minAgeHours
+	}
+	if f.unreviewed {
+		req.Unreviewed = &f.unreviewed
+	}
+	return req
+}
+
+func newFilterCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filter",
+		Short: "Manage saved pull request filters",
+	}
+
+	var userID, teamName string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved filters of a user and of their team",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			query := url.Values{}
+			if userID != "" {
+				query.Set("user_id", userID)
+			}
+			if teamName != "" {
+				query.Set("team_name", teamName)
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/savedFilter/list?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, filterHeaders, filterRows)
+		},
+	}
+	list.Flags().StringVar(&userID, "user", "", "user whose filters to list")
+	list.Flags().StringVar(&teamName, "team", "", "team whose filters to list (default: the user's team)")
+
+	var (
+		filter                 prFilterFlags
+		ownerUserID, ownerTeam string
+	)
+	save := &cobra.Command{
+		Use:   "save <name>",
+		Short: "Save a filter for a user or a team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostSavedFilterAddJSONRequestBody{Name: args[0], Filter: filter.filter()}
+			if ownerUserID != "" {
+				req.UserId = &ownerUserID
+			}
+			if ownerTeam != "" {
+				req.TeamName = &ownerTeam
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/savedFilter/add", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, filterHeaders, func(f api.SavedFilter) [][]string {
+				return filterRows([]api.SavedFilter{f})
+			})
+		},
+	}
+	filter.register(save)
+	save.Flags().StringVar(&ownerUserID, "for-user", "", "user who owns the filter")
+	save.Flags().StringVar(&ownerTeam, "for-team", "", "team that owns the filter")
+
+	del := &cobra.Command{
+		Use:   "delete <filter_id>",
+		Short: "Delete a saved filter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := api.PostSavedFilterDeleteJSONRequestBody{FilterId: id}
+			_, err = opts.client().do(cmd.Context(), http.MethodPost, "/savedFilter/delete", req)
+			return err
+		},
+	}
+
+	cmd.AddCommand(list, save, del)
+	return cmd
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	opts := &options{}
+
+	root := &cobra.Command{
+		Use:           "prrcli",
+		Short:         "Command-line client for the PR reviewer service",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	defaultServer := os.Getenv("PRR_SERVER")
+	if defaultServer == "" {
+		defaultServer = "http://localhost:8080"
+	}
+	root.PersistentFlags().StringVar(&opts.server, "server", defaultServer, "service base URL (env PRR_SERVER)")
+	root.PersistentFlags().StringVar(&opts.actor, "actor", os.Getenv("PRR_ACTOR"), "user ID sent as X-Actor-Id, also what @me stands for (env PRR_ACTOR)")
+	root.PersistentFlags().BoolVar(&opts.json, "json", false, "print raw JSON responses instead of tables")
+
+	root.AddCommand(
+		newTeamCmd(opts),
+		newUserCmd(opts),
+		newPRCmd(opts),
+		newFilterCmd(opts),
+	)
+	return root
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+var (
+	prHeaders      = []string{"PR_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS"}
+	prShortHeaders = []string{"PR_ID", "NAME", "AUTHOR", "STATUS"}
+)
+
+func prRows(pr api.PullRequest) [][]string {
+	return [][]string{{pr.PullRequestId, pr.PullRequestName, pr.AuthorId, string(pr.Status), strings.Join(pr.AssignedReviewers, ",")}}
+}
+
+func prShortRows(prs []api.PullRequestShort) [][]string {
+	rows := make([][]string, len(prs))
+	for i, pr := range prs {
+		rows[i] = []string{pr.PullRequestId, pr.PullRequestName, pr.AuthorId, string(pr.Status)}
+	}
+	return rows
+}
+
+func newPRCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr",
+		Short: "Manage pull requests",
+	}
+	cmd.AddCommand(
+		newPRCreateCmd(opts),
+		newPRGetCmd(opts),
+		newPRMergeCmd(opts),
+		newPRReadyCmd(opts),
+		newPRCloseCmd(opts),
+		newPRAssignCmd(opts),
+		newPRApproveCmd(opts),
+		newPRRequestChangesCmd(opts),
+		newPRAckCmd(opts),
+		newPRSetAutoMergeCmd(opts),
+		newPRSetPriorityCmd(opts),
+		newPRReassignCmd(opts),
+		newPRUnassignedCmd(opts),
+		newPRSearchCmd(opts),
+		newPRListCmd(opts),
+	)
+	return cmd
+}
+
+// prCreateFlags are the optional fields of a new pull request.
+type prCreateFlags struct {
+	skills      []string
+	description string
+	autoMerge   bool
+	draft       bool
+	priority    string
+	repository  string
+	id          string
+	externalID  string
+	templateID  int64
+}
+
+func (f *prCreateFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&f.skills, "skill", "s", nil, "preferred reviewer skill (repeatable)")
+	cmd.Flags().StringVarP(&f.description, "description", "d", "", "pull request description")
+	cmd.Flags().BoolVar(&f.autoMerge, "auto-merge", false, "merge automatically once all reviewers approve")
+	cmd.Flags().BoolVar(&f.draft, "draft", false, "create a draft; reviewers are assigned once it is ready")
+	cmd.Flags().StringVarP(&f.priority, "priority", "p", "", "priority: low, normal or urgent")
+	cmd.Flags().StringVarP(&f.repository, "repository", "r", "", "repository whose settings assign the reviewers")
+	cmd.Flags().StringVar(&f.id, "id", "", "pull request ID to use instead of a generated one")
+	cmd.Flags().StringVar(&f.externalID, "external-id", "", "ID of the pull request in an external system")
+	cmd.Flags().Int64Var(&f.templateID, "template", 0, "ID of the author's team PR template to apply")
+}
+
+// request builds the create request, leaving out the flags that were not set.
+func (f *prCreateFlags) request(name, authorID string) api.PullRequestCreateRequest {
+	req := api.PullRequestCreateRequest{PullRequestName: name, AuthorId: authorID}
+	if len(f.skills) > 0 {
+		req.RequiredSkills = &f.skills
+	}
+	if f.priority != "" {
+		p := api.PullRequestPriority(strings.ToUpper(f.priority))
+		req.Priority = &p
+	}
+	if f.templateID != 0 {
+		req.TemplateId = &f.templateID
+	}
+	req.Description = nonEmpty(f.description)
+	req.RepositoryName = nonEmpty(f.repository)
+	req.PullRequestId = nonEmpty(f.id)
+	req.ExternalId = nonEmpty(f.externalID)
+	if f.autoMerge {
+		req.AutoMerge = &f.autoMerge
+	}
+	if f.draft {
+		req.Draft = &f.draft
+	}
+	return req
+}
+
+// nonEmpty returns a pointer to s, nil when it is empty.
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func newPRCreateCmd(opts *options) *cobra.Command {
+	var flags prCreateFlags
+	cmd := &cobra.Command{
+		Use:   "create <name> <author_id>",
+		Short: "Create a pull request and auto-assign reviewers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/create", flags.request(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+	flags.register(cmd)
+	return cmd
+}
+
+func newPRGetCmd(opts *options) *cobra.Command {
+	var byExternalID bool
+	cmd := &cobra.Command{
+		Use:   "get <pull_request_id>",
+		Short: "Show a pull request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "/pullRequest/get/" + url.PathEscape(args[0])
+			if byExternalID {
+				path = "/pullRequest/getByExternalId?external_id=" + url.QueryEscape(args[0])
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, path, nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+	cmd.Flags().BoolVar(&byExternalID, "external", false, "look the pull request up by its external ID")
+	return cmd
+}
+
+func newPRMergeCmd(opts *options) *cobra.Command {
+	var mergedBy string
+	cmd := &cobra.Command{
+		Use:   "merge <pull_request_id>",
+		Short: "Mark a pull request as merged",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestMergeJSONRequestBody{PullRequestId: args[0], MergedBy: nonEmpty(mergedBy)}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/merge", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+	cmd.Flags().StringVar(&mergedBy, "by", "", "user ID of whoever merged the pull request")
+	return cmd
+}
+
+func newPRReadyCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ready <pull_request_id>",
+		Short: "Mark a draft pull request as ready for review and assign reviewers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestReadyJSONRequestBody{PullRequestId: args[0]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/ready", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRCloseCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "close <pull_request_id>",
+		Short: "Close a pull request without merging it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestCloseJSONRequestBody{PullRequestId: args[0]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/close", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRAssignCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "assign <pull_request_id> <user_id>",
+		Short: "Manually assign a reviewer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestAssignJSONRequestBody{PullRequestId: args[0], UserId: args[1]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/assign", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRApproveCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve <pull_request_id> <user_id>",
+		Short: "Record a reviewer's approval",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestApproveJSONRequestBody{PullRequestId: args[0], UserId: args[1]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/approve", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRRequestChangesCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-changes <pull_request_id> <user_id>",
+		Short: "Record a reviewer's request for changes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestRequestChangesJSONRequestBody{PullRequestId: args[0], UserId: args[1]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/requestChanges", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRAckCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ack <pull_request_id> <user_id>",
+		Short: "Acknowledge a review assignment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestPullRequestIdAckJSONRequestBody{UserId: args[1]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/"+url.PathEscape(args[0])+"/ack", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRSetAutoMergeCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-merge <pull_request_id> <true|false>",
+		Short: "Enable or disable auto-merge for a pull request",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			req := api.PostPullRequestSetAutoMergeJSONRequestBody{PullRequestId: args[0], AutoMerge: enabled}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/setAutoMerge", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRSetPriorityCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-priority <pull_request_id> <low|normal|urgent>",
+		Short: "Change the priority of a pull request",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestSetPriorityJSONRequestBody{PullRequestId: args[0], Priority: api.PullRequestPriority(strings.ToUpper(args[1]))}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/setPriority", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prHeaders, prRows)
+		},
+	}
+}
+
+func newPRReassignCmd(opts *options) *cobra.Command {
+	var declineReason string
+	cmd := &cobra.Command{
+		Use:   "reassign <pull_request_id> <old_user_id>",
+		Short: "Replace a reviewer with another member of their team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostPullRequestReassignJSONRequestBody{PullRequestId: args[0], OldUserId: args[1]}
+			if declineReason != "" {
+				reason := api.DeclineReason(declineReason)
+				req.DeclineReason = &reason
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/pullRequest/reassign", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, append(prHeaders, "REPLACED_BY"), func(resp struct {
+				Pr         api.PullRequest `json:"pr"`
+				ReplacedBy string          `json:"replaced_by"`
+			}) [][]string {
+				return [][]string{append(prRows(resp.Pr)[0], resp.ReplacedBy)}
+			})
+		},
+	}
+	cmd.Flags().StringVar(&declineReason, "decline-reason", "", "why the reviewer declined: conflict_of_interest, overloaded, on_leave or lacks_context")
+	return cmd
+}
+
+func newPRUnassignedCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unassigned",
+		Short: "List open pull requests without reviewers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/pullRequest/open-without-reviewers", nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prShortHeaders, prShortRows)
+		},
+	}
+}
+
+// pageFlags are the --limit and --offset flags of paged listings.
+type pageFlags struct {
+	limit, offset int
+}
+
+func (f *pageFlags) register(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&f.limit, "limit", 0, "page size (server default 20)")
+	cmd.Flags().IntVar(&f.offset, "offset", 0, "number of results to skip")
+}
+
+// set adds the flags that were given to query.
+func (f *pageFlags) set(query url.Values) {
+	if f.limit > 0 {
+		query.Set("limit", strconv.Itoa(f.limit))
+	}
+	if f.offset > 0 {
+		query.Set("offset", strconv.Itoa(f.offset))
+	}
+}
+
+func newPRSearchCmd(opts *options) *cobra.Command {
+	var page pageFlags
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Full-text search over pull request names and descriptions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := url.Values{"q": {args[0]}}
+			page.set(query)
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/pullRequest/search?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, append(prShortHeaders, "RANK"), func(resp api.PullRequestSearchResponse) [][]string {
+				rows := make([][]string, len(resp.PullRequests))
+				for i, pr := range resp.PullRequests {
+					rank := strconv.FormatFloat(float64(pr.Rank), 'f', 3, 32)
+					rows[i] = []string{pr.PullRequestId, pr.PullRequestName, pr.AuthorId, string(pr.Status), rank}
+				}
+				return rows
+			})
+		},
+	}
+	page.register(cmd)
+	return cmd
+}
+
+func newPRListCmd(opts *options) *cobra.Command {
+	var (
+		filter   prFilterFlags
+		filterID int64
+		page     pageFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pull requests matching a filter",
+		Long:  "List pull requests matching a filter. Flags override the fields of the saved filter given with --filter.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			query := filter.query(cmd)
+			if filterID > 0 {
+				query.Set("filter_id", strconv.FormatInt(filterID, 10))
+			}
+			page.set(query)
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/pullRequest/list?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, append(prShortHeaders, "PRIORITY"), func(resp api.PullRequestListResponse) [][]string {
+				rows := prShortRows(resp.PullRequests)
+				for i, pr := range resp.PullRequests {
+					var priority string
+					if pr.Priority != nil {
+						priority = string(*pr.Priority)
+					}
+					rows[i] = append(rows[i], priority)
+				}
+				return rows
+			})
+		},
+	}
+	filter.register(cmd)
+	cmd.Flags().Int64Var(&filterID, "filter", 0, "ID of a saved filter to start from")
+	page.register(cmd)
+	return cmd
+}
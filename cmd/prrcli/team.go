@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+var teamMemberHeaders = []string{"USER_ID", "USERNAME", "ACTIVE"}
+
+func teamMemberRows(team api.Team) [][]string {
+	rows := make([][]string, len(team.Members))
+	for i, m := range team.Members {
+		rows[i] = []string{m.UserId, m.Username, boolString(m.IsActive == nil || *m.IsActive)}
+	}
+	return rows
+}
+
+func newTeamCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team",
+		Short: "Manage teams",
+	}
+	cmd.AddCommand(
+		newTeamAddCmd(opts),
+		newTeamGetCmd(opts),
+		newTeamListCmd(opts),
+		newTeamRenameCmd(opts),
+		newTeamSetEscalationCmd(opts),
+		newTeamSetCooldownCmd(opts),
+		newTeamDeactivateCmd(opts),
+	)
+	return cmd
+}
+
+func newTeamAddCmd(opts *options) *cobra.Command {
+	var members []string
+	var allowDuplicates bool
+	cmd := &cobra.Command{
+		Use:   "add <team_name>",
+		Short: "Create a team with members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.Team{TeamName: args[0], Members: make([]api.TeamMember, len(members))}
+			for i, m := range members {
+				req.Members[i] = api.TeamMember{Username: m}
+			}
+			if allowDuplicates {
+				req.AllowDuplicateUsernames = &allowDuplicates
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/team/add", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, teamMemberHeaders, teamMemberRows)
+		},
+	}
+	cmd.Flags().StringSliceVarP(&members, "member", "m", nil, "member username (repeatable)")
+	cmd.Flags().BoolVar(&allowDuplicates, "allow-duplicate-usernames", false, "allow several members with the same username")
+	return cmd
+}
+
+func newTeamGetCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <team_name>",
+		Short: "Show a team and its members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/team/get?team_name="+url.QueryEscape(args[0]), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, teamMemberHeaders, teamMemberRows)
+		},
+	}
+}
+
+func newTeamListCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all teams",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/team/list", nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, []string{"TEAM", "ACTIVE"}, func(teams []api.TeamShort) [][]string {
+				rows := make([][]string, len(teams))
+				for i, t := range teams {
+					rows[i] = []string{t.TeamName, boolString(t.IsActive)}
+				}
+				return rows
+			})
+		},
+	}
+}
+
+func newTeamRenameCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename <old_team_name> <new_team_name>",
+		Short: "Rename a team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostTeamEditJSONRequestBody{OldTeamName: args[0], NewTeamName: &args[1]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/team/edit", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, teamMemberHeaders, teamMemberRows)
+		},
+	}
+}
+
+func newTeamSetEscalationCmd(opts *options) *cobra.Command {
+	var policy api.EscalationPolicy
+	var lead string
+	cmd := &cobra.Command{
+		Use:   "set-escalation <team_name>",
+		Short: "Set how the team's PRs without reviewer activity are escalated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if lead != "" {
+				policy.LeadUserId = &lead
+			}
+			req := api.PostTeamEditJSONRequestBody{OldTeamName: args[0], Escalation: &policy}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/team/edit", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, teamMemberHeaders, teamMemberRows)
+		},
+	}
+	cmd.Flags().StringVar(&lead, "lead", "", "user ID of the team lead to notify")
+	cmd.Flags().IntVar(&policy.NotifyLeadAfterHours, "notify-lead-after", 0, "hours without reviewer activity before notifying the lead (0 disables)")
+	cmd.Flags().IntVar(&policy.AddReviewerAfterHours, "add-reviewer-after", 0, "hours without reviewer activity before adding a reviewer (0 disables)")
+	return cmd
+}
+
+func newTeamSetCooldownCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-cooldown <team_name> <prs>",
+		Short: "Pick reviewers of an author's last <prs> PRs last for the author's next PR (0 disables)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prs, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("<prs> must be a number: %w", err)
+			}
+			req := api.PostTeamEditJSONRequestBody{OldTeamName: args[0], ReviewCooldownPrs: &prs}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/team/edit", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, teamMemberHeaders, teamMemberRows)
+		},
+	}
+}
+
+func newTeamDeactivateCmd(opts *options) *cobra.Command {
+	var at string
+	cmd := &cobra.Command{
+		Use:   "deactivate <team_name>",
+		Short: "Deactivate a team and reassign its open reviews, now or at a planned time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.TeamDeactivateRequest{TeamName: args[0]}
+			if at != "" {
+				effectiveAt, err := time.Parse(time.RFC3339, at)
+				if err != nil {
+					return fmt.Errorf("--at must be an RFC 3339 timestamp: %w", err)
+				}
+				req.EffectiveAt = &effectiveAt
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/team/deactivate", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, []string{"DEACTIVATED_USERS", "REASSIGNED_REVIEWS", "SCHEDULED_AT"}, func(resp api.TeamDeactivateResponse) [][]string {
+				scheduledAt := ""
+				if resp.ScheduledAt != nil {
+					scheduledAt = resp.ScheduledAt.Format(time.RFC3339)
+				}
+				return [][]string{{intPtrString(resp.DeactivatedUsersCount), intPtrString(resp.ReassignedReviewsCount), scheduledAt}}
+			})
+		},
+	}
+	cmd.Flags().StringVar(&at, "at", "", "schedule the deactivation for this RFC 3339 time instead of applying it now")
+	return cmd
+}
+
+func intPtrString(v *int) string {
+	if v == nil {
+		return "0"
+	}
+	return strconv.Itoa(*v)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+var userHeaders = []string{"USER_ID", "USERNAME", "TEAM", "ACTIVE"}
+
+func userRows(u api.User) [][]string {
+	return [][]string{{u.UserId, u.Username, u.TeamName, boolString(u.IsActive)}}
+}
+
+func newUserCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+
+	var inactive bool
+	add := &cobra.Command{
+		Use:   "add <username> <team_name>",
+		Short: "Add a user to a team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.UserAddRequest{Username: args[0], TeamName: args[1], IsActive: !inactive}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/users/add", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, userHeaders, userRows)
+		},
+	}
+	add.Flags().BoolVar(&inactive, "inactive", false, "create the user as inactive")
+
+	get := &cobra.Command{
+		Use:   "get <user_id>",
+		Short: "Show a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/users/get/"+url.PathEscape(args[0]), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, userHeaders, userRows)
+		},
+	}
+
+	var findTeam string
+	find := &cobra.Command{
+		Use:   "find <username>",
+		Short: "Find a user by username",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := url.Values{"username": {args[0]}}
+			if findTeam != "" {
+				query.Set("team_name", findTeam)
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/users/getByUsername?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, userHeaders, userRows)
+		},
+	}
+	find.Flags().StringVarP(&findTeam, "team", "t", "", "team of the user, needed when several users share the username")
+
+	setActive := &cobra.Command{
+		Use:   "set-active <user_id> <true|false>",
+		Short: "Activate or deactivate a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			isActive, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			req := api.PostUsersSetIsActiveJSONRequestBody{UserId: args[0], IsActive: isActive}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/users/setIsActive", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, userHeaders, userRows)
+		},
+	}
+
+	deactivate := &cobra.Command{
+		Use:   "deactivate <user_id>...",
+		Short: "Deactivate several users at once and reassign their open reviews",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostUsersSetIsActiveBatchJSONRequestBody{UserIds: args}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/users/setIsActiveBatch", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, []string{"USER_ID", "STATUS", "REASSIGNED", "UNASSIGNED"}, func(resp struct {
+				Results []api.UserDeactivationResult `json:"results"`
+			}) [][]string {
+				rows := make([][]string, len(resp.Results))
+				for i, res := range resp.Results {
+					rows[i] = []string{res.UserId, string(res.Status), strconv.Itoa(len(res.Reassigned)), strconv.Itoa(len(res.UnassignedPullRequestIds))}
+				}
+				return rows
+			})
+		},
+	}
+
+	setSkills := &cobra.Command{
+		Use:   "set-skills <user_id> [skill...]",
+		Short: "Replace a user's skill tags",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostUsersSetSkillsJSONRequestBody{UserId: args[0], Skills: args[1:]}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/users/setSkills", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, userHeaders, userRows)
+		},
+	}
+
+	var openReviews string
+	move := &cobra.Command{
+		Use:   "move <user_id> <team_name>",
+		Short: "Move a user to another team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := api.PostUsersMoveToTeamJSONRequestBody{UserId: args[0], NewTeamName: args[1]}
+			if openReviews != "" {
+				policy := api.PostUsersMoveToTeamJSONBodyOpenReviews(openReviews)
+				req.OpenReviews = &policy
+			}
+			raw, err := opts.client().do(cmd.Context(), http.MethodPost, "/users/moveToTeam", req)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, append(userHeaders, "MOVED_REVIEWS"), func(u api.UserMoveResponse) [][]string {
+				return [][]string{{u.UserId, u.Username, u.TeamName, boolString(u.IsActive), strconv.Itoa(u.MovedReviewsCount)}}
+			})
+		},
+	}
+	move.Flags().StringVar(&openReviews, "open-reviews", "", "what to do with reviews on open PRs of the old team: keep, reassign or ask (default: server setting)")
+
+	reviews := &cobra.Command{
+		Use:   "reviews <user_id>",
+		Short: "List pull requests the user is assigned to review",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := opts.client().do(cmd.Context(), http.MethodGet, "/users/getReview?user_id="+url.QueryEscape(args[0]), nil)
+			if err != nil {
+				return err
+			}
+			return output(opts, raw, prShortHeaders, func(resp struct {
+				PullRequests []api.PullRequestShort `json:"pull_requests"`
+			}) [][]string {
+				return prShortRows(resp.PullRequests)
+			})
+		},
+	}
+
+	cmd.AddCommand(add, get, find, setActive, deactivate, setSkills, move, reviews)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/app"
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// serverConfig is the configuration read from the environment at startup.
+type serverConfig struct {
+	// configFile is the env file loaded at startup and watched for changes,
+	// environ the variables of the process environment that win over it.
+	configFile string
+	environ    map[string]string
+
+	dbURL     string
+	port      string
+	dbPolicy  dbConnectPolicy
+	redactPII bool
+	testHooks bool
+
+	readTimeout         time.Duration
+	txTimeout           time.Duration
+	accessLogSampleRate float64
+	reloadInterval      time.Duration
+
+	maxOpenReviews   int
+	candidatePoolTTL time.Duration
+	selectionSalt    string
+	movePolicy       domain.OpenReviewsPolicy
+	alertThreshold   int
+	alertWindow      time.Duration
+	budgetInterval   time.Duration
+	budgetAlertRatio float64
+
+	notifyInterval     time.Duration
+	overdueAfter       time.Duration
+	notificationRetry  app.NotificationRetry
+	slackReplayWindow  time.Duration
+	githubReplayWindow time.Duration
+	teamSyncOrg        string
+	teamSyncInterval   time.Duration
+
+	archiveRetention     time.Duration
+	archiveInterval      time.Duration
+	escalationInterval   time.Duration
+	deactivationInterval time.Duration
+	remindAfter          time.Duration
+	reassignAfter        time.Duration
+	ackInterval          time.Duration
+	inactiveAfter        time.Duration
+	inactiveInterval     time.Duration
+	historyInterval      time.Duration
+	reportInterval       time.Duration
+}
+
+// loadServerConfig loads CONFIG_FILE (.env by default) into the environment
+// and reads the startup configuration. Every invalid setting is reported, not
+// just the first one.
+func loadServerConfig(logger *slog.Logger) (*serverConfig, error) {
+	cfg := &serverConfig{configFile: cmp.Or(os.Getenv("CONFIG_FILE"), ".env")}
+	// Variables set in the environment take precedence over the file, both
+	// now and when the file is reloaded.
+	cfg.environ = environment()
+	if err := godotenv.Load(cfg.configFile); err != nil {
+		logger.Warn("Error loading .env file, using environment variables. In prod should be ok.")
+	}
+
+	var errs []error
+	check := func(what string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s config: %w", what, err))
+		}
+	}
+	cfg.dbURL = os.Getenv("APP_DB_URL")
+	if cfg.dbURL == "" {
+		errs = append(errs, errors.New("APP_DB_URL is not set"))
+	}
+	cfg.port = cmp.Or(os.Getenv("APP_PORT"), "8080")
+	cfg.selectionSalt = os.Getenv("REVIEWER_SELECTION_SALT")
+
+	var err error
+	cfg.redactPII, err = privacyConfig(os.Getenv)
+	check("privacy", err)
+	cfg.dbPolicy, err = dbConnectConfig()
+	check("database connection", err)
+	cfg.testHooks, err = testHooksConfig()
+	check("test hooks", err)
+	cfg.readTimeout, cfg.txTimeout, err = timeoutConfig(os.Getenv)
+	check("timeout", err)
+	cfg.accessLogSampleRate, err = accessLogConfig(os.Getenv)
+	check("access log", err)
+	cfg.reloadInterval, err = configReloadConfig()
+	check("config reload", err)
+
+	cfg.maxOpenReviews, cfg.candidatePoolTTL, err = reviewerConfig(os.Getenv)
+	check("reviewer", err)
+	cfg.movePolicy, err = userMoveConfig()
+	check("user move", err)
+	cfg.alertThreshold, cfg.alertWindow, err = staffingAlertConfig()
+	check("staffing alert", err)
+	cfg.budgetInterval, cfg.budgetAlertRatio, err = reviewBudgetConfig()
+	check("review budget", err)
+
+	cfg.notifyInterval, cfg.overdueAfter, err = notificationConfig(os.Getenv)
+	check("notification", err)
+	cfg.notificationRetry, err = notificationRetryConfig()
+	check("notification", err)
+	cfg.slackReplayWindow, cfg.githubReplayWindow, err = webhookReplayConfig()
+	check("webhook replay", err)
+	cfg.teamSyncOrg, cfg.teamSyncInterval, err = githubTeamSyncConfig()
+	check("GitHub teams sync", err)
+
+	cfg.archiveRetention, cfg.archiveInterval, err = archiveConfig()
+	check("archive", err)
+	cfg.escalationInterval, err = escalationConfig()
+	check("escalation", err)
+	cfg.deactivationInterval, err = teamDeactivationConfig()
+	check("team deactivation", err)
+	cfg.remindAfter, cfg.reassignAfter, cfg.ackInterval, err = ackConfig()
+	check("ack", err)
+	cfg.inactiveAfter, cfg.inactiveInterval, err = inactiveReviewConfig()
+	check("inactive review", err)
+	cfg.historyInterval, err = statsHistoryConfig()
+	check("stats history", err)
+	cfg.reportInterval, err = teamReportConfig()
+	check("team report", err)
+
+	return cfg, errors.Join(errs...)
+}
@@ -2,82 +2,119 @@ package main
 
 import (
 	"context"
+	crand "crypto/rand"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"math"
+	"math/rand/v2"
 	stdhttp "net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // quiet hours need time zones even in images without tzdata
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/app"
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/internal/github"
 	"github.com/glebmavi/pr_reviewer_service/internal/http"
+	"github.com/glebmavi/pr_reviewer_service/internal/notify"
 	"github.com/glebmavi/pr_reviewer_service/internal/storage/postgres"
+	"github.com/glebmavi/pr_reviewer_service/internal/testhooks"
 )
 
 func main() {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	logger := slog.New(http.NewContextLogHandler(http.NewRedactingLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
-	}))
+	}))))
 	slog.SetDefault(logger)
 	logger.Info("starting service...")
 
-	err := godotenv.Load()
+	cfg, err := loadServerConfig(logger)
 	if err != nil {
-		logger.Warn("Error loading .env file, using environment variables. In prod should be ok.")
-	}
-
-	dbURL := os.Getenv("APP_DB_URL")
-	if dbURL == "" {
-		logger.Error("APP_DB_URL is not set")
+		logger.Error("invalid config", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
-
-	port := os.Getenv("APP_PORT")
-	if port == "" {
-		port = "8080"
+	domain.SetRedactPII(cfg.redactPII)
+	if cfg.selectionSalt == "" {
+		cfg.selectionSalt = crand.Text()
+		logger.Warn("REVIEWER_SELECTION_SALT is not set, using a random salt: reviewer picks will differ between replicas and restarts")
 	}
 
-	dbPool, err := initDB(context.Background(), dbURL)
+	dbPool, dbConnected, err := initDB(context.Background(), cfg.dbURL, cfg.dbPolicy)
 	if err != nil {
 		logger.Error("failed to init db", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 	defer dbPool.Close()
-	logger.Info("database connection pool established")
+	if dbConnected {
+		logger.Info("database connection pool established")
+	}
 
 	repository := postgres.NewRepository(dbPool, logger.With("layer", "repository"))
+	var faults *testhooks.Faults
+	if cfg.testHooks {
+		faults = testhooks.NewFaults()
+		repository.InjectFaults(faults)
+		logger.Warn("test hooks enabled: faults can be injected into database queries via /test/hooks, never use in production")
+	}
 
-	pullRequestService := app.NewPullRequestService(repository, repository, repository, repository, logger.With("service", "pr"))
-	teamService := app.NewTeamService(repository, repository, pullRequestService, repository, logger.With("service", "team"))
-	userService := app.NewUserService(repository, repository, pullRequestService, repository, logger.With("service", "user"))
-	statsService := app.NewStatsService(repository, logger.With("service", "stats"))
+	svc, err := buildServices(cfg, repository, logger)
+	if err != nil {
+		logger.Error("failed to build services", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
-	handler := http.NewHandler(teamService, pullRequestService, userService, statsService, logger.With("layer", "http"))
-	router := http.NewRouter(handler)
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		if err := seed(svc, dbConnected, cfg.dbPolicy, logger); err != nil {
+			logger.Error("seed failed", slog.String("error", err.Error()))
+			dbPool.Close()
+			os.Exit(1)
+		}
+		return
+	}
 
+	tunables := http.NewTunables(cfg.readTimeout, cfg.accessLogSampleRate)
+	router, err := http.NewRouter(svc.handler(faults, logger), tunables)
+	if err != nil {
+		logger.Error("failed to build router", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 	server := &stdhttp.Server{
-		Addr:    ":" + port,
+		Addr:    ":" + cfg.port,
 		Handler: router,
 	}
+	go serve(server, logger)
 
-	go func() {
-		logger.Info(fmt.Sprintf("server starting on port %s", port))
-		if err := server.ListenAndServe(); err != nil && !errors.Is(err, stdhttp.ErrServerClosed) {
-			logger.Error("server listen error", slog.String("error", err.Error()))
-			os.Exit(1)
-		}
-	}()
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// Serve even if the database is still booting: readiness reports it down
+	// until the background reconnection succeeds, instead of the pod crashing.
+	if !dbConnected {
+		logger.Warn("database is not reachable yet, starting anyway", slog.Duration("waited", cfg.dbPolicy.maxWait))
+		go reconnectDB(jobsCtx, dbPool, cfg.dbPolicy, logger)
+	}
+
+	svc.startJobs(jobsCtx, cfg, logger)
+	if err := svc.watchConfig(jobsCtx, cfg, tunables, logger); err != nil {
+		logger.Error("failed to watch config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("shutting down server...")
+	stopJobs()
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -90,20 +127,672 @@ func main() {
 	logger.Info("server exited gracefully")
 }
 
-func initDB(ctx context.Context, dbURL string) (*pgxpool.Pool, error) {
-	var pool *pgxpool.Pool
-	var err error
+// serve runs the server until it is shut down; any other error exits.
+func serve(server *stdhttp.Server, logger *slog.Logger) {
+	logger.Info(fmt.Sprintf("server starting on port %s", strings.TrimPrefix(server.Addr, ":")))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, stdhttp.ErrServerClosed) {
+		logger.Error("server listen error", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+// seed runs the seed command, which needs the database up.
+func seed(svc *services, dbConnected bool, policy dbConnectPolicy, logger *slog.Logger) error {
+	if !dbConnected {
+		return fmt.Errorf("database is not reachable after %s", policy.maxWait)
+	}
+	seedService := app.NewSeedService(svc.team, svc.user, svc.pr, logger.With("service", "seed"))
+	return runSeed(context.Background(), seedService, logger, os.Args[2:])
+}
 
-	for i := 0; i < 5; i++ {
-		pool, err = pgxpool.New(ctx, dbURL)
+// reconnectDB keeps retrying the database without a time limit until it
+// answers or ctx is done.
+func reconnectDB(ctx context.Context, pool *pgxpool.Pool, policy dbConnectPolicy, logger *slog.Logger) {
+	if waitForDB(ctx, pool.Ping, policy, 0) {
+		logger.Info("database connection pool established")
+	}
+}
+
+// dbConnectPolicy controls how the database is waited for: attempts back off
+// exponentially from initialBackoff up to maxBackoff, with jitter so that
+// replicas started together do not retry in lockstep.
+type dbConnectPolicy struct {
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	// maxWait is how long startup waits for the database before serving
+	// anyway; the connection is then retried in the background.
+	maxWait time.Duration
+}
+
+// backoff returns the delay before the retry after the given attempt (counted
+// from 0): half of the exponential delay, capped at maxBackoff, plus the jitter
+// share (in [0, 1)) of the other half. ok is false when the retry would come
+// after budget, counted from waited; a zero budget never runs out.
+func (p dbConnectPolicy) backoff(attempt int, jitter float64, waited, budget time.Duration) (delay time.Duration, ok bool) {
+	d := p.initialBackoff
+	for i := 0; i < attempt && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	half := min(d, p.maxBackoff) / 2
+	delay = half + time.Duration(jitter*float64(half))
+	if budget > 0 && waited+delay > budget {
+		return 0, false
+	}
+	return delay, true
+}
+
+// initDB creates the pool and waits up to policy.maxWait for the database to
+// answer. It fails only on an invalid URL; connected reports whether the
+// database was reached in time.
+func initDB(ctx context.Context, dbURL string, policy dbConnectPolicy) (pool *pgxpool.Pool, connected bool, err error) {
+	pool, err = pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid database config: %w", err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, policy.maxWait)
+	defer cancel()
+	return pool, waitForDB(waitCtx, pool.Ping, policy, policy.maxWait), nil
+}
+
+// waitForDB pings the database until it answers, the next retry would not fit
+// into budget (zero for no limit) or ctx is done.
+func waitForDB(ctx context.Context, ping func(context.Context) error, policy dbConnectPolicy, budget time.Duration) bool {
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		err := ping(ctx)
 		if err == nil {
-			if err = pool.Ping(ctx); err == nil {
-				return pool, nil
+			return true
+		}
+		delay, ok := policy.backoff(attempt, rand.Float64(), time.Since(start), budget)
+		if !ok {
+			slog.Warn("failed to connect to db, giving up", "attempt", attempt+1, "waited", time.Since(start).String(), "error", err.Error())
+			return false
+		}
+		slog.Warn("failed to connect to db, retrying...", "attempt", attempt+1, "retry_in", delay.String(), "error", err.Error())
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+	}
+}
+
+// dbConnectConfig reads the database connection retry policy.
+func dbConnectConfig() (dbConnectPolicy, error) {
+	policy := dbConnectPolicy{
+		initialBackoff: 500 * time.Millisecond,
+		maxBackoff:     30 * time.Second,
+		maxWait:        time.Minute,
+	}
+	for _, opt := range []struct {
+		env string
+		dst *time.Duration
+	}{
+		{"DB_CONNECT_INITIAL_BACKOFF", &policy.initialBackoff},
+		{"DB_CONNECT_MAX_BACKOFF", &policy.maxBackoff},
+		{"DB_CONNECT_MAX_WAIT", &policy.maxWait},
+	} {
+		if v := os.Getenv(opt.env); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				return dbConnectPolicy{}, fmt.Errorf("%s must be a positive duration, got %q", opt.env, v)
 			}
+			*opt.dst = d
+		}
+	}
+	if policy.maxBackoff < policy.initialBackoff {
+		return dbConnectPolicy{}, fmt.Errorf("DB_CONNECT_MAX_BACKOFF must not be less than DB_CONNECT_INITIAL_BACKOFF")
+	}
+	return policy, nil
+}
+
+// archiveConfig reads the merged PR retention policy. A zero retention
+// (PR_ARCHIVE_AFTER_DAYS unset or 0) disables the archival job.
+func archiveConfig() (time.Duration, time.Duration, error) {
+	var retention time.Duration
+	if v := os.Getenv("PR_ARCHIVE_AFTER_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			return 0, 0, fmt.Errorf("PR_ARCHIVE_AFTER_DAYS must be a non-negative integer, got %q", v)
 		}
-		slog.Warn("failed to connect to db, retrying...", "attempt", i+1, "error", err.Error())
-		time.Sleep(2 * time.Second)
+		retention = time.Duration(days) * 24 * time.Hour
 	}
 
-	return nil, fmt.Errorf("failed to connect to database after 5 attempts: %w", err)
+	interval := time.Hour
+	if v := os.Getenv("PR_ARCHIVE_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("PR_ARCHIVE_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+
+	return retention, interval, nil
+}
+
+// notificationConfig reads how often queued notifications are delivered and
+// the default of how long a pending review may wait before digests report it
+// as overdue, which is rounded up to whole hours.
+func notificationConfig(getenv func(string) string) (time.Duration, time.Duration, error) {
+	interval := 15 * time.Second
+	if v := getenv("NOTIFY_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("NOTIFY_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+
+	overdueAfter := 48 * time.Hour
+	if v := getenv("DIGEST_OVERDUE_AFTER"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("DIGEST_OVERDUE_AFTER must be a positive duration, got %q", v)
+		}
+		overdueAfter = d
+	}
+
+	return interval, overdueAfter, nil
+}
+
+// notificationRetryConfig reads how many times a notification is delivered
+// before it is dead-lettered and how long to wait before the first retry.
+func notificationRetryConfig() (app.NotificationRetry, error) {
+	retry := app.NotificationRetry{MaxAttempts: 5, BaseDelay: time.Minute}
+	if v := os.Getenv("NOTIFY_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return retry, fmt.Errorf("NOTIFY_MAX_ATTEMPTS must be a positive integer, got %q", v)
+		}
+		retry.MaxAttempts = n
+	}
+	if v := os.Getenv("NOTIFY_RETRY_DELAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return retry, fmt.Errorf("NOTIFY_RETRY_DELAY must be a positive duration, got %q", v)
+		}
+		retry.BaseDelay = d
+	}
+	return retry, nil
+}
+
+// userMoveConfig reads what happens by default to the open reviews of a user
+// moved to another team.
+func userMoveConfig() (domain.OpenReviewsPolicy, error) {
+	policy := domain.OpenReviewsKeep
+	if v := os.Getenv("USER_MOVE_OPEN_REVIEWS"); v != "" {
+		policy = domain.OpenReviewsPolicy(v)
+		if !policy.Valid() {
+			return "", fmt.Errorf("USER_MOVE_OPEN_REVIEWS must be one of keep, reassign, ask, got %q", v)
+		}
+	}
+	return policy, nil
+}
+
+// escalationConfig reads how often stalled PRs are checked against their
+// team's escalation policy.
+func escalationConfig() (time.Duration, error) {
+	interval := 5 * time.Minute
+	if v := os.Getenv("ESCALATION_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, fmt.Errorf("ESCALATION_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+	return interval, nil
+}
+
+// statsHistoryConfig reads how often the job recording the daily stats
+// history checks whether today is already recorded.
+func statsHistoryConfig() (time.Duration, error) {
+	interval := time.Hour
+	if v := os.Getenv("STATS_HISTORY_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, fmt.Errorf("STATS_HISTORY_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+	return interval, nil
+}
+
+// webhookReplayConfig reads how old a signed Slack request may be
+// (SLACK_REPLAY_WINDOW) and how long accepted GitHub deliveries are remembered
+// to refuse them if sent again (GITHUB_WEBHOOK_REPLAY_WINDOW). GitHub does not
+// sign a timestamp and redelivers failed events with the same signature, so
+// its window is off by default.
+func webhookReplayConfig() (time.Duration, time.Duration, error) {
+	slackWindow, githubWindow := 5*time.Minute, time.Duration(0)
+	if v := os.Getenv("SLACK_REPLAY_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("SLACK_REPLAY_WINDOW must be a positive duration, got %q", v)
+		}
+		slackWindow = d
+	}
+	if v := os.Getenv("GITHUB_WEBHOOK_REPLAY_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return 0, 0, fmt.Errorf("GITHUB_WEBHOOK_REPLAY_WINDOW must be a non-negative duration, got %q", v)
+		}
+		githubWindow = d
+	}
+	return slackWindow, githubWindow, nil
+}
+
+// reloadableConfig are the variables of the config file applied without a
+// restart. Changes to any other variable take effect on the next start.
+var reloadableConfig = []string{
+	"READ_TIMEOUT",
+	"TX_TIMEOUT",
+	"ACCESS_LOG_SAMPLE_RATE",
+	"LOG_REDACT_PII",
+	"REVIEWER_MAX_OPEN_REVIEWS",
+	"DIGEST_OVERDUE_AFTER",
+}
+
+// environment returns the variables set in the environment of the process.
+func environment() map[string]string {
+	environ := make(map[string]string)
+	for _, kv := range os.Environ() {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			environ[key] = value
+		}
+	}
+	return environ
+}
+
+// readReloadableConfig reads the reloadable variables from the config file.
+// Variables set in environ win over the file; a missing file leaves only
+// them.
+func readReloadableConfig(path string, environ map[string]string) (map[string]string, error) {
+	file, err := godotenv.Read(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for _, key := range reloadableConfig {
+		if v, ok := environ[key]; ok {
+			values[key] = v
+		} else if v, ok := file[key]; ok {
+			values[key] = v
+		}
+	}
+	return values, nil
+}
+
+// configApplier returns the function applying reloaded variables to the
+// running service. Nothing changes unless every value is valid.
+func configApplier(uow *app.TimeoutUnitOfWork, tunables *http.Tunables, settingsService *app.SettingsService) func(map[string]string) error {
+	return func(values map[string]string) error {
+		getenv := func(key string) string { return values[key] }
+		redactPII, err := privacyConfig(getenv)
+		if err != nil {
+			return err
+		}
+		readTimeout, txTimeout, err := timeoutConfig(getenv)
+		if err != nil {
+			return err
+		}
+		accessLogSampleRate, err := accessLogConfig(getenv)
+		if err != nil {
+			return err
+		}
+		maxOpenReviews, _, err := reviewerConfig(getenv)
+		if err != nil {
+			return err
+		}
+		_, overdueAfter, err := notificationConfig(getenv)
+		if err != nil {
+			return err
+		}
+
+		domain.SetRedactPII(redactPII)
+		uow.SetTimeout(txTimeout)
+		tunables.SetReadTimeout(readTimeout)
+		tunables.SetAccessLogSampleRate(accessLogSampleRate)
+		settingsService.SetDefaults(settingsDefaults(maxOpenReviews, overdueAfter))
+		return nil
+	}
+}
+
+// settingsDefaults are the org-wide settings seeded from the environment. The
+// environment only seeds them; once an admin saves the settings,
+// /admin/settings is authoritative.
+func settingsDefaults(maxOpenReviews int, overdueAfter time.Duration) domain.Settings {
+	defaults := app.DefaultSettings()
+	defaults.MaxOpenReviews = maxOpenReviews
+	defaults.ReviewSLAHours = int(math.Ceil(overdueAfter.Hours()))
+	return defaults
+}
+
+// configReloadConfig reads CONFIG_RELOAD_INTERVAL, how often the config file
+// is checked for changes. Zero disables polling; SIGHUP still reloads it.
+func configReloadConfig() (time.Duration, error) {
+	interval := 10 * time.Second
+	if v := os.Getenv("CONFIG_RELOAD_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return 0, fmt.Errorf("CONFIG_RELOAD_INTERVAL must be a non-negative duration, got %q", v)
+		}
+		interval = d
+	}
+	return interval, nil
+}
+
+// timeoutConfig reads the deadline for API reads (READ_TIMEOUT) and for
+// transactions (TX_TIMEOUT). Zero disables a deadline, leaving only the 60s
+// router timeout.
+func timeoutConfig(getenv func(string) string) (time.Duration, time.Duration, error) {
+	readTimeout, txTimeout := 2*time.Second, 5*time.Second
+	for _, opt := range []struct {
+		env string
+		dst *time.Duration
+	}{
+		{"READ_TIMEOUT", &readTimeout},
+		{"TX_TIMEOUT", &txTimeout},
+	} {
+		if v := getenv(opt.env); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return 0, 0, fmt.Errorf("%s must be a non-negative duration, got %q", opt.env, v)
+			}
+			*opt.dst = d
+		}
+	}
+	return readTimeout, txTimeout, nil
+}
+
+// teamDeactivationConfig reads how often scheduled team deactivations are
+// checked.
+func teamDeactivationConfig() (time.Duration, error) {
+	interval := time.Minute
+	if v := os.Getenv("TEAM_DEACTIVATION_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, fmt.Errorf("TEAM_DEACTIVATION_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+	return interval, nil
+}
+
+// ackConfig reads after how long an unacknowledged review assignment is
+// reminded about (ACK_REMIND_AFTER) and reassigned (ACK_REASSIGN_AFTER), and
+// how often they are checked. Zero durations disable the steps.
+func ackConfig() (time.Duration, time.Duration, time.Duration, error) {
+	var after [2]time.Duration
+	for i, name := range []string{"ACK_REMIND_AFTER", "ACK_REASSIGN_AFTER"} {
+		if v := os.Getenv(name); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return 0, 0, 0, fmt.Errorf("%s must be a non-negative duration, got %q", name, v)
+			}
+			after[i] = d
+		}
+	}
+
+	interval := time.Minute
+	if v := os.Getenv("ACK_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, 0, fmt.Errorf("ACK_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+
+	return after[0], after[1], interval, nil
+}
+
+// inactiveReviewConfig reads after how long a reviewer who took no action on
+// a review is replaced (INACTIVE_REVIEW_REASSIGN_AFTER, zero disables it) and
+// how often reviews are checked (INACTIVE_REVIEW_INTERVAL).
+func inactiveReviewConfig() (time.Duration, time.Duration, error) {
+	var after time.Duration
+	if v := os.Getenv("INACTIVE_REVIEW_REASSIGN_AFTER"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return 0, 0, fmt.Errorf("INACTIVE_REVIEW_REASSIGN_AFTER must be a non-negative duration, got %q", v)
+		}
+		after = d
+	}
+
+	interval := 5 * time.Minute
+	if v := os.Getenv("INACTIVE_REVIEW_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("INACTIVE_REVIEW_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+
+	return after, interval, nil
+}
+
+// reviewerConfig reads REVIEWER_MAX_OPEN_REVIEWS, the default number of open
+// reviews after which a user is skipped for new non-urgent assignments (zero
+// disables the cap), and REVIEWER_CANDIDATE_CACHE_TTL, how long the active members of
+// a team are cached for reviewer selection (zero disables the cache).
+func reviewerConfig(getenv func(string) string) (int, time.Duration, error) {
+	maxOpen, ttl := 0, 5*time.Second
+	if v := getenv("REVIEWER_MAX_OPEN_REVIEWS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("REVIEWER_MAX_OPEN_REVIEWS must be a non-negative integer, got %q", v)
+		}
+		maxOpen = n
+	}
+	if v := getenv("REVIEWER_CANDIDATE_CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return 0, 0, fmt.Errorf("REVIEWER_CANDIDATE_CACHE_TTL must be a non-negative duration, got %q", v)
+		}
+		ttl = d
+	}
+	return maxOpen, ttl, nil
+}
+
+// staffingAlertConfig reads how many reviewer picks that found no candidate
+// within NO_CANDIDATE_ALERT_WINDOW alert the team lead
+// (NO_CANDIDATE_ALERT_THRESHOLD). A zero threshold disables the alerts.
+func staffingAlertConfig() (int, time.Duration, error) {
+	var threshold int
+	if v := os.Getenv("NO_CANDIDATE_ALERT_THRESHOLD"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("NO_CANDIDATE_ALERT_THRESHOLD must be a non-negative integer, got %q", v)
+		}
+		threshold = n
+	}
+
+	window := time.Hour
+	if v := os.Getenv("NO_CANDIDATE_ALERT_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("NO_CANDIDATE_ALERT_WINDOW must be a positive duration, got %q", v)
+		}
+		window = d
+	}
+
+	return threshold, window, nil
+}
+
+// reviewBudgetConfig reads how often review sprints are rolled over and team
+// budgets checked (REVIEW_BUDGET_INTERVAL), and the share of a team's combined
+// budget whose use alerts the team lead (REVIEW_BUDGET_ALERT_RATIO, 0
+// disables the alerts).
+func reviewBudgetConfig() (time.Duration, float64, error) {
+	interval := 5 * time.Minute
+	if v := os.Getenv("REVIEW_BUDGET_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("REVIEW_BUDGET_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+
+	ratio := 0.9
+	if v := os.Getenv("REVIEW_BUDGET_ALERT_RATIO"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			return 0, 0, fmt.Errorf("REVIEW_BUDGET_ALERT_RATIO must be a number between 0 and 1, got %q", v)
+		}
+		ratio = f
+	}
+
+	return interval, ratio, nil
+}
+
+// testHooksConfig reads APP_TEST_HOOKS, which exposes the fault injection
+// endpoints used by end-to-end tests.
+func testHooksConfig() (bool, error) {
+	v := os.Getenv("APP_TEST_HOOKS")
+	if v == "" {
+		return false, nil
+	}
+	on, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("APP_TEST_HOOKS must be true or false, got %q", v)
+	}
+	return on, nil
+}
+
+// privacyConfig reads LOG_REDACT_PII, which redacts usernames, emails and
+// GitHub logins from error messages and logs.
+func privacyConfig(getenv func(string) string) (bool, error) {
+	v := getenv("LOG_REDACT_PII")
+	if v == "" {
+		return false, nil
+	}
+	on, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("LOG_REDACT_PII must be true or false, got %q", v)
+	}
+	return on, nil
+}
+
+// accessLogConfig reads ACCESS_LOG_SAMPLE_RATE, the share of successful
+// requests written to the access log. Failed requests are always logged.
+func accessLogConfig(getenv func(string) string) (float64, error) {
+	rate := 1.0
+	if v := getenv("ACCESS_LOG_SAMPLE_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			return 0, fmt.Errorf("ACCESS_LOG_SAMPLE_RATE must be a number between 0 and 1, got %q", v)
+		}
+		rate = f
+	}
+	return rate, nil
+}
+
+// teamReportConfig reads how often team report schedules are checked for due
+// reports.
+func teamReportConfig() (time.Duration, error) {
+	interval := 5 * time.Minute
+	if v := os.Getenv("TEAM_REPORT_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, fmt.Errorf("TEAM_REPORT_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+	return interval, nil
+}
+
+// emailConfig builds the email channel from SMTP_ADDR ("host:port"), SMTP_FROM
+// and, for servers requiring authentication, SMTP_USERNAME and SMTP_PASSWORD.
+// Without SMTP_ADDR the channel is disabled and nil is returned.
+func emailConfig() *notify.EmailChannel {
+	addr := os.Getenv("SMTP_ADDR")
+	if addr == "" {
+		return nil
+	}
+	from := os.Getenv("SMTP_FROM")
+	if from == "" {
+		from = "pr-reviewer@localhost"
+	}
+	return notify.NewEmailChannel(addr, from, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+}
+
+// slackHealthCheck probes Slack's api.test method, which needs no token. The
+// service only receives slash commands from Slack, so this tells whether they
+// can arrive at all. baseURL overrides https://slack.com/api for tests.
+func slackHealthCheck(baseURL string) app.HealthCheckFunc {
+	if baseURL == "" {
+		baseURL = "https://slack.com/api"
+	}
+	url := strings.TrimRight(baseURL, "/") + "/api.test"
+	client := &stdhttp.Client{Timeout: 10 * time.Second}
+	return func(ctx context.Context) error {
+		req, err := stdhttp.NewRequestWithContext(ctx, stdhttp.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("failed to build Slack request: %w", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("Slack api.test: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != stdhttp.StatusOK {
+			return fmt.Errorf("Slack api.test: %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// githubConfig builds the GitHub client. Tokens come from GITHUB_TOKENS
+// ("org=token,...", a bare token applies to every owner) and, when
+// GITHUB_APP_ID is set, from installations of the GitHub App. Without either
+// the sync is disabled and a nil client is returned.
+func githubConfig(store github.InstallationStore) (domain.GitHubClient, error) {
+	var sources github.ChainTokens
+
+	if v := os.Getenv("GITHUB_TOKENS"); v != "" {
+		tokens, err := github.ParseStaticTokens(v)
+		if err != nil {
+			return nil, fmt.Errorf("GITHUB_TOKENS: %w", err)
+		}
+		if len(tokens) > 0 {
+			sources = append(sources, tokens)
+		}
+	}
+
+	if v := os.Getenv("GITHUB_APP_ID"); v != "" {
+		appID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || appID <= 0 {
+			return nil, fmt.Errorf("GITHUB_APP_ID must be a positive integer, got %q", v)
+		}
+		keyPEM := []byte(os.Getenv("GITHUB_APP_PRIVATE_KEY"))
+		if path := os.Getenv("GITHUB_APP_PRIVATE_KEY_FILE"); path != "" {
+			if keyPEM, err = os.ReadFile(path); err != nil {
+				return nil, fmt.Errorf("GITHUB_APP_PRIVATE_KEY_FILE: %w", err)
+			}
+		}
+		key, err := github.ParsePrivateKey(keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("GitHub App private key: %w", err)
+		}
+		sources = append(sources, github.NewAppTokens(os.Getenv("GITHUB_API_URL"), appID, key, store))
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return github.NewClient(os.Getenv("GITHUB_API_URL"), sources), nil
+}
+
+// githubTeamSyncConfig reads the GitHub organization whose teams are synced
+// into internal teams (GITHUB_TEAMS_SYNC_ORG, empty disables the sync) and
+// how often it runs (GITHUB_TEAMS_SYNC_INTERVAL).
+func githubTeamSyncConfig() (string, time.Duration, error) {
+	interval := time.Hour
+	if v := os.Getenv("GITHUB_TEAMS_SYNC_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return "", 0, fmt.Errorf("GITHUB_TEAMS_SYNC_INTERVAL must be a positive duration, got %q", v)
+		}
+		interval = d
+	}
+	return os.Getenv("GITHUB_TEAMS_SYNC_ORG"), interval, nil
 }
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testDBPolicy = dbConnectPolicy{
+	initialBackoff: 100 * time.Millisecond,
+	maxBackoff:     time.Second,
+}
+
+func TestDBBackoffGrowth(t *testing.T) {
+	// Without jitter the delay is half of 100ms, 200ms, 400ms, 800ms and then
+	// of the 1s cap
+	want := []time.Duration{50, 100, 200, 400, 500, 500, 500}
+	for attempt, w := range want {
+		delay, ok := testDBPolicy.backoff(attempt, 0, 0, 0)
+		if !ok || delay != w*time.Millisecond {
+			t.Errorf("attempt %d: got %v %v, want %v", attempt, delay, ok, w*time.Millisecond)
+		}
+	}
+
+	// Attempts far past the cap do not overflow
+	if delay, _ := testDBPolicy.backoff(1000, 0, 0, 0); delay != 500*time.Millisecond {
+		t.Errorf("attempt 1000: got %v, want 500ms", delay)
+	}
+}
+
+func TestDBBackoffJitter(t *testing.T) {
+	for attempt := range 6 {
+		lowest, _ := testDBPolicy.backoff(attempt, 0, 0, 0)
+		for _, jitter := range []float64{0, 0.25, 0.5, 0.999} {
+			delay, _ := testDBPolicy.backoff(attempt, jitter, 0, 0)
+			// The jitter spreads the delay over [half, full) of the exponential
+			// delay
+			if delay < lowest || delay >= 2*lowest {
+				t.Errorf("attempt %d, jitter %v: got %v, want within [%v, %v)", attempt, jitter, delay, lowest, 2*lowest)
+			}
+		}
+	}
+	// Half of the jitter adds a quarter of the exponential delay
+	if delay, _ := testDBPolicy.backoff(3, 0.5, 0, 0); delay != 600*time.Millisecond {
+		t.Errorf("attempt 3, jitter 0.5: got %v, want 600ms", delay)
+	}
+}
+
+func TestDBBackoffBudget(t *testing.T) {
+	// A retry in 400ms fits into what is left of a 1s budget after 600ms
+	if delay, ok := testDBPolicy.backoff(3, 0, 600*time.Millisecond, time.Second); !ok || delay != 400*time.Millisecond {
+		t.Errorf("got %v %v, want 400ms within the budget", delay, ok)
+	}
+	// but not after 601ms
+	if _, ok := testDBPolicy.backoff(3, 0, 601*time.Millisecond, time.Second); ok {
+		t.Error("retry past the budget is allowed")
+	}
+	// A zero budget never runs out
+	if _, ok := testDBPolicy.backoff(3, 0, 24*time.Hour, 0); !ok {
+		t.Error("retry without a budget is refused")
+	}
+}
+
+func TestWaitForDB(t *testing.T) {
+	policy := dbConnectPolicy{initialBackoff: time.Millisecond, maxBackoff: 4 * time.Millisecond}
+	var pings atomic.Int32
+	ping := func(context.Context) error {
+		if pings.Add(1) < 4 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	if !waitForDB(context.Background(), ping, policy, 0) {
+		t.Fatal("waitForDB gave up on a database that came up")
+	}
+	if n := pings.Load(); n != 4 {
+		t.Errorf("pinged %d times, want 4", n)
+	}
+
+	down := func(context.Context) error { return errors.New("connection refused") }
+	start := time.Now()
+	if waitForDB(context.Background(), down, dbConnectPolicy{initialBackoff: 40 * time.Millisecond, maxBackoff: 40 * time.Millisecond}, 50*time.Millisecond) {
+		t.Fatal("waitForDB reported a database that is down as up")
+	}
+	// The first retry (20-40ms) fits into the 50ms budget, the second does not
+	if waited := time.Since(start); waited > time.Second {
+		t.Errorf("gave up after %v, want once the budget ran out", waited)
+	}
+}
+
+func TestWaitForDBStopsOnCancel(t *testing.T) {
+	// Retries at least an hour apart: only the cancellation can end the wait
+	policy := dbConnectPolicy{initialBackoff: 2 * time.Hour, maxBackoff: 2 * time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	pinged := make(chan struct{}, 1)
+	down := func(context.Context) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return errors.New("connection refused")
+	}
+
+	done := make(chan bool)
+	go func() { done <- waitForDB(ctx, down, policy, 0) }()
+	<-pinged
+	cancel()
+
+	select {
+	case ok := <-done:
+		if ok {
+			t.Error("waitForDB reported a database that is down as up")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waitForDB kept retrying after the context was canceled")
+	}
+}
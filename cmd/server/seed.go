@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/app"
+)
+
+// runSeed implements the `server seed <fixture>` subcommand.
+// The fixture may be YAML or JSON, as JSON is a subset of YAML.
+func runSeed(ctx context.Context, seedSvc *app.SeedService, logger *slog.Logger, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: server seed <fixture.yaml|fixture.json>")
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture app.Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return fmt.Errorf("failed to parse fixture %s: %w", args[0], err)
+	}
+
+	result, err := seedSvc.Seed(ctx, &fixture)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("seed completed",
+		"teams", result.Teams,
+		"users", result.Users,
+		"pull_requests", result.PullRequests,
+	)
+	return nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/app"
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/internal/github"
+	"github.com/glebmavi/pr_reviewer_service/internal/http"
+	"github.com/glebmavi/pr_reviewer_service/internal/notify"
+	"github.com/glebmavi/pr_reviewer_service/internal/storage/postgres"
+	"github.com/glebmavi/pr_reviewer_service/internal/testhooks"
+)
+
+// services are the application services of the server, wired to the
+// repository, and the notification channels and GitHub client they use.
+type services struct {
+	uow *app.TimeoutUnitOfWork
+
+	githubClient   domain.GitHubClient
+	webhookChannel *notify.WebhookChannel
+	emailChannel   *notify.EmailChannel
+
+	settings       *app.SettingsService
+	notification   *app.NotificationService
+	live           *app.LiveService
+	github         *app.GitHubService
+	githubApp      *app.GitHubAppService
+	pr             *app.PullRequestService
+	team           *app.TeamService
+	user           *app.UserService
+	stats          *app.StatsService
+	admin          *app.AdminService
+	archive        *app.ArchiveService
+	health         *app.HealthService
+	repository     *app.RepositoryService
+	reviewRule     *app.ReviewRuleService
+	savedFilter    *app.SavedFilterService
+	prTemplate     *app.PRTemplateService
+	provisioning   *app.ProvisioningService
+	githubTeamSync *app.GitHubTeamSyncService
+	reviewBudget   *app.ReviewBudgetService
+	teamReport     *app.TeamReportService
+	webhook        *app.WebhookService
+	teamSnapshot   *app.TeamSnapshotService
+	slack          *app.SlackService
+	escalation     *app.EscalationService
+	ack            *app.AckService
+	inactiveReview *app.InactiveReviewService
+	statsHistory   *app.StatsHistoryService
+}
+
+// buildServices wires the services to the repository. It fails only on an
+// invalid GitHub config.
+func buildServices(cfg *serverConfig, repository *postgres.Repository, logger *slog.Logger) (*services, error) {
+	githubClient, err := githubConfig(repository)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub config: %w", err)
+	}
+	if githubClient != nil {
+		logger.Info("GitHub review request sync enabled")
+	}
+
+	// Bulk work (import, archival, notification delivery with its external
+	// calls) keeps using unbounded transactions.
+	uow := app.NewTimeoutUnitOfWork(repository, cfg.txTimeout)
+	s := &services{uow: uow, githubClient: githubClient}
+	s.github = app.NewGitHubService(repository, repository, githubClient, uow, logger.With("service", "github"))
+	s.settings = app.NewSettingsService(repository, repository, uow, settingsDefaults(cfg.maxOpenReviews, cfg.overdueAfter), logger.With("service", "settings"))
+	s.notification = app.NewNotificationService(repository, repository, repository, repository, s.settings, cfg.notificationRetry, logger.With("service", "notification"))
+	s.registerChannels(repository, logger)
+
+	staffingAlertService := app.NewStaffingAlertService(repository, s.notification, cfg.alertThreshold, cfg.alertWindow, logger.With("service", "staffing_alert"))
+	s.live = app.NewLiveService(repository, repository, repository, os.Getenv("LIVE_UPDATES_TOKEN"), logger.With("service", "live"))
+
+	reviewNotifiers := app.ReviewNotifiers{s.github, s.notification}
+	s.pr = app.NewPullRequestService(repository, repository, repository, repository, repository, uow, reviewNotifiers, s.live, staffingAlertService, s.settings, cfg.candidatePoolTTL, cfg.selectionSalt, logger.With("service", "pr"))
+	s.team = app.NewTeamService(repository, repository, repository, s.pr, uow, logger.With("service", "team"))
+	s.user = app.NewUserService(repository, repository, s.pr, uow, cfg.movePolicy, logger.With("service", "user"))
+	s.stats = app.NewStatsService(repository, logger.With("service", "stats"))
+	s.admin = app.NewAdminService(repository, repository, repository, repository, s.pr, repository, logger.With("service", "admin"))
+	s.archive = app.NewArchiveService(repository, repository, logger.With("service", "archive"))
+	s.health = app.NewHealthService(logger.With("service", "health"))
+	s.registerHealthChecks(repository)
+
+	s.githubApp = app.NewGitHubAppService(repository, repository, repository, repository, repository, s.pr, s.github, uow, os.Getenv("GITHUB_WEBHOOK_SECRET"), cfg.githubReplayWindow, logger.With("service", "github_app"))
+	s.repository = app.NewRepositoryService(repository, repository, uow, logger.With("service", "repository"))
+	s.reviewRule = app.NewReviewRuleService(repository, repository, repository, repository, s.pr, uow, logger.With("service", "review_rule"))
+	s.savedFilter = app.NewSavedFilterService(repository, repository, repository, uow, logger.With("service", "saved_filter"))
+	s.prTemplate = app.NewPRTemplateService(repository, repository, repository, uow, logger.With("service", "pr_template"))
+	s.provisioning = app.NewProvisioningService(repository, repository, s.user, s.team, uow, os.Getenv("SCIM_TOKEN"), logger.With("service", "provisioning"))
+	githubDirectory, _ := githubClient.(domain.GitHubDirectory)
+	s.githubTeamSync = app.NewGitHubTeamSyncService(repository, repository, repository, s.user, s.team, githubDirectory, cfg.teamSyncOrg, uow, logger.With("service", "github_team_sync"))
+	s.reviewBudget = app.NewReviewBudgetService(repository, repository, s.pr, s.notification, uow, cfg.budgetAlertRatio, logger.With("service", "review_budget"))
+	s.teamReport = app.NewTeamReportService(repository, repository, s.notification, uow, logger.With("service", "team_report"))
+	s.webhook = app.NewWebhookService(repository, s.webhookChannel, logger.With("service", "webhook"))
+	s.teamSnapshot = app.NewTeamSnapshotService(repository, repository, repository, repository, repository, repository, s.pr, uow, logger.With("service", "team_snapshot"))
+	s.slack = app.NewSlackService(repository, s.pr, uow, os.Getenv("SLACK_SIGNING_SECRET"), cfg.slackReplayWindow, logger.With("service", "slack"))
+
+	s.escalation = app.NewEscalationService(repository, s.pr, s.notification, uow, logger.With("service", "escalation"))
+	s.ack = app.NewAckService(repository, s.pr, s.notification, uow, cfg.remindAfter, cfg.reassignAfter, logger.With("service", "ack"))
+	s.inactiveReview = app.NewInactiveReviewService(repository, s.pr, s.notification, cfg.inactiveAfter, logger.With("service", "inactive_review"))
+	s.statsHistory = app.NewStatsHistoryService(repository, uow, logger.With("service", "stats_history"))
+	return s, nil
+}
+
+// registerChannels registers the notification channels: the log and webhooks
+// always, email when SMTP is configured.
+func (s *services) registerChannels(repository *postgres.Repository, logger *slog.Logger) {
+	s.notification.RegisterChannel("log", notify.NewLogChannel(logger.With("channel", "log")))
+	s.webhookChannel = notify.NewWebhookChannel(repository, logger.With("channel", "webhook"))
+	s.notification.RegisterChannel("webhook", s.webhookChannel)
+	s.emailChannel = emailConfig()
+	if s.emailChannel != nil {
+		s.notification.RegisterChannel("email", s.emailChannel)
+	}
+}
+
+// registerHealthChecks registers the database, which readiness depends on,
+// and the optional integrations that are configured. Webhooks are reported
+// only while some user has a webhook target.
+func (s *services) registerHealthChecks(repository *postgres.Repository) {
+	s.health.Register("postgres", true, repository.Ping)
+	s.health.RegisterWhen("webhook", false, repository.HasWebhookTargets, s.webhookChannel.Ping)
+	if s.emailChannel != nil {
+		s.health.Register("smtp", false, s.emailChannel.Ping)
+	}
+	if client, ok := s.githubClient.(*github.Client); ok {
+		s.health.Register("github", false, client.Ping)
+	}
+	if os.Getenv("SLACK_SIGNING_SECRET") != "" {
+		s.health.Register("slack", false, slackHealthCheck(os.Getenv("SLACK_API_URL")))
+	}
+}
+
+func (s *services) handler(faults *testhooks.Faults, logger *slog.Logger) *http.Handler {
+	return http.NewHandler(s.team, s.pr, s.user, s.stats, s.admin, s.archive, s.health, s.github, s.githubApp, s.notification, s.repository, s.reviewRule, s.savedFilter, s.prTemplate, s.provisioning, s.githubTeamSync, s.reviewBudget, s.teamReport, s.webhook, s.live, s.teamSnapshot, s.slack, s.settings, faults, logger.With("layer", "http"))
+}
+
+// startJobs starts the background jobs that are enabled; they stop when ctx
+// is done.
+func (s *services) startJobs(ctx context.Context, cfg *serverConfig, logger *slog.Logger) {
+	if cfg.archiveRetention > 0 {
+		logger.Info("merged PR archival enabled", slog.Duration("retention", cfg.archiveRetention), slog.Duration("interval", cfg.archiveInterval))
+		go s.archive.Run(ctx, cfg.archiveRetention, cfg.archiveInterval)
+	}
+	go s.notification.Run(ctx, cfg.notifyInterval)
+	go s.escalation.Run(ctx, cfg.escalationInterval)
+	go s.team.Run(ctx, cfg.deactivationInterval)
+	if s.ack.Enabled() {
+		logger.Info("review acknowledgement follow-up enabled", slog.Duration("remind_after", cfg.remindAfter), slog.Duration("reassign_after", cfg.reassignAfter))
+		go s.ack.Run(ctx, cfg.ackInterval)
+	}
+	if s.inactiveReview.Enabled() {
+		logger.Info("inactive review reassignment enabled", slog.Duration("reassign_after", cfg.inactiveAfter))
+		go s.inactiveReview.Run(ctx, cfg.inactiveInterval)
+	}
+	go s.statsHistory.Run(ctx, cfg.historyInterval)
+	go s.reviewBudget.Run(ctx, cfg.budgetInterval)
+	go s.teamReport.Run(ctx, cfg.reportInterval)
+	if s.githubTeamSync.Enabled() {
+		logger.Info("GitHub teams sync enabled", slog.String("org", cfg.teamSyncOrg), slog.Duration("interval", cfg.teamSyncInterval))
+		go s.githubTeamSync.Run(ctx, cfg.teamSyncInterval)
+	}
+}
+
+// watchConfig applies changes to the reloadable variables of the config file
+// every cfg.reloadInterval and on SIGHUP until ctx is done.
+func (s *services) watchConfig(ctx context.Context, cfg *serverConfig, tunables *http.Tunables, logger *slog.Logger) error {
+	loadConfig := func() (map[string]string, error) { return readReloadableConfig(cfg.configFile, cfg.environ) }
+	initialConfig, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	configWatcher := app.NewConfigWatcher(loadConfig, configApplier(s.uow, tunables, s.settings), initialConfig, logger.With("service", "config"))
+	if cfg.reloadInterval > 0 {
+		go configWatcher.Run(ctx, cfg.reloadInterval)
+	}
+	// SIGHUP reloads the config at once, without waiting for the next poll
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if _, err := configWatcher.Reload(ctx); err != nil {
+				logger.Error("config reload failed", slog.String("event", "config.reload_failed"), slog.String("error", err.Error()))
+			}
+		}
+	}()
+	return nil
+}
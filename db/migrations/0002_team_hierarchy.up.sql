@@ -0,0 +1,6 @@
+ALTER TABLE teams
+    ADD COLUMN parent_team_id INTEGER REFERENCES teams(team_id) ON DELETE SET NULL,
+    ADD COLUMN escalate_to_parent BOOLEAN NOT NULL DEFAULT false,
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_team_id <> team_id);
+
+CREATE INDEX idx_teams_parent_team_id ON teams(parent_team_id);
@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE teams
+    ADD COLUMN required_reviewer_role VARCHAR(50) NOT NULL DEFAULT '';
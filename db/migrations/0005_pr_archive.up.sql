@@ -0,0 +1,23 @@
+CREATE TABLE pull_requests_archive (
+    pr_id VARCHAR(100) PRIMARY KEY,
+    pr_name VARCHAR(255) NOT NULL,
+    author_id VARCHAR(100) NOT NULL REFERENCES users(user_id),
+    status pr_status NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    merged_at TIMESTAMPTZ,
+    required_skills TEXT[] NOT NULL DEFAULT '{}',
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE review_assignments_archive (
+    pr_id VARCHAR(100) NOT NULL REFERENCES pull_requests_archive(pr_id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (pr_id, user_id)
+);
+
+CREATE INDEX idx_pr_merged_at
+    ON pull_requests (merged_at)
+    WHERE status = 'MERGED';
+
+CREATE INDEX idx_ra_archive_userid
+    ON review_assignments_archive (user_id);
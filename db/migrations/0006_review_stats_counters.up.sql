@@ -0,0 +1,145 @@
+-- Precomputed review counters so /stats does not aggregate review_assignments
+-- on every request. They are kept in sync by triggers and always reflect the
+-- live (non-archived) assignments.
+CREATE TABLE user_review_stats (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    total_reviews BIGINT NOT NULL DEFAULT 0,
+    open_reviews BIGINT NOT NULL DEFAULT 0,
+    merged_reviews BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE team_review_stats (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    open_reviews BIGINT NOT NULL DEFAULT 0,
+    merged_reviews BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_user_review_stats_total
+    ON user_review_stats (total_reviews DESC)
+    WHERE total_reviews > 0;
+
+-- bump_review_stats adds delta to the counters of a reviewer and their current team.
+CREATE FUNCTION bump_review_stats(p_user_id VARCHAR, p_status pr_status, p_delta BIGINT) RETURNS void AS $$
+DECLARE
+    open_delta BIGINT := CASE WHEN p_status = 'OPEN' THEN p_delta ELSE 0 END;
+    merged_delta BIGINT := CASE WHEN p_status = 'MERGED' THEN p_delta ELSE 0 END;
+BEGIN
+    INSERT INTO user_review_stats (user_id, total_reviews, open_reviews, merged_reviews)
+    SELECT u.user_id, p_delta, open_delta, merged_delta
+    FROM users u
+    WHERE u.user_id = p_user_id
+    ON CONFLICT (user_id) DO UPDATE
+        SET total_reviews = user_review_stats.total_reviews + EXCLUDED.total_reviews,
+            open_reviews = user_review_stats.open_reviews + EXCLUDED.open_reviews,
+            merged_reviews = user_review_stats.merged_reviews + EXCLUDED.merged_reviews;
+
+    INSERT INTO team_review_stats (team_id, open_reviews, merged_reviews)
+    SELECT u.team_id, open_delta, merged_delta
+    FROM users u
+    WHERE u.user_id = p_user_id
+    ON CONFLICT (team_id) DO UPDATE
+        SET open_reviews = team_review_stats.open_reviews + EXCLUDED.open_reviews,
+            merged_reviews = team_review_stats.merged_reviews + EXCLUDED.merged_reviews;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION review_assignments_stats_trigger() RETURNS trigger AS $$
+DECLARE
+    pr_state pr_status;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT status INTO pr_state FROM pull_requests WHERE pr_id = NEW.pr_id;
+        PERFORM bump_review_stats(NEW.user_id, pr_state, 1);
+        RETURN NEW;
+    END IF;
+
+    -- When the PR itself is being deleted its counters were already
+    -- released by pull_requests_stats_delete_trigger.
+    SELECT status INTO pr_state FROM pull_requests WHERE pr_id = OLD.pr_id;
+    IF FOUND THEN
+        PERFORM bump_review_stats(OLD.user_id, pr_state, -1);
+    END IF;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION pull_requests_stats_update_trigger() RETURNS trigger AS $$
+DECLARE
+    reviewer VARCHAR;
+BEGIN
+    FOR reviewer IN SELECT user_id FROM review_assignments WHERE pr_id = NEW.pr_id LOOP
+        PERFORM bump_review_stats(reviewer, OLD.status, -1);
+        PERFORM bump_review_stats(reviewer, NEW.status, 1);
+    END LOOP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION pull_requests_stats_delete_trigger() RETURNS trigger AS $$
+DECLARE
+    reviewer VARCHAR;
+BEGIN
+    FOR reviewer IN SELECT user_id FROM review_assignments WHERE pr_id = OLD.pr_id LOOP
+        PERFORM bump_review_stats(reviewer, OLD.status, -1);
+    END LOOP;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Team counters follow the reviewer's current team, so moving a user moves their load.
+CREATE FUNCTION users_stats_team_trigger() RETURNS trigger AS $$
+DECLARE
+    stats user_review_stats%ROWTYPE;
+BEGIN
+    SELECT * INTO stats FROM user_review_stats WHERE user_id = NEW.user_id;
+    IF NOT FOUND THEN
+        RETURN NEW;
+    END IF;
+
+    UPDATE team_review_stats
+    SET open_reviews = open_reviews - stats.open_reviews,
+        merged_reviews = merged_reviews - stats.merged_reviews
+    WHERE team_id = OLD.team_id;
+
+    INSERT INTO team_review_stats (team_id, open_reviews, merged_reviews)
+    VALUES (NEW.team_id, stats.open_reviews, stats.merged_reviews)
+    ON CONFLICT (team_id) DO UPDATE
+        SET open_reviews = team_review_stats.open_reviews + EXCLUDED.open_reviews,
+            merged_reviews = team_review_stats.merged_reviews + EXCLUDED.merged_reviews;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_assignments_stats
+    AFTER INSERT OR DELETE ON review_assignments
+    FOR EACH ROW EXECUTE FUNCTION review_assignments_stats_trigger();
+
+CREATE TRIGGER pull_requests_stats_update
+    AFTER UPDATE OF status ON pull_requests
+    FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION pull_requests_stats_update_trigger();
+
+CREATE TRIGGER pull_requests_stats_delete
+    BEFORE DELETE ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION pull_requests_stats_delete_trigger();
+
+CREATE TRIGGER users_stats_team
+    AFTER UPDATE OF team_id ON users
+    FOR EACH ROW WHEN (OLD.team_id IS DISTINCT FROM NEW.team_id)
+    EXECUTE FUNCTION users_stats_team_trigger();
+
+-- Backfill from existing data
+INSERT INTO user_review_stats (user_id, total_reviews, open_reviews, merged_reviews)
+SELECT ra.user_id,
+       COUNT(*),
+       COUNT(*) FILTER (WHERE pr.status = 'OPEN'),
+       COUNT(*) FILTER (WHERE pr.status = 'MERGED')
+FROM review_assignments ra
+JOIN pull_requests pr ON pr.pr_id = ra.pr_id
+GROUP BY ra.user_id;
+
+INSERT INTO team_review_stats (team_id, open_reviews, merged_reviews)
+SELECT u.team_id, SUM(s.open_reviews), SUM(s.merged_reviews)
+FROM user_review_stats s
+JOIN users u ON u.user_id = s.user_id
+GROUP BY u.team_id;
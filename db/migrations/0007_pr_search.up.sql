@@ -0,0 +1,10 @@
+ALTER TABLE pull_requests
+    ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+-- Must match the expression used by SearchPRs so the planner picks the index.
+CREATE INDEX idx_pr_search
+    ON pull_requests
+    USING GIN ((setweight(to_tsvector('simple', pr_name), 'A') || setweight(to_tsvector('simple', description), 'B')));
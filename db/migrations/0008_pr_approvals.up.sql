@@ -0,0 +1,11 @@
+ALTER TABLE review_assignments
+    ADD COLUMN approved_at TIMESTAMPTZ;
+
+ALTER TABLE review_assignments_archive
+    ADD COLUMN approved_at TIMESTAMPTZ;
+
+ALTER TABLE pull_requests
+    ADD COLUMN auto_merge BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN auto_merge BOOLEAN NOT NULL DEFAULT false;
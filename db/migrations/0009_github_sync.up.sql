@@ -0,0 +1,17 @@
+CREATE TABLE github_accounts (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    login VARCHAR(39) NOT NULL
+);
+
+-- GitHub logins are case-insensitive
+CREATE UNIQUE INDEX idx_github_accounts_login
+    ON github_accounts (lower(login));
+
+-- Links are dropped together with the PR (including when it is archived),
+-- since merged PRs need no further syncing.
+CREATE TABLE github_pull_requests (
+    pr_id VARCHAR(100) PRIMARY KEY REFERENCES pull_requests(pr_id) ON DELETE CASCADE,
+    repository VARCHAR(140) NOT NULL,
+    number INTEGER NOT NULL CHECK (number > 0),
+    UNIQUE (repository, number)
+);
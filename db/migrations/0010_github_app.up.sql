@@ -0,0 +1,22 @@
+CREATE TABLE github_installations (
+    installation_id BIGINT PRIMARY KEY,
+    account_login VARCHAR(39) NOT NULL,
+    -- Cached installation access token, refreshed shortly before it expires
+    access_token TEXT,
+    token_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_github_installations_account
+    ON github_installations (lower(account_login));
+
+-- Repositories the app is installed on. A repository without a team is known
+-- but not onboarded: its pull requests are ignored.
+CREATE TABLE github_repositories (
+    repository VARCHAR(140) PRIMARY KEY,
+    installation_id BIGINT NOT NULL REFERENCES github_installations(installation_id) ON DELETE CASCADE,
+    team_id INTEGER REFERENCES teams(team_id)
+);
+
+CREATE INDEX idx_github_repositories_installation
+    ON github_repositories (installation_id);
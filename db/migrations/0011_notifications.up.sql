@@ -0,0 +1,29 @@
+CREATE TABLE notification_preferences (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    channels TEXT[] NOT NULL DEFAULT '{log}',
+    muted_events TEXT[] NOT NULL DEFAULT '{}',
+    -- Local "HH:MM" bounds; the window may wrap past midnight
+    quiet_hours_start VARCHAR(5) CHECK (quiet_hours_start ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'),
+    quiet_hours_end VARCHAR(5) CHECK (quiet_hours_end ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'),
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    webhook_url TEXT NOT NULL DEFAULT ''
+);
+
+-- Notifications are queued here and delivered by a background worker, which
+-- lets quiet hours postpone delivery and failed deliveries be retried.
+CREATE TABLE notification_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event TEXT NOT NULL,
+    pr_id VARCHAR(100),
+    message TEXT NOT NULL,
+    deliver_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_outbox_pending
+    ON notification_outbox (deliver_after)
+    WHERE sent_at IS NULL;
@@ -0,0 +1,15 @@
+ALTER TABLE review_assignments
+    ADD COLUMN assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Existing assignments are dated by their PR, the closest known approximation
+UPDATE review_assignments ra
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.pr_id = ra.pr_id;
+
+ALTER TABLE review_assignments_archive
+    ADD COLUMN assigned_at TIMESTAMPTZ;
+
+ALTER TABLE notification_preferences
+    ADD COLUMN digest VARCHAR(10) NOT NULL DEFAULT 'off' CHECK (digest IN ('off', 'daily', 'weekly')),
+    ADD COLUMN last_digest_at TIMESTAMPTZ;
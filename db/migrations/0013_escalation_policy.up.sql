@@ -0,0 +1,9 @@
+ALTER TABLE teams
+    ADD COLUMN lead_user_id VARCHAR(100) REFERENCES users(user_id) ON DELETE SET NULL,
+    -- Hours without reviewer activity before escalating; 0 disables the step
+    ADD COLUMN escalation_notify_after_hours INTEGER NOT NULL DEFAULT 0 CHECK (escalation_notify_after_hours >= 0),
+    ADD COLUMN escalation_add_reviewer_after_hours INTEGER NOT NULL DEFAULT 0 CHECK (escalation_add_reviewer_after_hours >= 0);
+
+ALTER TABLE pull_requests
+    ADD COLUMN lead_notified_at TIMESTAMPTZ,
+    ADD COLUMN reviewer_escalated_at TIMESTAMPTZ;
@@ -0,0 +1,14 @@
+-- Declared in ascending order so that ORDER BY priority DESC puts urgent PRs first
+CREATE TYPE pr_priority AS ENUM ('LOW', 'NORMAL', 'URGENT');
+
+ALTER TABLE pull_requests
+    ADD COLUMN priority pr_priority NOT NULL DEFAULT 'NORMAL';
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN priority pr_priority NOT NULL DEFAULT 'NORMAL';
+
+-- Scales team escalation delays: urgent PRs escalate four times sooner, low
+-- priority ones twice later.
+CREATE FUNCTION priority_delay_factor(p pr_priority) RETURNS double precision AS $$
+    SELECT CASE p WHEN 'URGENT' THEN 0.25 WHEN 'LOW' THEN 2.0 ELSE 1.0 END
+$$ LANGUAGE sql IMMUTABLE;
@@ -0,0 +1,11 @@
+ALTER TABLE review_assignments
+    ADD COLUMN acked_at TIMESTAMPTZ,
+    ADD COLUMN ack_reminded_at TIMESTAMPTZ;
+
+-- An approval implies the reviewer has seen the assignment
+UPDATE review_assignments
+SET acked_at = approved_at
+WHERE approved_at IS NOT NULL;
+
+ALTER TABLE review_assignments_archive
+    ADD COLUMN acked_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+-- Planned offboarding: the scheduler deactivates the team once this time passes
+ALTER TABLE teams
+    ADD COLUMN deactivate_at TIMESTAMPTZ;
+
+CREATE INDEX idx_teams_deactivate_at
+    ON teams (deactivate_at)
+    WHERE deactivate_at IS NOT NULL;
@@ -0,0 +1,26 @@
+-- Per-repository reviewer assignment settings. PRs that reference a repository
+-- pick reviewers from its default team instead of the author's team.
+CREATE TABLE repositories (
+    repository_name VARCHAR(140) PRIMARY KEY,
+    default_team_id INTEGER REFERENCES teams(team_id),
+    -- Reviewers assigned to a new PR; NULL keeps the service default
+    required_reviewers INTEGER CHECK (required_reviewers BETWEEN 1 AND 3),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Rules are tried in position order; the first one whose prefix matches the PR
+-- name (case-insensitively) routes the PR to its team and adds its skills.
+CREATE TABLE repository_routing_rules (
+    repository_name VARCHAR(140) NOT NULL REFERENCES repositories(repository_name) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title_prefix VARCHAR(100) NOT NULL CHECK (title_prefix <> ''),
+    team_id INTEGER REFERENCES teams(team_id),
+    required_skills TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (repository_name, position)
+);
+
+ALTER TABLE pull_requests
+    ADD COLUMN repository_name VARCHAR(140) REFERENCES repositories(repository_name) ON DELETE SET NULL;
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN repository_name VARCHAR(140);
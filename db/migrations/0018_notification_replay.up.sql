@@ -0,0 +1,7 @@
+-- Delivered notifications stay in the outbox as the log of emitted events.
+-- A replay queues a copy that points back at the original.
+ALTER TABLE notification_outbox
+    ADD COLUMN replay_of BIGINT REFERENCES notification_outbox(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_notification_outbox_created_at
+    ON notification_outbox (created_at);
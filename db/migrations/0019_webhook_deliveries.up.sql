@@ -0,0 +1,19 @@
+-- Every attempt to post a notification to a webhook, kept so that failed
+-- deliveries can be inspected and sent again.
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    notification_id BIGINT REFERENCES notification_outbox(id) ON DELETE SET NULL,
+    user_id VARCHAR(100) REFERENCES users(user_id) ON DELETE SET NULL,
+    url TEXT NOT NULL,
+    request_body TEXT NOT NULL,
+    -- NULL when no response was received
+    status_code INTEGER,
+    response_body TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms INTEGER NOT NULL,
+    redelivery_of BIGINT REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    attempted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_attempted_at
+    ON webhook_deliveries (attempted_at DESC, id DESC);
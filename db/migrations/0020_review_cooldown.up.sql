@@ -0,0 +1,3 @@
+ALTER TABLE teams
+    -- Reviewers of an author's last N PRs are picked last for the author's next PR; 0 disables it
+    ADD COLUMN review_cooldown_prs INTEGER NOT NULL DEFAULT 0 CHECK (review_cooldown_prs >= 0);
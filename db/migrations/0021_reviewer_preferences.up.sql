@@ -0,0 +1,9 @@
+-- Preferred reviewers of an author within a team; higher weights are picked first
+CREATE TABLE reviewer_preferences (
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    author_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    weight INTEGER NOT NULL CHECK (weight BETWEEN 1 AND 100),
+    PRIMARY KEY (team_id, author_id, reviewer_id),
+    CHECK (author_id <> reviewer_id)
+);
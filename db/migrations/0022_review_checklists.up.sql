@@ -0,0 +1,16 @@
+ALTER TABLE teams
+    -- Items copied to every new PR reviewed by the team
+    ADD COLUMN checklist_items TEXT[] NOT NULL DEFAULT '{}',
+    -- Whether PRs can only be merged once every checklist item is ticked
+    ADD COLUMN checklist_required BOOLEAN NOT NULL DEFAULT false;
+
+-- The checklist of a PR, copied from the team template when the PR is created
+CREATE TABLE pr_checklist_items (
+    pr_id VARCHAR(100) NOT NULL REFERENCES pull_requests(pr_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    label TEXT NOT NULL,
+    checked BOOLEAN NOT NULL DEFAULT false,
+    checked_by VARCHAR(100) REFERENCES users(user_id) ON DELETE SET NULL,
+    checked_at TIMESTAMPTZ,
+    PRIMARY KEY (pr_id, position)
+);
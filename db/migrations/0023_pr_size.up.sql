@@ -0,0 +1,20 @@
+-- Size of the change as reported by the client; NULL when unknown
+ALTER TABLE pull_requests
+    ADD COLUMN lines_changed INTEGER CHECK (lines_changed >= 0),
+    ADD COLUMN files_changed INTEGER CHECK (files_changed >= 0);
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN lines_changed INTEGER,
+    ADD COLUMN files_changed INTEGER;
+
+-- Rules are tried in position order; the first one whose bounds fit the PR
+-- size sets how many reviewers the PR gets, never more than the usual number.
+CREATE TABLE team_size_rules (
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    -- NULL bounds match any size
+    max_lines_changed INTEGER CHECK (max_lines_changed >= 0),
+    max_files_changed INTEGER CHECK (max_files_changed >= 0),
+    reviewers INTEGER NOT NULL CHECK (reviewers BETWEEN 1 AND 3),
+    PRIMARY KEY (team_id, position)
+);
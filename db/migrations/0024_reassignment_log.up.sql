@@ -0,0 +1,14 @@
+-- Every reviewer taken off a PR without finishing the review, kept for churn
+-- statistics. team_id is the team of the removed reviewer at that moment.
+CREATE TABLE reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pr_id VARCHAR(100) NOT NULL,
+    team_id INTEGER REFERENCES teams(team_id) ON DELETE CASCADE,
+    from_user_id VARCHAR(100) REFERENCES users(user_id) ON DELETE SET NULL,
+    -- NULL when nobody replaced the reviewer
+    to_user_id VARCHAR(100) REFERENCES users(user_id) ON DELETE SET NULL,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('deactivation', 'manual', 'handoff', 'unacked', 'rebalance')),
+    reassigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reassignments_reassigned_at ON reassignments (reassigned_at);
@@ -0,0 +1,16 @@
+-- Reviewer picks that found nobody, kept for a short window to spot teams
+-- that run out of reviewers.
+CREATE TABLE no_candidate_events (
+    id BIGSERIAL PRIMARY KEY,
+    team_id INT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    pr_id VARCHAR(100) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_no_candidate_events_team ON no_candidate_events (team_id, occurred_at);
+
+-- When the team lead was last alerted, so a spike is reported once per window.
+CREATE TABLE no_candidate_alerts (
+    team_id INT PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    alerted_at TIMESTAMPTZ NOT NULL
+);
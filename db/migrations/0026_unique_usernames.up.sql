@@ -0,0 +1,47 @@
+-- Usernames are unique within a team unless the team allows duplicates.
+-- Teams that already have duplicate usernames keep them allowed.
+ALTER TABLE teams ADD COLUMN allow_duplicate_usernames BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE teams
+SET allow_duplicate_usernames = true
+WHERE team_id IN (
+    SELECT team_id FROM users
+    GROUP BY team_id, username
+    HAVING COUNT(*) > 1
+);
+
+CREATE INDEX idx_users_team_username ON users (team_id, username);
+
+-- A unique index cannot depend on the team's flag, so the check is a trigger.
+-- Locking the team row serializes concurrent inserts into the same team.
+CREATE FUNCTION users_unique_username_trigger() RETURNS trigger AS $$
+DECLARE
+    allowed BOOLEAN;
+BEGIN
+    SELECT allow_duplicate_usernames INTO allowed
+    FROM teams
+    WHERE team_id = NEW.team_id
+    FOR NO KEY UPDATE;
+    IF allowed THEN
+        RETURN NEW;
+    END IF;
+
+    IF EXISTS (
+        SELECT 1 FROM users
+        WHERE team_id = NEW.team_id AND username = NEW.username AND user_id <> NEW.user_id
+    ) THEN
+        RAISE EXCEPTION 'username "%" already exists in team %', NEW.username, NEW.team_id
+            USING ERRCODE = 'unique_violation', CONSTRAINT = 'users_team_username_unique';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_unique_username_insert
+    BEFORE INSERT ON users
+    FOR EACH ROW EXECUTE FUNCTION users_unique_username_trigger();
+
+CREATE TRIGGER users_unique_username_update
+    BEFORE UPDATE OF team_id, username ON users
+    FOR EACH ROW WHEN (OLD.team_id IS DISTINCT FROM NEW.team_id OR OLD.username IS DISTINCT FROM NEW.username)
+    EXECUTE FUNCTION users_unique_username_trigger();
@@ -0,0 +1,7 @@
+-- Caller-supplied identifier of the PR in an external system (e.g. an SCM),
+-- used to correlate webhook events without a separate mapping.
+ALTER TABLE pull_requests
+    ADD COLUMN external_id VARCHAR(255) UNIQUE;
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN external_id VARCHAR(255);
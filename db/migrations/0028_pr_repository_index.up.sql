@@ -0,0 +1,6 @@
+-- Repository stats filter PRs, live and archived, by repository.
+CREATE INDEX idx_pr_repository_name
+    ON pull_requests (repository_name);
+
+CREATE INDEX idx_pr_archive_repository_name
+    ON pull_requests_archive (repository_name);
@@ -0,0 +1,17 @@
+-- A reviewer either approves a PR or requests changes. reviewed_at keeps the
+-- first verdict, so review turnaround is reviewed_at - assigned_at.
+ALTER TABLE review_assignments
+    ADD COLUMN changes_requested_at TIMESTAMPTZ,
+    ADD COLUMN reviewed_at TIMESTAMPTZ;
+
+UPDATE review_assignments
+SET reviewed_at = approved_at
+WHERE approved_at IS NOT NULL;
+
+ALTER TABLE review_assignments_archive
+    ADD COLUMN changes_requested_at TIMESTAMPTZ,
+    ADD COLUMN reviewed_at TIMESTAMPTZ;
+
+UPDATE review_assignments_archive
+SET reviewed_at = approved_at
+WHERE approved_at IS NOT NULL;
@@ -0,0 +1,18 @@
+-- Notifications that failed every delivery attempt are moved to the dead
+-- letter queue instead of being left pending forever.
+ALTER TABLE notification_outbox
+    ADD COLUMN dead_lettered_at TIMESTAMPTZ;
+
+UPDATE notification_outbox
+SET dead_lettered_at = NOW()
+WHERE sent_at IS NULL AND attempts >= 5;
+
+DROP INDEX idx_notification_outbox_pending;
+
+CREATE INDEX idx_notification_outbox_pending
+    ON notification_outbox (deliver_after)
+    WHERE sent_at IS NULL AND dead_lettered_at IS NULL;
+
+CREATE INDEX idx_notification_outbox_dead_lettered
+    ON notification_outbox (dead_lettered_at)
+    WHERE dead_lettered_at IS NOT NULL;
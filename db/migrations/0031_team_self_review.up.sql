@@ -0,0 +1,2 @@
+-- Lets authors be assigned to review their own PRs, for solo teams and emergencies
+ALTER TABLE teams ADD COLUMN allow_self_review BOOLEAN NOT NULL DEFAULT false;
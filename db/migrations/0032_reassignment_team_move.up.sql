@@ -0,0 +1,3 @@
+ALTER TABLE reassignments DROP CONSTRAINT reassignments_reason_check;
+ALTER TABLE reassignments ADD CONSTRAINT reassignments_reason_check
+    CHECK (reason IN ('deactivation', 'manual', 'handoff', 'unacked', 'rebalance', 'team_move'));
@@ -0,0 +1,89 @@
+-- Periods during which an open PR had no reviewers, kept for the staffing gap
+-- time series. team_id is the author's team when the period started; ended_at
+-- is NULL while the PR is still waiting for a reviewer.
+CREATE TABLE unassigned_pr_periods (
+    id BIGSERIAL PRIMARY KEY,
+    pr_id VARCHAR(100) NOT NULL,
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_unassigned_pr_periods_open ON unassigned_pr_periods (pr_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_unassigned_pr_periods_range ON unassigned_pr_periods (started_at, ended_at);
+
+CREATE FUNCTION open_unassigned_period(p_pr_id VARCHAR) RETURNS void AS $$
+BEGIN
+    INSERT INTO unassigned_pr_periods (pr_id, team_id)
+    SELECT pr.pr_id, u.team_id
+    FROM pull_requests pr
+    JOIN users u ON u.user_id = pr.author_id
+    WHERE pr.pr_id = p_pr_id AND pr.status = 'OPEN'
+      AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id)
+    ON CONFLICT (pr_id) WHERE ended_at IS NULL DO NOTHING;
+END;
+$$ LANGUAGE plpgsql;
+
+-- A period opened in the same transaction (a PR created and assigned at once,
+-- or a reviewer replaced) never was visible to anyone and is dropped.
+CREATE FUNCTION close_unassigned_period(p_pr_id VARCHAR) RETURNS void AS $$
+BEGIN
+    DELETE FROM unassigned_pr_periods
+    WHERE pr_id = p_pr_id AND ended_at IS NULL AND started_at = NOW();
+
+    UPDATE unassigned_pr_periods
+    SET ended_at = NOW()
+    WHERE pr_id = p_pr_id AND ended_at IS NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION review_assignments_unassigned_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM close_unassigned_period(NEW.pr_id);
+        RETURN NEW;
+    END IF;
+    PERFORM open_unassigned_period(OLD.pr_id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION pull_requests_unassigned_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM open_unassigned_period(NEW.pr_id);
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'UPDATE' AND NEW.status = 'OPEN' THEN
+        PERFORM open_unassigned_period(NEW.pr_id);
+        RETURN NEW;
+    END IF;
+    PERFORM close_unassigned_period(OLD.pr_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_assignments_unassigned
+    AFTER INSERT OR DELETE ON review_assignments
+    FOR EACH ROW EXECUTE FUNCTION review_assignments_unassigned_trigger();
+
+CREATE TRIGGER pull_requests_unassigned_insert
+    AFTER INSERT ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION pull_requests_unassigned_trigger();
+
+CREATE TRIGGER pull_requests_unassigned_update
+    AFTER UPDATE OF status ON pull_requests
+    FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION pull_requests_unassigned_trigger();
+
+CREATE TRIGGER pull_requests_unassigned_delete
+    AFTER DELETE ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION pull_requests_unassigned_trigger();
+
+-- Backfill PRs waiting for a reviewer right now; earlier gaps are not known.
+INSERT INTO unassigned_pr_periods (pr_id, team_id, started_at)
+SELECT pr.pr_id, u.team_id, pr.created_at
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+WHERE pr.status = 'OPEN'
+  AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id);
@@ -0,0 +1,23 @@
+ALTER TABLE pull_requests
+    ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}';
+
+-- Service-wide review rules. A PR matches a rule when it has any of its labels
+-- or belongs to any of its repositories; the first enabled match by position
+-- overrides the team and number of reviewers and adds its skills.
+CREATE TABLE review_rules (
+    rule_name VARCHAR(100) PRIMARY KEY,
+    position INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    labels TEXT[] NOT NULL DEFAULT '{}',
+    repositories TEXT[] NOT NULL DEFAULT '{}',
+    team_id INTEGER REFERENCES teams(team_id) ON DELETE CASCADE,
+    -- NULL keeps the number of reviewers the PR would get without the rule
+    reviewers INTEGER CHECK (reviewers BETWEEN 1 AND 3),
+    required_skills TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (cardinality(labels) + cardinality(repositories) > 0),
+    CHECK (team_id IS NOT NULL OR reviewers IS NOT NULL OR cardinality(required_skills) > 0)
+);
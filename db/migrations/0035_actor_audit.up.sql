@@ -0,0 +1,13 @@
+-- Actors are whoever the client said performed the action (X-Actor-Id), not
+-- necessarily known users, so they are not foreign keys. NULL means the
+-- service did it on its own or the client did not say.
+ALTER TABLE pull_requests
+    ADD COLUMN merged_by VARCHAR(100),
+    ADD COLUMN reassigned_by VARCHAR(100);
+
+ALTER TABLE pull_requests_archive
+    ADD COLUMN merged_by VARCHAR(100),
+    ADD COLUMN reassigned_by VARCHAR(100);
+
+ALTER TABLE reassignments
+    ADD COLUMN reassigned_by VARCHAR(100);
@@ -0,0 +1,34 @@
+-- Internal teams kept in sync with GitHub organization teams. The link is by
+-- the GitHub team ID, so renames on either side keep it.
+CREATE TABLE github_team_links (
+    github_team_id BIGINT PRIMARY KEY,
+    org VARCHAR(39) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    team_id INTEGER NOT NULL UNIQUE REFERENCES teams(team_id) ON DELETE CASCADE
+);
+
+-- Reports of the GitHub teams sync runs, newest kept
+CREATE TABLE github_team_sync_runs (
+    run_id BIGSERIAL PRIMARY KEY,
+    org VARCHAR(39) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL,
+    teams_created INTEGER NOT NULL DEFAULT 0,
+    users_created INTEGER NOT NULL DEFAULT 0,
+    users_moved INTEGER NOT NULL DEFAULT 0,
+    users_deactivated INTEGER NOT NULL DEFAULT 0,
+    -- Set when the run could not read the organization from GitHub
+    error TEXT NOT NULL DEFAULT ''
+);
+
+-- Memberships a run could not apply, for an admin to resolve
+CREATE TABLE github_team_sync_conflicts (
+    run_id BIGINT NOT NULL REFERENCES github_team_sync_runs(run_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    reason VARCHAR(50) NOT NULL,
+    login VARCHAR(39) NOT NULL DEFAULT '',
+    user_id VARCHAR(100) NOT NULL DEFAULT '',
+    team_name VARCHAR(100) NOT NULL DEFAULT '',
+    detail TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (run_id, position)
+);
@@ -0,0 +1,29 @@
+-- Review budgets cap the reviews each member of a team takes per sprint. The
+-- scheduler starts a new sprint every sprint_days days from sprint_started_at.
+CREATE TABLE team_review_budgets (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    reviews_per_sprint INTEGER NOT NULL CHECK (reviews_per_sprint > 0),
+    sprint_days INTEGER NOT NULL CHECK (sprint_days > 0),
+    sprint_started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- When the team lead was told the sprint's budget runs out, so it is reported once per sprint
+    alerted_at TIMESTAMPTZ
+);
+
+-- Reviews assigned to a user since the current sprint of their team started
+CREATE TABLE user_review_budgets (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    used INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE FUNCTION review_assignments_budget_trigger() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO user_review_budgets (user_id, used)
+    VALUES (NEW.user_id, 1)
+    ON CONFLICT (user_id) DO UPDATE SET used = user_review_budgets.used + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_assignments_budget
+    AFTER INSERT ON review_assignments
+    FOR EACH ROW EXECUTE FUNCTION review_assignments_budget_trigger();
@@ -0,0 +1,11 @@
+-- Optional reviewers are assigned on top of the required ones. They may
+-- approve or request changes, but only required reviewers count towards
+-- auto-merge, the required reviewer role, escalation and acknowledgement SLAs.
+ALTER TABLE teams
+    ADD COLUMN optional_reviewers INTEGER NOT NULL DEFAULT 0 CHECK (optional_reviewers >= 0);
+
+ALTER TABLE review_assignments
+    ADD COLUMN optional BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE review_assignments_archive
+    ADD COLUMN optional BOOLEAN NOT NULL DEFAULT false;
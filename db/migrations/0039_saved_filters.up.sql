@@ -0,0 +1,23 @@
+-- Named PR list filters, owned by a user or by a team. Filter columns hold the
+-- parameters of GET /pullRequest/list; an empty value matches any PR.
+CREATE TABLE saved_filters (
+    filter_id BIGSERIAL PRIMARY KEY,
+    filter_name VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) REFERENCES users(user_id) ON DELETE CASCADE,
+    team_id INTEGER REFERENCES teams(team_id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT '',
+    author_id VARCHAR(100) NOT NULL DEFAULT '',
+    reviewer_id VARCHAR(100) NOT NULL DEFAULT '',
+    team_name VARCHAR(100) NOT NULL DEFAULT '',
+    repository_name VARCHAR(140) NOT NULL DEFAULT '',
+    priority VARCHAR(20) NOT NULL DEFAULT '',
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    min_age_hours INTEGER NOT NULL DEFAULT 0 CHECK (min_age_hours >= 0),
+    unreviewed BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((user_id IS NULL) <> (team_id IS NULL))
+);
+
+CREATE UNIQUE INDEX saved_filters_user_name ON saved_filters (user_id, filter_name) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX saved_filters_team_name ON saved_filters (team_id, filter_name) WHERE team_id IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Why the reviewer declined the review, when they said so on a manual
+-- reassignment. NULL when no reason was given.
+ALTER TABLE reassignments
+    ADD COLUMN decline_reason VARCHAR(30)
+        CHECK (decline_reason IN ('conflict_of_interest', 'overloaded', 'on_leave', 'lacks_context'));
@@ -0,0 +1,6 @@
+-- New values of the PR status machine. They are added on their own because
+-- enum values cannot be used in the transaction that adds them.
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'DRAFT' BEFORE 'OPEN';
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'IN_REVIEW' AFTER 'OPEN';
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'APPROVED' AFTER 'IN_REVIEW';
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'CLOSED' AFTER 'MERGED';
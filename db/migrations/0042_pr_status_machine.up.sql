@@ -0,0 +1,91 @@
+-- PR status machine: DRAFT -> OPEN -> IN_REVIEW -> APPROVED -> MERGED or
+-- CLOSED. Drafts, merges and closes are explicit; between OPEN, IN_REVIEW and
+-- APPROVED a PR moves with its reviewers' verdicts, kept up to date by
+-- triggers like the review counters.
+ALTER TABLE pull_requests
+    ADD COLUMN closed_at TIMESTAMPTZ;
+
+-- Reviews of drafts and of PRs under review count as open.
+CREATE OR REPLACE FUNCTION bump_review_stats(p_user_id VARCHAR, p_status pr_status, p_delta BIGINT) RETURNS void AS $$
+DECLARE
+    open_delta BIGINT := CASE WHEN p_status NOT IN ('MERGED', 'CLOSED') THEN p_delta ELSE 0 END;
+    merged_delta BIGINT := CASE WHEN p_status = 'MERGED' THEN p_delta ELSE 0 END;
+BEGIN
+    INSERT INTO user_review_stats (user_id, total_reviews, open_reviews, merged_reviews)
+    SELECT u.user_id, p_delta, open_delta, merged_delta
+    FROM users u
+    WHERE u.user_id = p_user_id
+    ON CONFLICT (user_id) DO UPDATE
+        SET total_reviews = user_review_stats.total_reviews + EXCLUDED.total_reviews,
+            open_reviews = user_review_stats.open_reviews + EXCLUDED.open_reviews,
+            merged_reviews = user_review_stats.merged_reviews + EXCLUDED.merged_reviews;
+
+    INSERT INTO team_review_stats (team_id, open_reviews, merged_reviews)
+    SELECT u.team_id, open_delta, merged_delta
+    FROM users u
+    WHERE u.user_id = p_user_id
+    ON CONFLICT (team_id) DO UPDATE
+        SET open_reviews = team_review_stats.open_reviews + EXCLUDED.open_reviews,
+            merged_reviews = team_review_stats.merged_reviews + EXCLUDED.merged_reviews;
+END;
+$$ LANGUAGE plpgsql;
+
+-- refresh_pr_review_status moves a PR that is ready for review to OPEN while
+-- it has no required reviewers, to APPROVED once all of them approved and to
+-- IN_REVIEW otherwise. Drafts, merged and closed PRs are left alone.
+CREATE FUNCTION refresh_pr_review_status(p_pr_id VARCHAR) RETURNS void AS $$
+BEGIN
+    UPDATE pull_requests pr
+    SET status = s.status
+    FROM (
+        SELECT CASE
+                   WHEN COUNT(*) FILTER (WHERE NOT ra.optional) = 0 THEN 'OPEN'
+                   WHEN COUNT(*) FILTER (WHERE NOT ra.optional AND ra.approved_at IS NULL) = 0 THEN 'APPROVED'
+                   ELSE 'IN_REVIEW'
+               END::pr_status AS status
+        FROM review_assignments ra
+        WHERE ra.pr_id = p_pr_id
+    ) s
+    WHERE pr.pr_id = p_pr_id
+      AND pr.status IN ('OPEN', 'IN_REVIEW', 'APPROVED')
+      AND pr.status <> s.status;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION review_assignments_status_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_pr_review_status(OLD.pr_id);
+        RETURN OLD;
+    END IF;
+    PERFORM refresh_pr_review_status(NEW.pr_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Fires before review_assignments_unassigned (triggers run in name order), so
+-- a PR left without reviewers is OPEN again when its gap is recorded.
+CREATE TRIGGER review_assignments_status
+    AFTER INSERT OR DELETE ON review_assignments
+    FOR EACH ROW EXECUTE FUNCTION review_assignments_status_trigger();
+
+CREATE TRIGGER review_assignments_status_verdict
+    AFTER UPDATE OF approved_at, optional ON review_assignments
+    FOR EACH ROW WHEN (OLD.approved_at IS DISTINCT FROM NEW.approved_at OR OLD.optional IS DISTINCT FROM NEW.optional)
+    EXECUTE FUNCTION review_assignments_status_trigger();
+
+CREATE FUNCTION pull_requests_ready_trigger() RETURNS trigger AS $$
+BEGIN
+    PERFORM refresh_pr_review_status(NEW.pr_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- A draft marked ready may already have reviewers assigned by hand.
+CREATE TRIGGER pull_requests_ready
+    AFTER UPDATE OF status ON pull_requests
+    FOR EACH ROW WHEN (OLD.status = 'DRAFT' AND NEW.status = 'OPEN')
+    EXECUTE FUNCTION pull_requests_ready_trigger();
+
+-- Backfill open PRs from their current reviewers.
+SELECT refresh_pr_review_status(pr_id) FROM pull_requests WHERE status = 'OPEN';
@@ -0,0 +1,24 @@
+-- Weekly reports sent to team leads. The scheduler sends a team's report once
+-- its weekday and hour in timezone have passed since the last one.
+CREATE TABLE team_report_schedules (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    -- 0 is Sunday, like Go's time.Weekday
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    hour SMALLINT NOT NULL CHECK (hour BETWEEN 0 AND 23),
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_sent_at TIMESTAMPTZ
+);
+
+ALTER TABLE notification_preferences
+    ADD COLUMN email TEXT NOT NULL DEFAULT '';
+
+-- HTML version of the message for channels that can show it, such as email;
+-- message stays the plain-text version
+ALTER TABLE notification_outbox
+    ADD COLUMN html TEXT NOT NULL DEFAULT '';
+
+-- SLA breaches are PRs escalated within a week
+CREATE INDEX idx_pr_escalated_at
+    ON pull_requests (LEAST(lead_notified_at, reviewer_escalated_at))
+    WHERE lead_notified_at IS NOT NULL OR reviewer_escalated_at IS NOT NULL;
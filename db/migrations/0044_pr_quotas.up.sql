@@ -0,0 +1,7 @@
+-- Caps how many PRs each member of a team may have open at once (drafts
+-- included). In warn mode PRs over the cap are still created.
+CREATE TABLE team_pr_quotas (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    max_open_prs INTEGER NOT NULL CHECK (max_open_prs > 0),
+    mode VARCHAR(10) NOT NULL DEFAULT 'warn' CHECK (mode IN ('warn', 'block'))
+);
@@ -0,0 +1,14 @@
+-- Team PR templates picked on PR creation: the prefix goes in front of the PR
+-- name, the labels are added to the PR's own and the default reviewers are
+-- preferred when reviewers are picked.
+CREATE TABLE pr_templates (
+    template_id BIGSERIAL PRIMARY KEY,
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    template_name VARCHAR(100) NOT NULL,
+    name_prefix VARCHAR(100) NOT NULL DEFAULT '',
+    labels TEXT[] NOT NULL DEFAULT '{}',
+    default_reviewers TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (team_id, template_name)
+);
@@ -0,0 +1,20 @@
+-- Users in training are not picked as reviewers; instead they shadow the
+-- reviews of a share of their team's new PRs to ramp up. Shadow reviews are
+-- kept apart from review_assignments, so they never count towards approvals,
+-- review load, budgets or review stats. Like reassignments they outlive the
+-- PR's archiving, as the shadow review stats count them.
+ALTER TABLE users
+    ADD COLUMN in_training BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE teams
+    ADD COLUMN shadow_review_percent INTEGER NOT NULL DEFAULT 0
+        CHECK (shadow_review_percent BETWEEN 0 AND 100);
+
+CREATE TABLE shadow_reviews (
+    pr_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pr_id, user_id)
+);
+
+CREATE INDEX idx_shadow_reviews_assigned_at ON shadow_reviews (assigned_at);
@@ -0,0 +1,17 @@
+-- Weekly primary-reviewer rotations. Weeks start on Monday (UTC); the week
+-- that starts start_date belongs to member_ids[1], the next one to
+-- member_ids[2] and so on, wrapping around.
+CREATE TABLE review_rotations (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    start_date DATE NOT NULL CHECK (EXTRACT(ISODOW FROM start_date) = 1),
+    member_ids TEXT[] NOT NULL CHECK (cardinality(member_ids) > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Weeks whose primary reviewer is not the one the rotation says
+CREATE TABLE review_rotation_overrides (
+    team_id INTEGER NOT NULL REFERENCES review_rotations(team_id) ON DELETE CASCADE,
+    week_start DATE NOT NULL CHECK (EXTRACT(ISODOW FROM week_start) = 1),
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, week_start)
+);
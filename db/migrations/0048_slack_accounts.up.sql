@@ -0,0 +1,6 @@
+-- Slack user IDs of service users, used to run the /reviews slash command
+-- on behalf of the user that typed it
+CREATE TABLE slack_accounts (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    slack_user_id VARCHAR(32) NOT NULL UNIQUE
+);
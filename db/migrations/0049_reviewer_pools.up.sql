@@ -0,0 +1,7 @@
+-- Members of a team that reviewers are picked from; a team without rows
+-- picks from all of its members
+CREATE TABLE team_reviewer_pool (
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, user_id)
+);
@@ -0,0 +1,10 @@
+ALTER TABLE reassignments DROP CONSTRAINT reassignments_reason_check;
+ALTER TABLE reassignments ADD CONSTRAINT reassignments_reason_check
+    CHECK (reason IN ('deactivation', 'manual', 'handoff', 'unacked', 'rebalance', 'team_move', 'inactive'));
+
+-- Teams whose reviewers are not swapped out after a period without review
+-- activity; the policy applies to every other team
+CREATE TABLE team_inactive_reassign_opt_outs (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    opted_out_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
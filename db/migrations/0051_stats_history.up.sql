@@ -0,0 +1,27 @@
+-- Daily copies of the review counters, so that trends survive archiving
+-- (which takes archived reviews out of the live counters) and churn. User
+-- rows outlive the user; team_id is the team the user was in on that day.
+CREATE TABLE user_stats_history (
+    snapshot_date DATE NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    team_id INTEGER REFERENCES teams(team_id) ON DELETE SET NULL,
+    total_reviews BIGINT NOT NULL,
+    open_reviews BIGINT NOT NULL,
+    merged_reviews BIGINT NOT NULL,
+    PRIMARY KEY (snapshot_date, user_id)
+);
+
+CREATE INDEX idx_user_stats_history_user_id
+    ON user_stats_history (user_id, snapshot_date);
+
+CREATE TABLE team_stats_history (
+    snapshot_date DATE NOT NULL,
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    active_members INTEGER NOT NULL,
+    open_reviews BIGINT NOT NULL,
+    merged_reviews BIGINT NOT NULL,
+    PRIMARY KEY (snapshot_date, team_id)
+);
+
+CREATE INDEX idx_team_stats_history_team_id
+    ON team_stats_history (team_id, snapshot_date);
@@ -0,0 +1,13 @@
+-- Secrets for repository webhooks posted to the shared /github/webhook
+-- endpoint without the GitHub App. A repository has at most one secret and
+-- its pull requests are only ever created in the secret's team.
+CREATE TABLE github_ingestion_tokens (
+    repository VARCHAR(140) PRIMARY KEY,
+    team_id INTEGER NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_github_ingestion_tokens_team
+    ON github_ingestion_tokens (team_id);
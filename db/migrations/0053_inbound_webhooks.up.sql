@@ -0,0 +1,21 @@
+-- Webhook deliveries received from SCMs with the outcome of processing them,
+-- so that failed ones can be inspected and processed again after a fix.
+-- Only deliveries whose signature checked out are kept.
+CREATE TABLE inbound_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    source VARCHAR(20) NOT NULL,
+    event VARCHAR(100) NOT NULL,
+    delivery_id VARCHAR(100) NOT NULL DEFAULT '',
+    -- Repository whose ingestion token verified the delivery; empty for the
+    -- GitHub App secret
+    ingestion_token VARCHAR(140) NOT NULL DEFAULT '',
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('processed', 'failed')),
+    error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 1,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_inbound_webhooks_received_at
+    ON inbound_webhooks (received_at DESC, id DESC);
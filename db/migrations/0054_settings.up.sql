@@ -0,0 +1,24 @@
+-- Org-wide defaults of reviewer selection and reviews. The table holds at
+-- most one row; until it is saved the server's built-in defaults apply.
+CREATE TABLE org_settings (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    max_reviewers INTEGER NOT NULL CHECK (max_reviewers > 0),
+    selection_strategy VARCHAR(20) NOT NULL CHECK (selection_strategy IN ('random', 'least_loaded')),
+    review_sla_hours INTEGER NOT NULL CHECK (review_sla_hours > 0),
+    max_open_reviews INTEGER NOT NULL CHECK (max_open_reviews >= 0),
+    auto_merge BOOLEAN NOT NULL,
+    shadow_reviews BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Overrides of org_settings for single teams; NULL inherits the org default.
+CREATE TABLE team_settings (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    max_reviewers INTEGER CHECK (max_reviewers > 0),
+    selection_strategy VARCHAR(20) CHECK (selection_strategy IN ('random', 'least_loaded')),
+    review_sla_hours INTEGER CHECK (review_sla_hours > 0),
+    max_open_reviews INTEGER CHECK (max_open_reviews >= 0),
+    auto_merge BOOLEAN,
+    shadow_reviews BOOLEAN,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
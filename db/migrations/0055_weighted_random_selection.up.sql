@@ -0,0 +1,9 @@
+-- Weighted random selection: candidates with fewer open reviews are more
+-- likely to be picked.
+ALTER TABLE org_settings DROP CONSTRAINT org_settings_selection_strategy_check;
+ALTER TABLE org_settings ADD CONSTRAINT org_settings_selection_strategy_check
+    CHECK (selection_strategy IN ('random', 'least_loaded', 'weighted_random'));
+
+ALTER TABLE team_settings DROP CONSTRAINT team_settings_selection_strategy_check;
+ALTER TABLE team_settings ADD CONSTRAINT team_settings_selection_strategy_check
+    CHECK (selection_strategy IN ('random', 'least_loaded', 'weighted_random'));
@@ -0,0 +1,137 @@
+-- name: UpsertGitHubAccount :one
+INSERT INTO github_accounts (user_id, login)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET login = EXCLUDED.login
+RETURNING *;
+
+-- name: ListGitHubAccountsByUserIDs :many
+SELECT * FROM github_accounts
+WHERE user_id = ANY($1::text[]);
+
+-- name: ListGitHubAccountsByLogins :many
+SELECT * FROM github_accounts
+WHERE lower(login) = ANY($1::text[]);
+
+-- name: UpsertGitHubPRLink :one
+INSERT INTO github_pull_requests (pr_id, repository, number)
+VALUES ($1, $2, $3)
+ON CONFLICT (pr_id) DO UPDATE SET repository = EXCLUDED.repository, number = EXCLUDED.number
+RETURNING *;
+
+-- name: GetGitHubPRLink :one
+SELECT * FROM github_pull_requests
+WHERE pr_id = $1;
+
+-- name: GetGitHubPRLinkByNumber :one
+SELECT * FROM github_pull_requests
+WHERE repository = $1 AND number = $2;
+
+-- name: UpsertGitHubInstallation :exec
+INSERT INTO github_installations (installation_id, account_login)
+VALUES ($1, $2)
+ON CONFLICT (installation_id) DO UPDATE SET account_login = EXCLUDED.account_login;
+
+-- name: DeleteGitHubInstallation :exec
+DELETE FROM github_installations
+WHERE installation_id = $1;
+
+-- name: GetGitHubInstallationByAccount :one
+SELECT * FROM github_installations
+WHERE lower(account_login) = lower($1);
+
+-- name: SaveGitHubInstallationToken :exec
+UPDATE github_installations
+SET access_token = $2, token_expires_at = $3
+WHERE installation_id = $1;
+
+-- name: UpsertGitHubRepositories :exec
+INSERT INTO github_repositories (repository, installation_id)
+SELECT unnest(@repositories::text[]), @installation_id::bigint
+ON CONFLICT (repository) DO UPDATE SET installation_id = EXCLUDED.installation_id;
+
+-- name: DeleteGitHubRepositories :exec
+DELETE FROM github_repositories
+WHERE repository = ANY($1::text[]);
+
+-- name: SetGitHubRepositoryTeam :one
+UPDATE github_repositories
+SET team_id = $2
+WHERE repository = $1
+RETURNING *;
+
+-- name: GetGitHubRepository :one
+SELECT r.repository, r.installation_id, r.team_id, COALESCE(t.team_name, '')::text AS team_name
+FROM github_repositories r
+LEFT JOIN teams t ON t.team_id = r.team_id
+WHERE r.repository = $1;
+
+-- name: ListGitHubRepositories :many
+SELECT r.repository, r.installation_id, r.team_id, COALESCE(t.team_name, '')::text AS team_name
+FROM github_repositories r
+LEFT JOIN teams t ON t.team_id = r.team_id
+ORDER BY r.repository;
+
+-- name: ListGitHubTeamLinks :many
+SELECT * FROM github_team_links
+WHERE lower(org) = lower($1);
+
+-- name: UpsertGitHubTeamLink :exec
+INSERT INTO github_team_links (github_team_id, org, slug, team_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (github_team_id) DO UPDATE SET org = EXCLUDED.org, slug = EXCLUDED.slug, team_id = EXCLUDED.team_id;
+
+-- name: CreateGitHubTeamSyncRun :one
+INSERT INTO github_team_sync_runs (org, started_at, finished_at, teams_created, users_created, users_moved, users_deactivated, error)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING run_id;
+
+-- name: CreateGitHubTeamSyncConflicts :exec
+INSERT INTO github_team_sync_conflicts (run_id, position, reason, login, user_id, team_name, detail)
+SELECT @run_id::bigint, i, (@reasons::text[])[i], (@logins::text[])[i], (@user_ids::text[])[i],
+       (@team_names::text[])[i], (@details::text[])[i]
+FROM generate_subscripts(@reasons::text[], 1) AS i;
+
+-- name: PruneGitHubTeamSyncRuns :exec
+DELETE FROM github_team_sync_runs
+WHERE run_id <= @before_run_id::bigint;
+
+-- name: GetLatestGitHubTeamSyncRun :one
+SELECT * FROM github_team_sync_runs
+ORDER BY run_id DESC
+LIMIT 1;
+
+-- name: ListGitHubTeamSyncConflicts :many
+SELECT * FROM github_team_sync_conflicts
+WHERE run_id = $1
+ORDER BY position;
+
+-- name: UpsertGitHubIngestionToken :one
+-- Sets the secret of a repository, unless the repository already has one of
+-- another team, in which case nothing is returned.
+INSERT INTO github_ingestion_tokens (repository, team_id, secret)
+VALUES ($1, $2, $3)
+ON CONFLICT (repository) DO UPDATE
+    SET secret = EXCLUDED.secret, created_at = NOW(), last_used_at = NULL
+    WHERE github_ingestion_tokens.team_id = EXCLUDED.team_id
+RETURNING *;
+
+-- name: GetGitHubIngestionToken :one
+SELECT k.repository, k.team_id, k.secret, k.created_at, k.last_used_at, t.team_name
+FROM github_ingestion_tokens k
+JOIN teams t ON t.team_id = k.team_id
+WHERE k.repository = $1;
+
+-- name: ListGitHubIngestionTokens :many
+SELECT k.repository, k.team_id, k.secret, k.created_at, k.last_used_at, t.team_name
+FROM github_ingestion_tokens k
+JOIN teams t ON t.team_id = k.team_id
+ORDER BY k.repository;
+
+-- name: DeleteGitHubIngestionToken :execrows
+DELETE FROM github_ingestion_tokens
+WHERE repository = $1;
+
+-- name: TouchGitHubIngestionToken :exec
+UPDATE github_ingestion_tokens
+SET last_used_at = NOW()
+WHERE repository = $1;
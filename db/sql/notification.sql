@@ -0,0 +1,84 @@
+-- name: GetNotificationPreferences :one
+SELECT * FROM notification_preferences
+WHERE user_id = $1;
+
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (user_id, channels, muted_events, quiet_hours_start, quiet_hours_end, timezone, webhook_url, digest, email)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (user_id) DO UPDATE SET
+    channels = EXCLUDED.channels,
+    muted_events = EXCLUDED.muted_events,
+    quiet_hours_start = EXCLUDED.quiet_hours_start,
+    quiet_hours_end = EXCLUDED.quiet_hours_end,
+    timezone = EXCLUDED.timezone,
+    webhook_url = EXCLUDED.webhook_url,
+    digest = EXCLUDED.digest,
+    email = EXCLUDED.email
+RETURNING *;
+
+-- name: EnqueueNotification :exec
+INSERT INTO notification_outbox (user_id, event, pr_id, message, deliver_after, html)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ClaimDueNotifications :many
+-- Locks due notifications so that concurrent workers deliver each one once.
+SELECT * FROM notification_outbox
+WHERE sent_at IS NULL AND dead_lettered_at IS NULL AND deliver_after <= NOW()
+ORDER BY deliver_after, id
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkNotificationSent :exec
+UPDATE notification_outbox
+SET sent_at = NOW(), attempts = attempts + 1
+WHERE id = $1;
+
+-- name: MarkNotificationFailed :exec
+UPDATE notification_outbox
+SET attempts = attempts + 1, last_error = $2, deliver_after = $3
+WHERE id = $1;
+
+-- name: MarkNotificationDeadLettered :exec
+-- Records the last failed attempt and gives up on the notification.
+UPDATE notification_outbox
+SET attempts = attempts + 1, last_error = $2, dead_lettered_at = NOW()
+WHERE id = $1;
+
+-- name: ListDeadLetteredNotifications :many
+-- Most recently abandoned first. Empty filters match all notifications.
+SELECT * FROM notification_outbox
+WHERE dead_lettered_at IS NOT NULL
+  AND (@user_id::text = '' OR user_id = @user_id::text)
+  AND (@event::text = '' OR event = @event::text)
+ORDER BY dead_lettered_at DESC, id DESC
+LIMIT @result_limit OFFSET @result_offset;
+
+-- name: ClaimDueDigests :many
+-- Picks active users whose last digest is older than their digest period.
+SELECT np.*
+FROM notification_preferences np
+JOIN users u ON u.user_id = np.user_id
+WHERE u.is_active
+  AND np.digest <> 'off'
+  AND (np.last_digest_at IS NULL
+       OR np.last_digest_at <= NOW() - CASE np.digest WHEN 'weekly' THEN INTERVAL '7 days' ELSE INTERVAL '1 day' END)
+ORDER BY np.user_id
+LIMIT @batch_size
+FOR UPDATE OF np SKIP LOCKED;
+
+-- name: MarkDigestSent :exec
+UPDATE notification_preferences
+SET last_digest_at = NOW()
+WHERE user_id = $1;
+
+-- name: ReplayNotifications :execrows
+-- Queues copies of the matching notifications that were delivered or given up
+-- on. Pending notifications and earlier replays are skipped.
+INSERT INTO notification_outbox (user_id, event, pr_id, message, replay_of, html)
+SELECT o.user_id, o.event, o.pr_id, o.message, o.id, o.html
+FROM notification_outbox o
+WHERE o.replay_of IS NULL
+  AND (o.sent_at IS NOT NULL OR o.dead_lettered_at IS NOT NULL)
+  AND o.created_at >= @since::timestamptz AND o.created_at < @until::timestamptz
+  AND (@event::text = '' OR o.event = @event::text)
+  AND (@user_id::text = '' OR o.user_id = @user_id::text);
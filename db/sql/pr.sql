@@ -1,20 +1,48 @@
 -- name: CreatePR :one
-INSERT INTO pull_requests (pr_id, pr_name, author_id)
-VALUES ($1, $2, $3)
+INSERT INTO pull_requests (pr_id, pr_name, author_id, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
 
 -- name: GetPRByID :one
 SELECT * FROM pull_requests
 WHERE pr_id = $1;
 
+-- name: GetPRWithReviewers :one
+-- The PR with its reviewers (and their verdicts), shadow reviewers and
+-- checklist as JSON arrays, in one round trip.
+SELECT sqlc.embed(pr),
+       COALESCE((SELECT json_agg(json_build_object(
+                    'user_id', u.user_id, 'username', u.username, 'optional', ra.optional,
+                    'approved_at', ra.approved_at, 'changes_requested_at', ra.changes_requested_at))
+                 FROM review_assignments ra
+                 JOIN users u ON u.user_id = ra.user_id
+                 WHERE ra.pr_id = pr.pr_id), '[]')::jsonb AS reviewers,
+       COALESCE((SELECT json_agg(sr.user_id ORDER BY sr.user_id)
+                 FROM shadow_reviews sr WHERE sr.pr_id = pr.pr_id), '[]')::jsonb AS shadow_reviewers,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'position', c.position, 'label', c.label, 'checked', c.checked,
+                    'checked_by', c.checked_by, 'checked_at', c.checked_at) ORDER BY c.position)
+                 FROM pr_checklist_items c WHERE c.pr_id = pr.pr_id), '[]')::jsonb AS checklist
+FROM pull_requests pr
+WHERE pr.pr_id = $1;
+
+-- name: GetPRByExternalID :one
+SELECT * FROM pull_requests
+WHERE external_id = $1;
+
 -- name: ListPRs :many
-SELECT * FROM pull_requests;
+SELECT * FROM pull_requests
+ORDER BY created_at, pr_id;
 
 -- name: CountPRs :one
 SELECT count(*) FROM pull_requests;
 
 -- name: AddReviewerToPR :exec
-INSERT INTO review_assignments (pr_id, user_id)
+INSERT INTO review_assignments (pr_id, user_id, optional)
+VALUES ($1, $2, $3);
+
+-- name: AddShadowReviewer :exec
+INSERT INTO shadow_reviews (pr_id, user_id)
 VALUES ($1, $2);
 
 -- name: GetReviewersForPR :many
@@ -23,49 +51,301 @@ FROM users u
 JOIN review_assignments ra ON u.user_id = ra.user_id
 WHERE ra.pr_id = $1;
 
+-- name: GetRequiredReviewersForPR :many
+SELECT u.*
+FROM users u
+JOIN review_assignments ra ON u.user_id = ra.user_id
+WHERE ra.pr_id = $1 AND NOT ra.optional;
+
 -- name: MergePR :one
 UPDATE pull_requests
 SET status = 'MERGED',
-    merged_at = NOW()
-WHERE pr_id = $1
+    merged_at = NOW(),
+    merged_by = NULLIF(@merged_by::varchar, '')
+WHERE pr_id = @pr_id
 RETURNING *;
 
--- name: FindReplacementCandidates :many
-SELECT u.*
-FROM users u
-WHERE u.team_id = $1
-  AND u.is_active = true
-  AND u.user_id != $2       -- Not author
-  AND u.user_id != ALL($3::varchar[]) -- Not those in arr
-ORDER BY random()
-LIMIT $4;
-
--- name: RemoveReviewerFromPR :exec
+-- name: MarkPRReady :one
+-- Moves a draft to OPEN; the pull_requests_ready trigger then moves it on
+-- by the reviewers it already has.
+UPDATE pull_requests
+SET status = 'OPEN'
+WHERE pr_id = $1 AND status = 'DRAFT'
+RETURNING *;
+
+-- name: ClosePR :one
+UPDATE pull_requests
+SET status = 'CLOSED',
+    closed_at = NOW()
+WHERE pr_id = $1 AND status NOT IN ('MERGED', 'CLOSED')
+RETURNING *;
+
+-- name: ListPRTimeline :many
+-- Lifecycle events of a PR, oldest first. Only the current reviewers' latest
+-- verdicts are stored, so replaced reviewers show up through reassignments.
+SELECT e.event_type::varchar AS event_type, e.occurred_at::timestamptz AS occurred_at,
+       COALESCE(e.user_id, '')::varchar AS user_id, COALESCE(e.replaced_by, '')::varchar AS replaced_by,
+       COALESCE(e.reason, '')::varchar AS reason, COALESCE(e.decline_reason, '')::varchar AS decline_reason,
+       COALESCE(e.actor, '')::varchar AS actor
+FROM (
+    SELECT 0 AS rank, 'created' AS event_type, pr.created_at AS occurred_at, pr.author_id AS user_id,
+           NULL::varchar AS replaced_by, NULL::varchar AS reason, NULL::varchar AS decline_reason,
+           NULL::varchar AS actor
+    FROM pull_requests pr WHERE pr.pr_id = @pr_id
+    UNION ALL
+    SELECT 1, 'assigned', ra.assigned_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = @pr_id
+    UNION ALL
+    SELECT 1, 'reassigned', r.reassigned_at, r.from_user_id, r.to_user_id, r.reason, r.decline_reason, r.reassigned_by
+    FROM reassignments r WHERE r.pr_id = @pr_id
+    UNION ALL
+    SELECT 2, 'acked', ra.acked_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = @pr_id AND ra.acked_at IS NOT NULL
+    UNION ALL
+    SELECT 3, 'changes_requested', ra.changes_requested_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = @pr_id AND ra.changes_requested_at IS NOT NULL
+    UNION ALL
+    SELECT 3, 'approved', ra.approved_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = @pr_id AND ra.approved_at IS NOT NULL
+    UNION ALL
+    SELECT 4, 'merged', pr.merged_at, COALESCE(pr.merged_by, pr.author_id), NULL, NULL, NULL, pr.merged_by
+    FROM pull_requests pr WHERE pr.pr_id = @pr_id AND pr.merged_at IS NOT NULL
+    UNION ALL
+    SELECT 4, 'closed', pr.closed_at, pr.author_id, NULL, NULL, NULL, NULL
+    FROM pull_requests pr WHERE pr.pr_id = @pr_id AND pr.closed_at IS NOT NULL
+) e
+ORDER BY e.occurred_at, e.rank, e.user_id;
+
+-- name: GetRecentReviewersOfAuthor :many
+SELECT DISTINCT ra.user_id
+FROM review_assignments ra
+WHERE ra.pr_id IN (
+    SELECT pr.pr_id
+    FROM pull_requests pr
+    WHERE pr.author_id = @author_id
+      AND pr.pr_id <> @exclude_pr_id
+    ORDER BY pr.created_at DESC, pr.pr_id DESC
+    LIMIT @pr_count
+);
+
+-- name: RemoveReviewerFromPR :one
 DELETE FROM review_assignments
-WHERE pr_id = $1 AND user_id = $2;
+WHERE pr_id = $1 AND user_id = $2
+RETURNING optional;
+
+-- name: RemoveOpenReviewsByUsers :many
+DELETE FROM review_assignments ra
+USING pull_requests pr
+WHERE ra.pr_id = pr.pr_id AND pr.status NOT IN ('MERGED', 'CLOSED') AND ra.user_id = ANY(@user_ids::varchar[])
+RETURNING ra.pr_id, ra.user_id;
 
 -- name: RemoveAllReviewersFromPR :exec
 DELETE FROM review_assignments
 WHERE pr_id = $1;
 
 -- name: GetPRsForReviewer :many
-SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.required_skills, pr.priority, pr.repository_name, pr.labels
 FROM pull_requests pr
 JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-WHERE ra.user_id = $1;
+WHERE ra.user_id = $1
+ORDER BY pr.status, pr.priority DESC, pr.created_at, pr.pr_id;
 
 -- name: GetOpenReviewsForUsers :many
 SELECT ra.pr_id, ra.user_id, pr.author_id
 FROM review_assignments ra
 JOIN pull_requests pr ON ra.pr_id = pr.pr_id
-WHERE pr.status = 'OPEN'
-  AND ra.user_id = ANY($1::text[]);
+WHERE pr.status NOT IN ('MERGED', 'CLOSED')
+  AND ra.user_id = ANY($1::text[])
+ORDER BY ra.pr_id, ra.user_id;
 
 -- name: GetReviewStats :many
-SELECT user_id, COUNT(*) AS review_count
-FROM review_assignments
-GROUP BY user_id
-ORDER BY review_count DESC;
+-- Ack latency covers archived assignments too; those archived before
+-- assignment times were recorded are skipped. Users with more reviews come
+-- first unless order_by is 'username'; ties go by username, then user ID, so
+-- pages are stable. A zero limit returns every user.
+SELECT s.user_id, u.username, s.total_reviews AS review_count,
+       COALESCE(a.acked_count, 0)::bigint AS acked_count,
+       COALESCE(a.avg_ack_seconds, 0)::double precision AS avg_ack_seconds
+FROM user_review_stats s
+JOIN users u ON u.user_id = s.user_id
+LEFT JOIN (
+    SELECT acks.user_id, COUNT(*) AS acked_count,
+           AVG(EXTRACT(EPOCH FROM acks.acked_at - acks.assigned_at)) AS avg_ack_seconds
+    FROM (
+        SELECT user_id, assigned_at, acked_at FROM review_assignments
+        UNION ALL
+        SELECT user_id, assigned_at, acked_at FROM review_assignments_archive
+    ) acks
+    WHERE acks.acked_at IS NOT NULL AND acks.assigned_at IS NOT NULL
+    GROUP BY acks.user_id
+) a ON a.user_id = s.user_id
+WHERE s.total_reviews > 0
+ORDER BY CASE WHEN @order_by::text = 'username' THEN NULL ELSE s.total_reviews END DESC NULLS LAST,
+         u.username, s.user_id
+LIMIT NULLIF(@result_limit::int, 0) OFFSET @result_offset::int;
+
+-- name: CountReviewStats :one
+SELECT COUNT(*) FROM user_review_stats
+WHERE total_reviews > 0;
+
+-- name: ListMemberReviewCounts :many
+-- Reviews assigned within [since, until) to each active member of the active
+-- teams, archived assignments included. Members without reviews count as 0.
+SELECT t.team_name, u.user_id, COUNT(a.user_id)::bigint AS review_count
+FROM users u
+JOIN teams t ON t.team_id = u.team_id
+LEFT JOIN (
+    SELECT user_id FROM review_assignments
+    WHERE assigned_at >= @since::timestamptz AND assigned_at < @until::timestamptz
+    UNION ALL
+    SELECT user_id FROM review_assignments_archive
+    WHERE assigned_at >= @since::timestamptz AND assigned_at < @until::timestamptz
+) a ON a.user_id = u.user_id
+WHERE u.is_active AND t.is_active
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+GROUP BY t.team_name, u.user_id
+ORDER BY t.team_name, u.user_id;
+
+-- name: RecordReassignment :exec
+WITH logged AS (
+    INSERT INTO reassignments (pr_id, team_id, from_user_id, to_user_id, reason, decline_reason, reassigned_by)
+    SELECT @pr_id, u.team_id, u.user_id, NULLIF(@to_user_id::varchar, ''), @reason,
+           NULLIF(@decline_reason::varchar, ''), NULLIF(@reassigned_by::varchar, '')
+    FROM users u
+    WHERE u.user_id = @from_user_id
+    RETURNING pr_id, reassigned_by
+)
+UPDATE pull_requests pr
+SET reassigned_by = logged.reassigned_by
+FROM logged
+WHERE pr.pr_id = logged.pr_id;
+
+-- name: RecordReassignments :exec
+WITH logged AS (
+    INSERT INTO reassignments (pr_id, team_id, from_user_id, to_user_id, reason, reassigned_by)
+    SELECT m.pr_id, u.team_id, u.user_id, NULLIF(m.to_user_id, ''), @reason, NULLIF(@reassigned_by::varchar, '')
+    FROM (
+        SELECT unnest(@pr_ids::varchar[]) AS pr_id,
+               unnest(@from_user_ids::varchar[]) AS from_user_id,
+               unnest(@to_user_ids::varchar[]) AS to_user_id
+    ) m
+    JOIN users u ON u.user_id = m.from_user_id
+    RETURNING pr_id, reassigned_by
+)
+UPDATE pull_requests pr
+SET reassigned_by = logged.reassigned_by
+FROM (SELECT DISTINCT pr_id, reassigned_by FROM logged) logged
+WHERE pr.pr_id = logged.pr_id;
+
+-- name: ListMergeCounts :many
+-- PRs merged within [since, until) per recorded merger, archived PRs included;
+-- merged_by is '' for merges nobody was recorded for.
+SELECT COALESCE(m.merged_by, '')::varchar AS merged_by, COALESCE(u.username, '')::varchar AS username, COUNT(*)::bigint AS merge_count
+FROM (
+    SELECT merged_by, merged_at FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT merged_by, merged_at FROM pull_requests_archive WHERE status = 'MERGED'
+) m
+LEFT JOIN users u ON u.user_id = m.merged_by
+WHERE m.merged_at >= @since::timestamptz AND m.merged_at < @until::timestamptz
+GROUP BY m.merged_by, u.username
+ORDER BY merge_count DESC, merged_by;
+
+-- name: ListShadowReviewCounts :many
+-- Shadow reviews assigned within [since, until) to each user of the active
+-- teams who is in training or had any in that period.
+SELECT t.team_name, u.user_id, u.username, u.in_training, COUNT(sr.user_id)::bigint AS shadow_review_count
+FROM users u
+JOIN teams t ON t.team_id = u.team_id
+LEFT JOIN shadow_reviews sr ON sr.user_id = u.user_id
+    AND sr.assigned_at >= @since::timestamptz AND sr.assigned_at < @until::timestamptz
+WHERE t.is_active
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+GROUP BY t.team_name, u.user_id, u.username, u.in_training
+HAVING u.in_training OR COUNT(sr.user_id) > 0
+ORDER BY t.team_name, shadow_review_count DESC, u.user_id;
+
+-- name: ListTeamMergedPRs :many
+-- PRs by the team's members merged within [since, until), archived PRs
+-- included.
+SELECT p.pr_id, p.pr_name, p.author_id, p.created_at, p.merged_at::timestamptz AS merged_at
+FROM (
+    SELECT pr_id, pr_name, author_id, created_at, merged_at FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT pr_id, pr_name, author_id, created_at, merged_at FROM pull_requests_archive WHERE status = 'MERGED'
+) p
+JOIN users u ON u.user_id = p.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE t.team_name = @team_name::text
+  AND p.merged_at >= @since::timestamptz AND p.merged_at < @until::timestamptz
+ORDER BY p.merged_at, p.pr_id;
+
+-- name: ListTeamSLABreaches :many
+-- PRs by the team's members first escalated within [since, until).
+SELECT p.pr_id, p.pr_name, p.author_id, p.created_at,
+       LEAST(p.lead_notified_at, p.reviewer_escalated_at)::timestamptz AS escalated_at
+FROM pull_requests p
+JOIN users u ON u.user_id = p.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE t.team_name = @team_name::text
+  AND LEAST(p.lead_notified_at, p.reviewer_escalated_at) >= @since::timestamptz
+  AND LEAST(p.lead_notified_at, p.reviewer_escalated_at) < @until::timestamptz
+ORDER BY escalated_at, p.pr_id;
+
+-- name: ListThroughputCounts :many
+-- Events of the metrics within [since, until) per UTC bucket of the unit
+-- ('hour', 'day' or 'week'), archived PRs included. PR events count for the
+-- author's team and review events for the reviewer's team.
+SELECT e.metric, date_trunc(@unit::text, e.at, 'UTC')::timestamptz AS bucket, COUNT(*)::bigint AS event_count
+FROM (
+    SELECT 'prs_created'::text AS metric, created_at AS at, author_id AS user_id FROM pull_requests
+    UNION ALL
+    SELECT 'prs_created', created_at, author_id FROM pull_requests_archive
+    UNION ALL
+    SELECT 'prs_merged', merged_at, author_id FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT 'prs_merged', merged_at, author_id FROM pull_requests_archive WHERE status = 'MERGED'
+    UNION ALL
+    SELECT 'reviews_assigned', assigned_at, user_id FROM review_assignments
+    UNION ALL
+    SELECT 'reviews_assigned', assigned_at, user_id FROM review_assignments_archive
+    UNION ALL
+    SELECT 'reviews_completed', reviewed_at, user_id FROM review_assignments
+    UNION ALL
+    SELECT 'reviews_completed', reviewed_at, user_id FROM review_assignments_archive
+) e
+JOIN users u ON u.user_id = e.user_id
+LEFT JOIN teams t ON t.team_id = u.team_id
+WHERE e.metric = ANY(@metrics::text[])
+  AND e.at >= @since::timestamptz AND e.at < @until::timestamptz
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+GROUP BY e.metric, bucket
+ORDER BY e.metric, bucket;
+
+-- name: ListReassignmentCounts :many
+-- Reassignments within [since, until) per team, removed reviewer, reason and
+-- decline reason ('' when none was given).
+SELECT t.team_name, COALESCE(r.from_user_id, '')::varchar AS user_id, r.reason,
+       COALESCE(r.decline_reason, '')::varchar AS decline_reason, COUNT(*)::bigint AS reassignment_count
+FROM reassignments r
+JOIN teams t ON t.team_id = r.team_id
+WHERE r.reassigned_at >= @since::timestamptz AND r.reassigned_at < @until::timestamptz
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+GROUP BY t.team_name, r.from_user_id, r.reason, r.decline_reason
+ORDER BY t.team_name, user_id, r.reason, decline_reason;
+
+-- name: ListUnassignedPRCounts :many
+-- Open PRs without reviewers at any moment of each UTC day in [since, until],
+-- per team; days without such PRs are omitted.
+SELECT t.team_name, d.day::date AS day, COUNT(DISTINCT p.pr_id)::bigint AS pr_count
+FROM generate_series(@since::date, @until::date, interval '1 day') AS d(day)
+JOIN unassigned_pr_periods p
+  ON p.started_at < (d.day + interval '1 day') AT TIME ZONE 'UTC'
+ AND (p.ended_at IS NULL OR p.ended_at > d.day AT TIME ZONE 'UTC')
+JOIN teams t ON t.team_id = p.team_id
+WHERE @team_name::text = '' OR t.team_name = @team_name::text
+GROUP BY t.team_name, d.day
+ORDER BY t.team_name, d.day;
 
 -- name: GetAuthorTeamByPR :one
 SELECT t.*
@@ -82,28 +362,375 @@ WHERE pr.status = 'OPEN'
 GROUP BY pr.pr_id
 HAVING COUNT(ra.user_id) = 0;
 
--- name: CountOpenReviewsByTeam :one
-SELECT COUNT(pr.pr_id)
+-- name: GetOpenPRsWithoutReviewersByIDs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.required_skills, pr.priority, pr.repository_name, pr.labels
 FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-JOIN users u ON ra.user_id = u.user_id
-WHERE u.team_id = $1 AND pr.status = 'OPEN';
+WHERE pr.pr_id = ANY(@pr_ids::varchar[]) AND pr.status = 'OPEN'
+  AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id)
+ORDER BY pr.priority DESC, pr.created_at, pr.pr_id;
+
+-- name: CountOpenReviewsByTeam :one
+SELECT COALESCE((SELECT open_reviews FROM team_review_stats WHERE team_id = $1), 0)::bigint;
+
+-- name: ListOpenReviewCounts :many
+SELECT user_id, open_reviews
+FROM user_review_stats
+WHERE user_id = ANY(@user_ids::varchar[]);
+
+-- name: ListOpenAuthoredCounts :many
+SELECT author_id, COUNT(*)::bigint AS open_prs
+FROM pull_requests
+WHERE author_id = ANY(@user_ids::varchar[]) AND status NOT IN ('MERGED', 'CLOSED')
+GROUP BY author_id;
 
 -- name: CountOpenReviewsByUser :one
-SELECT COUNT(pr.pr_id)
-FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-WHERE ra.user_id = $1 AND pr.status = 'OPEN';
+SELECT COALESCE((SELECT open_reviews FROM user_review_stats WHERE user_id = $1), 0)::bigint;
+
+-- name: CountOpenPRsByAuthor :one
+-- PRs of the author that are neither merged nor closed, drafts included.
+SELECT COUNT(*)::int FROM pull_requests
+WHERE author_id = $1 AND status NOT IN ('MERGED', 'CLOSED');
 
 -- name: CountMergedReviewsByTeam :one
-SELECT COUNT(pr.pr_id)
-FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-JOIN users u ON ra.user_id = u.user_id
-WHERE u.team_id = $1 AND pr.status = 'MERGED';
+SELECT COALESCE((SELECT merged_reviews FROM team_review_stats WHERE team_id = $1), 0)::bigint;
 
 -- name: CountMergedReviewsByUser :one
-SELECT COUNT(pr.pr_id)
+SELECT COALESCE((SELECT merged_reviews FROM user_review_stats WHERE user_id = $1), 0)::bigint;
+
+-- name: GetRepositoryPRStats :one
+-- PR counts, reviewers per PR and time to merge of a repository, archived PRs
+-- included. The merge durations are 0 when no PR was merged.
+SELECT
+    COUNT(*) FILTER (WHERE p.status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED')::bigint AS merged_count,
+    COALESCE(AVG(p.reviewer_count), 0)::float8 AS avg_reviewers,
+    COALESCE(AVG(EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS avg_merge_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS median_merge_seconds
+FROM (
+    SELECT pr.status, pr.created_at, pr.merged_at,
+           (SELECT COUNT(*) FROM review_assignments ra WHERE ra.pr_id = pr.pr_id) AS reviewer_count
+    FROM pull_requests pr
+    WHERE pr.repository_name = @repository_name
+    UNION ALL
+    SELECT pa.status, pa.created_at, pa.merged_at,
+           (SELECT COUNT(*) FROM review_assignments_archive raa WHERE raa.pr_id = pa.pr_id) AS reviewer_count
+    FROM pull_requests_archive pa
+    WHERE pa.repository_name = @repository_name
+) p;
+
+-- name: GetReviewerTurnaround :one
+-- Time from assignment to the first verdict of the user's reviews assigned
+-- within [since, until), archived assignments included. Pending counts reviews
+-- of open PRs still waiting for a verdict.
+SELECT
+    COUNT(*) FILTER (WHERE a.reviewed_at IS NOT NULL)::bigint AS reviewed_count,
+    COUNT(*) FILTER (WHERE a.reviewed_at IS NULL AND a.open)::bigint AS pending_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM a.reviewed_at - a.assigned_at)), 0)::float8 AS avg_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM a.reviewed_at - a.assigned_at)), 0)::float8 AS median_seconds
+FROM (
+    SELECT ra.assigned_at, ra.reviewed_at, pr.status NOT IN ('MERGED', 'CLOSED') AS open
+    FROM review_assignments ra
+    JOIN pull_requests pr ON pr.pr_id = ra.pr_id
+    WHERE ra.user_id = @user_id
+      AND ra.assigned_at >= @since::timestamptz AND ra.assigned_at < @until::timestamptz
+    UNION ALL
+    SELECT raa.assigned_at, raa.reviewed_at, false
+    FROM review_assignments_archive raa
+    WHERE raa.user_id = @user_id
+      AND raa.assigned_at >= @since::timestamptz AND raa.assigned_at < @until::timestamptz
+) a;
+
+-- name: GetAuthorPRStats :one
+-- PR counts, reviewers per PR and time to merge of the PRs the user opened,
+-- archived PRs included. The merge durations are 0 when no PR was merged.
+SELECT
+    COUNT(*)::bigint AS opened_count,
+    COUNT(*) FILTER (WHERE p.status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED')::bigint AS merged_count,
+    COALESCE(AVG(p.reviewer_count), 0)::float8 AS avg_reviewers,
+    COALESCE(AVG(EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS avg_merge_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS median_merge_seconds
+FROM (
+    SELECT pr.status, pr.created_at, pr.merged_at,
+           (SELECT COUNT(*) FROM review_assignments ra WHERE ra.pr_id = pr.pr_id) AS reviewer_count
+    FROM pull_requests pr
+    WHERE pr.author_id = @author_id
+    UNION ALL
+    SELECT pa.status, pa.created_at, pa.merged_at,
+           (SELECT COUNT(*) FROM review_assignments_archive raa WHERE raa.pr_id = pa.pr_id) AS reviewer_count
+    FROM pull_requests_archive pa
+    WHERE pa.author_id = @author_id
+) p;
+
+-- name: GetOrgSummary :one
+-- Org-wide top line: open PRs (drafts included), open PRs without reviewers,
+-- active users of active teams and the PRs merged within [since, until) with
+-- their average time from creation to merge, archived PRs included.
+SELECT
+    (SELECT COUNT(*) FROM pull_requests WHERE status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    (SELECT COUNT(*) FROM pull_requests pr
+     WHERE pr.status = 'OPEN' AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id))::bigint AS unassigned_count,
+    (SELECT COUNT(*) FROM users u JOIN teams t ON t.team_id = u.team_id WHERE u.is_active AND t.is_active)::bigint AS active_users,
+    COUNT(*)::bigint AS merged_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM m.merged_at - m.created_at)), 0)::float8 AS avg_merge_seconds
+FROM (
+    SELECT pr.created_at, pr.merged_at
+    FROM pull_requests pr
+    WHERE pr.status = 'MERGED' AND pr.merged_at >= @since::timestamptz AND pr.merged_at < @until::timestamptz
+    UNION ALL
+    SELECT pa.created_at, pa.merged_at
+    FROM pull_requests_archive pa
+    WHERE pa.status = 'MERGED' AND pa.merged_at >= @since::timestamptz AND pa.merged_at < @until::timestamptz
+) m;
+
+-- name: ImportPR :one
+INSERT INTO pull_requests (pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, closed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: ListReviewAssignments :many
+SELECT * FROM review_assignments
+ORDER BY pr_id, user_id;
+
+-- name: ListMergedPRIDsBefore :many
+SELECT pr_id
+FROM pull_requests
+WHERE status = 'MERGED' AND merged_at < $1
+ORDER BY merged_at, pr_id
+LIMIT $2;
+
+-- name: CopyPRsToArchive :execrows
+INSERT INTO pull_requests_archive (pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by)
+SELECT pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by
+FROM pull_requests
+WHERE pr_id = ANY($1::text[]);
+
+-- name: CopyReviewAssignmentsToArchive :exec
+INSERT INTO review_assignments_archive (pr_id, user_id, approved_at, assigned_at, acked_at, changes_requested_at, reviewed_at, optional)
+SELECT pr_id, user_id, approved_at, assigned_at, acked_at, changes_requested_at, reviewed_at, optional
+FROM review_assignments
+WHERE pr_id = ANY($1::text[]);
+
+-- name: DeletePRs :execrows
+DELETE FROM pull_requests
+WHERE pr_id = ANY($1::text[]);
+
+-- name: IsPRArchived :one
+SELECT EXISTS (SELECT 1 FROM pull_requests_archive WHERE pr_id = $1);
+
+-- name: SearchPRs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.description,
+       ts_rank(setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'),
+               websearch_to_tsquery('simple', @query::text))::real AS rank
 FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-WHERE ra.user_id = $1 AND pr.status = 'MERGED';
+WHERE (setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'))
+      @@ websearch_to_tsquery('simple', @query::text)
+ORDER BY rank DESC, pr.created_at DESC, pr.pr_id
+LIMIT @result_limit OFFSET @result_offset;
+
+-- name: CountSearchPRs :one
+SELECT COUNT(*)
+FROM pull_requests pr
+WHERE (setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'))
+      @@ websearch_to_tsquery('simple', @query::text);
+
+-- name: FilterPRs :many
+-- PRs matching every set filter, open first, then more urgent and older ones.
+-- Empty filters match any PR. Unreviewed keeps PRs without a verdict from the
+-- reviewer, or from any reviewer when no reviewer is given.
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.priority
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE (@status::text = '' OR pr.status::text = @status::text)
+  AND (@author_id::text = '' OR pr.author_id = @author_id::text)
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+  AND (@repository_name::text = '' OR pr.repository_name = @repository_name::text)
+  AND (@priority::text = '' OR pr.priority::text = @priority::text)
+  AND (@label::text = '' OR @label::text = ANY(pr.labels))
+  AND (@min_age_hours::int = 0 OR pr.created_at <= NOW() - make_interval(hours => @min_age_hours::int))
+  AND (@reviewer_id::text = '' OR EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND ra.user_id = @reviewer_id::text
+          AND (NOT @unreviewed::bool OR (ra.approved_at IS NULL AND ra.changes_requested_at IS NULL))))
+  AND (NOT @unreviewed::bool OR @reviewer_id::text <> '' OR NOT EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND (ra.approved_at IS NOT NULL OR ra.changes_requested_at IS NOT NULL)))
+ORDER BY pr.status, pr.priority DESC, pr.created_at, pr.pr_id
+LIMIT @result_limit OFFSET @result_offset;
+
+-- name: CountFilteredPRs :one
+SELECT COUNT(*)
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE (@status::text = '' OR pr.status::text = @status::text)
+  AND (@author_id::text = '' OR pr.author_id = @author_id::text)
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+  AND (@repository_name::text = '' OR pr.repository_name = @repository_name::text)
+  AND (@priority::text = '' OR pr.priority::text = @priority::text)
+  AND (@label::text = '' OR @label::text = ANY(pr.labels))
+  AND (@min_age_hours::int = 0 OR pr.created_at <= NOW() - make_interval(hours => @min_age_hours::int))
+  AND (@reviewer_id::text = '' OR EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND ra.user_id = @reviewer_id::text
+          AND (NOT @unreviewed::bool OR (ra.approved_at IS NULL AND ra.changes_requested_at IS NULL))))
+  AND (NOT @unreviewed::bool OR @reviewer_id::text <> '' OR NOT EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND (ra.approved_at IS NOT NULL OR ra.changes_requested_at IS NOT NULL)));
+
+-- name: LockPR :one
+SELECT pr_id FROM pull_requests WHERE pr_id = $1 FOR UPDATE;
+
+-- name: ApproveReview :execrows
+-- An approval settles the reviewer's earlier request for changes.
+UPDATE review_assignments
+SET approved_at = COALESCE(approved_at, NOW()),
+    changes_requested_at = NULL,
+    reviewed_at = COALESCE(reviewed_at, NOW()),
+    acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2;
+
+-- name: RequestChanges :execrows
+-- Requesting changes withdraws the reviewer's approval.
+UPDATE review_assignments
+SET changes_requested_at = COALESCE(changes_requested_at, NOW()),
+    approved_at = NULL,
+    reviewed_at = COALESCE(reviewed_at, NOW()),
+    acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2;
+
+-- name: GetApprovalState :one
+-- Optional reviewers do not count.
+SELECT COUNT(*) FILTER (WHERE approved_at IS NOT NULL) AS approved,
+       COUNT(*) AS total
+FROM review_assignments
+WHERE pr_id = $1 AND NOT optional;
+
+-- name: ListApprovedReviewers :many
+SELECT user_id
+FROM review_assignments
+WHERE pr_id = $1 AND approved_at IS NOT NULL
+ORDER BY approved_at, user_id;
+
+-- name: ListChangesRequestedReviewers :many
+SELECT user_id
+FROM review_assignments
+WHERE pr_id = $1 AND changes_requested_at IS NOT NULL
+ORDER BY changes_requested_at, user_id;
+
+-- name: SetPRAutoMerge :one
+UPDATE pull_requests
+SET auto_merge = $2
+WHERE pr_id = $1
+RETURNING *;
+
+-- name: ListPendingReviews :many
+SELECT pr.pr_id, pr.pr_name, pr.priority, ra.assigned_at
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+WHERE ra.user_id = $1 AND pr.status NOT IN ('MERGED', 'CLOSED') AND ra.approved_at IS NULL
+ORDER BY pr.priority DESC, ra.assigned_at, pr.pr_id;
+
+-- name: ListStalledPRs :many
+-- PRs in review without any approval whose oldest assignment has outlived
+-- one of the author's team escalation steps that was not taken yet. Delays
+-- are scaled by priority: a quarter for urgent PRs, double for low priority
+-- ones. Only required reviewers are considered.
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.priority, t.team_id, t.lead_user_id,
+       t.escalation_notify_after_hours, t.escalation_add_reviewer_after_hours,
+       pr.lead_notified_at, pr.reviewer_escalated_at,
+       MIN(ra.assigned_at)::timestamptz AS waiting_since
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+JOIN review_assignments ra ON ra.pr_id = pr.pr_id AND NOT ra.optional
+WHERE pr.status = 'IN_REVIEW'
+  AND (t.escalation_notify_after_hours > 0 OR t.escalation_add_reviewer_after_hours > 0)
+  AND (pr.lead_notified_at IS NULL OR pr.reviewer_escalated_at IS NULL)
+GROUP BY pr.pr_id, t.team_id
+HAVING COUNT(ra.approved_at) = 0
+   AND ((t.escalation_notify_after_hours > 0 AND pr.lead_notified_at IS NULL
+         AND MIN(ra.assigned_at) <= NOW() - make_interval(hours => t.escalation_notify_after_hours) * priority_delay_factor(pr.priority))
+     OR (t.escalation_add_reviewer_after_hours > 0 AND pr.reviewer_escalated_at IS NULL
+         AND MIN(ra.assigned_at) <= NOW() - make_interval(hours => t.escalation_add_reviewer_after_hours) * priority_delay_factor(pr.priority)))
+ORDER BY waiting_since
+LIMIT $1;
+
+-- name: MarkLeadNotified :execrows
+UPDATE pull_requests
+SET lead_notified_at = NOW()
+WHERE pr_id = $1 AND lead_notified_at IS NULL;
+
+-- name: MarkReviewerEscalated :execrows
+UPDATE pull_requests
+SET reviewer_escalated_at = NOW()
+WHERE pr_id = $1 AND reviewer_escalated_at IS NULL;
+
+-- name: SetPRPriority :one
+UPDATE pull_requests
+SET priority = $2
+WHERE pr_id = $1
+RETURNING *;
+
+-- name: AckReview :execrows
+UPDATE review_assignments
+SET acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2;
+
+-- name: ListUnackedReviews :many
+-- Required assignments on PRs in review that were neither acknowledged nor
+-- approved and are due for a reminder (assigned before $1 and not reminded
+-- yet) or for reassignment (assigned before $2).
+SELECT ra.pr_id, ra.user_id, ra.assigned_at, ra.ack_reminded_at, pr.pr_name
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+WHERE pr.status = 'IN_REVIEW'
+  AND NOT ra.optional
+  AND ra.acked_at IS NULL
+  AND ra.approved_at IS NULL
+  AND ((ra.ack_reminded_at IS NULL AND ra.assigned_at <= @remind_before::timestamptz)
+    OR ra.assigned_at <= @reassign_before::timestamptz)
+ORDER BY ra.assigned_at, ra.pr_id, ra.user_id
+LIMIT @batch_size;
+
+-- name: MarkAckReminded :execrows
+UPDATE review_assignments
+SET ack_reminded_at = NOW()
+WHERE pr_id = $1 AND user_id = $2 AND ack_reminded_at IS NULL;
+
+-- name: ListInactiveReviews :many
+-- Required assignments on PRs in review whose reviewer has neither approved
+-- nor requested changes, and has not acknowledged them since before $1
+-- either. Reviewers from teams that opted out are skipped.
+SELECT ra.pr_id, ra.user_id, GREATEST(ra.assigned_at, ra.acked_at)::timestamptz AS last_activity_at, pr.pr_name
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+JOIN users u ON ra.user_id = u.user_id
+WHERE pr.status = 'IN_REVIEW'
+  AND NOT ra.optional
+  AND ra.reviewed_at IS NULL
+  AND GREATEST(ra.assigned_at, ra.acked_at) <= @inactive_since::timestamptz
+  AND NOT EXISTS (
+    SELECT 1 FROM team_inactive_reassign_opt_outs o WHERE o.team_id = u.team_id
+  )
+ORDER BY last_activity_at, ra.pr_id, ra.user_id
+LIMIT @batch_size;
+
+-- name: CreateChecklistItems :exec
+INSERT INTO pr_checklist_items (pr_id, position, label)
+SELECT @pr_id, t.ord - 1, t.label
+FROM unnest(@labels::text[]) WITH ORDINALITY AS t(label, ord);
+
+-- name: GetChecklistItems :many
+SELECT * FROM pr_checklist_items
+WHERE pr_id = $1
+ORDER BY position;
+
+-- name: SetChecklistItemChecked :execrows
+-- Ticking an item again keeps who ticked it first
+UPDATE pr_checklist_items
+SET checked = @checked::bool,
+    checked_by = CASE WHEN @checked::bool THEN COALESCE(checked_by, @user_id::varchar) END,
+    checked_at = CASE WHEN @checked::bool THEN COALESCE(checked_at, NOW()) END
+WHERE pr_id = @pr_id AND position = @position;
@@ -0,0 +1,26 @@
+-- name: CreatePRTemplate :one
+INSERT INTO pr_templates (team_id, template_name, name_prefix, labels, default_reviewers)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING template_id;
+
+-- name: UpdatePRTemplate :execrows
+UPDATE pr_templates
+SET template_name = $2, name_prefix = $3, labels = $4, default_reviewers = $5, updated_at = NOW()
+WHERE template_id = $1;
+
+-- name: GetPRTemplate :one
+SELECT pt.*, t.team_name
+FROM pr_templates pt
+JOIN teams t ON t.team_id = pt.team_id
+WHERE pt.template_id = $1;
+
+-- name: ListPRTemplates :many
+SELECT pt.*, t.team_name
+FROM pr_templates pt
+JOIN teams t ON t.team_id = pt.team_id
+WHERE pt.team_id = $1
+ORDER BY pt.template_name;
+
+-- name: DeletePRTemplate :execrows
+DELETE FROM pr_templates
+WHERE template_id = $1;
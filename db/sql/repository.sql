@@ -0,0 +1,69 @@
+-- name: CreateRepository :one
+INSERT INTO repositories (repository_name, default_team_id, required_reviewers)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UpdateRepository :one
+UPDATE repositories
+SET default_team_id = $2, required_reviewers = $3
+WHERE repository_name = $1
+RETURNING *;
+
+-- name: GetRepository :one
+SELECT r.*, COALESCE(t.team_name, '')::text AS default_team_name
+FROM repositories r
+LEFT JOIN teams t ON t.team_id = r.default_team_id
+WHERE r.repository_name = $1;
+
+-- name: ListRepositories :many
+SELECT r.*, COALESCE(t.team_name, '')::text AS default_team_name
+FROM repositories r
+LEFT JOIN teams t ON t.team_id = r.default_team_id
+ORDER BY r.repository_name;
+
+-- name: DeleteRepository :execrows
+DELETE FROM repositories
+WHERE repository_name = $1;
+
+-- name: DeleteRoutingRules :exec
+DELETE FROM repository_routing_rules
+WHERE repository_name = $1;
+
+-- name: InsertRoutingRule :exec
+INSERT INTO repository_routing_rules (repository_name, position, title_prefix, team_id, required_skills)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListRoutingRules :many
+-- Rules of the given repositories, all of them when the list is empty.
+SELECT rr.repository_name, rr.title_prefix, rr.team_id, rr.required_skills,
+       COALESCE(t.team_name, '')::text AS team_name
+FROM repository_routing_rules rr
+LEFT JOIN teams t ON t.team_id = rr.team_id
+WHERE cardinality(@repository_names::text[]) = 0 OR rr.repository_name = ANY(@repository_names::text[])
+ORDER BY rr.repository_name, rr.position;
+
+-- name: CreateReviewRule :exec
+INSERT INTO review_rules (rule_name, position, enabled, labels, repositories, team_id, reviewers, required_skills)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateReviewRule :execrows
+UPDATE review_rules
+SET position = $2, enabled = $3, labels = $4, repositories = $5, team_id = $6, reviewers = $7, required_skills = $8
+WHERE rule_name = $1;
+
+-- name: GetReviewRule :one
+SELECT rr.*, COALESCE(t.team_name, '')::text AS team_name
+FROM review_rules rr
+LEFT JOIN teams t ON t.team_id = rr.team_id
+WHERE rr.rule_name = $1;
+
+-- name: ListReviewRules :many
+-- Rules in evaluation order.
+SELECT rr.*, COALESCE(t.team_name, '')::text AS team_name
+FROM review_rules rr
+LEFT JOIN teams t ON t.team_id = rr.team_id
+ORDER BY rr.position, rr.rule_name;
+
+-- name: DeleteReviewRule :execrows
+DELETE FROM review_rules
+WHERE rule_name = $1;
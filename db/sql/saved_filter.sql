@@ -0,0 +1,34 @@
+-- name: CreateSavedFilter :one
+INSERT INTO saved_filters (filter_name, user_id, team_id, status, author_id, reviewer_id, team_name, repository_name, priority, label, min_age_hours, unreviewed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING filter_id;
+
+-- name: UpdateSavedFilter :execrows
+UPDATE saved_filters
+SET filter_name = $2, status = $3, author_id = $4, reviewer_id = $5, team_name = $6, repository_name = $7,
+    priority = $8, label = $9, min_age_hours = $10, unreviewed = $11, updated_at = NOW()
+WHERE filter_id = $1;
+
+-- name: GetSavedFilter :one
+SELECT sf.*, COALESCE(t.team_name, '')::text AS owner_team_name
+FROM saved_filters sf
+LEFT JOIN teams t ON t.team_id = sf.team_id
+WHERE sf.filter_id = $1;
+
+-- name: ListSavedFilters :many
+-- Filters owned by the user or by the team, the user's first, by name.
+SELECT sf.*, COALESCE(t.team_name, '')::text AS owner_team_name
+FROM saved_filters sf
+LEFT JOIN teams t ON t.team_id = sf.team_id
+WHERE sf.user_id = @user_id::text OR sf.team_id = @team_id::int
+ORDER BY sf.team_id NULLS FIRST, sf.filter_name, sf.filter_id;
+
+-- name: DeleteSavedFilter :execrows
+DELETE FROM saved_filters
+WHERE filter_id = $1;
+
+-- name: RenameSavedFilterTeam :execrows
+-- Filters select PRs by the name of the author's team, so they follow renames.
+UPDATE saved_filters
+SET team_name = @new_name::text
+WHERE team_name = @old_name::text;
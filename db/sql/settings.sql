@@ -0,0 +1,43 @@
+-- name: GetOrgSettings :one
+SELECT * FROM org_settings;
+
+-- name: UpsertOrgSettings :one
+INSERT INTO org_settings (id, max_reviewers, selection_strategy, review_sla_hours, max_open_reviews, auto_merge, shadow_reviews)
+VALUES (TRUE, $1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE
+    SET max_reviewers = EXCLUDED.max_reviewers,
+        selection_strategy = EXCLUDED.selection_strategy,
+        review_sla_hours = EXCLUDED.review_sla_hours,
+        max_open_reviews = EXCLUDED.max_open_reviews,
+        auto_merge = EXCLUDED.auto_merge,
+        shadow_reviews = EXCLUDED.shadow_reviews,
+        updated_at = NOW()
+RETURNING *;
+
+-- name: GetTeamSettings :one
+SELECT * FROM team_settings
+WHERE team_id = $1;
+
+-- name: ListTeamSettings :many
+SELECT s.team_id, t.team_name, s.max_reviewers, s.selection_strategy, s.review_sla_hours,
+       s.max_open_reviews, s.auto_merge, s.shadow_reviews, s.updated_at
+FROM team_settings s
+JOIN teams t ON t.team_id = s.team_id
+ORDER BY t.team_name;
+
+-- name: UpsertTeamSettings :one
+INSERT INTO team_settings (team_id, max_reviewers, selection_strategy, review_sla_hours, max_open_reviews, auto_merge, shadow_reviews)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (team_id) DO UPDATE
+    SET max_reviewers = EXCLUDED.max_reviewers,
+        selection_strategy = EXCLUDED.selection_strategy,
+        review_sla_hours = EXCLUDED.review_sla_hours,
+        max_open_reviews = EXCLUDED.max_open_reviews,
+        auto_merge = EXCLUDED.auto_merge,
+        shadow_reviews = EXCLUDED.shadow_reviews,
+        updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteTeamSettings :execrows
+DELETE FROM team_settings
+WHERE team_id = $1;
@@ -0,0 +1,9 @@
+-- name: UpsertSlackAccount :one
+INSERT INTO slack_accounts (user_id, slack_user_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET slack_user_id = EXCLUDED.slack_user_id
+RETURNING *;
+
+-- name: GetSlackAccountBySlackID :one
+SELECT * FROM slack_accounts
+WHERE slack_user_id = $1;
@@ -0,0 +1,36 @@
+-- name: InsertUserStatsHistory :execrows
+-- Records the review counters of every user for the day, unless the day is
+-- already recorded.
+INSERT INTO user_stats_history (snapshot_date, user_id, team_id, total_reviews, open_reviews, merged_reviews)
+SELECT @snapshot_date::date, u.user_id, u.team_id,
+       COALESCE(s.total_reviews, 0), COALESCE(s.open_reviews, 0), COALESCE(s.merged_reviews, 0)
+FROM users u
+LEFT JOIN user_review_stats s ON s.user_id = u.user_id
+ON CONFLICT (snapshot_date, user_id) DO NOTHING;
+
+-- name: InsertTeamStatsHistory :execrows
+-- Records the review counters and active member count of every team for the
+-- day, unless the day is already recorded.
+INSERT INTO team_stats_history (snapshot_date, team_id, active_members, open_reviews, merged_reviews)
+SELECT @snapshot_date::date, t.team_id,
+       (SELECT COUNT(*) FROM users u WHERE u.team_id = t.team_id AND u.is_active)::integer,
+       COALESCE(s.open_reviews, 0), COALESCE(s.merged_reviews, 0)
+FROM teams t
+LEFT JOIN team_review_stats s ON s.team_id = t.team_id
+ON CONFLICT (snapshot_date, team_id) DO NOTHING;
+
+-- name: ListUserStatsHistory :many
+SELECT h.snapshot_date, COALESCE(t.team_name, '')::text AS team_name,
+       h.total_reviews, h.open_reviews, h.merged_reviews
+FROM user_stats_history h
+LEFT JOIN teams t ON t.team_id = h.team_id
+WHERE h.user_id = @user_id
+  AND h.snapshot_date BETWEEN @since::date AND @until::date
+ORDER BY h.snapshot_date;
+
+-- name: ListTeamStatsHistory :many
+SELECT snapshot_date, active_members, open_reviews, merged_reviews
+FROM team_stats_history
+WHERE team_id = @team_id
+  AND snapshot_date BETWEEN @since::date AND @until::date
+ORDER BY snapshot_date;
@@ -1,6 +1,6 @@
 -- name: CreateTeam :one
-INSERT INTO teams (team_name)
-VALUES ($1)
+INSERT INTO teams (team_name, allow_duplicate_usernames)
+VALUES ($1, $2)
 RETURNING *;
 
 -- name: GetTeamByID :one
@@ -11,8 +11,23 @@ WHERE team_id = $1;
 SELECT * FROM teams
 WHERE team_name = $1;
 
+-- name: GetTeamWithMembers :one
+-- The team with its members and size rules as JSON arrays, in one round trip.
+SELECT sqlc.embed(t),
+       COALESCE((SELECT json_agg(json_build_object(
+                    'user_id', u.user_id, 'username', u.username, 'is_active', u.is_active,
+                    'role', u.role, 'skills', u.skills, 'in_training', u.in_training))
+                 FROM users u WHERE u.team_id = t.team_id), '[]')::jsonb AS members,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'max_lines_changed', s.max_lines_changed, 'max_files_changed', s.max_files_changed,
+                    'reviewers', s.reviewers) ORDER BY s.position)
+                 FROM team_size_rules s WHERE s.team_id = t.team_id), '[]')::jsonb AS size_rules
+FROM teams t
+WHERE t.team_name = $1;
+
 -- name: ListTeams :many
-SELECT * FROM teams;
+SELECT * FROM teams
+ORDER BY team_name;
 
 -- name: CountTeams :one
 SELECT count(*) FROM teams;
@@ -25,7 +40,7 @@ RETURNING *;
 
 -- name: DeactivateTeam :one
 UPDATE teams
-SET is_active = false
+SET is_active = false, deactivate_at = NULL
 WHERE team_id = $1
 RETURNING *;
 
@@ -34,3 +49,269 @@ UPDATE teams
 SET is_active = true
 WHERE team_id = $1
 RETURNING *;
+
+-- name: ImportTeam :one
+INSERT INTO teams (team_name, is_active, allow_duplicate_usernames, allow_self_review)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: SetTeamParent :one
+UPDATE teams
+SET parent_team_id = $2, escalate_to_parent = $3
+WHERE team_id = $1
+RETURNING *;
+
+-- name: ListChildTeams :many
+SELECT * FROM teams
+WHERE parent_team_id = $1
+ORDER BY team_name;
+
+-- name: SetTeamRequiredReviewerRole :one
+UPDATE teams
+SET required_reviewer_role = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamOptionalReviewers :one
+UPDATE teams
+SET optional_reviewers = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamShadowReviewPercent :one
+UPDATE teams
+SET shadow_review_percent = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamEscalationPolicy :one
+UPDATE teams
+SET lead_user_id = $2, escalation_notify_after_hours = $3, escalation_add_reviewer_after_hours = $4
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamReviewCooldown :one
+UPDATE teams
+SET review_cooldown_prs = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamChecklist :one
+UPDATE teams
+SET checklist_items = $2, checklist_required = $3
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamAllowDuplicateUsernames :one
+UPDATE teams
+SET allow_duplicate_usernames = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: SetTeamAllowSelfReview :one
+UPDATE teams
+SET allow_self_review = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: ScheduleTeamDeactivation :one
+UPDATE teams
+SET deactivate_at = $2
+WHERE team_id = $1
+RETURNING *;
+
+-- name: ListTeamsDueForDeactivation :many
+SELECT * FROM teams
+WHERE deactivate_at <= NOW()
+ORDER BY deactivate_at, team_id
+LIMIT $1;
+
+-- name: ListReviewerPreferences :many
+SELECT * FROM reviewer_preferences
+WHERE team_id = $1
+ORDER BY author_id, weight DESC, reviewer_id;
+
+-- name: DeleteReviewerPreferences :exec
+DELETE FROM reviewer_preferences
+WHERE team_id = $1;
+
+-- name: InsertReviewerPreference :exec
+INSERT INTO reviewer_preferences (team_id, author_id, reviewer_id, weight)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListTeamSizeRules :many
+SELECT * FROM team_size_rules
+WHERE team_id = $1
+ORDER BY position;
+
+-- name: DeleteTeamSizeRules :exec
+DELETE FROM team_size_rules
+WHERE team_id = $1;
+
+-- name: InsertTeamSizeRule :exec
+INSERT INTO team_size_rules (team_id, position, max_lines_changed, max_files_changed, reviewers)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: InsertNoCandidateEvent :exec
+INSERT INTO no_candidate_events (team_id, pr_id)
+VALUES ($1, $2);
+
+-- name: DeleteNoCandidateEventsBefore :exec
+DELETE FROM no_candidate_events
+WHERE team_id = $1 AND occurred_at < $2;
+
+-- name: CountNoCandidateEvents :one
+SELECT COUNT(*)::int FROM no_candidate_events
+WHERE team_id = $1 AND occurred_at >= $2;
+
+-- name: ClaimNoCandidateAlert :execrows
+-- Marks the team as alerted unless it already was after $2.
+INSERT INTO no_candidate_alerts (team_id, alerted_at)
+VALUES ($1, NOW())
+ON CONFLICT (team_id) DO UPDATE SET alerted_at = EXCLUDED.alerted_at
+WHERE no_candidate_alerts.alerted_at < $2;
+
+-- name: GetTeamReviewBudget :one
+SELECT * FROM team_review_budgets
+WHERE team_id = $1;
+
+-- name: ListTeamReviewBudgets :many
+SELECT b.* FROM team_review_budgets b
+JOIN teams t ON t.team_id = b.team_id
+WHERE t.is_active
+ORDER BY b.team_id;
+
+-- name: UpsertTeamReviewBudget :one
+INSERT INTO team_review_budgets (team_id, reviews_per_sprint, sprint_days)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET reviews_per_sprint = EXCLUDED.reviews_per_sprint,
+        sprint_days = EXCLUDED.sprint_days
+RETURNING *;
+
+-- name: DeleteTeamReviewBudget :execrows
+DELETE FROM team_review_budgets
+WHERE team_id = $1;
+
+-- name: StartReviewSprint :execrows
+-- Moves the budget to a new sprint unless another run already did.
+UPDATE team_review_budgets
+SET sprint_started_at = @started_at, alerted_at = NULL
+WHERE team_id = @team_id AND sprint_started_at < @started_at;
+
+-- name: ResetTeamReviewBudgetUsage :exec
+UPDATE user_review_budgets ub
+SET used = 0
+FROM users u
+WHERE u.user_id = ub.user_id AND u.team_id = $1;
+
+-- name: ClaimReviewBudgetAlert :execrows
+UPDATE team_review_budgets
+SET alerted_at = NOW()
+WHERE team_id = $1 AND alerted_at IS NULL;
+
+-- name: GetTeamReportSchedule :one
+SELECT * FROM team_report_schedules
+WHERE team_id = $1;
+
+-- name: ListTeamReportSchedules :many
+SELECT s.* FROM team_report_schedules s
+JOIN teams t ON t.team_id = s.team_id
+WHERE t.is_active
+ORDER BY s.team_id;
+
+-- name: UpsertTeamReportSchedule :one
+INSERT INTO team_report_schedules (team_id, weekday, hour, timezone)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id) DO UPDATE
+    SET weekday = EXCLUDED.weekday,
+        hour = EXCLUDED.hour,
+        timezone = EXCLUDED.timezone
+RETURNING *;
+
+-- name: DeleteTeamReportSchedule :execrows
+DELETE FROM team_report_schedules
+WHERE team_id = $1;
+
+-- name: ClaimTeamReport :execrows
+-- Records the report of the slot as sent unless another run already did. A
+-- schedule's first report is the first slot after it was created.
+UPDATE team_report_schedules
+SET last_sent_at = @slot
+WHERE team_id = @team_id AND COALESCE(last_sent_at, created_at) < @slot;
+
+-- name: GetTeamPRQuota :one
+SELECT * FROM team_pr_quotas
+WHERE team_id = $1;
+
+-- name: UpsertTeamPRQuota :one
+INSERT INTO team_pr_quotas (team_id, max_open_prs, mode)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET max_open_prs = EXCLUDED.max_open_prs,
+        mode = EXCLUDED.mode
+RETURNING *;
+
+-- name: DeleteTeamPRQuota :execrows
+DELETE FROM team_pr_quotas
+WHERE team_id = $1;
+
+-- name: GetReviewRotation :one
+SELECT * FROM review_rotations
+WHERE team_id = $1;
+
+-- name: UpsertReviewRotation :one
+INSERT INTO review_rotations (team_id, start_date, member_ids)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET start_date = EXCLUDED.start_date,
+        member_ids = EXCLUDED.member_ids,
+        updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteReviewRotation :execrows
+DELETE FROM review_rotations
+WHERE team_id = $1;
+
+-- name: ListReviewRotationOverrides :many
+SELECT * FROM review_rotation_overrides
+WHERE team_id = $1
+ORDER BY week_start;
+
+-- name: UpsertReviewRotationOverride :exec
+INSERT INTO review_rotation_overrides (team_id, week_start, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id, week_start) DO UPDATE SET user_id = EXCLUDED.user_id;
+
+-- name: DeleteReviewRotationOverride :exec
+DELETE FROM review_rotation_overrides
+WHERE team_id = $1 AND week_start = $2;
+
+-- name: ListReviewerPool :many
+-- Users who have since left the team are skipped.
+SELECT p.user_id FROM team_reviewer_pool p
+JOIN users u ON u.user_id = p.user_id AND u.team_id = p.team_id
+WHERE p.team_id = $1
+ORDER BY p.user_id;
+
+-- name: DeleteReviewerPool :exec
+DELETE FROM team_reviewer_pool
+WHERE team_id = $1;
+
+-- name: InsertReviewerPool :exec
+INSERT INTO team_reviewer_pool (team_id, user_id)
+SELECT @team_id::integer, unnest(@user_ids::text[]);
+
+-- name: IsInactiveReassignOptedOut :one
+SELECT EXISTS (
+    SELECT 1 FROM team_inactive_reassign_opt_outs WHERE team_id = $1
+);
+
+-- name: InsertInactiveReassignOptOut :exec
+INSERT INTO team_inactive_reassign_opt_outs (team_id)
+VALUES ($1)
+ON CONFLICT (team_id) DO NOTHING;
+
+-- name: DeleteInactiveReassignOptOut :exec
+DELETE FROM team_inactive_reassign_opt_outs
+WHERE team_id = $1;
@@ -4,11 +4,19 @@ VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetUserWithTeam :one
-SELECT u.user_id, u.username, u.is_active, t.team_id, t.team_name, t.is_active as team_is_active
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name, t.is_active as team_is_active
 FROM users u
 JOIN teams t ON u.team_id = t.team_id
 WHERE u.user_id = $1;
 
+-- name: GetUsersByUsername :many
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name, t.is_active as team_is_active
+FROM users u
+JOIN teams t ON u.team_id = t.team_id
+WHERE u.username = @username::text
+  AND (@team_name::text = '' OR t.team_name = @team_name::text)
+ORDER BY t.team_name, u.user_id;
+
 -- name: ListUsers :many
 SELECT * FROM users;
 
@@ -26,6 +34,11 @@ WHERE team_id = $1
   AND is_active = true
   AND user_id != ALL ($2::varchar[]);
 
+-- name: ListActiveTeamMembers :many
+SELECT * FROM users
+WHERE team_id = $1 AND is_active = true
+ORDER BY user_id;
+
 -- name: GetTeamMembers :many
 SELECT * FROM users
 WHERE team_id = $1;
@@ -44,6 +57,24 @@ SET is_active = $2
 WHERE user_id = $1
 RETURNING *;
 
+-- name: SetUserRole :one
+UPDATE users
+SET role = $2
+WHERE user_id = $1
+RETURNING *;
+
+-- name: SetUserInTraining :one
+UPDATE users
+SET in_training = $2
+WHERE user_id = $1
+RETURNING *;
+
+-- name: SetUserSkills :one
+UPDATE users
+SET skills = $2
+WHERE user_id = $1
+RETURNING *;
+
 -- name: MoveUserToTeam :one
 UPDATE users
 SET team_id = $2
@@ -56,3 +87,184 @@ SET is_active = false
 WHERE team_id = $1
   AND is_active = true
 RETURNING user_id;
+
+-- name: ListUsersWithTeam :many
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name
+FROM users u
+JOIN teams t ON u.team_id = t.team_id
+ORDER BY u.user_id;
+
+-- name: LockUsers :many
+SELECT user_id FROM users
+WHERE user_id = ANY($1::text[])
+ORDER BY user_id
+FOR UPDATE;
+
+-- name: MergeReviewProgress :exec
+-- On PRs reviewed by both users the target keeps its assignment and takes over
+-- the source's verdict and acknowledgement. An approval by either of them
+-- wins over a request for changes.
+UPDATE review_assignments t
+SET approved_at = COALESCE(t.approved_at, s.approved_at),
+    changes_requested_at = CASE
+        WHEN t.approved_at IS NULL AND s.approved_at IS NULL
+        THEN COALESCE(t.changes_requested_at, s.changes_requested_at)
+    END,
+    reviewed_at = LEAST(t.reviewed_at, s.reviewed_at),
+    acked_at = COALESCE(t.acked_at, s.acked_at),
+    optional = t.optional AND s.optional
+FROM review_assignments s
+WHERE s.pr_id = t.pr_id
+  AND s.user_id = @source_id
+  AND t.user_id = @target_id;
+
+-- name: CopyReviewAssignmentsToUser :execrows
+-- Inserting (rather than updating user_id) keeps the review counters in sync
+-- through their triggers. PRs authored by the target are skipped.
+INSERT INTO review_assignments (pr_id, user_id, approved_at, assigned_at, acked_at, ack_reminded_at, changes_requested_at, reviewed_at, optional)
+SELECT s.pr_id, @target_id, s.approved_at, s.assigned_at, s.acked_at, s.ack_reminded_at, s.changes_requested_at, s.reviewed_at, s.optional
+FROM review_assignments s
+JOIN pull_requests pr ON pr.pr_id = s.pr_id
+WHERE s.user_id = @source_id
+  AND pr.author_id <> @target_id
+ON CONFLICT (pr_id, user_id) DO NOTHING;
+
+-- name: DeleteUserReviewAssignments :execrows
+DELETE FROM review_assignments
+WHERE user_id = $1;
+
+-- name: DeleteReviewsOfAuthor :execrows
+-- Drops the reviewer's assignments on PRs written by the author.
+DELETE FROM review_assignments ra
+USING pull_requests pr
+WHERE pr.pr_id = ra.pr_id
+  AND ra.user_id = @reviewer_id
+  AND pr.author_id = @author_id;
+
+-- name: DeleteDuplicateArchivedReviews :exec
+DELETE FROM review_assignments_archive s
+USING review_assignments_archive t
+WHERE s.pr_id = t.pr_id
+  AND s.user_id = @source_id
+  AND t.user_id = @target_id;
+
+-- name: MoveArchivedReviewAssignments :exec
+UPDATE review_assignments_archive
+SET user_id = @target_id
+WHERE user_id = @source_id;
+
+-- name: MovePRAuthor :execrows
+UPDATE pull_requests
+SET author_id = @target_id
+WHERE author_id = @source_id;
+
+-- name: MoveArchivedPRAuthor :exec
+UPDATE pull_requests_archive
+SET author_id = @target_id
+WHERE author_id = @source_id;
+
+-- name: MoveGitHubAccount :execrows
+UPDATE github_accounts ga
+SET user_id = @target_id
+WHERE ga.user_id = @source_id
+  AND NOT EXISTS (SELECT 1 FROM github_accounts t WHERE t.user_id = @target_id);
+
+-- name: MoveSlackAccount :exec
+UPDATE slack_accounts sa
+SET user_id = @target_id
+WHERE sa.user_id = @source_id
+  AND NOT EXISTS (SELECT 1 FROM slack_accounts t WHERE t.user_id = @target_id);
+
+-- name: MoveNotificationPreferences :exec
+UPDATE notification_preferences np
+SET user_id = @target_id
+WHERE np.user_id = @source_id
+  AND NOT EXISTS (SELECT 1 FROM notification_preferences t WHERE t.user_id = @target_id);
+
+-- name: MoveSavedFilters :exec
+-- Filters named like one the target already has stay with the source.
+UPDATE saved_filters sf
+SET user_id = @target_id
+WHERE sf.user_id = @source_id
+  AND NOT EXISTS (SELECT 1 FROM saved_filters t WHERE t.user_id = @target_id AND t.filter_name = sf.filter_name);
+
+-- name: MoveSavedFilterReferences :exec
+UPDATE saved_filters
+SET author_id = CASE WHEN author_id = @source_id::text THEN @target_id::text ELSE author_id END,
+    reviewer_id = CASE WHEN reviewer_id = @source_id::text THEN @target_id::text ELSE reviewer_id END
+WHERE author_id = @source_id::text OR reviewer_id = @source_id::text;
+
+-- name: MovePRTemplateReviewers :exec
+UPDATE pr_templates
+SET default_reviewers = CASE
+        WHEN @target_id::text = ANY(default_reviewers) THEN array_remove(default_reviewers, @source_id::text)
+        ELSE array_replace(default_reviewers, @source_id::text, @target_id::text)
+    END
+WHERE @source_id::text = ANY(default_reviewers);
+
+-- name: MoveReviewRotationMembers :exec
+UPDATE review_rotations
+SET member_ids = CASE
+        WHEN @target_id::text = ANY(member_ids) THEN array_remove(member_ids, @source_id::text)
+        ELSE array_replace(member_ids, @source_id::text, @target_id::text)
+    END
+WHERE @source_id::text = ANY(member_ids);
+
+-- name: MoveReviewRotationOverrides :exec
+UPDATE review_rotation_overrides
+SET user_id = @target_id
+WHERE user_id = @source_id;
+
+-- name: MovePendingNotifications :exec
+UPDATE notification_outbox
+SET user_id = @target_id
+WHERE user_id = @source_id AND sent_at IS NULL;
+
+-- name: MoveWebhookDeliveries :exec
+UPDATE webhook_deliveries
+SET user_id = @target_id
+WHERE user_id = @source_id;
+
+-- name: MoveReviewerPool :exec
+UPDATE team_reviewer_pool p
+SET user_id = @target_id
+WHERE p.user_id = @source_id
+  AND NOT EXISTS (SELECT 1 FROM team_reviewer_pool t WHERE t.team_id = p.team_id AND t.user_id = @target_id);
+
+-- name: MoveReviewerPreferences :exec
+-- Pairs that would point the target at itself or that the target already has are dropped
+INSERT INTO reviewer_preferences (team_id, author_id, reviewer_id, weight)
+SELECT p.team_id,
+       CASE WHEN p.author_id = @source_id THEN @target_id ELSE p.author_id END AS author_id,
+       CASE WHEN p.reviewer_id = @source_id THEN @target_id ELSE p.reviewer_id END AS reviewer_id,
+       p.weight
+FROM reviewer_preferences p
+WHERE (p.author_id = @source_id OR p.reviewer_id = @source_id)
+  AND p.author_id <> @target_id
+  AND p.reviewer_id <> @target_id
+ON CONFLICT DO NOTHING;
+
+-- name: MoveChecklistChecks :exec
+UPDATE pr_checklist_items
+SET checked_by = @target_id
+WHERE checked_by = @source_id;
+
+-- name: MoveReassignmentHistory :exec
+UPDATE reassignments
+SET from_user_id = CASE WHEN from_user_id = @source_id THEN @target_id ELSE from_user_id END,
+    to_user_id = CASE WHEN to_user_id = @source_id THEN @target_id ELSE to_user_id END
+WHERE from_user_id = @source_id OR to_user_id = @source_id;
+
+-- name: MoveTeamLead :exec
+UPDATE teams
+SET lead_user_id = @target_id
+WHERE lead_user_id = @source_id;
+
+-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE user_id = $1;
+
+-- name: ListReviewBudgetUsage :many
+SELECT user_id, used
+FROM user_review_budgets
+WHERE user_id = ANY(@user_ids::varchar[]);
@@ -0,0 +1,48 @@
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (notification_id, user_id, url, request_body, status_code, response_body, error, duration_ms, redelivery_of)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+-- Newest attempts first. An empty status matches all attempts.
+SELECT * FROM webhook_deliveries
+WHERE (@user_id::text = '' OR user_id = @user_id::text)
+  AND (@status::text = ''
+       OR (@status::text = 'failed' AND error <> '')
+       OR (@status::text = 'succeeded' AND error = ''))
+ORDER BY attempted_at DESC, id DESC
+LIMIT @result_limit OFFSET @result_offset;
+
+-- name: CreateInboundWebhook :one
+INSERT INTO inbound_webhooks (source, event, delivery_id, ingestion_token, payload, status, error)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetInboundWebhook :one
+SELECT * FROM inbound_webhooks
+WHERE id = $1;
+
+-- name: HasWebhookTargets :one
+-- Whether any user is notified through the webhook channel.
+SELECT EXISTS (
+    SELECT 1 FROM notification_preferences
+    WHERE webhook_url <> '' AND 'webhook' = ANY(channels)
+);
+
+-- name: ListInboundWebhooks :many
+-- Newest deliveries first. An empty status or event matches all deliveries.
+SELECT * FROM inbound_webhooks
+WHERE (@status::text = '' OR status = @status::text)
+  AND (@event::text = '' OR event = @event::text)
+ORDER BY received_at DESC, id DESC
+LIMIT @result_limit OFFSET @result_offset;
+
+-- name: UpdateInboundWebhookResult :one
+UPDATE inbound_webhooks
+SET status = $2, error = $3, attempts = attempts + 1, processed_at = NOW()
+WHERE id = $1
+RETURNING *;
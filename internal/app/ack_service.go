@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const unackedReviewBatchSize = 100
+
+// AckService follows up on review assignments that were not acknowledged: the
+// reviewer is reminded once after remindAfter and replaced by another member
+// of the team after reassignAfter. A zero duration disables the step.
+type AckService struct {
+	prRepo        domain.PullRequestRepository
+	prSvc         *PullRequestService
+	notifySvc     *NotificationService
+	tx            domain.UnitOfWork
+	remindAfter   time.Duration
+	reassignAfter time.Duration
+	log           *slog.Logger
+}
+
+func NewAckService(
+	prRepo domain.PullRequestRepository,
+	prSvc *PullRequestService,
+	notifySvc *NotificationService,
+	tx domain.UnitOfWork,
+	remindAfter, reassignAfter time.Duration,
+	log *slog.Logger,
+) *AckService {
+	return &AckService{
+		prRepo:        prRepo,
+		prSvc:         prSvc,
+		notifySvc:     notifySvc,
+		tx:            tx,
+		remindAfter:   remindAfter,
+		reassignAfter: reassignAfter,
+		log:           log,
+	}
+}
+
+// Enabled reports whether any follow-up step is configured.
+func (s *AckService) Enabled() bool {
+	return s.remindAfter > 0 || s.reassignAfter > 0
+}
+
+// FollowUp reminds and reassigns reviewers whose acknowledgement is overdue
+// and returns how many steps were taken. An assignment that fails is logged
+// and retried on the next run.
+func (s *AckService) FollowUp(ctx context.Context) (int, error) {
+	now := time.Now()
+	var remindBefore, reassignBefore time.Time
+	if s.remindAfter > 0 {
+		remindBefore = now.Add(-s.remindAfter)
+	}
+	if s.reassignAfter > 0 {
+		reassignBefore = now.Add(-s.reassignAfter)
+	}
+
+	reviews, err := s.prRepo.ListUnackedReviews(ctx, remindBefore, reassignBefore, unackedReviewBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	steps := 0
+	for i := range reviews {
+		r := &reviews[i]
+		waiting := now.Sub(r.AssignedAt)
+
+		if s.reassignAfter > 0 && waiting >= s.reassignAfter {
+			_, newReviewerID, err := s.prSvc.ReassignReviewer(ctx, r.PRID, r.UserID, domain.ReassignmentUnacked, "")
+			if err != nil {
+				s.log.WarnContext(ctx, "failed to reassign unacknowledged review", "pr_id", r.PRID, "user_id", r.UserID, "error", err)
+				continue
+			}
+			s.log.InfoContext(ctx, "reassigned unacknowledged review", "event", "pr.ack_reassigned", "pr_id", r.PRID, "old_user_id", r.UserID, "new_user_id", newReviewerID)
+			steps++
+			continue
+		}
+
+		if !r.Reminded {
+			reminded, err := s.remind(ctx, r, waiting)
+			if err != nil {
+				s.log.WarnContext(ctx, "failed to remind reviewer", "pr_id", r.PRID, "user_id", r.UserID, "error", err)
+				continue
+			}
+			if reminded {
+				steps++
+			}
+		}
+	}
+	return steps, nil
+}
+
+func (s *AckService) remind(ctx context.Context, r *domain.UnackedReview, waiting time.Duration) (bool, error) {
+	var first bool
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		first, err = s.prRepo.MarkAckReminded(ctx, tx, r.PRID, r.UserID)
+		return err
+	})
+	if err != nil || !first {
+		return false, err
+	}
+
+	n := &domain.Notification{
+		UserID:  r.UserID,
+		Event:   domain.EventAckReminder,
+		PRID:    r.PRID,
+		Message: fmt.Sprintf("Please acknowledge your review of %q (%s), assigned %s ago", r.PRName, r.PRID, waiting.Round(time.Minute)),
+	}
+	if err := s.notifySvc.Notify(ctx, n); err != nil {
+		s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "pr_id", r.PRID, "error", err)
+	}
+	return true, nil
+}
+
+// Run follows up on unacknowledged reviews every interval until ctx is
+// cancelled.
+func (s *AckService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.FollowUp(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "ack follow-up run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
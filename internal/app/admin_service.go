@@ -0,0 +1,525 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+type AdminService struct {
+	dumpRepo domain.DumpRepository
+	teamRepo domain.TeamRepository
+	userRepo domain.UserRepository
+	prRepo   domain.PullRequestRepository
+	prSvc    *PullRequestService
+	tx       domain.UnitOfWork
+	log      *slog.Logger
+}
+
+func NewAdminService(
+	dumpRepo domain.DumpRepository,
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	prRepo domain.PullRequestRepository,
+	prSvc *PullRequestService,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *AdminService {
+	return &AdminService{
+		dumpRepo: dumpRepo,
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		prSvc:    prSvc,
+		tx:       tx,
+		log:      log,
+	}
+}
+
+func (s *AdminService) Export(ctx context.Context) (*domain.DataDump, error) {
+	teams, err := s.dumpRepo.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export teams: %w", err)
+	}
+	users, err := s.dumpRepo.ListUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export users: %w", err)
+	}
+	prs, err := s.dumpRepo.ListPRsWithReviewers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export pull requests: %w", err)
+	}
+
+	return &domain.DataDump{Teams: teams, Users: users, PullRequests: prs}, nil
+}
+
+// Import restores a dump produced by Export. The whole dump is validated before anything
+// is written and is imported in a single transaction, so a failed import leaves no partial data.
+func (s *AdminService) Import(ctx context.Context, dump *domain.DataDump) (*domain.ImportResult, error) {
+	if err := validateDump(dump); err != nil {
+		return nil, err
+	}
+
+	// Dumps of teams that hold duplicate usernames keep them allowed, and so do
+	// dumps of teams whose authors review their own PRs.
+	usernames := make(map[string][]string, len(dump.Teams))
+	userTeams := make(map[string]string, len(dump.Users))
+	for _, u := range dump.Users {
+		usernames[u.TeamName] = append(usernames[u.TeamName], u.Username)
+		userTeams[u.ID] = u.TeamName
+	}
+	selfReview := make(map[string]bool)
+	for _, pr := range dump.PullRequests {
+		if slices.ContainsFunc(pr.Reviewers, func(r domain.Reviewer) bool { return r.ID == pr.AuthorID }) {
+			selfReview[userTeams[pr.AuthorID]] = true
+		}
+	}
+
+	var result *domain.ImportResult
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		result = &domain.ImportResult{}
+		teamIDs := make(map[string]int32, len(dump.Teams))
+		for _, t := range dump.Teams {
+			t.AllowDuplicateUsernames = duplicateUsername(usernames[t.TeamName]) != ""
+			t.AllowSelfReview = selfReview[t.TeamName]
+			created, err := s.dumpRepo.ImportTeam(ctx, tx, &t)
+			if err != nil {
+				return err
+			}
+			teamIDs[t.TeamName] = created.ID
+			result.Teams++
+		}
+
+		for _, u := range dump.Users {
+			u.TeamID = teamIDs[u.TeamName]
+			if err := s.dumpRepo.ImportUser(ctx, tx, &u); err != nil {
+				return err
+			}
+			result.Users++
+		}
+
+		for _, pr := range dump.PullRequests {
+			assigned, err := s.importPR(ctx, tx, &pr)
+			if err != nil {
+				return err
+			}
+			result.PullRequests++
+			result.Assignments += assigned
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "data imported",
+		"teams", result.Teams,
+		"users", result.Users,
+		"pull_requests", result.PullRequests,
+		"assignments", result.Assignments,
+	)
+	return result, nil
+}
+
+// importPR inserts a PR with its reviewers and their approvals and returns the
+// number of review assignments created.
+func (s *AdminService) importPR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) (int, error) {
+	if err := s.dumpRepo.ImportPR(ctx, tx, pr); err != nil {
+		return 0, err
+	}
+	if len(pr.Reviewers) == 0 {
+		return 0, nil
+	}
+
+	var requiredIDs, optionalIDs []string
+	for _, r := range pr.Reviewers {
+		if r.Optional {
+			optionalIDs = append(optionalIDs, r.ID)
+		} else {
+			requiredIDs = append(requiredIDs, r.ID)
+		}
+	}
+	if err := s.dumpRepo.AssignReviewers(ctx, tx, pr.ID, requiredIDs, false); err != nil {
+		return 0, fmt.Errorf("failed to import reviewers for PR %s: %w", pr.ID, err)
+	}
+	if err := s.dumpRepo.AssignReviewers(ctx, tx, pr.ID, optionalIDs, true); err != nil {
+		return 0, fmt.Errorf("failed to import reviewers for PR %s: %w", pr.ID, err)
+	}
+	for _, userID := range pr.ApprovedBy {
+		if err := s.dumpRepo.ApproveReview(ctx, tx, pr.ID, userID); err != nil {
+			return 0, fmt.Errorf("failed to import approvals for PR %s: %w", pr.ID, err)
+		}
+	}
+	return len(pr.Reviewers), nil
+}
+
+// Rebalance moves open review assignments between active members of a team until their
+// open review counts differ by at most one, or no further move is possible. A PR is never
+// moved to its author or to a user already reviewing it, and the last reviewer with the
+// role required by the author's team is never moved to a user without that role.
+func (s *AdminService) Rebalance(ctx context.Context, teamName string) (*domain.RebalanceReport, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of team %s: %w", teamName, err)
+	}
+	members, memberIDs := activeMembers(users)
+
+	assignments, err := s.prRepo.GetOpenAssignmentsForUsers(ctx, memberIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open reviews of team %s: %w", teamName, err)
+	}
+
+	plan := newRebalancePlan(memberIDs, assignments)
+	report := &domain.RebalanceReport{TeamName: team.TeamName, LoadBefore: plan.loads()}
+	if report.Moves, err = s.planRebalance(ctx, plan, members); err != nil {
+		return nil, err
+	}
+	report.LoadAfter = plan.loads()
+
+	if len(report.Moves) == 0 {
+		return report, nil
+	}
+	if err := s.applyRebalance(ctx, report.Moves); err != nil {
+		return nil, err
+	}
+
+	s.log.InfoContext(ctx, "team reviews rebalanced", "team_name", team.TeamName, "moves", len(report.Moves))
+	return report, nil
+}
+
+// activeMembers returns the active users by ID and their IDs in order.
+func activeMembers(users []domain.User) (map[string]*domain.User, []string) {
+	members := make(map[string]*domain.User, len(users))
+	memberIDs := make([]string, 0, len(users))
+	for i := range users {
+		if users[i].IsActive {
+			members[users[i].ID] = &users[i]
+			memberIDs = append(memberIDs, users[i].ID)
+		}
+	}
+	return members, memberIDs
+}
+
+// planRebalance applies moves to plan until no further move is possible and
+// returns them.
+func (s *AdminService) planRebalance(ctx context.Context, plan *rebalancePlan, members map[string]*domain.User) ([]domain.RebalanceMove, error) {
+	var moves []domain.RebalanceMove
+	for {
+		move, ok, err := s.nextRebalanceMove(ctx, plan, members)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return moves, nil
+		}
+		plan.apply(move)
+		moves = append(moves, move)
+	}
+}
+
+// applyRebalance makes the planned moves in one transaction.
+func (s *AdminService) applyRebalance(ctx context.Context, moves []domain.RebalanceMove) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		for _, m := range moves {
+			optional, err := s.prRepo.RemoveReviewer(ctx, tx, m.PRID, m.FromUserID)
+			if err != nil {
+				return fmt.Errorf("failed to remove reviewer %s from PR %s: %w", m.FromUserID, m.PRID, err)
+			}
+			if err := s.prRepo.AssignReviewers(ctx, tx, m.PRID, []string{m.ToUserID}, optional); err != nil {
+				return fmt.Errorf("failed to assign reviewer %s to PR %s: %w", m.ToUserID, m.PRID, err)
+			}
+			if err := s.prRepo.RecordReassignment(ctx, tx, m.PRID, m.FromUserID, m.ToUserID, domain.ReassignmentRebalance, ""); err != nil {
+				return fmt.Errorf("failed to record reassignment on PR %s: %w", m.PRID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// MergeUsers folds a duplicate user into the one that stays: the source's
+// review assignments, authored PRs, GitHub account and notification settings
+// move to the target and the source is deleted, all in one transaction.
+func (s *AdminService) MergeUsers(ctx context.Context, sourceID, targetID string) (*domain.UserMergeResult, error) {
+	if sourceID == "" || targetID == "" {
+		return nil, fmt.Errorf("%w: source_user_id and target_user_id are required", domain.ErrValidation)
+	}
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: cannot merge user %s into itself", domain.ErrValidation, sourceID)
+	}
+	for _, id := range []string{sourceID, targetID} {
+		if _, err := s.userRepo.GetUserByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	var result *domain.UserMergeResult
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		result, err = s.userRepo.MergeUsers(ctx, tx, sourceID, targetID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "users merged", "event", "user.merged",
+		"source_user_id", sourceID,
+		"target_user_id", targetID,
+		"reviews_moved", result.ReviewsMoved,
+		"reviews_dropped", result.ReviewsDropped,
+		"pull_requests_moved", result.PullRequestsMoved,
+	)
+	return result, nil
+}
+
+// nextRebalanceMove finds a move from the most loaded member to the least loaded one that
+// narrows the gap between them.
+func (s *AdminService) nextRebalanceMove(ctx context.Context, plan *rebalancePlan, members map[string]*domain.User) (domain.RebalanceMove, bool, error) {
+	byLoad := slices.Clone(plan.memberIDs)
+	slices.SortStableFunc(byLoad, func(a, b string) int {
+		return cmp.Compare(plan.load[b], plan.load[a])
+	})
+
+	for _, from := range byLoad {
+		for j := len(byLoad) - 1; j >= 0; j-- {
+			to := byLoad[j]
+			if plan.load[from]-plan.load[to] < 2 {
+				break
+			}
+			for _, prID := range plan.byUser[from] {
+				if plan.authors[prID] == to || plan.reviewers[prID][to] {
+					continue
+				}
+				ok, err := s.keepsRequiredRole(ctx, plan, prID, members, members[from], members[to])
+				if err != nil {
+					return domain.RebalanceMove{}, false, err
+				}
+				if ok {
+					return domain.RebalanceMove{PRID: prID, FromUserID: from, ToUserID: to}, true, nil
+				}
+			}
+		}
+	}
+	return domain.RebalanceMove{}, false, nil
+}
+
+func (s *AdminService) keepsRequiredRole(ctx context.Context, plan *rebalancePlan, prID string, members map[string]*domain.User, from, to *domain.User) (bool, error) {
+	if from.Role == "" || from.Role == to.Role {
+		return true, nil
+	}
+
+	authorID := plan.authors[prID]
+	role, ok := plan.requiredRoles[authorID]
+	if !ok {
+		author, err := s.userRepo.GetUserByID(ctx, authorID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get author of PR %s: %w", prID, err)
+		}
+		team, err := s.teamRepo.GetTeamByID(ctx, author.TeamID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get author's team for PR %s: %w", prID, err)
+		}
+		role = team.RequiredReviewerRole
+		plan.requiredRoles[authorID] = role
+	}
+	if role != from.Role {
+		return true, nil
+	}
+
+	for id := range plan.reviewers[prID] {
+		if id != from.ID && members[id].Role == role {
+			return true, nil
+		}
+	}
+	// Reviewers from other teams are not touched by the rebalance.
+	reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get reviewers of PR %s: %w", prID, err)
+	}
+	for _, r := range reviewers {
+		if _, isMember := members[r.ID]; !isMember && r.Role == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rebalancePlan is the in-memory state of a team's open review assignments while moves are planned.
+type rebalancePlan struct {
+	memberIDs     []string
+	load          map[string]int
+	byUser        map[string][]string        // member -> PRs under review
+	reviewers     map[string]map[string]bool // PR -> member reviewers
+	authors       map[string]string          // PR -> author
+	requiredRoles map[string]string          // author -> required reviewer role
+}
+
+func newRebalancePlan(memberIDs []string, assignments []domain.ReviewAssignment) *rebalancePlan {
+	plan := &rebalancePlan{
+		memberIDs:     memberIDs,
+		load:          make(map[string]int, len(memberIDs)),
+		byUser:        make(map[string][]string, len(memberIDs)),
+		reviewers:     make(map[string]map[string]bool),
+		authors:       make(map[string]string),
+		requiredRoles: make(map[string]string),
+	}
+	for _, id := range memberIDs {
+		plan.load[id] = 0
+	}
+	for _, a := range assignments {
+		plan.authors[a.PRID] = a.AuthorID
+		if plan.reviewers[a.PRID] == nil {
+			plan.reviewers[a.PRID] = make(map[string]bool)
+		}
+		plan.reviewers[a.PRID][a.UserID] = true
+		plan.byUser[a.UserID] = append(plan.byUser[a.UserID], a.PRID)
+		plan.load[a.UserID]++
+	}
+	return plan
+}
+
+func (p *rebalancePlan) apply(m domain.RebalanceMove) {
+	p.byUser[m.FromUserID] = slices.DeleteFunc(p.byUser[m.FromUserID], func(prID string) bool { return prID == m.PRID })
+	p.byUser[m.ToUserID] = append(p.byUser[m.ToUserID], m.PRID)
+	delete(p.reviewers[m.PRID], m.FromUserID)
+	p.reviewers[m.PRID][m.ToUserID] = true
+	p.load[m.FromUserID]--
+	p.load[m.ToUserID]++
+}
+
+func (p *rebalancePlan) loads() map[string]int {
+	return maps.Clone(p.load)
+}
+
+// validateDump checks that every reference inside the dump points to an entity defined
+// in the same dump and that the dump does not break the reviewer assignment rules.
+// Missing PR timestamps are filled in along the way.
+func validateDump(dump *domain.DataDump) error {
+	teams, err := validateDumpTeams(dump.Teams)
+	if err != nil {
+		return err
+	}
+	users, err := validateDumpUsers(dump.Users, teams)
+	if err != nil {
+		return err
+	}
+
+	prs := make(map[string]bool, len(dump.PullRequests))
+	for i := range dump.PullRequests {
+		pr := &dump.PullRequests[i]
+		if prs[pr.ID] {
+			return fmt.Errorf("%w: duplicate PR %s", domain.ErrValidation, pr.ID)
+		}
+		prs[pr.ID] = true
+
+		if err := validateDumpPR(pr, users); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateDumpTeams(dumpTeams []domain.Team) (map[string]bool, error) {
+	teams := make(map[string]bool, len(dumpTeams))
+	for _, t := range dumpTeams {
+		if t.TeamName == "" {
+			return nil, fmt.Errorf("%w: team name is required", domain.ErrValidation)
+		}
+		if teams[t.TeamName] {
+			return nil, fmt.Errorf("%w: duplicate team %s", domain.ErrValidation, t.TeamName)
+		}
+		teams[t.TeamName] = true
+	}
+	return teams, nil
+}
+
+func validateDumpUsers(dumpUsers []domain.User, teams map[string]bool) (map[string]bool, error) {
+	users := make(map[string]bool, len(dumpUsers))
+	usernames := make(map[string]bool, len(dumpUsers))
+	for _, u := range dumpUsers {
+		if u.ID == "" || u.Username == "" {
+			return nil, fmt.Errorf("%w: user_id and username are required", domain.ErrValidation)
+		}
+		if users[u.ID] {
+			return nil, fmt.Errorf("%w: duplicate user %s", domain.ErrValidation, u.ID)
+		}
+		if usernames[u.Username] {
+			return nil, fmt.Errorf("%w: duplicate username %s", domain.ErrValidation, domain.PII(u.Username))
+		}
+		if !teams[u.TeamName] {
+			return nil, fmt.Errorf("%w: user %s references unknown team %s", domain.ErrValidation, u.ID, u.TeamName)
+		}
+		users[u.ID] = true
+		usernames[u.Username] = true
+	}
+	return users, nil
+}
+
+func validateDumpPR(pr *domain.PullRequest, users map[string]bool) error {
+	if pr.ID == "" || pr.Name == "" {
+		return fmt.Errorf("%w: pull_request_id and pull_request_name are required", domain.ErrValidation)
+	}
+	if !users[pr.AuthorID] {
+		return fmt.Errorf("%w: PR %s references unknown author %s", domain.ErrValidation, pr.ID, pr.AuthorID)
+	}
+	if !pr.Status.Valid() {
+		return fmt.Errorf("%w: PR %s has unknown status %s", domain.ErrValidation, pr.ID, pr.Status)
+	}
+	if pr.Status != domain.StatusMerged && pr.MergedAt != nil {
+		return fmt.Errorf("%w: %s PR %s cannot have mergedAt", domain.ErrValidation, pr.Status, pr.ID)
+	}
+	if pr.Status != domain.StatusClosed && pr.ClosedAt != nil {
+		return fmt.Errorf("%w: %s PR %s cannot have closedAt", domain.ErrValidation, pr.Status, pr.ID)
+	}
+
+	if pr.CreatedAt.IsZero() {
+		pr.CreatedAt = time.Now()
+	}
+	if pr.Status == domain.StatusMerged && pr.MergedAt == nil {
+		mergedAt := pr.CreatedAt
+		pr.MergedAt = &mergedAt
+	}
+	if pr.Status == domain.StatusClosed && pr.ClosedAt == nil {
+		closedAt := pr.CreatedAt
+		pr.ClosedAt = &closedAt
+	}
+
+	return validateDumpReviewers(pr, users)
+}
+
+func validateDumpReviewers(pr *domain.PullRequest, users map[string]bool) error {
+	if required := pr.RequiredReviewerCount(); required > maxEscalatedReviewers {
+		return fmt.Errorf("%w: PR %s has more than %d reviewers", domain.ErrValidation, pr.ID, maxEscalatedReviewers)
+	} else if len(pr.Reviewers)-required > maxOptionalReviewers {
+		return fmt.Errorf("%w: PR %s has more than %d optional reviewers", domain.ErrValidation, pr.ID, maxOptionalReviewers)
+	}
+	seen := make(map[string]bool, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		if !users[r.ID] {
+			return fmt.Errorf("%w: PR %s references unknown reviewer %s", domain.ErrValidation, pr.ID, r.ID)
+		}
+		if seen[r.ID] {
+			return fmt.Errorf("%w: PR %s has duplicate reviewer %s", domain.ErrValidation, pr.ID, r.ID)
+		}
+		seen[r.ID] = true
+	}
+	for _, userID := range pr.ApprovedBy {
+		if !seen[userID] {
+			return fmt.Errorf("%w: PR %s has an approval from %s, who is not its reviewer", domain.ErrValidation, pr.ID, userID)
+		}
+	}
+	return nil
+}
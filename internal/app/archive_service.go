@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// archiveBatchSize bounds how many PRs are moved per transaction so a large
+// backlog does not hold locks on pull_requests for long.
+const archiveBatchSize = 500
+
+type ArchiveService struct {
+	archiveRepo domain.ArchiveRepository
+	tx          domain.UnitOfWork
+	log         *slog.Logger
+}
+
+func NewArchiveService(archiveRepo domain.ArchiveRepository, tx domain.UnitOfWork, log *slog.Logger) *ArchiveService {
+	return &ArchiveService{
+		archiveRepo: archiveRepo,
+		tx:          tx,
+		log:         log,
+	}
+}
+
+// ArchiveMergedOlderThan moves PRs merged more than retention ago into the
+// archive tables and returns how many were moved.
+func (s *ArchiveService) ArchiveMergedOlderThan(ctx context.Context, retention time.Duration) (int, error) {
+	if retention < 0 {
+		return 0, fmt.Errorf("%w: retention must not be negative", domain.ErrValidation)
+	}
+	cutoff := time.Now().Add(-retention)
+
+	total := 0
+	for {
+		ids, err := s.archiveRepo.ListMergedPRIDsBefore(ctx, cutoff, archiveBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to list merged PRs: %w", err)
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		archived, err := s.archiveBatch(ctx, ids)
+		if err != nil {
+			return total, err
+		}
+		total += archived
+
+		if len(ids) < archiveBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.log.InfoContext(ctx, "archived merged pull requests", "count", total, "merged_before", cutoff)
+	}
+	return total, nil
+}
+
+func (s *ArchiveService) archiveBatch(ctx context.Context, prIDs []string) (int, error) {
+	var archived int
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		if archived, err = s.archiveRepo.ArchivePRs(ctx, tx, prIDs); err != nil {
+			return fmt.Errorf("failed to archive pull requests: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return archived, nil
+}
+
+// Run archives merged PRs every interval until ctx is cancelled.
+func (s *ArchiveService) Run(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ArchiveMergedOlderThan(ctx, retention); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "archival run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// candidatePool is what reviewer selection needs to know about a team that
+// only changes with user and team mutations: its active members, the
+// reviewer pool, the reviewer preferences of authors, the review budget and
+// the rotation.
+type candidatePool struct {
+	members []domain.User
+	// reviewerPool lists the members regular reviewers are picked from; empty
+	// means all of them.
+	reviewerPool []string
+	// primary is the member on rotation the week the pool was loaded, empty
+	// when the team has no rotation.
+	primary string
+	// weights maps an author and a reviewer to the preference weight.
+	weights map[[2]string]int
+	// budget is the reviews each member takes per sprint; 0 means no budget.
+	budget int
+}
+
+// candidatePools caches candidate pools per team for a short time, so that a
+// burst of PRs for one team reads its members once.
+type candidatePools struct {
+	ttl time.Duration
+
+	mu sync.Mutex
+	// gen is bumped by invalidate, so that pools loaded before a mutation are
+	// not stored after it.
+	gen     uint64
+	entries map[int32]candidatePoolEntry
+}
+
+type candidatePoolEntry struct {
+	pool    *candidatePool
+	expires time.Time
+}
+
+func newCandidatePools(ttl time.Duration) *candidatePools {
+	return &candidatePools{ttl: ttl, entries: make(map[int32]candidatePoolEntry)}
+}
+
+// get returns the team's pool, loading it on a miss. A zero TTL disables the
+// cache.
+func (c *candidatePools) get(ctx context.Context, teamID int32, load func(ctx context.Context, teamID int32) (*candidatePool, error)) (*candidatePool, error) {
+	if c.ttl <= 0 {
+		return load(ctx, teamID)
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[teamID]
+	gen := c.gen
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.pool, nil
+	}
+
+	pool, err := load(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.gen == gen {
+		c.entries[teamID] = candidatePoolEntry{pool: pool, expires: time.Now().Add(c.ttl)}
+	}
+	c.mu.Unlock()
+	return pool, nil
+}
+
+// invalidate drops every cached pool. Mutations of users and teams are rare
+// enough not to bother working out which teams they touch.
+func (c *candidatePools) invalidate() {
+	c.mu.Lock()
+	c.gen++
+	clear(c.entries)
+	c.mu.Unlock()
+}
+
+// candidateQuery describes the reviewers wanted from a pool.
+type candidateQuery struct {
+	authorID   string
+	excludeIDs []string
+	// role, if set, is required from every candidate.
+	role string
+	// skills are preferred; cooldown users are picked only when nobody else is
+	// available.
+	skills   []string
+	cooldown []string
+	// hints are picked before anyone else, such as the default reviewers of
+	// the PR's template.
+	hints []string
+	// shadow asks for users in training, who are otherwise never candidates.
+	shadow bool
+	limit  int
+	// prID seeds the random choices, together with the selection salt, so the
+	// picks for a PR can be reproduced.
+	prID string
+	// explanations, if set, receives why each returned candidate was picked,
+	// by user ID.
+	explanations map[string]domain.ReviewerExplanation
+}
+
+// eligible returns the members of the pool that may review the query's PR.
+func (p *candidatePool) eligible(q candidateQuery) []domain.User {
+	var users []domain.User
+	for _, u := range p.members {
+		if u.ID == q.authorID || slices.Contains(q.excludeIDs, u.ID) {
+			continue
+		}
+		if q.role != "" && u.Role != q.role {
+			continue
+		}
+		if u.InTraining != q.shadow {
+			continue
+		}
+		if !q.shadow && len(p.reviewerPool) > 0 && !slices.Contains(p.reviewerPool, u.ID) {
+			continue
+		}
+		users = append(users, u)
+	}
+	return users
+}
+
+// rank orders candidates the way they are picked: hinted users first, then the
+// primary reviewer of the week, preferred reviewers of the author, users in
+// cooldown last, then by matching skills, with ties broken by the strategy
+// using the open review counts. It returns at most q.limit users.
+func (p *candidatePool) rank(candidates []domain.User, q candidateQuery, strategy domain.SelectionStrategy, open map[string]int, rng *rand.Rand) []domain.User {
+	// Random keys are drawn in a fixed order, so that the same PR and
+	// candidates give the same picks. Exponential keys divided by the weight
+	// give a weighted random order (Efraimidis-Spirakis).
+	slices.SortFunc(candidates, func(a, b domain.User) int { return strings.Compare(a.ID, b.ID) })
+	keys := make(map[string]float64, len(candidates))
+	for _, u := range candidates {
+		weight := 1.0
+		if strategy == domain.SelectionWeightedRandom {
+			weight = 1 / float64(1+open[u.ID])
+		}
+		keys[u.ID] = rng.ExpFloat64() / weight
+	}
+
+	slices.SortStableFunc(candidates, func(a, b domain.User) int {
+		if c := p.compare(a, b, q); c != 0 {
+			return c
+		}
+		if strategy == domain.SelectionLeastLoaded && open[a.ID] != open[b.ID] {
+			return open[a.ID] - open[b.ID]
+		}
+		return cmp.Compare(keys[a.ID], keys[b.ID])
+	})
+	if len(candidates) > q.limit {
+		candidates = candidates[:q.limit]
+	}
+	return candidates
+}
+
+// Streams of selectionRand, so that separate choices for one PR are not
+// correlated.
+const (
+	selectionStreamRank uint64 = iota
+	selectionStreamShadow
+)
+
+// selectionRand returns the source of a random choice for the PR. It is
+// seeded by an HMAC of the PR ID keyed with the server's salt: clients choose
+// PR IDs, so without the salt they could pick an ID that lands a PR on a
+// reviewer of their choice. With the same salt the choice can be reproduced in
+// tests and audits. Without a PR ID it is seeded at random.
+func selectionRand(salt []byte, prID string, stream uint64) *rand.Rand {
+	if prID == "" {
+		return rand.New(rand.NewPCG(rand.Uint64(), stream))
+	}
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(prID))
+	return rand.New(rand.NewPCG(binary.BigEndian.Uint64(mac.Sum(nil)), stream))
+}
+
+// compare orders two candidates by rank's criteria; 0 means rank's strategy
+// picks between them.
+func (p *candidatePool) compare(a, b domain.User, q candidateQuery) int {
+	if ha, hb := slices.Contains(q.hints, a.ID), slices.Contains(q.hints, b.ID); ha != hb {
+		if ha {
+			return -1
+		}
+		return 1
+	}
+	if pa, pb := a.ID == p.primary, b.ID == p.primary; pa != pb {
+		if pa {
+			return -1
+		}
+		return 1
+	}
+	if wa, wb := p.weights[[2]string{q.authorID, a.ID}], p.weights[[2]string{q.authorID, b.ID}]; wa != wb {
+		return wb - wa
+	}
+	if ca, cb := slices.Contains(q.cooldown, a.ID), slices.Contains(q.cooldown, b.ID); ca != cb {
+		if ca {
+			return 1
+		}
+		return -1
+	}
+	return matchingSkills(b, q.skills) - matchingSkills(a, q.skills)
+}
+
+// explain says why rank picked u out of the given number of candidates.
+func (p *candidatePool) explain(u domain.User, candidates int, q candidateQuery, strategy domain.SelectionStrategy, open map[string]int) domain.ReviewerExplanation {
+	e := domain.ReviewerExplanation{
+		UserID:           u.ID,
+		Role:             q.role,
+		Hinted:           slices.Contains(q.hints, u.ID),
+		Rotation:         u.ID == p.primary,
+		PreferenceWeight: p.weights[[2]string{q.authorID, u.ID}],
+		Cooldown:         slices.Contains(q.cooldown, u.ID),
+		MatchedSkills:    matchedSkills(u, q.skills),
+		Strategy:         strategy,
+		Candidates:       candidates,
+	}
+	if open != nil {
+		n := open[u.ID]
+		e.OpenReviews = &n
+	}
+	return e
+}
+
+func matchingSkills(u domain.User, skills []string) int {
+	return len(matchedSkills(u, skills))
+}
+
+// matchedSkills returns the skills u has, once each, in their order.
+func matchedSkills(u domain.User, skills []string) []string {
+	var matched []string
+	for i, s := range skills {
+		if slices.Contains(u.Skills, s) && !slices.Contains(skills[:i], s) {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ConfigChange is a config value that changed on reload.
+type ConfigChange struct {
+	Key string
+	Old string
+	New string
+}
+
+// ConfigWatcher applies changes of the config file to the running service.
+// The file is polled rather than watched for events: mounted config maps are
+// swapped through symlinks, which file events miss.
+type ConfigWatcher struct {
+	load  func() (map[string]string, error)
+	apply func(values map[string]string) error
+
+	mu      sync.Mutex
+	current map[string]string
+	// rejected are the last values that failed to apply, so a broken file is
+	// reported once rather than on every poll.
+	rejected map[string]string
+	log      *slog.Logger
+}
+
+// NewConfigWatcher returns a watcher of the values load reads, starting from
+// the current values the service runs with. apply validates the values as a
+// whole and applies them only if all are valid.
+func NewConfigWatcher(
+	load func() (map[string]string, error),
+	apply func(values map[string]string) error,
+	current map[string]string,
+	log *slog.Logger,
+) *ConfigWatcher {
+	return &ConfigWatcher{
+		load:    load,
+		apply:   apply,
+		current: current,
+		log:     log,
+	}
+}
+
+// Reload reads the config and applies it if any value changed. Each change
+// is logged as a config.changed audit event.
+func (w *ConfigWatcher) Reload(ctx context.Context) ([]ConfigChange, error) {
+	values, err := w.load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	changes := configChanges(w.current, values)
+	if len(changes) == 0 || w.rejected != nil && maps.Equal(values, w.rejected) {
+		return nil, nil
+	}
+	if err := w.apply(values); err != nil {
+		w.rejected = values
+		return nil, fmt.Errorf("config was not applied: %w", err)
+	}
+	w.current, w.rejected = values, nil
+
+	for _, c := range changes {
+		w.log.InfoContext(ctx, "config changed", "event", "config.changed", "key", c.Key, "old", c.Old, "new", c.New)
+	}
+	return changes, nil
+}
+
+// Run reloads the config every interval until ctx is cancelled.
+func (w *ConfigWatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := w.Reload(ctx); err != nil && ctx.Err() == nil {
+			w.log.ErrorContext(ctx, "config reload failed", "event", "config.reload_failed", "error", err)
+		}
+	}
+}
+
+// configChanges lists the keys whose values differ, in key order. A missing
+// key has an empty value.
+func configChanges(old, values map[string]string) []ConfigChange {
+	keys := slices.Sorted(maps.Keys(old))
+	for key := range values {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []ConfigChange
+	for _, key := range keys {
+		if old[key] != values[key] {
+			changes = append(changes, ConfigChange{Key: key, Old: old[key], New: values[key]})
+		}
+	}
+	return changes
+}
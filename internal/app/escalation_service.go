@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const stalledPRBatchSize = 100
+
+// EscalationService applies team escalation policies to PRs that get no
+// reviewer activity: first the team lead is notified, later an extra reviewer
+// is added. Each step is taken at most once per PR.
+type EscalationService struct {
+	prRepo    domain.PullRequestRepository
+	prSvc     *PullRequestService
+	notifySvc *NotificationService
+	tx        domain.UnitOfWork
+	log       *slog.Logger
+}
+
+func NewEscalationService(
+	prRepo domain.PullRequestRepository,
+	prSvc *PullRequestService,
+	notifySvc *NotificationService,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *EscalationService {
+	return &EscalationService{
+		prRepo:    prRepo,
+		prSvc:     prSvc,
+		notifySvc: notifySvc,
+		tx:        tx,
+		log:       log,
+	}
+}
+
+// Escalate takes the escalation steps that are due and returns how many were
+// taken. A PR that fails to escalate is logged and retried on the next run.
+func (s *EscalationService) Escalate(ctx context.Context) (int, error) {
+	stalled, err := s.prRepo.ListStalledPRs(ctx, stalledPRBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	steps := 0
+	for i := range stalled {
+		pr := &stalled[i]
+		waiting := now.Sub(pr.WaitingSince)
+
+		if hours := pr.Policy.NotifyLeadAfterHours; hours > 0 && !pr.LeadNotified && waiting >= pr.Priority.EscalationDelay(hours) {
+			notified, err := s.notifyLead(ctx, pr, waiting)
+			if err != nil {
+				s.log.WarnContext(ctx, "failed to escalate stalled PR", "pr_id", pr.PRID, "error", err)
+				continue
+			}
+			if notified {
+				steps++
+			}
+		}
+
+		if hours := pr.Policy.AddReviewerAfterHours; hours > 0 && !pr.ReviewerEscalated && waiting >= pr.Priority.EscalationDelay(hours) {
+			if _, err := s.prSvc.AddEscalationReviewer(ctx, pr.PRID); err != nil {
+				s.log.WarnContext(ctx, "failed to escalate stalled PR", "pr_id", pr.PRID, "error", err)
+				continue
+			}
+			steps++
+		}
+	}
+	return steps, nil
+}
+
+func (s *EscalationService) notifyLead(ctx context.Context, pr *domain.StalledPR, waiting time.Duration) (bool, error) {
+	var first bool
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		first, err = s.prRepo.MarkLeadNotified(ctx, tx, pr.PRID)
+		return err
+	})
+	if err != nil || !first {
+		return false, err
+	}
+
+	if pr.Policy.LeadUserID == "" {
+		s.log.WarnContext(ctx, "stalled PR has no team lead to notify", "pr_id", pr.PRID, "team_id", pr.TeamID)
+		return false, nil
+	}
+	s.log.InfoContext(ctx, "notifying team lead about stalled PR", "event", "pr.stalled", "pr_id", pr.PRID, "lead_id", pr.Policy.LeadUserID)
+	n := &domain.Notification{
+		UserID:  pr.Policy.LeadUserID,
+		Event:   domain.EventPRStalled,
+		PRID:    pr.PRID,
+		Message: fmt.Sprintf("PR %q (%s) has had no reviewer activity for %s", pr.PRName, pr.PRID, waiting.Round(time.Hour)),
+	}
+	if err := s.notifySvc.Notify(ctx, n); err != nil {
+		s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "pr_id", pr.PRID, "error", err)
+	}
+	return true, nil
+}
+
+// Run escalates stalled PRs every interval until ctx is cancelled.
+func (s *EscalationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Escalate(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "escalation run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/internal/storage/memory"
+)
+
+// TestNotifyLeadOnce checks that a PR seen as stalled by two overlapping
+// sweeps notifies the team lead only once.
+func TestNotifyLeadOnce(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.DiscardHandler)
+	store := memory.NewStore()
+	uow := NewTimeoutUnitOfWork(store, 5*time.Second)
+	settingsSvc := NewSettingsService(store, store, uow, DefaultSettings(), log)
+	prSvc := NewPullRequestService(store, store, store, store, store, uow, nil, nil, nil, settingsSvc, 0, "", log)
+	notifySvc := NewNotificationService(store, store, store, store, settingsSvc, NotificationRetry{MaxAttempts: 1, BaseDelay: time.Minute}, log)
+	teamSvc := NewTeamService(store, store, store, prSvc, uow, log)
+
+	team, err := teamSvc.CreateTeam(ctx, "escalation", []string{"lead", "author"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lead, author := team.Members[0].ID, team.Members[1].ID
+	pr, err := prSvc.CreatePR(ctx, CreatePRInput{Name: "feat: stalled", AuthorID: author})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := NewEscalationService(store, prSvc, notifySvc, uow, log)
+	// Both sweeps listed the PR before either of them marked it
+	stalled := domain.StalledPR{
+		PRID:         pr.ID,
+		PRName:       pr.Name,
+		AuthorID:     author,
+		Priority:     domain.PriorityNormal,
+		TeamID:       team.ID,
+		Policy:       domain.EscalationPolicy{NotifyLeadAfterHours: 1, LeadUserID: lead},
+		WaitingSince: time.Now().Add(-2 * time.Hour),
+	}
+	var notified []bool
+	for range 2 {
+		sweep := stalled
+		ok, err := svc.notifyLead(ctx, &sweep, 2*time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		notified = append(notified, ok)
+	}
+	if !notified[0] || notified[1] {
+		t.Errorf("notified = %v, want [true false]", notified)
+	}
+
+	queued, err := store.ClaimDueNotifications(ctx, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var leadNotifications int
+	for _, n := range queued {
+		if n.UserID == lead && n.Event == domain.EventPRStalled {
+			leadNotifications++
+		}
+	}
+	if leadNotifications != 1 {
+		t.Errorf("lead got %d stalled PR notifications, want 1", leadNotifications)
+	}
+}
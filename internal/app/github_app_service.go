@@ -0,0 +1,608 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// Webhook payloads, reduced to the fields the service uses.
+type (
+	githubAccount struct {
+		Login string `json:"login"`
+	}
+	githubInstallation struct {
+		ID      int64         `json:"id"`
+		Account githubAccount `json:"account"`
+	}
+	githubRepository struct {
+		FullName string `json:"full_name"`
+	}
+	installationEvent struct {
+		Action       string             `json:"action"`
+		Installation githubInstallation `json:"installation"`
+		Repositories []githubRepository `json:"repositories"`
+	}
+	installationRepositoriesEvent struct {
+		Action              string             `json:"action"`
+		Installation        githubInstallation `json:"installation"`
+		RepositoriesAdded   []githubRepository `json:"repositories_added"`
+		RepositoriesRemoved []githubRepository `json:"repositories_removed"`
+	}
+	pullRequestEvent struct {
+		Action      string           `json:"action"`
+		Number      int              `json:"number"`
+		Repository  githubRepository `json:"repository"`
+		Sender      githubAccount    `json:"sender"`
+		PullRequest struct {
+			Title  string        `json:"title"`
+			Body   string        `json:"body"`
+			User   githubAccount `json:"user"`
+			Merged bool          `json:"merged"`
+			Draft  bool          `json:"draft"`
+			Labels []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+			// Sizes are nil when the payload does not carry them
+			Additions    *int `json:"additions"`
+			Deletions    *int `json:"deletions"`
+			ChangedFiles *int `json:"changed_files"`
+		} `json:"pull_request"`
+	}
+)
+
+// GitHubAppService handles the GitHub App side of the integration: installation
+// webhooks, repository-to-team mapping and pull request events of onboarded
+// repositories.
+type GitHubAppService struct {
+	githubRepo    domain.GitHubRepository
+	userRepo      domain.UserRepository
+	teamRepo      domain.TeamRepository
+	repoRepo      domain.RepositoryRepository
+	inboundRepo   domain.InboundWebhookRepository
+	prSvc         *PullRequestService
+	githubSvc     *GitHubService
+	tx            domain.UnitOfWork
+	webhookSecret string
+	replays       *replayGuard
+	log           *slog.Logger
+}
+
+func NewGitHubAppService(
+	githubRepo domain.GitHubRepository,
+	userRepo domain.UserRepository,
+	teamRepo domain.TeamRepository,
+	repoRepo domain.RepositoryRepository,
+	inboundRepo domain.InboundWebhookRepository,
+	prSvc *PullRequestService,
+	githubSvc *GitHubService,
+	tx domain.UnitOfWork,
+	webhookSecret string,
+	replayWindow time.Duration,
+	log *slog.Logger,
+) *GitHubAppService {
+	return &GitHubAppService{
+		githubRepo:    githubRepo,
+		userRepo:      userRepo,
+		teamRepo:      teamRepo,
+		repoRepo:      repoRepo,
+		inboundRepo:   inboundRepo,
+		prSvc:         prSvc,
+		githubSvc:     githubSvc,
+		tx:            tx,
+		webhookSecret: webhookSecret,
+		replays:       newReplayGuard(replayWindow),
+		log:           log,
+	}
+}
+
+// HandleWebhook verifies the X-Hub-Signature-256 of a delivery and applies it.
+// Events and actions the service does not care about are accepted and ignored.
+// Deliveries signed with the ingestion token of their repository instead of
+// the GitHub App secret may only carry pull request events, which create PRs
+// in the token's team alone. A delivery accepted within the replay window is
+// refused if sent again; one that failed can be redelivered. Every verified
+// delivery is recorded with the outcome of processing it.
+func (s *GitHubAppService) HandleWebhook(ctx context.Context, event, deliveryID, signature string, payload []byte) error {
+	token, err := s.authenticate(ctx, signature, payload)
+	if err != nil {
+		return err
+	}
+	if !s.replays.reserve(signature) {
+		return fmt.Errorf("%w: webhook delivery was already received", domain.ErrUnauthorized)
+	}
+	err = s.applyWebhook(ctx, event, payload, token)
+
+	w := &domain.InboundWebhook{Source: "github", Event: event, DeliveryID: deliveryID, Payload: string(payload), Status: domain.InboundWebhookProcessed}
+	if token != nil {
+		w.IngestionToken = token.Repository
+	}
+	if err != nil {
+		w.Status, w.Error = domain.InboundWebhookFailed, err.Error()
+	}
+	if _, recErr := s.inboundRepo.CreateInboundWebhook(ctx, w); recErr != nil {
+		s.log.WarnContext(ctx, "failed to record inbound webhook", "github_event", event, "delivery_id", deliveryID, "error", recErr)
+	}
+
+	if err != nil {
+		s.replays.release(signature)
+		return err
+	}
+	return nil
+}
+
+// ListInboundWebhooks returns the received deliveries, newest first.
+func (s *GitHubAppService) ListInboundWebhooks(ctx context.Context, filter domain.InboundWebhookFilter) ([]domain.InboundWebhook, error) {
+	switch filter.Status {
+	case "", domain.InboundWebhookProcessed, domain.InboundWebhookFailed:
+	default:
+		return nil, fmt.Errorf("%w: unknown webhook status %q", domain.ErrValidation, filter.Status)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+	return s.inboundRepo.ListInboundWebhooks(ctx, filter)
+}
+
+// ReprocessInboundWebhook applies the payload of a failed delivery again, for
+// example after a fix, and returns it with the new outcome. The signature is
+// not checked again, but a delivery verified by an ingestion token fails if
+// the token was revoked meanwhile.
+func (s *GitHubAppService) ReprocessInboundWebhook(ctx context.Context, id int64) (*domain.InboundWebhook, error) {
+	w, err := s.inboundRepo.GetInboundWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w.Status != domain.InboundWebhookFailed {
+		return nil, fmt.Errorf("%w: webhook %d did not fail", domain.ErrValidation, id)
+	}
+
+	var token *domain.GitHubIngestionToken
+	if w.IngestionToken != "" {
+		token, err = s.githubRepo.GetGitHubIngestionToken(ctx, w.IngestionToken)
+		if errors.Is(err, domain.ErrNotFound) {
+			err = fmt.Errorf("%w: the ingestion token of %s was revoked", domain.ErrUnauthorized, w.IngestionToken)
+		}
+	}
+	if err == nil {
+		err = s.applyWebhook(ctx, w.Event, []byte(w.Payload), token)
+	}
+	status, errMsg := domain.InboundWebhookProcessed, ""
+	if err != nil {
+		status, errMsg = domain.InboundWebhookFailed, err.Error()
+	}
+
+	w, err = s.inboundRepo.UpdateInboundWebhookResult(ctx, id, status, errMsg)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "inbound webhook reprocessed",
+		"event", "github.webhook_reprocessed",
+		"webhook_id", id,
+		"github_event", w.Event,
+		"failed", w.Status == domain.InboundWebhookFailed,
+	)
+	return w, nil
+}
+
+func (s *GitHubAppService) applyWebhook(ctx context.Context, event string, payload []byte, token *domain.GitHubIngestionToken) error {
+	var err error
+	switch event {
+	case "installation":
+		if token != nil {
+			return fmt.Errorf("%w: ingestion tokens only accept pull request events", domain.ErrUnauthorized)
+		}
+		var e installationEvent
+		if err = json.Unmarshal(payload, &e); err == nil {
+			err = s.handleInstallation(ctx, &e)
+		}
+	case "installation_repositories":
+		if token != nil {
+			return fmt.Errorf("%w: ingestion tokens only accept pull request events", domain.ErrUnauthorized)
+		}
+		var e installationRepositoriesEvent
+		if err = json.Unmarshal(payload, &e); err == nil {
+			err = s.handleInstallationRepositories(ctx, &e)
+		}
+	case "pull_request":
+		var e pullRequestEvent
+		if err = json.Unmarshal(payload, &e); err == nil {
+			err = s.handlePullRequest(ctx, &e, token)
+		}
+	default:
+		s.log.DebugContext(ctx, "ignoring GitHub event", "event", event)
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+		return fmt.Errorf("%w: malformed %s payload: %v", domain.ErrValidation, event, err)
+	}
+	return err
+}
+
+// authenticate checks the signature against the GitHub App secret, then
+// against the ingestion token of the repository named in the payload. It
+// returns the token when the delivery was signed with one.
+func (s *GitHubAppService) authenticate(ctx context.Context, signature string, payload []byte) (*domain.GitHubIngestionToken, error) {
+	if s.webhookSecret != "" && verifySignature(s.webhookSecret, signature, payload) == nil {
+		return nil, nil
+	}
+
+	var delivery struct {
+		Repository githubRepository `json:"repository"`
+	}
+	if json.Unmarshal(payload, &delivery) == nil && delivery.Repository.FullName != "" {
+		token, err := s.githubRepo.GetGitHubIngestionToken(ctx, delivery.Repository.FullName)
+		switch {
+		case err == nil:
+			if err := verifySignature(token.Secret, signature, payload); err != nil {
+				return nil, err
+			}
+			if err := s.githubRepo.TouchGitHubIngestionToken(ctx, token.Repository); err != nil {
+				s.log.WarnContext(ctx, "failed to record ingestion token use", "repository", token.Repository, "error", err)
+			}
+			return token, nil
+		case !errors.Is(err, domain.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	if s.webhookSecret == "" {
+		return nil, fmt.Errorf("%w: GitHub webhook secret is not configured", domain.ErrUnauthorized)
+	}
+	return nil, verifySignature(s.webhookSecret, signature, payload)
+}
+
+func verifySignature(secret, signature string, payload []byte) error {
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil || !strings.HasPrefix(signature, "sha256=") {
+		return fmt.Errorf("%w: malformed webhook signature", domain.ErrUnauthorized)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("%w: webhook signature mismatch", domain.ErrUnauthorized)
+	}
+	return nil
+}
+
+func (s *GitHubAppService) handleInstallation(ctx context.Context, e *installationEvent) error {
+	switch e.Action {
+	case "created", "unsuspend", "new_permissions_accepted":
+		return s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+			if err := s.upsertInstallation(ctx, tx, e.Installation); err != nil {
+				return err
+			}
+			return s.githubRepo.AddGitHubRepos(ctx, tx, e.Installation.ID, repositoryNames(e.Repositories))
+		})
+	case "deleted", "suspend":
+		// Repositories and their team mappings go with the installation; linked PRs stay
+		if err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+			return s.githubRepo.DeleteGitHubInstallation(ctx, tx, e.Installation.ID)
+		}); err != nil {
+			return err
+		}
+		s.log.InfoContext(ctx, "GitHub App uninstalled", "installation_id", e.Installation.ID, "account", e.Installation.Account.Login)
+	}
+	return nil
+}
+
+func (s *GitHubAppService) handleInstallationRepositories(ctx context.Context, e *installationRepositoriesEvent) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.upsertInstallation(ctx, tx, e.Installation); err != nil {
+			return err
+		}
+		if err := s.githubRepo.AddGitHubRepos(ctx, tx, e.Installation.ID, repositoryNames(e.RepositoriesAdded)); err != nil {
+			return err
+		}
+		return s.githubRepo.RemoveGitHubRepos(ctx, tx, repositoryNames(e.RepositoriesRemoved))
+	})
+}
+
+func (s *GitHubAppService) upsertInstallation(ctx context.Context, tx domain.Tx, inst githubInstallation) error {
+	if inst.ID == 0 || inst.Account.Login == "" {
+		return fmt.Errorf("%w: installation id and account are required", domain.ErrValidation)
+	}
+	s.log.InfoContext(ctx, "GitHub App installation updated", "installation_id", inst.ID, "account", inst.Account.Login)
+	return s.githubRepo.UpsertGitHubInstallation(ctx, tx, &domain.GitHubInstallation{ID: inst.ID, AccountLogin: inst.Account.Login})
+}
+
+// handlePullRequest applies a pull request event. token is the ingestion
+// token the delivery was signed with, nil for the GitHub App.
+func (s *GitHubAppService) handlePullRequest(ctx context.Context, e *pullRequestEvent, token *domain.GitHubIngestionToken) error {
+	// The webhook is signed by GitHub, so its sender is trusted as the actor
+	// unless the caller named one.
+	if domain.ActorFromContext(ctx) == "" && e.Sender.Login != "" {
+		ctx = domain.WithActor(ctx, "github:"+e.Sender.Login)
+	}
+	switch e.Action {
+	case "opened", "reopened":
+		return s.importPullRequest(ctx, e, token)
+	case "ready_for_review":
+		return s.pullRequestReady(ctx, e, token)
+	case "closed":
+		return s.pullRequestClosed(ctx, e)
+	}
+	return nil
+}
+
+// pullRequestReady marks the linked PR ready for review, importing the pull
+// request when it is not linked yet.
+func (s *GitHubAppService) pullRequestReady(ctx context.Context, e *pullRequestEvent, token *domain.GitHubIngestionToken) error {
+	link, err := s.githubRepo.GetGitHubPRLinkByNumber(ctx, e.Repository.FullName, e.Number)
+	if errors.Is(err, domain.ErrNotFound) {
+		return s.importPullRequest(ctx, e, token)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = s.prSvc.MarkReady(ctx, link.PRID)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return nil
+	}
+	return err
+}
+
+// pullRequestClosed merges or closes the linked PR as it was on GitHub.
+func (s *GitHubAppService) pullRequestClosed(ctx context.Context, e *pullRequestEvent) error {
+	link, err := s.githubRepo.GetGitHubPRLinkByNumber(ctx, e.Repository.FullName, e.Number)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if e.PullRequest.Merged {
+		return s.mergeLinkedPR(ctx, link.PRID)
+	}
+	_, err = s.prSvc.ClosePR(ctx, link.PRID)
+	if errors.Is(err, domain.ErrPRMerged) || errors.Is(err, domain.ErrPRClosed) {
+		return nil
+	}
+	return err
+}
+
+// mergeLinkedPR merges a PR merged on GitHub. GitHub has the last word, so
+// a PR that could not be merged here is only logged.
+func (s *GitHubAppService) mergeLinkedPR(ctx context.Context, prID string) error {
+	_, err := s.prSvc.MergePR(ctx, prID, "")
+	switch {
+	case errors.Is(err, domain.ErrPRMerged), errors.Is(err, domain.ErrPRClosed):
+		return nil
+	case errors.Is(err, domain.ErrInvalidTransition):
+		s.log.WarnContext(ctx, "draft PR merged on GitHub", "pr_id", prID)
+		return nil
+	case errors.Is(err, domain.ErrReviewRequirementsNotMet):
+		s.log.WarnContext(ctx, "PR merged on GitHub without meeting review requirements", "pr_id", prID, "error", err)
+		return nil
+	}
+	return err
+}
+
+// importPullRequest creates a PR for a pull request opened in a repository
+// mapped to a team, assigns reviewers and requests their reviews on GitHub.
+// Draft pull requests are imported as drafts without reviewers. With an
+// ingestion token the repository belongs to the token's team, whose members
+// alone may author the PR.
+func (s *GitHubAppService) importPullRequest(ctx context.Context, e *pullRequestEvent, token *domain.GitHubIngestionToken) error {
+	repository := e.Repository.FullName
+	teamID, ok, err := s.importTeam(ctx, repository, token)
+	if err != nil || !ok {
+		return err
+	}
+
+	if _, err := s.githubRepo.GetGitHubPRLinkByNumber(ctx, repository, e.Number); err == nil {
+		return nil
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	// A repository configured under the same name drives reviewer assignment.
+	var configured string
+	if _, err := s.repoRepo.GetRepository(ctx, repository); err == nil {
+		configured = repository
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	author, err := s.userForLogin(ctx, e.PullRequest.User.Login, teamID)
+	if err != nil {
+		return err
+	}
+	if token != nil && author.TeamID != teamID {
+		return fmt.Errorf("%w: the ingestion token of %s belongs to team '%s', not to the team of author %s",
+			domain.ErrUnauthorized, repository, token.TeamName, domain.PII(e.PullRequest.User.Login))
+	}
+	// The PR is already open on GitHub, so the author's quota cannot block it.
+	pr, err := s.prSvc.createPR(ctx, createPRInputFromEvent(e, author.ID, configured), false)
+	if err != nil {
+		return err
+	}
+	if _, err := s.githubSvc.LinkPR(ctx, pr.ID, repository, e.Number); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "imported pull request from GitHub", "pr_id", pr.ID, "repository", repository, "number", e.Number)
+	return nil
+}
+
+// importTeam returns the team pull requests of the repository are imported
+// into: the team of the ingestion token, or the team the repository is mapped
+// to. ok is false when the repository is not mapped to any.
+func (s *GitHubAppService) importTeam(ctx context.Context, repository string, token *domain.GitHubIngestionToken) (teamID int32, ok bool, err error) {
+	if token != nil {
+		return token.TeamID, true, nil
+	}
+	repo, err := s.githubRepo.GetGitHubRepo(ctx, repository)
+	if errors.Is(err, domain.ErrNotFound) || (err == nil && repo.TeamID == nil) {
+		s.log.InfoContext(ctx, "ignoring pull request of a repository not mapped to a team", "repository", repository)
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return *repo.TeamID, true, nil
+}
+
+// createPRInputFromEvent converts the pull request of an event by authorID
+// to the input of a new PR in the configured repository, if any.
+func createPRInputFromEvent(e *pullRequestEvent, authorID, configured string) CreatePRInput {
+	size := domain.PRSize{FilesChanged: e.PullRequest.ChangedFiles}
+	if e.PullRequest.Additions != nil && e.PullRequest.Deletions != nil {
+		lines := *e.PullRequest.Additions + *e.PullRequest.Deletions
+		size.LinesChanged = &lines
+	}
+	labels := make([]string, len(e.PullRequest.Labels))
+	for i, label := range e.PullRequest.Labels {
+		labels[i] = label.Name
+	}
+	return CreatePRInput{
+		Name:        e.PullRequest.Title,
+		Description: e.PullRequest.Body,
+		AuthorID:    authorID,
+		Repository:  configured,
+		Labels:      labels,
+		Priority:    domain.PriorityNormal,
+		Size:        size,
+		Draft:       e.PullRequest.Draft,
+	}
+}
+
+// userForLogin finds the user linked to a GitHub login, creating one in the
+// given team when the login is new.
+func (s *GitHubAppService) userForLogin(ctx context.Context, login string, teamID int32) (*domain.User, error) {
+	accounts, err := s.githubRepo.GetGitHubAccountsByLogins(ctx, []string{login})
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) > 0 {
+		return s.userRepo.GetUserByID(ctx, accounts[0].UserID)
+	}
+
+	var user *domain.User
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		created, err := s.userRepo.CreateUser(ctx, tx, &domain.User{ID: uuid.New().String(), Username: login, TeamID: teamID, IsActive: true})
+		if err != nil {
+			return fmt.Errorf("failed to provision user for GitHub login %s: %w", domain.PII(login), err)
+		}
+		user = created
+		_, err = s.githubRepo.SetGitHubLogin(ctx, tx, user.ID, login)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+	s.log.InfoContext(ctx, "provisioned user for GitHub login", "user_id", user.ID, "login", login, "team_id", teamID)
+	return user, nil
+}
+
+// SetRepoTeam onboards a repository: its new pull requests get reviewers from teamName.
+func (s *GitHubAppService) SetRepoTeam(ctx context.Context, repository, teamName string) (*domain.GitHubRepo, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.githubRepo.SetGitHubRepoTeam(ctx, tx, repository, team.ID)
+	}); err != nil {
+		return nil, err
+	}
+	return s.githubRepo.GetGitHubRepo(ctx, repository)
+}
+
+func (s *GitHubAppService) ListRepos(ctx context.Context) ([]domain.GitHubRepo, error) {
+	return s.githubRepo.ListGitHubRepos(ctx)
+}
+
+// CreateIngestionToken generates the webhook secret of a repository posting
+// to the shared endpoint without the GitHub App, replacing the team's previous
+// secret. The secret is only returned here. A repository has a secret of one
+// team at most.
+func (s *GitHubAppService) CreateIngestionToken(ctx context.Context, repository, teamName string) (*domain.GitHubIngestionToken, error) {
+	if !strings.Contains(strings.Trim(repository, "/"), "/") {
+		return nil, fmt.Errorf("%w: repository must be owner/name", domain.ErrValidation)
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	var token *domain.GitHubIngestionToken
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		token, err = s.githubRepo.SetGitHubIngestionToken(ctx, tx, &domain.GitHubIngestionToken{
+			Repository: repository,
+			TeamID:     team.ID,
+			TeamName:   team.TeamName,
+			Secret:     hex.EncodeToString(secret),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "created ingestion token", "event", "github.ingestion_token_created", "repository", repository, "team_name", team.TeamName)
+	return token, nil
+}
+
+// ListIngestionTokens lists the ingestion tokens without their secrets, only
+// the team's when teamName is set.
+func (s *GitHubAppService) ListIngestionTokens(ctx context.Context, teamName string) ([]domain.GitHubIngestionToken, error) {
+	if teamName != "" {
+		if _, err := s.teamRepo.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	tokens, err := s.githubRepo.ListGitHubIngestionTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if teamName != "" {
+		tokens = slices.DeleteFunc(tokens, func(t domain.GitHubIngestionToken) bool { return t.TeamName != teamName })
+	}
+	return tokens, nil
+}
+
+// RevokeIngestionToken deletes the secret of a repository; its deliveries are
+// rejected from then on unless the GitHub App signs them.
+func (s *GitHubAppService) RevokeIngestionToken(ctx context.Context, repository string) error {
+	if err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.githubRepo.DeleteGitHubIngestionToken(ctx, tx, repository)
+	}); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "revoked ingestion token", "event", "github.ingestion_token_revoked", "repository", repository)
+	return nil
+}
+
+func repositoryNames(repos []githubRepository) []string {
+	names := make([]string, len(repos))
+	for i, r := range repos {
+		names[i] = r.FullName
+	}
+	return names
+}
@@ -0,0 +1,220 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// githubSyncTimeout bounds a background sync so a slow GitHub API does not
+// pile up goroutines.
+const githubSyncTimeout = 30 * time.Second
+
+var (
+	githubLoginRe = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]{0,37}[A-Za-z0-9])?$`)
+	githubRepoRe  = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]{0,38})/[A-Za-z0-9._-]{1,100}$`)
+)
+
+// GitHubService mirrors reviewer assignments to GitHub review requests on
+// linked pull requests. The client is nil when the integration is disabled;
+// accounts and links can still be stored then.
+type GitHubService struct {
+	githubRepo domain.GitHubRepository
+	prRepo     domain.PullRequestRepository
+	client     domain.GitHubClient
+	tx         domain.UnitOfWork
+	log        *slog.Logger
+}
+
+func NewGitHubService(
+	githubRepo domain.GitHubRepository,
+	prRepo domain.PullRequestRepository,
+	client domain.GitHubClient,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *GitHubService {
+	return &GitHubService{
+		githubRepo: githubRepo,
+		prRepo:     prRepo,
+		client:     client,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+func (s *GitHubService) SetUserLogin(ctx context.Context, userID, login string) (*domain.GitHubAccount, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
+	}
+	if !githubLoginRe.MatchString(login) {
+		return nil, fmt.Errorf("%w: invalid GitHub login %q", domain.ErrValidation, domain.PII(login))
+	}
+
+	var account *domain.GitHubAccount
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		account, err = s.githubRepo.SetGitHubLogin(ctx, tx, userID, login)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// LinkPR ties a PR to a GitHub pull request and pushes its current reviewers
+// there. A failed push does not undo the link; it is reported in SyncError.
+func (s *GitHubService) LinkPR(ctx context.Context, prID, repository string, number int) (*domain.GitHubPRLink, error) {
+	if !githubRepoRe.MatchString(repository) {
+		return nil, fmt.Errorf("%w: repository must be in owner/name form", domain.ErrValidation)
+	}
+	if number <= 0 {
+		return nil, fmt.Errorf("%w: pull request number must be positive", domain.ErrValidation)
+	}
+	if _, err := s.prRepo.GetPRByID(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	var link *domain.GitHubPRLink
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		link, err = s.githubRepo.LinkGitHubPR(ctx, tx, &domain.GitHubPRLink{PRID: prID, Repository: repository, Number: number})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.SyncPR(ctx, prID); err != nil {
+		s.log.WarnContext(ctx, "failed to sync reviewers to GitHub", "pr_id", prID, "error", err)
+		link.SyncError = err.Error()
+	}
+	return link, nil
+}
+
+// ReviewersChanged syncs the PR in the background so GitHub latency and
+// outages never fail the request that changed the reviewers.
+func (s *GitHubService) ReviewersChanged(ctx context.Context, prID string, _ []string) {
+	if s.client == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), githubSyncTimeout)
+		defer cancel()
+		if err := s.SyncPR(ctx, prID); err != nil {
+			s.log.WarnContext(ctx, "failed to sync reviewers to GitHub", "pr_id", prID, "error", err)
+		}
+	}()
+}
+
+// SyncPR makes the pending review requests on the linked GitHub PR match the
+// PR's reviewers. Requests for GitHub users unknown to the service are left
+// alone; reviewers without a linked login are skipped.
+func (s *GitHubService) SyncPR(ctx context.Context, prID string) error {
+	if s.client == nil {
+		return nil
+	}
+	link, err := s.openPRLink(ctx, prID)
+	if err != nil || link == nil {
+		return err
+	}
+
+	want, err := s.reviewerLogins(ctx, prID)
+	if err != nil {
+		return err
+	}
+	requested, err := s.client.ListRequestedReviewers(ctx, link.Repository, link.Number)
+	if err != nil {
+		return err
+	}
+	known, err := s.githubRepo.GetGitHubAccountsByLogins(ctx, requested)
+	if err != nil {
+		return err
+	}
+
+	toAdd, toRemove := reviewRequestChanges(want, requested, known)
+	if err := s.applyReviewRequests(ctx, link, toAdd, toRemove); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "synced reviewers to GitHub", "pr_id", prID, "repository", link.Repository, "number", link.Number, "requested", len(toAdd), "removed", len(toRemove))
+	return nil
+}
+
+// openPRLink returns the GitHub PR linked to the PR, nil when there is none or
+// the PR is no longer open.
+func (s *GitHubService) openPRLink(ctx context.Context, prID string) (*domain.GitHubPRLink, error) {
+	link, err := s.githubRepo.GetGitHubPRLink(ctx, prID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil || !pr.IsOpen() {
+		return nil, err
+	}
+	return link, nil
+}
+
+// reviewRequestChanges compares the wanted logins, keyed by their lower-cased
+// form, with the requested ones: known accounts that are requested but not
+// wanted are removed, wanted logins that are not requested are added.
+func reviewRequestChanges(want map[string]string, requested []string, known []domain.GitHubAccount) (toAdd, toRemove []string) {
+	for _, a := range known {
+		if _, ok := want[strings.ToLower(a.Login)]; !ok {
+			toRemove = append(toRemove, a.Login)
+		}
+	}
+	pending := maps.Clone(want)
+	for _, l := range requested {
+		delete(pending, strings.ToLower(l))
+	}
+	toAdd = make([]string, 0, len(pending))
+	for _, l := range pending {
+		toAdd = append(toAdd, l)
+	}
+	return toAdd, toRemove
+}
+
+// applyReviewRequests removes and adds the review requests on GitHub.
+func (s *GitHubService) applyReviewRequests(ctx context.Context, link *domain.GitHubPRLink, toAdd, toRemove []string) error {
+	if len(toRemove) > 0 {
+		if err := s.client.RemoveReviewRequests(ctx, link.Repository, link.Number, toRemove); err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		return s.client.RequestReviewers(ctx, link.Repository, link.Number, toAdd)
+	}
+	return nil
+}
+
+// reviewerLogins returns the GitHub logins of the PR's reviewers keyed by their
+// lower-cased form.
+func (s *GitHubService) reviewerLogins(ctx context.Context, prID string) (map[string]string, error) {
+	reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := s.githubRepo.GetGitHubAccountsByUserIDs(ctx, currentReviewersToIDs(reviewers))
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) < len(reviewers) {
+		s.log.WarnContext(ctx, "some reviewers have no GitHub login", "pr_id", prID, "reviewers", len(reviewers), "linked", len(accounts))
+	}
+
+	logins := make(map[string]string, len(accounts))
+	for _, a := range accounts {
+		logins[strings.ToLower(a.Login)] = a.Login
+	}
+	return logins, nil
+}
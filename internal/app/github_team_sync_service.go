@@ -0,0 +1,372 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// GitHubTeamSyncService keeps internal teams in line with the teams of a
+// GitHub organization: teams missing internally are created, members are
+// created or moved into them and members who left every GitHub team are
+// deactivated. Users are matched by their linked GitHub login; memberships
+// that cannot be applied are reported as conflicts instead.
+type GitHubTeamSyncService struct {
+	githubRepo domain.GitHubRepository
+	userRepo   domain.UserRepository
+	teamRepo   domain.TeamRepository
+	userSvc    *UserService
+	teamSvc    *TeamService
+	directory  domain.GitHubDirectory
+	org        string
+	tx         domain.UnitOfWork
+	log        *slog.Logger
+
+	// mu keeps scheduled and manual runs from overlapping.
+	mu sync.Mutex
+}
+
+// NewGitHubTeamSyncService creates the service. The sync is disabled when
+// directory is nil or org is empty.
+func NewGitHubTeamSyncService(
+	githubRepo domain.GitHubRepository,
+	userRepo domain.UserRepository,
+	teamRepo domain.TeamRepository,
+	userSvc *UserService,
+	teamSvc *TeamService,
+	directory domain.GitHubDirectory,
+	org string,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *GitHubTeamSyncService {
+	return &GitHubTeamSyncService{
+		githubRepo: githubRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		userSvc:    userSvc,
+		teamSvc:    teamSvc,
+		directory:  directory,
+		org:        org,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+func (s *GitHubTeamSyncService) Enabled() bool {
+	return s.directory != nil && s.org != ""
+}
+
+// Run syncs the teams every interval until ctx is done.
+func (s *GitHubTeamSyncService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sync(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "GitHub teams sync failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *GitHubTeamSyncService) LatestReport(ctx context.Context) (*domain.GitHubTeamSyncReport, error) {
+	return s.githubRepo.GetLatestGitHubTeamSyncReport(ctx)
+}
+
+// githubTeamRoster is a GitHub team with its members and the internal team
+// it is synced to.
+type githubTeamRoster struct {
+	github  domain.GitHubTeam
+	team    *domain.Team
+	members []string
+}
+
+// Sync runs the sync once and stores its report. A failure to read the
+// organization is recorded in the report's Error rather than returned.
+func (s *GitHubTeamSyncService) Sync(ctx context.Context) (*domain.GitHubTeamSyncReport, error) {
+	if !s.Enabled() {
+		return nil, fmt.Errorf("%w: GitHub teams sync is not configured", domain.ErrValidation)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := &domain.GitHubTeamSyncReport{Org: s.org, StartedAt: time.Now()}
+	rosters, err := s.readOrg(ctx)
+	if err != nil {
+		report.Error = err.Error()
+		s.log.WarnContext(ctx, "failed to read GitHub organization teams", "org", s.org, "error", err)
+	} else if err := s.apply(ctx, rosters, report); err != nil {
+		return nil, err
+	}
+	report.FinishedAt = time.Now()
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.githubRepo.SaveGitHubTeamSyncReport(ctx, tx, report)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "GitHub teams synced",
+		"event", "github.teams_synced",
+		"org", s.org,
+		"teams_created", report.TeamsCreated,
+		"users_created", report.UsersCreated,
+		"users_moved", report.UsersMoved,
+		"users_deactivated", report.UsersDeactivated,
+		"conflicts", len(report.Conflicts),
+	)
+	return report, nil
+}
+
+func (s *GitHubTeamSyncService) readOrg(ctx context.Context) ([]githubTeamRoster, error) {
+	teams, err := s.directory.ListOrgTeams(ctx, s.org)
+	if err != nil {
+		return nil, err
+	}
+	rosters := make([]githubTeamRoster, len(teams))
+	for i, t := range teams {
+		members, err := s.directory.ListTeamMembers(ctx, s.org, t.Slug)
+		if err != nil {
+			return nil, err
+		}
+		rosters[i] = githubTeamRoster{github: t, members: members}
+	}
+	return rosters, nil
+}
+
+func (s *GitHubTeamSyncService) apply(ctx context.Context, rosters []githubTeamRoster, report *domain.GitHubTeamSyncReport) error {
+	conflict := func(reason domain.GitHubTeamSyncConflictReason, login, userID, teamName, detail string) {
+		report.Conflicts = append(report.Conflicts, domain.GitHubTeamSyncConflict{
+			Reason: reason, Login: login, UserID: userID, TeamName: teamName, Detail: detail,
+		})
+	}
+
+	if err := s.resolveTeams(ctx, rosters, report, conflict); err != nil {
+		return err
+	}
+
+	// Each login goes to one team: the one of its GitHub teams the user is in
+	// already, or else the first.
+	targets := make(map[string][]*domain.Team)
+	logins := make(map[string]string)
+	for _, r := range rosters {
+		for _, login := range r.members {
+			key := strings.ToLower(login)
+			logins[key] = login
+			if r.team != nil {
+				targets[key] = append(targets[key], r.team)
+			}
+		}
+	}
+	keys := make([]string, 0, len(logins))
+	for key := range logins {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	accounts, err := s.githubRepo.GetGitHubAccountsByLogins(ctx, keys)
+	if err != nil {
+		return err
+	}
+	userByLogin := make(map[string]string, len(accounts))
+	for _, a := range accounts {
+		userByLogin[strings.ToLower(a.Login)] = a.UserID
+	}
+
+	for _, key := range keys {
+		teams := targets[key]
+		if len(teams) == 0 {
+			continue
+		}
+		login := logins[key]
+		if err := s.syncMember(ctx, login, userByLogin[key], teams, report, conflict); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range rosters {
+		if r.team == nil {
+			continue
+		}
+		if err := s.deactivateLeavers(ctx, r.team, logins, report, conflict); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveTeams finds or creates the internal team of every GitHub team: the
+// linked one, else an unlinked team of the same name, else a new one.
+func (s *GitHubTeamSyncService) resolveTeams(
+	ctx context.Context,
+	rosters []githubTeamRoster,
+	report *domain.GitHubTeamSyncReport,
+	conflict func(domain.GitHubTeamSyncConflictReason, string, string, string, string),
+) error {
+	links, err := s.githubRepo.ListGitHubTeamLinks(ctx, s.org)
+	if err != nil {
+		return err
+	}
+	linkedTeam := make(map[int64]int32, len(links))
+	linkedTeamIDs := make(map[int32]bool, len(links))
+	for _, l := range links {
+		linkedTeam[l.GitHubTeamID] = l.TeamID
+		linkedTeamIDs[l.TeamID] = true
+	}
+
+	for i := range rosters {
+		gh := rosters[i].github
+		var team *domain.Team
+		if teamID, ok := linkedTeam[gh.ID]; ok {
+			if team, err = s.teamRepo.GetTeamByID(ctx, teamID); err != nil {
+				return err
+			}
+		} else {
+			team, err = s.teamRepo.GetTeamByName(ctx, gh.Name)
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				if team, err = s.teamSvc.CreateTeam(ctx, gh.Name, nil, false); err != nil {
+					conflict(domain.SyncConflictFailed, "", "", gh.Name, err.Error())
+					continue
+				}
+				report.TeamsCreated++
+			case err != nil:
+				return err
+			case linkedTeamIDs[team.ID]:
+				conflict(domain.SyncConflictTeamNameTaken, "", "", gh.Name, "synced from another GitHub team")
+				continue
+			}
+		}
+
+		link := &domain.GitHubTeamLink{GitHubTeamID: gh.ID, Org: s.org, Slug: gh.Slug, TeamID: team.ID}
+		err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+			return s.githubRepo.LinkGitHubTeam(ctx, tx, link)
+		})
+		if err != nil {
+			return err
+		}
+		linkedTeamIDs[team.ID] = true
+
+		if !team.IsActive {
+			conflict(domain.SyncConflictTeamInactive, "", "", team.TeamName, "")
+			continue
+		}
+		rosters[i].team = team
+	}
+	return nil
+}
+
+// syncMember creates the user of a login or moves them into their team.
+func (s *GitHubTeamSyncService) syncMember(
+	ctx context.Context,
+	login, userID string,
+	teams []*domain.Team,
+	report *domain.GitHubTeamSyncReport,
+	conflict func(domain.GitHubTeamSyncConflictReason, string, string, string, string),
+) error {
+	if userID == "" {
+		team := teams[0]
+		if len(teams) > 1 {
+			conflict(domain.SyncConflictSeveralTeams, login, "", team.TeamName, "")
+		}
+		user, err := s.userSvc.AddUser(ctx, login, team.TeamName, true)
+		if errors.Is(err, domain.ErrUsernameExists) {
+			conflict(domain.SyncConflictUsernameTaken, login, "", team.TeamName, "")
+			return nil
+		}
+		if err != nil {
+			conflict(domain.SyncConflictFailed, login, "", team.TeamName, err.Error())
+			return nil
+		}
+		err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+			_, err := s.githubRepo.SetGitHubLogin(ctx, tx, user.ID, login)
+			return err
+		})
+		if err != nil {
+			conflict(domain.SyncConflictFailed, login, user.ID, team.TeamName, err.Error())
+			return nil
+		}
+		report.UsersCreated++
+		return nil
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	team := teams[0]
+	if i := slices.IndexFunc(teams, func(t *domain.Team) bool { return t.ID == user.TeamID }); i >= 0 {
+		team = teams[i]
+	}
+	if len(teams) > 1 {
+		conflict(domain.SyncConflictSeveralTeams, login, user.ID, team.TeamName, "")
+	}
+	if team.ID == user.TeamID {
+		return nil
+	}
+	if !user.IsActive {
+		conflict(domain.SyncConflictUserInactive, login, user.ID, team.TeamName, "")
+		return nil
+	}
+	if _, _, err := s.userSvc.MoveUserToTeam(ctx, user.ID, team.TeamName, domain.OpenReviewsReassign); err != nil {
+		conflict(domain.SyncConflictFailed, login, user.ID, team.TeamName, err.Error())
+		return nil
+	}
+	report.UsersMoved++
+	return nil
+}
+
+// deactivateLeavers deactivates the active members of a synced team whose
+// login is in none of the organization's teams.
+func (s *GitHubTeamSyncService) deactivateLeavers(
+	ctx context.Context,
+	team *domain.Team,
+	logins map[string]string,
+	report *domain.GitHubTeamSyncReport,
+	conflict func(domain.GitHubTeamSyncConflictReason, string, string, string, string),
+) error {
+	members, err := s.userRepo.GetActiveUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	accounts, err := s.githubRepo.GetGitHubAccountsByUserIDs(ctx, currentReviewersToIDs(members))
+	if err != nil {
+		return err
+	}
+	loginOf := make(map[string]string, len(accounts))
+	for _, a := range accounts {
+		loginOf[a.UserID] = a.Login
+	}
+
+	for _, m := range members {
+		login, ok := loginOf[m.ID]
+		if !ok {
+			conflict(domain.SyncConflictNoGitHubLogin, "", m.ID, team.TeamName, "")
+			continue
+		}
+		if _, ok := logins[strings.ToLower(login)]; ok {
+			continue
+		}
+		if _, err := s.userSvc.SetUserActiveStatus(ctx, m.ID, false); err != nil {
+			conflict(domain.SyncConflictFailed, login, m.ID, team.TeamName, err.Error())
+			continue
+		}
+		report.UsersDeactivated++
+	}
+	return nil
+}
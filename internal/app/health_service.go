@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// healthCheckTimeout bounds each dependency probe so one hung integration
+// does not stall the readiness endpoint.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthCheckFunc probes a dependency and returns nil when it is reachable.
+type HealthCheckFunc func(ctx context.Context) error
+
+// HealthInUseFunc reports whether a dependency is in use at the moment.
+type HealthInUseFunc func(ctx context.Context) (bool, error)
+
+type healthCheck struct {
+	name     string
+	critical bool
+	check    HealthCheckFunc
+	// inUse, when set, leaves the dependency out of the report while unused.
+	inUse HealthInUseFunc
+}
+
+type HealthService struct {
+	checks []healthCheck
+	log    *slog.Logger
+}
+
+func NewHealthService(log *slog.Logger) *HealthService {
+	return &HealthService{log: log}
+}
+
+// Register adds a dependency to the readiness report. Critical dependencies
+// (the database) make the service unready when down; optional ones such as
+// notification channels only mark it degraded.
+func (s *HealthService) Register(name string, critical bool, check HealthCheckFunc) {
+	s.checks = append(s.checks, healthCheck{name: name, critical: critical, check: check})
+}
+
+// RegisterWhen adds a dependency that is only reported while inUse says so,
+// for integrations whose targets are set up at runtime, such as user webhooks.
+func (s *HealthService) RegisterWhen(name string, critical bool, inUse HealthInUseFunc, check HealthCheckFunc) {
+	s.checks = append(s.checks, healthCheck{name: name, critical: critical, check: check, inUse: inUse})
+}
+
+// Check probes all registered dependencies concurrently.
+func (s *HealthService) Check(ctx context.Context) *domain.HealthReport {
+	probed := make([]*domain.DependencyHealth, len(s.checks))
+
+	var wg sync.WaitGroup
+	for i, c := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probed[i] = s.probe(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	deps := make([]domain.DependencyHealth, 0, len(probed))
+	for _, d := range probed {
+		if d != nil {
+			deps = append(deps, *d)
+		}
+	}
+	report := &domain.HealthReport{Status: domain.HealthOK, Dependencies: deps}
+	for _, d := range deps {
+		switch {
+		case d.Up:
+		case d.Critical:
+			report.Status = domain.HealthDown
+		case report.Status == domain.HealthOK:
+			report.Status = domain.HealthDegraded
+		}
+	}
+	return report
+}
+
+// probe checks one dependency. It returns nil for a dependency not in use.
+func (s *HealthService) probe(ctx context.Context, c healthCheck) *domain.DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	if c.inUse != nil {
+		var inUse bool
+		if inUse, err = c.inUse(ctx); err == nil && !inUse {
+			return nil
+		}
+	}
+	if err == nil {
+		err = c.check(ctx)
+	}
+	dep := &domain.DependencyHealth{
+		Name:     c.name,
+		Critical: c.critical,
+		Up:       err == nil,
+		Latency:  time.Since(start),
+	}
+	if err != nil {
+		dep.Error = err.Error()
+		s.log.WarnContext(ctx, "dependency health check failed", "dependency", c.name, "critical", c.critical, "error", err)
+	}
+	return dep
+}
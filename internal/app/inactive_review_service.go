@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const inactiveReviewBatchSize = 100
+
+// InactiveReviewService swaps out reviewers who took no action on a review
+// (acknowledging, approving or requesting changes) for reassignAfter. The
+// replacement is notified of the new review as usual and the reviewer taken
+// off is told why. Teams can opt out; a zero reassignAfter disables the policy.
+type InactiveReviewService struct {
+	prRepo        domain.PullRequestRepository
+	prSvc         *PullRequestService
+	notifySvc     *NotificationService
+	reassignAfter time.Duration
+	log           *slog.Logger
+}
+
+func NewInactiveReviewService(
+	prRepo domain.PullRequestRepository,
+	prSvc *PullRequestService,
+	notifySvc *NotificationService,
+	reassignAfter time.Duration,
+	log *slog.Logger,
+) *InactiveReviewService {
+	return &InactiveReviewService{
+		prRepo:        prRepo,
+		prSvc:         prSvc,
+		notifySvc:     notifySvc,
+		reassignAfter: reassignAfter,
+		log:           log,
+	}
+}
+
+// Enabled reports whether inactive reviewers are reassigned.
+func (s *InactiveReviewService) Enabled() bool {
+	return s.reassignAfter > 0
+}
+
+// Reassign replaces the reviewers who have been inactive for too long and
+// returns how many were replaced. A review that fails to be reassigned, for
+// example because nobody else can take it, is logged and retried on the next
+// run.
+func (s *InactiveReviewService) Reassign(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, nil
+	}
+	now := time.Now()
+	reviews, err := s.prRepo.ListInactiveReviews(ctx, now.Add(-s.reassignAfter), inactiveReviewBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	for i := range reviews {
+		r := &reviews[i]
+		_, newReviewerID, err := s.prSvc.ReassignReviewer(ctx, r.PRID, r.UserID, domain.ReassignmentInactive, "")
+		if err != nil {
+			s.log.WarnContext(ctx, "failed to reassign inactive review", "pr_id", r.PRID, "user_id", r.UserID, "error", err)
+			continue
+		}
+		s.log.InfoContext(ctx, "reassigned inactive review", "event", "pr.inactive_reassigned", "pr_id", r.PRID, "old_user_id", r.UserID, "new_user_id", newReviewerID)
+		reassigned++
+
+		n := &domain.Notification{
+			UserID: r.UserID,
+			Event:  domain.EventReviewReassigned,
+			PRID:   r.PRID,
+			Message: fmt.Sprintf("Your review of %q (%s) was reassigned to %s after %s without activity",
+				r.PRName, r.PRID, newReviewerID, now.Sub(r.LastActivityAt).Round(time.Minute)),
+		}
+		if err := s.notifySvc.Notify(ctx, n); err != nil {
+			s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "pr_id", r.PRID, "error", err)
+		}
+	}
+	return reassigned, nil
+}
+
+// Run reassigns inactive reviews every interval until ctx is cancelled.
+func (s *InactiveReviewService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Reassign(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "inactive review reassignment run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// liveBufferSize is how many updates may queue up for a subscriber before it
+// is dropped as too slow.
+const liveBufferSize = 32
+
+// LiveSubscription receives the PR updates of one team.
+type LiveSubscription struct {
+	TeamName string
+	teamID   int32
+	updates  chan *domain.PRUpdate
+}
+
+// Updates delivers the team's updates. The channel is closed when the
+// subscriber falls behind and is dropped.
+func (s *LiveSubscription) Updates() <-chan *domain.PRUpdate {
+	return s.updates
+}
+
+// LiveService pushes committed PR changes to subscribers of the teams
+// involved: the author's team and the teams of the current reviewers.
+type LiveService struct {
+	prRepo   domain.PullRequestRepository
+	userRepo domain.UserRepository
+	teamRepo domain.TeamRepository
+	token    string
+	log      *slog.Logger
+
+	mu   sync.Mutex
+	subs map[int32]map[*LiveSubscription]struct{}
+}
+
+// NewLiveService creates the service. Subscribers must present token; an
+// empty token rejects everyone.
+func NewLiveService(
+	prRepo domain.PullRequestRepository,
+	userRepo domain.UserRepository,
+	teamRepo domain.TeamRepository,
+	token string,
+	log *slog.Logger,
+) *LiveService {
+	return &LiveService{
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		token:    token,
+		log:      log,
+		subs:     make(map[int32]map[*LiveSubscription]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber for the team's updates. Callers must
+// Unsubscribe when done.
+func (s *LiveService) Subscribe(ctx context.Context, token, teamName string) (*LiveSubscription, error) {
+	if s.token == "" {
+		return nil, fmt.Errorf("%w: live updates token is not configured", domain.ErrUnauthorized)
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		return nil, fmt.Errorf("%w: invalid live updates token", domain.ErrUnauthorized)
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &LiveSubscription{
+		TeamName: team.TeamName,
+		teamID:   team.ID,
+		updates:  make(chan *domain.PRUpdate, liveBufferSize),
+	}
+	s.mu.Lock()
+	if s.subs[team.ID] == nil {
+		s.subs[team.ID] = make(map[*LiveSubscription]struct{})
+	}
+	s.subs[team.ID][sub] = struct{}{}
+	s.mu.Unlock()
+
+	s.log.InfoContext(ctx, "live updates subscribed", "event", "live.subscribed", "team_name", team.TeamName)
+	return sub, nil
+}
+
+// Unsubscribe removes the subscriber; it is a no-op for dropped subscribers.
+func (s *LiveService) Unsubscribe(sub *LiveSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(sub)
+}
+
+// PRChanged implements domain.PRObserver. Subscribers whose buffer is full are
+// dropped rather than slowing down the request that changed the PR.
+func (s *LiveService) PRChanged(ctx context.Context, prID string, change domain.PRUpdateType) {
+	if !s.hasSubscribers() {
+		return
+	}
+	update, teamIDs, err := s.snapshot(ctx, prID, change)
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to load PR for live update", "pr_id", prID, "error", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, teamID := range teamIDs {
+		for sub := range s.subs[teamID] {
+			select {
+			case sub.updates <- update:
+			default:
+				s.remove(sub)
+				s.log.WarnContext(ctx, "dropped slow live updates subscriber", "event", "live.dropped", "team_name", sub.TeamName)
+			}
+		}
+	}
+}
+
+func (s *LiveService) hasSubscribers() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subs) > 0
+}
+
+// remove must be called with s.mu held.
+func (s *LiveService) remove(sub *LiveSubscription) {
+	team := s.subs[sub.teamID]
+	if _, ok := team[sub]; !ok {
+		return
+	}
+	delete(team, sub)
+	if len(team) == 0 {
+		delete(s.subs, sub.teamID)
+	}
+	close(sub.updates)
+}
+
+// snapshot loads the PR after the change and the IDs of the teams involved.
+func (s *LiveService) snapshot(ctx context.Context, prID string, change domain.PRUpdateType) (*domain.PRUpdate, []int32, error) {
+	pr, err := s.prRepo.GetPRWithReviewers(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Reviewers are read again for their teams, which the PR does not carry.
+	reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teamIDs := []int32{author.TeamID}
+	for _, r := range reviewers {
+		if !slices.Contains(teamIDs, r.TeamID) {
+			teamIDs = append(teamIDs, r.TeamID)
+		}
+	}
+	return &domain.PRUpdate{Type: change, PR: pr, OccurredAt: time.Now()}, teamIDs, nil
+}
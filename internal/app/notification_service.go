@@ -0,0 +1,471 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const (
+	notificationBatchSize = 50
+	digestBatchSize       = 100
+	quietHoursLayout      = "15:04"
+)
+
+// NotificationRetry is how failed deliveries are retried: first after
+// BaseDelay, then twice as late after every failure, until MaxAttempts
+// attempts have failed and the notification is dead-lettered.
+type NotificationRetry struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// NotificationService queues notifications according to each user's
+// preferences and delivers them through the registered channels. Reviews
+// pending for longer than the review SLA in the settings of the reviewer's
+// team are flagged as overdue in digests.
+// Delivery happens in the background, so a failing channel never fails the
+// request that raised the notification.
+type NotificationService struct {
+	notifRepo domain.NotificationRepository
+	userRepo  domain.UserRepository
+	prRepo    domain.PullRequestRepository
+	tx        domain.UnitOfWork
+	channels  map[string]domain.NotificationChannel
+	settings  *SettingsService
+	retry     NotificationRetry
+	log       *slog.Logger
+}
+
+func NewNotificationService(
+	notifRepo domain.NotificationRepository,
+	userRepo domain.UserRepository,
+	prRepo domain.PullRequestRepository,
+	tx domain.UnitOfWork,
+	settings *SettingsService,
+	retry NotificationRetry,
+	log *slog.Logger,
+) *NotificationService {
+	return &NotificationService{
+		notifRepo: notifRepo,
+		userRepo:  userRepo,
+		prRepo:    prRepo,
+		tx:        tx,
+		channels:  make(map[string]domain.NotificationChannel),
+		settings:  settings,
+		retry:     retry,
+		log:       log,
+	}
+}
+
+// RegisterChannel makes a channel available to users under the given name.
+func (s *NotificationService) RegisterChannel(name string, ch domain.NotificationChannel) {
+	s.channels[name] = ch
+}
+
+// GetPreferences returns the user's preferences, or the defaults if they never set any.
+func (s *NotificationService) GetPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.preferences(ctx, userID)
+}
+
+func (s *NotificationService) SetPreferences(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	if prefs.Timezone == "" {
+		prefs.Timezone = "UTC"
+	}
+	if prefs.Digest == "" {
+		prefs.Digest = domain.DigestOff
+	}
+	if err := s.validatePreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.NotificationPreferences
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = s.notifRepo.SetNotificationPreferences(ctx, tx, prefs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (s *NotificationService) validatePreferences(prefs *domain.NotificationPreferences) error {
+	for _, ch := range prefs.Channels {
+		if _, ok := s.channels[ch]; !ok {
+			return fmt.Errorf("%w: unknown notification channel %q", domain.ErrValidation, ch)
+		}
+	}
+	for _, e := range prefs.MutedEvents {
+		if !slices.Contains(domain.NotificationEvents, e) {
+			return fmt.Errorf("%w: unknown notification event %q", domain.ErrValidation, e)
+		}
+	}
+	switch prefs.Digest {
+	case domain.DigestOff, domain.DigestDaily, domain.DigestWeekly:
+	default:
+		return fmt.Errorf("%w: unknown digest frequency %q", domain.ErrValidation, prefs.Digest)
+	}
+	if err := validateQuietHours(prefs); err != nil {
+		return err
+	}
+	return validateChannelAddresses(prefs)
+}
+
+// validateQuietHours checks the quiet hours and the timezone they are in.
+func validateQuietHours(prefs *domain.NotificationPreferences) error {
+	if (prefs.QuietHoursStart == "") != (prefs.QuietHoursEnd == "") {
+		return fmt.Errorf("%w: quiet hours need both a start and an end", domain.ErrValidation)
+	}
+	for _, v := range []string{prefs.QuietHoursStart, prefs.QuietHoursEnd} {
+		if _, err := time.Parse(quietHoursLayout, v); v != "" && (err != nil || len(v) != len(quietHoursLayout)) {
+			return fmt.Errorf("%w: quiet hours must be in HH:MM form, got %q", domain.ErrValidation, v)
+		}
+	}
+	if _, err := time.LoadLocation(prefs.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", domain.ErrValidation, prefs.Timezone)
+	}
+	return nil
+}
+
+// validateChannelAddresses checks that the email and webhook channels, when
+// chosen, have somewhere to deliver to.
+func validateChannelAddresses(prefs *domain.NotificationPreferences) error {
+	if slices.Contains(prefs.Channels, "email") {
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil || addr.Address != prefs.Email {
+			return fmt.Errorf("%w: the email channel needs a valid email address", domain.ErrValidation)
+		}
+	}
+	if slices.Contains(prefs.Channels, "webhook") {
+		u, err := url.Parse(prefs.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: the webhook channel needs an http(s) webhook URL", domain.ErrValidation)
+		}
+	}
+	return nil
+}
+
+func (s *NotificationService) preferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	prefs, err := s.notifRepo.GetNotificationPreferences(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.DefaultNotificationPreferences(userID), nil
+	}
+	return prefs, err
+}
+
+// Notify queues a notification for the user unless they muted the event.
+// Notifications raised during the user's quiet hours are held until they end.
+func (s *NotificationService) Notify(ctx context.Context, n *domain.Notification) error {
+	prefs, err := s.preferences(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	if prefs.Mutes(n.Event) || len(prefs.Channels) == 0 {
+		s.log.DebugContext(ctx, "notification suppressed by user preferences", "user_id", n.UserID, "event", n.Event)
+		return nil
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.notifRepo.EnqueueNotification(ctx, tx, n, deliveryTime(prefs, time.Now()))
+	})
+}
+
+// ReviewersChanged tells newly assigned reviewers that their review is requested.
+func (s *NotificationService) ReviewersChanged(ctx context.Context, prID string, added []string) {
+	if len(added) == 0 {
+		return
+	}
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to load PR for notification", "pr_id", prID, "error", err)
+		return
+	}
+	for _, userID := range added {
+		n := &domain.Notification{
+			UserID:  userID,
+			Event:   domain.EventReviewRequested,
+			PRID:    prID,
+			Message: fmt.Sprintf("You were asked to review %q (%s)", pr.Name, prID),
+		}
+		if err := s.Notify(ctx, n); err != nil {
+			s.log.WarnContext(ctx, "failed to queue notification", "user_id", userID, "pr_id", prID, "error", err)
+		}
+	}
+}
+
+// DeliverDue sends one batch of due notifications and returns how many were
+// delivered. Failed deliveries are retried with exponential backoff and
+// dead-lettered after the last attempt. Delivery is at least once: a batch
+// whose transaction is run again after a conflict is sent again.
+func (s *NotificationService) DeliverDue(ctx context.Context) (int, error) {
+	var sent int
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		due, err := s.notifRepo.ClaimDueNotifications(ctx, tx, notificationBatchSize)
+		if err != nil {
+			return err
+		}
+
+		sent = 0
+		for i := range due {
+			n := &due[i]
+			if err := s.deliver(ctx, n); err != nil {
+				if err := s.markFailed(ctx, tx, n, err); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := s.notifRepo.MarkNotificationSent(ctx, tx, n.ID); err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, nil
+}
+
+// markFailed schedules the next attempt of a failed delivery, or moves the
+// notification to the dead letter queue if it was the last one.
+func (s *NotificationService) markFailed(ctx context.Context, tx domain.Tx, n *domain.Notification, cause error) error {
+	attempt := n.Attempts + 1
+	if attempt >= s.retry.MaxAttempts {
+		s.log.ErrorContext(ctx, "notification dead-lettered",
+			"event", "notification.dead_lettered",
+			"notification_id", n.ID,
+			"user_id", n.UserID,
+			"notification_event", n.Event,
+			"attempts", attempt,
+			"error", cause,
+		)
+		return s.notifRepo.MarkNotificationDeadLettered(ctx, tx, n.ID, cause.Error())
+	}
+
+	retryAt := time.Now().Add(s.retry.BaseDelay << n.Attempts)
+	s.log.WarnContext(ctx, "notification delivery failed", "notification_id", n.ID, "user_id", n.UserID, "attempt", attempt, "retry_at", retryAt, "error", cause)
+	return s.notifRepo.MarkNotificationFailed(ctx, tx, n.ID, cause.Error(), retryAt)
+}
+
+// deliver sends n through every channel the user chose, using the preferences
+// current at delivery time.
+func (s *NotificationService) deliver(ctx context.Context, n *domain.Notification) error {
+	prefs, err := s.preferences(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range prefs.Channels {
+		ch, ok := s.channels[name]
+		if !ok {
+			continue
+		}
+		if err := ch.Send(ctx, prefs, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendDigests queues digests for users whose digest period has passed and
+// returns how many were queued. Users with nothing pending get no digest.
+func (s *NotificationService) SendDigests(ctx context.Context) (int, error) {
+	var queued int
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		due, err := s.notifRepo.ClaimDueDigests(ctx, tx, digestBatchSize)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		queued = 0
+		for i := range due {
+			prefs := &due[i]
+			reviews, err := s.prRepo.GetPendingReviews(ctx, prefs.UserID)
+			if err != nil {
+				return err
+			}
+			if len(reviews) > 0 && len(prefs.Channels) > 0 {
+				sla, err := s.reviewSLA(ctx, prefs.UserID)
+				if err != nil {
+					return err
+				}
+				n := &domain.Notification{UserID: prefs.UserID, Event: domain.EventDigest, Message: s.digestMessage(prefs.Digest, reviews, now, sla)}
+				if err := s.notifRepo.EnqueueNotification(ctx, tx, n, deliveryTime(prefs, now)); err != nil {
+					return err
+				}
+				queued++
+			}
+			if err := s.notifRepo.MarkDigestSent(ctx, tx, prefs.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if queued > 0 {
+		s.log.InfoContext(ctx, "queued review digests", "count", queued)
+	}
+	return queued, nil
+}
+
+// reviewSLA returns the review SLA that applies to the user.
+func (s *NotificationService) reviewSLA(ctx context.Context, userID string) (time.Duration, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	settings, err := s.settings.effective(ctx, user.TeamID)
+	if err != nil {
+		return 0, err
+	}
+	return settings.ReviewSLA(), nil
+}
+
+func (s *NotificationService) digestMessage(freq domain.DigestFrequency, reviews []domain.PendingReview, now time.Time, sla time.Duration) string {
+	var lines []string
+	overdue := 0
+	for _, r := range reviews {
+		waiting := now.Sub(r.AssignedAt)
+		line := fmt.Sprintf("- %q (%s), waiting %s", r.PRName, r.PRID, waiting.Round(time.Hour))
+		if r.Priority == domain.PriorityUrgent {
+			line += " [urgent]"
+		}
+		if waiting > sla {
+			line += " [overdue]"
+			overdue++
+		}
+		lines = append(lines, line)
+	}
+	header := fmt.Sprintf("Your %s review digest: %d pending review(s), %d overdue", freq, len(reviews), overdue)
+	return header + "\n" + strings.Join(lines, "\n")
+}
+
+// Replay queues the notifications matching filter for delivery again, so that
+// a consumer that missed them can catch up. Only notifications that were
+// delivered or given up on are replayed; a zero Until means now.
+func (s *NotificationService) Replay(ctx context.Context, filter domain.NotificationFilter) (int, error) {
+	if filter.Until.IsZero() {
+		filter.Until = time.Now()
+	}
+	if !filter.Since.Before(filter.Until) {
+		return 0, fmt.Errorf("%w: since must be before until", domain.ErrValidation)
+	}
+	if filter.Event != "" && filter.Event != domain.EventDigest && !slices.Contains(domain.NotificationEvents, filter.Event) {
+		return 0, fmt.Errorf("%w: unknown notification event %q", domain.ErrValidation, filter.Event)
+	}
+	if filter.UserID != "" {
+		if _, err := s.userRepo.GetUserByID(ctx, filter.UserID); err != nil {
+			return 0, err
+		}
+	}
+
+	var replayed int
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		replayed, err = s.notifRepo.ReplayNotifications(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.log.InfoContext(ctx, "notifications replayed", "event", "notifications.replayed",
+		"count", replayed, "notification_event", filter.Event, "user_id", filter.UserID, "since", filter.Since, "until", filter.Until)
+	return replayed, nil
+}
+
+// ListDeadLetters returns the notifications given up on, most recent first.
+// They can be queued again with Replay.
+func (s *NotificationService) ListDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	if filter.Event != "" && filter.Event != domain.EventDigest && !slices.Contains(domain.NotificationEvents, filter.Event) {
+		return nil, fmt.Errorf("%w: unknown notification event %q", domain.ErrValidation, filter.Event)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+	return s.notifRepo.ListDeadLetters(ctx, filter)
+}
+
+// Run queues digests and delivers due notifications every interval until ctx
+// is cancelled.
+func (s *NotificationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SendDigests(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "digest run failed", "error", err)
+		}
+		if _, err := s.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "notification delivery run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliveryTime returns now, or the end of the user's quiet hours if now falls
+// inside them. The quiet window may wrap past midnight.
+func deliveryTime(prefs *domain.NotificationPreferences, now time.Time) time.Time {
+	if prefs.QuietHoursStart == "" || prefs.QuietHoursStart == prefs.QuietHoursEnd {
+		return now
+	}
+	loc, err := time.LoadLocation(prefs.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	start, errStart := time.Parse(quietHoursLayout, prefs.QuietHoursStart)
+	end, errEnd := time.Parse(quietHoursLayout, prefs.QuietHoursEnd)
+	if errStart != nil || errEnd != nil {
+		return now
+	}
+
+	local := now.In(loc)
+	minute := func(t time.Time) int { return t.Hour()*60 + t.Minute() }
+	cur, from, to := minute(local), minute(start), minute(end)
+	quiet := (from < to && cur >= from && cur < to) || (from > to && (cur >= from || cur < to))
+	if !quiet {
+		return now
+	}
+
+	wake := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+	if !wake.After(local) {
+		wake = wake.AddDate(0, 0, 1)
+	}
+	return wake
+}
+
+// ReviewNotifiers fans a reviewer change out to several notifiers.
+type ReviewNotifiers []domain.ReviewNotifier
+
+func (ns ReviewNotifiers) ReviewersChanged(ctx context.Context, prID string, added []string) {
+	for _, n := range ns {
+		n.ReviewersChanged(ctx, prID, added)
+	}
+}
@@ -1,358 +1,1998 @@
 package app
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
 )
 
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+
+	maxPRIDLength         = 100
+	maxPRExternalIDLength = 255
+)
+
 type PullRequestService struct {
 	prRepo   domain.PullRequestRepository
 	userRepo domain.UserRepository
 	teamRepo domain.TeamRepository
-	tx       domain.Transactor
-	log      *slog.Logger
+	repoRepo domain.RepositoryRepository
+	ruleRepo domain.ReviewRuleRepository
+	tx       domain.UnitOfWork
+	notifier domain.ReviewNotifier
+	observer domain.PRObserver
+	// noCandidate is told about reassignments that found no reviewer.
+	noCandidate domain.NoCandidateObserver
+	// settings decide the number of reviewers, how they are picked and the
+	// cap on their open reviews, per team.
+	settings   *SettingsService
+	candidates *candidatePools
+	// selectionSalt keys the seeds of random reviewer picks.
+	selectionSalt []byte
+	log           *slog.Logger
 }
 
+// NewPullRequestService creates the service; notifier, observer and noCandidate
+// may be nil. Team members eligible for review are cached for
+// candidatePoolTTL; zero disables the cache. selectionSalt keys the seeds of
+// random picks and must be kept secret.
 func NewPullRequestService(
 	prRepo domain.PullRequestRepository,
 	userRepo domain.UserRepository,
 	teamRepo domain.TeamRepository,
-	tx domain.Transactor,
+	repoRepo domain.RepositoryRepository,
+	ruleRepo domain.ReviewRuleRepository,
+	tx domain.UnitOfWork,
+	notifier domain.ReviewNotifier,
+	observer domain.PRObserver,
+	noCandidate domain.NoCandidateObserver,
+	settings *SettingsService,
+	candidatePoolTTL time.Duration,
+	selectionSalt string,
 	log *slog.Logger,
 ) *PullRequestService {
 	return &PullRequestService{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		tx:       tx,
-		log:      log,
+		prRepo:        prRepo,
+		userRepo:      userRepo,
+		teamRepo:      teamRepo,
+		repoRepo:      repoRepo,
+		ruleRepo:      ruleRepo,
+		tx:            tx,
+		notifier:      notifier,
+		observer:      observer,
+		noCandidate:   noCandidate,
+		settings:      settings,
+		candidates:    newCandidatePools(candidatePoolTTL),
+		selectionSalt: []byte(selectionSalt),
+		log:           log,
 	}
 }
 
-func (s *PullRequestService) CreatePR(ctx context.Context, name, authorID string) (*domain.PullRequest, error) {
-	if name == "" || authorID == "" {
-		return nil, fmt.Errorf("%w: name and authorID are required", domain.ErrValidation)
-	}
+// CreatePRInput describes a PR to create. Only Name and AuthorID are required.
+type CreatePRInput struct {
+	// ID is generated when empty
+	ID          string
+	ExternalID  string
+	Name        string
+	Description string
+	AuthorID    string
+	// Repository, when set, picks the repository settings for the review
+	Repository     string
+	RequiredSkills []string
+	Labels         []string
+	AutoMerge      bool
+	Priority       domain.PRPriority
+	Size           domain.PRSize
+	// Draft PRs get their reviewers once they are marked ready
+	Draft    bool
+	Template *domain.PRTemplate
+}
+
+// CreatePR creates a PR and assigns its reviewers; a draft gets them once it is
+// marked ready. When Repository is set, the repository settings decide the
+// team, skills and number of reviewers; a review rule matching the repository
+// or labels overrides them, and the size rules of the reviewers' team may lower
+// that number for small PRs. The team's optional reviewers are picked after the
+// required ones.
+// An author at the open PR quota of their team gets ErrTooManyOpenPRs when the
+// quota blocks; otherwise the PR is created with OverQuota set.
+// A Template, which must belong to the author's team, prefixes the name, adds
+// its labels and has its default reviewers picked first when they are eligible.
+// The settings of the reviewers' team give the default number of reviewers and
+// may refuse AutoMerge.
+func (s *PullRequestService) CreatePR(ctx context.Context, in CreatePRInput) (*domain.PullRequest, error) {
+	return s.createPR(ctx, in, true)
+}
 
-	author, err := s.userRepo.GetUserByID(ctx, authorID)
+// createPR creates the PR like CreatePR; without enforceQuota a blocking quota
+// only sets OverQuota, for PRs that already exist elsewhere.
+func (s *PullRequestService) createPR(ctx context.Context, in CreatePRInput, enforceQuota bool) (*domain.PullRequest, error) {
+	if err := validateCreatePRInput(&in); err != nil {
+		return nil, err
+	}
+	author, err := s.userRepo.GetUserByID(ctx, in.AuthorID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get author: %w", err)
 	}
+	quota, err := s.prQuota(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyTemplate(&in, author.TeamID); err != nil {
+		return nil, err
+	}
+	prToCreate := newPullRequest(&in)
+	route, err := s.routeNewPR(ctx, prToCreate, author, in.Template)
+	if err != nil {
+		return nil, err
+	}
 
-	tx, err := s.tx.BeginTx(ctx)
+	var createdPR *domain.PullRequest
+	var candidateIDs []string
+	var selfReview, overQuota bool
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		if overQuota, err = s.checkPRQuota(ctx, tx, author, quota, enforceQuota); err != nil {
+			return err
+		}
+		if createdPR, err = s.insertPR(ctx, tx, prToCreate, route.checklist); err != nil {
+			return err
+		}
+		if in.Draft {
+			return nil
+		}
+		candidateIDs, selfReview, err = s.assignInitialReviewers(ctx, tx, createdPR, author, route)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
+	}
+
+	s.refreshStatus(ctx, createdPR)
+	if selfReview {
+		s.logSelfReview(ctx, createdPR.ID, in.AuthorID, route.teamID, "no_candidate")
+	}
+	if overQuota {
+		createdPR.OverQuota = true
+		s.log.WarnContext(ctx, "PR created over the author's open PR quota",
+			"event", "pr.over_quota",
+			"pr_id", createdPR.ID,
+			"author_id", in.AuthorID,
+			"max_open_prs", quota.MaxOpenPRs,
+		)
+	}
+	if len(createdPR.Reviewers) > 0 {
+		s.notifyReviewersChanged(ctx, createdPR.ID, candidateIDs)
+	}
+	s.publishPRChange(ctx, createdPR.ID, domain.PRCreated)
+	return createdPR, nil
+}
+
+// validateCreatePRInput checks the input and fills in the generated ID and the
+// default priority.
+func validateCreatePRInput(in *CreatePRInput) error {
+	if in.Name == "" || in.AuthorID == "" {
+		return fmt.Errorf("%w: name and authorID are required", domain.ErrValidation)
+	}
+	if err := validatePRIdentifier("pull_request_id", in.ID, maxPRIDLength); err != nil {
+		return err
+	}
+	if err := validatePRIdentifier("external_id", in.ExternalID, maxPRExternalIDLength); err != nil {
+		return err
+	}
+	if in.ID == "" {
+		in.ID = uuid.New().String()
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	if (in.Size.LinesChanged != nil && *in.Size.LinesChanged < 0) || (in.Size.FilesChanged != nil && *in.Size.FilesChanged < 0) {
+		return fmt.Errorf("%w: lines_changed and files_changed cannot be negative", domain.ErrValidation)
+	}
+	if in.Priority == "" {
+		in.Priority = domain.PriorityNormal
+	}
+	if !in.Priority.Valid() {
+		return fmt.Errorf("%w: unknown priority %q", domain.ErrValidation, in.Priority)
+	}
+	return nil
+}
+
+// applyTemplate prefixes the name with the template's prefix and adds its
+// labels to a copy of the input's. The template must belong to the author's
+// team.
+func applyTemplate(in *CreatePRInput, authorTeamID int32) error {
+	t := in.Template
+	if t == nil {
+		return nil
+	}
+	if t.TeamID != authorTeamID {
+		return fmt.Errorf("%w: PR template %d belongs to team '%s', not to the author's team", domain.ErrValidation, t.ID, t.TeamName)
+	}
+	if !strings.HasPrefix(in.Name, t.NamePrefix) {
+		in.Name = t.NamePrefix + in.Name
+	}
+	in.Labels = slices.Clone(in.Labels)
+	for _, label := range t.Labels {
+		if !slices.Contains(in.Labels, label) {
+			in.Labels = append(in.Labels, label)
 		}
-	}(s.tx, ctx, tx)
+	}
+	return nil
+}
 
-	prToCreate := &domain.PullRequest{
-		ID:       uuid.New().String(),
-		Name:     name,
-		AuthorID: authorID,
-		Status:   domain.StatusOpen,
+func newPullRequest(in *CreatePRInput) *domain.PullRequest {
+	pr := &domain.PullRequest{
+		ID:             in.ID,
+		ExternalID:     in.ExternalID,
+		Name:           in.Name,
+		Description:    in.Description,
+		AuthorID:       in.AuthorID,
+		Status:         domain.StatusOpen,
+		RequiredSkills: in.RequiredSkills,
+		Labels:         in.Labels,
+		AutoMerge:      in.AutoMerge,
+		Priority:       in.Priority,
+		Repository:     in.Repository,
+		Size:           in.Size,
 	}
+	if in.Draft {
+		pr.Status = domain.StatusDraft
+	}
+	return pr
+}
 
-	createdPR, err := s.prRepo.CreatePR(ctx, tx, prToCreate)
+// routeNewPR routes the reviews of a PR about to be created and sets its
+// required skills from the route. The default reviewers of the template are
+// preferred.
+func (s *PullRequestService) routeNewPR(ctx context.Context, pr *domain.PullRequest, author *domain.User, template *domain.PRTemplate) (*reviewRoute, error) {
+	route, err := s.routeReviews(ctx, pr, author)
 	if err != nil {
 		return nil, err
 	}
+	if pr.AutoMerge && !route.autoMerge {
+		return nil, fmt.Errorf("%w: auto-merge is turned off in the settings of the reviewers' team", domain.ErrValidation)
+	}
+	pr.RequiredSkills = route.skills
+	if template != nil {
+		route.hints = append(route.hints, template.DefaultReviewers...)
+	}
+	return route, nil
+}
+
+// checkPRQuota reports whether the author is at their open PR quota and, when
+// enforce is set, refuses the PR if the quota blocks. The count is taken in
+// tx, so that concurrent PRs of the author cannot both slip under the quota.
+func (s *PullRequestService) checkPRQuota(ctx context.Context, tx domain.Tx, author *domain.User, quota *domain.PRQuota, enforce bool) (bool, error) {
+	over, err := s.overPRQuota(ctx, tx, author, quota)
+	if err != nil {
+		return false, err
+	}
+	if over && quota.Mode == domain.PRQuotaBlock && enforce {
+		return false, fmt.Errorf("%w: user '%s' already has %d open PRs", domain.ErrTooManyOpenPRs, author.ID, quota.MaxOpenPRs)
+	}
+	return over, nil
+}
+
+// insertPR stores the PR with the checklist of its route.
+func (s *PullRequestService) insertPR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, checklist []string) (*domain.PullRequest, error) {
+	created, err := s.prRepo.CreatePR(ctx, tx, pr)
+	if err != nil || len(checklist) == 0 {
+		return created, err
+	}
+	if err := s.prRepo.CreateChecklist(ctx, tx, created.ID, checklist); err != nil {
+		return nil, fmt.Errorf("failed to create checklist: %w", err)
+	}
+	created.Checklist = make([]domain.ChecklistItem, len(checklist))
+	for i, label := range checklist {
+		created.Checklist[i] = domain.ChecklistItem{Position: i, Label: label}
+	}
+	return created, nil
+}
+
+// refreshStatus reloads the status of a PR just created: assigning required
+// reviewers has moved it to IN_REVIEW.
+func (s *PullRequestService) refreshStatus(ctx context.Context, pr *domain.PullRequest) {
+	stored, err := s.prRepo.GetPRByID(ctx, pr.ID)
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to refresh PR status", "pr_id", pr.ID, "error", err)
+		return
+	}
+	pr.Status = stored.Status
+}
+
+// prQuota returns the open PR quota of the author's team, nil without one.
+func (s *PullRequestService) prQuota(ctx context.Context, author *domain.User) (*domain.PRQuota, error) {
+	quota, err := s.teamRepo.GetPRQuota(ctx, author.TeamID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	return quota, err
+}
+
+// overPRQuota reports whether the author already has as many PRs open as the
+// quota allows. The count locks the author in tx until it ends.
+func (s *PullRequestService) overPRQuota(ctx context.Context, tx domain.Tx, author *domain.User, quota *domain.PRQuota) (bool, error) {
+	if quota == nil {
+		return false, nil
+	}
+	open, err := s.prRepo.CountOpenPRsByAuthor(ctx, tx, author.ID)
+	if err != nil {
+		return false, err
+	}
+	return open >= quota.MaxOpenPRs, nil
+}
 
-	candidates, err := s.userRepo.FindReviewCandidates(ctx, author.TeamID, authorID, []string{}, maxReviewers)
+// assignInitialReviewers assigns the required and optional reviewers the route
+// asks for to a PR that is ready for review and adds them to pr.Reviewers, and
+// why they were picked to pr.Explanations. When nobody is available the author
+// may review their own PR.
+func (s *PullRequestService) assignInitialReviewers(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, author *domain.User, route *reviewRoute) ([]string, bool, error) {
+	candidates, err := s.selectReviewers(ctx, tx, author, route, nil, []string{}, pr.Priority, route.limit)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find review candidates: %w", err)
+		return nil, false, fmt.Errorf("failed to find review candidates: %w", err)
+	}
+	selfReview := len(candidates) == 0 && route.selfReview && route.limit > 0 && author.IsActive
+	if selfReview {
+		candidates = []domain.User{*author}
 	}
 
+	candidateIDs := currentReviewersToIDs(candidates)
 	if len(candidates) > 0 {
-		candidateIDs := make([]string, len(candidates))
-		reviewers := make([]domain.Reviewer, len(candidates))
-		for i, c := range candidates {
-			candidateIDs[i] = c.ID
-			reviewers[i] = domain.Reviewer{ID: c.ID, Username: c.Username}
+		if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, candidateIDs, false); err != nil {
+			return nil, false, fmt.Errorf("failed to assign reviewers: %w", err)
 		}
-		if err := s.prRepo.AssignReviewers(ctx, tx, createdPR.ID, candidateIDs); err != nil {
-			return nil, fmt.Errorf("failed to assign reviewers: %w", err)
+		for _, c := range candidates {
+			pr.Reviewers = append(pr.Reviewers, domain.Reviewer{ID: c.ID, Username: c.Username})
 		}
-		createdPR.Reviewers = reviewers
 	}
-
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	if err := s.assignShadowReviewer(ctx, tx, pr, author, route); err != nil {
+		return nil, false, err
 	}
 
-	return createdPR, nil
+	if route.optional == 0 {
+		pr.Explanations = route.explain(candidateIDs)
+		return candidateIDs, selfReview, nil
+	}
+	optional, err := s.findReviewCandidates(ctx, tx, author, route, candidateIDs, "", pr.Priority != domain.PriorityUrgent, route.optional)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find optional review candidates: %w", err)
+	}
+	if len(optional) > 0 {
+		optionalIDs := currentReviewersToIDs(optional)
+		if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, optionalIDs, true); err != nil {
+			return nil, false, fmt.Errorf("failed to assign optional reviewers: %w", err)
+		}
+		for _, c := range optional {
+			pr.Reviewers = append(pr.Reviewers, domain.Reviewer{ID: c.ID, Username: c.Username, Optional: true})
+		}
+		candidateIDs = append(candidateIDs, optionalIDs...)
+	}
+	pr.Explanations = route.explain(candidateIDs)
+	return candidateIDs, selfReview, nil
 }
 
-func (s *PullRequestService) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	pr, err := s.prRepo.GetPRByID(ctx, prID)
+// assignShadowReviewer adds one of the route team's members in training as a
+// shadow reviewer of a new PR, on the share of PRs the team asks for.
+func (s *PullRequestService) assignShadowReviewer(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, author *domain.User, route *reviewRoute) error {
+	if route.shadowPercent <= 0 || selectionRand(s.selectionSalt, pr.ID, selectionStreamShadow).IntN(100) >= route.shadowPercent {
+		return nil
+	}
+	shadows, err := s.teamCandidates(ctx, tx, route.teamID, candidateQuery{
+		authorID: author.ID,
+		skills:   route.skills,
+		shadow:   true,
+		limit:    1,
+		prID:     pr.ID,
+	}, false)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to find shadow reviewer: %w", err)
+	}
+	for _, u := range shadows {
+		if err := s.prRepo.AddShadowReviewer(ctx, tx, pr.ID, u.ID); err != nil {
+			return fmt.Errorf("failed to assign shadow reviewer: %w", err)
+		}
+		pr.ShadowReviewers = append(pr.ShadowReviewers, u.ID)
+		s.log.InfoContext(ctx, "shadow reviewer assigned", "event", "pr.shadow_assigned", "pr_id", pr.ID, "user_id", u.ID)
+	}
+	return nil
+}
+
+// validatePRIdentifier checks a caller-supplied PR identifier; empty means unset.
+func validatePRIdentifier(field, value string, maxLength int) error {
+	if len(value) > maxLength {
+		return fmt.Errorf("%w: %s must be at most %d characters long", domain.ErrValidation, field, maxLength)
 	}
+	if strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("%w: %s must not contain whitespace", domain.ErrValidation, field)
+	}
+	return nil
+}
 
-	reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+// GetPRByExternalID returns the PR with the given external ID.
+func (s *PullRequestService) GetPRByExternalID(ctx context.Context, externalID string) (*domain.PullRequest, error) {
+	if externalID == "" {
+		return nil, fmt.Errorf("%w: external_id is required", domain.ErrValidation)
+	}
+	pr, err := s.prRepo.GetPRByExternalID(ctx, externalID)
 	if err != nil {
 		return nil, err
 	}
+	return s.GetPR(ctx, pr.ID)
+}
 
-	pr.Reviewers = make([]domain.Reviewer, len(reviewers))
-	for i, r := range reviewers {
-		pr.Reviewers[i] = domain.Reviewer{ID: r.ID, Username: r.Username}
-	}
+func (s *PullRequestService) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return s.prRepo.GetPRWithReviewers(ctx, prID)
+}
 
-	return pr, nil
+// GetPRTimeline returns the lifecycle events of a PR, oldest first.
+func (s *PullRequestService) GetPRTimeline(ctx context.Context, prID string) ([]domain.PREvent, error) {
+	return s.prRepo.GetPRTimeline(ctx, prID)
 }
 
-func (s *PullRequestService) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+// MergePR merges an open PR on behalf of mergedBy, a user ID; when it is empty
+// the actor of the request, if any, is recorded instead.
+func (s *PullRequestService) MergePR(ctx context.Context, prID, mergedBy string) (*domain.PullRequest, error) {
 	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
+	if mergedBy != "" {
+		if _, err := s.userRepo.GetUserByID(ctx, mergedBy); err != nil {
+			return nil, fmt.Errorf("failed to get merging user: %w", err)
+		}
+	} else {
+		mergedBy = domain.ActorFromContext(ctx)
+	}
 
-	if !pr.IsOpen() {
-		return nil, domain.ErrPRMerged
+	if err := pr.TransitionError(domain.StatusMerged); err != nil {
+		return nil, err
 	}
 
-	tx, err := s.tx.BeginTx(ctx)
+	if err := s.checkReviewRequirements(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	var mergedPR *domain.PullRequest
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		mergedPR, err = s.prRepo.MergePR(ctx, tx, prID, mergedBy)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
-		}
-	}(s.tx, ctx, tx)
+	s.publishPRChange(ctx, prID, domain.PRMerged)
+
+	return mergedPR, nil
+}
 
-	mergedPR, err := s.prRepo.MergePR(ctx, tx, prID)
+// MarkReady moves a draft PR to OPEN and assigns its reviewers unless some
+// were already assigned by hand; required reviewers move it on to IN_REVIEW.
+func (s *PullRequestService) MarkReady(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := pr.TransitionError(domain.StatusOpen); err != nil {
+		return nil, err
+	}
+	author, route, err := s.routePR(ctx, pr)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	assign := pr.RequiredReviewerCount() == 0
+	var candidateIDs []string
+	var selfReview bool
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if _, err := s.prRepo.MarkPRReady(ctx, tx, prID); err != nil {
+			return err
+		}
+		if !assign {
+			return nil
+		}
+		var err error
+		candidateIDs, selfReview, err = s.assignInitialReviewers(ctx, tx, pr, author, route)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return mergedPR, nil
+	s.log.InfoContext(ctx, "pull request ready for review", "event", "pr.ready", "pr_id", prID, "reviewers", len(candidateIDs))
+	if selfReview {
+		s.logSelfReview(ctx, prID, pr.AuthorID, route.teamID, "no_candidate")
+	}
+	if len(candidateIDs) > 0 {
+		s.notifyReviewersChanged(ctx, prID, candidateIDs)
+	}
+	s.publishPRChange(ctx, prID, domain.PRReady)
+	return s.GetPR(ctx, prID)
 }
 
-func (s *PullRequestService) AssignReviewer(ctx context.Context, prID string, userID string) (*domain.PullRequest, error) {
-	user, err := s.userRepo.GetUserByID(ctx, userID)
+// ClosePR closes a PR without merging it. Its reviewers keep their
+// assignments, which no longer count as open reviews.
+func (s *PullRequestService) ClosePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user to assign: %w", err)
+		return nil, err
 	}
-	if !user.IsActive {
-		return nil, domain.ErrUserNotActive
+	if err := pr.TransitionError(domain.StatusClosed); err != nil {
+		return nil, err
 	}
 
-	pr, err := s.GetPR(ctx, prID)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		_, err := s.prRepo.ClosePR(ctx, tx, prID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if !pr.IsOpen() {
-		return nil, domain.ErrPRMerged
-	}
 
-	if pr.AuthorID == userID {
-		return nil, fmt.Errorf("%w: author cannot be assigned as a reviewer to their own PR", domain.ErrValidation)
+	s.log.InfoContext(ctx, "pull request closed", "event", "pr.closed", "pr_id", prID, "actor", domain.ActorFromContext(ctx))
+	s.publishPRChange(ctx, prID, domain.PRClosed)
+	return s.GetPR(ctx, prID)
+}
+
+// ApprovePR records userID's approval of an open PR. If the PR has auto-merge
+// enabled and this was the last missing approval, the PR is merged in the same
+// transaction.
+func (s *PullRequestService) ApprovePR(ctx context.Context, prID, userID string) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
 	}
 
-	for _, r := range pr.Reviewers {
-		if r.ID == userID {
-			return pr, nil
+	merged := false
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.prRepo.ApproveReview(ctx, tx, prID, userID); err != nil {
+			return err
+		}
+		if !pr.AutoMerge {
+			return nil
 		}
+		var err error
+		merged, err = s.tryAutoMerge(ctx, tx, pr)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if len(pr.Reviewers) >= maxReviewers {
-		return nil, fmt.Errorf("%w: pull request already has the maximum number of reviewers", domain.ErrValidation)
+	s.log.InfoContext(ctx, "review approved", "event", "pr.review_approved", "pr_id", prID, "user_id", userID)
+	s.publishPRChange(ctx, prID, domain.PRApproved)
+	if merged {
+		s.log.InfoContext(ctx, "pull request auto-merged", "event", "pr.auto_merged", "pr_id", prID)
+		s.publishPRChange(ctx, prID, domain.PRMerged)
 	}
+	return s.GetPR(ctx, prID)
+}
 
-	tx, err := s.tx.BeginTx(ctx)
+// RequestChanges records that an assigned reviewer wants changes to an open
+// PR. It withdraws the reviewer's approval; a later approval settles the
+// request. Repeated requests keep the first time.
+func (s *PullRequestService) RequestChanges(ctx context.Context, prID, userID string) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
-		}
-	}(s.tx, ctx, tx)
-
-	if err := s.prRepo.AssignReviewers(ctx, tx, prID, []string{userID}); err != nil {
-		return nil, fmt.Errorf("failed to assign reviewer in repo: %w", err)
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
 	}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.prRepo.RequestChanges(ctx, tx, prID, userID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.log.InfoContext(ctx, "changes requested", "event", "pr.changes_requested", "pr_id", prID, "user_id", userID)
+	s.publishPRChange(ctx, prID, domain.PRChangesRequested)
 	return s.GetPR(ctx, prID)
 }
 
-func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID string, oldUserID string) (*domain.PullRequest, string, error) {
-	pr, err := s.GetPR(ctx, prID)
+// AckReview records that userID has seen their review assignment on an open
+// PR. Repeated acknowledgements keep the first time.
+func (s *PullRequestService) AckReview(ctx context.Context, prID, userID string) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
 	}
 
-	if err := s.validateReassignment(pr, oldUserID); err != nil {
-		return nil, "", err
+	if err := s.prRepo.AckReview(ctx, prID, userID); err != nil {
+		return nil, err
 	}
 
-	tx, err := s.tx.BeginTx(ctx)
+	s.log.InfoContext(ctx, "review acknowledged", "event", "pr.review_acked", "pr_id", prID, "user_id", userID)
+	return s.GetPR(ctx, prID)
+}
+
+// UpdateChecklist ticks or unticks checklist items of an open PR on behalf of
+// one of its reviewers. Completing the checklist may auto-merge the PR.
+func (s *PullRequestService) UpdateChecklist(ctx context.Context, prID, userID string, updates []domain.ChecklistUpdate) (*domain.PullRequest, error) {
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("%w: no checklist items to update", domain.ErrValidation)
+	}
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
+	}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
+	}
+	reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(reviewers, func(u domain.User) bool { return u.ID == userID }) {
+		return nil, fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
-		}
-	}(s.tx, ctx, tx)
 
-	newReviewerID, err := s.reassignReviewerInTx(ctx, tx, pr, oldUserID)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		for _, u := range updates {
+			if err := s.prRepo.SetChecklistItem(ctx, tx, prID, u.Position, userID, u.Checked); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, "", fmt.Errorf("failed to commit transaction: %w", err)
+	s.log.InfoContext(ctx, "checklist updated", "event", "pr.checklist_updated", "pr_id", prID, "user_id", userID, "items", len(updates))
+	s.publishPRChange(ctx, prID, domain.PRChecklistUpdated)
+	if pr.AutoMerge {
+		s.autoMergeAfterChecklist(ctx, pr)
 	}
+	return s.GetPR(ctx, prID)
+}
 
-	retPR, err := s.GetPR(ctx, prID)
+// autoMergeAfterChecklist merges an auto-merge PR whose checklist was the last
+// missing requirement. Failures are only logged: the checklist is already saved.
+func (s *PullRequestService) autoMergeAfterChecklist(ctx context.Context, pr *domain.PullRequest) {
+	var merged bool
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		merged, err = s.tryAutoMerge(ctx, tx, pr)
+		return err
+	})
 	if err != nil {
-		return nil, "", err
+		s.log.ErrorContext(ctx, "auto-merge failed", "pr_id", pr.ID, "error", err)
+		return
 	}
-
-	return retPR, newReviewerID, nil
+	if !merged {
+		return
+	}
+	s.log.InfoContext(ctx, "pull request auto-merged", "event", "pr.auto_merged", "pr_id", pr.ID)
+	s.publishPRChange(ctx, pr.ID, domain.PRMerged)
 }
 
-func (s *PullRequestService) validateReassignment(pr *domain.PullRequest, oldUserID string) error {
+// SetAutoMerge turns auto-merge on or off for an open PR. Enabling it on a PR
+// that already has all approvals merges it right away; it fails when the
+// settings of the reviewers' team turn auto-merge off.
+func (s *PullRequestService) SetAutoMerge(ctx context.Context, prID string, enabled bool) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
 	if !pr.IsOpen() {
-		return domain.ErrPRMerged
+		return nil, pr.NotOpenError()
+	}
+	if enabled {
+		_, route, err := s.routePR(ctx, pr)
+		if err != nil {
+			return nil, err
+		}
+		if !route.autoMerge {
+			return nil, fmt.Errorf("%w: auto-merge is turned off in the settings of the reviewers' team", domain.ErrValidation)
+		}
 	}
 
-	isAssigned := false
-	for _, r := range pr.Reviewers {
-		if r.ID == oldUserID {
-			isAssigned = true
-			break
+	merged := false
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.prRepo.SetAutoMerge(ctx, tx, prID, enabled); err != nil {
+			return err
 		}
+		if !enabled {
+			return nil
+		}
+		var err error
+		merged, err = s.tryAutoMerge(ctx, tx, pr)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if !isAssigned {
-		return fmt.Errorf("%w: user %s, PR %s", domain.ErrNotAssigned, oldUserID, pr.ID)
+
+	if merged {
+		s.log.InfoContext(ctx, "pull request auto-merged", "event", "pr.auto_merged", "pr_id", prID)
+		s.publishPRChange(ctx, prID, domain.PRMerged)
 	}
-	return nil
+	return s.GetPR(ctx, prID)
 }
 
-func (s *PullRequestService) reassignReviewerInTx(ctx context.Context, tx pgx.Tx, pr *domain.PullRequest, oldUserID string) (string, error) {
-	if err := s.prRepo.RemoveReviewer(ctx, tx, pr.ID, oldUserID); err != nil {
-		return "", fmt.Errorf("failed to remove reviewer: %w", err)
+// SetPriority changes the priority of an open PR. It applies to future reviewer
+// picks and escalation checks; current reviewers are kept.
+func (s *PullRequestService) SetPriority(ctx context.Context, prID string, priority domain.PRPriority) (*domain.PullRequest, error) {
+	if !priority.Valid() {
+		return nil, fmt.Errorf("%w: unknown priority %q", domain.ErrValidation, priority)
 	}
-
-	// Refetch reviewers inside the transaction to get the current state after removal.
-	currentReviewers, err := s.prRepo.GetReviewers(ctx, pr.ID)
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get current reviewers: %w", err)
+		return nil, err
 	}
-
-	currentReviewerIDs := make([]string, 0, len(currentReviewers))
-	for _, r := range currentReviewers {
-		if r.ID != oldUserID {
-			currentReviewerIDs = append(currentReviewerIDs, r.ID)
-		}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
 	}
-	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.prRepo.SetPriority(ctx, tx, prID, priority)
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to get author: %w", err)
+		return nil, err
 	}
+	return s.GetPR(ctx, prID)
+}
 
-	excludeIDs := append(currentReviewerIDs, oldUserID)
-	candidates, err := s.userRepo.FindReviewCandidates(ctx, author.TeamID, pr.AuthorID, excludeIDs, 1)
+// tryAutoMerge merges pr when every required reviewer has approved it and the
+// team's review requirements are met. A PR without required reviewers, or of
+// a team with auto-merge turned off in its settings, is never merged
+// automatically.
+func (s *PullRequestService) tryAutoMerge(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) (bool, error) {
+	approved, total, err := s.prRepo.GetApprovalState(ctx, tx, pr.ID)
 	if err != nil {
-		return "", fmt.Errorf("failed to find new candidate: %w", err)
+		return false, fmt.Errorf("failed to get approval state: %w", err)
 	}
-
-	if len(candidates) == 0 {
-		s.log.Warn("no new reviewer found for PR", "pr_id", pr.ID)
-		return "", fmt.Errorf("%w: no new reviewer found for PR: %v", domain.ErrNoCandidate, pr.ID)
+	if total == 0 || approved < total {
+		return false, nil
 	}
 
-	newReviewerID := candidates[0].ID
-	if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, []string{newReviewerID}); err != nil {
-		return "", fmt.Errorf("failed to assign new reviewer: %w", err)
+	_, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return false, err
+	}
+	if !route.autoMerge {
+		s.log.InfoContext(ctx, "auto-merge skipped, it is turned off in the team settings", "pr_id", pr.ID, "team_id", route.teamID)
+		return false, nil
+	}
+	if err := s.checkRouteRequirements(ctx, pr, route); err != nil {
+		if errors.Is(err, domain.ErrReviewRequirementsNotMet) {
+			s.log.InfoContext(ctx, "auto-merge postponed", "pr_id", pr.ID, "reason", err.Error())
+			return false, nil
+		}
+		return false, err
 	}
 
-	return newReviewerID, nil
+	if _, err := s.prRepo.MergePR(ctx, tx, pr.ID, domain.ActorFromContext(ctx)); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (s *PullRequestService) GetReviewsForUser(ctx context.Context, userID string) ([]domain.PullRequest, error) {
-	return s.prRepo.GetPRsByReviewer(ctx, userID)
+// checkReviewRequirements verifies that the PR has a required reviewer with the role
+// required by the team its reviewers come from and, if the team requires it, a fully
+// ticked checklist.
+func (s *PullRequestService) checkReviewRequirements(ctx context.Context, pr *domain.PullRequest) error {
+	_, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return err
+	}
+	return s.checkRouteRequirements(ctx, pr, route)
 }
 
-func (s *PullRequestService) GetOpenPRsWithoutReviewers(ctx context.Context) ([]domain.PullRequest, error) {
-	return s.prRepo.GetOpenPRsWithoutReviewers(ctx)
-}
+// checkRouteRequirements is checkReviewRequirements with the PR's review
+// route already resolved.
+func (s *PullRequestService) checkRouteRequirements(ctx context.Context, pr *domain.PullRequest, route *reviewRoute) error {
+	team, err := s.teamRepo.GetTeamByID(ctx, route.teamID)
+	if err != nil {
+		return fmt.Errorf("failed to get reviewers' team: %w", err)
+	}
 
-func (s *PullRequestService) reassignReviewsForUsers(ctx context.Context, tx pgx.Tx, userIDs []string) (int, error) {
-	reassignedCount := 0
-	for _, userID := range userIDs {
-		prs, err := s.prRepo.GetOpenPRsByReviewer(ctx, tx, userID)
+	if team.RequiredReviewerRole != "" {
+		reviewers, err := s.prRepo.GetRequiredReviewers(ctx, pr.ID)
 		if err != nil {
-			return 0, fmt.Errorf("failed to get open PRs for user %s: %w", userID, err)
+			return fmt.Errorf("failed to get reviewers: %w", err)
 		}
+		if !domain.HasRole(reviewers, team.RequiredReviewerRole) {
+			return fmt.Errorf("%w: PR %s needs a reviewer with role %q", domain.ErrReviewRequirementsNotMet, pr.ID, team.RequiredReviewerRole)
+		}
+	}
 
-		for _, pr := range prs {
-			if err := s.prRepo.RemoveReviewer(ctx, tx, pr.ID, userID); err != nil {
-				return 0, fmt.Errorf("failed to remove reviewer %s from PR %s: %w", userID, pr.ID, err)
-			}
+	if team.Checklist.RequireCompletion {
+		checklist, err := s.prRepo.GetChecklist(ctx, pr.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get checklist: %w", err)
+		}
+		if !domain.ChecklistComplete(checklist) {
+			return fmt.Errorf("%w: PR %s has unticked checklist items", domain.ErrReviewRequirementsNotMet, pr.ID)
+		}
+	}
+	return nil
+}
+
+func (s *PullRequestService) AssignReviewer(ctx context.Context, prID string, userID string) (*domain.PullRequest, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user to assign: %w", err)
+	}
+	if !user.IsActive {
+		return nil, domain.ErrUserNotActive
+	}
+
+	pr, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
+	}
+
+	for _, r := range pr.Reviewers {
+		if r.ID == userID {
+			return pr, nil
+		}
+	}
+
+	_, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	selfReview := pr.AuthorID == userID
+	if selfReview && !route.selfReview {
+		return nil, fmt.Errorf("%w: author cannot be assigned as a reviewer to their own PR", domain.ErrValidation)
+	}
+	if pr.RequiredReviewerCount() >= route.limit {
+		return nil, fmt.Errorf("%w: pull request already has the maximum number of reviewers", domain.ErrValidation)
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.prRepo.AssignReviewers(ctx, tx, prID, []string{userID}, false); err != nil {
+			return fmt.Errorf("failed to assign reviewer in repo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if selfReview {
+		s.logSelfReview(ctx, prID, userID, route.teamID, "manual")
+	}
+	s.notifyReviewersChanged(ctx, prID, []string{userID})
+	s.publishPRChange(ctx, prID, domain.PRReviewersChanged)
 
-			currentReviewers, err := s.prRepo.GetReviewers(ctx, pr.ID)
+	return s.GetPR(ctx, prID)
+}
+
+// ReassignReviewer replaces oldUserID on the PR with an automatically picked
+// reviewer. decline is why oldUserID declined the review, if they said.
+func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID string, oldUserID string, reason domain.ReassignmentReason, decline domain.DeclineReason) (*domain.PullRequest, string, error) {
+	if decline != "" && !decline.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown decline_reason %q", domain.ErrValidation, decline)
+	}
+	pr, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.validateReassignment(pr, oldUserID); err != nil {
+		return nil, "", err
+	}
+
+	var newReviewerID string
+	var missed noCandidates
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		missed = nil
+		var err error
+		newReviewerID, err = s.reassignReviewerInTx(ctx, tx, pr, oldUserID, reason, decline, &missed)
+		return err
+	})
+	// A failed pick rolls the reassignment back, but is still worth reporting.
+	if err == nil || errors.Is(err, domain.ErrNoCandidate) {
+		s.reportNoCandidates(ctx, missed)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	s.notifyReviewersChanged(ctx, prID, []string{newReviewerID})
+	s.publishPRChange(ctx, prID, domain.PRReviewersChanged)
+
+	retPR, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return nil, "", err
+	}
+	retPR.Explanations = pr.Explanations
+
+	return retPR, newReviewerID, nil
+}
+
+// ReassignAll hands every open review of fromUserID over to toUserID in one
+// transaction. Without toUserID, and on PRs toUserID wrote or already reviews,
+// the new reviewer is picked as in ReassignReviewer.
+func (s *PullRequestService) ReassignAll(ctx context.Context, fromUserID, toUserID string) ([]domain.RebalanceMove, error) {
+	if _, err := s.userRepo.GetUserByID(ctx, fromUserID); err != nil {
+		return nil, fmt.Errorf("failed to get user to reassign from: %w", err)
+	}
+	if toUserID != "" {
+		if toUserID == fromUserID {
+			return nil, fmt.Errorf("%w: reviews cannot be reassigned to the same user", domain.ErrValidation)
+		}
+		to, err := s.userRepo.GetUserByID(ctx, toUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user to reassign to: %w", err)
+		}
+		if !to.IsActive {
+			return nil, domain.ErrUserNotActive
+		}
+	}
+
+	var moved []domain.RebalanceMove
+	var missed noCandidates
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		missed = nil
+		prs, err := s.prRepo.GetOpenPRsByReviewer(ctx, tx, fromUserID)
+		if err != nil {
+			return fmt.Errorf("failed to get open PRs for user %s: %w", fromUserID, err)
+		}
+		moved = make([]domain.RebalanceMove, 0, len(prs))
+		for i := range prs {
+			pr := &prs[i]
+			if !pr.IsOpen() {
+				continue
+			}
+			newReviewerID, err := s.handOverReview(ctx, tx, pr, fromUserID, toUserID, &missed)
 			if err != nil {
-				return 0, fmt.Errorf("failed to get reviewers for PR %s: %w", pr.ID, err)
+				return err
 			}
+			moved = append(moved, domain.RebalanceMove{PRID: pr.ID, FromUserID: fromUserID, ToUserID: newReviewerID})
+		}
+		return nil
+	})
+	if err == nil || errors.Is(err, domain.ErrNoCandidate) {
+		s.reportNoCandidates(ctx, missed)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-			if len(currentReviewers) == 0 {
-				author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
-				if err != nil {
-					return 0, fmt.Errorf("failed to get author for PR %s: %w", pr.ID, err)
-				}
+	s.log.InfoContext(ctx, "reviews handed off",
+		"event", "pr.reviews_handed_off",
+		"from_user_id", fromUserID,
+		"to_user_id", toUserID,
+		"moved_count", len(moved),
+	)
+	for _, m := range moved {
+		s.notifyReviewersChanged(ctx, m.PRID, []string{m.ToUserID})
+		s.publishPRChange(ctx, m.PRID, domain.PRReviewersChanged)
+	}
+	return moved, nil
+}
 
-				authorTeam, err := s.teamRepo.GetTeamByID(ctx, author.TeamID)
-				if err != nil {
-					return 0, fmt.Errorf("failed to get author's team for PR %s: %w", pr.ID, err)
-				}
+// handOverReview replaces fromUserID with toUserID among the reviewers of pr,
+// falling back to an automatic pick when toUserID is empty, wrote the PR or
+// already reviews it.
+func (s *PullRequestService) handOverReview(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, fromUserID, toUserID string, missed *noCandidates) (string, error) {
+	if toUserID == "" || toUserID == pr.AuthorID {
+		return s.reassignReviewerInTx(ctx, tx, pr, fromUserID, domain.ReassignmentHandoff, "", missed)
+	}
+	reviewers, err := s.prRepo.GetReviewers(ctx, pr.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get reviewers for PR %s: %w", pr.ID, err)
+	}
+	if slices.ContainsFunc(reviewers, func(u domain.User) bool { return u.ID == toUserID }) {
+		return s.reassignReviewerInTx(ctx, tx, pr, fromUserID, domain.ReassignmentHandoff, "", missed)
+	}
+
+	optional, err := s.prRepo.RemoveReviewer(ctx, tx, pr.ID, fromUserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to remove reviewer: %w", err)
+	}
+	if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, []string{toUserID}, optional); err != nil {
+		return "", fmt.Errorf("failed to assign new reviewer: %w", err)
+	}
+	if err := s.prRepo.RecordReassignment(ctx, tx, pr.ID, fromUserID, toUserID, domain.ReassignmentHandoff, ""); err != nil {
+		return "", fmt.Errorf("failed to record reassignment: %w", err)
+	}
+	return toUserID, nil
+}
+
+func (s *PullRequestService) validateReassignment(pr *domain.PullRequest, oldUserID string) error {
+	if !pr.IsOpen() {
+		return pr.NotOpenError()
+	}
+
+	isAssigned := false
+	for _, r := range pr.Reviewers {
+		if r.ID == oldUserID {
+			isAssigned = true
+			break
+		}
+	}
+	if !isAssigned {
+		return fmt.Errorf("%w: user %s, PR %s", domain.ErrNotAssigned, oldUserID, pr.ID)
+	}
+	return nil
+}
+
+// reassignReviewerInTx replaces oldUserID with an automatically picked reviewer
+// and records the reassignment. When nobody is available the removal is still
+// recorded, the PR is added to missed and ErrNoCandidate is returned; callers
+// that commit anyway keep both.
+// Users in exclude are never picked. The new reviewer is optional if the old one was;
+// why they were picked is set in pr.Explanations.
+func (s *PullRequestService) reassignReviewerInTx(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, oldUserID string, reason domain.ReassignmentReason, decline domain.DeclineReason, missed *noCandidates, exclude ...string) (string, error) {
+	optional, err := s.prRepo.RemoveReviewer(ctx, tx, pr.ID, oldUserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to remove reviewer: %w", err)
+	}
+
+	// Refetch reviewers inside the transaction to get the current state after removal.
+	currentReviewers, err := s.prRepo.GetReviewers(ctx, pr.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current reviewers: %w", err)
+	}
+
+	remainingReviewers := make([]domain.User, 0, len(currentReviewers))
+	currentReviewerIDs := make([]string, 0, len(currentReviewers))
+	for _, r := range currentReviewers {
+		if r.ID != oldUserID {
+			remainingReviewers = append(remainingReviewers, r)
+			currentReviewerIDs = append(currentReviewerIDs, r.ID)
+		}
+	}
+	author, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return "", err
+	}
+
+	excludeIDs := append(append(currentReviewerIDs, oldUserID), exclude...)
+	candidates, err := s.selectReviewers(ctx, tx, author, route, remainingReviewers, excludeIDs, pr.Priority, 1)
+	if err != nil {
+		return "", fmt.Errorf("failed to find new candidate: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		s.log.WarnContext(ctx, "no new reviewer found for PR", "pr_id", pr.ID)
+		missed.add(route.teamID, pr.ID)
+		if err := s.prRepo.RecordReassignment(ctx, tx, pr.ID, oldUserID, "", reason, decline); err != nil {
+			return "", fmt.Errorf("failed to record reassignment: %w", err)
+		}
+		return "", fmt.Errorf("%w: no new reviewer found for PR: %v", domain.ErrNoCandidate, pr.ID)
+	}
+
+	newReviewerID := candidates[0].ID
+	pr.Explanations = route.explain([]string{newReviewerID})
+	if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, []string{newReviewerID}, optional); err != nil {
+		return "", fmt.Errorf("failed to assign new reviewer: %w", err)
+	}
+	if err := s.prRepo.RecordReassignment(ctx, tx, pr.ID, oldUserID, newReviewerID, reason, decline); err != nil {
+		return "", fmt.Errorf("failed to record reassignment: %w", err)
+	}
 
-				if authorTeam.IsActive {
-					excludeIDs := currentReviewersToIDs(currentReviewers)
-					candidates, err := s.userRepo.FindReviewCandidates(ctx, author.TeamID, pr.AuthorID, excludeIDs, maxReviewers-len(currentReviewers))
-					if err != nil {
-						return 0, fmt.Errorf("failed to find review candidates for PR %s: %w", pr.ID, err)
-					}
-
-					if len(candidates) > 0 {
-						candidateIDs := make([]string, len(candidates))
-						for i, c := range candidates {
-							candidateIDs[i] = c.ID
-						}
-						if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, candidateIDs); err != nil {
-							return 0, fmt.Errorf("failed to assign new reviewers for PR %s: %w", pr.ID, err)
-						}
-						reassignedCount++
-					}
+	return newReviewerID, nil
+}
+
+// workloads returns the current load of the users in their order next to
+// capacity, the cap on open reviews that applies to them.
+func (s *PullRequestService) workloads(ctx context.Context, users []domain.User, capacity int) ([]domain.Workload, error) {
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	reviews, err := s.userRepo.GetOpenReviewCounts(ctx, nil, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open review counts: %w", err)
+	}
+	authored, err := s.userRepo.GetOpenAuthoredCounts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open PR counts: %w", err)
+	}
+
+	workloads := make([]domain.Workload, len(users))
+	for i, u := range users {
+		workloads[i] = domain.Workload{
+			User:         u,
+			OpenReviews:  reviews[u.ID],
+			OpenAuthored: authored[u.ID],
+			Capacity:     capacity,
+		}
+	}
+	return workloads, nil
+}
+
+// maxSuggestions bounds how many reviewer suggestions are returned at once.
+const maxSuggestions = 50
+
+// SuggestReviewers ranks the users that could be added as reviewers of an
+// open PR without assigning anybody. The order is the one automatic selection
+// picks in, with fewer open reviews first where selection picks at random;
+// users over the reviewer cap or out of review budget, whom selection skips,
+// come last.
+func (s *PullRequestService) SuggestReviewers(ctx context.Context, prID string, limit int) ([]domain.ReviewerSuggestion, error) {
+	if limit <= 0 || limit > maxSuggestions {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSuggestions)
+	}
+	pr, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if !pr.IsOpen() {
+		return nil, pr.NotOpenError()
+	}
+	author, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.candidates.get(ctx, route.teamID, s.loadCandidatePool)
+	if err != nil {
+		return nil, err
+	}
+
+	q := suggestionQuery(pr, author, route)
+	candidates := pool.eligible(q)
+	if len(candidates) == 0 {
+		return []domain.ReviewerSuggestion{}, nil
+	}
+	scorer, err := s.suggestionScorer(ctx, pr, author, route, pool, candidates)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := s.workloads(ctx, candidates, scorer.maxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]domain.ReviewerSuggestion, len(workloads))
+	for i, w := range workloads {
+		suggestions[i] = scorer.score(w)
+	}
+	rankSuggestions(suggestions, pool, q)
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// suggestionQuery selects the candidates for suggestions: those selection
+// could pick for pr, except its current reviewers.
+func suggestionQuery(pr *domain.PullRequest, author *domain.User, route *reviewRoute) candidateQuery {
+	q := candidateQuery{authorID: author.ID, skills: route.skills, cooldown: route.cooldown}
+	for _, r := range pr.Reviewers {
+		q.excludeIDs = append(q.excludeIDs, r.ID)
+	}
+	return q
+}
+
+// reviewerScorer turns the workload of a candidate into a suggestion for one
+// PR.
+type reviewerScorer struct {
+	pool           *candidatePool
+	authorID       string
+	cooldown       []string
+	skills         []string
+	urgent         bool
+	maxOpenReviews int
+	budgetUsed     map[string]int
+}
+
+// suggestionScorer loads what scoring the candidates of pr needs: the reviewer
+// cap of the team and, when it has a review budget, the budget used.
+func (s *PullRequestService) suggestionScorer(ctx context.Context, pr *domain.PullRequest, author *domain.User, route *reviewRoute, pool *candidatePool, candidates []domain.User) (*reviewerScorer, error) {
+	settings, err := s.settings.effective(ctx, route.teamID)
+	if err != nil {
+		return nil, err
+	}
+	scorer := &reviewerScorer{
+		pool:           pool,
+		authorID:       author.ID,
+		cooldown:       route.cooldown,
+		skills:         slices.Compact(slices.Sorted(slices.Values(route.skills))),
+		urgent:         pr.Priority == domain.PriorityUrgent,
+		maxOpenReviews: settings.MaxOpenReviews,
+	}
+	if pool.budget > 0 {
+		if scorer.budgetUsed, err = s.userRepo.GetReviewBudgetUsage(ctx, nil, currentReviewersToIDs(candidates)); err != nil {
+			return nil, err
+		}
+	}
+	return scorer, nil
+}
+
+// score returns the suggestion for the candidate with workload w.
+func (sc *reviewerScorer) score(w domain.Workload) domain.ReviewerSuggestion {
+	sg := domain.ReviewerSuggestion{
+		User:             w.User,
+		OpenReviews:      w.OpenReviews,
+		MatchedSkills:    []string{},
+		SkillMatchScore:  1,
+		PreferenceWeight: sc.pool.weights[[2]string{sc.authorID, w.User.ID}],
+		OnRotation:       w.User.ID == sc.pool.primary,
+		InCooldown:       slices.Contains(sc.cooldown, w.User.ID),
+		OverCapacity:     !sc.urgent && (!w.CanTakeReview() || sc.pool.budget > 0 && sc.budgetUsed[w.User.ID] >= sc.pool.budget),
+	}
+	if w.Capacity > 0 {
+		sg.LoadScore = max(0, 1-float64(w.OpenReviews)/float64(w.Capacity))
+	} else {
+		sg.LoadScore = 1 / float64(1+w.OpenReviews)
+	}
+	for _, skill := range sc.skills {
+		if slices.Contains(w.User.Skills, skill) {
+			sg.MatchedSkills = append(sg.MatchedSkills, skill)
+		}
+	}
+	if len(sc.skills) > 0 {
+		sg.SkillMatchScore = float64(len(sg.MatchedSkills)) / float64(len(sc.skills))
+	}
+	return sg
+}
+
+// rankSuggestions sorts suggestions in the order selection picks in, with the
+// users over capacity last.
+func rankSuggestions(suggestions []domain.ReviewerSuggestion, pool *candidatePool, q candidateQuery) {
+	slices.SortFunc(suggestions, func(a, b domain.ReviewerSuggestion) int {
+		if a.OverCapacity != b.OverCapacity {
+			if a.OverCapacity {
+				return 1
+			}
+			return -1
+		}
+		if c := pool.compare(a.User, b.User, q); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.OpenReviews, b.OpenReviews); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.User.Username, b.User.Username)
+	})
+}
+
+// routedReview is an open PR together with the author and review route it was
+// resolved with.
+type routedReview struct {
+	pr     *domain.PullRequest
+	author *domain.User
+	route  *reviewRoute
+}
+
+// teamReviews returns the open PRs userID reviews whose reviewers come from
+// teamID.
+func (s *PullRequestService) teamReviews(ctx context.Context, tx domain.Tx, userID string, teamID int32) ([]routedReview, error) {
+	prs, err := s.prRepo.GetOpenPRsByReviewer(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open PRs for user %s: %w", userID, err)
+	}
+	var reviews []routedReview
+	for i := range prs {
+		pr := &prs[i]
+		if !pr.IsOpen() {
+			continue
+		}
+		author, route, err := s.routePR(ctx, pr)
+		if err != nil {
+			return nil, err
+		}
+		if route.teamID == teamID {
+			reviews = append(reviews, routedReview{pr: pr, author: author, route: route})
+		}
+	}
+	return reviews, nil
+}
+
+// replaceReviewerInTx hands the review of userID over to an automatically
+// picked reviewer and records the reassignment. Unlike reassignReviewerInTx it
+// keeps userID on the PR when nobody is available, adds the PR to missed and
+// returns "" then.
+func (s *PullRequestService) replaceReviewerInTx(ctx context.Context, tx domain.Tx, r routedReview, userID string, reason domain.ReassignmentReason, missed *noCandidates) (string, error) {
+	reviewers, err := s.prRepo.GetReviewers(ctx, r.pr.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get reviewers for PR %s: %w", r.pr.ID, err)
+	}
+	remaining := slices.DeleteFunc(slices.Clone(reviewers), func(u domain.User) bool { return u.ID == userID })
+	candidates, err := s.selectReviewers(ctx, tx, r.author, r.route, remaining, currentReviewersToIDs(reviewers), r.pr.Priority, 1)
+	if err != nil {
+		return "", fmt.Errorf("failed to find new candidate: %w", err)
+	}
+	if len(candidates) == 0 {
+		missed.add(r.route.teamID, r.pr.ID)
+		return "", nil
+	}
+
+	newReviewerID := candidates[0].ID
+	optional, err := s.prRepo.RemoveReviewer(ctx, tx, r.pr.ID, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to remove reviewer: %w", err)
+	}
+	if err := s.prRepo.AssignReviewers(ctx, tx, r.pr.ID, []string{newReviewerID}, optional); err != nil {
+		return "", fmt.Errorf("failed to assign new reviewer: %w", err)
+	}
+	if err := s.prRepo.RecordReassignment(ctx, tx, r.pr.ID, userID, newReviewerID, reason, ""); err != nil {
+		return "", fmt.Errorf("failed to record reassignment: %w", err)
+	}
+	return newReviewerID, nil
+}
+
+// AddEscalationReviewer adds one more reviewer to a stalled PR, even past the
+// usual limit, and records that the PR was escalated. It returns "" when the
+// PR was already escalated or no candidate is available.
+func (s *PullRequestService) AddEscalationReviewer(ctx context.Context, prID string) (string, error) {
+	pr, err := s.GetPR(ctx, prID)
+	if err != nil {
+		return "", err
+	}
+	author, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return "", err
+	}
+
+	var first bool
+	var newReviewerID string
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		newReviewerID = ""
+		var err error
+		first, err = s.prRepo.MarkReviewerEscalated(ctx, tx, prID)
+		if err != nil || !first {
+			return err
+		}
+
+		if pr.RequiredReviewerCount() < maxEscalatedReviewers {
+			reviewers, err := s.prRepo.GetReviewers(ctx, prID)
+			if err != nil {
+				return fmt.Errorf("failed to get reviewers: %w", err)
+			}
+			candidates, err := s.selectReviewers(ctx, tx, author, route, reviewers, currentReviewersToIDs(reviewers), pr.Priority, 1)
+			if err != nil {
+				return fmt.Errorf("failed to find review candidates: %w", err)
+			}
+			if len(candidates) > 0 {
+				newReviewerID = candidates[0].ID
+				if err := s.prRepo.AssignReviewers(ctx, tx, prID, []string{newReviewerID}, false); err != nil {
+					return fmt.Errorf("failed to assign reviewer: %w", err)
 				}
 			}
 		}
+		return nil
+	})
+	if err != nil || !first {
+		return "", err
+	}
+
+	if newReviewerID == "" {
+		s.log.WarnContext(ctx, "no extra reviewer available for stalled PR", "pr_id", prID)
+		return "", nil
+	}
+	s.log.InfoContext(ctx, "added reviewer to stalled PR", "event", "pr.escalated", "pr_id", prID, "user_id", newReviewerID)
+	s.notifyReviewersChanged(ctx, prID, []string{newReviewerID})
+	s.publishPRChange(ctx, prID, domain.PRReviewersChanged)
+	return newReviewerID, nil
+}
+
+func (s *PullRequestService) GetReviewsForUser(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+	return s.prRepo.GetPRsByReviewer(ctx, userID)
+}
+
+func (s *PullRequestService) GetOpenPRsWithoutReviewers(ctx context.Context) ([]domain.PullRequest, error) {
+	return s.prRepo.GetOpenPRsWithoutReviewers(ctx)
+}
+
+// SearchPRs runs a full-text search over PR names and descriptions. The query
+// accepts web search syntax ("quoted phrases", OR, -excluded words).
+func (s *PullRequestService) SearchPRs(ctx context.Context, query string, limit, offset int) (*domain.PRSearchPage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: search query is required", domain.ErrValidation)
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+
+	hits, total, err := s.prRepo.SearchPRs(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search pull requests: %w", err)
+	}
+	return &domain.PRSearchPage{Hits: hits, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// ListPRs returns a page of the PRs matching the filter. AuthorID and
+// ReviewerID set to "@me" stand for the actor of the request.
+func (s *PullRequestService) ListPRs(ctx context.Context, filter domain.PRFilter) (*domain.PRListPage, error) {
+	if err := validatePRFilter(filter); err != nil {
+		return nil, err
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+	for _, id := range []*string{&filter.AuthorID, &filter.ReviewerID} {
+		if *id != domain.MeFilterValue {
+			continue
+		}
+		*id = domain.ActorFromContext(ctx)
+		if *id == "" {
+			return nil, fmt.Errorf("%w: %s needs the X-Actor-Id header", domain.ErrValidation, domain.MeFilterValue)
+		}
+	}
+
+	prs, total, err := s.prRepo.FilterPRs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	return &domain.PRListPage{PullRequests: prs, Total: total, Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+// validatePRFilter checks the filter fields, leaving limit and offset alone.
+func validatePRFilter(filter domain.PRFilter) error {
+	if filter.Status != "" && !filter.Status.Valid() {
+		return fmt.Errorf("%w: unknown status %q", domain.ErrValidation, filter.Status)
+	}
+	if filter.Priority != "" && !filter.Priority.Valid() {
+		return fmt.Errorf("%w: unknown priority %q", domain.ErrValidation, filter.Priority)
+	}
+	if filter.MinAgeHours < 0 {
+		return fmt.Errorf("%w: min_age_hours must not be negative", domain.ErrValidation)
+	}
+	return nil
+}
+
+// reassignReviewsOfTeam takes the deactivated members of team off their open
+// reviews. Removals and the reassignment log are written with one statement
+// each; only PRs left without any reviewer get new ones, picked per PR.
+func (s *PullRequestService) reassignReviewsOfTeam(ctx context.Context, tx domain.Tx, team *domain.Team, userIDs []string, missed *noCandidates) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	removed, err := s.prRepo.RemoveOpenReviewsByUsers(ctx, tx, userIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove reviewers: %w", err)
+	}
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	prIDs := make([]string, 0, len(removed))
+	for _, m := range removed {
+		prIDs = append(prIDs, m.PRID)
+	}
+	orphaned, err := s.prRepo.GetOpenPRsWithoutReviewersByIDs(ctx, tx, prIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find PRs left without reviewers: %w", err)
+	}
+
+	// Candidates are read outside tx, where the members are still active.
+	replacements := make(map[string]string, len(orphaned))
+	teamActive := map[int32]bool{team.ID: false}
+	for i := range orphaned {
+		to, err := s.replaceOrphanedReviewers(ctx, tx, &orphaned[i], userIDs, teamActive, missed)
+		if err != nil {
+			return 0, err
+		}
+		if to != "" {
+			replacements[orphaned[i].ID] = to
+		}
+	}
+	reassignedCount := len(replacements)
+
+	// A PR that lost several reviewers at once logs its replacement only once.
+	for i := range removed {
+		if to, ok := replacements[removed[i].PRID]; ok {
+			removed[i].ToUserID = to
+			delete(replacements, removed[i].PRID)
+		}
+	}
+	if err := s.prRepo.RecordReassignments(ctx, tx, removed, domain.ReassignmentDeactivation); err != nil {
+		return 0, fmt.Errorf("failed to record reassignments: %w", err)
 	}
 	return reassignedCount, nil
 }
 
+// replaceOrphanedReviewers assigns new reviewers, none of userIDs, to pr, a PR
+// left without any, and returns the first of them. It returns "" when the
+// reviewers' team is inactive or has nobody to pick, noting the latter in
+// missed; teamActive caches whether each team seen so far is active.
+func (s *PullRequestService) replaceOrphanedReviewers(ctx context.Context, tx domain.Tx, pr *domain.PullRequest, userIDs []string, teamActive map[int32]bool, missed *noCandidates) (string, error) {
+	author, route, err := s.routePR(ctx, pr)
+	if err != nil {
+		return "", fmt.Errorf("failed to route reviews for PR %s: %w", pr.ID, err)
+	}
+	active, ok := teamActive[route.teamID]
+	if !ok {
+		reviewTeam, err := s.teamRepo.GetTeamByID(ctx, route.teamID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get reviewers' team for PR %s: %w", pr.ID, err)
+		}
+		active = reviewTeam.IsActive
+		teamActive[route.teamID] = active
+	}
+	if !active {
+		return "", nil
+	}
+
+	candidates, err := s.selectReviewers(ctx, tx, author, route, nil, userIDs, pr.Priority, route.limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to find review candidates for PR %s: %w", pr.ID, err)
+	}
+	if len(candidates) == 0 {
+		missed.add(route.teamID, pr.ID)
+		return "", nil
+	}
+	candidateIDs := currentReviewersToIDs(candidates)
+	if err := s.prRepo.AssignReviewers(ctx, tx, pr.ID, candidateIDs, false); err != nil {
+		return "", fmt.Errorf("failed to assign new reviewers for PR %s: %w", pr.ID, err)
+	}
+	return candidateIDs[0], nil
+}
+
+// selectReviewers picks up to limit new reviewers for a PR by author from the route's team.
+// If that team requires a reviewer role that none of the current reviewers has, one slot
+// is filled with a user of that role first. Users with any of the route's skills are
+// preferred.
+func (s *PullRequestService) selectReviewers(ctx context.Context, tx domain.Tx, author *domain.User, route *reviewRoute, current []domain.User, excludeIDs []string, priority domain.PRPriority, limit int) ([]domain.User, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	capped := priority != domain.PriorityUrgent
+
+	team, err := s.teamRepo.GetTeamByID(ctx, route.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []domain.User
+	if role := team.RequiredReviewerRole; role != "" && !domain.HasRole(current, role) {
+		selected, err = s.findReviewCandidates(ctx, tx, author, route, excludeIDs, role, capped, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(selected) == 0 {
+			s.log.WarnContext(ctx, "no reviewer with required role available", "team_id", team.ID, "role", role)
+		}
+		for _, u := range selected {
+			excludeIDs = append(excludeIDs, u.ID)
+		}
+	}
+
+	if remaining := limit - len(selected); remaining > 0 {
+		rest, err := s.findReviewCandidates(ctx, tx, author, route, excludeIDs, "", capped, remaining)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, rest...)
+	}
+	return selected, nil
+}
+
+// findReviewCandidates picks reviewers from the route's team. When the team has no
+// candidates and is set to escalate, the search continues in its parent team, and so on
+// up the hierarchy. When capped, users at the open reviews cap or out of their
+// team's review budget are skipped.
+func (s *PullRequestService) findReviewCandidates(ctx context.Context, tx domain.Tx, author *domain.User, route *reviewRoute, excludeIDs []string, role string, capped bool, limit int) ([]domain.User, error) {
+	teamID := route.teamID
+	visited := make(map[int32]bool)
+	for {
+		candidates, err := s.teamCandidates(ctx, tx, teamID, candidateQuery{
+			authorID:     author.ID,
+			excludeIDs:   excludeIDs,
+			role:         role,
+			skills:       route.skills,
+			cooldown:     route.cooldown,
+			hints:        route.hints,
+			limit:        limit,
+			prID:         route.prID,
+			explanations: route.explanations,
+		}, capped)
+		if err != nil || len(candidates) > 0 {
+			return candidates, err
+		}
+		visited[teamID] = true
+
+		team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if !team.EscalateToParent || team.ParentTeamID == nil || visited[*team.ParentTeamID] {
+			return candidates, nil
+		}
+
+		s.log.InfoContext(ctx, "escalating reviewer search to parent team", "team_id", teamID, "parent_team_id", *team.ParentTeamID)
+		teamID = *team.ParentTeamID
+	}
+}
+
+// teamCandidates picks up to q.limit reviewers from the team's cached pool.
+// Open review counts and budget usage change with every assignment, so they
+// are read fresh, in tx so that assignments made earlier in it count, and
+// only when the team's settings or budget need them.
+func (s *PullRequestService) teamCandidates(ctx context.Context, tx domain.Tx, teamID int32, q candidateQuery, capped bool) ([]domain.User, error) {
+	pool, err := s.candidates.get(ctx, teamID, s.loadCandidatePool)
+	if err != nil {
+		return nil, err
+	}
+	candidates := pool.eligible(q)
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+	settings, err := s.settings.effective(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	candidates, open, err := s.withinCapacity(ctx, tx, pool, candidates, settings, capped)
+	if err != nil {
+		return nil, err
+	}
+	eligible := len(candidates)
+	picked := pool.rank(candidates, q, settings.SelectionStrategy, open, selectionRand(s.selectionSalt, q.prID, selectionStreamRank))
+	if q.explanations != nil {
+		for _, u := range picked {
+			q.explanations[u.ID] = pool.explain(u, eligible, q, settings.SelectionStrategy, open)
+		}
+	}
+	return picked, nil
+}
+
+// withinCapacity drops the candidates at the reviewer cap or out of review
+// budget when capped. It also returns the open review counts of the
+// candidates, read when the cap or the selection strategy needs them.
+func (s *PullRequestService) withinCapacity(ctx context.Context, tx domain.Tx, pool *candidatePool, candidates []domain.User, settings domain.Settings, capped bool) ([]domain.User, map[string]int, error) {
+	weighsLoad := settings.SelectionStrategy == domain.SelectionLeastLoaded || settings.SelectionStrategy == domain.SelectionWeightedRandom
+	capOpen := capped && settings.MaxOpenReviews > 0
+	ids := currentReviewersToIDs(candidates)
+	var open map[string]int
+	if weighsLoad || capOpen {
+		var err error
+		if open, err = s.userRepo.GetOpenReviewCounts(ctx, tx, ids); err != nil {
+			return nil, nil, err
+		}
+	}
+	if capOpen {
+		candidates = slices.DeleteFunc(candidates, func(u domain.User) bool {
+			return open[u.ID] >= settings.MaxOpenReviews
+		})
+	}
+	if capped && pool.budget > 0 {
+		used, err := s.userRepo.GetReviewBudgetUsage(ctx, tx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = slices.DeleteFunc(candidates, func(u domain.User) bool {
+			return used[u.ID] >= pool.budget
+		})
+	}
+	return candidates, open, nil
+}
+
+func (s *PullRequestService) loadCandidatePool(ctx context.Context, teamID int32) (*candidatePool, error) {
+	members, err := s.userRepo.GetActiveUsersByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := s.teamRepo.GetReviewerPreferences(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	reviewerPool, err := s.teamRepo.GetReviewerPool(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	pool := &candidatePool{members: members, reviewerPool: reviewerPool, weights: make(map[[2]string]int, len(prefs))}
+	for _, p := range prefs {
+		pool.weights[[2]string{p.AuthorID, p.ReviewerID}] = p.Weight
+	}
+	budget, err := s.teamRepo.GetReviewBudget(ctx, teamID)
+	switch {
+	case err == nil:
+		pool.budget = budget.ReviewsPerSprint
+	case !errors.Is(err, domain.ErrNotFound):
+		return nil, err
+	}
+	rotation, err := s.teamRepo.GetReviewRotation(ctx, teamID)
+	switch {
+	case err == nil:
+		pool.primary = rotation.Week(domain.WeekStart(time.Now())).UserID
+	case !errors.Is(err, domain.ErrNotFound):
+		return nil, err
+	}
+	return pool, nil
+}
+
+// invalidateCandidates drops the cached candidate pools; it is called after
+// user and team mutations are committed.
+func (s *PullRequestService) invalidateCandidates() {
+	s.candidates.invalidate()
+}
+
+// reviewRoute tells where the reviewers of a PR come from.
+type reviewRoute struct {
+	// prID is the PR being routed; it seeds the random choices of reviewers.
+	prID   string
+	teamID int32
+	skills []string
+	// limit is the number of required reviewers a PR gets.
+	limit int
+	// optional is the number of optional reviewers a new PR gets on top.
+	optional int
+	// shadowPercent is the chance, in percent, that a new PR gets a shadow
+	// reviewer.
+	shadowPercent int
+	// cooldown lists the users picked only when nobody else is available.
+	cooldown []string
+	// checklist is copied to new PRs.
+	checklist []string
+	// selfReview allows the author to review their own PR.
+	selfReview bool
+	// autoMerge allows the PR to be merged automatically once approved.
+	autoMerge bool
+	// rule is the name of the review rule that routed the PR, if any.
+	rule string
+	// hints lists users picked before anyone else when they are eligible.
+	hints []string
+	// explanations collects why reviewers were picked for the PR, by user ID.
+	explanations map[string]domain.ReviewerExplanation
+}
+
+// explain returns why the given reviewers were picked, leaving out those
+// that were not picked by the route, such as the author reviewing their own PR.
+func (r *reviewRoute) explain(userIDs []string) []domain.ReviewerExplanation {
+	var explanations []domain.ReviewerExplanation
+	for _, id := range userIDs {
+		if e, ok := r.explanations[id]; ok {
+			explanations = append(explanations, e)
+		}
+	}
+	return explanations
+}
+
+// routePR resolves the review route of a stored PR and returns its author too.
+func (s *PullRequestService) routePR(ctx context.Context, pr *domain.PullRequest) (*domain.User, *reviewRoute, error) {
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	route, err := s.routeReviews(ctx, pr, author)
+	if err != nil {
+		return nil, nil, err
+	}
+	return author, route, nil
+}
+
+// routeReviews resolves the review route of a PR by author. Without a
+// repository reviewers come from the author's team; otherwise the repository's
+// first matching routing rule, then its default team, take precedence, and the
+// rule's skills are added to the PR's own. A Review-Team trailer in the
+// description overrides both, and a matching review rule overrides all of
+// them. The review cooldown and size rules of the chosen team apply either
+// way, and the chosen team's members named in a Reviewers trailer are picked
+// first when they are eligible.
+func (s *PullRequestService) routeReviews(ctx context.Context, pr *domain.PullRequest, author *domain.User) (*reviewRoute, error) {
+	rules, err := s.ruleRepo.ListReviewRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review rules: %w", err)
+	}
+	return s.routeWithRules(ctx, pr, author, rules)
+}
+
+// routeWithRules is routeReviews with the review rules given in evaluation
+// order, so that unsaved rules can be tried out.
+func (s *PullRequestService) routeWithRules(ctx context.Context, pr *domain.PullRequest, author *domain.User, rules []domain.ReviewRule) (*reviewRoute, error) {
+	route := &reviewRoute{prID: pr.ID, teamID: author.TeamID, skills: pr.RequiredSkills, explanations: make(map[string]domain.ReviewerExplanation)}
+	if pr.Repository != "" {
+		if err := s.routeByRepository(ctx, pr, route); err != nil {
+			return nil, err
+		}
+	}
+	trailers := domain.ParseReviewTrailers(pr.Description)
+	if trailers.Team != "" {
+		if err := s.routeByTrailer(ctx, pr, trailers.Team, route); err != nil {
+			return nil, err
+		}
+	}
+	if rule := domain.MatchReviewRule(rules, pr); rule != nil {
+		route.applyReviewRule(rule)
+	}
+
+	team, err := s.teamRepo.GetTeamByID(ctx, route.teamID)
+	if err != nil {
+		return nil, err
+	}
+	if len(trailers.Reviewers) > 0 {
+		if route.hints, err = s.trailerReviewers(ctx, pr, team.TeamName, trailers.Reviewers); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.applyTeamSettings(ctx, pr, team, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+// routeByTrailer routes the reviews to the team named in a Review-Team
+// trailer, unless it is unknown or inactive.
+func (s *PullRequestService) routeByTrailer(ctx context.Context, pr *domain.PullRequest, teamName string, route *reviewRoute) error {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	switch {
+	case errors.Is(err, domain.ErrNotFound) || (err == nil && !team.IsActive):
+		s.log.WarnContext(ctx, "ignoring Review-Team trailer of an unknown or inactive team", "pr_id", pr.ID, "team_name", teamName)
+	case err != nil:
+		return err
+	default:
+		route.teamID = team.ID
+	}
+	return nil
+}
+
+// applyReviewRule routes the reviews as the matching review rule says.
+func (r *reviewRoute) applyReviewRule(rule *domain.ReviewRule) {
+	r.rule = rule.Name
+	if rule.TeamID != nil {
+		r.teamID = *rule.TeamID
+	}
+	if rule.Reviewers > 0 {
+		r.limit = rule.Reviewers
+	}
+	r.addSkills(rule.RequiredSkills)
+}
+
+// applyTeamSettings completes the route with the settings of the reviewers'
+// team: reviewer counts, size rules, checklist and review cooldown.
+func (s *PullRequestService) applyTeamSettings(ctx context.Context, pr *domain.PullRequest, team *domain.Team, route *reviewRoute) error {
+	settings, err := s.settings.effective(ctx, route.teamID)
+	if err != nil {
+		return err
+	}
+	if route.limit == 0 {
+		route.limit = settings.MaxReviewers
+	}
+	route.autoMerge = settings.AutoMerge
+	route.checklist = team.Checklist.Items
+	route.selfReview = team.AllowSelfReview
+	route.optional = team.OptionalReviewers
+	if settings.ShadowReviews {
+		route.shadowPercent = team.ShadowReviewPercent
+	}
+	if pr.Size.Known() {
+		rules, err := s.teamRepo.GetTeamSizeRules(ctx, route.teamID)
+		if err != nil {
+			return fmt.Errorf("failed to get size rules: %w", err)
+		}
+		if rule := domain.MatchSizeRule(rules, pr.Size); rule != nil {
+			route.limit = min(route.limit, rule.Reviewers)
+		}
+	}
+	if team.ReviewCooldownPRs > 0 {
+		route.cooldown, err = s.prRepo.GetRecentReviewers(ctx, pr.AuthorID, pr.ID, team.ReviewCooldownPRs)
+		if err != nil {
+			return fmt.Errorf("failed to get recent reviewers: %w", err)
+		}
+	}
+	return nil
+}
+
+// trailerReviewers resolves the names in a Reviewers trailer to the IDs of
+// the team's members, by username ignoring case or by user ID. Names of
+// anyone else are ignored.
+func (s *PullRequestService) trailerReviewers(ctx context.Context, pr *domain.PullRequest, teamName string, names []string) ([]string, error) {
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+	var ids []string
+	for _, name := range names {
+		i := slices.IndexFunc(team.Members, func(u domain.User) bool {
+			return u.ID == name || strings.EqualFold(u.Username, name)
+		})
+		if i < 0 {
+			s.log.WarnContext(ctx, "ignoring Reviewers trailer entry outside the reviewers' team", "pr_id", pr.ID, "team_name", teamName, "username", name)
+			continue
+		}
+		ids = append(ids, team.Members[i].ID)
+	}
+	return ids, nil
+}
+
+func (s *PullRequestService) routeByRepository(ctx context.Context, pr *domain.PullRequest, route *reviewRoute) error {
+	repo, err := s.repoRepo.GetRepository(ctx, pr.Repository)
+	if err != nil {
+		return fmt.Errorf("failed to get repository: %w", err)
+	}
+	if repo.DefaultTeamID != nil {
+		route.teamID = *repo.DefaultTeamID
+	}
+	if repo.RequiredReviewers > 0 {
+		route.limit = repo.RequiredReviewers
+	}
+	if rule := repo.MatchRule(pr.Name); rule != nil {
+		if rule.TeamID != nil {
+			route.teamID = *rule.TeamID
+		}
+		route.addSkills(rule.RequiredSkills)
+	}
+	return nil
+}
+
+// addSkills adds the skills the route does not prefer yet without touching
+// the PR's own slice.
+func (r *reviewRoute) addSkills(skills []string) {
+	r.skills = slices.Clone(r.skills)
+	for _, skill := range skills {
+		if !slices.Contains(r.skills, skill) {
+			r.skills = append(r.skills, skill)
+		}
+	}
+}
+
+// logSelfReview records an author assigned to their own PR, which only teams
+// with self-review allowed permit. trigger is "manual" or "no_candidate".
+func (s *PullRequestService) logSelfReview(ctx context.Context, prID, authorID string, teamID int32, trigger string) {
+	s.log.WarnContext(ctx, "author assigned to review their own PR",
+		"event", "pr.self_review_assigned",
+		"pr_id", prID,
+		"user_id", authorID,
+		"team_id", teamID,
+		"trigger", trigger,
+	)
+}
+
+func (s *PullRequestService) notifyReviewersChanged(ctx context.Context, prID string, added []string) {
+	if s.notifier != nil {
+		s.notifier.ReviewersChanged(ctx, prID, added)
+	}
+}
+
+// noCandidates collects the PRs a transaction found no reviewer for. The
+// transaction may be retried, so they are reported once it is over, and each
+// attempt starts with an empty list.
+type noCandidates []noCandidate
+
+type noCandidate struct {
+	teamID int32
+	prID   string
+}
+
+func (n *noCandidates) add(teamID int32, prID string) {
+	*n = append(*n, noCandidate{teamID: teamID, prID: prID})
+}
+
+// reportNoCandidates reports the failed picks of a finished transaction.
+func (s *PullRequestService) reportNoCandidates(ctx context.Context, missed noCandidates) {
+	if s.noCandidate == nil {
+		return
+	}
+	for _, m := range missed {
+		s.noCandidate.NoCandidate(ctx, m.teamID, m.prID)
+	}
+}
+
+func (s *PullRequestService) publishPRChange(ctx context.Context, prID string, change domain.PRUpdateType) {
+	if s.observer != nil {
+		s.observer.PRChanged(ctx, prID, change)
+	}
+}
+
 func currentReviewersToIDs(reviewers []domain.User) []string {
 	ids := make([]string, len(reviewers))
 	for i, r := range reviewers {
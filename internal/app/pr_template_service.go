@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const (
+	maxTemplateNameLength   = 100
+	maxTemplatePrefixLength = 100
+	maxTemplateLabels       = 20
+	maxTemplateReviewers    = 10
+)
+
+// PRTemplateService manages the PR templates of teams. A template is picked
+// when a PR is created; see PullRequestService.CreatePR for how it applies.
+type PRTemplateService struct {
+	templateRepo domain.PRTemplateRepository
+	teamRepo     domain.TeamRepository
+	userRepo     domain.UserRepository
+	tx           domain.UnitOfWork
+	log          *slog.Logger
+}
+
+func NewPRTemplateService(
+	templateRepo domain.PRTemplateRepository,
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *PRTemplateService {
+	return &PRTemplateService{
+		templateRepo: templateRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		tx:           tx,
+		log:          log,
+	}
+}
+
+// CreateTemplate stores a new template for the team named in TeamName.
+func (s *PRTemplateService) CreateTemplate(ctx context.Context, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	if err := validatePRTemplate(template); err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, template.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	template.TeamID = team.ID
+	if err := s.checkDefaultReviewers(ctx, team, template.DefaultReviewers); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.PRTemplate
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = s.templateRepo.CreatePRTemplate(ctx, tx, template)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "PR template created", "event", "pr_template.created",
+		"template_id", saved.ID, "template_name", saved.Name, "team_name", saved.TeamName)
+	return saved, nil
+}
+
+// UpdateTemplate renames the template and replaces its settings; the team is kept.
+func (s *PRTemplateService) UpdateTemplate(ctx context.Context, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	if err := validatePRTemplate(template); err != nil {
+		return nil, err
+	}
+	current, err := s.templateRepo.GetPRTemplate(ctx, template.ID)
+	if err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetTeamByID(ctx, current.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDefaultReviewers(ctx, team, template.DefaultReviewers); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.PRTemplate
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = s.templateRepo.UpdatePRTemplate(ctx, tx, template)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "PR template updated", "event", "pr_template.updated", "template_id", saved.ID, "template_name", saved.Name)
+	return saved, nil
+}
+
+func (s *PRTemplateService) GetTemplate(ctx context.Context, id int64) (*domain.PRTemplate, error) {
+	return s.templateRepo.GetPRTemplate(ctx, id)
+}
+
+func (s *PRTemplateService) ListTemplates(ctx context.Context, teamName string) ([]domain.PRTemplate, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return s.templateRepo.ListPRTemplates(ctx, team.ID)
+}
+
+func (s *PRTemplateService) DeleteTemplate(ctx context.Context, id int64) error {
+	if err := s.templateRepo.DeletePRTemplate(ctx, id); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "PR template deleted", "event", "pr_template.deleted", "template_id", id)
+	return nil
+}
+
+// checkDefaultReviewers makes sure every default reviewer is a member of the team.
+func (s *PRTemplateService) checkDefaultReviewers(ctx context.Context, team *domain.Team, reviewerIDs []string) error {
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+	members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+	for _, id := range reviewerIDs {
+		if !slices.ContainsFunc(members, func(u domain.User) bool { return u.ID == id }) {
+			return fmt.Errorf("%w: default reviewer '%s' is not a member of team '%s'", domain.ErrValidation, id, team.TeamName)
+		}
+	}
+	return nil
+}
+
+func validatePRTemplate(template *domain.PRTemplate) error {
+	if template.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if len(template.Name) > maxTemplateNameLength {
+		return fmt.Errorf("%w: name must be at most %d characters", domain.ErrValidation, maxTemplateNameLength)
+	}
+	if len(template.NamePrefix) > maxTemplatePrefixLength {
+		return fmt.Errorf("%w: name_prefix must be at most %d characters", domain.ErrValidation, maxTemplatePrefixLength)
+	}
+	if len(template.Labels) > maxTemplateLabels {
+		return fmt.Errorf("%w: at most %d labels are allowed", domain.ErrValidation, maxTemplateLabels)
+	}
+	if slices.Contains(template.Labels, "") {
+		return fmt.Errorf("%w: labels cannot be empty", domain.ErrValidation)
+	}
+	if len(template.DefaultReviewers) > maxTemplateReviewers {
+		return fmt.Errorf("%w: at most %d default reviewers are allowed", domain.ErrValidation, maxTemplateReviewers)
+	}
+	for i, id := range template.DefaultReviewers {
+		if slices.Contains(template.DefaultReviewers[:i], id) {
+			return fmt.Errorf("%w: duplicate default reviewer '%s'", domain.ErrValidation, id)
+		}
+	}
+	return nil
+}
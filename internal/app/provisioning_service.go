@@ -0,0 +1,362 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// ProvisioningService lets a corporate identity provider manage users and
+// team memberships over SCIM. A user belongs to exactly one team, so joining
+// a team moves the user there and leaving it deactivates the user; users and
+// teams are deactivated rather than deleted.
+type ProvisioningService struct {
+	userRepo domain.UserRepository
+	teamRepo domain.TeamRepository
+	userSvc  *UserService
+	teamSvc  *TeamService
+	tx       domain.UnitOfWork
+	token    string
+	log      *slog.Logger
+}
+
+// NewProvisioningService creates the service. Clients must present token; an
+// empty token rejects everyone.
+func NewProvisioningService(
+	userRepo domain.UserRepository,
+	teamRepo domain.TeamRepository,
+	userSvc *UserService,
+	teamSvc *TeamService,
+	tx domain.UnitOfWork,
+	token string,
+	log *slog.Logger,
+) *ProvisioningService {
+	return &ProvisioningService{
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		userSvc:  userSvc,
+		teamSvc:  teamSvc,
+		tx:       tx,
+		token:    token,
+		log:      log,
+	}
+}
+
+// Authorize checks the bearer token of a provisioning request.
+func (s *ProvisioningService) Authorize(token string) error {
+	if s.token == "" {
+		return fmt.Errorf("%w: provisioning token is not configured", domain.ErrUnauthorized)
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		return fmt.Errorf("%w: invalid provisioning token", domain.ErrUnauthorized)
+	}
+	return nil
+}
+
+// ListUsers returns the users with the given username, or every user when it
+// is empty, team by team.
+func (s *ProvisioningService) ListUsers(ctx context.Context, username string) ([]domain.User, error) {
+	if username != "" {
+		return s.userRepo.GetUsersByUsername(ctx, username, "")
+	}
+	teams, err := s.teamRepo.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var users []domain.User
+	for _, team := range teams {
+		members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			m.TeamName = team.TeamName
+			users = append(users, m)
+		}
+	}
+	return users, nil
+}
+
+func (s *ProvisioningService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	return s.userRepo.GetUserByID(ctx, userID)
+}
+
+// CreateUser adds a user to the team; unlike the API, the team is required
+// even for inactive users, since it cannot be assigned later on creation.
+func (s *ProvisioningService) CreateUser(ctx context.Context, username, teamName string, isActive bool) (*domain.User, error) {
+	if teamName == "" {
+		return nil, fmt.Errorf("%w: the user's team (department) is required", domain.ErrValidation)
+	}
+	user, err := s.userSvc.AddUser(ctx, username, teamName, isActive)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "user provisioned", "event", "provisioning.user_created", "user_id", user.ID, "team_name", teamName)
+	return user, nil
+}
+
+// UpdateUser applies the patch. Each change is made on its own: a user being
+// activated is activated first and a user being deactivated is deactivated
+// last, so that moving to another team hands the open reviews over as
+// POST /users/moveToTeam with open_reviews=reassign does.
+func (s *ProvisioningService) UpdateUser(ctx context.Context, userID string, patch domain.UserPatch) (*domain.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUserPatch(patch); err != nil {
+		return nil, err
+	}
+
+	if patch.IsActive != nil && *patch.IsActive && !user.IsActive {
+		if _, err := s.userSvc.SetUserActiveStatus(ctx, userID, true); err != nil {
+			return nil, err
+		}
+		user.IsActive = true
+	}
+	if err := s.moveAndRenameUser(ctx, user, patch); err != nil {
+		return nil, err
+	}
+	if patch.IsActive != nil && !*patch.IsActive && user.IsActive {
+		if _, err := s.userSvc.SetUserActiveStatus(ctx, userID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	s.log.InfoContext(ctx, "user provisioned", "event", "provisioning.user_updated", "user_id", userID)
+	return s.userRepo.GetUserByID(ctx, userID)
+}
+
+func validateUserPatch(patch domain.UserPatch) error {
+	if patch.Username != nil && *patch.Username == "" {
+		return fmt.Errorf("%w: userName must not be empty", domain.ErrValidation)
+	}
+	if patch.TeamName != nil && *patch.TeamName == "" {
+		return fmt.Errorf("%w: the user's team (department) must not be empty", domain.ErrValidation)
+	}
+	return nil
+}
+
+// moveAndRenameUser applies the team and username of the patch that differ
+// from the user's, moving before renaming.
+func (s *ProvisioningService) moveAndRenameUser(ctx context.Context, user *domain.User, patch domain.UserPatch) error {
+	if patch.TeamName != nil && *patch.TeamName != user.TeamName {
+		if err := s.moveUser(ctx, user.ID, *patch.TeamName); err != nil {
+			return err
+		}
+	}
+	if patch.Username != nil && *patch.Username != user.Username {
+		if err := s.renameUser(ctx, user.ID, *patch.Username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeactivateUser handles the deletion of a user by the identity provider.
+func (s *ProvisioningService) DeactivateUser(ctx context.Context, userID string) error {
+	inactive := false
+	_, err := s.UpdateUser(ctx, userID, domain.UserPatch{IsActive: &inactive})
+	return err
+}
+
+// moveUser moves an active user like POST /users/moveToTeam; inactive users
+// have no reviews left and are moved directly.
+func (s *ProvisioningService) moveUser(ctx context.Context, userID, teamName string) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsActive {
+		_, _, err := s.userSvc.MoveUserToTeam(ctx, userID, teamName, domain.OpenReviewsReassign)
+		return err
+	}
+
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return err
+	}
+	if err := s.userSvc.checkUsernameFree(ctx, team, user.Username, user.ID); err != nil {
+		return err
+	}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		_, err := s.userRepo.MoveUserToTeam(ctx, tx, userID, team.ID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.userSvc.prSvc.invalidateCandidates()
+	return nil
+}
+
+func (s *ProvisioningService) renameUser(ctx context.Context, userID, username string) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	team, err := s.teamRepo.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return err
+	}
+	if err := s.userSvc.checkUsernameFree(ctx, team, username, user.ID); err != nil {
+		return err
+	}
+	user.Username = username
+	_, err = s.userSvc.UpdateUser(ctx, user)
+	return err
+}
+
+// ListGroups returns the team with the given name, or every team when it is
+// empty, with their members.
+func (s *ProvisioningService) ListGroups(ctx context.Context, teamName string) ([]domain.Team, error) {
+	var names []string
+	if teamName != "" {
+		names = []string{teamName}
+	} else {
+		teams, err := s.teamRepo.ListTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range teams {
+			names = append(names, t.TeamName)
+		}
+	}
+
+	groups := make([]domain.Team, 0, len(names))
+	for _, name := range names {
+		team, err := s.teamRepo.GetTeamWithMembers(ctx, name)
+		if errors.Is(err, domain.ErrNotFound) && teamName != "" {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, *team)
+	}
+	return groups, nil
+}
+
+// GetGroup returns the team with its members. Groups are identified by the
+// team's numeric ID, which, unlike its name, survives renames.
+func (s *ProvisioningService) GetGroup(ctx context.Context, teamID int32) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return s.teamRepo.GetTeamWithMembers(ctx, team.TeamName)
+}
+
+// CreateGroup creates an empty team and moves the given users into it.
+func (s *ProvisioningService) CreateGroup(ctx context.Context, teamName string, memberIDs []string) (*domain.Team, error) {
+	team, err := s.teamSvc.CreateTeam(ctx, teamName, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "team provisioned", "event", "provisioning.team_created", "team_name", teamName)
+	return s.AddGroupMembers(ctx, team.ID, memberIDs)
+}
+
+// RenameGroup renames the team.
+func (s *ProvisioningService) RenameGroup(ctx context.Context, teamID int32, newName string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if newName == "" {
+		return nil, fmt.Errorf("%w: displayName must not be empty", domain.ErrValidation)
+	}
+	if newName != team.TeamName {
+		if _, err := s.teamSvc.UpdateTeam(ctx, UpdateTeamInput{OldName: team.TeamName, NewName: newName}); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetGroup(ctx, teamID)
+}
+
+// AddGroupMembers moves the users into the team.
+func (s *ProvisioningService) AddGroupMembers(ctx context.Context, teamID int32, userIDs []string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range userIDs {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.TeamID == teamID {
+			continue
+		}
+		if err := s.moveUser(ctx, userID, team.TeamName); err != nil {
+			return nil, err
+		}
+		s.log.InfoContext(ctx, "team member provisioned", "event", "provisioning.member_added", "team_name", team.TeamName, "user_id", userID)
+	}
+	return s.GetGroup(ctx, teamID)
+}
+
+// RemoveGroupMembers deactivates the users that are still members of the
+// team; users already moved elsewhere are left alone.
+func (s *ProvisioningService) RemoveGroupMembers(ctx context.Context, teamID int32, userIDs []string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range userIDs {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if user.TeamID != teamID || !user.IsActive {
+			continue
+		}
+		if _, err := s.userSvc.SetUserActiveStatus(ctx, userID, false); err != nil {
+			return nil, err
+		}
+		s.log.InfoContext(ctx, "team member provisioned", "event", "provisioning.member_removed", "team_name", team.TeamName, "user_id", userID)
+	}
+	return s.GetGroup(ctx, teamID)
+}
+
+// SetGroupMembers makes the users the team's only active members.
+func (s *ProvisioningService) SetGroupMembers(ctx context.Context, teamID int32, userIDs []string) (*domain.Team, error) {
+	team, err := s.GetGroup(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		keep[id] = true
+	}
+	var removed []string
+	for _, m := range team.Members {
+		if !keep[m.ID] {
+			removed = append(removed, m.ID)
+		}
+	}
+	if _, err := s.RemoveGroupMembers(ctx, teamID, removed); err != nil {
+		return nil, err
+	}
+	return s.AddGroupMembers(ctx, teamID, userIDs)
+}
+
+// DeleteGroup deactivates the team and its members, reassigning their reviews.
+func (s *ProvisioningService) DeleteGroup(ctx context.Context, teamID int32) error {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if _, _, err := s.teamSvc.DeactivateTeamAndReassign(ctx, team.TeamName); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "team deprovisioned", "event", "provisioning.team_deactivated", "team_name", team.TeamName)
+	return nil
+}
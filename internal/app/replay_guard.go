@@ -0,0 +1,49 @@
+package app
+
+import (
+	"sync"
+	"time"
+)
+
+// replayGuard remembers the signatures of accepted webhook deliveries for a
+// window, so that a captured delivery cannot be sent again while it is
+// remembered. It is kept in memory per process: each replica refuses only the
+// replays it has seen itself. A zero window turns the guard off.
+type replayGuard struct {
+	mu     sync.Mutex
+	window time.Duration
+	seen   map[string]time.Time
+}
+
+func newReplayGuard(window time.Duration) *replayGuard {
+	return &replayGuard{window: window, seen: make(map[string]time.Time)}
+}
+
+// reserve records signature and reports whether it was not seen within the
+// window. Expired signatures are forgotten on the way.
+func (g *replayGuard) reserve(signature string) bool {
+	if g.window <= 0 {
+		return true
+	}
+	now := time.Now()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for sig, at := range g.seen {
+		if now.Sub(at) >= g.window {
+			delete(g.seen, sig)
+		}
+	}
+	if _, ok := g.seen[signature]; ok {
+		return false
+	}
+	g.seen[signature] = now
+	return true
+}
+
+// release forgets a reserved signature, so that a delivery that failed can be
+// redelivered.
+func (g *replayGuard) release(signature string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.seen, signature)
+}
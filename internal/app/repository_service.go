@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const maxRoutingRules = 20
+
+// RepositoryService manages per-repository reviewer assignment settings.
+type RepositoryService struct {
+	repoRepo domain.RepositoryRepository
+	teamRepo domain.TeamRepository
+	tx       domain.UnitOfWork
+	log      *slog.Logger
+}
+
+func NewRepositoryService(
+	repoRepo domain.RepositoryRepository,
+	teamRepo domain.TeamRepository,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *RepositoryService {
+	return &RepositoryService{
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+		tx:       tx,
+		log:      log,
+	}
+}
+
+// CreateRepository stores a new repository. Teams are given by name in
+// DefaultTeamName and in the routing rules.
+func (s *RepositoryService) CreateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	return s.save(ctx, repo, s.repoRepo.CreateRepository)
+}
+
+// UpdateRepository replaces the settings of an existing repository, routing
+// rules included.
+func (s *RepositoryService) UpdateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	return s.save(ctx, repo, s.repoRepo.UpdateRepository)
+}
+
+func (s *RepositoryService) GetRepository(ctx context.Context, name string) (*domain.Repository, error) {
+	return s.repoRepo.GetRepository(ctx, name)
+}
+
+func (s *RepositoryService) ListRepositories(ctx context.Context) ([]domain.Repository, error) {
+	return s.repoRepo.ListRepositories(ctx)
+}
+
+// DeleteRepository removes the repository; its PRs keep their reviewers and
+// no longer reference it.
+func (s *RepositoryService) DeleteRepository(ctx context.Context, name string) error {
+	if err := s.repoRepo.DeleteRepository(ctx, name); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "repository deleted", "event", "repository.deleted", "repository", name)
+	return nil
+}
+
+func (s *RepositoryService) save(
+	ctx context.Context,
+	repo *domain.Repository,
+	store func(context.Context, domain.Tx, *domain.Repository) (*domain.Repository, error),
+) (*domain.Repository, error) {
+	if err := s.resolve(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.Repository
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = store(ctx, tx, repo)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// resolve validates the repository and looks up the IDs of the teams it names.
+func (s *RepositoryService) resolve(ctx context.Context, repo *domain.Repository) error {
+	if repo.Name == "" {
+		return fmt.Errorf("%w: repository name is required", domain.ErrValidation)
+	}
+	if repo.RequiredReviewers < 0 || repo.RequiredReviewers > maxEscalatedReviewers {
+		return fmt.Errorf("%w: required_reviewers must be between 1 and %d", domain.ErrValidation, maxEscalatedReviewers)
+	}
+	if len(repo.RoutingRules) > maxRoutingRules {
+		return fmt.Errorf("%w: at most %d routing rules are allowed", domain.ErrValidation, maxRoutingRules)
+	}
+
+	var err error
+	if repo.DefaultTeamID, err = s.teamID(ctx, repo.DefaultTeamName); err != nil {
+		return err
+	}
+	for i := range repo.RoutingRules {
+		rule := &repo.RoutingRules[i]
+		if rule.TitlePrefix == "" {
+			return fmt.Errorf("%w: routing rule %d has no title_prefix", domain.ErrValidation, i)
+		}
+		if rule.TeamID, err = s.teamID(ctx, rule.TeamName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *RepositoryService) teamID(ctx context.Context, teamName string) (*int32, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return &team.ID, nil
+}
@@ -0,0 +1,249 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const (
+	maxReviewsPerSprint = 1000
+	maxSprintDays       = 90
+	defaultSprintDays   = 14
+)
+
+// ReviewBudgetService manages the review budgets of teams: how many reviews
+// each member is assigned per sprint. Reviewer selection skips members out of
+// budget for regular PRs. Its scheduler starts a new sprint once the current
+// one ends, which resets what members used, and alerts the team lead once per
+// sprint when the team's combined budget is nearly used up.
+type ReviewBudgetService struct {
+	teamRepo  domain.TeamRepository
+	userRepo  domain.UserRepository
+	prSvc     *PullRequestService
+	notifySvc *NotificationService
+	tx        domain.UnitOfWork
+	// alertRatio is the share of the combined budget whose use alerts the team
+	// lead; 0 disables the alerts.
+	alertRatio float64
+	log        *slog.Logger
+}
+
+func NewReviewBudgetService(
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	prSvc *PullRequestService,
+	notifySvc *NotificationService,
+	tx domain.UnitOfWork,
+	alertRatio float64,
+	log *slog.Logger,
+) *ReviewBudgetService {
+	return &ReviewBudgetService{
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		prSvc:      prSvc,
+		notifySvc:  notifySvc,
+		tx:         tx,
+		alertRatio: alertRatio,
+		log:        log,
+	}
+}
+
+// GetBudget returns the team's budget with the usage of every member. Budget
+// is nil for a team without one.
+func (s *ReviewBudgetService) GetBudget(ctx context.Context, teamName string) (*domain.TeamReviewBudget, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return s.teamBudget(ctx, team)
+}
+
+// SetBudget sets how many reviews each member of the team is assigned per
+// sprint of sprintDays days (14 when 0). A new budget starts its first sprint
+// now; changing a budget keeps the current sprint. Zero reviewsPerSprint
+// removes the budget.
+func (s *ReviewBudgetService) SetBudget(ctx context.Context, teamName string, reviewsPerSprint, sprintDays int) (*domain.TeamReviewBudget, error) {
+	if sprintDays == 0 {
+		sprintDays = defaultSprintDays
+	}
+	if err := validateReviewBudget(reviewsPerSprint, sprintDays); err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if reviewsPerSprint == 0 {
+			if err := s.teamRepo.DeleteReviewBudget(ctx, tx, team.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+			return nil
+		}
+		_, err := s.teamRepo.SetReviewBudget(ctx, tx, team.ID, reviewsPerSprint, sprintDays)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "review budget updated",
+		"event", "team.review_budget_set",
+		"team_name", team.TeamName,
+		"reviews_per_sprint", reviewsPerSprint,
+		"sprint_days", sprintDays,
+	)
+	return s.teamBudget(ctx, team)
+}
+
+func validateReviewBudget(reviewsPerSprint, sprintDays int) error {
+	if reviewsPerSprint < 0 || reviewsPerSprint > maxReviewsPerSprint {
+		return fmt.Errorf("%w: reviews_per_sprint must be between 0 and %d", domain.ErrValidation, maxReviewsPerSprint)
+	}
+	if sprintDays < 1 || sprintDays > maxSprintDays {
+		return fmt.Errorf("%w: sprint_days must be between 1 and %d", domain.ErrValidation, maxSprintDays)
+	}
+	return nil
+}
+
+func (s *ReviewBudgetService) teamBudget(ctx context.Context, team *domain.Team) (*domain.TeamReviewBudget, error) {
+	result := &domain.TeamReviewBudget{TeamName: team.TeamName, Members: []domain.ReviewBudgetUsage{}}
+	budget, err := s.teamRepo.GetReviewBudget(ctx, team.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return result, nil
+		}
+		return nil, err
+	}
+	result.Budget = budget
+
+	members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	used, err := s.userRepo.GetReviewBudgetUsage(ctx, nil, currentReviewersToIDs(members))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		m.TeamName = team.TeamName
+		result.Members = append(result.Members, domain.ReviewBudgetUsage{User: m, Used: used[m.ID]})
+	}
+	slices.SortFunc(result.Members, func(a, b domain.ReviewBudgetUsage) int {
+		if c := cmp.Compare(a.Used, b.Used); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.User.Username, b.User.Username)
+	})
+	return result, nil
+}
+
+// Tick starts a new sprint for every budget whose sprint ended and alerts the
+// leads of teams that nearly used up their budget. It returns how many
+// sprints were started; a team that fails is logged and retried on the next
+// run.
+func (s *ReviewBudgetService) Tick(ctx context.Context) (int, error) {
+	budgets, err := s.teamRepo.ListReviewBudgets(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	started := 0
+	for i := range budgets {
+		b := &budgets[i]
+		if start := b.CurrentSprintStart(now); start.After(b.SprintStartedAt) {
+			ok, err := s.startSprint(ctx, b, start)
+			if err != nil {
+				s.log.WarnContext(ctx, "failed to start review sprint", "team_id", b.TeamID, "error", err)
+				continue
+			}
+			if ok {
+				started++
+			}
+			continue
+		}
+		if s.alertRatio > 0 && b.AlertedAt == nil {
+			if err := s.checkExhaustion(ctx, b); err != nil {
+				s.log.WarnContext(ctx, "failed to check review budget", "team_id", b.TeamID, "error", err)
+			}
+		}
+	}
+	return started, nil
+}
+
+func (s *ReviewBudgetService) startSprint(ctx context.Context, b *domain.ReviewBudget, start time.Time) (bool, error) {
+	var ok bool
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		ok, err = s.teamRepo.StartReviewSprint(ctx, tx, b.TeamID, start)
+		return err
+	})
+	if err != nil || !ok {
+		return false, err
+	}
+	s.log.InfoContext(ctx, "review sprint started", "event", "team.review_sprint_started", "team_id", b.TeamID, "started_at", start)
+	return true, nil
+}
+
+func (s *ReviewBudgetService) checkExhaustion(ctx context.Context, b *domain.ReviewBudget) error {
+	team, err := s.teamRepo.GetTeamByID(ctx, b.TeamID)
+	if err != nil {
+		return err
+	}
+	usage, err := s.teamBudget(ctx, team)
+	if err != nil || usage.Budget == nil {
+		return err
+	}
+	used, total := usage.Used(), usage.Total()
+	if total == 0 || float64(used) < s.alertRatio*float64(total) {
+		return nil
+	}
+	claimed, err := s.teamRepo.ClaimReviewBudgetAlert(ctx, team.ID)
+	if err != nil || !claimed {
+		return err
+	}
+
+	s.log.WarnContext(ctx, "team is running out of review budget", "event", "team.review_budget_low", "team_name", team.TeamName, "used", used, "total", total)
+	if team.Escalation.LeadUserID == "" {
+		s.log.WarnContext(ctx, "team out of review budget has no team lead to alert", "team_name", team.TeamName)
+		return nil
+	}
+	n := &domain.Notification{
+		UserID:  team.Escalation.LeadUserID,
+		Event:   domain.EventReviewBudgetLow,
+		Message: fmt.Sprintf("Team %q has used %d of its %d reviews for the sprint ending %s", team.TeamName, used, total, usage.Budget.SprintEndsAt().Format(time.DateOnly)),
+	}
+	if err := s.notifySvc.Notify(ctx, n); err != nil {
+		s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "team_name", team.TeamName, "error", err)
+	}
+	return nil
+}
+
+// Run starts due sprints and checks budgets every interval until ctx is
+// cancelled.
+func (s *ReviewBudgetService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Tick(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "review budget run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// ReviewRuleService manages the review rules evaluated when reviewers are
+// assigned.
+type ReviewRuleService struct {
+	ruleRepo domain.ReviewRuleRepository
+	repoRepo domain.RepositoryRepository
+	teamRepo domain.TeamRepository
+	userRepo domain.UserRepository
+	prSvc    *PullRequestService
+	tx       domain.UnitOfWork
+	log      *slog.Logger
+}
+
+func NewReviewRuleService(
+	ruleRepo domain.ReviewRuleRepository,
+	repoRepo domain.RepositoryRepository,
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	prSvc *PullRequestService,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *ReviewRuleService {
+	return &ReviewRuleService{
+		ruleRepo: ruleRepo,
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+		prSvc:    prSvc,
+		tx:       tx,
+		log:      log,
+	}
+}
+
+// CreateRule stores a new rule. The team is given by name in TeamName.
+func (s *ReviewRuleService) CreateRule(ctx context.Context, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	saved, err := s.save(ctx, rule, s.ruleRepo.CreateReviewRule)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "review rule created", "event", "review_rule.created", "rule_name", saved.Name)
+	return saved, nil
+}
+
+// UpdateRule replaces every setting of an existing rule.
+func (s *ReviewRuleService) UpdateRule(ctx context.Context, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	saved, err := s.save(ctx, rule, s.ruleRepo.UpdateReviewRule)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "review rule updated", "event", "review_rule.updated", "rule_name", saved.Name)
+	return saved, nil
+}
+
+func (s *ReviewRuleService) GetRule(ctx context.Context, name string) (*domain.ReviewRule, error) {
+	return s.ruleRepo.GetReviewRule(ctx, name)
+}
+
+func (s *ReviewRuleService) ListRules(ctx context.Context) ([]domain.ReviewRule, error) {
+	return s.ruleRepo.ListReviewRules(ctx)
+}
+
+// DeleteRule removes the rule; reviewers it already assigned are kept.
+func (s *ReviewRuleService) DeleteRule(ctx context.Context, name string) error {
+	if err := s.ruleRepo.DeleteReviewRule(ctx, name); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "review rule deleted", "event", "review_rule.deleted", "rule_name", name)
+	return nil
+}
+
+// DryRun routes a sample PR as CreatePR would, without storing anything. When
+// rule is set it is validated and evaluated in place of the stored rule of
+// the same name, so that a change can be checked before it is saved.
+func (s *ReviewRuleService) DryRun(ctx context.Context, rule *domain.ReviewRule, pr *domain.PullRequest) (*domain.RouteDecision, error) {
+	if pr.AuthorID == "" {
+		return nil, fmt.Errorf("%w: author_id is required", domain.ErrValidation)
+	}
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+	if pr.Repository != "" {
+		if _, err := s.repoRepo.GetRepository(ctx, pr.Repository); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.ruleRepo.ListReviewRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		if err := s.resolve(ctx, rule); err != nil {
+			return nil, err
+		}
+		rules = slices.DeleteFunc(rules, func(r domain.ReviewRule) bool { return r.Name == rule.Name })
+		rules = append(rules, *rule)
+		slices.SortStableFunc(rules, func(a, b domain.ReviewRule) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Name, b.Name))
+		})
+	}
+
+	route, err := s.prSvc.routeWithRules(ctx, pr, author, rules)
+	if err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetTeamByID(ctx, route.teamID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RouteDecision{
+		Rule:           route.rule,
+		TeamName:       team.TeamName,
+		Reviewers:      route.limit,
+		RequiredSkills: route.skills,
+	}, nil
+}
+
+func (s *ReviewRuleService) save(
+	ctx context.Context,
+	rule *domain.ReviewRule,
+	store func(context.Context, domain.Tx, *domain.ReviewRule) (*domain.ReviewRule, error),
+) (*domain.ReviewRule, error) {
+	if err := s.resolve(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.ReviewRule
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = store(ctx, tx, rule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// resolve validates the rule and looks up the ID of the team it names.
+func (s *ReviewRuleService) resolve(ctx context.Context, rule *domain.ReviewRule) error {
+	if err := validateReviewRule(rule); err != nil {
+		return err
+	}
+	if err := checkRuleRepositories(ctx, s.repoRepo, rule); err != nil {
+		return err
+	}
+	rule.TeamID = nil
+	if rule.TeamName != "" {
+		team, err := s.teamRepo.GetTeamByName(ctx, rule.TeamName)
+		if err != nil {
+			return err
+		}
+		rule.TeamID = &team.ID
+	}
+	return nil
+}
+
+func validateReviewRule(rule *domain.ReviewRule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("%w: rule_name is required", domain.ErrValidation)
+	}
+	if rule.Position < 0 {
+		return fmt.Errorf("%w: position cannot be negative", domain.ErrValidation)
+	}
+	if len(rule.Labels) == 0 && len(rule.Repositories) == 0 {
+		return fmt.Errorf("%w: a rule needs labels or repositories to match", domain.ErrValidation)
+	}
+	if rule.TeamName == "" && rule.Reviewers == 0 && len(rule.RequiredSkills) == 0 {
+		return fmt.Errorf("%w: a rule needs team_name, reviewers or required_skills", domain.ErrValidation)
+	}
+	if rule.Reviewers < 0 || rule.Reviewers > maxEscalatedReviewers {
+		return fmt.Errorf("%w: reviewers must be between 1 and %d", domain.ErrValidation, maxEscalatedReviewers)
+	}
+	if slices.Contains(rule.Labels, "") {
+		return fmt.Errorf("%w: labels cannot be empty", domain.ErrValidation)
+	}
+	return nil
+}
+
+// checkRuleRepositories makes sure every repository the rule matches is configured.
+func checkRuleRepositories(ctx context.Context, repoRepo domain.RepositoryRepository, rule *domain.ReviewRule) error {
+	for _, name := range rule.Repositories {
+		if _, err := repoRepo.GetRepository(ctx, name); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return fmt.Errorf("%w: repository '%s' is not configured", domain.ErrValidation, name)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const maxFilterNameLength = 100
+
+// SavedFilterService manages named PR list filters that users and teams keep
+// for views such as "my overdue reviews".
+type SavedFilterService struct {
+	filterRepo domain.SavedFilterRepository
+	teamRepo   domain.TeamRepository
+	userRepo   domain.UserRepository
+	tx         domain.UnitOfWork
+	log        *slog.Logger
+}
+
+func NewSavedFilterService(
+	filterRepo domain.SavedFilterRepository,
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *SavedFilterService {
+	return &SavedFilterService{
+		filterRepo: filterRepo,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+// CreateFilter stores a new filter for the user in UserID or for the team
+// named in TeamName; exactly one of them must be set.
+func (s *SavedFilterService) CreateFilter(ctx context.Context, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	if err := validateSavedFilter(filter); err != nil {
+		return nil, err
+	}
+	if (filter.UserID == "") == (filter.TeamName == "") {
+		return nil, fmt.Errorf("%w: exactly one of user_id and team_name is required", domain.ErrValidation)
+	}
+	filter.TeamID = nil
+	if filter.UserID != "" {
+		if _, err := s.userRepo.GetUserByID(ctx, filter.UserID); err != nil {
+			return nil, err
+		}
+	} else {
+		team, err := s.teamRepo.GetTeamByName(ctx, filter.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		filter.TeamID = &team.ID
+	}
+
+	var saved *domain.SavedFilter
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = s.filterRepo.CreateSavedFilter(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "saved filter created", "event", "saved_filter.created",
+		"filter_id", saved.ID, "filter_name", saved.Name, "user_id", saved.UserID, "team_name", saved.TeamName)
+	return saved, nil
+}
+
+// UpdateFilter renames the filter and replaces its definition; the owner is kept.
+func (s *SavedFilterService) UpdateFilter(ctx context.Context, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	if err := validateSavedFilter(filter); err != nil {
+		return nil, err
+	}
+
+	var saved *domain.SavedFilter
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		saved, err = s.filterRepo.UpdateSavedFilter(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "saved filter updated", "event", "saved_filter.updated", "filter_id", saved.ID, "filter_name", saved.Name)
+	return saved, nil
+}
+
+func (s *SavedFilterService) GetFilter(ctx context.Context, id int64) (*domain.SavedFilter, error) {
+	return s.filterRepo.GetSavedFilter(ctx, id)
+}
+
+// ListFilters returns the filters of the user, followed by those of the team.
+// Without teamName the user's own team is used.
+func (s *SavedFilterService) ListFilters(ctx context.Context, userID, teamName string) ([]domain.SavedFilter, error) {
+	if userID == "" && teamName == "" {
+		return nil, fmt.Errorf("%w: user_id or team_name is required", domain.ErrValidation)
+	}
+	var teamID int32
+	if userID != "" {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		teamID = user.TeamID
+	}
+	if teamName != "" {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teamID = team.ID
+	}
+	return s.filterRepo.ListSavedFilters(ctx, userID, teamID)
+}
+
+func (s *SavedFilterService) DeleteFilter(ctx context.Context, id int64) error {
+	if err := s.filterRepo.DeleteSavedFilter(ctx, id); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "saved filter deleted", "event", "saved_filter.deleted", "filter_id", id)
+	return nil
+}
+
+func validateSavedFilter(filter *domain.SavedFilter) error {
+	if filter.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if len(filter.Name) > maxFilterNameLength {
+		return fmt.Errorf("%w: name must be at most %d characters", domain.ErrValidation, maxFilterNameLength)
+	}
+	return validatePRFilter(filter.Filter)
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// Fixture describes demo data loaded by the seed command.
+// Users are referenced by username, since user IDs are generated on creation.
+type Fixture struct {
+	Teams        []FixtureTeam        `yaml:"teams" json:"teams"`
+	PullRequests []FixturePullRequest `yaml:"pull_requests" json:"pull_requests"`
+}
+
+type FixtureTeam struct {
+	Name    string          `yaml:"name" json:"name"`
+	Members []FixtureMember `yaml:"members" json:"members"`
+}
+
+type FixtureMember struct {
+	Username string   `yaml:"username" json:"username"`
+	IsActive *bool    `yaml:"is_active" json:"is_active"`
+	Skills   []string `yaml:"skills" json:"skills"`
+}
+
+type FixturePullRequest struct {
+	Name           string            `yaml:"name" json:"name"`
+	Description    string            `yaml:"description" json:"description"`
+	Author         string            `yaml:"author" json:"author"`
+	Merged         bool              `yaml:"merged" json:"merged"`
+	AutoMerge      bool              `yaml:"auto_merge" json:"auto_merge"`
+	Priority       domain.PRPriority `yaml:"priority" json:"priority"`
+	RequiredSkills []string          `yaml:"required_skills" json:"required_skills"`
+	Labels         []string          `yaml:"labels" json:"labels"`
+}
+
+type SeedResult struct {
+	Teams        int
+	Users        int
+	PullRequests int
+}
+
+type SeedService struct {
+	teamSvc *TeamService
+	userSvc *UserService
+	prSvc   *PullRequestService
+	log     *slog.Logger
+}
+
+func NewSeedService(teamSvc *TeamService, userSvc *UserService, prSvc *PullRequestService, log *slog.Logger) *SeedService {
+	return &SeedService{
+		teamSvc: teamSvc,
+		userSvc: userSvc,
+		prSvc:   prSvc,
+		log:     log,
+	}
+}
+
+// Seed creates the fixture's teams first, then its pull requests, going through the regular
+// services so that reviewer assignment rules apply to the seeded data as well.
+func (s *SeedService) Seed(ctx context.Context, fixture *Fixture) (*SeedResult, error) {
+	result := &SeedResult{}
+	userIDs := make(map[string]string)
+
+	for _, ft := range fixture.Teams {
+		usernames := make([]string, len(ft.Members))
+		for i, m := range ft.Members {
+			usernames[i] = m.Username
+		}
+
+		team, err := s.teamSvc.CreateTeam(ctx, ft.Name, usernames, false)
+		if err != nil {
+			return result, fmt.Errorf("failed to seed team %s: %w", ft.Name, err)
+		}
+		result.Teams++
+
+		for i, member := range team.Members {
+			userIDs[member.Username] = member.ID
+			result.Users++
+			if skills := ft.Members[i].Skills; len(skills) > 0 {
+				if _, err := s.userSvc.SetUserSkills(ctx, member.ID, skills); err != nil {
+					return result, fmt.Errorf("failed to set skills of seeded user %s: %w", domain.PII(member.Username), err)
+				}
+			}
+			if isActive := ft.Members[i].IsActive; isActive != nil && !*isActive {
+				if _, err := s.userSvc.SetUserActiveStatus(ctx, member.ID, false); err != nil {
+					return result, fmt.Errorf("failed to deactivate seeded user %s: %w", domain.PII(member.Username), err)
+				}
+			}
+		}
+		s.log.InfoContext(ctx, "seeded team", "team_name", ft.Name, "members", len(team.Members))
+	}
+
+	for _, fpr := range fixture.PullRequests {
+		authorID, ok := userIDs[fpr.Author]
+		if !ok {
+			return result, fmt.Errorf("%w: author %s of PR %s is not defined in the fixture", domain.ErrValidation, fpr.Author, fpr.Name)
+		}
+
+		pr, err := s.prSvc.CreatePR(ctx, CreatePRInput{
+			Name:           fpr.Name,
+			Description:    fpr.Description,
+			AuthorID:       authorID,
+			RequiredSkills: fpr.RequiredSkills,
+			Labels:         fpr.Labels,
+			AutoMerge:      fpr.AutoMerge,
+			Priority:       fpr.Priority,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to seed PR %s: %w", fpr.Name, err)
+		}
+		if fpr.Merged {
+			if _, err := s.prSvc.MergePR(ctx, pr.ID, ""); err != nil {
+				return result, fmt.Errorf("failed to merge seeded PR %s: %w", fpr.Name, err)
+			}
+		}
+		result.PullRequests++
+	}
+
+	return result, nil
+}
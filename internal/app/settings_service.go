@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// DefaultSettings are the org-wide settings that apply until an admin saves
+// their own.
+func DefaultSettings() domain.Settings {
+	return domain.Settings{
+		MaxReviewers:      maxReviewers,
+		SelectionStrategy: domain.SelectionRandom,
+		ReviewSLAHours:    48,
+		AutoMerge:         true,
+		ShadowReviews:     true,
+	}
+}
+
+// SettingsService manages the org-wide defaults of reviewer selection and
+// reviews and the overrides teams layer on top of them. Settings are read
+// fresh on every use, so changes apply to the next PR without a restart.
+type SettingsService struct {
+	settingsRepo domain.SettingsRepository
+	teamRepo     domain.TeamRepository
+	tx           domain.UnitOfWork
+	// defaults apply while the org-wide settings were never saved.
+	defaultsMu sync.RWMutex
+	defaults   domain.Settings
+	log        *slog.Logger
+}
+
+func NewSettingsService(
+	settingsRepo domain.SettingsRepository,
+	teamRepo domain.TeamRepository,
+	tx domain.UnitOfWork,
+	defaults domain.Settings,
+	log *slog.Logger,
+) *SettingsService {
+	return &SettingsService{
+		settingsRepo: settingsRepo,
+		teamRepo:     teamRepo,
+		tx:           tx,
+		defaults:     defaults,
+		log:          log,
+	}
+}
+
+// SetDefaults replaces the settings that apply while the org-wide settings
+// were never saved, for example when the config file is reloaded.
+func (s *SettingsService) SetDefaults(defaults domain.Settings) {
+	s.defaultsMu.Lock()
+	defer s.defaultsMu.Unlock()
+	s.defaults = defaults
+}
+
+// GetSettings returns the org-wide settings with the overrides of every team.
+func (s *SettingsService) GetSettings(ctx context.Context) (*domain.OrgSettings, error) {
+	defaults, err := s.orgSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.settingsRepo.ListTeamSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OrgSettings{Defaults: defaults, Teams: teams}, nil
+}
+
+// UpdateSettings replaces the org-wide settings. Teams keep their overrides.
+func (s *SettingsService) UpdateSettings(ctx context.Context, settings domain.Settings) (*domain.OrgSettings, error) {
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		_, err := s.settingsRepo.SaveOrgSettings(ctx, tx, &settings)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "org settings updated", "event", "settings.updated",
+		"max_reviewers", settings.MaxReviewers,
+		"selection_strategy", settings.SelectionStrategy,
+		"review_sla_hours", settings.ReviewSLAHours,
+		"max_open_reviews", settings.MaxOpenReviews,
+		"auto_merge", settings.AutoMerge,
+		"shadow_reviews", settings.ShadowReviews,
+	)
+	return s.GetSettings(ctx)
+}
+
+// GetTeamSettings returns the overrides of the team, empty when it has none,
+// and the settings that apply to it.
+func (s *SettingsService) GetTeamSettings(ctx context.Context, teamName string) (*domain.TeamSettings, domain.Settings, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, domain.Settings{}, err
+	}
+	overrides, err := s.teamSettings(ctx, team)
+	if err != nil {
+		return nil, domain.Settings{}, err
+	}
+	defaults, err := s.orgSettings(ctx)
+	if err != nil {
+		return nil, domain.Settings{}, err
+	}
+	return overrides, overrides.Apply(defaults), nil
+}
+
+// SetTeamSettings replaces the overrides of the team; overrides of nothing
+// make the team follow the org-wide settings again.
+func (s *SettingsService) SetTeamSettings(ctx context.Context, teamName string, overrides domain.TeamSettings) (*domain.TeamSettings, domain.Settings, error) {
+	if err := validateTeamSettings(overrides); err != nil {
+		return nil, domain.Settings{}, err
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, domain.Settings{}, err
+	}
+	overrides.TeamID = team.ID
+	overrides.TeamName = team.TeamName
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if overrides.Empty() {
+			if err := s.settingsRepo.DeleteTeamSettings(ctx, tx, team.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+			return nil
+		}
+		_, err := s.settingsRepo.SetTeamSettings(ctx, tx, &overrides)
+		return err
+	})
+	if err != nil {
+		return nil, domain.Settings{}, err
+	}
+	s.log.InfoContext(ctx, "team settings updated", "event", "settings.team_updated", "team_name", team.TeamName, "cleared", overrides.Empty())
+	return s.GetTeamSettings(ctx, team.TeamName)
+}
+
+// effective returns the settings that apply to the team.
+func (s *SettingsService) effective(ctx context.Context, teamID int32) (domain.Settings, error) {
+	settings, err := s.orgSettings(ctx)
+	if err != nil {
+		return domain.Settings{}, err
+	}
+	overrides, err := s.settingsRepo.GetTeamSettings(ctx, teamID)
+	switch {
+	case err == nil:
+		return overrides.Apply(settings), nil
+	case errors.Is(err, domain.ErrNotFound):
+		return settings, nil
+	default:
+		return domain.Settings{}, fmt.Errorf("failed to get team settings: %w", err)
+	}
+}
+
+func (s *SettingsService) orgSettings(ctx context.Context) (domain.Settings, error) {
+	settings, err := s.settingsRepo.GetOrgSettings(ctx)
+	switch {
+	case err == nil:
+		return *settings, nil
+	case errors.Is(err, domain.ErrNotFound):
+		s.defaultsMu.RLock()
+		defer s.defaultsMu.RUnlock()
+		return s.defaults, nil
+	default:
+		return domain.Settings{}, fmt.Errorf("failed to get org settings: %w", err)
+	}
+}
+
+func (s *SettingsService) teamSettings(ctx context.Context, team *domain.Team) (*domain.TeamSettings, error) {
+	overrides, err := s.settingsRepo.GetTeamSettings(ctx, team.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return &domain.TeamSettings{TeamID: team.ID, TeamName: team.TeamName}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	overrides.TeamName = team.TeamName
+	return overrides, nil
+}
+
+func validateSettings(s domain.Settings) error {
+	return validateTeamSettings(domain.TeamSettings{
+		MaxReviewers:      &s.MaxReviewers,
+		SelectionStrategy: &s.SelectionStrategy,
+		ReviewSLAHours:    &s.ReviewSLAHours,
+		MaxOpenReviews:    &s.MaxOpenReviews,
+	})
+}
+
+func validateTeamSettings(t domain.TeamSettings) error {
+	if t.MaxReviewers != nil && (*t.MaxReviewers < 1 || *t.MaxReviewers > maxEscalatedReviewers) {
+		return fmt.Errorf("%w: max_reviewers must be between 1 and %d", domain.ErrValidation, maxEscalatedReviewers)
+	}
+	if t.SelectionStrategy != nil && !t.SelectionStrategy.Valid() {
+		return fmt.Errorf("%w: unknown selection strategy %q", domain.ErrValidation, *t.SelectionStrategy)
+	}
+	if t.ReviewSLAHours != nil && *t.ReviewSLAHours < 1 {
+		return fmt.Errorf("%w: review_sla_hours must be positive", domain.ErrValidation)
+	}
+	if t.MaxOpenReviews != nil && *t.MaxOpenReviews < 0 {
+		return fmt.Errorf("%w: max_open_reviews cannot be negative", domain.ErrValidation)
+	}
+	return nil
+}
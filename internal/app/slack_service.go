@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+var slackUserIDRe = regexp.MustCompile(`^[UW][A-Z0-9]{1,31}$`)
+
+const slackUsage = "Usage:\n" +
+	"• `/reviews mine` lists your open reviews\n" +
+	"• `/reviews assign <pr>` makes you a reviewer of a PR\n" +
+	"• `/reviews decline <pr> [reason]` hands your review of a PR to someone else; " +
+	"reason is conflict_of_interest, overloaded, on_leave or lacks_context"
+
+// SlackService runs the /reviews slash command. Commands act on behalf of the
+// user the calling Slack user is linked to.
+type SlackService struct {
+	slackRepo     domain.SlackRepository
+	prSvc         *PullRequestService
+	tx            domain.UnitOfWork
+	signingSecret string
+	maxRequestAge time.Duration
+	log           *slog.Logger
+}
+
+func NewSlackService(
+	slackRepo domain.SlackRepository,
+	prSvc *PullRequestService,
+	tx domain.UnitOfWork,
+	signingSecret string,
+	maxRequestAge time.Duration,
+	log *slog.Logger,
+) *SlackService {
+	return &SlackService{
+		slackRepo:     slackRepo,
+		prSvc:         prSvc,
+		tx:            tx,
+		signingSecret: signingSecret,
+		maxRequestAge: maxRequestAge,
+		log:           log,
+	}
+}
+
+func (s *SlackService) SetUserSlackID(ctx context.Context, userID, slackUserID string) (*domain.SlackAccount, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
+	}
+	if !slackUserIDRe.MatchString(slackUserID) {
+		return nil, fmt.Errorf("%w: invalid Slack user ID %q", domain.ErrValidation, slackUserID)
+	}
+
+	var account *domain.SlackAccount
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		account, err = s.slackRepo.SetSlackUserID(ctx, tx, userID, slackUserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// VerifyRequest checks the X-Slack-Signature of a request against the signing
+// secret. Requests with a timestamp more than maxRequestAge away from now are
+// refused as possible replays.
+func (s *SlackService) VerifyRequest(timestamp, signature string, body []byte) error {
+	if s.signingSecret == "" {
+		return fmt.Errorf("%w: Slack signing secret is not configured", domain.ErrUnauthorized)
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed Slack request timestamp", domain.ErrUnauthorized)
+	}
+	if age := time.Since(time.Unix(sec, 0)); age > s.maxRequestAge || age < -s.maxRequestAge {
+		return fmt.Errorf("%w: Slack request timestamp is too far from now", domain.ErrUnauthorized)
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "v0="))
+	if err != nil || !strings.HasPrefix(signature, "v0=") {
+		return fmt.Errorf("%w: malformed Slack signature", domain.ErrUnauthorized)
+	}
+	mac := hmac.New(sha256.New, []byte(s.signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("%w: Slack signature mismatch", domain.ErrUnauthorized)
+	}
+	return nil
+}
+
+// HandleCommand runs the text of a /reviews command typed by slackUserID and
+// returns the reply. Errors the user can act on are returned as is for the
+// caller to show.
+func (s *SlackService) HandleCommand(ctx context.Context, slackUserID, text string) (string, error) {
+	args := strings.Fields(text)
+	if len(args) == 0 || args[0] == "help" {
+		return slackUsage, nil
+	}
+
+	account, err := s.slackRepo.GetSlackAccountBySlackID(ctx, slackUserID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return "Your Slack account is not linked to a reviewer; ask an administrator to link it.", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	ctx = domain.WithActor(ctx, account.UserID)
+
+	switch cmd := strings.ToLower(args[0]); {
+	case cmd == "mine" && len(args) == 1:
+		return s.listReviews(ctx, account.UserID)
+	case cmd == "assign" && len(args) == 2:
+		pr, err := s.prSvc.AssignReviewer(ctx, args[1], account.UserID)
+		if err != nil {
+			return "", err
+		}
+		s.log.InfoContext(ctx, "reviewer assigned from Slack", "event", "slack.assign", "pr_id", pr.ID, "user_id", account.UserID)
+		return fmt.Sprintf("You are now a reviewer of %q (%s).", pr.Name, pr.ID), nil
+	case cmd == "decline" && (len(args) == 2 || len(args) == 3):
+		var reason domain.DeclineReason
+		if len(args) == 3 {
+			reason = domain.DeclineReason(args[2])
+		}
+		pr, newReviewerID, err := s.prSvc.ReassignReviewer(ctx, args[1], account.UserID, domain.ReassignmentManual, reason)
+		if err != nil {
+			return "", err
+		}
+		s.log.InfoContext(ctx, "review declined from Slack", "event", "slack.decline", "pr_id", pr.ID, "user_id", account.UserID, "new_reviewer_id", newReviewerID)
+		return fmt.Sprintf("You no longer review %q (%s); it was reassigned to %s.", pr.Name, pr.ID, newReviewerID), nil
+	default:
+		return fmt.Sprintf("Unknown command %q.\n%s", text, slackUsage), nil
+	}
+}
+
+func (s *SlackService) listReviews(ctx context.Context, userID string) (string, error) {
+	prs, err := s.prSvc.GetReviewsForUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	open := 0
+	for i := range prs {
+		if !prs[i].IsOpen() {
+			continue
+		}
+		open++
+		fmt.Fprintf(&b, "\n• %s (%s, %s)", prs[i].Name, prs[i].ID, prs[i].Status)
+	}
+	if open == 0 {
+		return "You have no open reviews.", nil
+	}
+	return fmt.Sprintf("Your open reviews (%d):%s", open, b.String()), nil
+}
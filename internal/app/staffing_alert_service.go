@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// StaffingAlertService counts reviewer picks that found no candidate per team
+// and alerts the team lead once they reach threshold within window, as the
+// team is then short of reviewers. A team is alerted at most once per window.
+type StaffingAlertService struct {
+	teamRepo  domain.TeamRepository
+	notifySvc *NotificationService
+	// threshold is the number of failed picks that raises an alert; 0 disables
+	// the tracking.
+	threshold int
+	window    time.Duration
+	log       *slog.Logger
+}
+
+func NewStaffingAlertService(
+	teamRepo domain.TeamRepository,
+	notifySvc *NotificationService,
+	threshold int,
+	window time.Duration,
+	log *slog.Logger,
+) *StaffingAlertService {
+	return &StaffingAlertService{
+		teamRepo:  teamRepo,
+		notifySvc: notifySvc,
+		threshold: threshold,
+		window:    window,
+		log:       log,
+	}
+}
+
+// NoCandidate records a failed pick. Errors are only logged: the alert must
+// never fail the reassignment that triggered it.
+func (s *StaffingAlertService) NoCandidate(ctx context.Context, teamID int32, prID string) {
+	if s.threshold <= 0 {
+		return
+	}
+	since := time.Now().Add(-s.window)
+	count, err := s.teamRepo.RecordNoCandidate(ctx, teamID, prID, since)
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to record missing review candidate", "team_id", teamID, "pr_id", prID, "error", err)
+		return
+	}
+	if count < s.threshold {
+		return
+	}
+	claimed, err := s.teamRepo.ClaimNoCandidateAlert(ctx, teamID, since)
+	if err != nil || !claimed {
+		if err != nil {
+			s.log.WarnContext(ctx, "failed to claim staffing alert", "team_id", teamID, "error", err)
+		}
+		return
+	}
+
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to load team for staffing alert", "team_id", teamID, "error", err)
+		return
+	}
+	s.log.WarnContext(ctx, "team keeps running out of review candidates", "event", "team.no_candidate_spike", "team_name", team.TeamName, "count", count, "window", s.window.String())
+	if team.Escalation.LeadUserID == "" {
+		s.log.WarnContext(ctx, "understaffed team has no team lead to alert", "team_name", team.TeamName)
+		return
+	}
+	n := &domain.Notification{
+		UserID:  team.Escalation.LeadUserID,
+		Event:   domain.EventNoCandidateSpike,
+		PRID:    prID,
+		Message: fmt.Sprintf("No reviewer could be found %d times in %s for team %q; the team may be short of reviewers", count, s.window, team.TeamName),
+	}
+	if err := s.notifySvc.Notify(ctx, n); err != nil {
+		s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "team_name", team.TeamName, "error", err)
+	}
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// StatsHistoryService records the review counters of every user and team once
+// per UTC day, so that trends outlive PR archival and moves between teams.
+type StatsHistoryService struct {
+	statsRepo domain.StatsRepository
+	tx        domain.UnitOfWork
+	log       *slog.Logger
+}
+
+func NewStatsHistoryService(statsRepo domain.StatsRepository, tx domain.UnitOfWork, log *slog.Logger) *StatsHistoryService {
+	return &StatsHistoryService{
+		statsRepo: statsRepo,
+		tx:        tx,
+		log:       log,
+	}
+}
+
+// Snapshot records today's counters and reports whether anything was
+// recorded; the first run of a day records it and later runs are no-ops.
+func (s *StatsHistoryService) Snapshot(ctx context.Context) (bool, error) {
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	var recorded bool
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		if recorded, err = s.statsRepo.SnapshotStats(ctx, tx, day); err != nil {
+			return fmt.Errorf("failed to record stats history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if recorded {
+		s.log.InfoContext(ctx, "recorded stats history", "event", "stats.history_recorded", "date", day.Format(time.DateOnly))
+	}
+	return recorded, nil
+}
+
+// Run records the day's stats every interval until ctx is cancelled. The
+// interval only bounds how late into the day the snapshot is taken.
+func (s *StatsHistoryService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Snapshot(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "stats history run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
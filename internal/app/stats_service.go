@@ -1,8 +1,13 @@
 package app
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
 )
@@ -19,8 +24,27 @@ func NewStatsService(statsRepo domain.StatsRepository, log *slog.Logger) *StatsS
 	}
 }
 
-func (s *StatsService) GetStats(ctx context.Context) ([]domain.StatItem, error) {
-	return s.statsRepo.GetReviewStats(ctx)
+// GetStats returns the review counts of users ordered by order (by review
+// count when empty). A zero limit returns every user.
+func (s *StatsService) GetStats(ctx context.Context, order domain.StatsOrder, limit, offset int) (*domain.StatsPage, error) {
+	if order == "" {
+		order = domain.StatsOrderReviewCount
+	}
+	if order != domain.StatsOrderReviewCount && order != domain.StatsOrderUsername {
+		return nil, fmt.Errorf("%w: unknown sort %q", domain.ErrValidation, order)
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+
+	items, total, err := s.statsRepo.GetReviewStats(ctx, order, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.StatsPage{Items: items, Total: total, Limit: limit, Offset: offset}, nil
 }
 
 func (s *StatsService) GetOpenReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
@@ -38,3 +62,434 @@ func (s *StatsService) GetOpenReviewCountForUser(ctx context.Context, userID str
 func (s *StatsService) GetMergedReviewCountForUser(ctx context.Context, userID string) (int, error) {
 	return s.statsRepo.GetMergedReviewCountForUser(ctx, userID)
 }
+
+func (s *StatsService) GetRepositoryStats(ctx context.Context, repositoryName string) (*domain.RepositoryStats, error) {
+	return s.statsRepo.GetRepositoryStats(ctx, repositoryName)
+}
+
+// summaryWindow is the period of the merges the org summary reports.
+const summaryWindow = 7 * 24 * time.Hour
+
+// GetSummary returns the org-wide top line, with the merges of the week ending
+// now.
+func (s *StatsService) GetSummary(ctx context.Context) (*domain.OrgSummary, error) {
+	until := time.Now()
+	return s.statsRepo.GetOrgSummary(ctx, until.Add(-summaryWindow), until)
+}
+
+// GetAuthorStats reports the PRs userID opened, complementing the review
+// counts of GetStats.
+func (s *StatsService) GetAuthorStats(ctx context.Context, userID string) (*domain.AuthorStats, error) {
+	return s.statsRepo.GetAuthorStats(ctx, userID)
+}
+
+// maxFairnessWindow bounds the fairness report so it stays cheap to compute.
+const maxFairnessWindow = 365 * 24 * time.Hour
+
+// GetFairness reports how evenly reviews assigned during the window ending now
+// were spread within each active team, or within teamName only when it is set.
+func (s *StatsService) GetFairness(ctx context.Context, teamName string, window time.Duration) (*domain.FairnessReport, error) {
+	if window <= 0 || window > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now()
+	since := until.Add(-window)
+
+	counts, err := s.statsRepo.GetMemberReviewCounts(ctx, teamName, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.FairnessReport{Since: since, Until: until, Teams: []domain.TeamFairness{}}
+	for start := 0; start < len(counts); {
+		end := start
+		byUser := make(map[string]int)
+		for end < len(counts) && counts[end].TeamName == counts[start].TeamName {
+			byUser[counts[end].UserID] = counts[end].ReviewCount
+			end++
+		}
+		report.Teams = append(report.Teams, teamFairness(counts[start].TeamName, byUser))
+		start = end
+	}
+	return report, nil
+}
+
+// teamFairness computes the distribution metrics of a team's review counts:
+// the population standard deviation, the Gini coefficient (0 is a perfectly
+// even split) and the ratio between the busiest and the least busy member.
+func teamFairness(teamName string, byUser map[string]int) domain.TeamFairness {
+	f := domain.TeamFairness{TeamName: teamName, Members: len(byUser), ReviewCounts: byUser}
+	if len(byUser) == 0 {
+		return f
+	}
+
+	values := make([]float64, 0, len(byUser))
+	f.MinReviews = math.MaxInt
+	for _, c := range byUser {
+		values = append(values, float64(c))
+		f.TotalReviews += c
+		f.MinReviews = min(f.MinReviews, c)
+		f.MaxReviews = max(f.MaxReviews, c)
+	}
+	n := float64(len(values))
+	f.Mean = float64(f.TotalReviews) / n
+
+	var variance, absDiffs float64
+	for _, a := range values {
+		variance += (a - f.Mean) * (a - f.Mean)
+		for _, b := range values {
+			absDiffs += math.Abs(a - b)
+		}
+	}
+	f.StdDev = math.Sqrt(variance / n)
+	if f.Mean > 0 {
+		f.Gini = absDiffs / (2 * n * n * f.Mean)
+	}
+	if f.MinReviews > 0 {
+		ratio := float64(f.MaxReviews) / float64(f.MinReviews)
+		f.MaxMinRatio = &ratio
+	}
+	return f
+}
+
+// GetReassignments reports how often reviewers were taken off PRs during the
+// window ending now, per team and per removed reviewer, with the reasons and
+// the decline reasons reviewers gave ordered by frequency.
+func (s *StatsService) GetReassignments(ctx context.Context, teamName string, window time.Duration) (*domain.ReassignmentReport, error) {
+	if window <= 0 || window > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now()
+	since := until.Add(-window)
+
+	counts, err := s.statsRepo.GetReassignmentCounts(ctx, teamName, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ReassignmentReport{Since: since, Until: until}
+	total := newReasonTally()
+	teams := make(map[string]*reasonTally)
+	users := make(map[[2]string]*reasonTally)
+	for _, c := range counts {
+		total.add(c)
+		if teams[c.TeamName] == nil {
+			teams[c.TeamName] = newReasonTally()
+		}
+		teams[c.TeamName].add(c)
+		if c.UserID == "" {
+			continue
+		}
+		key := [2]string{c.TeamName, c.UserID}
+		if users[key] == nil {
+			users[key] = newReasonTally()
+		}
+		users[key].add(c)
+	}
+
+	report.Total, report.Reasons = rankReasons(total.byReason)
+	report.DeclineReasons = rankDeclineReasons(total.byDecline)
+	report.Teams = make([]domain.ReassignmentGroup, 0, len(teams))
+	for name, tally := range teams {
+		g := domain.ReassignmentGroup{TeamName: name, DeclineReasons: rankDeclineReasons(tally.byDecline)}
+		g.Total, g.Reasons = rankReasons(tally.byReason)
+		report.Teams = append(report.Teams, g)
+	}
+	report.Users = make([]domain.ReassignmentGroup, 0, len(users))
+	for key, tally := range users {
+		g := domain.ReassignmentGroup{TeamName: key[0], UserID: key[1], DeclineReasons: rankDeclineReasons(tally.byDecline)}
+		g.Total, g.Reasons = rankReasons(tally.byReason)
+		report.Users = append(report.Users, g)
+	}
+	sortByChurn(report.Teams)
+	sortByChurn(report.Users)
+	return report, nil
+}
+
+// GetMerges reports who merged the PRs merged during the window ending now.
+func (s *StatsService) GetMerges(ctx context.Context, window time.Duration) (*domain.MergeReport, error) {
+	if window <= 0 || window > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now()
+	since := until.Add(-window)
+
+	counts, err := s.statsRepo.GetMergeCounts(ctx, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.MergeReport{Since: since, Until: until, Users: make([]domain.MergeCount, 0, len(counts))}
+	for _, c := range counts {
+		report.Total += c.Count
+		if c.MergedBy == "" {
+			report.Unattributed += c.Count
+			continue
+		}
+		report.Users = append(report.Users, c)
+	}
+	return report, nil
+}
+
+// GetShadowReviews reports the shadow reviews of users in training during the
+// window ending now, for teamName only when it is set.
+func (s *StatsService) GetShadowReviews(ctx context.Context, teamName string, window time.Duration) (*domain.ShadowReviewReport, error) {
+	if window <= 0 || window > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now()
+	since := until.Add(-window)
+
+	counts, err := s.statsRepo.GetShadowReviewCounts(ctx, teamName, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ShadowReviewReport{Since: since, Until: until, Users: counts}
+	for _, c := range counts {
+		report.Total += c.Count
+	}
+	return report, nil
+}
+
+// GetReviewerTurnaround reports how long userID took to approve or request
+// changes on the reviews assigned to them during the window ending now.
+func (s *StatsService) GetReviewerTurnaround(ctx context.Context, userID string, window time.Duration) (*domain.ReviewerTurnaround, error) {
+	if window <= 0 || window > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now()
+	return s.statsRepo.GetReviewerTurnaround(ctx, userID, until.Add(-window), until)
+}
+
+// GetUnassigned reports, per team and UTC day, how many open PRs were without
+// reviewers during the windowDays days up to and including today. A team
+// appears when it had such PRs in the window, or when it is teamName.
+func (s *StatsService) GetUnassigned(ctx context.Context, teamName string, windowDays int) (*domain.UnassignedReport, error) {
+	if windowDays <= 0 || time.Duration(windowDays)*24*time.Hour > maxFairnessWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 365 days", domain.ErrValidation)
+	}
+	until := time.Now().UTC().Truncate(24 * time.Hour)
+	since := until.AddDate(0, 0, -(windowDays - 1))
+
+	counts, err := s.statsRepo.GetUnassignedCounts(ctx, teamName, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	byTeam := make(map[string]map[time.Time]int)
+	if teamName != "" {
+		byTeam[teamName] = make(map[time.Time]int)
+	}
+	for _, c := range counts {
+		if byTeam[c.TeamName] == nil {
+			byTeam[c.TeamName] = make(map[time.Time]int)
+		}
+		byTeam[c.TeamName][c.Day.UTC()] = c.Count
+	}
+
+	report := &domain.UnassignedReport{Since: since, Until: until, Teams: make([]domain.TeamUnassignedSeries, 0, len(byTeam))}
+	for name, byDay := range byTeam {
+		series := domain.TeamUnassignedSeries{TeamName: name, Days: make([]domain.UnassignedCount, 0, windowDays)}
+		for day := since; !day.After(until); day = day.AddDate(0, 0, 1) {
+			n := byDay[day]
+			series.Days = append(series.Days, domain.UnassignedCount{TeamName: name, Day: day, Count: n})
+			series.Peak = max(series.Peak, n)
+		}
+		report.Teams = append(report.Teams, series)
+	}
+	slices.SortFunc(report.Teams, func(a, b domain.TeamUnassignedSeries) int {
+		if c := cmp.Compare(b.Peak, a.Peak); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.TeamName, b.TeamName)
+	})
+	return report, nil
+}
+
+// maxSeriesPoints bounds how many buckets a time series may have.
+const maxSeriesPoints = 1000
+
+// GetTimeSeries counts the events of the metrics (all of them when empty) in
+// every bucket of the interval (a day when empty) from the bucket of since to
+// until, for teamName only when it is set. A zero until means now and a zero
+// since 30 buckets before until.
+func (s *StatsService) GetTimeSeries(ctx context.Context, teamName string, metrics []domain.ThroughputMetric, interval domain.SeriesInterval, since, until time.Time) ([]domain.TimeSeries, error) {
+	if len(metrics) == 0 {
+		metrics = domain.ThroughputMetrics
+	}
+	for _, m := range metrics {
+		if !slices.Contains(domain.ThroughputMetrics, m) {
+			return nil, fmt.Errorf("%w: unknown metric %q", domain.ErrValidation, m)
+		}
+	}
+	if interval == "" {
+		interval = domain.IntervalDay
+	}
+	if !interval.Valid() {
+		return nil, fmt.Errorf("%w: unknown interval %q", domain.ErrValidation, interval)
+	}
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if since.IsZero() {
+		since = until.Add(-29 * interval.Duration())
+	}
+	buckets, err := seriesBuckets(interval, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.statsRepo.GetThroughputCounts(ctx, teamName, metrics, interval, buckets[0], until)
+	if err != nil {
+		return nil, err
+	}
+	return timeSeries(metrics, buckets, counts), nil
+}
+
+// seriesBuckets returns the starts of the buckets of the interval from the
+// bucket of since to until.
+func seriesBuckets(interval domain.SeriesInterval, since, until time.Time) ([]time.Time, error) {
+	start := interval.Truncate(since)
+	if !start.Before(until) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrValidation)
+	}
+	var buckets []time.Time
+	for b := start; b.Before(until); b = b.Add(interval.Duration()) {
+		if len(buckets) == maxSeriesPoints {
+			return nil, fmt.Errorf("%w: the range spans more than %d %s buckets", domain.ErrValidation, maxSeriesPoints, interval)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// timeSeries returns a series per metric with a point in every bucket,
+// zero where counts has none.
+func timeSeries(metrics []domain.ThroughputMetric, buckets []time.Time, counts []domain.ThroughputCount) []domain.TimeSeries {
+	byMetric := make(map[domain.ThroughputMetric]map[time.Time]int, len(metrics))
+	for _, c := range counts {
+		if byMetric[c.Metric] == nil {
+			byMetric[c.Metric] = make(map[time.Time]int)
+		}
+		byMetric[c.Metric][c.Bucket] = c.Count
+	}
+
+	series := make([]domain.TimeSeries, 0, len(metrics))
+	for i, m := range metrics {
+		if slices.Contains(metrics[:i], m) {
+			continue
+		}
+		ts := domain.TimeSeries{Metric: m, Points: make([]domain.ThroughputCount, len(buckets))}
+		for j, b := range buckets {
+			ts.Points[j] = domain.ThroughputCount{Metric: m, Bucket: b, Count: byMetric[m][b]}
+		}
+		series = append(series, ts)
+	}
+	return series
+}
+
+func rankReasons(byReason map[domain.ReassignmentReason]int) (int, []domain.ReasonCount) {
+	total := 0
+	reasons := make([]domain.ReasonCount, 0, len(byReason))
+	for reason, n := range byReason {
+		total += n
+		reasons = append(reasons, domain.ReasonCount{Reason: reason, Count: n})
+	}
+	slices.SortFunc(reasons, func(a, b domain.ReasonCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Reason, b.Reason)
+	})
+	return total, reasons
+}
+
+// reasonTally counts reassignments by reason and by the decline reason given.
+type reasonTally struct {
+	byReason  map[domain.ReassignmentReason]int
+	byDecline map[domain.DeclineReason]int
+}
+
+func newReasonTally() *reasonTally {
+	return &reasonTally{
+		byReason:  make(map[domain.ReassignmentReason]int),
+		byDecline: make(map[domain.DeclineReason]int),
+	}
+}
+
+func (t *reasonTally) add(c domain.ReassignmentCount) {
+	t.byReason[c.Reason] += c.Count
+	if c.DeclineReason != "" {
+		t.byDecline[c.DeclineReason] += c.Count
+	}
+}
+
+func rankDeclineReasons(byReason map[domain.DeclineReason]int) []domain.DeclineReasonCount {
+	reasons := make([]domain.DeclineReasonCount, 0, len(byReason))
+	for reason, n := range byReason {
+		reasons = append(reasons, domain.DeclineReasonCount{Reason: reason, Count: n})
+	}
+	slices.SortFunc(reasons, func(a, b domain.DeclineReasonCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Reason, b.Reason)
+	})
+	return reasons
+}
+
+func sortByChurn(groups []domain.ReassignmentGroup) {
+	slices.SortFunc(groups, func(a, b domain.ReassignmentGroup) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.TeamName, b.TeamName); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+}
+
+// maxHistoryDays bounds how many days a stats history request may span.
+const maxHistoryDays = 366
+
+// historyRange resolves the day range of a stats history request. A zero
+// until means today (UTC) and a zero since 29 days before until.
+func historyRange(since, until time.Time) (time.Time, time.Time, error) {
+	if until.IsZero() {
+		until = time.Now()
+	}
+	until = until.UTC().Truncate(24 * time.Hour)
+	if since.IsZero() {
+		since = until.AddDate(0, 0, -29)
+	}
+	since = since.UTC().Truncate(24 * time.Hour)
+	if since.After(until) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from must not be after to", domain.ErrValidation)
+	}
+	if until.Sub(since) >= maxHistoryDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: the range spans more than %d days", domain.ErrValidation, maxHistoryDays)
+	}
+	return since, until, nil
+}
+
+// GetUserStatsHistory returns the daily snapshots of the user's review
+// counters from since to until, both inclusive. Days before the first
+// snapshot or skipped by the job are missing rather than zero.
+func (s *StatsService) GetUserStatsHistory(ctx context.Context, userID string, since, until time.Time) ([]domain.UserStatsSnapshot, error) {
+	since, until, err := historyRange(since, until)
+	if err != nil {
+		return nil, err
+	}
+	return s.statsRepo.GetUserStatsHistory(ctx, userID, since, until)
+}
+
+// GetTeamStatsHistory is GetUserStatsHistory for a team.
+func (s *StatsService) GetTeamStatsHistory(ctx context.Context, teamName string, since, until time.Time) ([]domain.TeamStatsSnapshot, error) {
+	since, until, err := historyRange(since, until)
+	if err != nil {
+		return nil, err
+	}
+	return s.statsRepo.GetTeamStatsHistory(ctx, teamName, since, until)
+}
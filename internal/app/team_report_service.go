@@ -0,0 +1,318 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// reportWindow is the period a team report covers, ending at its slot.
+const reportWindow = 7 * 24 * time.Hour
+
+//go:embed templates/team_report.html
+var reportTemplates embed.FS
+
+var teamReportTemplate = template.Must(template.New("team_report.html").Funcs(template.FuncMap{
+	"date":     func(t time.Time) string { return t.Format(time.DateOnly) },
+	"datetime": func(t time.Time) string { return t.Format("2006-01-02 15:04 MST") },
+	"duration": reportDuration,
+}).ParseFS(reportTemplates, "templates/team_report.html"))
+
+// TeamReportService builds weekly team reports: the PRs the team merged, their
+// average turnaround, how evenly reviews were spread and the PRs escalated for
+// breaching the review SLA. Its scheduler sends each team lead the report of
+// their team at the weekday and hour the team configured.
+type TeamReportService struct {
+	teamRepo  domain.TeamRepository
+	statsRepo domain.StatsRepository
+	notifySvc *NotificationService
+	tx        domain.UnitOfWork
+	log       *slog.Logger
+}
+
+func NewTeamReportService(
+	teamRepo domain.TeamRepository,
+	statsRepo domain.StatsRepository,
+	notifySvc *NotificationService,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *TeamReportService {
+	return &TeamReportService{
+		teamRepo:  teamRepo,
+		statsRepo: statsRepo,
+		notifySvc: notifySvc,
+		tx:        tx,
+		log:       log,
+	}
+}
+
+// GetSchedule returns the team's report schedule, nil when reports are off.
+func (s *TeamReportService) GetSchedule(ctx context.Context, teamName string) (*domain.TeamReportSchedule, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.teamRepo.GetReportSchedule(ctx, team.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	return schedule, err
+}
+
+// SetSchedule sends the team lead the weekly report every weekday at hour:00
+// in timezone (UTC when empty); the first report goes out at the next such
+// time. Disabling the reports removes the schedule and returns nil.
+func (s *TeamReportService) SetSchedule(ctx context.Context, teamName string, enabled bool, weekday time.Weekday, hour int, timezone string) (*domain.TeamReportSchedule, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if enabled {
+		if err := validateReportSchedule(weekday, hour, timezone); err != nil {
+			return nil, err
+		}
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule *domain.TeamReportSchedule
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if !enabled {
+			if err := s.teamRepo.DeleteReportSchedule(ctx, tx, team.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+			return nil
+		}
+		var err error
+		schedule, err = s.teamRepo.SetReportSchedule(ctx, tx, &domain.TeamReportSchedule{
+			TeamID:   team.ID,
+			Weekday:  weekday,
+			Hour:     hour,
+			Timezone: timezone,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.InfoContext(ctx, "team report schedule updated",
+		"event", "team.report_schedule_set",
+		"team_name", team.TeamName,
+		"enabled", enabled,
+		"weekday", weekday,
+		"hour", hour,
+		"timezone", timezone,
+	)
+	return schedule, nil
+}
+
+func validateReportSchedule(weekday time.Weekday, hour int, timezone string) error {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return fmt.Errorf("%w: unknown weekday", domain.ErrValidation)
+	}
+	if hour < 0 || hour > 23 {
+		return fmt.Errorf("%w: hour must be between 0 and 23", domain.ErrValidation)
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", domain.ErrValidation, timezone)
+	}
+	return nil
+}
+
+// GetReport builds the team's report of the week ending now, with times in the
+// timezone of the team's schedule (UTC without one).
+func (s *TeamReportService) GetReport(ctx context.Context, teamName string) (*domain.TeamReport, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	schedule, err := s.teamRepo.GetReportSchedule(ctx, team.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	if schedule != nil {
+		if l, err := time.LoadLocation(schedule.Timezone); err == nil {
+			loc = l
+		}
+	}
+	return s.build(ctx, team.TeamName, time.Now().In(loc))
+}
+
+func (s *TeamReportService) build(ctx context.Context, teamName string, until time.Time) (*domain.TeamReport, error) {
+	report := &domain.TeamReport{TeamName: teamName, Since: until.Add(-reportWindow), Until: until}
+
+	merged, err := s.statsRepo.GetTeamMergedPRs(ctx, teamName, report.Since, report.Until)
+	if err != nil {
+		return nil, err
+	}
+	report.Merged = localReportPRs(merged, until.Location())
+	if len(merged) > 0 {
+		var total time.Duration
+		for _, pr := range merged {
+			total += pr.At.Sub(pr.CreatedAt)
+		}
+		avg := total / time.Duration(len(merged))
+		report.AvgTurnaround = &avg
+	}
+
+	breaches, err := s.statsRepo.GetTeamSLABreaches(ctx, teamName, report.Since, report.Until)
+	if err != nil {
+		return nil, err
+	}
+	report.SLABreaches = localReportPRs(breaches, until.Location())
+
+	counts, err := s.statsRepo.GetMemberReviewCounts(ctx, teamName, report.Since, report.Until)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[string]int, len(counts))
+	for _, c := range counts {
+		byUser[c.UserID] = c.ReviewCount
+	}
+	report.Fairness = teamFairness(teamName, byUser)
+	return report, nil
+}
+
+func localReportPRs(prs []domain.ReportPR, loc *time.Location) []domain.ReportPR {
+	for i := range prs {
+		prs[i].CreatedAt = prs[i].CreatedAt.In(loc)
+		prs[i].At = prs[i].At.In(loc)
+	}
+	return prs
+}
+
+// RenderHTML renders the report as an HTML page.
+func (s *TeamReportService) RenderHTML(report *domain.TeamReport) (string, error) {
+	var buf bytes.Buffer
+	if err := teamReportTemplate.Execute(&buf, report); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// reportSummary is the plain-text version of the report for channels that
+// cannot show HTML.
+func reportSummary(report *domain.TeamReport) string {
+	lines := []string{
+		fmt.Sprintf("Weekly review report for team %q, %s to %s", report.TeamName, report.Since.Format(time.DateOnly), report.Until.Format(time.DateOnly)),
+		fmt.Sprintf("- merged PRs: %d", len(report.Merged)),
+	}
+	if report.AvgTurnaround != nil {
+		lines = append(lines, fmt.Sprintf("- average turnaround: %s", reportDuration(*report.AvgTurnaround)))
+	}
+	lines = append(lines,
+		fmt.Sprintf("- SLA breaches: %d", len(report.SLABreaches)),
+		fmt.Sprintf("- reviews assigned: %d, %d to %d per member, Gini %.2f", report.Fairness.TotalReviews, report.Fairness.MinReviews, report.Fairness.MaxReviews, report.Fairness.Gini),
+	)
+	for _, pr := range report.SLABreaches {
+		lines = append(lines, fmt.Sprintf("  - %q (%s) escalated %s", pr.Name, pr.ID, pr.At.Format("2006-01-02 15:04")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// reportDuration formats d in days and hours, or hours and minutes when
+// shorter than a day.
+func reportDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d >= 24*time.Hour {
+		return fmt.Sprintf("%dd %dh", d/(24*time.Hour), d%(24*time.Hour)/time.Hour)
+	}
+	return fmt.Sprintf("%dh %dm", d/time.Hour, d%time.Hour/time.Minute)
+}
+
+// Tick sends the reports that became due since the last run and returns how
+// many were sent. A team that fails is logged and retried on the next run.
+func (s *TeamReportService) Tick(ctx context.Context) (int, error) {
+	schedules, err := s.teamRepo.ListReportSchedules(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	sent := 0
+	for i := range schedules {
+		schedule := &schedules[i]
+		loc, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			s.log.WarnContext(ctx, "team report schedule has an unknown timezone", "team_id", schedule.TeamID, "timezone", schedule.Timezone)
+			loc = time.UTC
+		}
+		slot := schedule.LastSlot(now, loc)
+		if !slot.After(schedule.CreatedAt) || (schedule.LastSentAt != nil && !slot.After(*schedule.LastSentAt)) {
+			continue
+		}
+		ok, err := s.send(ctx, schedule.TeamID, slot)
+		if err != nil {
+			s.log.WarnContext(ctx, "failed to send team report", "team_id", schedule.TeamID, "error", err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+func (s *TeamReportService) send(ctx context.Context, teamID int32, slot time.Time) (bool, error) {
+	team, err := s.teamRepo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return false, err
+	}
+	report, err := s.build(ctx, team.TeamName, slot)
+	if err != nil {
+		return false, err
+	}
+	html, err := s.RenderHTML(report)
+	if err != nil {
+		return false, err
+	}
+	claimed, err := s.teamRepo.ClaimTeamReport(ctx, teamID, slot)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	s.log.InfoContext(ctx, "team report sent", "event", "team.report_sent", "team_name", team.TeamName, "slot", slot)
+	if team.Escalation.LeadUserID == "" {
+		s.log.WarnContext(ctx, "team with a report schedule has no team lead to send it to", "team_name", team.TeamName)
+		return true, nil
+	}
+	n := &domain.Notification{
+		UserID:  team.Escalation.LeadUserID,
+		Event:   domain.EventTeamReport,
+		Message: reportSummary(report),
+		HTML:    html,
+	}
+	if err := s.notifySvc.Notify(ctx, n); err != nil {
+		s.log.WarnContext(ctx, "failed to queue notification", "user_id", n.UserID, "team_name", team.TeamName, "error", err)
+	}
+	return true, nil
+}
+
+// Run sends due team reports every interval until ctx is cancelled.
+func (s *TeamReportService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Tick(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "team report run failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
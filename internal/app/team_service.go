@@ -1,166 +1,1008 @@
 package app
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
 )
 
 const (
 	maxReviewers = 2
+	// maxEscalatedReviewers allows escalation to add one reviewer beyond the usual limit.
+	maxEscalatedReviewers  = maxReviewers + 1
+	maxOptionalReviewers   = 3
+	maxReviewCooldownPRs   = 50
+	maxReviewerPreferences = 200
+	maxChecklistItems      = 20
+	maxChecklistItemLength = 200
+	maxSizeRules           = 10
+	maxPreferenceWeight    = 100
+	maxOpenPRsQuota        = 100
+	maxRotationMembers     = 100
+	maxRotationWeeks       = 52
+	defaultRotationWeeks   = 4
+
+	dueDeactivationBatchSize = 50
 )
 
 type TeamService struct {
-	teamRepo domain.TeamRepository
-	userRepo domain.UserRepository
-	prSvc    *PullRequestService
-	tx       domain.Transactor
-	log      *slog.Logger
+	teamRepo   domain.TeamRepository
+	userRepo   domain.UserRepository
+	filterRepo domain.SavedFilterRepository
+	prSvc      *PullRequestService
+	tx         domain.UnitOfWork
+	log        *slog.Logger
 }
 
 func NewTeamService(
 	teamRepo domain.TeamRepository,
 	userRepo domain.UserRepository,
+	filterRepo domain.SavedFilterRepository,
 	prSvc *PullRequestService,
-	tx domain.Transactor,
+	tx domain.UnitOfWork,
 	log *slog.Logger,
 ) *TeamService {
 	return &TeamService{
-		teamRepo: teamRepo,
-		userRepo: userRepo,
-		prSvc:    prSvc,
-		tx:       tx,
-		log:      log,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		filterRepo: filterRepo,
+		prSvc:      prSvc,
+		tx:         tx,
+		log:        log,
 	}
 }
 
-func (s *TeamService) CreateTeam(ctx context.Context, name string, userNames []string) (*domain.Team, error) {
+// CreateTeam creates a team with its members. Member usernames must be unique
+// unless allowDuplicateUsernames is set.
+func (s *TeamService) CreateTeam(ctx context.Context, name string, userNames []string, allowDuplicateUsernames bool) (*domain.Team, error) {
 	if name == "" {
 		return nil, fmt.Errorf("%w: team name is required", domain.ErrValidation)
 	}
+	if dup := duplicateUsername(userNames); dup != "" && !allowDuplicateUsernames {
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(dup))
+	}
+
+	teamToCreate := &domain.Team{TeamName: name, IsActive: true, AllowDuplicateUsernames: allowDuplicateUsernames}
+	var createdTeam *domain.Team
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		createdTeam, err = s.teamRepo.CreateTeam(ctx, tx, teamToCreate)
+		if err != nil {
+			return err
+		}
+
+		createdUsers := make([]domain.User, 0, len(userNames))
+		for _, username := range userNames {
+			if username == "" {
+				return fmt.Errorf("%w: username is required", domain.ErrValidation)
+			}
+			userToCreate := &domain.User{
+				ID:       uuid.New().String(),
+				Username: username,
+				TeamID:   createdTeam.ID,
+				IsActive: true,
+			}
+			createdUser, err := s.userRepo.CreateUser(ctx, tx, userToCreate)
+			if err != nil {
+				return err
+			}
+			createdUsers = append(createdUsers, *createdUser)
+		}
+		createdTeam.Members = createdUsers
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdTeam, nil
+}
+
+// UpdateTeamInput describes changes to a team; nil settings are left as they
+// are.
+type UpdateTeamInput struct {
+	OldName string
+	// NewName renames the team when set and different from OldName
+	NewName           string
+	Escalation        *domain.EscalationPolicy
+	ReviewCooldownPRs *int
+	Checklist         *domain.ChecklistTemplate
+	// SizeRules replace the team's when non-nil; an empty slice removes them
+	SizeRules               []domain.SizeRule
+	AllowDuplicateUsernames *bool
+	AllowSelfReview         *bool
+}
+
+// teamUpdate applies one optional setting of UpdateTeamInput in tx and
+// returns the updated team.
+type teamUpdate func(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error)
+
+// UpdateTeam renames the team when NewName is set and differs, and replaces
+// its escalation policy, review cooldown, checklist template, size rules,
+// duplicate usernames and self-review settings when they are non-nil.
+// Saved filters selecting PRs by the old name follow a rename, which is
+// logged as a team.renamed audit event.
+func (s *TeamService) UpdateTeam(ctx context.Context, in UpdateTeamInput) (*domain.Team, error) {
+	if err := validateTeamUpdate(&in); err != nil {
+		return nil, err
+	}
+
+	renamed := in.NewName != "" && in.NewName != in.OldName
+	var updatedTeam *domain.Team
+	var renamedFilters int
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		if updatedTeam, err = s.teamRepo.GetTeamByName(ctx, in.OldName); err != nil {
+			return err
+		}
+		if renamed {
+			if updatedTeam, renamedFilters, err = s.renameTeam(ctx, tx, in.OldName, in.NewName); err != nil {
+				return err
+			}
+		}
+		for _, update := range []teamUpdate{
+			s.updateEscalationPolicy,
+			s.updateReviewCooldown,
+			s.updateChecklist,
+			s.updateSizeRules,
+			s.updateAllowDuplicateUsernames,
+			s.updateAllowSelfReview,
+		} {
+			if updatedTeam, err = update(ctx, tx, updatedTeam, &in); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if renamed {
+		// Cached candidate pools hold the members with the old team name.
+		s.prSvc.invalidateCandidates()
+		s.log.InfoContext(ctx, "team renamed",
+			"event", "team.renamed",
+			"team_id", updatedTeam.ID,
+			"old_name", in.OldName,
+			"new_name", in.NewName,
+			"saved_filters", renamedFilters,
+			"actor", domain.ActorFromContext(ctx),
+		)
+	}
+
+	if updatedTeam.SizeRules, err = s.teamRepo.GetTeamSizeRules(ctx, updatedTeam.ID); err != nil {
+		return nil, err
+	}
+	return updatedTeam, nil
+}
+
+func validateTeamUpdate(in *UpdateTeamInput) error {
+	if in.Escalation != nil {
+		if err := validateEscalationPolicy(in.Escalation); err != nil {
+			return err
+		}
+	}
+	if c := in.ReviewCooldownPRs; c != nil && (*c < 0 || *c > maxReviewCooldownPRs) {
+		return fmt.Errorf("%w: review_cooldown_prs must be between 0 and %d", domain.ErrValidation, maxReviewCooldownPRs)
+	}
+	if in.Checklist != nil {
+		if err := validateChecklistTemplate(in.Checklist); err != nil {
+			return err
+		}
+	}
+	return validateSizeRules(in.SizeRules)
+}
 
-	tx, err := s.tx.BeginTx(ctx)
+// renameTeam renames the team and the saved filters selecting its PRs, whose
+// number it returns.
+func (s *TeamService) renameTeam(ctx context.Context, tx domain.Tx, oldName, newName string) (*domain.Team, int, error) {
+	team, err := s.teamRepo.UpdateTeam(ctx, tx, oldName, newName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, 0, err
+	}
+	filters, err := s.filterRepo.RenameSavedFilterTeam(ctx, tx, oldName, newName)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to rename team in saved filters: %w", err)
+	}
+	return team, filters, nil
+}
+
+func (s *TeamService) updateEscalationPolicy(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.Escalation == nil {
+		return team, nil
+	}
+	return s.teamRepo.SetTeamEscalationPolicy(ctx, tx, team.ID, *in.Escalation)
+}
+
+func (s *TeamService) updateReviewCooldown(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.ReviewCooldownPRs == nil {
+		return team, nil
+	}
+	return s.teamRepo.SetTeamReviewCooldown(ctx, tx, team.ID, *in.ReviewCooldownPRs)
+}
+
+func (s *TeamService) updateChecklist(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.Checklist == nil {
+		return team, nil
+	}
+	return s.teamRepo.SetTeamChecklist(ctx, tx, team.ID, *in.Checklist)
+}
+
+func (s *TeamService) updateSizeRules(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.SizeRules == nil {
+		return team, nil
+	}
+	return team, s.teamRepo.SetTeamSizeRules(ctx, tx, team.ID, in.SizeRules)
+}
+
+// updateAllowDuplicateUsernames refuses to forbid duplicate usernames while
+// members still share one.
+func (s *TeamService) updateAllowDuplicateUsernames(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.AllowDuplicateUsernames == nil {
+		return team, nil
+	}
+	if !*in.AllowDuplicateUsernames {
+		members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		if dup := duplicateUsername(memberUsernames(members)); dup != "" {
+			return nil, fmt.Errorf("%w: '%s' is used by several members; rename them first", domain.ErrUsernameExists, domain.PII(dup))
+		}
+	}
+	return s.teamRepo.SetTeamAllowDuplicateUsernames(ctx, tx, team.ID, *in.AllowDuplicateUsernames)
+}
+
+func (s *TeamService) updateAllowSelfReview(ctx context.Context, tx domain.Tx, team *domain.Team, in *UpdateTeamInput) (*domain.Team, error) {
+	if in.AllowSelfReview == nil {
+		return team, nil
+	}
+	return s.teamRepo.SetTeamAllowSelfReview(ctx, tx, team.ID, *in.AllowSelfReview)
+}
+
+// duplicateUsername returns the first username that occurs more than once, or "".
+func duplicateUsername(usernames []string) string {
+	seen := make(map[string]bool, len(usernames))
+	for _, name := range usernames {
+		if seen[name] {
+			return name
+		}
+		seen[name] = true
+	}
+	return ""
+}
+
+func memberUsernames(members []domain.User) []string {
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.Username
+	}
+	return names
+}
+
+func validateSizeRules(rules []domain.SizeRule) error {
+	if len(rules) > maxSizeRules {
+		return fmt.Errorf("%w: at most %d size rules are allowed", domain.ErrValidation, maxSizeRules)
+	}
+	for i, rule := range rules {
+		if rule.MaxLinesChanged == nil && rule.MaxFilesChanged == nil {
+			return fmt.Errorf("%w: size rule %d needs max_lines_changed or max_files_changed", domain.ErrValidation, i)
+		}
+		if (rule.MaxLinesChanged != nil && *rule.MaxLinesChanged < 0) || (rule.MaxFilesChanged != nil && *rule.MaxFilesChanged < 0) {
+			return fmt.Errorf("%w: size rule %d has a negative bound", domain.ErrValidation, i)
+		}
+		if rule.Reviewers < 1 || rule.Reviewers > maxEscalatedReviewers {
+			return fmt.Errorf("%w: size rule %d must assign between 1 and %d reviewers", domain.ErrValidation, i, maxEscalatedReviewers)
+		}
+	}
+	return nil
+}
+
+func validateChecklistTemplate(c *domain.ChecklistTemplate) error {
+	if len(c.Items) > maxChecklistItems {
+		return fmt.Errorf("%w: a checklist can have at most %d items", domain.ErrValidation, maxChecklistItems)
+	}
+	for i, item := range c.Items {
+		c.Items[i] = strings.TrimSpace(item)
+		if c.Items[i] == "" || len(c.Items[i]) > maxChecklistItemLength {
+			return fmt.Errorf("%w: checklist items must be 1 to %d characters long", domain.ErrValidation, maxChecklistItemLength)
+		}
+	}
+	return nil
+}
+
+func validateEscalationPolicy(p *domain.EscalationPolicy) error {
+	if p.NotifyLeadAfterHours < 0 || p.AddReviewerAfterHours < 0 {
+		return fmt.Errorf("%w: escalation delays cannot be negative", domain.ErrValidation)
+	}
+	if p.NotifyLeadAfterHours > 0 && p.LeadUserID == "" {
+		return fmt.Errorf("%w: notifying the team lead needs a lead_user_id", domain.ErrValidation)
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	return nil
+}
+
+func (s *TeamService) DeactivateTeamAndReassign(ctx context.Context, teamName string) (int, int, error) {
+	var deactivatedUserIDs []string
+	var reassignedCount int
+	var missed noCandidates
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		missed = nil
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		if err := s.teamRepo.DeactivateTeam(ctx, tx, teamName); err != nil {
+			return err
 		}
-	}(s.tx, ctx, tx)
 
-	teamToCreate := &domain.Team{TeamName: name, IsActive: true}
-	createdTeam, err := s.teamRepo.CreateTeam(ctx, tx, teamToCreate)
+		if deactivatedUserIDs, err = s.userRepo.DeactivateUsersByTeam(ctx, tx, team.ID); err != nil {
+			return err
+		}
+		reassignedCount, err = s.prSvc.reassignReviewsOfTeam(ctx, tx, team, deactivatedUserIDs, &missed)
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	s.prSvc.invalidateCandidates()
+	s.prSvc.reportNoCandidates(ctx, missed)
+
+	return len(deactivatedUserIDs), reassignedCount, nil
+}
+
+// ScheduleDeactivation plans the deactivation of an active team at the given
+// time; a later call replaces the plan. The deactivation itself is carried out
+// by DeactivateDue.
+func (s *TeamService) ScheduleDeactivation(ctx context.Context, teamName string, at time.Time) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
+	if !team.IsActive {
+		return nil, fmt.Errorf("%w: team %s is already inactive", domain.ErrValidation, teamName)
+	}
+
+	var scheduled *domain.Team
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		scheduled, err = s.teamRepo.ScheduleTeamDeactivation(ctx, tx, team.ID, &at)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.InfoContext(ctx, "team deactivation scheduled", "team_name", teamName, "deactivate_at", at)
+	return scheduled, nil
+}
+
+// DeactivateDue deactivates the teams whose scheduled deactivation time has
+// passed and returns how many were deactivated. A team that fails is logged
+// and retried on the next run.
+func (s *TeamService) DeactivateDue(ctx context.Context) (int, error) {
+	teams, err := s.teamRepo.ListTeamsDueForDeactivation(ctx, dueDeactivationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deactivated := 0
+	for _, team := range teams {
+		users, reassigned, err := s.DeactivateTeamAndReassign(ctx, team.TeamName)
+		if err != nil {
+			s.log.WarnContext(ctx, "failed to apply scheduled team deactivation", "team_name", team.TeamName, "error", err)
+			continue
+		}
+		s.log.InfoContext(ctx, "scheduled team deactivation applied", "event", "team.deactivated",
+			"team_name", team.TeamName,
+			"deactivated_users", users,
+			"reassigned_reviews", reassigned,
+		)
+		deactivated++
+	}
+	return deactivated, nil
+}
+
+// Run applies due team deactivations every interval until ctx is cancelled.
+func (s *TeamService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeactivateDue(ctx); err != nil && ctx.Err() == nil {
+			s.log.ErrorContext(ctx, "scheduled team deactivation run failed", "error", err)
+		}
 
-	createdUsers := make([]domain.User, 0, len(userNames))
-	for _, username := range userNames {
-		if username == "" {
-			return nil, fmt.Errorf("%w: username is required", domain.ErrValidation)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
-		userToCreate := &domain.User{
-			ID:       uuid.New().String(),
-			Username: username,
-			TeamID:   createdTeam.ID,
-			IsActive: true,
+	}
+}
+
+func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("team with name %s not found", teamName)
 		}
-		createdUser, err := s.userRepo.CreateUser(ctx, tx, userToCreate)
+		return nil, fmt.Errorf("failed to get team by name %s: %w", teamName, err)
+	}
+	return team, nil
+}
+
+// GetCapacity compares the open reviews of each member of the team with the
+// reviewer cap.
+func (s *TeamService) GetCapacity(ctx context.Context, teamName string) (*domain.TeamCapacity, error) {
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range team.Members {
+		team.Members[i].TeamName = team.TeamName
+	}
+	settings, err := s.prSvc.settings.effective(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.prSvc.workloads(ctx, team.Members, settings.MaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(members, func(a, b domain.Workload) int {
+		if a.CanTakeReview() != b.CanTakeReview() {
+			if a.CanTakeReview() {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(a.OpenReviews, b.OpenReviews); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.User.Username, b.User.Username)
+	})
+	return &domain.TeamCapacity{TeamName: team.TeamName, Capacity: settings.MaxOpenReviews, Members: members}, nil
+}
+
+func (s *TeamService) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	return s.teamRepo.ListTeams(ctx)
+}
+
+// SetTeamParent attaches a team to a parent team, or detaches it when parentName is empty.
+// Cycles in the hierarchy are rejected.
+func (s *TeamService) SetTeamParent(ctx context.Context, teamName, parentName string, escalateToParent bool) (*domain.TeamHierarchy, error) {
+	if teamName == "" {
+		return nil, fmt.Errorf("%w: team name is required", domain.ErrValidation)
+	}
+	if parentName == "" && escalateToParent {
+		return nil, fmt.Errorf("%w: escalation requires a parent team", domain.ErrValidation)
+	}
+
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *int32
+	if parentName != "" {
+		parent, err := s.teamRepo.GetTeamByName(ctx, parentName)
 		if err != nil {
 			return nil, err
 		}
-		createdUsers = append(createdUsers, *createdUser)
+		if err := s.checkHierarchyCycle(ctx, team, parent); err != nil {
+			return nil, err
+		}
+		parentID = &parent.ID
 	}
-	createdTeam.Members = createdUsers
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if _, err := s.teamRepo.SetTeamParent(ctx, tx, team.ID, parentID, escalateToParent); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return createdTeam, nil
+	return s.GetTeamHierarchy(ctx, teamName)
 }
 
-func (s *TeamService) UpdateTeam(ctx context.Context, oldName, newName string) (*domain.Team, error) {
-	tx, err := s.tx.BeginTx(ctx)
+// checkHierarchyCycle walks up from the new parent and fails if it reaches the team itself.
+func (s *TeamService) checkHierarchyCycle(ctx context.Context, team, parent *domain.Team) error {
+	for current := parent; ; {
+		if current.ID == team.ID {
+			return fmt.Errorf("%w: team %s cannot be a descendant of itself", domain.ErrValidation, team.TeamName)
+		}
+		if current.ParentTeamID == nil {
+			return nil
+		}
+		next, err := s.teamRepo.GetTeamByID(ctx, *current.ParentTeamID)
+		if err != nil {
+			return err
+		}
+		current = next
+	}
+}
+
+func (s *TeamService) GetTeamHierarchy(ctx context.Context, teamName string) (*domain.TeamHierarchy, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+
+	hierarchy := &domain.TeamHierarchy{Team: *team}
+	if team.ParentTeamID != nil {
+		parent, err := s.teamRepo.GetTeamByID(ctx, *team.ParentTeamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent of team %s: %w", teamName, err)
 		}
-	}(s.tx, ctx, tx)
+		hierarchy.Parent = parent
+	}
 
-	updatedTeam, err := s.teamRepo.UpdateTeam(ctx, tx, oldName, newName)
+	children, err := s.teamRepo.ListChildTeams(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child teams of %s: %w", teamName, err)
+	}
+	hierarchy.Children = children
+
+	return hierarchy, nil
+}
+
+// SetReviewerRequirements sets the reviewer role every PR of the team must have
+// among its required reviewers before merging, and, when optionalReviewers is
+// not nil, how many optional reviewers new PRs get on top of the required ones.
+// When shadowReviewPercent is not nil it sets the share of new PRs that get a
+// member in training as a shadow reviewer. An empty role removes the
+// requirement.
+func (s *TeamService) SetReviewerRequirements(ctx context.Context, teamName, role string, optionalReviewers, shadowReviewPercent *int) (*domain.Team, error) {
+	if optionalReviewers != nil && (*optionalReviewers < 0 || *optionalReviewers > maxOptionalReviewers) {
+		return nil, fmt.Errorf("%w: optional_reviewers must be between 0 and %d", domain.ErrValidation, maxOptionalReviewers)
+	}
+	if shadowReviewPercent != nil && (*shadowReviewPercent < 0 || *shadowReviewPercent > 100) {
+		return nil, fmt.Errorf("%w: shadow_review_percent must be between 0 and 100", domain.ErrValidation)
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	var updatedTeam *domain.Team
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		updatedTeam, err = s.teamRepo.SetTeamRequiredReviewerRole(ctx, tx, team.ID, role)
+		if err != nil {
+			return err
+		}
+		if optionalReviewers != nil {
+			if updatedTeam, err = s.teamRepo.SetTeamOptionalReviewers(ctx, tx, team.ID, *optionalReviewers); err != nil {
+				return err
+			}
+		}
+		if shadowReviewPercent != nil {
+			updatedTeam, err = s.teamRepo.SetTeamShadowReviewPercent(ctx, tx, team.ID, *shadowReviewPercent)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return updatedTeam, nil
 }
 
-func (s *TeamService) DeactivateTeamAndReassign(ctx context.Context, teamName string) (int, int, error) {
-	tx, err := s.tx.BeginTx(ctx)
+// GetReviewerPreferences returns the team's preferred reviewer mappings.
+func (s *TeamService) GetReviewerPreferences(ctx context.Context, teamName string) ([]domain.ReviewerPreference, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return s.teamRepo.GetReviewerPreferences(ctx, team.ID)
+}
+
+// SetReviewerPreferences replaces the team's preferred reviewer mappings. Every
+// reviewer must be a member of the team; authors may come from any team whose
+// PRs are reviewed here. An empty list removes all preferences.
+func (s *TeamService) SetReviewerPreferences(ctx context.Context, teamName string, prefs []domain.ReviewerPreference) ([]domain.ReviewerPreference, error) {
+	if len(prefs) > maxReviewerPreferences {
+		return nil, fmt.Errorf("%w: at most %d reviewer preferences are allowed", domain.ErrValidation, maxReviewerPreferences)
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	memberIDs, err := s.teamMemberIDs(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateReviewerPreferences(prefs, memberIDs, team.TeamName); err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		_, err := s.teamRepo.SetReviewerPreferences(ctx, tx, team.ID, prefs)
+		return err
+	})
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "reviewer preferences updated",
+		"event", "team.reviewer_preferences_set",
+		"team_name", team.TeamName,
+		"count", len(prefs),
+	)
+	return s.teamRepo.GetReviewerPreferences(ctx, team.ID)
+}
+
+// validateReviewerPreferences checks the preferences of the team whose members
+// are memberIDs.
+func validateReviewerPreferences(prefs []domain.ReviewerPreference, memberIDs map[string]struct{}, teamName string) error {
+	seen := make(map[[2]string]struct{}, len(prefs))
+	for _, p := range prefs {
+		if p.AuthorID == "" || p.ReviewerID == "" {
+			return fmt.Errorf("%w: author_id and reviewer_id are required", domain.ErrValidation)
+		}
+		if p.AuthorID == p.ReviewerID {
+			return fmt.Errorf("%w: user '%s' cannot be their own preferred reviewer", domain.ErrValidation, p.AuthorID)
+		}
+		if p.Weight < 1 || p.Weight > maxPreferenceWeight {
+			return fmt.Errorf("%w: weight must be between 1 and %d", domain.ErrValidation, maxPreferenceWeight)
 		}
-	}(s.tx, ctx, tx)
+		if _, ok := memberIDs[p.ReviewerID]; !ok {
+			return fmt.Errorf("%w: reviewer '%s' is not a member of team '%s'", domain.ErrValidation, p.ReviewerID, teamName)
+		}
+		key := [2]string{p.AuthorID, p.ReviewerID}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("%w: duplicate preference %s -> %s", domain.ErrValidation, p.AuthorID, p.ReviewerID)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
 
+// GetPRQuota returns the team's open PR quota, nil when it has none.
+func (s *TeamService) GetPRQuota(ctx context.Context, teamName string) (*domain.PRQuota, error) {
 	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
+	}
+	quota, err := s.teamRepo.GetPRQuota(ctx, team.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
 	}
+	return quota, err
+}
 
-	if err := s.teamRepo.DeactivateTeam(ctx, tx, teamName); err != nil {
-		return 0, 0, err
+// SetPRQuota lets each member of the team have at most maxOpenPRs PRs open at
+// once; mode (warn when empty) decides whether more are flagged or refused.
+// Zero maxOpenPRs removes the quota and returns nil.
+func (s *TeamService) SetPRQuota(ctx context.Context, teamName string, maxOpenPRs int, mode domain.PRQuotaMode) (*domain.PRQuota, error) {
+	if mode == "" {
+		mode = domain.PRQuotaWarn
+	}
+	if err := validatePRQuota(maxOpenPRs, mode); err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
 	}
 
-	deactivatedUserIDs, err := s.userRepo.DeactivateUsersByTeam(ctx, tx, team.ID)
+	var quota *domain.PRQuota
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if maxOpenPRs == 0 {
+			if err := s.teamRepo.DeletePRQuota(ctx, tx, team.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+			return nil
+		}
+		var err error
+		quota, err = s.teamRepo.SetPRQuota(ctx, tx, &domain.PRQuota{TeamID: team.ID, MaxOpenPRs: maxOpenPRs, Mode: mode})
+		return err
+	})
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
-	reassignedCount, err := s.prSvc.reassignReviewsForUsers(ctx, tx, deactivatedUserIDs)
+	s.log.InfoContext(ctx, "PR quota updated",
+		"event", "team.pr_quota_set",
+		"team_name", team.TeamName,
+		"max_open_prs", maxOpenPRs,
+		"mode", mode,
+	)
+	return quota, nil
+}
+
+func validatePRQuota(maxOpenPRs int, mode domain.PRQuotaMode) error {
+	if maxOpenPRs < 0 || maxOpenPRs > maxOpenPRsQuota {
+		return fmt.Errorf("%w: max_open_prs must be between 0 and %d", domain.ErrValidation, maxOpenPRsQuota)
+	}
+	if !mode.Valid() {
+		return fmt.Errorf("%w: unknown quota mode %q", domain.ErrValidation, mode)
+	}
+	return nil
+}
+
+// GetReviewRotation returns the team's review rotation with the primary
+// reviewers of the next weeks weeks, the current one first.
+func (s *TeamService) GetReviewRotation(ctx context.Context, teamName string, weeks int) (*domain.RotationSchedule, error) {
+	if weeks < 1 || weeks > maxRotationWeeks {
+		return nil, fmt.Errorf("%w: weeks must be between 1 and %d", domain.ErrValidation, maxRotationWeeks)
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
+	return s.rotationSchedule(ctx, team, weeks)
+}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return 0, 0, fmt.Errorf("failed to commit transaction: %w", err)
+// SetReviewRotation makes the members take turns, in order, as the team's
+// primary reviewer for a week each, starting with the week startDate falls in
+// (the current week when zero). Overrides of single weeks are kept. An empty
+// list removes the rotation with its overrides.
+func (s *TeamService) SetReviewRotation(ctx context.Context, teamName string, startDate time.Time, memberIDs []string) (*domain.RotationSchedule, error) {
+	if startDate.IsZero() {
+		startDate = time.Now()
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	teamMembers, err := s.teamMemberIDs(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRotationMembers(memberIDs, teamMembers, team.TeamName); err != nil {
+		return nil, err
 	}
 
-	return len(deactivatedUserIDs), reassignedCount, nil
+	rotation := &domain.ReviewRotation{TeamID: team.ID, StartDate: domain.WeekStart(startDate), MemberIDs: memberIDs}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if len(memberIDs) == 0 {
+			if err := s.teamRepo.DeleteReviewRotation(ctx, tx, team.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+			return nil
+		}
+		return s.teamRepo.SetReviewRotation(ctx, tx, rotation)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "review rotation updated",
+		"event", "team.review_rotation_set",
+		"team_name", team.TeamName,
+		"start_date", rotation.StartDate.Format(time.DateOnly),
+		"members", len(memberIDs),
+	)
+	return s.rotationSchedule(ctx, team, defaultRotationWeeks)
 }
 
-func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+// OverrideReviewRotation makes userID, a member of the team, the primary
+// reviewer of the week weekOf falls in instead of whoever the rotation picks.
+// An empty userID gives the week back to the rotation.
+func (s *TeamService) OverrideReviewRotation(ctx context.Context, teamName string, weekOf time.Time, userID string) (*domain.RotationSchedule, error) {
+	weekStart := domain.WeekStart(weekOf)
+	if weekStart.Before(domain.WeekStart(time.Now())) {
+		return nil, fmt.Errorf("%w: the week of %s is over", domain.ErrValidation, weekOf.Format(time.DateOnly))
+	}
 	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
 	if err != nil {
+		return nil, err
+	}
+	if userID != "" {
+		teamMembers, err := s.teamMemberIDs(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := teamMembers[userID]; !ok {
+			return nil, fmt.Errorf("%w: user '%s' is not a member of team '%s'", domain.ErrValidation, userID, team.TeamName)
+		}
+	}
+
+	if _, err := s.teamRepo.GetReviewRotation(ctx, team.ID); err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return nil, fmt.Errorf("team with name %s not found", teamName)
+			return nil, fmt.Errorf("%w: team '%s' has no review rotation", domain.ErrNotFound, team.TeamName)
 		}
-		return nil, fmt.Errorf("failed to get team by name %s: %w", teamName, err)
+		return nil, err
 	}
 
-	users, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.teamRepo.SetRotationOverride(ctx, tx, team.ID, weekStart, userID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users for team %s: %w", teamName, err)
+		return nil, err
 	}
+	s.prSvc.invalidateCandidates()
 
-	team.Members = users
-	return team, nil
+	s.log.InfoContext(ctx, "review rotation overridden",
+		"event", "team.review_rotation_overridden",
+		"team_name", team.TeamName,
+		"week_start", weekStart.Format(time.DateOnly),
+		"user_id", userID,
+	)
+	return s.rotationSchedule(ctx, team, defaultRotationWeeks)
+}
+
+// rotationSchedule returns the team's rotation with the primary reviewers of
+// the next weeks weeks.
+func (s *TeamService) rotationSchedule(ctx context.Context, team *domain.Team, weeks int) (*domain.RotationSchedule, error) {
+	schedule := &domain.RotationSchedule{TeamName: team.TeamName, Weeks: []domain.RotationWeek{}}
+	rotation, err := s.teamRepo.GetReviewRotation(ctx, team.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return schedule, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	schedule.Rotation = rotation
+
+	members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(members))
+	for _, m := range members {
+		usernames[m.ID] = m.Username
+	}
+	weekStart := domain.WeekStart(time.Now())
+	for range weeks {
+		week := rotation.Week(weekStart)
+		week.Username = usernames[week.UserID]
+		schedule.Weeks = append(schedule.Weeks, week)
+		weekStart = weekStart.AddDate(0, 0, 7)
+	}
+	return schedule, nil
+}
+
+// GetReviewerPool returns the members of the team's reviewer pool.
+func (s *TeamService) GetReviewerPool(ctx context.Context, teamName string) (*domain.ReviewerPool, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewerPool(ctx, team)
+}
+
+// SetReviewerPool makes the team pick regular reviewers only from the given
+// members. An empty list removes the pool, so that reviewers are picked from
+// everyone again.
+func (s *TeamService) SetReviewerPool(ctx context.Context, teamName string, userIDs []string) (*domain.ReviewerPool, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	teamMembers, err := s.teamMemberIDs(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateReviewerPool(userIDs, teamMembers, team.TeamName); err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.teamRepo.SetReviewerPool(ctx, tx, team.ID, userIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "reviewer pool updated",
+		"event", "team.reviewer_pool_set",
+		"team_name", team.TeamName,
+		"members", len(userIDs),
+	)
+	return s.reviewerPool(ctx, team)
+}
+
+func (s *TeamService) reviewerPool(ctx context.Context, team *domain.Team) (*domain.ReviewerPool, error) {
+	userIDs, err := s.teamRepo.GetReviewerPool(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	pool := &domain.ReviewerPool{TeamName: team.TeamName, Members: []domain.User{}}
+	if len(userIDs) == 0 {
+		return pool, nil
+	}
+	members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if slices.Contains(userIDs, m.ID) {
+			pool.Members = append(pool.Members, m)
+		}
+	}
+	return pool, nil
+}
+
+// GetInactiveReassign reports whether reviewers of the team who take no
+// action on a review for a while are swapped out.
+func (s *TeamService) GetInactiveReassign(ctx context.Context, teamName string) (bool, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return false, err
+	}
+	return s.teamRepo.GetInactiveReassign(ctx, team.ID)
+}
+
+// SetInactiveReassign opts the team out of (or back into) reassigning
+// inactive reviewers.
+func (s *TeamService) SetInactiveReassign(ctx context.Context, teamName string, enabled bool) (bool, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		return s.teamRepo.SetInactiveReassign(ctx, tx, team.ID, enabled)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	s.log.InfoContext(ctx, "inactive review reassignment updated",
+		"event", "team.inactive_reassign_set",
+		"team_name", team.TeamName,
+		"enabled", enabled,
+	)
+	return enabled, nil
+}
+
+func (s *TeamService) teamMemberIDs(ctx context.Context, teamID int32) (map[string]struct{}, error) {
+	members, err := s.userRepo.GetUsersByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	memberIDs := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		memberIDs[m.ID] = struct{}{}
+	}
+	return memberIDs, nil
+}
+
+// validateRotationMembers checks the rotation of the team whose members are
+// teamMembers.
+func validateRotationMembers(memberIDs []string, teamMembers map[string]struct{}, teamName string) error {
+	if len(memberIDs) > maxRotationMembers {
+		return fmt.Errorf("%w: at most %d rotation members are allowed", domain.ErrValidation, maxRotationMembers)
+	}
+	for i, id := range memberIDs {
+		if _, ok := teamMembers[id]; !ok {
+			return fmt.Errorf("%w: user '%s' is not a member of team '%s'", domain.ErrValidation, id, teamName)
+		}
+		if slices.Contains(memberIDs[:i], id) {
+			return fmt.Errorf("%w: duplicate rotation member %s", domain.ErrValidation, id)
+		}
+	}
+	return nil
+}
+
+// validateReviewerPool checks the reviewer pool of the team whose members are
+// teamMembers.
+func validateReviewerPool(userIDs []string, teamMembers map[string]struct{}, teamName string) error {
+	for i, id := range userIDs {
+		if _, ok := teamMembers[id]; !ok {
+			return fmt.Errorf("%w: user '%s' is not a member of team '%s'", domain.ErrValidation, id, teamName)
+		}
+		if slices.Contains(userIDs[:i], id) {
+			return fmt.Errorf("%w: duplicate reviewer pool member %s", domain.ErrValidation, id)
+		}
+	}
+	return nil
 }
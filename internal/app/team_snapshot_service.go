@@ -0,0 +1,472 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// TeamSnapshotService copies a team with its members and settings between
+// installations, e.g. from a pilot to the organisation-wide one.
+type TeamSnapshotService struct {
+	teamRepo     domain.TeamRepository
+	userRepo     domain.UserRepository
+	dumpRepo     domain.DumpRepository
+	ruleRepo     domain.ReviewRuleRepository
+	repoRepo     domain.RepositoryRepository
+	templateRepo domain.PRTemplateRepository
+	prSvc        *PullRequestService
+	tx           domain.UnitOfWork
+	log          *slog.Logger
+}
+
+func NewTeamSnapshotService(
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	dumpRepo domain.DumpRepository,
+	ruleRepo domain.ReviewRuleRepository,
+	repoRepo domain.RepositoryRepository,
+	templateRepo domain.PRTemplateRepository,
+	prSvc *PullRequestService,
+	tx domain.UnitOfWork,
+	log *slog.Logger,
+) *TeamSnapshotService {
+	return &TeamSnapshotService{
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		dumpRepo:     dumpRepo,
+		ruleRepo:     ruleRepo,
+		repoRepo:     repoRepo,
+		templateRepo: templateRepo,
+		prSvc:        prSvc,
+		tx:           tx,
+		log:          log,
+	}
+}
+
+// Export returns the snapshot of the team. Reviewer preferences of authors from
+// other teams and rotation members who left the team are left out, so that
+// the snapshot only refers to its members.
+func (s *TeamSnapshotService) Export(ctx context.Context, teamName string) (*domain.TeamSnapshot, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if team.SizeRules, err = s.teamRepo.GetTeamSizeRules(ctx, team.ID); err != nil {
+		return nil, err
+	}
+	if team.Members, err = s.userRepo.GetUsersByTeam(ctx, team.ID); err != nil {
+		return nil, fmt.Errorf("failed to export members of team %s: %w", teamName, err)
+	}
+	snapshot := &domain.TeamSnapshot{Team: *team}
+	if err := s.exportRouting(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	if err := s.exportSettings(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	if err := s.exportRotation(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// exportRouting adds the reviewer preferences, review rules and PR templates
+// of the team to its snapshot.
+func (s *TeamSnapshotService) exportRouting(ctx context.Context, snapshot *domain.TeamSnapshot) error {
+	team := &snapshot.Team
+	prefs, err := s.teamRepo.GetReviewerPreferences(ctx, team.ID)
+	if err != nil {
+		return fmt.Errorf("failed to export reviewer preferences of team %s: %w", team.TeamName, err)
+	}
+	snapshot.ReviewerPreferences = slices.DeleteFunc(prefs, func(p domain.ReviewerPreference) bool {
+		return !slices.ContainsFunc(team.Members, func(u domain.User) bool { return u.ID == p.AuthorID })
+	})
+
+	rules, err := s.ruleRepo.ListReviewRules(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export review rules of team %s: %w", team.TeamName, err)
+	}
+	snapshot.ReviewRules = slices.DeleteFunc(rules, func(r domain.ReviewRule) bool {
+		return r.TeamID == nil || *r.TeamID != team.ID
+	})
+
+	if snapshot.PRTemplates, err = s.templateRepo.ListPRTemplates(ctx, team.ID); err != nil {
+		return fmt.Errorf("failed to export PR templates of team %s: %w", team.TeamName, err)
+	}
+	return nil
+}
+
+// exportSettings adds the optional team settings kept outside the team to its
+// snapshot.
+func (s *TeamSnapshotService) exportSettings(ctx context.Context, snapshot *domain.TeamSnapshot) error {
+	teamID := snapshot.Team.ID
+	var err error
+	if snapshot.ReviewBudget, err = s.teamRepo.GetReviewBudget(ctx, teamID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	if snapshot.ReportSchedule, err = s.teamRepo.GetReportSchedule(ctx, teamID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	if snapshot.PRQuota, err = s.teamRepo.GetPRQuota(ctx, teamID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	if snapshot.ReviewerPool, err = s.teamRepo.GetReviewerPool(ctx, teamID); err != nil {
+		return err
+	}
+	inactiveReassign, err := s.teamRepo.GetInactiveReassign(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	snapshot.InactiveReassignOptOut = !inactiveReassign
+	return nil
+}
+
+// exportRotation adds the review rotation of the team to its snapshot, without
+// overrides and members who left the team.
+func (s *TeamSnapshotService) exportRotation(ctx context.Context, snapshot *domain.TeamSnapshot) error {
+	team := &snapshot.Team
+	rotation, err := s.teamRepo.GetReviewRotation(ctx, team.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rotation.Overrides = nil
+	rotation.MemberIDs = slices.DeleteFunc(rotation.MemberIDs, func(id string) bool {
+		return !slices.ContainsFunc(team.Members, func(u domain.User) bool { return u.ID == id })
+	})
+	if len(rotation.MemberIDs) > 0 {
+		snapshot.ReviewRotation = rotation
+	}
+	return nil
+}
+
+// Import creates the team of a snapshot produced by Export, keeping the IDs of
+// its members, and returns the snapshot of the created team. The snapshot is
+// validated before anything is written and is imported in a single
+// transaction. Repositories matched by review rules must already be configured.
+func (s *TeamSnapshotService) Import(ctx context.Context, snapshot *domain.TeamSnapshot) (*domain.TeamSnapshot, error) {
+	if err := s.validateSnapshot(ctx, snapshot); err != nil {
+		return nil, err
+	}
+
+	t := &snapshot.Team
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		team, err := s.dumpRepo.ImportTeam(ctx, tx, &domain.Team{
+			TeamName:                t.TeamName,
+			IsActive:                true,
+			AllowDuplicateUsernames: t.AllowDuplicateUsernames,
+			AllowSelfReview:         t.AllowSelfReview,
+		})
+		if err != nil {
+			return err
+		}
+		for _, u := range t.Members {
+			u.TeamID = team.ID
+			if err := s.dumpRepo.ImportUser(ctx, tx, &u); err != nil {
+				return err
+			}
+			if u.InTraining {
+				if _, err := s.userRepo.SetUserInTraining(ctx, tx, u.ID, true); err != nil {
+					return err
+				}
+			}
+		}
+		if err := s.importSettings(ctx, tx, team.ID, snapshot); err != nil {
+			return err
+		}
+
+		for _, r := range snapshot.ReviewRules {
+			r.TeamID, r.TeamName = &team.ID, team.TeamName
+			if _, err := s.ruleRepo.CreateReviewRule(ctx, tx, &r); err != nil {
+				return err
+			}
+		}
+		for _, pt := range snapshot.PRTemplates {
+			pt.TeamID, pt.TeamName = team.ID, team.TeamName
+			if _, err := s.templateRepo.CreatePRTemplate(ctx, tx, &pt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	s.log.InfoContext(ctx, "team imported",
+		"event", "team.imported",
+		"team_name", t.TeamName,
+		"members", len(t.Members),
+		"review_rules", len(snapshot.ReviewRules),
+		"pr_templates", len(snapshot.PRTemplates),
+	)
+	return s.Export(ctx, t.TeamName)
+}
+
+// importSettings stores the settings of the snapshot on the newly created team.
+func (s *TeamSnapshotService) importSettings(ctx context.Context, tx domain.Tx, teamID int32, snapshot *domain.TeamSnapshot) error {
+	if err := s.importReviewSettings(ctx, tx, teamID, &snapshot.Team); err != nil {
+		return err
+	}
+	if err := s.importPolicies(ctx, tx, teamID, &snapshot.Team); err != nil {
+		return err
+	}
+	if err := s.importReviewers(ctx, tx, teamID, snapshot); err != nil {
+		return err
+	}
+	return s.importSchedules(ctx, tx, teamID, snapshot)
+}
+
+// importReviewSettings stores the reviewer role, optional and shadow
+// reviewers and the review cooldown.
+func (s *TeamSnapshotService) importReviewSettings(ctx context.Context, tx domain.Tx, teamID int32, t *domain.Team) error {
+	if t.RequiredReviewerRole != "" {
+		if _, err := s.teamRepo.SetTeamRequiredReviewerRole(ctx, tx, teamID, t.RequiredReviewerRole); err != nil {
+			return err
+		}
+	}
+	if t.OptionalReviewers > 0 {
+		if _, err := s.teamRepo.SetTeamOptionalReviewers(ctx, tx, teamID, t.OptionalReviewers); err != nil {
+			return err
+		}
+	}
+	if t.ShadowReviewPercent > 0 {
+		if _, err := s.teamRepo.SetTeamShadowReviewPercent(ctx, tx, teamID, t.ShadowReviewPercent); err != nil {
+			return err
+		}
+	}
+	if t.ReviewCooldownPRs > 0 {
+		if _, err := s.teamRepo.SetTeamReviewCooldown(ctx, tx, teamID, t.ReviewCooldownPRs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importPolicies stores the escalation policy, checklist and size rules.
+func (s *TeamSnapshotService) importPolicies(ctx context.Context, tx domain.Tx, teamID int32, t *domain.Team) error {
+	if t.Escalation != (domain.EscalationPolicy{}) {
+		if _, err := s.teamRepo.SetTeamEscalationPolicy(ctx, tx, teamID, t.Escalation); err != nil {
+			return err
+		}
+	}
+	if len(t.Checklist.Items) > 0 || t.Checklist.RequireCompletion {
+		if _, err := s.teamRepo.SetTeamChecklist(ctx, tx, teamID, t.Checklist); err != nil {
+			return err
+		}
+	}
+	if len(t.SizeRules) > 0 {
+		return s.teamRepo.SetTeamSizeRules(ctx, tx, teamID, t.SizeRules)
+	}
+	return nil
+}
+
+// importReviewers stores the reviewer preferences, rotation and pool and the
+// opt-out of inactive reassignment.
+func (s *TeamSnapshotService) importReviewers(ctx context.Context, tx domain.Tx, teamID int32, snapshot *domain.TeamSnapshot) error {
+	if len(snapshot.ReviewerPreferences) > 0 {
+		if _, err := s.teamRepo.SetReviewerPreferences(ctx, tx, teamID, snapshot.ReviewerPreferences); err != nil {
+			return err
+		}
+	}
+	if r := snapshot.ReviewRotation; r != nil {
+		rotation := &domain.ReviewRotation{TeamID: teamID, StartDate: domain.WeekStart(r.StartDate), MemberIDs: r.MemberIDs}
+		if err := s.teamRepo.SetReviewRotation(ctx, tx, rotation); err != nil {
+			return err
+		}
+	}
+	if len(snapshot.ReviewerPool) > 0 {
+		if err := s.teamRepo.SetReviewerPool(ctx, tx, teamID, snapshot.ReviewerPool); err != nil {
+			return err
+		}
+	}
+	if snapshot.InactiveReassignOptOut {
+		return s.teamRepo.SetInactiveReassign(ctx, tx, teamID, false)
+	}
+	return nil
+}
+
+// importSchedules stores the review budget, report schedule and PR quota.
+func (s *TeamSnapshotService) importSchedules(ctx context.Context, tx domain.Tx, teamID int32, snapshot *domain.TeamSnapshot) error {
+	if b := snapshot.ReviewBudget; b != nil {
+		if _, err := s.teamRepo.SetReviewBudget(ctx, tx, teamID, b.ReviewsPerSprint, b.SprintDays); err != nil {
+			return err
+		}
+	}
+	if rs := snapshot.ReportSchedule; rs != nil {
+		schedule := &domain.TeamReportSchedule{TeamID: teamID, Weekday: rs.Weekday, Hour: rs.Hour, Timezone: rs.Timezone}
+		if _, err := s.teamRepo.SetReportSchedule(ctx, tx, schedule); err != nil {
+			return err
+		}
+	}
+	if q := snapshot.PRQuota; q != nil {
+		if _, err := s.teamRepo.SetPRQuota(ctx, tx, &domain.PRQuota{TeamID: teamID, MaxOpenPRs: q.MaxOpenPRs, Mode: q.Mode}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSnapshot applies the checks of the endpoints that set each part of
+// the snapshot, with the members of the snapshot standing in for the team's.
+// Defaults are filled in along the way.
+func (s *TeamSnapshotService) validateSnapshot(ctx context.Context, snapshot *domain.TeamSnapshot) error {
+	t := &snapshot.Team
+	if t.TeamName == "" {
+		return fmt.Errorf("%w: team name is required", domain.ErrValidation)
+	}
+	memberIDs, err := validateSnapshotMembers(t)
+	if err != nil {
+		return err
+	}
+	if err := validateSnapshotSettings(t); err != nil {
+		return err
+	}
+	if err := validateSnapshotPreferences(snapshot.ReviewerPreferences, memberIDs, t.TeamName); err != nil {
+		return err
+	}
+	if err := s.validateSnapshotRules(ctx, snapshot.ReviewRules, t.TeamName); err != nil {
+		return err
+	}
+	if err := validateSnapshotTemplates(snapshot.PRTemplates, memberIDs, t.TeamName); err != nil {
+		return err
+	}
+	if err := validateSnapshotSchedules(snapshot); err != nil {
+		return err
+	}
+	if r := snapshot.ReviewRotation; r != nil {
+		if len(r.MemberIDs) == 0 {
+			return fmt.Errorf("%w: member_ids of the review rotation must not be empty", domain.ErrValidation)
+		}
+		if err := validateRotationMembers(r.MemberIDs, memberIDs, t.TeamName); err != nil {
+			return err
+		}
+	}
+	return validateReviewerPool(snapshot.ReviewerPool, memberIDs, t.TeamName)
+}
+
+// validateSnapshotMembers checks the members and returns their IDs.
+func validateSnapshotMembers(t *domain.Team) (map[string]struct{}, error) {
+	memberIDs := make(map[string]struct{}, len(t.Members))
+	for _, u := range t.Members {
+		if u.ID == "" || u.Username == "" {
+			return nil, fmt.Errorf("%w: user_id and username are required", domain.ErrValidation)
+		}
+		if _, ok := memberIDs[u.ID]; ok {
+			return nil, fmt.Errorf("%w: duplicate user %s", domain.ErrValidation, u.ID)
+		}
+		memberIDs[u.ID] = struct{}{}
+	}
+	if dup := duplicateUsername(memberUsernames(t.Members)); dup != "" && !t.AllowDuplicateUsernames {
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(dup))
+	}
+	return memberIDs, nil
+}
+
+// validateSnapshotSettings checks the team settings, including the escalation
+// policy, checklist and size rules.
+func validateSnapshotSettings(t *domain.Team) error {
+	if t.OptionalReviewers < 0 || t.OptionalReviewers > maxOptionalReviewers {
+		return fmt.Errorf("%w: optional_reviewers must be between 0 and %d", domain.ErrValidation, maxOptionalReviewers)
+	}
+	if t.ShadowReviewPercent < 0 || t.ShadowReviewPercent > 100 {
+		return fmt.Errorf("%w: shadow_review_percent must be between 0 and 100", domain.ErrValidation)
+	}
+	if err := validateEscalationPolicy(&t.Escalation); err != nil {
+		return err
+	}
+	if t.ReviewCooldownPRs < 0 || t.ReviewCooldownPRs > maxReviewCooldownPRs {
+		return fmt.Errorf("%w: review_cooldown_prs must be between 0 and %d", domain.ErrValidation, maxReviewCooldownPRs)
+	}
+	if err := validateChecklistTemplate(&t.Checklist); err != nil {
+		return err
+	}
+	return validateSizeRules(t.SizeRules)
+}
+
+func validateSnapshotPreferences(prefs []domain.ReviewerPreference, memberIDs map[string]struct{}, teamName string) error {
+	if len(prefs) > maxReviewerPreferences {
+		return fmt.Errorf("%w: at most %d reviewer preferences are allowed", domain.ErrValidation, maxReviewerPreferences)
+	}
+	if err := validateReviewerPreferences(prefs, memberIDs, teamName); err != nil {
+		return err
+	}
+	for _, p := range prefs {
+		if _, ok := memberIDs[p.AuthorID]; !ok {
+			return fmt.Errorf("%w: author '%s' is not a member of team '%s'", domain.ErrValidation, p.AuthorID, teamName)
+		}
+	}
+	return nil
+}
+
+func (s *TeamSnapshotService) validateSnapshotRules(ctx context.Context, rules []domain.ReviewRule, teamName string) error {
+	for i := range rules {
+		r := &rules[i]
+		// The rule routes to the imported team whatever team it named.
+		r.TeamName = teamName
+		if err := validateReviewRule(r); err != nil {
+			return err
+		}
+		if err := checkRuleRepositories(ctx, s.repoRepo, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSnapshotTemplates(templates []domain.PRTemplate, memberIDs map[string]struct{}, teamName string) error {
+	for i := range templates {
+		pt := &templates[i]
+		if err := validatePRTemplate(pt); err != nil {
+			return err
+		}
+		for _, id := range pt.DefaultReviewers {
+			if _, ok := memberIDs[id]; !ok {
+				return fmt.Errorf("%w: default reviewer '%s' is not a member of team '%s'", domain.ErrValidation, id, teamName)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSnapshotSchedules checks the review budget, report schedule and PR
+// quota, filling in their defaults.
+func validateSnapshotSchedules(snapshot *domain.TeamSnapshot) error {
+	if b := snapshot.ReviewBudget; b != nil {
+		if b.SprintDays == 0 {
+			b.SprintDays = defaultSprintDays
+		}
+		if b.ReviewsPerSprint == 0 {
+			return fmt.Errorf("%w: reviews_per_sprint of the review budget must be positive", domain.ErrValidation)
+		}
+		if err := validateReviewBudget(b.ReviewsPerSprint, b.SprintDays); err != nil {
+			return err
+		}
+	}
+	if rs := snapshot.ReportSchedule; rs != nil {
+		if rs.Timezone == "" {
+			rs.Timezone = "UTC"
+		}
+		if err := validateReportSchedule(rs.Weekday, rs.Hour, rs.Timezone); err != nil {
+			return err
+		}
+	}
+	if q := snapshot.PRQuota; q != nil {
+		if q.Mode == "" {
+			q.Mode = domain.PRQuotaWarn
+		}
+		if q.MaxOpenPRs == 0 {
+			return fmt.Errorf("%w: max_open_prs of the PR quota must be positive", domain.ErrValidation)
+		}
+		return validatePRQuota(q.MaxOpenPRs, q.Mode)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// TimeoutUnitOfWork bounds every transaction, retries included, by a
+// timeout that can be changed while the service runs.
+type TimeoutUnitOfWork struct {
+	uow     domain.UnitOfWork
+	timeout atomic.Int64
+}
+
+// NewTimeoutUnitOfWork wraps uow so that a transaction running longer than
+// timeout is rolled back with ErrTimeout. A zero timeout leaves transactions
+// unbounded.
+func NewTimeoutUnitOfWork(uow domain.UnitOfWork, timeout time.Duration) *TimeoutUnitOfWork {
+	u := &TimeoutUnitOfWork{uow: uow}
+	u.SetTimeout(timeout)
+	return u
+}
+
+// SetTimeout changes the timeout of transactions started from now on.
+func (u *TimeoutUnitOfWork) SetTimeout(timeout time.Duration) {
+	u.timeout.Store(int64(timeout))
+}
+
+func (u *TimeoutUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, tx domain.Tx) error) error {
+	timeout := time.Duration(u.timeout.Load())
+	if timeout <= 0 {
+		return u.uow.WithinTx(ctx, fn)
+	}
+	txCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := u.uow.WithinTx(txCtx, fn)
+	// Repositories hide the context error behind ErrInternalError, so the
+	// deadline is checked on the context itself.
+	if err != nil && ctx.Err() == nil && errors.Is(txCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: transaction did not finish within %s", domain.ErrTimeout, timeout)
+	}
+	return err
+}
@@ -5,34 +5,41 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
 )
 
+// maxDeactivationBatch bounds how many users SetUsersInactive takes at once.
+const maxDeactivationBatch = 100
+
 type UserService struct {
 	userRepo domain.UserRepository
 	teamRepo domain.TeamRepository
 	prSvc    *PullRequestService
-	tx       domain.Transactor
-	log      *slog.Logger
+	tx       domain.UnitOfWork
+	// movePolicy applies to moves that do not choose an OpenReviewsPolicy.
+	movePolicy domain.OpenReviewsPolicy
+	log        *slog.Logger
 }
 
 func NewUserService(
 	userRepo domain.UserRepository,
 	teamRepo domain.TeamRepository,
 	prSvc *PullRequestService,
-	tx domain.Transactor,
+	tx domain.UnitOfWork,
+	movePolicy domain.OpenReviewsPolicy,
 	log *slog.Logger,
 ) *UserService {
 	return &UserService{
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		prSvc:    prSvc,
-		tx:       tx,
-		log:      log,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		prSvc:      prSvc,
+		tx:         tx,
+		movePolicy: movePolicy,
+		log:        log,
 	}
 }
 
@@ -45,16 +52,9 @@ func (s *UserService) AddUser(ctx context.Context, username, teamName string, is
 	if err != nil {
 		return nil, err
 	}
-
-	tx, err := s.tx.BeginTx(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	if err := s.checkUsernameFree(ctx, team, username, ""); err != nil {
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
-		}
-	}(s.tx, ctx, tx)
 
 	userToCreate := &domain.User{
 		ID:       uuid.New().String(),
@@ -63,126 +63,449 @@ func (s *UserService) AddUser(ctx context.Context, username, teamName string, is
 		IsActive: isActive,
 	}
 
-	createdUser, err := s.userRepo.CreateUser(ctx, tx, userToCreate)
+	var createdUser *domain.User
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		createdUser, err = s.userRepo.CreateUser(ctx, tx, userToCreate)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.prSvc.invalidateCandidates()
 	createdUser.TeamName = team.TeamName
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
 	return createdUser, nil
 }
 
+// checkUsernameFree fails with ErrUsernameExists if another member of the team
+// already uses username and the team does not allow duplicates. The database
+// enforces the same rule; checking first gives a clearer error.
+func (s *UserService) checkUsernameFree(ctx context.Context, team *domain.Team, username, userID string) error {
+	if team.AllowDuplicateUsernames {
+		return nil
+	}
+	members, err := s.userRepo.GetUsersByTeam(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.Username == username && m.ID != userID {
+			return fmt.Errorf("%w: '%s' in team '%s'", domain.ErrUsernameExists, domain.PII(username), team.TeamName)
+		}
+	}
+	return nil
+}
+
 func (s *UserService) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
 	return s.userRepo.GetUserByID(ctx, userID)
 }
 
+// GetUserByUsername finds a user by username, within teamName if it is set.
+// A username shared by several users is reported as ErrUsernameExists.
+// GetWorkload returns the user's open reviews and authored PRs next to the
+// reviewer cap, for picking a reviewer by hand.
+func (s *UserService) GetWorkload(ctx context.Context, userID string) (*domain.Workload, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.prSvc.settings.effective(ctx, user.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := s.prSvc.workloads(ctx, []domain.User{*user}, settings.MaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+	return &workloads[0], nil
+}
+
+func (s *UserService) GetUserByUsername(ctx context.Context, username, teamName string) (*domain.User, error) {
+	if username == "" {
+		return nil, fmt.Errorf("%w: username is required", domain.ErrValidation)
+	}
+	if teamName != "" {
+		if _, err := s.teamRepo.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	users, err := s.userRepo.GetUsersByUsername(ctx, username, teamName)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(users) == 0:
+		return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, domain.PII(username))
+	case len(users) > 1 && teamName == "":
+		return nil, fmt.Errorf("%w: '%s' belongs to several users, pass team_name", domain.ErrUsernameExists, domain.PII(username))
+	case len(users) > 1:
+		return nil, fmt.Errorf("%w: '%s' belongs to several users of team '%s'", domain.ErrUsernameExists, domain.PII(username), teamName)
+	}
+	return &users[0], nil
+}
+
 func (s *UserService) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	if user.ID == "" {
 		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
 	}
 
-	tx, err := s.tx.BeginTx(ctx)
+	var updatedUser *domain.User
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		var err error
+		updatedUser, err = s.userRepo.UpdateUser(ctx, tx, user)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	s.prSvc.invalidateCandidates()
+
+	return updatedUser, nil
+}
+
+func (s *UserService) SetUserRole(ctx context.Context, userID, role string) (*domain.User, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
+	}
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if _, err := s.userRepo.SetUserRole(ctx, tx, userID, role); err != nil {
+			return err
 		}
-	}(s.tx, ctx, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	return s.userRepo.GetUserByID(ctx, userID)
+}
+
+// SetUserInTraining flags a user as in training or clears the flag. Users in
+// training shadow reviews instead of being picked as reviewers; they can still
+// be assigned by hand.
+func (s *UserService) SetUserInTraining(ctx context.Context, userID string, inTraining bool) (*domain.User, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
+	}
 
-	updatedUser, err := s.userRepo.UpdateUser(ctx, tx, user)
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if _, err := s.userRepo.SetUserInTraining(ctx, tx, userID, inTraining); err != nil {
+			return err
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.prSvc.invalidateCandidates()
+
+	return s.userRepo.GetUserByID(ctx, userID)
+}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+func (s *UserService) SetUserSkills(ctx context.Context, userID string, skills []string) (*domain.User, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", domain.ErrValidation)
+	}
+	for _, skill := range skills {
+		if skill == "" {
+			return nil, fmt.Errorf("%w: skill must not be empty", domain.ErrValidation)
+		}
 	}
 
-	return updatedUser, nil
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if _, err := s.userRepo.SetUserSkills(ctx, tx, userID, skills); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+
+	return s.userRepo.GetUserByID(ctx, userID)
+}
+
+// MoveUserToTeam moves the user to another team. policy, or the service's
+// default when it is empty, decides what happens to the user's reviews of open
+// PRs whose reviewers come from the old team; it returns the user and the
+// reviews handed over to other reviewers.
+func (s *UserService) MoveUserToTeam(ctx context.Context, userID, newTeamName string, policy domain.OpenReviewsPolicy) (*domain.User, []domain.RebalanceMove, error) {
+	if policy == "" {
+		policy = s.movePolicy
+	}
+	if !policy.Valid() {
+		return nil, nil, fmt.Errorf("%w: unknown open reviews policy %q", domain.ErrValidation, policy)
+	}
+	user, newTeam, err := s.moveTarget(ctx, userID, newTeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedUser *domain.User
+	var moved []domain.RebalanceMove
+	var missed noCandidates
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		moved, missed = nil, nil
+		var err error
+		if newTeam.ID != user.TeamID && policy != domain.OpenReviewsKeep {
+			// Reviews are handed over before the move, so that the reassignment
+			// log attributes them to the old team.
+			if moved, err = s.handOverReviews(ctx, tx, user, policy, &missed); err != nil {
+				return err
+			}
+		}
+		updatedUser, err = s.userRepo.MoveUserToTeam(ctx, tx, userID, newTeam.ID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s.prSvc.invalidateCandidates()
+	s.prSvc.reportNoCandidates(ctx, missed)
+	s.announceHandOver(ctx, user, newTeam, moved)
+
+	updatedUser.TeamName = newTeam.TeamName
+	return updatedUser, moved, nil
 }
 
-func (s *UserService) MoveUserToTeam(ctx context.Context, userID, newTeamName string) (*domain.User, error) {
+// moveTarget returns the user to move and the team to move them to, checking
+// that both are active and the username is free in the new team.
+func (s *UserService) moveTarget(ctx context.Context, userID, newTeamName string) (*domain.User, *domain.Team, error) {
 	user, err := s.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !user.CanBeMoved() {
-		return nil, fmt.Errorf("%w: user is not active", domain.ErrValidation)
+		return nil, nil, fmt.Errorf("%w: user is not active", domain.ErrValidation)
 	}
 
 	newTeam, err := s.teamRepo.GetTeamByName(ctx, newTeamName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !newTeam.CanBeMoved() {
-		return nil, fmt.Errorf("%w: new team is not active", domain.ErrValidation)
+		return nil, nil, fmt.Errorf("%w: new team is not active", domain.ErrValidation)
 	}
-
-	tx, err := s.tx.BeginTx(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	if newTeam.ID != user.TeamID {
+		if err := s.checkUsernameFree(ctx, newTeam, user.Username, user.ID); err != nil {
+			return nil, nil, err
 		}
-	}(s.tx, ctx, tx)
+	}
+	return user, newTeam, nil
+}
 
-	updatedUser, err := s.userRepo.MoveUserToTeam(ctx, tx, userID, newTeam.ID)
+// handOverReviews hands the user's reviews of open PRs of their current team
+// over to other reviewers as policy says, returning the reviews moved.
+func (s *UserService) handOverReviews(ctx context.Context, tx domain.Tx, user *domain.User, policy domain.OpenReviewsPolicy, missed *noCandidates) ([]domain.RebalanceMove, error) {
+	reviews, err := s.prSvc.teamReviews(ctx, tx, user.ID, user.TeamID)
 	if err != nil {
 		return nil, err
 	}
-
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	if policy == domain.OpenReviewsAsk && len(reviews) > 0 {
+		return nil, fmt.Errorf("%w: user %s reviews %d open PR(s) of team %s; move them with open_reviews keep or reassign",
+			domain.ErrOpenReviews, user.ID, len(reviews), user.TeamName)
 	}
+	var moved []domain.RebalanceMove
+	for _, r := range reviews {
+		newReviewerID, err := s.prSvc.replaceReviewerInTx(ctx, tx, r, user.ID, domain.ReassignmentTeamMove, missed)
+		if err != nil {
+			return nil, err
+		}
+		if newReviewerID != "" {
+			moved = append(moved, domain.RebalanceMove{PRID: r.pr.ID, FromUserID: user.ID, ToUserID: newReviewerID})
+		}
+	}
+	return moved, nil
+}
 
-	updatedUser.TeamName = newTeam.TeamName
-	return updatedUser, nil
+// announceHandOver logs the reviews handed over on a team move and notifies
+// their new reviewers.
+func (s *UserService) announceHandOver(ctx context.Context, user *domain.User, newTeam *domain.Team, moved []domain.RebalanceMove) {
+	if len(moved) > 0 {
+		s.log.InfoContext(ctx, "reviews handed off after team move",
+			"event", "user.team_move_reviews_handed_off",
+			"user_id", user.ID,
+			"old_team_name", user.TeamName,
+			"new_team_name", newTeam.TeamName,
+			"moved_count", len(moved),
+		)
+	}
+	for _, m := range moved {
+		s.prSvc.notifyReviewersChanged(ctx, m.PRID, []string{m.ToUserID})
+		s.prSvc.publishPRChange(ctx, m.PRID, domain.PRReviewersChanged)
+	}
 }
 
 func (s *UserService) SetUserActiveStatus(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
-	tx, err := s.tx.BeginTx(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func(tx2 domain.Transactor, ctx context.Context, tx pgx.Tx) {
-		if err := tx2.RollbackTx(ctx, tx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-			s.log.Error("failed to rollback transaction", "error", err)
+	var user *domain.User
+	var missed noCandidates
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		missed = nil
+		var err error
+		user, err = s.userRepo.SetUserActiveStatus(ctx, tx, userID, isActive)
+		if err != nil {
+			return fmt.Errorf("%w: failed while trying to set active status %s", domain.ErrValidation, err)
+		}
+
+		prs, err := s.prSvc.GetReviewsForUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("%w: failed while trying to get pull requests from user %s", domain.ErrInternalError, err)
 		}
-	}(s.tx, ctx, tx)
 
-	user, err := s.userRepo.SetUserActiveStatus(ctx, tx, userID, isActive)
+		if !isActive {
+			for _, pr := range prs {
+				if _, err := s.prSvc.reassignReviewerInTx(ctx, tx, &pr, userID, domain.ReassignmentDeactivation, "", &missed); err != nil {
+					if errors.Is(err, domain.ErrNoCandidate) {
+						continue // not finding candidates should not be an issue for deactivating
+					}
+					return fmt.Errorf("%w: failed to reassign pull request %s: %v", domain.ErrValidation, pr.ID, err)
+				}
+			}
+
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed while trying to set active status %s", domain.ErrValidation, err)
+		return nil, err
 	}
+	s.prSvc.invalidateCandidates()
+	s.prSvc.reportNoCandidates(ctx, missed)
 
-	prs, err := s.prSvc.GetReviewsForUser(ctx, userID)
+	return user, nil
+}
+
+// SetUsersInactive deactivates the users in one transaction and reassigns
+// their open reviews, never to another user of the batch. Unknown IDs are
+// reported as not found without failing the rest; any other error rolls the
+// whole batch back.
+func (s *UserService) SetUsersInactive(ctx context.Context, userIDs []string) ([]domain.UserDeactivation, error) {
+	userIDs, err := deactivationBatch(userIDs)
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed while trying to get pull requests from user %s", domain.ErrInternalError, err)
+		return nil, err
 	}
 
-	if !isActive {
-		for _, pr := range prs {
-			if _, err := s.prSvc.reassignReviewerInTx(ctx, tx, &pr, userID); err != nil {
-				if errors.Is(err, domain.ErrNoCandidate) {
-					continue // not finding candidates should not be an issue for deactivating
-				}
-				return nil, fmt.Errorf("%w: failed to reassign pull request %s: %v", domain.ErrValidation, pr.ID, err)
+	var results []domain.UserDeactivation
+	var missed noCandidates
+	err = s.tx.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		missed = nil
+		var found []string
+		var err error
+		if results, found, err = s.deactivateUsers(ctx, tx, userIDs); err != nil {
+			return err
+		}
+		for i := range results {
+			if results[i].Status == domain.DeactivationNotFound {
+				continue
+			}
+			if err := s.reassignDeactivated(ctx, tx, &results[i], found, &missed); err != nil {
+				return err
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.prSvc.invalidateCandidates()
+	s.prSvc.reportNoCandidates(ctx, missed)
+	s.announceDeactivations(ctx, results)
+	return results, nil
+}
+
+// deactivationBatch checks a batch of user IDs to deactivate and returns it
+// without duplicates.
+func deactivationBatch(userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("%w: user_ids must not be empty", domain.ErrValidation)
+	}
+	if len(userIDs) > maxDeactivationBatch {
+		return nil, fmt.Errorf("%w: at most %d users can be deactivated at once", domain.ErrValidation, maxDeactivationBatch)
+	}
+	if slices.Contains(userIDs, "") {
+		return nil, fmt.Errorf("%w: user_ids cannot be empty", domain.ErrValidation)
+	}
+	seen := make(map[string]bool, len(userIDs))
+	return slices.DeleteFunc(slices.Clone(userIDs), func(id string) bool {
+		dup := seen[id]
+		seen[id] = true
+		return dup
+	}), nil
+}
 
+// deactivateUsers marks the users inactive, returning a result per user ID
+// and the IDs of the users that exist.
+func (s *UserService) deactivateUsers(ctx context.Context, tx domain.Tx, userIDs []string) ([]domain.UserDeactivation, []string, error) {
+	results := make([]domain.UserDeactivation, len(userIDs))
+	var found []string
+	for i, userID := range userIDs {
+		results[i].UserID = userID
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if errors.Is(err, domain.ErrNotFound) {
+			results[i].Status = domain.DeactivationNotFound
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		results[i].Status = domain.DeactivationDone
+		if !user.IsActive {
+			results[i].Status = domain.DeactivationAlreadyInactive
+		}
+		if _, err := s.userRepo.SetUserActiveStatus(ctx, tx, userID, false); err != nil {
+			return nil, nil, err
+		}
+		found = append(found, userID)
 	}
+	return results, found, nil
+}
 
-	if err := s.tx.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+// reassignDeactivated reassigns the open reviews of a deactivated user, none
+// of them to a user of batch, recording the outcome in result.
+func (s *UserService) reassignDeactivated(ctx context.Context, tx domain.Tx, result *domain.UserDeactivation, batch []string, missed *noCandidates) error {
+	userID := result.UserID
+	prs, err := s.prSvc.GetReviewsForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get reviews of user %s: %w", userID, err)
 	}
+	for _, pr := range prs {
+		if !pr.IsOpen() {
+			continue
+		}
+		to, err := s.prSvc.reassignReviewerInTx(ctx, tx, &pr, userID, domain.ReassignmentDeactivation, "", missed, batch...)
+		if errors.Is(err, domain.ErrNoCandidate) {
+			result.Unassigned = append(result.Unassigned, pr.ID)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to reassign pull request %s: %w", pr.ID, err)
+		}
+		result.Reassigned = append(result.Reassigned, domain.RebalanceMove{PRID: pr.ID, FromUserID: userID, ToUserID: to})
+	}
+	return nil
+}
 
-	return user, nil
+// announceDeactivations logs the users deactivated and notifies the new
+// reviewers of their reviews.
+func (s *UserService) announceDeactivations(ctx context.Context, results []domain.UserDeactivation) {
+	for _, r := range results {
+		if r.Status == domain.DeactivationNotFound {
+			continue
+		}
+		s.log.InfoContext(ctx, "user deactivated",
+			"event", "user.deactivated",
+			"user_id", r.UserID,
+			"status", r.Status,
+			"reassigned_count", len(r.Reassigned),
+			"unassigned_count", len(r.Unassigned),
+		)
+		for _, m := range r.Reassigned {
+			s.prSvc.notifyReviewersChanged(ctx, m.PRID, []string{m.ToUserID})
+			s.prSvc.publishPRChange(ctx, m.PRID, domain.PRReviewersChanged)
+		}
+	}
 }
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// WebhookService exposes the webhook delivery log and redelivers failed attempts.
+type WebhookService struct {
+	deliveries domain.WebhookDeliveryRepository
+	sender     domain.WebhookSender
+	log        *slog.Logger
+}
+
+func NewWebhookService(deliveries domain.WebhookDeliveryRepository, sender domain.WebhookSender, log *slog.Logger) *WebhookService {
+	return &WebhookService{deliveries: deliveries, sender: sender, log: log}
+}
+
+// ListDeliveries returns delivery attempts, newest first.
+func (s *WebhookService) ListDeliveries(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, error) {
+	switch filter.Status {
+	case "", "failed", "succeeded":
+	default:
+		return nil, fmt.Errorf("%w: unknown delivery status %q", domain.ErrValidation, filter.Status)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrValidation, maxSearchLimit)
+	}
+	if filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", domain.ErrValidation)
+	}
+	return s.deliveries.ListWebhookDeliveries(ctx, filter)
+}
+
+// Redeliver posts the payload of a failed delivery again and returns the new
+// attempt.
+func (s *WebhookService) Redeliver(ctx context.Context, id int64) (*domain.WebhookDelivery, error) {
+	prev, err := s.deliveries.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !prev.Failed() {
+		return nil, fmt.Errorf("%w: delivery %d did not fail", domain.ErrValidation, id)
+	}
+
+	d, err := s.sender.Redeliver(ctx, prev)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "webhook redelivered",
+		"event", "webhook.redelivered",
+		"delivery_id", id,
+		"redelivery_id", d.ID,
+		"status_code", d.StatusCode,
+		"failed", d.Failed(),
+	)
+	return d, nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor returns a context telling who performs the actions done with it.
+func WithActor(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actorID)
+}
+
+// ActorFromContext returns the actor set by WithActor, or "" when the action
+// was not attributed to anyone.
+func ActorFromContext(ctx context.Context) string {
+	actorID, _ := ctx.Value(actorKey{}).(string)
+	return actorID
+}
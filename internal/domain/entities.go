@@ -2,71 +2,1794 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 )
 
 var (
-	ErrInternalError = errors.New("internal Error")
-	ErrNoCandidate   = errors.New("no suitable candidate found for assignment")
-	ErrNotAssigned   = errors.New("user is not assigned to this PR")
-	ErrNotFound      = errors.New("resource not found")
-	ErrPRExists      = errors.New("PR already exists")
-	ErrPRMerged      = errors.New("operation not allowed on merged PR")
-	ErrTeamExists    = errors.New("team already exists")
-	ErrValidation    = errors.New("validation failed")
-	ErrUserNotActive = errors.New("user is not active")
+	ErrInternalError  = errors.New("internal Error")
+	ErrNoCandidate    = errors.New("no suitable candidate found for assignment")
+	ErrNotAssigned    = errors.New("user is not assigned to this PR")
+	ErrNotFound       = errors.New("resource not found")
+	ErrPRExists       = errors.New("PR already exists")
+	ErrPRMerged       = errors.New("operation not allowed on merged PR")
+	ErrPRClosed       = errors.New("operation not allowed on closed PR")
+	ErrTeamExists     = errors.New("team already exists")
+	ErrUsernameExists = errors.New("username already exists in team")
+	ErrRepoExists     = errors.New("repository already exists")
+	ErrRuleExists     = errors.New("review rule already exists")
+	ErrFilterExists   = errors.New("saved filter already exists")
+	ErrTemplateExists = errors.New("PR template already exists")
+	ErrValidation     = errors.New("validation failed")
+	ErrUserNotActive  = errors.New("user is not active")
+	ErrUnauthorized   = errors.New("unauthorized")
+	// ErrTxConflict means a transaction kept conflicting with concurrent ones;
+	// the request may succeed when repeated.
+	ErrTxConflict = errors.New("concurrent update conflict")
+	// ErrTimeout means an operation did not finish within its deadline.
+	ErrTimeout = errors.New("operation timed out")
+
+	ErrReviewRequirementsNotMet = errors.New("review requirements not met")
+	// ErrInvalidTransition means the PR's status does not allow the requested
+	// change of status.
+	ErrInvalidTransition = errors.New("invalid PR status transition")
+	// ErrOpenReviews means the caller has to decide what happens to a user's
+	// open reviews before the operation can go ahead.
+	ErrOpenReviews = errors.New("user has open reviews")
+	// ErrTooManyOpenPRs means the author reached the open PR quota of their
+	// team, which blocks new PRs.
+	ErrTooManyOpenPRs = errors.New("too many open PRs")
 )
 
+// PRStatus is where a PR is in its lifecycle: DRAFT -> OPEN -> IN_REVIEW ->
+// APPROVED -> MERGED or CLOSED. Between OPEN, IN_REVIEW and APPROVED a PR
+// moves with its reviewers' verdicts; the other transitions are explicit.
 type PRStatus string
 
 const (
-	StatusOpen   PRStatus = "OPEN"
-	StatusMerged PRStatus = "MERGED"
+	// StatusDraft: the author is not ready for review; no reviewers are
+	// assigned automatically.
+	StatusDraft PRStatus = "DRAFT"
+	// StatusOpen: ready for review, without required reviewers.
+	StatusOpen PRStatus = "OPEN"
+	// StatusInReview: some required reviewer has not approved yet.
+	StatusInReview PRStatus = "IN_REVIEW"
+	// StatusApproved: every required reviewer approved.
+	StatusApproved PRStatus = "APPROVED"
+	StatusMerged   PRStatus = "MERGED"
+	// StatusClosed: closed without merging.
+	StatusClosed PRStatus = "CLOSED"
+)
+
+func (s PRStatus) Valid() bool {
+	switch s {
+	case StatusDraft, StatusOpen, StatusInReview, StatusApproved, StatusMerged, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// prTransitions are the explicit status changes allowed from each status.
+var prTransitions = map[PRStatus][]PRStatus{
+	StatusDraft:    {StatusOpen, StatusClosed},
+	StatusOpen:     {StatusMerged, StatusClosed},
+	StatusInReview: {StatusMerged, StatusClosed},
+	StatusApproved: {StatusMerged, StatusClosed},
+}
+
+// CanTransitionTo reports whether a PR in status s may be explicitly moved
+// to next.
+func (s PRStatus) CanTransitionTo(next PRStatus) bool {
+	return slices.Contains(prTransitions[s], next)
+}
+
+type PRPriority string
+
+const (
+	PriorityLow    PRPriority = "LOW"
+	PriorityNormal PRPriority = "NORMAL"
+	PriorityUrgent PRPriority = "URGENT"
 )
 
+func (p PRPriority) Valid() bool {
+	return p == PriorityLow || p == PriorityNormal || p == PriorityUrgent
+}
+
+// EscalationDelay scales a team escalation delay: urgent PRs escalate four
+// times sooner and low priority ones twice later.
+func (p PRPriority) EscalationDelay(hours int) time.Duration {
+	d := time.Duration(hours) * time.Hour
+	switch p {
+	case PriorityUrgent:
+		return d / 4
+	case PriorityLow:
+		return d * 2
+	}
+	return d
+}
+
 type User struct {
 	ID       string
 	Username string
 	TeamID   int32
 	TeamName string
 	IsActive bool
+	// Role is a free-form reviewer role such as "senior"; empty means no role.
+	Role string
+	// Skills are expertise tags (e.g. "go", "frontend", "db") used to route reviews.
+	Skills []string
+	// InTraining users are not picked as reviewers automatically; they shadow
+	// the reviews of a share of their team's PRs instead.
+	InTraining bool
 }
 
 func (u *User) CanBeMoved() bool {
 	return u.IsActive
 }
 
+// UserPatch lists the user attributes an identity provider changes; nil
+// fields are left as they are.
+type UserPatch struct {
+	Username *string
+	TeamName *string
+	IsActive *bool
+}
+
+// OpenReviewsPolicy says what happens to the reviews a user has on open PRs of
+// their old team when they move to another team.
+type OpenReviewsPolicy string
+
+const (
+	// OpenReviewsKeep leaves the user on the PRs.
+	OpenReviewsKeep OpenReviewsPolicy = "keep"
+	// OpenReviewsReassign hands the reviews over to members of the old team;
+	// reviews nobody can take over are kept.
+	OpenReviewsReassign OpenReviewsPolicy = "reassign"
+	// OpenReviewsAsk refuses to move a user with such reviews, so that the
+	// caller picks keep or reassign.
+	OpenReviewsAsk OpenReviewsPolicy = "ask"
+)
+
+func (p OpenReviewsPolicy) Valid() bool {
+	return p == OpenReviewsKeep || p == OpenReviewsReassign || p == OpenReviewsAsk
+}
+
+// Workload is what a user has on their plate right now. Capacity is the cap on
+// open reviews a user is picked for automatically, 0 when there is none;
+// urgent PRs ignore it.
+type Workload struct {
+	User         User
+	OpenReviews  int
+	OpenAuthored int
+	Capacity     int
+}
+
+// Away reports whether the user is out of reviewer selection. The service has
+// no absence state of its own, deactivation is how a user is marked away.
+func (w *Workload) Away() bool {
+	return !w.User.IsActive
+}
+
+// CanTakeReview reports whether the user would be picked for another regular
+// PR as far as their load is concerned.
+func (w *Workload) CanTakeReview() bool {
+	return !w.Away() && (w.Capacity == 0 || w.OpenReviews < w.Capacity)
+}
+
+// ReviewerSuggestion is a candidate for a PR's reviewer. LoadScore falls from 1
+// for a user without open reviews towards 0 at the reviewer cap, or as open
+// reviews grow when there is no cap; SkillMatchScore is the share of the PR's
+// skills the user has, 1 when the PR needs none.
+type ReviewerSuggestion struct {
+	User             User
+	OpenReviews      int
+	LoadScore        float64
+	MatchedSkills    []string
+	SkillMatchScore  float64
+	PreferenceWeight int
+	// OnRotation is set for the primary reviewer of the week.
+	OnRotation   bool
+	InCooldown   bool
+	OverCapacity bool
+}
+
+// TeamCapacity is the workload of every member of a team, the members who can
+// take another review first and the least loaded of them first.
+type TeamCapacity struct {
+	TeamName string
+	Capacity int
+	Members  []Workload
+}
+
 type Team struct {
 	ID       int32
 	TeamName string
 	IsActive bool
 	Members  []User
+	// ParentTeamID is nil for top-level teams.
+	ParentTeamID *int32
+	// EscalateToParent allows reviewer selection to fall back to the parent team
+	// when the team itself has no available candidates.
+	EscalateToParent bool
+	// RequiredReviewerRole, if set, requires every PR authored in the team to have
+	// at least one reviewer with this role before it can be merged.
+	RequiredReviewerRole string
+	// OptionalReviewers is the number of optional reviewers assigned to new
+	// PRs on top of the required ones.
+	OptionalReviewers int
+	// ShadowReviewPercent is the share of new PRs, in percent, that get one of
+	// the members in training as a shadow reviewer.
+	ShadowReviewPercent int
+	Escalation          EscalationPolicy
+	// ReviewCooldownPRs makes reviewers of an author's last N PRs the last pick
+	// for the author's next PR; 0 disables the cooldown.
+	ReviewCooldownPRs int
+	Checklist         ChecklistTemplate
+	// SizeRules lower the number of reviewers of small PRs; see MatchSizeRule.
+	SizeRules []SizeRule
+	// DeactivateAt is when a planned deactivation takes effect, nil if none is scheduled.
+	DeactivateAt *time.Time
+	// AllowDuplicateUsernames lifts the per-team username uniqueness, for teams
+	// created before it was enforced.
+	AllowDuplicateUsernames bool
+	// AllowSelfReview lets authors of PRs reviewed by the team be assigned to
+	// their own PRs: manually, or automatically when nobody else is available.
+	AllowSelfReview bool
+}
+
+// EscalationPolicy says what happens to the team's PRs that get no reviewer
+// activity (no approval) for a while. Zero hours disable a step.
+type EscalationPolicy struct {
+	LeadUserID            string
+	NotifyLeadAfterHours  int
+	AddReviewerAfterHours int
+}
+
+func (p EscalationPolicy) Enabled() bool {
+	return p.NotifyLeadAfterHours > 0 || p.AddReviewerAfterHours > 0
+}
+
+// ChecklistTemplate lists the items copied to every new PR whose reviewers
+// come from the team.
+type ChecklistTemplate struct {
+	Items []string
+	// RequireCompletion blocks merging until every item of the PR is ticked.
+	RequireCompletion bool
+}
+
+// ReviewBudget caps the reviews each member of a team is assigned per sprint.
+// A new sprint starts every SprintDays days and resets what members used.
+type ReviewBudget struct {
+	TeamID           int32
+	ReviewsPerSprint int
+	SprintDays       int
+	SprintStartedAt  time.Time
+	// AlertedAt is when the team lead was told the sprint's budget runs out, nil if not yet.
+	AlertedAt *time.Time
+}
+
+// SprintLength returns how long a sprint of the budget lasts.
+func (b *ReviewBudget) SprintLength() time.Duration {
+	return time.Duration(b.SprintDays) * 24 * time.Hour
+}
+
+// SprintEndsAt returns when the current sprint ends.
+func (b *ReviewBudget) SprintEndsAt() time.Time {
+	return b.SprintStartedAt.Add(b.SprintLength())
+}
+
+// CurrentSprintStart returns the start of the sprint now falls in, keeping the
+// cadence of the stored sprint when several were missed.
+func (b *ReviewBudget) CurrentSprintStart(now time.Time) time.Time {
+	if now.Before(b.SprintEndsAt()) {
+		return b.SprintStartedAt
+	}
+	missed := now.Sub(b.SprintStartedAt) / b.SprintLength()
+	return b.SprintStartedAt.Add(missed * b.SprintLength())
+}
+
+// ReviewBudgetUsage is how much of the sprint's budget a team member used.
+type ReviewBudgetUsage struct {
+	User User
+	Used int
+}
+
+// TeamReviewBudget is a team's budget with the usage of every member, the
+// members with most of their budget left first. Budget is nil when the team
+// has none.
+type TeamReviewBudget struct {
+	TeamName string
+	Budget   *ReviewBudget
+	Members  []ReviewBudgetUsage
+}
+
+// Used returns the reviews used by the active members, counting nobody
+// beyond their own budget.
+func (t *TeamReviewBudget) Used() int {
+	if t.Budget == nil {
+		return 0
+	}
+	used := 0
+	for _, m := range t.Members {
+		if m.User.IsActive {
+			used += min(m.Used, t.Budget.ReviewsPerSprint)
+		}
+	}
+	return used
+}
+
+// Total returns the combined budget of the active members.
+func (t *TeamReviewBudget) Total() int {
+	if t.Budget == nil {
+		return 0
+	}
+	total := 0
+	for _, m := range t.Members {
+		if m.User.IsActive {
+			total += t.Budget.ReviewsPerSprint
+		}
+	}
+	return total
+}
+
+// PRQuotaMode is what happens to a PR created over its author's quota.
+type PRQuotaMode string
+
+const (
+	// PRQuotaWarn creates the PR and flags it as over quota.
+	PRQuotaWarn PRQuotaMode = "warn"
+	// PRQuotaBlock refuses to create the PR.
+	PRQuotaBlock PRQuotaMode = "block"
+)
+
+func (m PRQuotaMode) Valid() bool {
+	return m == PRQuotaWarn || m == PRQuotaBlock
+}
+
+// PRQuota caps how many PRs each member of a team may have open at once,
+// drafts included.
+type PRQuota struct {
+	TeamID     int32
+	MaxOpenPRs int
+	Mode       PRQuotaMode
+}
+
+// SelectionStrategy says how reviewer selection picks between candidates
+// that rank the same.
+type SelectionStrategy string
+
+const (
+	// SelectionRandom picks at random.
+	SelectionRandom SelectionStrategy = "random"
+	// SelectionLeastLoaded picks the candidates with the fewest open reviews
+	// first, at random among equals.
+	SelectionLeastLoaded SelectionStrategy = "least_loaded"
+	// SelectionWeightedRandom picks at random, making candidates with fewer
+	// open reviews more likely: the weight of a candidate is 1/(1+open).
+	SelectionWeightedRandom SelectionStrategy = "weighted_random"
+)
+
+func (s SelectionStrategy) Valid() bool {
+	return s == SelectionRandom || s == SelectionLeastLoaded || s == SelectionWeightedRandom
+}
+
+// Settings are the org-wide defaults of reviewer selection and reviews. A
+// team's TeamSettings are layered on top of them.
+type Settings struct {
+	// MaxReviewers is the number of required reviewers of a PR unless its
+	// repository or a review rule asks for another.
+	MaxReviewers      int
+	SelectionStrategy SelectionStrategy
+	// ReviewSLAHours is how long a review may wait before digests report it
+	// as overdue.
+	ReviewSLAHours int
+	// MaxOpenReviews caps the open reviews a user is picked for; 0 means no
+	// cap. Urgent PRs ignore it.
+	MaxOpenReviews int
+	// AutoMerge allows PRs to be merged automatically once approved.
+	AutoMerge bool
+	// ShadowReviews allows teams to assign shadow reviewers.
+	ShadowReviews bool
+	// UpdatedAt is zero while the built-in defaults apply.
+	UpdatedAt time.Time
+}
+
+// ReviewSLA returns ReviewSLAHours as a duration.
+func (s Settings) ReviewSLA() time.Duration {
+	return time.Duration(s.ReviewSLAHours) * time.Hour
+}
+
+// TeamSettings overrides the org-wide settings for a team; nil fields
+// inherit them.
+type TeamSettings struct {
+	TeamID            int32
+	TeamName          string
+	MaxReviewers      *int
+	SelectionStrategy *SelectionStrategy
+	ReviewSLAHours    *int
+	MaxOpenReviews    *int
+	AutoMerge         *bool
+	ShadowReviews     *bool
+	UpdatedAt         time.Time
+}
+
+// Empty reports whether the team overrides nothing.
+func (t *TeamSettings) Empty() bool {
+	return t.MaxReviewers == nil && t.SelectionStrategy == nil && t.ReviewSLAHours == nil &&
+		t.MaxOpenReviews == nil && t.AutoMerge == nil && t.ShadowReviews == nil
+}
+
+// Apply returns s with the team's overrides layered on top.
+func (t *TeamSettings) Apply(s Settings) Settings {
+	if t.MaxReviewers != nil {
+		s.MaxReviewers = *t.MaxReviewers
+	}
+	if t.SelectionStrategy != nil {
+		s.SelectionStrategy = *t.SelectionStrategy
+	}
+	if t.ReviewSLAHours != nil {
+		s.ReviewSLAHours = *t.ReviewSLAHours
+	}
+	if t.MaxOpenReviews != nil {
+		s.MaxOpenReviews = *t.MaxOpenReviews
+	}
+	if t.AutoMerge != nil {
+		s.AutoMerge = *t.AutoMerge
+	}
+	if t.ShadowReviews != nil {
+		s.ShadowReviews = *t.ShadowReviews
+	}
+	return s
+}
+
+// OrgSettings are the org-wide settings with the overrides of every team that
+// has some.
+type OrgSettings struct {
+	Defaults Settings
+	Teams    []TeamSettings
+}
+
+// TeamReportSchedule sends the team lead a weekly report every Weekday at
+// Hour:00 in Timezone.
+type TeamReportSchedule struct {
+	TeamID    int32
+	Weekday   time.Weekday
+	Hour      int
+	Timezone  string
+	CreatedAt time.Time
+	// LastSentAt is the slot of the last report sent, nil if none was.
+	LastSentAt *time.Time
+}
+
+// LastSlot returns the latest time a report was due at or before now, with
+// loc being the schedule's Timezone.
+func (s *TeamReportSchedule) LastSlot(now time.Time, loc *time.Location) time.Time {
+	local := now.In(loc)
+	daysBack := (int(local.Weekday()) - int(s.Weekday) + 7) % 7
+	slot := time.Date(local.Year(), local.Month(), local.Day()-daysBack, s.Hour, 0, 0, 0, loc)
+	if slot.After(now) {
+		slot = slot.AddDate(0, 0, -7)
+	}
+	return slot
+}
+
+// ReviewRotation is a team's weekly primary-reviewer rotation, on-call style.
+// Weeks start on Monday in UTC; the week starting StartDate belongs to
+// MemberIDs[0], the next one to MemberIDs[1] and so on, wrapping around. The
+// primary reviewer of the current week is picked before other candidates.
+type ReviewRotation struct {
+	TeamID    int32
+	StartDate time.Time
+	MemberIDs []string
+	// Overrides replace the primary reviewer of single weeks, earliest first.
+	Overrides []RotationOverride
+}
+
+type RotationOverride struct {
+	WeekStart time.Time
+	UserID    string
+}
+
+// RotationWeek is the primary reviewer of one week of a rotation. UserID is
+// empty for weeks before the rotation starts.
+type RotationWeek struct {
+	WeekStart  time.Time
+	UserID     string
+	Username   string
+	Overridden bool
+}
+
+// RotationSchedule is a team's rotation with the primary reviewers of the
+// upcoming weeks, the current one first. Rotation is nil when the team has
+// none.
+type RotationSchedule struct {
+	TeamName string
+	Rotation *ReviewRotation
+	Weeks    []RotationWeek
+}
+
+// WeekStart returns the Monday, at midnight UTC, of the week t falls in.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysBack := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysBack, 0, 0, 0, 0, time.UTC)
+}
+
+// Week returns the primary reviewer of the week starting weekStart.
+func (r *ReviewRotation) Week(weekStart time.Time) RotationWeek {
+	week := RotationWeek{WeekStart: weekStart}
+	for _, o := range r.Overrides {
+		if o.WeekStart.Equal(weekStart) {
+			week.UserID, week.Overridden = o.UserID, true
+			return week
+		}
+	}
+	if len(r.MemberIDs) == 0 || weekStart.Before(r.StartDate) {
+		return week
+	}
+	weeks := int(weekStart.Sub(r.StartDate).Hours()) / (7 * 24)
+	week.UserID = r.MemberIDs[weeks%len(r.MemberIDs)]
+	return week
+}
+
+// ReviewerPool is the part of a team's members that reviewers are picked from,
+// for example to leave out interns. Shadow reviewers are picked from users in
+// training regardless. A team with an empty pool picks from all members.
+type ReviewerPool struct {
+	TeamName string
+	Members  []User
+}
+
+// ReviewerPreference makes a team member a preferred reviewer of an author's PRs.
+// Among available candidates, higher weights are picked first; the open reviews
+// cap still applies.
+type ReviewerPreference struct {
+	AuthorID   string
+	ReviewerID string
+	Weight     int
+}
+
+// StalledPR is an open PR that is due for at least one escalation step.
+type StalledPR struct {
+	PRID              string
+	PRName            string
+	AuthorID          string
+	Priority          PRPriority
+	TeamID            int32
+	Policy            EscalationPolicy
+	WaitingSince      time.Time
+	LeadNotified      bool
+	ReviewerEscalated bool
+}
+
+// UnackedReview is a review assignment the reviewer has not acknowledged yet.
+type UnackedReview struct {
+	PRID       string
+	PRName     string
+	UserID     string
+	AssignedAt time.Time
+	Reminded   bool
+}
+
+// InactiveReview is a review assignment the reviewer has not acted on for a
+// while: LastActivityAt is when it was assigned or last acknowledged.
+type InactiveReview struct {
+	PRID           string
+	PRName         string
+	UserID         string
+	LastActivityAt time.Time
 }
 
 func (t *Team) CanBeMoved() bool {
 	return t.IsActive
 }
 
+// HasRole reports whether any of the users has the given role.
+func HasRole(users []User, role string) bool {
+	for _, u := range users {
+		if u.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+type TeamHierarchy struct {
+	Team     Team
+	Parent   *Team
+	Children []Team
+}
+
 type PullRequest struct {
+	ID string
+	// ExternalID identifies the PR in an external system; it is unique when set.
+	ExternalID  string
+	Name        string
+	Description string
+	AuthorID    string
+	Status      PRStatus
+	Reviewers   []Reviewer
+	// ShadowReviewers are users in training following the review; they are
+	// not reviewers and never count towards approvals.
+	ShadowReviewers []string
+	// RequiredSkills are preferred when picking reviewers; they are not mandatory.
+	RequiredSkills []string
+	// Labels are matched by review rules.
+	Labels []string
+	// AutoMerge merges the PR as soon as every required reviewer has approved it.
+	AutoMerge bool
+	// Priority orders reviewer listings and escalation; urgent PRs ignore the
+	// reviewers' open review cap.
+	Priority PRPriority
+	// Repository, when set, names the repository whose settings drive reviewer
+	// assignment for the PR.
+	Repository string
+	Size       PRSize
+	ApprovedBy []string
+	// ChangesRequestedBy lists reviewers whose request for changes was not
+	// followed by an approval yet.
+	ChangesRequestedBy []string
+	Checklist          []ChecklistItem
+	CreatedAt          time.Time
+	MergedAt           *time.Time
+	ClosedAt           *time.Time
+	// MergedBy and ReassignedBy are the actors of the merge and of the latest
+	// reassignment; empty when the service acted on its own.
+	MergedBy     string
+	ReassignedBy string
+	// OverQuota is set on a PR just created while its author was at the open
+	// PR quota of their team; it is not stored.
+	OverQuota bool
+	// Explanations say why the reviewers picked automatically by the call
+	// that returned the PR were chosen; they are not stored.
+	Explanations []ReviewerExplanation
+}
+
+// ReviewerExplanation says why reviewer selection picked a user: the
+// criteria reviewers are ranked by, in their order, and how the strategy
+// chose among the candidates that ranked the same.
+type ReviewerExplanation struct {
+	UserID string
+	// Role is the required reviewer role the user was picked for, if any.
+	Role string
+	// Hinted is set for the default reviewers of the PR's template and the
+	// reviewers named in its trailers.
+	Hinted bool
+	// Rotation is set for the team's primary reviewer of the week.
+	Rotation bool
+	// PreferenceWeight is the weight of the author's preference for the user.
+	PreferenceWeight int
+	// Cooldown is set when the user reviewed the author recently and was
+	// picked only for lack of others.
+	Cooldown      bool
+	MatchedSkills []string
+	Strategy      SelectionStrategy
+	// OpenReviews is nil unless the strategy or the open reviews cap needed it.
+	OpenReviews *int
+	// Candidates is how many users were eligible for the pick.
+	Candidates int
+}
+
+// PRSize is the size of a PR's change as reported on creation; nil fields are unknown.
+type PRSize struct {
+	LinesChanged *int
+	FilesChanged *int
+}
+
+func (s PRSize) Known() bool {
+	return s.LinesChanged != nil || s.FilesChanged != nil
+}
+
+// SizeRule gives PRs that fit within its bounds Reviewers reviewers. A nil
+// bound fits any size; a set bound does not fit a PR whose size on that
+// dimension is unknown.
+type SizeRule struct {
+	MaxLinesChanged *int
+	MaxFilesChanged *int
+	Reviewers       int
+}
+
+func (r SizeRule) Fits(size PRSize) bool {
+	within := func(bound, v *int) bool {
+		return bound == nil || (v != nil && *v <= *bound)
+	}
+	return within(r.MaxLinesChanged, size.LinesChanged) && within(r.MaxFilesChanged, size.FilesChanged)
+}
+
+// MatchSizeRule returns the first rule the PR size fits, or nil. PRs of
+// unknown size match no rule.
+func MatchSizeRule(rules []SizeRule, size PRSize) *SizeRule {
+	if !size.Known() {
+		return nil
+	}
+	for i := range rules {
+		if rules[i].Fits(size) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// ChecklistItem is an item of a PR's review checklist. CheckedBy and CheckedAt
+// refer to whoever ticked it first.
+type ChecklistItem struct {
+	Position  int
+	Label     string
+	Checked   bool
+	CheckedBy string
+	CheckedAt *time.Time
+}
+
+// ChecklistUpdate ticks or unticks the item at Position.
+type ChecklistUpdate struct {
+	Position int
+	Checked  bool
+}
+
+// ChecklistComplete reports whether every item is ticked; an empty checklist is complete.
+func ChecklistComplete(items []ChecklistItem) bool {
+	for _, item := range items {
+		if !item.Checked {
+			return false
+		}
+	}
+	return true
+}
+
+// PRUpdateType names a PR change pushed to live update subscribers.
+type PRUpdateType string
+
+const (
+	PRCreated          PRUpdateType = "pr.created"
+	PRReviewersChanged PRUpdateType = "pr.reviewers_changed"
+	PRApproved         PRUpdateType = "pr.approved"
+	PRChangesRequested PRUpdateType = "pr.changes_requested"
+	PRMerged           PRUpdateType = "pr.merged"
+	PRChecklistUpdated PRUpdateType = "pr.checklist_updated"
+	PRReady            PRUpdateType = "pr.ready"
+	PRClosed           PRUpdateType = "pr.closed"
+)
+
+// PRUpdate is a committed PR change together with the PR as it is afterwards.
+type PRUpdate struct {
+	Type       PRUpdateType
+	PR         *PullRequest
+	OccurredAt time.Time
+}
+
+// Repository holds reviewer assignment settings for PRs of one code repository.
+type Repository struct {
+	Name string
+	// DefaultTeamID is the team reviewers are picked from; nil keeps the
+	// author's team.
+	DefaultTeamID   *int32
+	DefaultTeamName string
+	// RequiredReviewers is the number of reviewers assigned to a new PR; 0
+	// keeps the service default.
+	RequiredReviewers int
+	RoutingRules      []RoutingRule
+	CreatedAt         time.Time
+}
+
+// RoutingRule sends PRs whose name starts with TitlePrefix to another team
+// and prefers reviewers with RequiredSkills.
+type RoutingRule struct {
+	TitlePrefix    string
+	TeamID         *int32
+	TeamName       string
+	RequiredSkills []string
+}
+
+// ReviewRule routes PRs across repositories. A PR matches it when it has any
+// of Labels or belongs to any of Repositories; the rule then picks reviewers
+// from TeamID, assigns Reviewers of them and prefers RequiredSkills. Unset
+// actions keep what the PR would get without the rule.
+type ReviewRule struct {
+	Name     string
+	Position int
+	Enabled  bool
+	Labels   []string
+	// Repositories are names of configured repositories.
+	Repositories   []string
+	TeamID         *int32
+	TeamName       string
+	Reviewers      int
+	RequiredSkills []string
+	CreatedAt      time.Time
+}
+
+func (r *ReviewRule) Matches(pr *PullRequest) bool {
+	if pr.Repository != "" && slices.Contains(r.Repositories, pr.Repository) {
+		return true
+	}
+	for _, label := range pr.Labels {
+		if slices.Contains(r.Labels, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchReviewRule returns the first enabled rule matching the PR, or nil.
+// Rules are expected in evaluation order.
+func MatchReviewRule(rules []ReviewRule, pr *PullRequest) *ReviewRule {
+	for i := range rules {
+		if rules[i].Enabled && rules[i].Matches(pr) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// ReviewTrailers are the reviewer requests an author put in the trailers of a
+// PR description, such as "Reviewers: alice, bob" and "Review-Team: platform".
+type ReviewTrailers struct {
+	// Reviewers are usernames or user IDs, without a leading @.
+	Reviewers []string
+	Team      string
+}
+
+// ParseReviewTrailers reads the trailers of the last paragraph of a PR
+// description, the way git reads them from a commit message. Keys are matched
+// ignoring case; reviewers may be separated by commas or spaces and repeated
+// over several lines, and of several Review-Team trailers the last one counts.
+func ParseReviewTrailers(description string) ReviewTrailers {
+	var t ReviewTrailers
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(description, "\r\n", "\n")), "\n\n")
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "reviewers":
+			for _, name := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+				if name = strings.TrimPrefix(name, "@"); name != "" && !slices.Contains(t.Reviewers, name) {
+					t.Reviewers = append(t.Reviewers, name)
+				}
+			}
+		case "review-team":
+			if team := strings.TrimSpace(value); team != "" {
+				t.Team = team
+			}
+		}
+	}
+	return t
+}
+
+// RouteDecision tells where the reviewers of a PR would come from.
+type RouteDecision struct {
+	// Rule is the name of the matching review rule; empty when none matched.
+	Rule           string
+	TeamName       string
+	Reviewers      int
+	RequiredSkills []string
+}
+
+// MatchRule returns the first routing rule whose prefix matches the PR name,
+// ignoring case, or nil.
+func (r *Repository) MatchRule(prName string) *RoutingRule {
+	name := strings.ToLower(prName)
+	for i := range r.RoutingRules {
+		if strings.HasPrefix(name, strings.ToLower(r.RoutingRules[i].TitlePrefix)) {
+			return &r.RoutingRules[i]
+		}
+	}
+	return nil
+}
+
+type Reviewer struct {
+	ID       string
+	Username string
+	// Optional reviewers may approve or request changes, but only required
+	// reviewers count for auto-merge, the required role, escalation and
+	// acknowledgement SLAs.
+	Optional bool
+}
+
+// IsOpen reports whether the PR is neither merged nor closed.
+func (pr *PullRequest) IsOpen() bool {
+	return pr.Status != StatusMerged && pr.Status != StatusClosed
+}
+
+// NotOpenError is the error for operations on a PR that is no longer open.
+func (pr *PullRequest) NotOpenError() error {
+	if pr.Status == StatusClosed {
+		return ErrPRClosed
+	}
+	return ErrPRMerged
+}
+
+// TransitionError is the error for moving the PR to next, nil when allowed.
+func (pr *PullRequest) TransitionError(next PRStatus) error {
+	if pr.Status.CanTransitionTo(next) {
+		return nil
+	}
+	if !pr.IsOpen() {
+		return pr.NotOpenError()
+	}
+	return fmt.Errorf("%w: PR %s is %s and cannot become %s", ErrInvalidTransition, pr.ID, pr.Status, next)
+}
+
+// RequiredReviewerCount counts the reviewers that are not optional.
+func (pr *PullRequest) RequiredReviewerCount() int {
+	n := 0
+	for _, r := range pr.Reviewers {
+		if !r.Optional {
+			n++
+		}
+	}
+	return n
+}
+
+// PRSearchHit is a full-text search match; higher Rank means a better match.
+type PRSearchHit struct {
+	PullRequest
+	Rank float32
+}
+
+type PRSearchPage struct {
+	Hits   []PRSearchHit
+	Total  int
+	Limit  int
+	Offset int
+}
+
+// PRFilter selects PRs for a list; empty fields match any PR. TeamName is
+// the author's team. Unreviewed keeps PRs without a verdict from ReviewerID,
+// or from any reviewer when ReviewerID is empty. AuthorID and ReviewerID may
+// be MeFilterValue, which stands for the user making the request.
+type PRFilter struct {
+	Status      PRStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Repository  string
+	Priority    PRPriority
+	Label       string
+	MinAgeHours int
+	Unreviewed  bool
+	Limit       int
+	Offset      int
+}
+
+const MeFilterValue = "@me"
+
+type PRListPage struct {
+	PullRequests []PullRequest
+	Total        int
+	Limit        int
+	Offset       int
+}
+
+// SavedFilter is a named PRFilter owned by a user or by a team: exactly one
+// of UserID and TeamID is set. The limit and offset of Filter are not saved.
+type SavedFilter struct {
+	ID        int64
+	Name      string
+	UserID    string
+	TeamID    *int32
+	TeamName  string
+	Filter    PRFilter
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PRTemplate is a team's preset for new PRs: NamePrefix goes in front of the
+// PR name, Labels are added to the PR's own and DefaultReviewers, members of
+// the team, are preferred when its reviewers are picked.
+type PRTemplate struct {
+	ID               int64
+	TeamID           int32
+	TeamName         string
+	Name             string
+	NamePrefix       string
+	Labels           []string
+	DefaultReviewers []string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// ReviewAssignment is a single reviewer slot on an open PR.
+type ReviewAssignment struct {
+	PRID     string
+	UserID   string
+	AuthorID string
+}
+
+type RebalanceMove struct {
+	PRID       string
+	FromUserID string
+	ToUserID   string
+}
+
+// DeactivationStatus is the outcome of deactivating one user of a batch.
+type DeactivationStatus string
+
+const (
+	DeactivationDone            DeactivationStatus = "deactivated"
+	DeactivationAlreadyInactive DeactivationStatus = "already_inactive"
+	DeactivationNotFound        DeactivationStatus = "not_found"
+)
+
+// UserDeactivation reports what a batch deactivation did for one user:
+// the reviews handed to someone else and the PRs left without a replacement.
+type UserDeactivation struct {
+	UserID     string
+	Status     DeactivationStatus
+	Reassigned []RebalanceMove
+	Unassigned []string
+}
+
+// RebalanceReport describes the moves made to even out open-review load within a team.
+// Loads are open review counts per active team member.
+type RebalanceReport struct {
+	TeamName   string
+	Moves      []RebalanceMove
+	LoadBefore map[string]int
+	LoadAfter  map[string]int
+}
+
+// MemberReviewCount is how many reviews a team member got within a period.
+type MemberReviewCount struct {
+	TeamName    string
+	UserID      string
+	ReviewCount int
+}
+
+// TeamFairness summarizes how evenly reviews were spread over a team's active
+// members. MaxMinRatio is nil when some member got no reviews at all.
+type TeamFairness struct {
+	TeamName     string
+	Members      int
+	TotalReviews int
+	MinReviews   int
+	MaxReviews   int
+	Mean         float64
+	StdDev       float64
+	Gini         float64
+	MaxMinRatio  *float64
+	ReviewCounts map[string]int
+}
+
+// FairnessReport covers reviews assigned within [Since, Until).
+type FairnessReport struct {
+	Since time.Time
+	Until time.Time
+	Teams []TeamFairness
+}
+
+// ReportPR is a PR listed in a team report; At is when it was merged or
+// first escalated.
+type ReportPR struct {
 	ID        string
 	Name      string
 	AuthorID  string
-	Status    PRStatus
-	Reviewers []Reviewer
 	CreatedAt time.Time
-	MergedAt  *time.Time
+	At        time.Time
 }
 
-type Reviewer struct {
-	ID       string
+// TeamReport summarizes a team's reviews within [Since, Until): the PRs its
+// members merged, how long they took from creation to merge, how evenly
+// reviews were spread and the PRs escalated for breaching the review SLA.
+// AvgTurnaround is nil when nothing was merged.
+type TeamReport struct {
+	TeamName      string
+	Since         time.Time
+	Until         time.Time
+	Merged        []ReportPR
+	AvgTurnaround *time.Duration
+	Fairness      TeamFairness
+	SLABreaches   []ReportPR
+}
+
+// ReassignmentReason says why a reviewer was taken off a PR.
+type ReassignmentReason string
+
+const (
+	// ReassignmentDeactivation: the reviewer or their team was deactivated.
+	ReassignmentDeactivation ReassignmentReason = "deactivation"
+	// ReassignmentManual: someone asked for another reviewer.
+	ReassignmentManual ReassignmentReason = "manual"
+	// ReassignmentHandoff: all of the reviewer's open reviews were handed over.
+	ReassignmentHandoff ReassignmentReason = "handoff"
+	// ReassignmentUnacked: the reviewer did not acknowledge the assignment in time.
+	ReassignmentUnacked ReassignmentReason = "unacked"
+	// ReassignmentRebalance: the team's review load was evened out.
+	ReassignmentRebalance ReassignmentReason = "rebalance"
+	// ReassignmentTeamMove: the reviewer moved to another team.
+	ReassignmentTeamMove ReassignmentReason = "team_move"
+	// ReassignmentInactive: the reviewer took no action on the review in time.
+	ReassignmentInactive ReassignmentReason = "inactive"
+)
+
+// DeclineReason says why a reviewer declined a review they were taken off
+// manually.
+type DeclineReason string
+
+const (
+	DeclineConflictOfInterest DeclineReason = "conflict_of_interest"
+	DeclineOverloaded         DeclineReason = "overloaded"
+	DeclineOnLeave            DeclineReason = "on_leave"
+	DeclineLacksContext       DeclineReason = "lacks_context"
+)
+
+func (r DeclineReason) Valid() bool {
+	switch r {
+	case DeclineConflictOfInterest, DeclineOverloaded, DeclineOnLeave, DeclineLacksContext:
+		return true
+	}
+	return false
+}
+
+// PREventType is the kind of an entry of a PR's timeline.
+type PREventType string
+
+const (
+	PREventCreated          PREventType = "created"
+	PREventAssigned         PREventType = "assigned"
+	PREventReassigned       PREventType = "reassigned"
+	PREventAcked            PREventType = "acked"
+	PREventChangesRequested PREventType = "changes_requested"
+	PREventApproved         PREventType = "approved"
+	PREventMerged           PREventType = "merged"
+	PREventClosed           PREventType = "closed"
+)
+
+// PREvent is an entry of a PR's timeline. UserID is the author, the reviewer
+// or, for reassignments, the reviewer taken off the PR, who was replaced by
+// ReplacedBy if anyone and may have given a DeclineReason; Actor is who did
+// it, when known.
+type PREvent struct {
+	Type          PREventType
+	At            time.Time
+	UserID        string
+	ReplacedBy    string
+	Reason        ReassignmentReason
+	DeclineReason DeclineReason
+	Actor         string
+}
+
+// ReassignmentCount is how many times a team member was taken off PRs for a
+// reason and decline reason within a period. UserID is empty for deleted
+// users, DeclineReason when none was given.
+type ReassignmentCount struct {
+	TeamName      string
+	UserID        string
+	Reason        ReassignmentReason
+	DeclineReason DeclineReason
+	Count         int
+}
+
+// MergeCount is how many PRs an actor merged within a period. Username is set
+// when MergedBy is the ID of a user.
+type MergeCount struct {
+	MergedBy string
 	Username string
+	Count    int
 }
 
-func (pr *PullRequest) IsOpen() bool {
-	return pr.Status != StatusMerged
+// MergeReport covers merges within [Since, Until). Unattributed counts merges
+// nobody was recorded for; Users are ordered by merges, most first.
+type MergeReport struct {
+	Since        time.Time
+	Until        time.Time
+	Total        int
+	Unattributed int
+	Users        []MergeCount
+}
+
+// ReasonCount is one entry of a breakdown by reason, most frequent first.
+type ReasonCount struct {
+	Reason ReassignmentReason
+	Count  int
+}
+
+// DeclineReasonCount is one entry of a breakdown by decline reason, most
+// frequent first.
+type DeclineReasonCount struct {
+	Reason DeclineReason
+	Count  int
+}
+
+// ReassignmentGroup is the churn of a team or, when UserID is set, of one of
+// its members. DeclineReasons only covers reassignments a reason was given
+// for.
+type ReassignmentGroup struct {
+	TeamName       string
+	UserID         string
+	Total          int
+	Reasons        []ReasonCount
+	DeclineReasons []DeclineReasonCount
+}
+
+// ReassignmentReport covers reassignments within [Since, Until). Teams and
+// users are ordered by churn, highest first.
+type ReassignmentReport struct {
+	Since          time.Time
+	Until          time.Time
+	Total          int
+	Reasons        []ReasonCount
+	DeclineReasons []DeclineReasonCount
+	Teams          []ReassignmentGroup
+	Users          []ReassignmentGroup
+}
+
+// ShadowReviewCount is how many shadow reviews a user of a team got within a
+// period.
+type ShadowReviewCount struct {
+	TeamName   string
+	UserID     string
+	Username   string
+	InTraining bool
+	Count      int
+}
+
+// ShadowReviewReport covers shadow reviews within [Since, Until). Users are
+// ordered by team, then by shadow reviews, most first.
+type ShadowReviewReport struct {
+	Since time.Time
+	Until time.Time
+	Total int
+	Users []ShadowReviewCount
+}
+
+// UnassignedCount is how many open PRs of a team were without reviewers at
+// some moment of a UTC day.
+type UnassignedCount struct {
+	TeamName string
+	Day      time.Time
+	Count    int
+}
+
+// TeamUnassignedSeries is a team's UnassignedCount for every day of a report,
+// days without such PRs included; Peak is the largest daily count.
+type TeamUnassignedSeries struct {
+	TeamName string
+	Peak     int
+	Days     []UnassignedCount
+}
+
+// UnassignedReport covers the UTC days from Since to Until inclusive. Teams
+// are ordered by Peak, highest first.
+type UnassignedReport struct {
+	Since time.Time
+	Until time.Time
+	Teams []TeamUnassignedSeries
+}
+
+// ThroughputMetric is a review throughput series: events counted per bucket
+// of time.
+type ThroughputMetric string
+
+const (
+	MetricPRsCreated       ThroughputMetric = "prs_created"
+	MetricPRsMerged        ThroughputMetric = "prs_merged"
+	MetricReviewsAssigned  ThroughputMetric = "reviews_assigned"
+	MetricReviewsCompleted ThroughputMetric = "reviews_completed"
+)
+
+// ThroughputMetrics lists every throughput metric.
+var ThroughputMetrics = []ThroughputMetric{MetricPRsCreated, MetricPRsMerged, MetricReviewsAssigned, MetricReviewsCompleted}
+
+// SeriesInterval is the length of a time series bucket. Buckets start at UTC
+// hours, days or weeks (on Monday).
+type SeriesInterval string
+
+const (
+	IntervalHour SeriesInterval = "hour"
+	IntervalDay  SeriesInterval = "day"
+	IntervalWeek SeriesInterval = "week"
+)
+
+func (i SeriesInterval) Valid() bool {
+	switch i {
+	case IntervalHour, IntervalDay, IntervalWeek:
+		return true
+	}
+	return false
+}
+
+// Truncate returns the start of the bucket t falls in.
+func (i SeriesInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case IntervalHour:
+		return t.Truncate(time.Hour)
+	case IntervalWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Duration returns the length of a bucket; buckets are in UTC, so it is fixed.
+func (i SeriesInterval) Duration() time.Duration {
+	switch i {
+	case IntervalHour:
+		return time.Hour
+	case IntervalWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// ThroughputCount is how many events of a metric fell in the bucket starting
+// at Bucket.
+type ThroughputCount struct {
+	Metric ThroughputMetric
+	Bucket time.Time
+	Count  int
+}
+
+// TimeSeries is a metric's count for every bucket of a range, empty buckets
+// included.
+type TimeSeries struct {
+	Metric ThroughputMetric
+	Points []ThroughputCount
+}
+
+// UserStatsSnapshot is a user's review counters as recorded on Date (UTC).
+// TeamName is the team the user was in that day, empty if it is gone.
+type UserStatsSnapshot struct {
+	Date          time.Time
+	TeamName      string
+	TotalReviews  int
+	OpenReviews   int
+	MergedReviews int
+}
+
+// TeamStatsSnapshot is a team's review counters and active member count as
+// recorded on Date (UTC).
+type TeamStatsSnapshot struct {
+	Date          time.Time
+	ActiveMembers int
+	OpenReviews   int
+	MergedReviews int
+}
+
+// RepositoryStats summarizes the PRs of a repository, archived ones included.
+// The merge times are nil until some PR is merged.
+type RepositoryStats struct {
+	RepositoryName    string
+	OpenPRs           int
+	MergedPRs         int
+	AvgReviewers      float64
+	AvgTimeToMerge    *time.Duration
+	MedianTimeToMerge *time.Duration
+}
+
+// AuthorStats summarizes the PRs a user opened, archived ones included. The
+// merge times are nil until some PR is merged.
+type AuthorStats struct {
+	UserID            string
+	OpenedPRs         int
+	OpenPRs           int
+	MergedPRs         int
+	AvgReviewers      float64
+	AvgTimeToMerge    *time.Duration
+	MedianTimeToMerge *time.Duration
+}
+
+// OrgSummary is the org-wide top line for a status widget: open PRs (drafts
+// included), open PRs still without reviewers, active users, and the PRs
+// merged within [Since, Until) with how long they took from creation to merge.
+// AvgTurnaround is nil when nothing was merged.
+type OrgSummary struct {
+	Since         time.Time
+	Until         time.Time
+	OpenPRs       int
+	UnassignedPRs int
+	ActiveUsers   int
+	MergedPRs     int
+	AvgTurnaround *time.Duration
+}
+
+// ReviewerTurnaround is how long a reviewer takes from assignment to the first
+// verdict (an approval or a request for changes) within [Since, Until). The
+// durations are nil until some review gets a verdict.
+type ReviewerTurnaround struct {
+	UserID           string
+	Since            time.Time
+	Until            time.Time
+	ReviewedCount    int
+	PendingCount     int
+	AvgTurnaround    *time.Duration
+	MedianTurnaround *time.Duration
+}
+
+// UserMergeResult describes what was moved from a duplicate user to the one
+// that replaced it. ReviewsDropped counts assignments that would have made the
+// target review its own PR or that it already had.
+type UserMergeResult struct {
+	SourceUserID       string
+	TargetUserID       string
+	ReviewsMoved       int
+	ReviewsDropped     int
+	PullRequestsMoved  int
+	GitHubAccountMoved bool
 }
 
 type StatItem struct {
 	ReviewCount int64
 	UserID      string
+	Username    string
+	// AckedCount is how many assignments the user acknowledged and
+	// AvgAckLatency how long that took on average.
+	AckedCount    int64
+	AvgAckLatency time.Duration
+}
+
+// StatsOrder is the order of review stats; ties are broken by username, then
+// user ID.
+type StatsOrder string
+
+const (
+	StatsOrderReviewCount StatsOrder = "review_count"
+	StatsOrderUsername    StatsOrder = "username"
+)
+
+type StatsPage struct {
+	Items []StatItem
+	Total int
+	// Limit is 0 when the page holds every user.
+	Limit  int
+	Offset int
+}
+
+// DataDump is a full export of the service data, used to migrate between environments.
+// Review assignments are carried in PullRequest.Reviewers.
+type DataDump struct {
+	Teams        []Team
+	Users        []User
+	PullRequests []PullRequest
+}
+
+type ImportResult struct {
+	Teams        int
+	Users        int
+	PullRequests int
+	Assignments  int
+}
+
+// TeamSnapshot is a team with its members and settings, used to copy the team
+// to another installation. Team holds the members with their roles and skills
+// and the size rules. The parent team, PRs and scheduled deactivation are not
+// part of a snapshot.
+type TeamSnapshot struct {
+	Team Team
+	// ReviewerPreferences are the preferences between members of the team.
+	ReviewerPreferences []ReviewerPreference
+	// ReviewRules are the rules that pick reviewers from the team.
+	ReviewRules []ReviewRule
+	PRTemplates []PRTemplate
+	// ReviewBudget, ReportSchedule, PRQuota and ReviewRotation are nil when
+	// the team has none. Overrides of the rotation are not part of a snapshot.
+	ReviewBudget   *ReviewBudget
+	ReportSchedule *TeamReportSchedule
+	PRQuota        *PRQuota
+	ReviewRotation *ReviewRotation
+	// ReviewerPool holds the IDs of the members in the team's reviewer pool.
+	ReviewerPool []string
+	// InactiveReassignOptOut is set when the team opted out of reassigning
+	// inactive reviewers.
+	InactiveReassignOptOut bool
+}
+
+type HealthStatus string
+
+const (
+	HealthOK       HealthStatus = "ok"
+	HealthDegraded HealthStatus = "degraded"
+	HealthDown     HealthStatus = "down"
+)
+
+// DependencyHealth is the result of probing one dependency. A failed critical
+// dependency takes the service down; a failed optional one only degrades it.
+type DependencyHealth struct {
+	Name     string
+	Critical bool
+	Up       bool
+	Error    string
+	Latency  time.Duration
+}
+
+type HealthReport struct {
+	Status       HealthStatus
+	Dependencies []DependencyHealth
+}
+
+// GitHubAccount maps a service user to their GitHub login.
+type GitHubAccount struct {
+	UserID string
+	Login  string
+}
+
+// SlackAccount maps a service user to their Slack user ID.
+type SlackAccount struct {
+	UserID      string
+	SlackUserID string
+}
+
+// GitHubPRLink ties a PR to a pull request on GitHub. Repository is "owner/name".
+type GitHubPRLink struct {
+	PRID       string
+	Repository string
+	Number     int
+	// SyncError is set when the reviewers could not be pushed to GitHub.
+	SyncError string
+}
+
+// GitHubInstallation is an installation of the GitHub App on a user or
+// organization account, with its cached access token.
+type GitHubInstallation struct {
+	ID             int64
+	AccountLogin   string
+	Token          string
+	TokenExpiresAt time.Time
+}
+
+// GitHubRepo is a repository the GitHub App is installed on. Pull requests of
+// a repository are only picked up once it is mapped to a team.
+type GitHubRepo struct {
+	Repository     string
+	InstallationID int64
+	TeamID         *int32
+	TeamName       string
+}
+
+// GitHubIngestionToken is the webhook secret of a repository that posts pull
+// request events to the shared webhook endpoint without the GitHub App. Those
+// events only ever create PRs in the token's team. Secret is only filled in
+// when the token is created.
+type GitHubIngestionToken struct {
+	Repository string
+	TeamID     int32
+	TeamName   string
+	Secret     string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// GitHubTeam is a team of a GitHub organization.
+type GitHubTeam struct {
+	ID   int64
+	Slug string
+	Name string
+}
+
+// GitHubTeamLink ties an internal team to the GitHub team it is synced from.
+type GitHubTeamLink struct {
+	GitHubTeamID int64
+	Org          string
+	Slug         string
+	TeamID       int32
+}
+
+// GitHubTeamSyncConflictReason says why the GitHub teams sync left a
+// membership as it was.
+type GitHubTeamSyncConflictReason string
+
+const (
+	// SyncConflictSeveralTeams: the login is in several GitHub teams, while a
+	// user belongs to one team; the user is kept in or moved to TeamName.
+	SyncConflictSeveralTeams GitHubTeamSyncConflictReason = "several_teams"
+	// SyncConflictTeamNameTaken: an internal team of that name is already
+	// synced from another GitHub team.
+	SyncConflictTeamNameTaken GitHubTeamSyncConflictReason = "team_name_taken"
+	// SyncConflictTeamInactive: the internal team is deactivated.
+	SyncConflictTeamInactive GitHubTeamSyncConflictReason = "team_inactive"
+	// SyncConflictUsernameTaken: a new user could not be created under the login.
+	SyncConflictUsernameTaken GitHubTeamSyncConflictReason = "username_taken"
+	// SyncConflictUserInactive: the user is deactivated and is not moved or
+	// reactivated automatically.
+	SyncConflictUserInactive GitHubTeamSyncConflictReason = "user_inactive"
+	// SyncConflictNoGitHubLogin: a member of a synced team has no linked
+	// GitHub login, so their membership cannot be checked.
+	SyncConflictNoGitHubLogin GitHubTeamSyncConflictReason = "no_github_login"
+	// SyncConflictFailed: applying the change failed; Detail has the error.
+	SyncConflictFailed GitHubTeamSyncConflictReason = "failed"
+)
+
+type GitHubTeamSyncConflict struct {
+	Reason   GitHubTeamSyncConflictReason
+	Login    string
+	UserID   string
+	TeamName string
+	Detail   string
+}
+
+// GitHubTeamSyncReport describes a run of the GitHub teams sync. Error is set
+// when the organization could not be read and nothing was changed.
+type GitHubTeamSyncReport struct {
+	ID               int64
+	Org              string
+	StartedAt        time.Time
+	FinishedAt       time.Time
+	TeamsCreated     int
+	UsersCreated     int
+	UsersMoved       int
+	UsersDeactivated int
+	Conflicts        []GitHubTeamSyncConflict
+	Error            string
+}
+
+type NotificationEvent string
+
+const (
+	EventReviewRequested  NotificationEvent = "review_requested"
+	EventDigest           NotificationEvent = "digest"
+	EventPRStalled        NotificationEvent = "pr_stalled"
+	EventAckReminder      NotificationEvent = "ack_reminder"
+	EventNoCandidateSpike NotificationEvent = "no_candidate_spike"
+	EventReviewBudgetLow  NotificationEvent = "review_budget_low"
+	EventTeamReport       NotificationEvent = "team_report"
+	EventReviewReassigned NotificationEvent = "review_reassigned"
+)
+
+// DigestFrequency is how often a user gets a summary of their pending reviews.
+type DigestFrequency string
+
+const (
+	DigestOff    DigestFrequency = "off"
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// NotificationEvents lists the events users can mute.
+var NotificationEvents = []NotificationEvent{EventReviewRequested, EventPRStalled, EventAckReminder, EventNoCandidateSpike, EventReviewBudgetLow, EventTeamReport, EventReviewReassigned}
+
+// NotificationPreferences control how a user is notified. Quiet hours are
+// "HH:MM" in Timezone; notifications falling inside them are held until the end.
+// With a digest enabled, review requests are only reported in the digest.
+type NotificationPreferences struct {
+	UserID          string
+	Channels        []string
+	MutedEvents     []NotificationEvent
+	QuietHoursStart string
+	QuietHoursEnd   string
+	Timezone        string
+	WebhookURL      string
+	// Email is the address the email channel sends to.
+	Email  string
+	Digest DigestFrequency
+}
+
+// DefaultNotificationPreferences apply to users who have not saved their own.
+func DefaultNotificationPreferences(userID string) *NotificationPreferences {
+	return &NotificationPreferences{UserID: userID, Channels: []string{"log"}, MutedEvents: []NotificationEvent{}, Timezone: "UTC", Digest: DigestOff}
+}
+
+func (p *NotificationPreferences) Mutes(event NotificationEvent) bool {
+	if event == EventReviewRequested && p.Digest != DigestOff {
+		return true
+	}
+	for _, e := range p.MutedEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification is a message queued for delivery to a user.
+type Notification struct {
+	ID      int64
+	UserID  string
+	Event   NotificationEvent
+	PRID    string
+	Message string
+	// HTML is an optional rich version of Message for channels that can
+	// show it.
+	HTML     string
+	Attempts int
+}
+
+// DeadLetter is a notification given up on after its last delivery attempt
+// failed.
+type DeadLetter struct {
+	Notification
+	LastError      string
+	CreatedAt      time.Time
+	DeadLetteredAt time.Time
+}
+
+// DeadLetterFilter selects dead-lettered notifications. Empty Event and
+// UserID match any event and recipient.
+type DeadLetterFilter struct {
+	Event  NotificationEvent
+	UserID string
+	Limit  int
+	Offset int
+}
+
+// NotificationFilter selects stored notifications for a replay. Empty Event
+// and UserID match any event and recipient.
+type NotificationFilter struct {
+	Event  NotificationEvent
+	UserID string
+	Since  time.Time
+	Until  time.Time
+}
+
+// WebhookDelivery is one attempt to post a notification to a webhook.
+type WebhookDelivery struct {
+	ID int64
+	// NotificationID is 0 once the notification is gone.
+	NotificationID int64
+	UserID         string
+	URL            string
+	RequestBody    string
+	// StatusCode is 0 when no response was received.
+	StatusCode   int
+	ResponseBody string
+	// Error is empty for successful deliveries.
+	Error        string
+	Duration     time.Duration
+	RedeliveryOf *int64
+	AttemptedAt  time.Time
+}
+
+func (d *WebhookDelivery) Failed() bool {
+	return d.Error != ""
+}
+
+// WebhookDeliveryFilter selects delivery attempts. Status is "", "failed" or
+// "succeeded".
+type WebhookDeliveryFilter struct {
+	UserID string
+	Status string
+	Limit  int
+	Offset int
+}
+
+// InboundWebhookStatus is the outcome of processing a received webhook.
+type InboundWebhookStatus string
+
+const (
+	InboundWebhookProcessed InboundWebhookStatus = "processed"
+	InboundWebhookFailed    InboundWebhookStatus = "failed"
+)
+
+// InboundWebhook is a webhook delivery received from an SCM whose signature
+// checked out, kept with the outcome of processing it.
+type InboundWebhook struct {
+	ID int64
+	// Source is the sender of the delivery; only "github" for now.
+	Source string
+	Event  string
+	// DeliveryID is the sender's ID of the delivery, empty if it gave none.
+	DeliveryID string
+	// IngestionToken is the repository whose ingestion token verified the
+	// delivery, empty for the GitHub App secret.
+	IngestionToken string
+	Payload        string
+	Status         InboundWebhookStatus
+	// Error is empty for processed deliveries.
+	Error       string
+	Attempts    int
+	ReceivedAt  time.Time
+	ProcessedAt time.Time
+}
+
+// InboundWebhookFilter selects received webhooks. Empty Status and Event match
+// any.
+type InboundWebhookFilter struct {
+	Status InboundWebhookStatus
+	Event  string
+	Limit  int
+	Offset int
+}
+
+// PendingReview is an open PR the user is assigned to and has not approved yet.
+type PendingReview struct {
+	PRID       string
+	PRName     string
+	Priority   PRPriority
+	AssignedAt time.Time
 }
@@ -0,0 +1,31 @@
+package domain
+
+import "sync/atomic"
+
+// Redacted replaces personal data while redaction is on.
+const Redacted = "[redacted]"
+
+var redactPII atomic.Bool
+
+// SetRedactPII turns the redaction of personal data in error messages and
+// logs on or off, for services running in regulated environments.
+func SetRedactPII(on bool) {
+	redactPII.Store(on)
+}
+
+// RedactPII reports whether personal data is redacted.
+func RedactPII() bool {
+	return redactPII.Load()
+}
+
+// PII marks personal data, such as a username, email address or GitHub
+// login, formatted into an error message. It prints as Redacted while
+// redaction is on.
+type PII string
+
+func (p PII) String() string {
+	if redactPII.Load() {
+		return Redacted
+	}
+	return string(p)
+}
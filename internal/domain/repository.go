@@ -2,51 +2,467 @@ package domain
 
 import (
 	"context"
-
-	"github.com/jackc/pgx/v5"
+	"time"
 )
 
-type Transactor interface {
-	BeginTx(ctx context.Context) (pgx.Tx, error)
-	CommitTx(ctx context.Context, tx pgx.Tx) error
-	RollbackTx(ctx context.Context, tx pgx.Tx) error
+// Tx is a transaction opened by UnitOfWork.WithinTx. Services treat it as
+// opaque and only hand it to repository methods; each storage backend decides
+// what it holds and embeds TxMarker in its transaction type. A nil Tx makes a
+// repository method run outside a transaction.
+type Tx interface {
+	isTx()
+}
+
+// TxMarker makes the transaction type of a storage backend a Tx.
+type TxMarker struct{}
+
+func (TxMarker) isTx() {}
+
+// UnitOfWork runs a group of repository calls atomically.
+type UnitOfWork interface {
+	// WithinTx runs fn in a transaction that is committed when fn succeeds
+	// and rolled back otherwise. A transaction that conflicts with a
+	// concurrent one (a serialization failure or a deadlock) is run again
+	// from the start, so fn must not have effects outside tx; when conflicts
+	// persist, ErrTxConflict is returned. fn should use the ctx it is given,
+	// which carries the transaction's deadline.
+	WithinTx(ctx context.Context, fn func(ctx context.Context, tx Tx) error) error
 }
 
 type TeamRepository interface {
-	CreateTeam(ctx context.Context, tx pgx.Tx, team *Team) (*Team, error)
+	CreateTeam(ctx context.Context, tx Tx, team *Team) (*Team, error)
 	GetTeamByName(ctx context.Context, teamName string) (*Team, error)
+	// GetTeamWithMembers returns the team with Members and SizeRules filled in.
+	GetTeamWithMembers(ctx context.Context, teamName string) (*Team, error)
 	GetTeamByID(ctx context.Context, teamID int32) (*Team, error)
-	UpdateTeam(ctx context.Context, tx pgx.Tx, oldTeamName, newTeamName string) (*Team, error)
-	DeactivateTeam(ctx context.Context, tx pgx.Tx, teamName string) error
+	ListTeams(ctx context.Context) ([]Team, error)
+	UpdateTeam(ctx context.Context, tx Tx, oldTeamName, newTeamName string) (*Team, error)
+	DeactivateTeam(ctx context.Context, tx Tx, teamName string) error
+	SetTeamParent(ctx context.Context, tx Tx, teamID int32, parentTeamID *int32, escalateToParent bool) (*Team, error)
+	ListChildTeams(ctx context.Context, teamID int32) ([]Team, error)
+	SetTeamRequiredReviewerRole(ctx context.Context, tx Tx, teamID int32, role string) (*Team, error)
+	SetTeamOptionalReviewers(ctx context.Context, tx Tx, teamID int32, count int) (*Team, error)
+	SetTeamShadowReviewPercent(ctx context.Context, tx Tx, teamID int32, percent int) (*Team, error)
+	SetTeamEscalationPolicy(ctx context.Context, tx Tx, teamID int32, policy EscalationPolicy) (*Team, error)
+	SetTeamReviewCooldown(ctx context.Context, tx Tx, teamID int32, prCount int) (*Team, error)
+	SetTeamAllowDuplicateUsernames(ctx context.Context, tx Tx, teamID int32, allow bool) (*Team, error)
+	SetTeamAllowSelfReview(ctx context.Context, tx Tx, teamID int32, allow bool) (*Team, error)
+	GetTeamSizeRules(ctx context.Context, teamID int32) ([]SizeRule, error)
+	// SetTeamSizeRules replaces the size rules of the team, keeping their order.
+	SetTeamSizeRules(ctx context.Context, tx Tx, teamID int32, rules []SizeRule) error
+	SetTeamChecklist(ctx context.Context, tx Tx, teamID int32, checklist ChecklistTemplate) (*Team, error)
+	GetReviewerPreferences(ctx context.Context, teamID int32) ([]ReviewerPreference, error)
+	// SetReviewerPreferences replaces all reviewer preferences of the team.
+	SetReviewerPreferences(ctx context.Context, tx Tx, teamID int32, prefs []ReviewerPreference) ([]ReviewerPreference, error)
+	// ScheduleTeamDeactivation sets when the team is deactivated; nil cancels the plan.
+	ScheduleTeamDeactivation(ctx context.Context, tx Tx, teamID int32, at *time.Time) (*Team, error)
+	ListTeamsDueForDeactivation(ctx context.Context, limit int) ([]Team, error)
+	// RecordNoCandidate stores a reviewer pick for the team that found nobody and
+	// returns how many the team had since the given time.
+	RecordNoCandidate(ctx context.Context, teamID int32, prID string, since time.Time) (int, error)
+	// ClaimNoCandidateAlert marks the team as alerted now and reports whether it
+	// was not alerted since the given time yet.
+	ClaimNoCandidateAlert(ctx context.Context, teamID int32, since time.Time) (bool, error)
+	GetReviewBudget(ctx context.Context, teamID int32) (*ReviewBudget, error)
+	// ListReviewBudgets returns the budgets of active teams.
+	ListReviewBudgets(ctx context.Context) ([]ReviewBudget, error)
+	// SetReviewBudget creates or changes the team's budget. A new budget starts
+	// its first sprint now; changing one keeps the current sprint.
+	SetReviewBudget(ctx context.Context, tx Tx, teamID int32, reviewsPerSprint, sprintDays int) (*ReviewBudget, error)
+	// DeleteReviewBudget removes the team's budget, failing with ErrNotFound if it has none.
+	DeleteReviewBudget(ctx context.Context, tx Tx, teamID int32) error
+	// StartReviewSprint moves the budget to the sprint starting at startedAt and
+	// resets what the team's members used. It reports false if the budget is
+	// already there.
+	StartReviewSprint(ctx context.Context, tx Tx, teamID int32, startedAt time.Time) (bool, error)
+	// ClaimReviewBudgetAlert marks the sprint's budget as alerted and reports
+	// whether it was not yet.
+	ClaimReviewBudgetAlert(ctx context.Context, teamID int32) (bool, error)
+	GetReportSchedule(ctx context.Context, teamID int32) (*TeamReportSchedule, error)
+	// ListReportSchedules returns the report schedules of active teams.
+	ListReportSchedules(ctx context.Context) ([]TeamReportSchedule, error)
+	SetReportSchedule(ctx context.Context, tx Tx, schedule *TeamReportSchedule) (*TeamReportSchedule, error)
+	// DeleteReportSchedule fails with ErrNotFound if the team has no schedule.
+	DeleteReportSchedule(ctx context.Context, tx Tx, teamID int32) error
+	// ClaimTeamReport records the report due at slot as sent and reports
+	// false if it already was.
+	ClaimTeamReport(ctx context.Context, teamID int32, slot time.Time) (bool, error)
+	GetPRQuota(ctx context.Context, teamID int32) (*PRQuota, error)
+	SetPRQuota(ctx context.Context, tx Tx, quota *PRQuota) (*PRQuota, error)
+	// DeletePRQuota fails with ErrNotFound if the team has no quota.
+	DeletePRQuota(ctx context.Context, tx Tx, teamID int32) error
+	// GetReviewRotation returns the team's rotation with its overrides,
+	// failing with ErrNotFound if it has none.
+	GetReviewRotation(ctx context.Context, teamID int32) (*ReviewRotation, error)
+	// SetReviewRotation creates or changes the team's rotation, keeping its
+	// overrides.
+	SetReviewRotation(ctx context.Context, tx Tx, rotation *ReviewRotation) error
+	// DeleteReviewRotation removes the team's rotation with its overrides,
+	// failing with ErrNotFound if it has none.
+	DeleteReviewRotation(ctx context.Context, tx Tx, teamID int32) error
+	// SetRotationOverride makes userID the primary reviewer of the week
+	// starting weekStart; an empty userID drops the week's override.
+	SetRotationOverride(ctx context.Context, tx Tx, teamID int32, weekStart time.Time, userID string) error
+	// GetReviewerPool returns the IDs of the team's members reviewers are
+	// picked from, empty when they are picked from everyone.
+	GetReviewerPool(ctx context.Context, teamID int32) ([]string, error)
+	// SetReviewerPool replaces the team's reviewer pool.
+	SetReviewerPool(ctx context.Context, tx Tx, teamID int32, userIDs []string) error
+	// GetInactiveReassign reports whether reviewers of the team are swapped
+	// out after a period without review activity; teams do unless they opted out.
+	GetInactiveReassign(ctx context.Context, teamID int32) (bool, error)
+	SetInactiveReassign(ctx context.Context, tx Tx, teamID int32, enabled bool) error
+}
+
+type SettingsRepository interface {
+	// GetOrgSettings fails with ErrNotFound until the org-wide settings are
+	// saved for the first time.
+	GetOrgSettings(ctx context.Context) (*Settings, error)
+	SaveOrgSettings(ctx context.Context, tx Tx, s *Settings) (*Settings, error)
+	// GetTeamSettings fails with ErrNotFound if the team overrides nothing.
+	GetTeamSettings(ctx context.Context, teamID int32) (*TeamSettings, error)
+	// ListTeamSettings returns the overrides of every team, ordered by team name.
+	ListTeamSettings(ctx context.Context) ([]TeamSettings, error)
+	SetTeamSettings(ctx context.Context, tx Tx, s *TeamSettings) (*TeamSettings, error)
+	// DeleteTeamSettings fails with ErrNotFound if the team overrides nothing.
+	DeleteTeamSettings(ctx context.Context, tx Tx, teamID int32) error
+}
+
+type RepositoryRepository interface {
+	// CreateRepository and UpdateRepository store the routing rules along with the repository.
+	CreateRepository(ctx context.Context, tx Tx, repo *Repository) (*Repository, error)
+	UpdateRepository(ctx context.Context, tx Tx, repo *Repository) (*Repository, error)
+	GetRepository(ctx context.Context, name string) (*Repository, error)
+	ListRepositories(ctx context.Context) ([]Repository, error)
+	DeleteRepository(ctx context.Context, name string) error
+}
+
+type ReviewRuleRepository interface {
+	CreateReviewRule(ctx context.Context, tx Tx, rule *ReviewRule) (*ReviewRule, error)
+	UpdateReviewRule(ctx context.Context, tx Tx, rule *ReviewRule) (*ReviewRule, error)
+	GetReviewRule(ctx context.Context, name string) (*ReviewRule, error)
+	// ListReviewRules returns the rules in evaluation order.
+	ListReviewRules(ctx context.Context) ([]ReviewRule, error)
+	DeleteReviewRule(ctx context.Context, name string) error
+}
+
+type SavedFilterRepository interface {
+	CreateSavedFilter(ctx context.Context, tx Tx, filter *SavedFilter) (*SavedFilter, error)
+	UpdateSavedFilter(ctx context.Context, tx Tx, filter *SavedFilter) (*SavedFilter, error)
+	GetSavedFilter(ctx context.Context, id int64) (*SavedFilter, error)
+	// ListSavedFilters returns the filters of the user followed by those of
+	// the team, by name. An empty userID or a zero teamID matches nothing.
+	ListSavedFilters(ctx context.Context, userID string, teamID int32) ([]SavedFilter, error)
+	DeleteSavedFilter(ctx context.Context, id int64) error
+	// RenameSavedFilterTeam makes the filters selecting PRs of the team
+	// oldName select them by newName and returns how many it changed.
+	RenameSavedFilterTeam(ctx context.Context, tx Tx, oldName, newName string) (int, error)
+}
+
+type PRTemplateRepository interface {
+	CreatePRTemplate(ctx context.Context, tx Tx, template *PRTemplate) (*PRTemplate, error)
+	UpdatePRTemplate(ctx context.Context, tx Tx, template *PRTemplate) (*PRTemplate, error)
+	GetPRTemplate(ctx context.Context, id int64) (*PRTemplate, error)
+	// ListPRTemplates returns the templates of the team by name.
+	ListPRTemplates(ctx context.Context, teamID int32) ([]PRTemplate, error)
+	DeletePRTemplate(ctx context.Context, id int64) error
 }
 
 type UserRepository interface {
-	CreateUser(ctx context.Context, tx pgx.Tx, user *User) (*User, error)
+	CreateUser(ctx context.Context, tx Tx, user *User) (*User, error)
 	GetUserByID(ctx context.Context, userID string) (*User, error)
 	GetUsersByTeam(ctx context.Context, teamID int32) ([]User, error)
-	UpdateUser(ctx context.Context, tx pgx.Tx, user *User) (*User, error)
-	SetUserActiveStatus(ctx context.Context, tx pgx.Tx, userID string, isActive bool) (*User, error)
-	MoveUserToTeam(ctx context.Context, tx pgx.Tx, userID string, newTeamID int32) (*User, error)
-	DeactivateUsersByTeam(ctx context.Context, tx pgx.Tx, teamID int32) ([]string, error)
-	FindReviewCandidates(ctx context.Context, teamID int32, authorID string, excludeUserIDs []string, limit int) ([]User, error)
+	// GetUsersByUsername returns the users with the exact username, only from the
+	// named team unless teamName is empty.
+	GetUsersByUsername(ctx context.Context, username, teamName string) ([]User, error)
+	UpdateUser(ctx context.Context, tx Tx, user *User) (*User, error)
+	SetUserActiveStatus(ctx context.Context, tx Tx, userID string, isActive bool) (*User, error)
+	SetUserRole(ctx context.Context, tx Tx, userID, role string) (*User, error)
+	SetUserInTraining(ctx context.Context, tx Tx, userID string, inTraining bool) (*User, error)
+	SetUserSkills(ctx context.Context, tx Tx, userID string, skills []string) (*User, error)
+	MoveUserToTeam(ctx context.Context, tx Tx, userID string, newTeamID int32) (*User, error)
+	DeactivateUsersByTeam(ctx context.Context, tx Tx, teamID int32) ([]string, error)
+	GetActiveUsersByTeam(ctx context.Context, teamID int32) ([]User, error)
+	// GetOpenReviewCounts returns the open review counts of the users, as seen
+	// by tx when it is not nil; users without open reviews may be missing.
+	GetOpenReviewCounts(ctx context.Context, tx Tx, userIDs []string) (map[string]int, error)
+	// GetOpenAuthoredCounts returns how many open PRs the users authored; users
+	// without open PRs may be missing.
+	GetOpenAuthoredCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+	// GetReviewBudgetUsage returns the reviews the users were assigned in the
+	// current sprint of their team, as seen by tx when it is not nil; users
+	// without any may be missing.
+	GetReviewBudgetUsage(ctx context.Context, tx Tx, userIDs []string) (map[string]int, error)
+	// MergeUsers moves everything referencing sourceID to targetID and deletes the source user.
+	MergeUsers(ctx context.Context, tx Tx, sourceID, targetID string) (*UserMergeResult, error)
 }
 
 type PullRequestRepository interface {
-	CreatePR(ctx context.Context, tx pgx.Tx, pr *PullRequest) (*PullRequest, error)
+	CreatePR(ctx context.Context, tx Tx, pr *PullRequest) (*PullRequest, error)
 	GetPRByID(ctx context.Context, prID string) (*PullRequest, error)
-	MergePR(ctx context.Context, tx pgx.Tx, prID string) (*PullRequest, error)
+	// GetPRWithReviewers returns the PR with Reviewers, ApprovedBy,
+	// ChangesRequestedBy and Checklist filled in.
+	GetPRWithReviewers(ctx context.Context, prID string) (*PullRequest, error)
+	GetPRByExternalID(ctx context.Context, externalID string) (*PullRequest, error)
+	// GetPRTimeline returns the PR's lifecycle events, oldest first.
+	GetPRTimeline(ctx context.Context, prID string) ([]PREvent, error)
+	// MergePR merges the PR on behalf of mergedBy, which may be empty.
+	MergePR(ctx context.Context, tx Tx, prID, mergedBy string) (*PullRequest, error)
+	// MarkPRReady moves a draft PR to OPEN.
+	MarkPRReady(ctx context.Context, tx Tx, prID string) (*PullRequest, error)
+	// ClosePR closes the PR without merging it.
+	ClosePR(ctx context.Context, tx Tx, prID string) (*PullRequest, error)
 	GetReviewers(ctx context.Context, prID string) ([]User, error)
-	RemoveReviewer(ctx context.Context, tx pgx.Tx, prID string, userID string) error
-	AssignReviewers(ctx context.Context, tx pgx.Tx, prID string, userIDs []string) error
-	GetOpenPRsByReviewer(ctx context.Context, tx pgx.Tx, userID string) ([]PullRequest, error)
+	// GetRequiredReviewers returns the reviewers that are not optional.
+	GetRequiredReviewers(ctx context.Context, prID string) ([]User, error)
+	// RemoveReviewer reports whether the removed reviewer was optional.
+	RemoveReviewer(ctx context.Context, tx Tx, prID string, userID string) (optional bool, err error)
+	AssignReviewers(ctx context.Context, tx Tx, prID string, userIDs []string, optional bool) error
+	AddShadowReviewer(ctx context.Context, tx Tx, prID, userID string) error
+	GetOpenPRsByReviewer(ctx context.Context, tx Tx, userID string) ([]PullRequest, error)
 	GetPRsByReviewer(ctx context.Context, userID string) ([]PullRequest, error)
 	GetOpenPRsWithoutReviewers(ctx context.Context) ([]PullRequest, error)
+	// RemoveOpenReviewsByUsers takes the users off every open PR they review
+	// and returns the removed assignments with an empty ToUserID.
+	RemoveOpenReviewsByUsers(ctx context.Context, tx Tx, userIDs []string) ([]RebalanceMove, error)
+	// GetOpenPRsWithoutReviewersByIDs returns those of prIDs that are open and
+	// have no reviewers, more urgent first.
+	GetOpenPRsWithoutReviewersByIDs(ctx context.Context, tx Tx, prIDs []string) ([]PullRequest, error)
+	GetOpenAssignmentsForUsers(ctx context.Context, userIDs []string) ([]ReviewAssignment, error)
+	SearchPRs(ctx context.Context, query string, limit, offset int) ([]PRSearchHit, int, error)
+	// FilterPRs returns a page of the PRs matching the filter and how many match in total.
+	FilterPRs(ctx context.Context, filter PRFilter) ([]PullRequest, int, error)
+	ApproveReview(ctx context.Context, tx Tx, prID, userID string) error
+	// GetApprovalState counts the required reviewers and their approvals.
+	GetApprovalState(ctx context.Context, tx Tx, prID string) (approved, total int, err error)
+	GetApprovedReviewers(ctx context.Context, prID string) ([]string, error)
+	// RequestChanges records the reviewer's request for changes and withdraws
+	// their approval.
+	RequestChanges(ctx context.Context, tx Tx, prID, userID string) error
+	GetChangesRequestedReviewers(ctx context.Context, prID string) ([]string, error)
+	// GetRecentReviewers returns the reviewers of the author's last prCount PRs
+	// other than excludePRID.
+	GetRecentReviewers(ctx context.Context, authorID, excludePRID string, prCount int) ([]string, error)
+	// CountOpenPRsByAuthor counts the author's PRs that are neither merged nor
+	// closed. Within tx it locks the author first, so that concurrent counts
+	// for the author wait for each other's transaction to end.
+	CountOpenPRsByAuthor(ctx context.Context, tx Tx, authorID string) (int, error)
+	GetPendingReviews(ctx context.Context, userID string) ([]PendingReview, error)
+	ListStalledPRs(ctx context.Context, limit int) ([]StalledPR, error)
+	// MarkLeadNotified and MarkReviewerEscalated report false if the step was already taken.
+	MarkLeadNotified(ctx context.Context, tx Tx, prID string) (bool, error)
+	MarkReviewerEscalated(ctx context.Context, tx Tx, prID string) (bool, error)
+	SetAutoMerge(ctx context.Context, tx Tx, prID string, autoMerge bool) error
+	SetPriority(ctx context.Context, tx Tx, prID string, priority PRPriority) error
+	AckReview(ctx context.Context, prID, userID string) error
+	// RecordReassignment logs that fromUserID was taken off the PR; toUserID is
+	// empty when nobody replaced them, decline when they gave no reason. The
+	// actor in ctx, if any, is recorded on the log entry and on the PR.
+	RecordReassignment(ctx context.Context, tx Tx, prID, fromUserID, toUserID string, reason ReassignmentReason, decline DeclineReason) error
+	RecordReassignments(ctx context.Context, tx Tx, moves []RebalanceMove, reason ReassignmentReason) error
+	CreateChecklist(ctx context.Context, tx Tx, prID string, labels []string) error
+	GetChecklist(ctx context.Context, prID string) ([]ChecklistItem, error)
+	// SetChecklistItem ticks or unticks an item on behalf of userID.
+	SetChecklistItem(ctx context.Context, tx Tx, prID string, position int, userID string, checked bool) error
+	// ListUnackedReviews returns unacknowledged assignments made before
+	// remindBefore that were not reminded yet, or made before reassignBefore.
+	// A zero time disables the corresponding condition.
+	ListUnackedReviews(ctx context.Context, remindBefore, reassignBefore time.Time, limit int) ([]UnackedReview, error)
+	// MarkAckReminded reports false if the reviewer was already reminded.
+	MarkAckReminded(ctx context.Context, tx Tx, prID, userID string) (bool, error)
+	// ListInactiveReviews returns unfinished assignments whose reviewer has
+	// not acted on them since before inactiveSince, skipping teams that opted
+	// out of inactivity reassignment.
+	ListInactiveReviews(ctx context.Context, inactiveSince time.Time, limit int) ([]InactiveReview, error)
 }
 
 type StatsRepository interface {
-	GetReviewStats(ctx context.Context) ([]StatItem, error)
+	// GetReviewStats returns a page of the users with reviews and how many
+	// there are in total; a zero limit returns all of them.
+	GetReviewStats(ctx context.Context, order StatsOrder, limit, offset int) ([]StatItem, int, error)
 	GetOpenReviewCountForTeam(ctx context.Context, teamName string) (int, error)
 	GetMergedReviewCountForTeam(ctx context.Context, teamName string) (int, error)
 	GetOpenReviewCountForUser(ctx context.Context, userID string) (int, error)
 	GetMergedReviewCountForUser(ctx context.Context, userID string) (int, error)
+	// GetMemberReviewCounts lists active members of active teams, or of teamName
+	// only when it is set, with the reviews they were assigned in [since, until).
+	GetMemberReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]MemberReviewCount, error)
+	// GetReassignmentCounts groups reassignments in [since, until) by team,
+	// removed reviewer and reason, for teamName only when it is set.
+	GetReassignmentCounts(ctx context.Context, teamName string, since, until time.Time) ([]ReassignmentCount, error)
+	// GetMergeCounts groups PRs merged in [since, until) by who merged them,
+	// most merges first; MergedBy is empty for unattributed merges.
+	GetMergeCounts(ctx context.Context, since, until time.Time) ([]MergeCount, error)
+	// GetShadowReviewCounts lists the users of active teams, or of teamName
+	// only when it is set, who are in training or got shadow reviews in
+	// [since, until), with how many they got.
+	GetShadowReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]ShadowReviewCount, error)
+	// GetUnassignedCounts returns, per team and ordered by team and day, the
+	// UTC days from since to until on which some open PR had no reviewers.
+	GetUnassignedCounts(ctx context.Context, teamName string, since, until time.Time) ([]UnassignedCount, error)
+	// GetThroughputCounts returns, ordered by metric and bucket, the non-empty
+	// buckets of the metrics within [since, until), for teamName only when it
+	// is set.
+	GetThroughputCounts(ctx context.Context, teamName string, metrics []ThroughputMetric, interval SeriesInterval, since, until time.Time) ([]ThroughputCount, error)
+	GetRepositoryStats(ctx context.Context, repositoryName string) (*RepositoryStats, error)
+	// GetReviewerTurnaround measures the user's reviews assigned in [since, until).
+	GetReviewerTurnaround(ctx context.Context, userID string, since, until time.Time) (*ReviewerTurnaround, error)
+	// GetAuthorStats summarizes the PRs userID opened.
+	GetAuthorStats(ctx context.Context, userID string) (*AuthorStats, error)
+	// GetOrgSummary counts the PRs and users of the whole org, with the PRs
+	// merged in [since, until).
+	GetOrgSummary(ctx context.Context, since, until time.Time) (*OrgSummary, error)
+	// GetTeamMergedPRs lists the PRs by the team's members merged in [since,
+	// until), archived ones included, oldest merge first.
+	GetTeamMergedPRs(ctx context.Context, teamName string, since, until time.Time) ([]ReportPR, error)
+	// GetTeamSLABreaches lists the PRs by the team's members first escalated
+	// in [since, until).
+	GetTeamSLABreaches(ctx context.Context, teamName string, since, until time.Time) ([]ReportPR, error)
+	// SnapshotStats records the review counters of every user and team for
+	// day and reports false if the day was already recorded.
+	SnapshotStats(ctx context.Context, tx Tx, day time.Time) (bool, error)
+	// GetUserStatsHistory returns the days recorded for the user within
+	// [since, until], oldest first. The history outlives the user; ErrNotFound
+	// is returned only for users that neither exist nor have any history.
+	GetUserStatsHistory(ctx context.Context, userID string, since, until time.Time) ([]UserStatsSnapshot, error)
+	// GetTeamStatsHistory returns the days recorded for the team within
+	// [since, until], oldest first.
+	GetTeamStatsHistory(ctx context.Context, teamName string, since, until time.Time) ([]TeamStatsSnapshot, error)
+}
+
+type DumpRepository interface {
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListUsers(ctx context.Context) ([]User, error)
+	ListPRsWithReviewers(ctx context.Context) ([]PullRequest, error)
+	ImportTeam(ctx context.Context, tx Tx, team *Team) (*Team, error)
+	ImportUser(ctx context.Context, tx Tx, user *User) error
+	ImportPR(ctx context.Context, tx Tx, pr *PullRequest) error
+	AssignReviewers(ctx context.Context, tx Tx, prID string, userIDs []string, optional bool) error
+	ApproveReview(ctx context.Context, tx Tx, prID, userID string) error
+}
+
+type ArchiveRepository interface {
+	ListMergedPRIDsBefore(ctx context.Context, before time.Time, limit int) ([]string, error)
+	ArchivePRs(ctx context.Context, tx Tx, prIDs []string) (int, error)
+}
+
+type GitHubRepository interface {
+	SetGitHubLogin(ctx context.Context, tx Tx, userID, login string) (*GitHubAccount, error)
+	GetGitHubAccountsByUserIDs(ctx context.Context, userIDs []string) ([]GitHubAccount, error)
+	GetGitHubAccountsByLogins(ctx context.Context, logins []string) ([]GitHubAccount, error)
+	LinkGitHubPR(ctx context.Context, tx Tx, link *GitHubPRLink) (*GitHubPRLink, error)
+	GetGitHubPRLink(ctx context.Context, prID string) (*GitHubPRLink, error)
+	GetGitHubPRLinkByNumber(ctx context.Context, repository string, number int) (*GitHubPRLink, error)
+	UpsertGitHubInstallation(ctx context.Context, tx Tx, installation *GitHubInstallation) error
+	DeleteGitHubInstallation(ctx context.Context, tx Tx, installationID int64) error
+	GetGitHubInstallationByAccount(ctx context.Context, login string) (*GitHubInstallation, error)
+	SaveGitHubInstallationToken(ctx context.Context, installationID int64, token string, expiresAt time.Time) error
+	AddGitHubRepos(ctx context.Context, tx Tx, installationID int64, repositories []string) error
+	RemoveGitHubRepos(ctx context.Context, tx Tx, repositories []string) error
+	SetGitHubRepoTeam(ctx context.Context, tx Tx, repository string, teamID int32) error
+	GetGitHubRepo(ctx context.Context, repository string) (*GitHubRepo, error)
+	ListGitHubRepos(ctx context.Context) ([]GitHubRepo, error)
+	// SetGitHubIngestionToken sets the secret of token.Repository, replacing
+	// the team's previous one. ErrRepoExists is returned when the repository
+	// has a secret of another team.
+	SetGitHubIngestionToken(ctx context.Context, tx Tx, token *GitHubIngestionToken) (*GitHubIngestionToken, error)
+	// GetGitHubIngestionToken returns the token with its secret.
+	GetGitHubIngestionToken(ctx context.Context, repository string) (*GitHubIngestionToken, error)
+	ListGitHubIngestionTokens(ctx context.Context) ([]GitHubIngestionToken, error)
+	DeleteGitHubIngestionToken(ctx context.Context, tx Tx, repository string) error
+	// TouchGitHubIngestionToken records that the token authenticated a delivery.
+	TouchGitHubIngestionToken(ctx context.Context, repository string) error
+	ListGitHubTeamLinks(ctx context.Context, org string) ([]GitHubTeamLink, error)
+	LinkGitHubTeam(ctx context.Context, tx Tx, link *GitHubTeamLink) error
+	// SaveGitHubTeamSyncReport stores the report, setting its ID, and drops
+	// the oldest reports beyond the ones kept.
+	SaveGitHubTeamSyncReport(ctx context.Context, tx Tx, report *GitHubTeamSyncReport) error
+	GetLatestGitHubTeamSyncReport(ctx context.Context) (*GitHubTeamSyncReport, error)
+}
+
+type SlackRepository interface {
+	SetSlackUserID(ctx context.Context, tx Tx, userID, slackUserID string) (*SlackAccount, error)
+	// GetSlackAccountBySlackID returns ErrNotFound when the Slack user is not
+	// linked to anyone.
+	GetSlackAccountBySlackID(ctx context.Context, slackUserID string) (*SlackAccount, error)
+}
+
+// GitHubDirectory is the subset of the GitHub REST API used to sync teams
+// from an organization.
+type GitHubDirectory interface {
+	ListOrgTeams(ctx context.Context, org string) ([]GitHubTeam, error)
+	// ListTeamMembers returns the logins of the team's members, including
+	// members of its child teams.
+	ListTeamMembers(ctx context.Context, org, slug string) ([]string, error)
+}
+
+// GitHubClient is the subset of the GitHub REST API used to mirror review requests.
+type GitHubClient interface {
+	ListRequestedReviewers(ctx context.Context, repository string, number int) ([]string, error)
+	RequestReviewers(ctx context.Context, repository string, number int, logins []string) error
+	RemoveReviewRequests(ctx context.Context, repository string, number int, logins []string) error
+}
+
+// ReviewNotifier is told about PRs whose reviewers changed, after the change is
+// committed. added lists the newly assigned reviewers.
+type ReviewNotifier interface {
+	ReviewersChanged(ctx context.Context, prID string, added []string)
+}
+
+// NoCandidateObserver is told when no reviewer could be found for a PR
+// reviewed by the team, whether or not the change is committed.
+type NoCandidateObserver interface {
+	NoCandidate(ctx context.Context, teamID int32, prID string)
+}
+
+// PRObserver is told about committed changes to PRs.
+type PRObserver interface {
+	PRChanged(ctx context.Context, prID string, change PRUpdateType)
+}
+
+type NotificationRepository interface {
+	GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	SetNotificationPreferences(ctx context.Context, tx Tx, prefs *NotificationPreferences) (*NotificationPreferences, error)
+	EnqueueNotification(ctx context.Context, tx Tx, n *Notification, deliverAfter time.Time) error
+	ClaimDueNotifications(ctx context.Context, tx Tx, limit int) ([]Notification, error)
+	MarkNotificationSent(ctx context.Context, tx Tx, id int64) error
+	MarkNotificationFailed(ctx context.Context, tx Tx, id int64, reason string, retryAt time.Time) error
+	// MarkNotificationDeadLettered records the last failed attempt and stops
+	// further deliveries.
+	MarkNotificationDeadLettered(ctx context.Context, tx Tx, id int64, reason string) error
+	ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error)
+	ClaimDueDigests(ctx context.Context, tx Tx, limit int) ([]NotificationPreferences, error)
+	MarkDigestSent(ctx context.Context, tx Tx, userID string) error
+	// ReplayNotifications queues copies of the delivered or abandoned notifications
+	// matching the filter and returns how many were queued.
+	ReplayNotifications(ctx context.Context, tx Tx, filter NotificationFilter) (int, error)
+}
+
+type WebhookDeliveryRepository interface {
+	CreateWebhookDelivery(ctx context.Context, d *WebhookDelivery) (*WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]WebhookDelivery, error)
+	// HasWebhookTargets reports whether any user is notified through the
+	// webhook channel.
+	HasWebhookTargets(ctx context.Context) (bool, error)
+}
+
+type InboundWebhookRepository interface {
+	CreateInboundWebhook(ctx context.Context, w *InboundWebhook) (*InboundWebhook, error)
+	GetInboundWebhook(ctx context.Context, id int64) (*InboundWebhook, error)
+	ListInboundWebhooks(ctx context.Context, filter InboundWebhookFilter) ([]InboundWebhook, error)
+	// UpdateInboundWebhookResult records the outcome of processing a received
+	// webhook again.
+	UpdateInboundWebhookResult(ctx context.Context, id int64, status InboundWebhookStatus, errMsg string) (*InboundWebhook, error)
+}
+
+// WebhookSender posts a recorded delivery's payload to its URL again and
+// returns the new attempt.
+type WebhookSender interface {
+	Redeliver(ctx context.Context, d *WebhookDelivery) (*WebhookDelivery, error)
+}
+
+// NotificationChannel delivers a notification to a user, e.g. by email or chat.
+type NotificationChannel interface {
+	Send(ctx context.Context, prefs *NotificationPreferences, n *Notification) error
 }
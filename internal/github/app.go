@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// tokenRefreshMargin makes installation tokens be renewed a bit before they
+// expire, so a token is never used right at its expiry.
+const tokenRefreshMargin = 5 * time.Minute
+
+// InstallationStore keeps GitHub App installations and their cached tokens.
+type InstallationStore interface {
+	GetGitHubInstallationByAccount(ctx context.Context, login string) (*domain.GitHubInstallation, error)
+	SaveGitHubInstallationToken(ctx context.Context, installationID int64, token string, expiresAt time.Time) error
+}
+
+// AppTokens issues installation access tokens for accounts the GitHub App is
+// installed on. Tokens are cached in the store until shortly before expiry.
+type AppTokens struct {
+	appID   int64
+	key     *rsa.PrivateKey
+	store   InstallationStore
+	baseURL string
+	http    *http.Client
+}
+
+func NewAppTokens(baseURL string, appID int64, key *rsa.PrivateKey, store InstallationStore) *AppTokens {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &AppTokens{
+		appID:   appID,
+		key:     key,
+		store:   store,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *AppTokens) Token(ctx context.Context, owner string) (string, error) {
+	inst, err := a.store.GetGitHubInstallationByAccount(ctx, owner)
+	if err != nil {
+		return "", err
+	}
+	if inst.Token != "" && time.Until(inst.TokenExpiresAt) > tokenRefreshMargin {
+		return inst.Token, nil
+	}
+
+	token, expiresAt, err := a.createInstallationToken(ctx, inst.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := a.store.SaveGitHubInstallationToken(ctx, inst.ID, token, expiresAt); err != nil {
+		return "", fmt.Errorf("failed to store installation token: %w", err)
+	}
+	return token, nil
+}
+
+func (a *AppTokens) createInstallationToken(ctx context.Context, installationID int64) (string, time.Time, error) {
+	jwt, err := a.appJWT(time.Now())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	path := fmt.Sprintf("/app/installations/%d/access_tokens", installationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+path, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to build GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("GitHub POST %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return "", time.Time{}, fmt.Errorf("GitHub POST %s: %s", path, resp.Status)
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode GitHub response: %w", err)
+	}
+	return body.Token, body.ExpiresAt, nil
+}
+
+// appJWT signs the short-lived RS256 token that authenticates the app itself.
+// iat is backdated to allow for clock drift, as GitHub recommends.
+func (a *AppTokens) appJWT(now time.Time) (string, error) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(a.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign app JWT: %w", err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// ParsePrivateKey reads the PEM private key downloaded from the GitHub App
+// settings (PKCS#1), also accepting PKCS#8.
+func ParsePrivateKey(pemData []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("no PEM block found in private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// ChainTokens tries each source in order and returns the first token found.
+type ChainTokens []TokenSource
+
+func (c ChainTokens) Token(ctx context.Context, owner string) (string, error) {
+	var errs []error
+	for _, src := range c {
+		token, err := src.Token(ctx, owner)
+		if err == nil {
+			return token, nil
+		}
+		errs = append(errs, err)
+	}
+	return "", errors.Join(errs...)
+}
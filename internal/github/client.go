@@ -0,0 +1,234 @@
+// Package github is a minimal GitHub REST API client for mirroring review
+// requests and syncing organization teams.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const (
+	DefaultBaseURL = "https://api.github.com"
+	apiVersion     = "2022-11-28"
+)
+
+// TokenSource returns the API token to use for repositories of the given owner
+// (organization or user).
+type TokenSource interface {
+	Token(ctx context.Context, owner string) (string, error)
+}
+
+// StaticTokens maps owners to personal access tokens. The "*" entry is used
+// for owners without their own token.
+type StaticTokens map[string]string
+
+func (t StaticTokens) Token(_ context.Context, owner string) (string, error) {
+	if token, ok := t[strings.ToLower(owner)]; ok {
+		return token, nil
+	}
+	if token, ok := t["*"]; ok {
+		return token, nil
+	}
+	return "", fmt.Errorf("no GitHub token configured for %q", owner)
+}
+
+// ParseStaticTokens parses "owner=token,owner2=token2". A bare token without
+// an owner becomes the default for all owners.
+func ParseStaticTokens(s string) (StaticTokens, error) {
+	tokens := make(StaticTokens)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		owner, token, found := strings.Cut(part, "=")
+		if !found {
+			owner, token = "*", part
+		}
+		owner, token = strings.ToLower(strings.TrimSpace(owner)), strings.TrimSpace(token)
+		if owner == "" || token == "" {
+			return nil, fmt.Errorf("invalid GitHub token entry %q", part)
+		}
+		tokens[owner] = token
+	}
+	return tokens, nil
+}
+
+type Client struct {
+	baseURL string
+	tokens  TokenSource
+	http    *http.Client
+}
+
+func NewClient(baseURL string, tokens TokenSource) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		tokens:  tokens,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Ping checks that the API answers, for readiness checks. It calls the rate
+// limit endpoint, which does not count against the limit, without a token:
+// any answer short of a server error means GitHub is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/rate_limit", nil)
+	if err != nil {
+		return fmt.Errorf("failed to build GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub GET /rate_limit: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("GitHub GET /rate_limit: %s", resp.Status)
+	}
+	return nil
+}
+
+type reviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+// ListRequestedReviewers returns the logins of users whose review is still pending.
+func (c *Client) ListRequestedReviewers(ctx context.Context, repository string, number int) ([]string, error) {
+	var resp struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+	}
+	if err := c.do(ctx, http.MethodGet, repository, requestedReviewersPath(repository, number), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	logins := make([]string, len(resp.Users))
+	for i, u := range resp.Users {
+		logins[i] = u.Login
+	}
+	return logins, nil
+}
+
+func (c *Client) RequestReviewers(ctx context.Context, repository string, number int, logins []string) error {
+	return c.do(ctx, http.MethodPost, repository, requestedReviewersPath(repository, number), reviewersRequest{Reviewers: logins}, nil)
+}
+
+func (c *Client) RemoveReviewRequests(ctx context.Context, repository string, number int, logins []string) error {
+	return c.do(ctx, http.MethodDelete, repository, requestedReviewersPath(repository, number), reviewersRequest{Reviewers: logins}, nil)
+}
+
+// pageSize is the largest page the GitHub API returns.
+const pageSize = 100
+
+// ListOrgTeams returns every team of the organization.
+func (c *Client) ListOrgTeams(ctx context.Context, org string) ([]domain.GitHubTeam, error) {
+	var teams []domain.GitHubTeam
+	for page := 1; ; page++ {
+		var resp []struct {
+			ID   int64  `json:"id"`
+			Slug string `json:"slug"`
+			Name string `json:"name"`
+		}
+		path := fmt.Sprintf("/orgs/%s/teams?per_page=%d&page=%d", url.PathEscape(org), pageSize, page)
+		if err := c.do(ctx, http.MethodGet, org, path, nil, &resp); err != nil {
+			return nil, err
+		}
+		for _, t := range resp {
+			teams = append(teams, domain.GitHubTeam{ID: t.ID, Slug: t.Slug, Name: t.Name})
+		}
+		if len(resp) < pageSize {
+			return teams, nil
+		}
+	}
+}
+
+// ListTeamMembers returns the logins of the team's members, including the
+// members of its child teams.
+func (c *Client) ListTeamMembers(ctx context.Context, org, slug string) ([]string, error) {
+	var logins []string
+	for page := 1; ; page++ {
+		var resp []struct {
+			Login string `json:"login"`
+		}
+		path := fmt.Sprintf("/orgs/%s/teams/%s/members?per_page=%d&page=%d", url.PathEscape(org), url.PathEscape(slug), pageSize, page)
+		if err := c.do(ctx, http.MethodGet, org, path, nil, &resp); err != nil {
+			return nil, err
+		}
+		for _, u := range resp {
+			logins = append(logins, u.Login)
+		}
+		if len(resp) < pageSize {
+			return logins, nil
+		}
+	}
+}
+
+func requestedReviewersPath(repository string, number int) string {
+	return fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", repository, number)
+}
+
+// do calls the API with the token for the owner of the repository, which may
+// also be given as just the owner.
+func (c *Client) do(ctx context.Context, method, repository, path string, body, out any) error {
+	owner, _, _ := strings.Cut(repository, "/")
+	token, err := c.tokens.Token(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode GitHub request: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to build GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&apiErr)
+		return fmt.Errorf("GitHub %s %s: %s: %s", method, path, resp.Status, apiErr.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode GitHub response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// accessLog writes an http.request record for every request with its
+// latency, status and body sizes. Only the sampled share of successful
+// requests is logged, so health probes and busy read endpoints do not flood
+// the logs; failed requests (status 400 and above) are always logged.
+func (h *Handler) accessLog(tunables *Tunables) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			sampleRate := tunables.AccessLogSampleRate()
+			status := ww.Status()
+			if status == 0 {
+				// Nothing was written, net/http answers 200
+				status = http.StatusOK
+			}
+			if status < http.StatusBadRequest && !sampled(sampleRate) {
+				return
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelWarn
+			}
+			attrs := []slog.Attr{
+				slog.String("event", "http.request"),
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+				slog.Int64("request_bytes", max(r.ContentLength, 0)),
+				slog.Int("response_bytes", ww.BytesWritten()),
+				slog.String("remote_addr", r.RemoteAddr),
+			}
+			// withActor runs further down the chain, so the actor is not in
+			// this request's context yet
+			if actorID := strings.TrimSpace(r.Header.Get(actorHeader)); actorID != "" && len(actorID) <= maxActorIDLength {
+				attrs = append(attrs, slog.String("actor_id", actorID))
+			}
+			if sampleRate < 1 && status < http.StatusBadRequest {
+				attrs = append(attrs, slog.Float64("sample_rate", sampleRate))
+			}
+			h.log.LogAttrs(r.Context(), level, "http request", attrs...)
+		})
+	}
+}
+
+func sampled(rate float64) bool {
+	return rate >= 1 || rate > 0 && rand.Float64() < rate
+}
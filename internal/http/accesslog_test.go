@@ -0,0 +1,64 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAccessLogSampling(t *testing.T) {
+	var buf bytes.Buffer
+	h := &Handler{log: slog.New(slog.NewJSONHandler(&buf, nil))}
+	handler := h.accessLog(NewTunables(0, 0))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	// Successful requests are dropped at a zero sample rate
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	if buf.Len() != 0 {
+		t.Fatalf("successful request was logged: %s", buf.String())
+	}
+
+	// but failed ones are always logged
+	req := httptest.NewRequest(http.MethodPost, "/missing", strings.NewReader(`{"a":1}`))
+	req.Header.Set(actorHeader, "lead-1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("failed to decode log record %q: %v", buf.String(), err)
+	}
+	want := map[string]any{
+		"event":         "http.request",
+		"method":        "POST",
+		"path":          "/missing",
+		"status":        float64(http.StatusNotFound),
+		"request_bytes": float64(7),
+		"actor_id":      "lead-1",
+	}
+	for k, v := range want {
+		if rec[k] != v {
+			t.Errorf("%s = %v, want %v", k, rec[k], v)
+		}
+	}
+	if _, ok := rec["duration_ms"]; !ok {
+		t.Error("duration_ms is missing")
+	}
+}
+
+func TestSampled(t *testing.T) {
+	if !sampled(1) {
+		t.Error("rate 1 must log every request")
+	}
+	if sampled(0) {
+		t.Error("rate 0 must not log successful requests")
+	}
+}
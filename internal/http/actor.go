@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+const (
+	actorHeader      = "X-Actor-Id"
+	maxActorIDLength = 100
+)
+
+// withActor attributes the request to the actor named in X-Actor-Id and logs
+// every mutating request as an api.mutation audit event. Requests without the
+// header are handled anonymously.
+func (h *Handler) withActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID := strings.TrimSpace(r.Header.Get(actorHeader))
+		if len(actorID) > maxActorIDLength {
+			h.respondError(w, r, api.VALIDATIONERROR, "X-Actor-Id is too long", http.StatusBadRequest)
+			return
+		}
+		ctx := r.Context()
+		if actorID != "" {
+			ctx = domain.WithActor(ctx, actorID)
+			r = r.WithContext(ctx)
+		}
+
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+		h.log.InfoContext(ctx, "api mutation",
+			"event", "api.mutation",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", ww.Status(),
+		)
+	})
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+//go:embed static/swagger.html
+var swaggerUIPage []byte
+
+// openAPISpecHandler serves the OpenAPI document embedded into pkg/api by oapi-codegen.
+// The spec is decoded and marshaled once, on the first request.
+func openAPISpecHandler() http.HandlerFunc {
+	var (
+		once sync.Once
+		spec []byte
+		err  error
+	)
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			swagger, loadErr := api.GetSwagger()
+			if loadErr != nil {
+				err = loadErr
+				return
+			}
+			spec, err = json.Marshal(swagger)
+		})
+		if err != nil {
+			http.Error(w, "failed to load OpenAPI spec", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	}
+}
+
+func swaggerUIHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(swaggerUIPage)
+}
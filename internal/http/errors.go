@@ -0,0 +1,109 @@
+package http
+
+import (
+	_ "embed"
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/go-chi/render"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// errorDocsPath is the page documenting the codes for people; each code has
+// its entry under the fragment of its name. GET /errors lists the same codes
+// as JSON.
+const errorDocsPath = "/docs/errors"
+
+//go:embed static/errors.html
+var errorDocsSource string
+
+var errorDocsPage = template.Must(template.New("errors").Parse(errorDocsSource))
+
+// apiError ties a domain error to the code and HTTP status clients see.
+type apiError struct {
+	err         error
+	code        api.ErrorResponseErrorCode
+	status      int
+	description string
+}
+
+// errorRegistry lists every error code of the API. serviceError picks the
+// first entry whose err matches, so an error wrapping several sentinels gets
+// the earliest one: a timeout wins over whatever it interrupted. Entries
+// without err are set by the HTTP layer itself; INTERNAL_ERROR is the
+// fallback for anything unmatched.
+var errorRegistry = []apiError{
+	{domain.ErrTimeout, api.TIMEOUT, http.StatusGatewayTimeout, "Запрос не уложился в отведённое время; его можно повторить"},
+	{domain.ErrNotFound, api.NOTFOUND, http.StatusNotFound, "Ресурс не найден"},
+	{domain.ErrTeamExists, api.TEAMEXISTS, http.StatusConflict, "Команда с таким именем уже существует"},
+	{domain.ErrUsernameExists, api.USERNAMEEXISTS, http.StatusConflict, "Имя пользователя уже занято"},
+	{domain.ErrRepoExists, api.REPOSITORYEXISTS, http.StatusConflict, "Репозиторий уже зарегистрирован"},
+	{domain.ErrRuleExists, api.REVIEWRULEEXISTS, http.StatusConflict, "Правило ревью с таким шаблоном пути уже есть"},
+	{domain.ErrFilterExists, api.SAVEDFILTEREXISTS, http.StatusConflict, "Сохранённый фильтр с таким именем уже есть"},
+	{domain.ErrTemplateExists, api.PRTEMPLATEEXISTS, http.StatusConflict, "Шаблон PR с таким именем уже есть у команды"},
+	{domain.ErrPRExists, api.PREXISTS, http.StatusConflict, "PR с таким идентификатором уже существует"},
+	{domain.ErrPRMerged, api.PRMERGED, http.StatusConflict, "PR уже слит и не может быть изменён"},
+	{domain.ErrPRClosed, api.PRCLOSED, http.StatusConflict, "PR закрыт; переоткройте его, чтобы изменить"},
+	{domain.ErrInvalidTransition, api.INVALIDSTATUSTRANSITION, http.StatusConflict, "Переход PR в запрошенный статус из текущего невозможен"},
+	{domain.ErrNotAssigned, api.NOTASSIGNED, http.StatusConflict, "Пользователь не назначен ревьюером этого PR"},
+	{domain.ErrNoCandidate, api.NOCANDIDATE, http.StatusConflict, "Нет подходящего кандидата в ревьюеры"},
+	{domain.ErrValidation, api.VALIDATIONERROR, http.StatusBadRequest, "Запрос не прошёл проверку; details перечисляет ошибки по полям"},
+	{domain.ErrUserNotActive, api.USERNOTACTIVE, http.StatusConflict, "Пользователь неактивен"},
+	{domain.ErrReviewRequirementsNotMet, api.REVIEWREQUIREMENTSNOTMET, http.StatusConflict, "PR не набрал одобрений, требуемых правилами ревью"},
+	{domain.ErrUnauthorized, api.UNAUTHORIZED, http.StatusUnauthorized, "Запрос не аутентифицирован"},
+	{domain.ErrTxConflict, api.CONCURRENTUPDATE, http.StatusConflict, "Данные одновременно изменил другой запрос; повторите запрос"},
+	{domain.ErrOpenReviews, api.HASOPENREVIEWS, http.StatusConflict, "У пользователя остались открытые ревью"},
+	{domain.ErrTooManyOpenPRs, api.TOOMANYOPENPRS, http.StatusConflict, "Автор превысил квоту открытых PR своей команды"},
+	{nil, api.INTERNALERROR, http.StatusInternalServerError, "Внутренняя ошибка сервиса; сообщите request_id в поддержку"},
+}
+
+// lookupError returns the registry entry for err, INTERNAL_ERROR when no
+// entry matches.
+func lookupError(err error) apiError {
+	for _, e := range errorRegistry {
+		if e.err != nil && errors.Is(err, e.err) {
+			return e
+		}
+	}
+	return lookupCode(api.INTERNALERROR)
+}
+
+func lookupCode(code api.ErrorResponseErrorCode) apiError {
+	for _, e := range errorRegistry {
+		if e.code == code {
+			return e
+		}
+	}
+	return apiError{code: code, status: http.StatusInternalServerError}
+}
+
+func errorDocsURL(code api.ErrorResponseErrorCode) string {
+	return errorDocsPath + "#" + string(code)
+}
+
+func errorCodeInfos() []api.ErrorCodeInfo {
+	infos := make([]api.ErrorCodeInfo, len(errorRegistry))
+	for i, e := range errorRegistry {
+		infos[i] = api.ErrorCodeInfo{
+			Code:        string(e.code),
+			HttpStatus:  e.status,
+			Description: e.description,
+			DocsUrl:     errorDocsURL(e.code),
+		}
+	}
+	return infos
+}
+
+func (h *Handler) GetErrors(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, map[string][]api.ErrorCodeInfo{"errors": errorCodeInfos()})
+}
+
+// errorDocsHandler serves the error codes as an HTML page, which docs_url of
+// error responses links to.
+func errorDocsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = errorDocsPage.Execute(w, errorCodeInfos())
+}
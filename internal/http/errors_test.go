@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// TestErrorRegistryCoversSpec checks that the registry and the code enum of
+// ErrorResponse in the OpenAPI spec list the same codes, each once.
+func TestErrorRegistryCoversSpec(t *testing.T) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+	codeSchema := spec.Components.Schemas["ErrorResponse"].Value.Properties["error"].Value.Properties["code"].Value
+
+	registered := make(map[string]int)
+	for _, e := range errorRegistry {
+		registered[string(e.code)]++
+	}
+	for code, n := range registered {
+		if n != 1 {
+			t.Errorf("code %s is registered %d times", code, n)
+		}
+	}
+
+	inSpec := make(map[string]bool)
+	for _, v := range codeSchema.Enum {
+		code := v.(string)
+		inSpec[code] = true
+		if registered[code] == 0 {
+			t.Errorf("code %s from the spec is not registered", code)
+		}
+	}
+	for code := range registered {
+		if !inSpec[code] {
+			t.Errorf("registered code %s is missing from the spec", code)
+		}
+	}
+}
+
+func TestErrorRegistryEntries(t *testing.T) {
+	for _, e := range errorRegistry {
+		if e.description == "" {
+			t.Errorf("code %s has no description", e.code)
+		}
+		if e.status < 400 || e.status > 599 {
+			t.Errorf("code %s has non-error status %d", e.code, e.status)
+		}
+		// Every sentinel must map to its own entry, not one listed earlier
+		if e.err != nil {
+			if got := lookupError(e.err); got.code != e.code {
+				t.Errorf("%v maps to %s instead of %s", e.err, got.code, e.code)
+			}
+		}
+	}
+}
+
+func TestLookupError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   api.ErrorResponseErrorCode
+		status int
+	}{
+		{"not found", domain.ErrNotFound, api.NOTFOUND, http.StatusNotFound},
+		{"team exists", domain.ErrTeamExists, api.TEAMEXISTS, http.StatusConflict},
+		{"username exists", domain.ErrUsernameExists, api.USERNAMEEXISTS, http.StatusConflict},
+		{"repository exists", domain.ErrRepoExists, api.REPOSITORYEXISTS, http.StatusConflict},
+		{"rule exists", domain.ErrRuleExists, api.REVIEWRULEEXISTS, http.StatusConflict},
+		{"filter exists", domain.ErrFilterExists, api.SAVEDFILTEREXISTS, http.StatusConflict},
+		{"template exists", domain.ErrTemplateExists, api.PRTEMPLATEEXISTS, http.StatusConflict},
+		{"pr exists", domain.ErrPRExists, api.PREXISTS, http.StatusConflict},
+		{"pr merged", domain.ErrPRMerged, api.PRMERGED, http.StatusConflict},
+		{"pr closed", domain.ErrPRClosed, api.PRCLOSED, http.StatusConflict},
+		{"invalid transition", domain.ErrInvalidTransition, api.INVALIDSTATUSTRANSITION, http.StatusConflict},
+		{"not assigned", domain.ErrNotAssigned, api.NOTASSIGNED, http.StatusConflict},
+		{"no candidate", domain.ErrNoCandidate, api.NOCANDIDATE, http.StatusConflict},
+		{"validation", domain.ErrValidation, api.VALIDATIONERROR, http.StatusBadRequest},
+		{"user not active", domain.ErrUserNotActive, api.USERNOTACTIVE, http.StatusConflict},
+		{"requirements not met", domain.ErrReviewRequirementsNotMet, api.REVIEWREQUIREMENTSNOTMET, http.StatusConflict},
+		{"unauthorized", domain.ErrUnauthorized, api.UNAUTHORIZED, http.StatusUnauthorized},
+		{"tx conflict", domain.ErrTxConflict, api.CONCURRENTUPDATE, http.StatusConflict},
+		{"open reviews", domain.ErrOpenReviews, api.HASOPENREVIEWS, http.StatusConflict},
+		{"too many open prs", domain.ErrTooManyOpenPRs, api.TOOMANYOPENPRS, http.StatusConflict},
+		{"timeout", domain.ErrTimeout, api.TIMEOUT, http.StatusGatewayTimeout},
+		{"wrapped", fmt.Errorf("%w: name is required", domain.ErrValidation), api.VALIDATIONERROR, http.StatusBadRequest},
+		{"timeout wins", fmt.Errorf("%w: %w", domain.ErrTimeout, domain.ErrNotFound), api.TIMEOUT, http.StatusGatewayTimeout},
+		{"internal", domain.ErrInternalError, api.INTERNALERROR, http.StatusInternalServerError},
+		{"unknown", errors.New("boom"), api.INTERNALERROR, http.StatusInternalServerError},
+		{"nil", nil, api.INTERNALERROR, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lookupError(tt.err)
+			if got.code != tt.code || got.status != tt.status {
+				t.Errorf("lookupError(%v) = %s %d, want %s %d", tt.err, got.code, got.status, tt.code, tt.status)
+			}
+		})
+	}
+}
+
+// TestServiceErrorTimeout checks that an error of a request whose deadline
+// passed maps to TIMEOUT even though repositories hide the context error.
+func TestServiceErrorTimeout(t *testing.T) {
+	h := &Handler{log: slog.New(slog.DiscardHandler)}
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	r := httptest.NewRequest(http.MethodGet, "/team/get", nil).WithContext(ctx)
+
+	code, _, status := h.serviceError(r, domain.ErrInternalError)
+	if code != api.TIMEOUT || status != http.StatusGatewayTimeout {
+		t.Errorf("serviceError = %s %d, want TIMEOUT 504", code, status)
+	}
+
+	code, message, status := h.serviceError(httptest.NewRequest(http.MethodGet, "/team/get", nil), errors.New("connection refused"))
+	if code != api.INTERNALERROR || status != http.StatusInternalServerError || message != "internal server error" {
+		t.Errorf("serviceError = %s %q %d, want INTERNAL_ERROR with a generic message", code, message, status)
+	}
+}
+
+func TestErrorDocsURL(t *testing.T) {
+	if got := errorDocsURL(api.PRMERGED); got != "/docs/errors#PR_MERGED" {
+		t.Errorf("errorDocsURL = %q", got)
+	}
+}
+
+// TestErrorDocsPage checks that docs_url leads to an HTML entry for every code.
+func TestErrorDocsPage(t *testing.T) {
+	rec := httptest.NewRecorder()
+	errorDocsHandler(rec, httptest.NewRequest(http.MethodGet, errorDocsPath, nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Fatalf("Content-Type = %q, want text/html", ct)
+	}
+	page := rec.Body.String()
+	for _, e := range errorRegistry {
+		path, fragment, _ := strings.Cut(errorDocsURL(e.code), "#")
+		if path != errorDocsPath {
+			t.Errorf("docs_url of %s points to %s", e.code, path)
+		}
+		if !strings.Contains(page, fmt.Sprintf(`<tr id="%s">`, fragment)) {
+			t.Errorf("page has no entry for %s", e.code)
+		}
+	}
+}
@@ -4,33 +4,83 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/app"
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/internal/testhooks"
 	"github.com/glebmavi/pr_reviewer_service/pkg/api"
 )
 
 // Handler implements the api.ServerInterface
 type Handler struct {
-	teamSvc  *app.TeamService
-	prSvc    *app.PullRequestService
-	userSvc  *app.UserService
-	statsSvc *app.StatsService
-	log      *slog.Logger
+	teamSvc         *app.TeamService
+	prSvc           *app.PullRequestService
+	userSvc         *app.UserService
+	statsSvc        *app.StatsService
+	adminSvc        *app.AdminService
+	archiveSvc      *app.ArchiveService
+	healthSvc       *app.HealthService
+	githubSvc       *app.GitHubService
+	githubApp       *app.GitHubAppService
+	notifySvc       *app.NotificationService
+	repoSvc         *app.RepositoryService
+	ruleSvc         *app.ReviewRuleService
+	filterSvc       *app.SavedFilterService
+	templateSvc     *app.PRTemplateService
+	provisioningSvc *app.ProvisioningService
+	teamSyncSvc     *app.GitHubTeamSyncService
+	budgetSvc       *app.ReviewBudgetService
+	reportSvc       *app.TeamReportService
+	webhookSvc      *app.WebhookService
+	liveSvc         *app.LiveService
+	snapshotSvc     *app.TeamSnapshotService
+	slackSvc        *app.SlackService
+	settingsSvc     *app.SettingsService
+	metrics         *serviceMetrics
+	// faults back the test hooks; nil unless APP_TEST_HOOKS is on.
+	faults *testhooks.Faults
+	log    *slog.Logger
 }
 
-func NewHandler(teamSvc *app.TeamService, prSvc *app.PullRequestService, userSvc *app.UserService, statsSvc *app.StatsService, log *slog.Logger) *Handler {
+func NewHandler(teamSvc *app.TeamService, prSvc *app.PullRequestService, userSvc *app.UserService, statsSvc *app.StatsService, adminSvc *app.AdminService, archiveSvc *app.ArchiveService, healthSvc *app.HealthService, githubSvc *app.GitHubService, githubApp *app.GitHubAppService, notifySvc *app.NotificationService, repoSvc *app.RepositoryService, ruleSvc *app.ReviewRuleService, filterSvc *app.SavedFilterService, templateSvc *app.PRTemplateService, provisioningSvc *app.ProvisioningService, teamSyncSvc *app.GitHubTeamSyncService, budgetSvc *app.ReviewBudgetService, reportSvc *app.TeamReportService, webhookSvc *app.WebhookService, liveSvc *app.LiveService, snapshotSvc *app.TeamSnapshotService, slackSvc *app.SlackService, settingsSvc *app.SettingsService, faults *testhooks.Faults, log *slog.Logger) *Handler {
 	return &Handler{
-		teamSvc:  teamSvc,
-		prSvc:    prSvc,
-		userSvc:  userSvc,
-		statsSvc: statsSvc,
-		log:      log,
+		teamSvc:         teamSvc,
+		prSvc:           prSvc,
+		userSvc:         userSvc,
+		statsSvc:        statsSvc,
+		adminSvc:        adminSvc,
+		archiveSvc:      archiveSvc,
+		healthSvc:       healthSvc,
+		githubSvc:       githubSvc,
+		githubApp:       githubApp,
+		notifySvc:       notifySvc,
+		repoSvc:         repoSvc,
+		ruleSvc:         ruleSvc,
+		filterSvc:       filterSvc,
+		templateSvc:     templateSvc,
+		provisioningSvc: provisioningSvc,
+		teamSyncSvc:     teamSyncSvc,
+		budgetSvc:       budgetSvc,
+		reportSvc:       reportSvc,
+		webhookSvc:      webhookSvc,
+		liveSvc:         liveSvc,
+		snapshotSvc:     snapshotSvc,
+		slackSvc:        slackSvc,
+		settingsSvc:     settingsSvc,
+		metrics:         newServiceMetrics(),
+		faults:          faults,
+		log:             log,
 	}
 }
 
@@ -40,6 +90,39 @@ func (h *Handler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, map[string]string{"status": "ok"})
 }
 
+func (h *Handler) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	report := h.healthSvc.Check(r.Context())
+
+	resp := api.ReadinessResponse{
+		Status:       api.ReadinessResponseStatus(report.Status),
+		Degraded:     []string{},
+		Dependencies: make([]api.DependencyStatus, len(report.Dependencies)),
+	}
+	for i, d := range report.Dependencies {
+		dep := api.DependencyStatus{
+			Name:      d.Name,
+			Status:    api.DependencyStatusStatusUp,
+			Critical:  d.Critical,
+			LatencyMs: d.Latency.Milliseconds(),
+		}
+		if !d.Up {
+			dep.Status = api.DependencyStatusStatusDown
+			dep.Error = &d.Error
+			if !d.Critical {
+				resp.Degraded = append(resp.Degraded, d.Name)
+			}
+		}
+		resp.Dependencies[i] = dep
+	}
+
+	status := http.StatusOK
+	if report.Status == domain.HealthDown {
+		status = http.StatusServiceUnavailable
+	}
+	render.Status(r, status)
+	render.JSON(w, r, resp)
+}
+
 // --- Teams ---
 
 func (h *Handler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
@@ -54,7 +137,8 @@ func (h *Handler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 		memberNames[i] = member.Username
 	}
 
-	team, err := h.teamSvc.CreateTeam(r.Context(), req.TeamName, memberNames)
+	allowDuplicates := req.AllowDuplicateUsernames != nil && *req.AllowDuplicateUsernames
+	team, err := h.teamSvc.CreateTeam(r.Context(), req.TeamName, memberNames, allowDuplicates)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
@@ -75,6 +159,77 @@ func (h *Handler) GetTeamGet(w http.ResponseWriter, r *http.Request, params api.
 	render.JSON(w, r, teamToAPI(team))
 }
 
+func (h *Handler) GetTeamTeamNameCapacity(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	capacity, err := h.teamSvc.GetCapacity(r.Context(), teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.TeamCapacity{TeamName: capacity.TeamName, Members: make([]api.UserWorkload, len(capacity.Members))}
+	if capacity.Capacity > 0 {
+		resp.Capacity = &capacity.Capacity
+	}
+	for i := range capacity.Members {
+		resp.Members[i] = workloadToAPI(&capacity.Members[i])
+		if resp.Members[i].CanTakeReview {
+			resp.AvailableCount++
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetTeamTeamNameExport(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	snapshot, err := h.snapshotSvc.Export(r.Context(), teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamSnapshotToAPI(snapshot))
+}
+
+func (h *Handler) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamImportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	snapshot, err := teamSnapshotFromAPI(&req)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	imported, err := h.snapshotSvc.Import(r.Context(), snapshot)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, teamSnapshotToAPI(imported))
+}
+
+func (h *Handler) GetTeamList(w http.ResponseWriter, r *http.Request) {
+	teams, err := h.teamSvc.ListTeams(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	shortTeams := make([]api.TeamShort, len(teams))
+	for i, t := range teams {
+		shortTeams[i] = api.TeamShort{TeamName: t.TeamName, IsActive: t.IsActive}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, shortTeams)
+}
+
 func (h *Handler) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
 	var req api.PostTeamEditJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -82,7 +237,20 @@ func (h *Handler) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team, err := h.teamSvc.UpdateTeam(r.Context(), req.OldTeamName, req.NewTeamName)
+	in := app.UpdateTeamInput{
+		OldName:                 req.OldTeamName,
+		Escalation:              escalationPolicyFromAPI(req.Escalation),
+		ReviewCooldownPRs:       req.ReviewCooldownPrs,
+		Checklist:               checklistTemplateFromAPI(req.Checklist),
+		SizeRules:               sizeRulesFromAPI(req.SizeRules),
+		AllowDuplicateUsernames: req.AllowDuplicateUsernames,
+		AllowSelfReview:         req.AllowSelfReview,
+	}
+	if req.NewTeamName != nil {
+		in.NewName = *req.NewTeamName
+	}
+
+	team, err := h.teamSvc.UpdateTeam(r.Context(), in)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
@@ -99,6 +267,17 @@ func (h *Handler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isFuture(req.EffectiveAt) {
+		team, err := h.teamSvc.ScheduleDeactivation(r.Context(), req.TeamName, *req.EffectiveAt)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+		render.Status(r, http.StatusAccepted)
+		render.JSON(w, r, api.TeamDeactivateResponse{ScheduledAt: team.DeactivateAt})
+		return
+	}
+
 	deactivatedCount, reassignedCount, err := h.teamSvc.DeactivateTeamAndReassign(r.Context(), req.TeamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
@@ -112,378 +291,3692 @@ func (h *Handler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// --- Users ---
-
-func (h *Handler) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
-	var req api.PostUsersAddJSONRequestBody
+func (h *Handler) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetParentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	user, err := h.userSvc.AddUser(r.Context(), req.Username, req.TeamName, req.IsActive)
-	if err != nil {
-		h.handleServiceError(w, r, err)
-		return
+	var parentName string
+	if req.ParentTeamName != nil {
+		parentName = *req.ParentTeamName
 	}
+	escalate := req.EscalateToParent != nil && *req.EscalateToParent
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, userToAPI(user))
-}
-
-func (h *Handler) GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
-	user, err := h.userSvc.GetUserByID(r.Context(), userId)
+	hierarchy, err := h.teamSvc.SetTeamParent(r.Context(), req.TeamName, parentName, escalate)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, userToAPI(user))
+	render.JSON(w, r, teamHierarchyToAPI(hierarchy))
 }
 
-func (h *Handler) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
-	var req api.PostUsersEditJSONRequestBody
+func (h *Handler) PostTeamSetReviewerRequirements(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetReviewerRequirementsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	user := &domain.User{
-		ID:       req.UserId,
-		Username: req.Username,
-		TeamName: req.TeamName,
-		IsActive: req.IsActive,
+	team, err := h.teamSvc.SetReviewerRequirements(r.Context(), req.TeamName, req.RequiredReviewerRole, req.OptionalReviewers, req.ShadowReviewPercent)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
 	}
 
-	updatedUser, err := h.userSvc.UpdateUser(r.Context(), user)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.TeamReviewerRequirements{
+		TeamName:             team.TeamName,
+		RequiredReviewerRole: team.RequiredReviewerRole,
+		OptionalReviewers:    &team.OptionalReviewers,
+		ShadowReviewPercent:  &team.ShadowReviewPercent,
+	})
+}
+
+func (h *Handler) GetTeamReviewerPreferences(w http.ResponseWriter, r *http.Request, params api.GetTeamReviewerPreferencesParams) {
+	prefs, err := h.teamSvc.GetReviewerPreferences(r.Context(), params.TeamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, userToAPI(updatedUser))
+	render.JSON(w, r, reviewerPreferencesToAPI(params.TeamName, prefs))
 }
 
-func (h *Handler) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
-	var req api.PostUsersMoveToTeamJSONRequestBody
+func (h *Handler) PostTeamSetReviewerPreferences(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetReviewerPreferencesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	user, err := h.userSvc.MoveUserToTeam(r.Context(), req.UserId, req.NewTeamName)
+	prefs := make([]domain.ReviewerPreference, len(req.Preferences))
+	for i, p := range req.Preferences {
+		prefs[i] = domain.ReviewerPreference{AuthorID: p.AuthorId, ReviewerID: p.ReviewerId, Weight: p.Weight}
+	}
+	saved, err := h.teamSvc.SetReviewerPreferences(r.Context(), req.TeamName, prefs)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, userToAPI(user))
+	render.JSON(w, r, reviewerPreferencesToAPI(req.TeamName, saved))
 }
 
-func (h *Handler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var req api.PostUsersSetIsActiveJSONRequestBody
+func (h *Handler) GetTeamReviewBudget(w http.ResponseWriter, r *http.Request, params api.GetTeamReviewBudgetParams) {
+	budget, err := h.budgetSvc.GetBudget(r.Context(), params.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reviewBudgetToAPI(budget))
+}
+
+func (h *Handler) PostTeamSetReviewBudget(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetReviewBudgetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	user, err := h.userSvc.SetUserActiveStatus(r.Context(), req.UserId, req.IsActive)
+	var sprintDays int
+	if req.SprintDays != nil {
+		sprintDays = *req.SprintDays
+		if sprintDays == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "sprint_days must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	budget, err := h.budgetSvc.SetBudget(r.Context(), req.TeamName, req.ReviewsPerSprint, sprintDays)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, userToAPI(user))
+	render.JSON(w, r, reviewBudgetToAPI(budget))
 }
 
-func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params api.GetUsersGetReviewParams) {
-	prs, err := h.prSvc.GetReviewsForUser(r.Context(), params.UserId)
+func (h *Handler) GetTeamReport(w http.ResponseWriter, r *http.Request, params api.GetTeamReportParams) {
+	report, err := h.reportSvc.GetReport(r.Context(), params.TeamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
-	shortPRs := make([]api.PullRequestShort, len(prs))
-	for i, pr := range prs {
-		shortPRs[i] = *prToShortAPI(&pr)
+	if params.Format != nil && *params.Format == api.Html {
+		page, err := h.reportSvc.RenderHTML(report)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(page))
+		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, struct {
-		UserId       string                 `json:"user_id"`
-		PullRequests []api.PullRequestShort `json:"pull_requests"`
-	}{
-		UserId:       params.UserId,
-		PullRequests: shortPRs,
-	})
+	render.JSON(w, r, teamReportToAPI(report))
 }
 
-// --- PullRequests ---
+func (h *Handler) GetTeamReportSchedule(w http.ResponseWriter, r *http.Request, params api.GetTeamReportScheduleParams) {
+	schedule, err := h.reportSvc.GetSchedule(r.Context(), params.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
 
-func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var req api.PostPullRequestCreateJSONRequestBody
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reportScheduleToAPI(params.TeamName, schedule))
+}
+
+func (h *Handler) PostTeamSetReportSchedule(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetReportScheduleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, err := h.prSvc.CreatePR(r.Context(), req.PullRequestName, req.AuthorId)
+	weekday, hour, timezone := time.Monday, 9, ""
+	if req.Weekday != nil {
+		var ok bool
+		if weekday, ok = weekdayFromAPI(*req.Weekday); !ok {
+			h.respondError(w, r, api.VALIDATIONERROR, "unknown weekday", http.StatusBadRequest)
+			return
+		}
+	}
+	if req.Hour != nil {
+		hour = *req.Hour
+	}
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+	schedule, err := h.reportSvc.SetSchedule(r.Context(), req.TeamName, req.Enabled, weekday, hour, timezone)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, prToAPI(pr))
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reportScheduleToAPI(req.TeamName, schedule))
 }
 
-func (h *Handler) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId api.PullRequestIdParam) {
-	pr, err := h.prSvc.GetPR(r.Context(), pullRequestId)
+func (h *Handler) GetTeamPrQuota(w http.ResponseWriter, r *http.Request, params api.GetTeamPrQuotaParams) {
+	quota, err := h.teamSvc.GetPRQuota(r.Context(), params.TeamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, prToAPI(pr))
+	render.JSON(w, r, prQuotaToAPI(params.TeamName, quota))
 }
 
-func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var req api.PostPullRequestMergeJSONRequestBody
+func (h *Handler) PostTeamSetPRQuota(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetPRQuotaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, err := h.prSvc.MergePR(r.Context(), req.PullRequestId)
+	var mode domain.PRQuotaMode
+	if req.Mode != nil {
+		mode = domain.PRQuotaMode(*req.Mode)
+	}
+	quota, err := h.teamSvc.SetPRQuota(r.Context(), req.TeamName, req.MaxOpenPrs, mode)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, prToAPI(pr))
+	render.JSON(w, r, prQuotaToAPI(req.TeamName, quota))
 }
 
-func (h *Handler) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
-	var req api.PostPullRequestAssignJSONRequestBody
+// defaultRotationWeeks is how many weeks of a rotation schedule are shown.
+const defaultRotationWeeks = 4
+
+func (h *Handler) GetTeamRotation(w http.ResponseWriter, r *http.Request, params api.GetTeamRotationParams) {
+	weeks := defaultRotationWeeks
+	if params.Weeks != nil {
+		weeks = *params.Weeks
+	}
+	schedule, err := h.teamSvc.GetReviewRotation(r.Context(), params.TeamName, weeks)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, rotationScheduleToAPI(schedule))
+}
+
+func (h *Handler) PostTeamSetRotation(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetRotationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, err := h.prSvc.AssignReviewer(r.Context(), req.PullRequestId, req.UserId)
+	var startDate time.Time
+	if req.StartDate != nil {
+		startDate = req.StartDate.Time
+	}
+	schedule, err := h.teamSvc.SetReviewRotation(r.Context(), req.TeamName, startDate, req.MemberIds)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, prToAPI(pr))
+	render.JSON(w, r, rotationScheduleToAPI(schedule))
 }
 
-func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var req api.PostPullRequestReassignJSONRequestBody
+func (h *Handler) PostTeamOverrideRotation(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamOverrideRotationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	pr, newReviewerID, err := h.prSvc.ReassignReviewer(r.Context(), req.PullRequestId, req.OldUserId)
+	var userID string
+	if req.UserId != nil {
+		userID = *req.UserId
+	}
+	schedule, err := h.teamSvc.OverrideReviewRotation(r.Context(), req.TeamName, req.WeekStart.Time, userID)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, struct {
-		Pr         *api.PullRequest `json:"pr"`
-		ReplacedBy string           `json:"replaced_by"`
-	}{
-		Pr:         prToAPI(pr),
-		ReplacedBy: newReviewerID,
-	})
+	render.JSON(w, r, rotationScheduleToAPI(schedule))
 }
 
-func (h *Handler) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
-	prs, err := h.prSvc.GetOpenPRsWithoutReviewers(r.Context())
+func (h *Handler) GetTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	pool, err := h.teamSvc.GetReviewerPool(r.Context(), teamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
-	shortPRs := make([]api.PullRequestShort, len(prs))
-	for i, pr := range prs {
-		shortPRs[i] = *prToShortAPI(&pr)
-	}
-
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, shortPRs)
+	render.JSON(w, r, reviewerPoolToAPI(pool))
 }
 
-// --- Stats ---
+func (h *Handler) PostTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	var req api.PostTeamTeamNameReviewerPoolJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
 
-func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
-	stats, err := h.statsSvc.GetStats(r.Context())
+	pool, err := h.teamSvc.SetReviewerPool(r.Context(), teamName, req.UserIds)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
 
-	apiStats := make([]api.StatItem, len(stats))
-	for i, s := range stats {
-		apiStats[i] = api.StatItem{
-			UserId:      &s.UserID,
-			ReviewCount: &s.ReviewCount,
-		}
-	}
-
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, api.StatsResponse{ReviewStats: &apiStats})
+	render.JSON(w, r, reviewerPoolToAPI(pool))
 }
 
-func (h *Handler) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
-	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetOpenReviewCountForTeam, teamName)
-}
+func (h *Handler) GetTeamInactiveReassign(w http.ResponseWriter, r *http.Request, params api.GetTeamInactiveReassignParams) {
+	enabled, err := h.teamSvc.GetInactiveReassign(r.Context(), params.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
 
-func (h *Handler) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
-	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetMergedReviewCountForTeam, teamName)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.TeamInactiveReassign{TeamName: params.TeamName, Enabled: enabled})
 }
 
-func (h *Handler) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
-	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetOpenReviewCountForUser, userId)
-}
+func (h *Handler) PostTeamSetInactiveReassign(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamSetInactiveReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
 
-func (h *Handler) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
-	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetMergedReviewCountForUser, userId)
+	enabled, err := h.teamSvc.SetInactiveReassign(r.Context(), req.TeamName, req.Enabled)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.TeamInactiveReassign{TeamName: req.TeamName, Enabled: enabled})
 }
 
-func (h *Handler) getReviewCount(ctx context.Context, w http.ResponseWriter, r *http.Request, countFn func(context.Context, string) (int, error), param string) {
-	count, err := countFn(ctx, param)
+func (h *Handler) GetTeamHierarchy(w http.ResponseWriter, r *http.Request, params api.GetTeamHierarchyParams) {
+	hierarchy, err := h.teamSvc.GetTeamHierarchy(r.Context(), params.TeamName)
 	if err != nil {
 		h.handleServiceError(w, r, err)
 		return
 	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, api.CountResponse{Count: count})
+	render.JSON(w, r, teamHierarchyToAPI(hierarchy))
 }
 
-// --- Error Helpers ---
-
-func (h *Handler) handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
-	var code = api.INTERNALERROR
-	var httpStatus = http.StatusInternalServerError
-	var message = err.Error()
+// --- Users ---
 
-	switch {
-	case errors.Is(err, domain.ErrNotFound):
-		code = api.NOTFOUND
-		httpStatus = http.StatusNotFound
-	case errors.Is(err, domain.ErrTeamExists):
-		code = api.TEAMEXISTS
-		httpStatus = http.StatusConflict
-	case errors.Is(err, domain.ErrPRExists):
-		code = api.PREXISTS
-		httpStatus = http.StatusConflict
-	case errors.Is(err, domain.ErrPRMerged):
-		code = api.PRMERGED
-		httpStatus = http.StatusConflict
-	case errors.Is(err, domain.ErrNotAssigned):
-		code = api.NOTASSIGNED
-		httpStatus = http.StatusConflict
-	case errors.Is(err, domain.ErrNoCandidate):
-		code = api.NOCANDIDATE
-		httpStatus = http.StatusConflict
-	case errors.Is(err, domain.ErrValidation):
-		code = api.VALIDATIONERROR
-		httpStatus = http.StatusBadRequest
-	case errors.Is(err, domain.ErrUserNotActive):
-		code = api.USERNOTACTIVE
-		httpStatus = http.StatusConflict
+func (h *Handler) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
 	}
 
-	if httpStatus == http.StatusInternalServerError {
-		h.log.ErrorContext(r.Context(), "internal server error", slog.String("error", err.Error()))
-		message = "internal server error"
-	} else {
-		h.log.InfoContext(r.Context(), "client error", slog.String("error", err.Error()), "code", string(code))
+	user, err := h.userSvc.AddUser(r.Context(), req.Username, req.TeamName, req.IsActive)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
 	}
 
-	h.respondError(w, r, code, message, httpStatus)
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, userToAPI(user))
 }
 
-func (h *Handler) respondError(w http.ResponseWriter, r *http.Request, code api.ErrorResponseErrorCode, message string, httpStatus int) {
-	resp := api.ErrorResponse{
-		Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{
-			Code:    code,
-			Message: message,
-		},
+func (h *Handler) GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	user, err := h.userSvc.GetUserByID(r.Context(), userId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
 	}
 
-	render.Status(r, httpStatus)
-	render.JSON(w, r, resp)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
 }
 
-// --- Mappers ---
-
+func (h *Handler) GetUsersGetByUsername(w http.ResponseWriter, r *http.Request, params api.GetUsersGetByUsernameParams) {
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+	user, err := h.userSvc.GetUserByUsername(r.Context(), params.Username, teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
+}
+
+func (h *Handler) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersEditJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user := &domain.User{
+		ID:       req.UserId,
+		Username: req.Username,
+		TeamName: req.TeamName,
+		IsActive: req.IsActive,
+	}
+
+	updatedUser, err := h.userSvc.UpdateUser(r.Context(), user)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(updatedUser))
+}
+
+func (h *Handler) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersMoveToTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var policy domain.OpenReviewsPolicy
+	if req.OpenReviews != nil {
+		policy = domain.OpenReviewsPolicy(*req.OpenReviews)
+	}
+
+	user, moved, err := h.userSvc.MoveUserToTeam(r.Context(), req.UserId, req.NewTeamName, policy)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	resp := api.UserMoveResponse{
+		UserId:            user.ID,
+		Username:          user.Username,
+		TeamName:          user.TeamName,
+		IsActive:          user.IsActive,
+		Role:              &user.Role,
+		Skills:            &user.Skills,
+		MovedReviewsCount: len(moved),
+	}
+	if user.InTraining {
+		resp.InTraining = &user.InTraining
+	}
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersSetIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.userSvc.SetUserActiveStatus(r.Context(), req.UserId, req.IsActive)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
+}
+
+func (h *Handler) PostUsersSetIsActiveBatch(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersSetIsActiveBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	deactivations, err := h.userSvc.SetUsersInactive(r.Context(), req.UserIds)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	results := make([]api.UserDeactivationResult, len(deactivations))
+	for i, d := range deactivations {
+		moves := make([]api.RebalanceMove, len(d.Reassigned))
+		for j, m := range d.Reassigned {
+			moves[j] = api.RebalanceMove{PullRequestId: m.PRID, FromUserId: m.FromUserID, ToUserId: m.ToUserID}
+		}
+		results[i] = api.UserDeactivationResult{
+			UserId:                   d.UserID,
+			Status:                   api.UserDeactivationResultStatus(d.Status),
+			Reassigned:               moves,
+			UnassignedPullRequestIds: d.Unassigned,
+		}
+		if results[i].UnassignedPullRequestIds == nil {
+			results[i].UnassignedPullRequestIds = []string{}
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, struct {
+		Results []api.UserDeactivationResult `json:"results"`
+	}{Results: results})
+}
+
+func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params api.GetUsersGetReviewParams) {
+	prs, err := h.prSvc.GetReviewsForUser(r.Context(), params.UserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	shortPRs := make([]api.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		shortPRs[i] = *prToShortAPI(&pr)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, struct {
+		UserId       string                 `json:"user_id"`
+		PullRequests []api.PullRequestShort `json:"pull_requests"`
+	}{
+		UserId:       params.UserId,
+		PullRequests: shortPRs,
+	})
+}
+
+func (h *Handler) PostUsersSetRole(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersSetRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.userSvc.SetUserRole(r.Context(), req.UserId, req.Role)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
+}
+
+func (h *Handler) PostUsersSetInTraining(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersSetInTrainingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.userSvc.SetUserInTraining(r.Context(), req.UserId, req.InTraining)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
+}
+
+func (h *Handler) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	var req api.PostUsersSetSkillsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.userSvc.SetUserSkills(r.Context(), req.UserId, req.Skills)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userToAPI(user))
+}
+
+func (h *Handler) GetUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	prefs, err := h.notifySvc.GetPreferences(r.Context(), userId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, notificationPreferencesToAPI(prefs))
+}
+
+func (h *Handler) GetUsersUserIdWorkload(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	workload, err := h.userSvc.GetWorkload(r.Context(), userId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, workloadToAPI(workload))
+}
+
+func (h *Handler) PostUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	var req api.PostUsersUserIdNotificationPreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	prefs := &domain.NotificationPreferences{
+		UserID:      userId,
+		Channels:    make([]string, len(req.Channels)),
+		MutedEvents: make([]domain.NotificationEvent, len(req.MutedEvents)),
+		Timezone:    req.Timezone,
+	}
+	for i, c := range req.Channels {
+		prefs.Channels[i] = string(c)
+	}
+	for i, e := range req.MutedEvents {
+		prefs.MutedEvents[i] = domain.NotificationEvent(e)
+	}
+	if req.QuietHoursStart != nil {
+		prefs.QuietHoursStart = *req.QuietHoursStart
+	}
+	if req.QuietHoursEnd != nil {
+		prefs.QuietHoursEnd = *req.QuietHoursEnd
+	}
+	if req.WebhookUrl != nil {
+		prefs.WebhookURL = *req.WebhookUrl
+	}
+	if req.Email != nil {
+		prefs.Email = *req.Email
+	}
+	if req.Digest != nil {
+		prefs.Digest = domain.DigestFrequency(*req.Digest)
+	}
+
+	saved, err := h.notifySvc.SetPreferences(r.Context(), prefs)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, notificationPreferencesToAPI(saved))
+}
+
+// --- PullRequests ---
+
+func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params api.PostPullRequestCreateParams) {
+	var req api.PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	in := createPRInputFromAPI(&req)
+	if req.TemplateId != nil {
+		var err error
+		if in.Template, err = h.templateSvc.GetTemplate(r.Context(), *req.TemplateId); err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+	}
+
+	pr, err := h.prSvc.CreatePR(r.Context(), in)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := prToAPI(pr)
+	if params.Explain != nil && *params.Explain {
+		explanations := explanationsToAPI(pr.Explanations)
+		resp.AssignmentExplanations = &explanations
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+// createPRInputFromAPI converts a PR creation request, all but its template,
+// to the service input.
+func createPRInputFromAPI(req *api.PostPullRequestCreateJSONRequestBody) app.CreatePRInput {
+	in := app.CreatePRInput{
+		Name:      req.PullRequestName,
+		AuthorID:  req.AuthorId,
+		AutoMerge: req.AutoMerge != nil && *req.AutoMerge,
+		Draft:     req.Draft != nil && *req.Draft,
+		Size:      domain.PRSize{LinesChanged: req.LinesChanged, FilesChanged: req.FilesChanged},
+	}
+	if req.PullRequestId != nil {
+		in.ID = *req.PullRequestId
+	}
+	if req.ExternalId != nil {
+		in.ExternalID = *req.ExternalId
+	}
+	if req.Description != nil {
+		in.Description = *req.Description
+	}
+	if req.RepositoryName != nil {
+		in.Repository = *req.RepositoryName
+	}
+	if req.RequiredSkills != nil {
+		in.RequiredSkills = *req.RequiredSkills
+	}
+	if req.Labels != nil {
+		in.Labels = *req.Labels
+	}
+	if req.Priority != nil {
+		in.Priority = domain.PRPriority(*req.Priority)
+	}
+	return in
+}
+
+func (h *Handler) GetPullRequestGetByExternalId(w http.ResponseWriter, r *http.Request, params api.GetPullRequestGetByExternalIdParams) {
+	pr, err := h.prSvc.GetPRByExternalID(r.Context(), params.ExternalId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId api.PullRequestIdParam, params api.GetPullRequestGetPullRequestIdParams) {
+	pr, err := h.prSvc.GetPR(r.Context(), pullRequestId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := prToAPI(pr)
+	if params.Include != nil && *params.Include == api.Timeline {
+		events, err := h.prSvc.GetPRTimeline(r.Context(), pullRequestId)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+		timeline := timelineToAPI(events)
+		resp.Timeline = &timeline
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var mergedBy string
+	if req.MergedBy != nil {
+		mergedBy = *req.MergedBy
+	}
+
+	pr, err := h.prSvc.MergePR(r.Context(), req.PullRequestId, mergedBy)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestReadyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.MarkReady(r.Context(), req.PullRequestId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.ClosePR(r.Context(), req.PullRequestId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestAssignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.AssignReviewer(r.Context(), req.PullRequestId, req.UserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestApprove(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestApproveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.ApprovePR(r.Context(), req.PullRequestId, req.UserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestRequestChanges(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestRequestChangesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.RequestChanges(r.Context(), req.PullRequestId, req.UserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+const defaultSuggestionLimit = 5
+
+func (h *Handler) GetPullRequestPullRequestIdSuggestReviewers(w http.ResponseWriter, r *http.Request, pullRequestId api.PullRequestIdParam, params api.GetPullRequestPullRequestIdSuggestReviewersParams) {
+	limit := defaultSuggestionLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions, err := h.prSvc.SuggestReviewers(r.Context(), pullRequestId, limit)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.ReviewerSuggestionsResponse{PullRequestId: pullRequestId, Suggestions: make([]api.ReviewerSuggestion, len(suggestions))}
+	for i, sg := range suggestions {
+		resp.Suggestions[i] = api.ReviewerSuggestion{
+			UserId:           sg.User.ID,
+			Username:         sg.User.Username,
+			OpenReviews:      sg.OpenReviews,
+			LoadScore:        sg.LoadScore,
+			SkillMatchScore:  sg.SkillMatchScore,
+			MatchedSkills:    sg.MatchedSkills,
+			PreferenceWeight: sg.PreferenceWeight,
+			OnRotation:       sg.OnRotation,
+			InCooldown:       sg.InCooldown,
+			OverCapacity:     sg.OverCapacity,
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostPullRequestPullRequestIdAck(w http.ResponseWriter, r *http.Request, pullRequestId api.PullRequestIdParam) {
+	var req api.PostPullRequestPullRequestIdAckJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.AckReview(r.Context(), pullRequestId, req.UserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestPullRequestIdChecklist(w http.ResponseWriter, r *http.Request, pullRequestId api.PullRequestIdParam) {
+	var req api.PostPullRequestPullRequestIdChecklistJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updates := make([]domain.ChecklistUpdate, len(req.Items))
+	for i, item := range req.Items {
+		updates[i] = domain.ChecklistUpdate{Position: item.Position, Checked: item.Checked}
+	}
+	pr, err := h.prSvc.UpdateChecklist(r.Context(), pullRequestId, req.UserId, updates)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestSetAutoMerge(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestSetAutoMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.SetAutoMerge(r.Context(), req.PullRequestId, req.AutoMerge)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestSetPriorityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	pr, err := h.prSvc.SetPriority(r.Context(), req.PullRequestId, domain.PRPriority(req.Priority))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prToAPI(pr))
+}
+
+func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params api.PostPullRequestReassignParams) {
+	var req api.PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var decline domain.DeclineReason
+	if req.DeclineReason != nil {
+		decline = domain.DeclineReason(*req.DeclineReason)
+	}
+
+	pr, newReviewerID, err := h.prSvc.ReassignReviewer(r.Context(), req.PullRequestId, req.OldUserId, domain.ReassignmentManual, decline)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := prToAPI(pr)
+	if params.Explain != nil && *params.Explain {
+		explanations := explanationsToAPI(pr.Explanations)
+		resp.AssignmentExplanations = &explanations
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, struct {
+		Pr         *api.PullRequest `json:"pr"`
+		ReplacedBy string           `json:"replaced_by"`
+	}{
+		Pr:         resp,
+		ReplacedBy: newReviewerID,
+	})
+}
+
+func (h *Handler) PostPullRequestReassignAll(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPullRequestReassignAllJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var toUserID string
+	if req.ToUserId != nil {
+		toUserID = *req.ToUserId
+	}
+	moved, err := h.prSvc.ReassignAll(r.Context(), req.FromUserId, toUserID)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	moves := make([]api.RebalanceMove, len(moved))
+	for i, m := range moved {
+		moves[i] = api.RebalanceMove{PullRequestId: m.PRID, FromUserId: m.FromUserID, ToUserId: m.ToUserID}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.ReassignAllResponse{MovedCount: len(moves), Moves: moves})
+}
+
+func (h *Handler) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
+	prs, err := h.prSvc.GetOpenPRsWithoutReviewers(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	shortPRs := make([]api.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		shortPRs[i] = *prToShortAPI(&pr)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, shortPRs)
+}
+
+func (h *Handler) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params api.GetPullRequestSearchParams) {
+	var limit, offset int
+	if params.Limit != nil {
+		limit = *params.Limit
+		if limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	page, err := h.prSvc.SearchPRs(r.Context(), params.Q, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	hits := make([]api.PullRequestSearchHit, len(page.Hits))
+	for i, hit := range page.Hits {
+		hits[i] = api.PullRequestSearchHit{
+			PullRequestId:   hit.ID,
+			PullRequestName: hit.Name,
+			AuthorId:        hit.AuthorID,
+			Status:          api.PullRequestSearchHitStatus(hit.Status),
+			Rank:            hit.Rank,
+		}
+		if hit.Description != "" {
+			hits[i].Description = &hit.Description
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.PullRequestSearchResponse{
+		PullRequests: hits,
+		Total:        page.Total,
+		Limit:        page.Limit,
+		Offset:       page.Offset,
+	})
+}
+
+// GetPullRequestList starts from the saved filter when filter_id is given
+// and lets the other parameters override its fields.
+func (h *Handler) GetPullRequestList(w http.ResponseWriter, r *http.Request, params api.GetPullRequestListParams) {
+	var filter domain.PRFilter
+	if params.FilterId != nil {
+		saved, err := h.filterSvc.GetFilter(r.Context(), *params.FilterId)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+		filter = saved.Filter
+	}
+	applyPRFilter(&filter, api.PullRequestFilter{
+		Status:         (*api.PullRequestFilterStatus)(params.Status),
+		AuthorId:       params.AuthorId,
+		ReviewerId:     params.ReviewerId,
+		TeamName:       params.TeamName,
+		RepositoryName: params.RepositoryName,
+		Priority:       params.Priority,
+		Label:          params.Label,
+		MinAgeHours:    params.MinAgeHours,
+		Unreviewed:     params.Unreviewed,
+	})
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+		if filter.Limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	page, err := h.prSvc.ListPRs(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	prs := make([]api.PullRequestShort, len(page.PullRequests))
+	for i := range page.PullRequests {
+		prs[i] = *prToShortAPI(&page.PullRequests[i])
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.PullRequestListResponse{
+		PullRequests: prs,
+		Total:        page.Total,
+		Limit:        page.Limit,
+		Offset:       page.Offset,
+	})
+}
+
+// --- Stats ---
+
+func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request, params api.GetStatsParams) {
+	page, ok := h.statsPage(w, r, params)
+	if !ok {
+		return
+	}
+
+	apiStats := make([]api.StatItem, len(page.Items))
+	for i, s := range page.Items {
+		apiStats[i] = api.StatItem{
+			UserId:      &s.UserID,
+			Username:    &s.Username,
+			ReviewCount: &s.ReviewCount,
+			AckedCount:  &s.AckedCount,
+		}
+		if s.AckedCount > 0 {
+			latency := s.AvgAckLatency.Seconds()
+			apiStats[i].AvgAckLatencySeconds = &latency
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.StatsResponse{ReviewStats: &apiStats, Total: &page.Total})
+}
+
+// statsPage loads the stats page the params ask for, responding with the
+// error itself when that fails.
+func (h *Handler) statsPage(w http.ResponseWriter, r *http.Request, params api.GetStatsParams) (*domain.StatsPage, bool) {
+	var (
+		order         domain.StatsOrder
+		limit, offset int
+	)
+	if params.Sort != nil {
+		order = domain.StatsOrder(*params.Sort)
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+		if limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return nil, false
+		}
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	page, err := h.statsSvc.GetStats(r.Context(), order, limit, offset)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return nil, false
+	}
+	return page, true
+}
+
+const defaultFairnessWindowDays = 30
+
+func (h *Handler) GetStatsFairness(w http.ResponseWriter, r *http.Request, params api.GetStatsFairnessParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	report, err := h.statsSvc.GetFairness(r.Context(), teamName, time.Duration(windowDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	teams := make([]api.TeamFairness, len(report.Teams))
+	for i, t := range report.Teams {
+		teams[i] = teamFairnessToAPI(t)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.FairnessResponse{
+		WindowStart: report.Since,
+		WindowEnd:   report.Until,
+		Teams:       teams,
+	})
+}
+
+func (h *Handler) GetStatsReassignments(w http.ResponseWriter, r *http.Request, params api.GetStatsReassignmentsParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	report, err := h.statsSvc.GetReassignments(r.Context(), teamName, time.Duration(windowDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.ReassignmentStatsResponse{
+		WindowStart:    report.Since,
+		WindowEnd:      report.Until,
+		Total:          report.Total,
+		Reasons:        reasonCountsToAPI(report.Reasons),
+		DeclineReasons: declineReasonCountsToAPI(report.DeclineReasons),
+		Teams:          reassignmentGroupsToAPI(report.Teams),
+		Users:          reassignmentGroupsToAPI(report.Users),
+	})
+}
+
+func (h *Handler) GetStatsMerges(w http.ResponseWriter, r *http.Request, params api.GetStatsMergesParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+
+	report, err := h.statsSvc.GetMerges(r.Context(), time.Duration(windowDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.MergeStatsResponse{
+		WindowStart:  report.Since,
+		WindowEnd:    report.Until,
+		Total:        report.Total,
+		Unattributed: report.Unattributed,
+		Users:        make([]api.MergeCount, len(report.Users)),
+	}
+	for i, c := range report.Users {
+		resp.Users[i] = api.MergeCount{MergedBy: c.MergedBy, MergedCount: c.Count}
+		if c.Username != "" {
+			resp.Users[i].Username = &c.Username
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsShadow(w http.ResponseWriter, r *http.Request, params api.GetStatsShadowParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	report, err := h.statsSvc.GetShadowReviews(r.Context(), teamName, time.Duration(windowDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.ShadowReviewStatsResponse{
+		WindowStart: report.Since,
+		WindowEnd:   report.Until,
+		Total:       report.Total,
+		Users:       make([]api.ShadowReviewCount, len(report.Users)),
+	}
+	for i, c := range report.Users {
+		resp.Users[i] = api.ShadowReviewCount{
+			TeamName:          c.TeamName,
+			UserId:            c.UserID,
+			Username:          c.Username,
+			InTraining:        c.InTraining,
+			ShadowReviewCount: c.Count,
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsUnassigned(w http.ResponseWriter, r *http.Request, params api.GetStatsUnassignedParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	report, err := h.statsSvc.GetUnassigned(r.Context(), teamName, windowDays)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.UnassignedStatsResponse{
+		WindowStart: openapi_types.Date{Time: report.Since},
+		WindowEnd:   openapi_types.Date{Time: report.Until},
+		Teams:       make([]api.UnassignedTeamSeries, len(report.Teams)),
+	}
+	for i, team := range report.Teams {
+		days := make([]api.UnassignedDay, len(team.Days))
+		for j, d := range team.Days {
+			days[j] = api.UnassignedDay{Date: openapi_types.Date{Time: d.Day}, UnassignedCount: d.Count}
+		}
+		resp.Teams[i] = api.UnassignedTeamSeries{TeamName: team.TeamName, PeakCount: team.Peak, Days: days}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params api.GetStatsTimeseriesParams) {
+	var (
+		metrics      []domain.ThroughputMetric
+		interval     domain.SeriesInterval
+		since, until time.Time
+		teamName     string
+	)
+	if params.Metric != nil {
+		for _, m := range *params.Metric {
+			metrics = append(metrics, domain.ThroughputMetric(m))
+		}
+	}
+	if params.Interval != nil {
+		interval = domain.SeriesInterval(*params.Interval)
+	}
+	if params.From != nil {
+		since = *params.From
+	}
+	if params.To != nil {
+		until = *params.To
+	}
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	series, err := h.statsSvc.GetTimeSeries(r.Context(), teamName, metrics, interval, since, until)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.TimeSeries, len(series))
+	for i, ts := range series {
+		points := make([][]float64, len(ts.Points))
+		for j, p := range ts.Points {
+			points[j] = []float64{float64(p.Count), float64(p.Bucket.UnixMilli())}
+		}
+		resp[i] = api.TimeSeries{Target: api.ThroughputMetric(ts.Metric), Datapoints: points}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsRepoRepositoryName(w http.ResponseWriter, r *http.Request, repositoryName string) {
+	stats, err := h.statsSvc.GetRepositoryStats(r.Context(), repositoryName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.RepositoryStatsResponse{
+		RepositoryName:    stats.RepositoryName,
+		OpenPrs:           stats.OpenPRs,
+		MergedPrs:         stats.MergedPRs,
+		AvgReviewersPerPr: stats.AvgReviewers,
+	}
+	if stats.AvgTimeToMerge != nil && stats.MedianTimeToMerge != nil {
+		avg, median := stats.AvgTimeToMerge.Seconds(), stats.MedianTimeToMerge.Seconds()
+		resp.AvgTimeToMergeSeconds = &avg
+		resp.MedianTimeToMergeSeconds = &median
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsSummary(w http.ResponseWriter, r *http.Request) {
+	summary, err := h.statsSvc.GetSummary(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.OrgSummaryResponse{
+		WindowStart:   summary.Since,
+		WindowEnd:     summary.Until,
+		OpenPrs:       summary.OpenPRs,
+		UnassignedPrs: summary.UnassignedPRs,
+		ActiveUsers:   summary.ActiveUsers,
+		MergedPrs:     summary.MergedPRs,
+	}
+	if summary.AvgTurnaround != nil {
+		secs := summary.AvgTurnaround.Seconds()
+		resp.AvgTurnaroundSeconds = &secs
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsAuthorUserId(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	stats, err := h.statsSvc.GetAuthorStats(r.Context(), userId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.AuthorStatsResponse{
+		UserId:            stats.UserID,
+		OpenedPrs:         stats.OpenedPRs,
+		OpenPrs:           stats.OpenPRs,
+		MergedPrs:         stats.MergedPRs,
+		AvgReviewersPerPr: stats.AvgReviewers,
+	}
+	if stats.AvgTimeToMerge != nil && stats.MedianTimeToMerge != nil {
+		avg, median := stats.AvgTimeToMerge.Seconds(), stats.MedianTimeToMerge.Seconds()
+		resp.AvgTimeToMergeSeconds = &avg
+		resp.MedianTimeToMergeSeconds = &median
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsUserUserIdTurnaround(w http.ResponseWriter, r *http.Request, userId api.UserIdParam, params api.GetStatsUserUserIdTurnaroundParams) {
+	windowDays := defaultFairnessWindowDays
+	if params.WindowDays != nil {
+		windowDays = *params.WindowDays
+	}
+
+	t, err := h.statsSvc.GetReviewerTurnaround(r.Context(), userId, time.Duration(windowDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.ReviewerTurnaroundResponse{
+		UserId:        t.UserID,
+		WindowStart:   t.Since,
+		WindowEnd:     t.Until,
+		ReviewedCount: t.ReviewedCount,
+		PendingCount:  t.PendingCount,
+	}
+	if t.AvgTurnaround != nil && t.MedianTurnaround != nil {
+		avg, median := t.AvgTurnaround.Seconds(), t.MedianTurnaround.Seconds()
+		resp.AvgTurnaroundSeconds = &avg
+		resp.MedianTurnaroundSeconds = &median
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsService(w http.ResponseWriter, r *http.Request) {
+	total, endpoints := h.metrics.snapshot()
+	resp := api.ServiceStatsResponse{
+		StartedAt:     h.metrics.startedAt,
+		UptimeSeconds: time.Since(h.metrics.startedAt).Seconds(),
+		Total:         serviceLatencyStats(total),
+		Endpoints:     make([]api.EndpointLatencyStats, len(endpoints)),
+	}
+	for i, e := range endpoints {
+		s := serviceLatencyStats(e.latencyStats)
+		resp.Endpoints[i] = api.EndpointLatencyStats{
+			Method:       e.Method,
+			Path:         e.Route,
+			Requests:     s.Requests,
+			ClientErrors: s.ClientErrors,
+			ServerErrors: s.ServerErrors,
+			ErrorRate:    s.ErrorRate,
+			P50Ms:        s.P50Ms,
+			P95Ms:        s.P95Ms,
+			P99Ms:        s.P99Ms,
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func serviceLatencyStats(s latencyStats) api.ServiceLatencyStats {
+	ms := func(d time.Duration) float64 { return float64(d.Microseconds()) / 1000 }
+	return api.ServiceLatencyStats{
+		Requests:     s.Requests,
+		ClientErrors: s.ClientErrors,
+		ServerErrors: s.ServerErrors,
+		ErrorRate:    s.ErrorRate,
+		P50Ms:        ms(s.P50),
+		P95Ms:        ms(s.P95),
+		P99Ms:        ms(s.P99),
+	}
+}
+
+// historyRange unwraps the optional from and to days of a stats history
+// request; zero times are left to the service defaults.
+func historyRange(from, to *openapi_types.Date) (since, until time.Time) {
+	if from != nil {
+		since = from.Time
+	}
+	if to != nil {
+		until = to.Time
+	}
+	return since, until
+}
+
+func (h *Handler) GetStatsHistoryUserUserId(w http.ResponseWriter, r *http.Request, userId api.UserIdParam, params api.GetStatsHistoryUserUserIdParams) {
+	since, until := historyRange(params.From, params.To)
+	history, err := h.statsSvc.GetUserStatsHistory(r.Context(), userId, since, until)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.UserStatsHistoryResponse{UserId: userId, Points: make([]api.UserStatsHistoryPoint, len(history))}
+	for i, p := range history {
+		resp.Points[i] = api.UserStatsHistoryPoint{
+			Date:          openapi_types.Date{Time: p.Date},
+			TotalReviews:  p.TotalReviews,
+			OpenReviews:   p.OpenReviews,
+			MergedReviews: p.MergedReviews,
+		}
+		if p.TeamName != "" {
+			resp.Points[i].TeamName = &p.TeamName
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsHistoryTeamTeamName(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam, params api.GetStatsHistoryTeamTeamNameParams) {
+	since, until := historyRange(params.From, params.To)
+	history, err := h.statsSvc.GetTeamStatsHistory(r.Context(), teamName, since, until)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.TeamStatsHistoryResponse{TeamName: teamName, Points: make([]api.TeamStatsHistoryPoint, len(history))}
+	for i, p := range history {
+		resp.Points[i] = api.TeamStatsHistoryPoint{
+			Date:          openapi_types.Date{Time: p.Date},
+			ActiveMembers: p.ActiveMembers,
+			OpenReviews:   p.OpenReviews,
+			MergedReviews: p.MergedReviews,
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetOpenReviewCountForTeam, teamName)
+}
+
+func (h *Handler) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName api.TeamNameParam) {
+	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetMergedReviewCountForTeam, teamName)
+}
+
+func (h *Handler) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetOpenReviewCountForUser, userId)
+}
+
+func (h *Handler) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId api.UserIdParam) {
+	h.getReviewCount(r.Context(), w, r, h.statsSvc.GetMergedReviewCountForUser, userId)
+}
+
+func (h *Handler) getReviewCount(ctx context.Context, w http.ResponseWriter, r *http.Request, countFn func(context.Context, string) (int, error), param string) {
+	count, err := countFn(ctx, param)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.CountResponse{Count: count})
+}
+
+// --- Repositories ---
+
+func (h *Handler) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	var req api.PostRepositoryAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	repo, err := h.repoSvc.CreateRepository(r.Context(), repositoryFromAPI(req))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, repositoryToAPI(repo))
+}
+
+func (h *Handler) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params api.GetRepositoryGetParams) {
+	repo, err := h.repoSvc.GetRepository(r.Context(), params.RepositoryName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, repositoryToAPI(repo))
+}
+
+func (h *Handler) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	repos, err := h.repoSvc.ListRepositories(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.Repository, len(repos))
+	for i := range repos {
+		resp[i] = repositoryToAPI(&repos[i])
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostRepositoryEdit(w http.ResponseWriter, r *http.Request) {
+	var req api.PostRepositoryEditJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	repo, err := h.repoSvc.UpdateRepository(r.Context(), repositoryFromAPI(req))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, repositoryToAPI(repo))
+}
+
+func (h *Handler) PostRepositoryDelete(w http.ResponseWriter, r *http.Request) {
+	var req api.PostRepositoryDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.repoSvc.DeleteRepository(r.Context(), req.RepositoryName); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// --- Review rules ---
+
+func (h *Handler) PostReviewRuleAdd(w http.ResponseWriter, r *http.Request) {
+	var req api.PostReviewRuleAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	rule, err := h.ruleSvc.CreateRule(r.Context(), reviewRuleFromAPI(req))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, reviewRuleToAPI(rule))
+}
+
+func (h *Handler) GetReviewRuleGet(w http.ResponseWriter, r *http.Request, params api.GetReviewRuleGetParams) {
+	rule, err := h.ruleSvc.GetRule(r.Context(), params.RuleName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reviewRuleToAPI(rule))
+}
+
+func (h *Handler) GetReviewRuleList(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.ruleSvc.ListRules(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.ReviewRule, len(rules))
+	for i := range rules {
+		resp[i] = reviewRuleToAPI(&rules[i])
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostReviewRuleEdit(w http.ResponseWriter, r *http.Request) {
+	var req api.PostReviewRuleEditJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	rule, err := h.ruleSvc.UpdateRule(r.Context(), reviewRuleFromAPI(req))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reviewRuleToAPI(rule))
+}
+
+func (h *Handler) PostReviewRuleDelete(w http.ResponseWriter, r *http.Request) {
+	var req api.PostReviewRuleDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.ruleSvc.DeleteRule(r.Context(), req.RuleName); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) PostReviewRuleDryRun(w http.ResponseWriter, r *http.Request) {
+	var req api.PostReviewRuleDryRunJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var rule *domain.ReviewRule
+	if req.Rule != nil {
+		rule = reviewRuleFromAPI(*req.Rule)
+	}
+	sample := req.PullRequest
+	pr := &domain.PullRequest{
+		AuthorID: sample.AuthorId,
+		Size:     domain.PRSize{LinesChanged: sample.LinesChanged, FilesChanged: sample.FilesChanged},
+	}
+	if sample.PullRequestName != nil {
+		pr.Name = *sample.PullRequestName
+	}
+	if sample.RepositoryName != nil {
+		pr.Repository = *sample.RepositoryName
+	}
+	if sample.Labels != nil {
+		pr.Labels = *sample.Labels
+	}
+	if sample.RequiredSkills != nil {
+		pr.RequiredSkills = *sample.RequiredSkills
+	}
+
+	decision, err := h.ruleSvc.DryRun(r.Context(), rule, pr)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.ReviewRuleDryRunResult{
+		TeamName:       decision.TeamName,
+		Reviewers:      decision.Reviewers,
+		RequiredSkills: decision.RequiredSkills,
+	}
+	if resp.RequiredSkills == nil {
+		resp.RequiredSkills = []string{}
+	}
+	if decision.Rule != "" {
+		resp.RuleName = &decision.Rule
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// --- Saved filters ---
+
+func (h *Handler) PostSavedFilterAdd(w http.ResponseWriter, r *http.Request) {
+	var req api.PostSavedFilterAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	filter := &domain.SavedFilter{Name: req.Name}
+	if req.UserId != nil {
+		filter.UserID = *req.UserId
+	}
+	if req.TeamName != nil {
+		filter.TeamName = *req.TeamName
+	}
+	applyPRFilter(&filter.Filter, req.Filter)
+
+	saved, err := h.filterSvc.CreateFilter(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, savedFilterToAPI(saved))
+}
+
+func (h *Handler) GetSavedFilterGet(w http.ResponseWriter, r *http.Request, params api.GetSavedFilterGetParams) {
+	filter, err := h.filterSvc.GetFilter(r.Context(), params.FilterId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, savedFilterToAPI(filter))
+}
+
+func (h *Handler) GetSavedFilterList(w http.ResponseWriter, r *http.Request, params api.GetSavedFilterListParams) {
+	var userID, teamName string
+	if params.UserId != nil {
+		userID = *params.UserId
+	}
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	filters, err := h.filterSvc.ListFilters(r.Context(), userID, teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.SavedFilter, len(filters))
+	for i := range filters {
+		resp[i] = savedFilterToAPI(&filters[i])
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostSavedFilterEdit(w http.ResponseWriter, r *http.Request) {
+	var req api.PostSavedFilterEditJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	filter := &domain.SavedFilter{ID: req.FilterId, Name: req.Name}
+	applyPRFilter(&filter.Filter, req.Filter)
+
+	saved, err := h.filterSvc.UpdateFilter(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, savedFilterToAPI(saved))
+}
+
+func (h *Handler) PostSavedFilterDelete(w http.ResponseWriter, r *http.Request) {
+	var req api.PostSavedFilterDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.filterSvc.DeleteFilter(r.Context(), req.FilterId); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// --- PR templates ---
+
+func (h *Handler) PostPrTemplateAdd(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPrTemplateAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	template := &domain.PRTemplate{Name: req.Name, TeamName: req.TeamName}
+	applyPRTemplate(template, req.NamePrefix, req.Labels, req.DefaultReviewers)
+
+	saved, err := h.templateSvc.CreateTemplate(r.Context(), template)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, prTemplateToAPI(saved))
+}
+
+func (h *Handler) GetPrTemplateGet(w http.ResponseWriter, r *http.Request, params api.GetPrTemplateGetParams) {
+	template, err := h.templateSvc.GetTemplate(r.Context(), params.TemplateId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prTemplateToAPI(template))
+}
+
+func (h *Handler) GetPrTemplateList(w http.ResponseWriter, r *http.Request, params api.GetPrTemplateListParams) {
+	templates, err := h.templateSvc.ListTemplates(r.Context(), params.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.PRTemplate, len(templates))
+	for i := range templates {
+		resp[i] = prTemplateToAPI(&templates[i])
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostPrTemplateEdit(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPrTemplateEditJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	template := &domain.PRTemplate{ID: req.TemplateId, Name: req.Name}
+	applyPRTemplate(template, req.NamePrefix, req.Labels, req.DefaultReviewers)
+
+	saved, err := h.templateSvc.UpdateTemplate(r.Context(), template)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, prTemplateToAPI(saved))
+}
+
+func (h *Handler) PostPrTemplateDelete(w http.ResponseWriter, r *http.Request) {
+	var req api.PostPrTemplateDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.templateSvc.DeleteTemplate(r.Context(), req.TemplateId); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// --- Admin ---
+
+func (h *Handler) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	dump, err := h.adminSvc.Export(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, dumpToAPI(dump))
+}
+
+func (h *Handler) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+	var req api.PostAdminImportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.adminSvc.Import(r.Context(), dumpFromAPI(&req))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.ImportResponse{
+		TeamsImported:        result.Teams,
+		UsersImported:        result.Users,
+		PullRequestsImported: result.PullRequests,
+		AssignmentsImported:  result.Assignments,
+	})
+}
+
+func (h *Handler) PostAdminRebalance(w http.ResponseWriter, r *http.Request) {
+	var req api.PostAdminRebalanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.adminSvc.Rebalance(r.Context(), req.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	moves := make([]api.RebalanceMove, len(report.Moves))
+	for i, m := range report.Moves {
+		moves[i] = api.RebalanceMove{PullRequestId: m.PRID, FromUserId: m.FromUserID, ToUserId: m.ToUserID}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.RebalanceResponse{
+		TeamName:   report.TeamName,
+		Moves:      moves,
+		LoadBefore: userLoadsToAPI(report.LoadBefore),
+		LoadAfter:  userLoadsToAPI(report.LoadAfter),
+	})
+}
+
+func (h *Handler) PostAdminUsersMerge(w http.ResponseWriter, r *http.Request) {
+	var req api.PostAdminUsersMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.adminSvc.MergeUsers(r.Context(), req.SourceUserId, req.TargetUserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.UserMergeResponse{
+		SourceUserId:       result.SourceUserID,
+		TargetUserId:       result.TargetUserID,
+		ReviewsMoved:       result.ReviewsMoved,
+		ReviewsDropped:     result.ReviewsDropped,
+		PullRequestsMoved:  result.PullRequestsMoved,
+		GithubAccountMoved: result.GitHubAccountMoved,
+	})
+}
+
+func (h *Handler) PostAdminArchive(w http.ResponseWriter, r *http.Request) {
+	var req api.PostAdminArchiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	archived, err := h.archiveSvc.ArchiveMergedOlderThan(r.Context(), time.Duration(req.MergedBeforeDays)*24*time.Hour)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.ArchiveResponse{ArchivedCount: archived})
+}
+
+func (h *Handler) PostAdminEventsReplay(w http.ResponseWriter, r *http.Request) {
+	var req api.PostAdminEventsReplayJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	filter := domain.NotificationFilter{Since: req.Since}
+	if req.Event != nil {
+		filter.Event = domain.NotificationEvent(*req.Event)
+	}
+	if req.UserId != nil {
+		filter.UserID = *req.UserId
+	}
+	if req.Until != nil {
+		filter.Until = *req.Until
+	}
+
+	replayed, err := h.notifySvc.Replay(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.EventReplayResponse{ReplayedCount: replayed})
+}
+
+func (h *Handler) GetAdminNotificationsFailed(w http.ResponseWriter, r *http.Request, params api.GetAdminNotificationsFailedParams) {
+	var filter domain.DeadLetterFilter
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if params.Event != nil {
+		filter.Event = domain.NotificationEvent(*params.Event)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+		if filter.Limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	letters, err := h.notifySvc.ListDeadLetters(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.FailedNotificationList{Notifications: make([]api.FailedNotification, len(letters))}
+	for i := range letters {
+		l := &letters[i]
+		resp.Notifications[i] = api.FailedNotification{
+			Id:        l.ID,
+			UserId:    l.UserID,
+			Event:     string(l.Event),
+			Message:   l.Message,
+			Attempts:  l.Attempts,
+			LastError: l.LastError,
+			CreatedAt: l.CreatedAt,
+			FailedAt:  l.DeadLetteredAt,
+		}
+		if l.PRID != "" {
+			resp.Notifications[i].PullRequestId = &l.PRID
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) GetAdminWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params api.GetAdminWebhooksDeliveriesParams) {
+	var filter domain.WebhookDeliveryFilter
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if params.Status != nil {
+		filter.Status = string(*params.Status)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+		if filter.Limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	deliveries, err := h.webhookSvc.ListDeliveries(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.WebhookDeliveryList{Deliveries: make([]api.WebhookDelivery, len(deliveries))}
+	for i := range deliveries {
+		resp.Deliveries[i] = webhookDeliveryToAPI(&deliveries[i])
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, deliveryId int64) {
+	d, err := h.webhookSvc.Redeliver(r.Context(), deliveryId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, webhookDeliveryToAPI(d))
+}
+
+func webhookDeliveryToAPI(d *domain.WebhookDelivery) api.WebhookDelivery {
+	resp := api.WebhookDelivery{
+		Id:           d.ID,
+		UserId:       d.UserID,
+		Url:          d.URL,
+		RequestBody:  d.RequestBody,
+		DurationMs:   d.Duration.Milliseconds(),
+		RedeliveryOf: d.RedeliveryOf,
+		AttemptedAt:  d.AttemptedAt,
+	}
+	if d.NotificationID != 0 {
+		resp.NotificationId = &d.NotificationID
+	}
+	if d.StatusCode != 0 {
+		resp.StatusCode = &d.StatusCode
+	}
+	if d.ResponseBody != "" {
+		resp.ResponseBody = &d.ResponseBody
+	}
+	if d.Error != "" {
+		resp.Error = &d.Error
+	}
+	return resp
+}
+
+func (h *Handler) GetAdminWebhooksInbound(w http.ResponseWriter, r *http.Request, params api.GetAdminWebhooksInboundParams) {
+	var filter domain.InboundWebhookFilter
+	if params.Status != nil {
+		filter.Status = domain.InboundWebhookStatus(*params.Status)
+	}
+	if params.Event != nil {
+		filter.Event = *params.Event
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+		if filter.Limit == 0 {
+			h.respondError(w, r, api.VALIDATIONERROR, "limit must be positive", http.StatusBadRequest)
+			return
+		}
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	webhooks, err := h.githubApp.ListInboundWebhooks(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.InboundWebhookList{Webhooks: make([]api.InboundWebhook, len(webhooks))}
+	for i := range webhooks {
+		resp.Webhooks[i] = inboundWebhookToAPI(&webhooks[i])
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (h *Handler) PostAdminWebhooksInboundWebhookIdReprocess(w http.ResponseWriter, r *http.Request, webhookId int64) {
+	webhook, err := h.githubApp.ReprocessInboundWebhook(r.Context(), webhookId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, inboundWebhookToAPI(webhook))
+}
+
+func inboundWebhookToAPI(w *domain.InboundWebhook) api.InboundWebhook {
+	resp := api.InboundWebhook{
+		Id:          w.ID,
+		Source:      api.InboundWebhookSource(w.Source),
+		Event:       w.Event,
+		Payload:     w.Payload,
+		Status:      api.InboundWebhookStatus(w.Status),
+		Attempts:    w.Attempts,
+		ReceivedAt:  w.ReceivedAt,
+		ProcessedAt: w.ProcessedAt,
+	}
+	if w.DeliveryID != "" {
+		resp.DeliveryId = &w.DeliveryID
+	}
+	if w.IngestionToken != "" {
+		resp.IngestionToken = &w.IngestionToken
+	}
+	if w.Error != "" {
+		resp.Error = &w.Error
+	}
+	return resp
+}
+
+// --- Settings ---
+
+func (h *Handler) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.settingsSvc.GetSettings(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, orgSettingsToAPI(settings))
+}
+
+func (h *Handler) PutAdminSettings(w http.ResponseWriter, r *http.Request) {
+	var req api.PutAdminSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	settings, err := h.settingsSvc.UpdateSettings(r.Context(), domain.Settings{
+		MaxReviewers:      req.MaxReviewers,
+		SelectionStrategy: domain.SelectionStrategy(req.SelectionStrategy),
+		ReviewSLAHours:    req.ReviewSlaHours,
+		MaxOpenReviews:    req.MaxOpenReviews,
+		AutoMerge:         req.Features.AutoMerge,
+		ShadowReviews:     req.Features.ShadowReviews,
+	})
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, orgSettingsToAPI(settings))
+}
+
+func (h *Handler) GetAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string) {
+	overrides, effective, err := h.settingsSvc.GetTeamSettings(r.Context(), teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamSettingsToAPI(overrides, effective))
+}
+
+func (h *Handler) PutAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string) {
+	var req api.PutAdminSettingsTeamsTeamNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	overrides := domain.TeamSettings{
+		MaxReviewers:   req.MaxReviewers,
+		ReviewSLAHours: req.ReviewSlaHours,
+		MaxOpenReviews: req.MaxOpenReviews,
+	}
+	if req.SelectionStrategy != nil {
+		strategy := domain.SelectionStrategy(*req.SelectionStrategy)
+		overrides.SelectionStrategy = &strategy
+	}
+	if req.Features != nil {
+		overrides.AutoMerge = req.Features.AutoMerge
+		overrides.ShadowReviews = req.Features.ShadowReviews
+	}
+	saved, effective, err := h.settingsSvc.SetTeamSettings(r.Context(), teamName, overrides)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamSettingsToAPI(saved, effective))
+}
+
+func settingsToAPI(s domain.Settings) api.Settings {
+	return api.Settings{
+		MaxReviewers:      s.MaxReviewers,
+		SelectionStrategy: api.SettingsSelectionStrategy(s.SelectionStrategy),
+		ReviewSlaHours:    s.ReviewSLAHours,
+		MaxOpenReviews:    s.MaxOpenReviews,
+		Features: api.SettingsFeatures{
+			AutoMerge:     s.AutoMerge,
+			ShadowReviews: s.ShadowReviews,
+		},
+	}
+}
+
+func orgSettingsToAPI(s *domain.OrgSettings) api.OrgSettings {
+	resp := api.OrgSettings{
+		Defaults: settingsToAPI(s.Defaults),
+		Teams:    make([]api.TeamSettings, len(s.Teams)),
+	}
+	if !s.Defaults.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &s.Defaults.UpdatedAt
+	}
+	for i := range s.Teams {
+		resp.Teams[i] = teamSettingsToAPI(&s.Teams[i], s.Teams[i].Apply(s.Defaults))
+	}
+	return resp
+}
+
+func teamSettingsToAPI(t *domain.TeamSettings, effective domain.Settings) api.TeamSettings {
+	resp := api.TeamSettings{
+		TeamName:  t.TeamName,
+		Effective: settingsToAPI(effective),
+		Overrides: api.SettingsOverride{
+			MaxReviewers:   t.MaxReviewers,
+			ReviewSlaHours: t.ReviewSLAHours,
+			MaxOpenReviews: t.MaxOpenReviews,
+		},
+	}
+	if t.SelectionStrategy != nil {
+		strategy := api.SettingsOverrideSelectionStrategy(*t.SelectionStrategy)
+		resp.Overrides.SelectionStrategy = &strategy
+	}
+	if t.AutoMerge != nil || t.ShadowReviews != nil {
+		resp.Overrides.Features = &api.SettingsFeaturesOverride{AutoMerge: t.AutoMerge, ShadowReviews: t.ShadowReviews}
+	}
+	if !t.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &t.UpdatedAt
+	}
+	return resp
+}
+
+// --- GitHub ---
+
+func (h *Handler) PostGithubLinkUser(w http.ResponseWriter, r *http.Request) {
+	var req api.PostGithubLinkUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	account, err := h.githubSvc.SetUserLogin(r.Context(), req.UserId, req.GithubLogin)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.GitHubAccount{UserId: account.UserID, GithubLogin: account.Login})
+}
+
+func (h *Handler) PostGithubLinkPullRequest(w http.ResponseWriter, r *http.Request) {
+	var req api.PostGithubLinkPullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	link, err := h.githubSvc.LinkPR(r.Context(), req.PullRequestId, req.Repository, req.Number)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := api.GitHubPullRequestLink{
+		PullRequestId: link.PRID,
+		Repository:    link.Repository,
+		Number:        link.Number,
+	}
+	if link.SyncError != "" {
+		resp.SyncError = &link.SyncError
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// maxWebhookPayload is GitHub's own limit for webhook deliveries.
+const maxWebhookPayload = 25 << 20
+
+func (h *Handler) PostGithubWebhook(w http.ResponseWriter, r *http.Request, params api.PostGithubWebhookParams) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayload))
+	if err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var deliveryID string
+	if params.XGitHubDelivery != nil {
+		deliveryID = *params.XGitHubDelivery
+	}
+	if err := h.githubApp.HandleWebhook(r.Context(), params.XGitHubEvent, deliveryID, params.XHubSignature256, payload); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) GetGithubRepositories(w http.ResponseWriter, r *http.Request) {
+	repos, err := h.githubApp.ListRepos(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.GitHubRepository, len(repos))
+	for i := range repos {
+		resp[i] = *githubRepoToAPI(&repos[i])
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, map[string][]api.GitHubRepository{"repositories": resp})
+}
+
+func (h *Handler) PostGithubSetRepositoryTeam(w http.ResponseWriter, r *http.Request) {
+	var req api.PostGithubSetRepositoryTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	repo, err := h.githubApp.SetRepoTeam(r.Context(), req.Repository, req.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, githubRepoToAPI(repo))
+}
+
+func (h *Handler) PostGithubSyncTeams(w http.ResponseWriter, r *http.Request) {
+	report, err := h.teamSyncSvc.Sync(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamSyncReportToAPI(report))
+}
+
+func (h *Handler) GetGithubTeamSyncReport(w http.ResponseWriter, r *http.Request) {
+	report, err := h.teamSyncSvc.LatestReport(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamSyncReportToAPI(report))
+}
+
+func (h *Handler) PostGithubIngestionTokensCreate(w http.ResponseWriter, r *http.Request) {
+	var req api.PostGithubIngestionTokensCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	token, err := h.githubApp.CreateIngestionToken(r.Context(), req.Repository, req.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, ingestionTokenToAPI(token))
+}
+
+func (h *Handler) GetGithubIngestionTokens(w http.ResponseWriter, r *http.Request, params api.GetGithubIngestionTokensParams) {
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+	tokens, err := h.githubApp.ListIngestionTokens(r.Context(), teamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	resp := make([]api.GitHubIngestionToken, len(tokens))
+	for i := range tokens {
+		resp[i] = *ingestionTokenToAPI(&tokens[i])
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, map[string][]api.GitHubIngestionToken{"tokens": resp})
+}
+
+func (h *Handler) PostGithubIngestionTokensRevoke(w http.ResponseWriter, r *http.Request) {
+	var req api.PostGithubIngestionTokensRevokeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.githubApp.RevokeIngestionToken(r.Context(), req.Repository); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) PostSlackLinkUser(w http.ResponseWriter, r *http.Request) {
+	var req api.PostSlackLinkUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	account, err := h.slackSvc.SetUserSlackID(r.Context(), req.UserId, req.SlackUserId)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, api.SlackAccount{UserId: account.UserID, SlackUserId: account.SlackUserID})
+}
+
+// --- Error Helpers ---
+
+func (h *Handler) handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	code, message, httpStatus := h.serviceError(r, err)
+	h.respondError(w, r, code, message, httpStatus)
+}
+
+// serviceError logs an error returned by a service and maps it to the API
+// error code, the message shown to the client and the HTTP status.
+func (h *Handler) serviceError(r *http.Request, err error) (api.ErrorResponseErrorCode, string, int) {
+	// Repositories hide context errors behind ErrInternalError, so a request
+	// that ran out of time is recognised by its context.
+	if !errors.Is(err, domain.ErrTimeout) && errors.Is(r.Context().Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%w: %w", domain.ErrTimeout, err)
+	}
+	var message = err.Error()
+	entry := lookupError(err)
+	code, httpStatus := entry.code, entry.status
+
+	if httpStatus == http.StatusInternalServerError {
+		h.log.ErrorContext(r.Context(), "internal server error", slog.String("error", err.Error()))
+		message = "internal server error"
+	} else {
+		h.log.InfoContext(r.Context(), "client error", slog.String("error", err.Error()), "code", string(code))
+	}
+	return code, message, httpStatus
+}
+
+func (h *Handler) respondError(w http.ResponseWriter, r *http.Request, code api.ErrorResponseErrorCode, message string, httpStatus int) {
+	h.respondErrorDetails(w, r, code, message, httpStatus, nil)
+}
+
+func (h *Handler) respondErrorDetails(w http.ResponseWriter, r *http.Request, code api.ErrorResponseErrorCode, message string, httpStatus int, details []api.ErrorDetail) {
+	if domain.RedactPII() {
+		message = redactEmails(message)
+		for i := range details {
+			details[i].Reason = redactEmails(details[i].Reason)
+		}
+	}
+	var resp api.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+	if len(details) > 0 {
+		resp.Error.Details = &details
+	}
+	if reqID := middleware.GetReqID(r.Context()); reqID != "" {
+		resp.Error.RequestId = &reqID
+	}
+	docsURL := errorDocsURL(code)
+	resp.Error.DocsUrl = &docsURL
+
+	render.Status(r, httpStatus)
+	render.JSON(w, r, resp)
+}
+
+// --- Mappers ---
+
 func teamToAPI(team *domain.Team) *api.Team {
 	members := make([]api.TeamMember, len(team.Members))
 	for i, m := range team.Members {
 		members[i] = api.TeamMember{
-			IsActive: m.IsActive,
+			IsActive: &m.IsActive,
 			UserId:   m.ID,
 			Username: m.Username,
 		}
 	}
-	return &api.Team{
-		TeamName: team.TeamName,
-		Members:  members,
+	resp := &api.Team{
+		TeamName: team.TeamName,
+		Members:  members,
+	}
+	resp.DeactivateAt = team.DeactivateAt
+	if team.AllowDuplicateUsernames {
+		resp.AllowDuplicateUsernames = &team.AllowDuplicateUsernames
+	}
+	if team.AllowSelfReview {
+		resp.AllowSelfReview = &team.AllowSelfReview
+	}
+	if team.ReviewCooldownPRs > 0 {
+		resp.ReviewCooldownPrs = &team.ReviewCooldownPRs
+	}
+	if team.OptionalReviewers > 0 {
+		resp.OptionalReviewers = &team.OptionalReviewers
+	}
+	if team.ShadowReviewPercent > 0 {
+		resp.ShadowReviewPercent = &team.ShadowReviewPercent
+	}
+	if len(team.SizeRules) > 0 {
+		rules := make([]api.SizeRule, len(team.SizeRules))
+		for i, rule := range team.SizeRules {
+			rules[i] = api.SizeRule{MaxLinesChanged: rule.MaxLinesChanged, MaxFilesChanged: rule.MaxFilesChanged, Reviewers: rule.Reviewers}
+		}
+		resp.SizeRules = &rules
+	}
+	if len(team.Checklist.Items) > 0 {
+		resp.Checklist = &api.ChecklistTemplate{
+			Items:             team.Checklist.Items,
+			RequireCompletion: &team.Checklist.RequireCompletion,
+		}
+	}
+	if team.Escalation.Enabled() || team.Escalation.LeadUserID != "" {
+		resp.Escalation = &api.EscalationPolicy{
+			NotifyLeadAfterHours:  team.Escalation.NotifyLeadAfterHours,
+			AddReviewerAfterHours: team.Escalation.AddReviewerAfterHours,
+		}
+		if team.Escalation.LeadUserID != "" {
+			resp.Escalation.LeadUserId = &team.Escalation.LeadUserID
+		}
+	}
+	return resp
+}
+
+func teamSnapshotToAPI(snapshot *domain.TeamSnapshot) *api.TeamSnapshot {
+	team := teamToAPI(&snapshot.Team)
+	resp := &api.TeamSnapshot{
+		TeamName:                team.TeamName,
+		Members:                 make([]api.TeamSnapshotMember, len(snapshot.Team.Members)),
+		OptionalReviewers:       team.OptionalReviewers,
+		ShadowReviewPercent:     team.ShadowReviewPercent,
+		Escalation:              team.Escalation,
+		ReviewCooldownPrs:       team.ReviewCooldownPrs,
+		Checklist:               team.Checklist,
+		SizeRules:               team.SizeRules,
+		AllowDuplicateUsernames: team.AllowDuplicateUsernames,
+		AllowSelfReview:         team.AllowSelfReview,
+	}
+	for i, m := range snapshot.Team.Members {
+		resp.Members[i] = api.TeamSnapshotMember{UserId: m.ID, Username: m.Username, IsActive: m.IsActive}
+		if m.Role != "" {
+			resp.Members[i].Role = &m.Role
+		}
+		if len(m.Skills) > 0 {
+			resp.Members[i].Skills = &m.Skills
+		}
+		if m.InTraining {
+			resp.Members[i].InTraining = &m.InTraining
+		}
+	}
+	if snapshot.Team.RequiredReviewerRole != "" {
+		resp.RequiredReviewerRole = &snapshot.Team.RequiredReviewerRole
+	}
+
+	prefs := reviewerPreferencesToAPI(snapshot.Team.TeamName, snapshot.ReviewerPreferences).Preferences
+	resp.ReviewerPreferences = &prefs
+	rules := make([]api.ReviewRule, len(snapshot.ReviewRules))
+	// Read-only fields are left out so that the snapshot is accepted by /team/import as is.
+	for i := range snapshot.ReviewRules {
+		rules[i] = reviewRuleToAPI(&snapshot.ReviewRules[i])
+		rules[i].CreatedAt = nil
+	}
+	resp.ReviewRules = &rules
+	templates := make([]api.PRTemplate, len(snapshot.PRTemplates))
+	for i := range snapshot.PRTemplates {
+		templates[i] = prTemplateToAPI(&snapshot.PRTemplates[i])
+		templates[i].TemplateId, templates[i].CreatedAt, templates[i].UpdatedAt = nil, nil, nil
+	}
+	resp.PrTemplates = &templates
+
+	if b := snapshot.ReviewBudget; b != nil {
+		resp.ReviewBudget = &api.TeamSnapshotReviewBudget{ReviewsPerSprint: b.ReviewsPerSprint, SprintDays: &b.SprintDays}
+	}
+	if s := snapshot.ReportSchedule; s != nil {
+		weekday := api.Weekday(strings.ToLower(s.Weekday.String()))
+		resp.ReportSchedule = &api.TeamSnapshotReportSchedule{Weekday: weekday, Hour: s.Hour, Timezone: &s.Timezone}
+	}
+	if q := snapshot.PRQuota; q != nil {
+		mode := api.PRQuotaMode(q.Mode)
+		resp.PrQuota = &api.TeamSnapshotPRQuota{MaxOpenPrs: q.MaxOpenPRs, Mode: &mode}
+	}
+	if rot := snapshot.ReviewRotation; rot != nil {
+		resp.ReviewRotation = &api.TeamSnapshotReviewRotation{StartDate: openapi_types.Date{Time: rot.StartDate}, MemberIds: rot.MemberIDs}
+	}
+	if len(snapshot.ReviewerPool) > 0 {
+		resp.ReviewerPool = &snapshot.ReviewerPool
+	}
+	if snapshot.InactiveReassignOptOut {
+		resp.InactiveReassignOptOut = &snapshot.InactiveReassignOptOut
+	}
+	return resp
+}
+
+func teamSnapshotFromAPI(req *api.TeamSnapshot) (*domain.TeamSnapshot, error) {
+	snapshot := &domain.TeamSnapshot{Team: domain.Team{
+		TeamName:  req.TeamName,
+		Members:   snapshotMembersFromAPI(req.Members),
+		SizeRules: sizeRulesFromAPI(req.SizeRules),
+	}}
+	t := &snapshot.Team
+	snapshotSettingsFromAPI(t, req)
+	if policy := escalationPolicyFromAPI(req.Escalation); policy != nil {
+		t.Escalation = *policy
+	}
+	if checklist := checklistTemplateFromAPI(req.Checklist); checklist != nil {
+		t.Checklist = *checklist
+	}
+	snapshotRulesFromAPI(snapshot, req)
+	if err := snapshotSchedulesFromAPI(snapshot, req); err != nil {
+		return nil, err
+	}
+	if req.ReviewerPool != nil {
+		snapshot.ReviewerPool = *req.ReviewerPool
+	}
+	if req.InactiveReassignOptOut != nil {
+		snapshot.InactiveReassignOptOut = *req.InactiveReassignOptOut
+	}
+	return snapshot, nil
+}
+
+func snapshotMembersFromAPI(members []api.TeamSnapshotMember) []domain.User {
+	users := make([]domain.User, len(members))
+	for i, m := range members {
+		users[i] = domain.User{ID: m.UserId, Username: m.Username, IsActive: m.IsActive}
+		if m.Role != nil {
+			users[i].Role = *m.Role
+		}
+		if m.Skills != nil {
+			users[i].Skills = *m.Skills
+		}
+		if m.InTraining != nil {
+			users[i].InTraining = *m.InTraining
+		}
+	}
+	return users
+}
+
+// snapshotSettingsFromAPI copies the scalar team settings of the snapshot.
+func snapshotSettingsFromAPI(t *domain.Team, req *api.TeamSnapshot) {
+	if req.RequiredReviewerRole != nil {
+		t.RequiredReviewerRole = *req.RequiredReviewerRole
+	}
+	if req.OptionalReviewers != nil {
+		t.OptionalReviewers = *req.OptionalReviewers
+	}
+	if req.ShadowReviewPercent != nil {
+		t.ShadowReviewPercent = *req.ShadowReviewPercent
+	}
+	if req.ReviewCooldownPrs != nil {
+		t.ReviewCooldownPRs = *req.ReviewCooldownPrs
+	}
+	if req.AllowDuplicateUsernames != nil {
+		t.AllowDuplicateUsernames = *req.AllowDuplicateUsernames
+	}
+	if req.AllowSelfReview != nil {
+		t.AllowSelfReview = *req.AllowSelfReview
+	}
+}
+
+func escalationPolicyFromAPI(req *api.EscalationPolicy) *domain.EscalationPolicy {
+	if req == nil {
+		return nil
+	}
+	policy := &domain.EscalationPolicy{
+		NotifyLeadAfterHours:  req.NotifyLeadAfterHours,
+		AddReviewerAfterHours: req.AddReviewerAfterHours,
+	}
+	if req.LeadUserId != nil {
+		policy.LeadUserID = *req.LeadUserId
+	}
+	return policy
+}
+
+func checklistTemplateFromAPI(req *api.ChecklistTemplate) *domain.ChecklistTemplate {
+	if req == nil {
+		return nil
+	}
+	checklist := &domain.ChecklistTemplate{Items: req.Items}
+	if req.RequireCompletion != nil {
+		checklist.RequireCompletion = *req.RequireCompletion
+	}
+	return checklist
+}
+
+// sizeRulesFromAPI returns nil when the rules are absent and an empty slice
+// when they are given empty.
+func sizeRulesFromAPI(req *[]api.SizeRule) []domain.SizeRule {
+	if req == nil {
+		return nil
+	}
+	rules := make([]domain.SizeRule, len(*req))
+	for i, rule := range *req {
+		rules[i] = domain.SizeRule{MaxLinesChanged: rule.MaxLinesChanged, MaxFilesChanged: rule.MaxFilesChanged, Reviewers: rule.Reviewers}
+	}
+	return rules
+}
+
+// snapshotRulesFromAPI copies the reviewer preferences, review rules and PR
+// templates of the snapshot.
+func snapshotRulesFromAPI(snapshot *domain.TeamSnapshot, req *api.TeamSnapshot) {
+	if req.ReviewerPreferences != nil {
+		for _, p := range *req.ReviewerPreferences {
+			snapshot.ReviewerPreferences = append(snapshot.ReviewerPreferences, domain.ReviewerPreference{AuthorID: p.AuthorId, ReviewerID: p.ReviewerId, Weight: p.Weight})
+		}
+	}
+	if req.ReviewRules != nil {
+		for _, rule := range *req.ReviewRules {
+			snapshot.ReviewRules = append(snapshot.ReviewRules, *reviewRuleFromAPI(rule))
+		}
+	}
+	if req.PrTemplates != nil {
+		for _, pt := range *req.PrTemplates {
+			template := domain.PRTemplate{Name: pt.Name}
+			applyPRTemplate(&template, pt.NamePrefix, pt.Labels, pt.DefaultReviewers)
+			snapshot.PRTemplates = append(snapshot.PRTemplates, template)
+		}
+	}
+}
+
+// snapshotSchedulesFromAPI copies the review budget, report schedule, PR
+// quota and review rotation of the snapshot.
+func snapshotSchedulesFromAPI(snapshot *domain.TeamSnapshot, req *api.TeamSnapshot) error {
+	if b := req.ReviewBudget; b != nil {
+		snapshot.ReviewBudget = &domain.ReviewBudget{ReviewsPerSprint: b.ReviewsPerSprint}
+		if b.SprintDays != nil {
+			snapshot.ReviewBudget.SprintDays = *b.SprintDays
+		}
+	}
+	if s := req.ReportSchedule; s != nil {
+		weekday, ok := weekdayFromAPI(s.Weekday)
+		if !ok {
+			return fmt.Errorf("%w: unknown weekday", domain.ErrValidation)
+		}
+		snapshot.ReportSchedule = &domain.TeamReportSchedule{Weekday: weekday, Hour: s.Hour}
+		if s.Timezone != nil {
+			snapshot.ReportSchedule.Timezone = *s.Timezone
+		}
+	}
+	if q := req.PrQuota; q != nil {
+		snapshot.PRQuota = &domain.PRQuota{MaxOpenPRs: q.MaxOpenPrs}
+		if q.Mode != nil {
+			snapshot.PRQuota.Mode = domain.PRQuotaMode(*q.Mode)
+		}
+	}
+	if rot := req.ReviewRotation; rot != nil {
+		snapshot.ReviewRotation = &domain.ReviewRotation{StartDate: rot.StartDate.Time, MemberIDs: rot.MemberIds}
+	}
+	return nil
+}
+
+// isFuture reports whether an optional timestamp from a request lies ahead.
+func isFuture(t *time.Time) bool {
+	return t != nil && t.After(time.Now())
+}
+
+func teamHierarchyToAPI(hierarchy *domain.TeamHierarchy) *api.TeamHierarchy {
+	children := make([]string, len(hierarchy.Children))
+	for i, c := range hierarchy.Children {
+		children[i] = c.TeamName
+	}
+
+	var parentName *string
+	if hierarchy.Parent != nil {
+		parentName = &hierarchy.Parent.TeamName
+	}
+
+	return &api.TeamHierarchy{
+		TeamName:         hierarchy.Team.TeamName,
+		ParentTeamName:   parentName,
+		EscalateToParent: hierarchy.Team.EscalateToParent,
+		Children:         children,
+	}
+}
+
+func reviewBudgetToAPI(b *domain.TeamReviewBudget) api.TeamReviewBudget {
+	resp := api.TeamReviewBudget{
+		TeamName: b.TeamName,
+		Used:     b.Used(),
+		Total:    b.Total(),
+		Members:  make([]api.ReviewBudgetMember, len(b.Members)),
+	}
+	if b.Budget != nil {
+		startedAt, endsAt := b.Budget.SprintStartedAt, b.Budget.SprintEndsAt()
+		resp.ReviewsPerSprint = b.Budget.ReviewsPerSprint
+		resp.SprintDays = &b.Budget.SprintDays
+		resp.SprintStartedAt = &startedAt
+		resp.SprintEndsAt = &endsAt
+	}
+	for i, m := range b.Members {
+		resp.Members[i] = api.ReviewBudgetMember{
+			UserId:    m.User.ID,
+			Username:  m.User.Username,
+			IsActive:  m.User.IsActive,
+			Used:      m.Used,
+			Remaining: max(0, resp.ReviewsPerSprint-m.Used),
+		}
+	}
+	return resp
+}
+
+func reportScheduleToAPI(teamName string, s *domain.TeamReportSchedule) api.TeamReportSchedule {
+	resp := api.TeamReportSchedule{TeamName: teamName}
+	if s == nil {
+		return resp
+	}
+	weekday := api.Weekday(strings.ToLower(s.Weekday.String()))
+	resp.Enabled = true
+	resp.Weekday = &weekday
+	resp.Hour = &s.Hour
+	resp.Timezone = &s.Timezone
+	resp.LastSentAt = s.LastSentAt
+	return resp
+}
+
+func prQuotaToAPI(teamName string, q *domain.PRQuota) api.TeamPRQuota {
+	resp := api.TeamPRQuota{TeamName: teamName}
+	if q == nil {
+		return resp
+	}
+	mode := api.PRQuotaMode(q.Mode)
+	resp.MaxOpenPrs = q.MaxOpenPRs
+	resp.Mode = &mode
+	return resp
+}
+
+func rotationScheduleToAPI(schedule *domain.RotationSchedule) api.TeamReviewRotation {
+	resp := api.TeamReviewRotation{
+		TeamName:  schedule.TeamName,
+		MemberIds: []string{},
+		Weeks:     make([]api.ReviewRotationWeek, len(schedule.Weeks)),
+	}
+	if rot := schedule.Rotation; rot != nil {
+		resp.StartDate = &openapi_types.Date{Time: rot.StartDate}
+		resp.MemberIds = rot.MemberIDs
+	}
+	for i, week := range schedule.Weeks {
+		resp.Weeks[i] = api.ReviewRotationWeek{WeekStart: openapi_types.Date{Time: week.WeekStart}, Overridden: week.Overridden}
+		if week.UserID != "" {
+			resp.Weeks[i].UserId = &week.UserID
+		}
+		if week.Username != "" {
+			resp.Weeks[i].Username = &week.Username
+		}
+	}
+	return resp
+}
+
+func reviewerPoolToAPI(pool *domain.ReviewerPool) api.TeamReviewerPool {
+	resp := api.TeamReviewerPool{TeamName: pool.TeamName, Members: make([]api.TeamMember, len(pool.Members))}
+	for i, m := range pool.Members {
+		resp.Members[i] = api.TeamMember{UserId: m.ID, Username: m.Username, IsActive: &m.IsActive}
+	}
+	return resp
+}
+
+func weekdayFromAPI(w api.Weekday) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == string(w) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+func teamReportToAPI(report *domain.TeamReport) api.TeamReport {
+	resp := api.TeamReport{
+		TeamName:    report.TeamName,
+		WindowStart: report.Since,
+		WindowEnd:   report.Until,
+		MergedPrs:   reportPRsToAPI(report.Merged),
+		SlaBreaches: reportPRsToAPI(report.SLABreaches),
+		Fairness:    teamFairnessToAPI(report.Fairness),
+	}
+	if report.AvgTurnaround != nil {
+		secs := report.AvgTurnaround.Seconds()
+		resp.AvgTurnaroundSeconds = &secs
+	}
+	return resp
+}
+
+func reportPRsToAPI(prs []domain.ReportPR) []api.ReportPR {
+	resp := make([]api.ReportPR, len(prs))
+	for i, pr := range prs {
+		resp[i] = api.ReportPR{
+			PullRequestId:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorId:        pr.AuthorID,
+			CreatedAt:       pr.CreatedAt,
+			At:              pr.At,
+		}
+	}
+	return resp
+}
+
+func teamFairnessToAPI(t domain.TeamFairness) api.TeamFairness {
+	return api.TeamFairness{
+		TeamName:     t.TeamName,
+		Members:      t.Members,
+		TotalReviews: t.TotalReviews,
+		MinReviews:   t.MinReviews,
+		MaxReviews:   t.MaxReviews,
+		Mean:         t.Mean,
+		Stddev:       t.StdDev,
+		Gini:         t.Gini,
+		MaxMinRatio:  t.MaxMinRatio,
+		ReviewCounts: t.ReviewCounts,
+	}
+}
+
+func reviewerPreferencesToAPI(teamName string, prefs []domain.ReviewerPreference) api.TeamReviewerPreferences {
+	resp := api.TeamReviewerPreferences{TeamName: teamName, Preferences: make([]api.ReviewerPreference, len(prefs))}
+	for i, p := range prefs {
+		resp.Preferences[i] = api.ReviewerPreference{AuthorId: p.AuthorID, ReviewerId: p.ReviewerID, Weight: p.Weight}
+	}
+	return resp
+}
+
+func reasonCountsToAPI(reasons []domain.ReasonCount) []api.ReasonCount {
+	resp := make([]api.ReasonCount, len(reasons))
+	for i, rc := range reasons {
+		resp[i] = api.ReasonCount{Reason: api.ReassignmentReason(rc.Reason), Count: rc.Count}
+	}
+	return resp
+}
+
+func declineReasonCountsToAPI(reasons []domain.DeclineReasonCount) []api.DeclineReasonCount {
+	resp := make([]api.DeclineReasonCount, len(reasons))
+	for i, rc := range reasons {
+		resp[i] = api.DeclineReasonCount{Reason: api.DeclineReason(rc.Reason), Count: rc.Count}
+	}
+	return resp
+}
+
+func reassignmentGroupsToAPI(groups []domain.ReassignmentGroup) []api.ReassignmentGroup {
+	resp := make([]api.ReassignmentGroup, len(groups))
+	for i, g := range groups {
+		resp[i] = api.ReassignmentGroup{
+			TeamName:       g.TeamName,
+			Total:          g.Total,
+			Reasons:        reasonCountsToAPI(g.Reasons),
+			DeclineReasons: declineReasonCountsToAPI(g.DeclineReasons),
+		}
+		if g.UserID != "" {
+			resp[i].UserId = &g.UserID
+		}
+	}
+	return resp
+}
+
+func workloadToAPI(w *domain.Workload) api.UserWorkload {
+	resp := api.UserWorkload{
+		UserId:          w.User.ID,
+		Username:        w.User.Username,
+		TeamName:        w.User.TeamName,
+		OpenReviews:     w.OpenReviews,
+		OpenAuthoredPrs: w.OpenAuthored,
+		IsAway:          w.Away(),
+		CanTakeReview:   w.CanTakeReview(),
+	}
+	if w.Capacity > 0 {
+		resp.Capacity = &w.Capacity
 	}
+	return resp
 }
 
 func userToAPI(user *domain.User) *api.User {
-	return &api.User{
+	resp := &api.User{
 		UserId:   user.ID,
 		Username: user.Username,
 		TeamName: user.TeamName,
 		IsActive: user.IsActive,
+		Role:     &user.Role,
+		Skills:   &user.Skills,
+	}
+	if user.InTraining {
+		resp.InTraining = &user.InTraining
+	}
+	return resp
+}
+
+func repositoryFromAPI(req api.Repository) *domain.Repository {
+	repo := &domain.Repository{Name: req.RepositoryName}
+	if req.DefaultTeamName != nil {
+		repo.DefaultTeamName = *req.DefaultTeamName
+	}
+	if req.RequiredReviewers != nil {
+		repo.RequiredReviewers = *req.RequiredReviewers
+	}
+	if req.RoutingRules != nil {
+		repo.RoutingRules = make([]domain.RoutingRule, len(*req.RoutingRules))
+		for i, rule := range *req.RoutingRules {
+			repo.RoutingRules[i] = domain.RoutingRule{TitlePrefix: rule.TitlePrefix}
+			if rule.TeamName != nil {
+				repo.RoutingRules[i].TeamName = *rule.TeamName
+			}
+			if rule.RequiredSkills != nil {
+				repo.RoutingRules[i].RequiredSkills = *rule.RequiredSkills
+			}
+		}
+	}
+	return repo
+}
+
+func repositoryToAPI(repo *domain.Repository) api.Repository {
+	rules := make([]api.RoutingRule, len(repo.RoutingRules))
+	for i, rule := range repo.RoutingRules {
+		rules[i] = api.RoutingRule{TitlePrefix: rule.TitlePrefix}
+		if rule.TeamName != "" {
+			rules[i].TeamName = &rule.TeamName
+		}
+		if len(rule.RequiredSkills) > 0 {
+			rules[i].RequiredSkills = &rule.RequiredSkills
+		}
+	}
+
+	resp := api.Repository{
+		RepositoryName: repo.Name,
+		RoutingRules:   &rules,
+		CreatedAt:      &repo.CreatedAt,
+	}
+	if repo.DefaultTeamName != "" {
+		resp.DefaultTeamName = &repo.DefaultTeamName
+	}
+	if repo.RequiredReviewers > 0 {
+		resp.RequiredReviewers = &repo.RequiredReviewers
+	}
+	return resp
+}
+
+func reviewRuleFromAPI(req api.ReviewRule) *domain.ReviewRule {
+	rule := &domain.ReviewRule{Name: req.RuleName, Enabled: true}
+	if req.Position != nil {
+		rule.Position = *req.Position
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Labels != nil {
+		rule.Labels = *req.Labels
+	}
+	if req.Repositories != nil {
+		rule.Repositories = *req.Repositories
+	}
+	if req.TeamName != nil {
+		rule.TeamName = *req.TeamName
+	}
+	if req.Reviewers != nil {
+		rule.Reviewers = *req.Reviewers
+	}
+	if req.RequiredSkills != nil {
+		rule.RequiredSkills = *req.RequiredSkills
+	}
+	return rule
+}
+
+func reviewRuleToAPI(rule *domain.ReviewRule) api.ReviewRule {
+	resp := api.ReviewRule{
+		RuleName:       rule.Name,
+		Position:       &rule.Position,
+		Enabled:        &rule.Enabled,
+		Labels:         &rule.Labels,
+		Repositories:   &rule.Repositories,
+		RequiredSkills: &rule.RequiredSkills,
+		CreatedAt:      &rule.CreatedAt,
+	}
+	if rule.TeamName != "" {
+		resp.TeamName = &rule.TeamName
+	}
+	if rule.Reviewers > 0 {
+		resp.Reviewers = &rule.Reviewers
+	}
+	return resp
+}
+
+// applyPRFilter overrides the fields of filter that req sets.
+func applyPRFilter(filter *domain.PRFilter, req api.PullRequestFilter) {
+	if req.Status != nil {
+		filter.Status = domain.PRStatus(*req.Status)
+	}
+	if req.AuthorId != nil {
+		filter.AuthorID = *req.AuthorId
+	}
+	if req.ReviewerId != nil {
+		filter.ReviewerID = *req.ReviewerId
+	}
+	if req.TeamName != nil {
+		filter.TeamName = *req.TeamName
+	}
+	if req.RepositoryName != nil {
+		filter.Repository = *req.RepositoryName
+	}
+	if req.Priority != nil {
+		filter.Priority = domain.PRPriority(*req.Priority)
+	}
+	if req.Label != nil {
+		filter.Label = *req.Label
+	}
+	if req.MinAgeHours != nil {
+		filter.MinAgeHours = *req.MinAgeHours
+	}
+	if req.Unreviewed != nil {
+		filter.Unreviewed = *req.Unreviewed
+	}
+}
+
+func prFilterToAPI(filter *domain.PRFilter) api.PullRequestFilter {
+	var resp api.PullRequestFilter
+	if filter.Status != "" {
+		status := api.PullRequestFilterStatus(filter.Status)
+		resp.Status = &status
+	}
+	if filter.AuthorID != "" {
+		resp.AuthorId = &filter.AuthorID
+	}
+	if filter.ReviewerID != "" {
+		resp.ReviewerId = &filter.ReviewerID
+	}
+	if filter.TeamName != "" {
+		resp.TeamName = &filter.TeamName
+	}
+	if filter.Repository != "" {
+		resp.RepositoryName = &filter.Repository
+	}
+	if filter.Priority != "" {
+		p := api.PullRequestPriority(filter.Priority)
+		resp.Priority = &p
+	}
+	if filter.Label != "" {
+		resp.Label = &filter.Label
+	}
+	if filter.MinAgeHours > 0 {
+		resp.MinAgeHours = &filter.MinAgeHours
+	}
+	if filter.Unreviewed {
+		resp.Unreviewed = &filter.Unreviewed
+	}
+	return resp
+}
+
+func savedFilterToAPI(filter *domain.SavedFilter) api.SavedFilter {
+	resp := api.SavedFilter{
+		FilterId:  &filter.ID,
+		Name:      filter.Name,
+		Filter:    prFilterToAPI(&filter.Filter),
+		CreatedAt: &filter.CreatedAt,
+		UpdatedAt: &filter.UpdatedAt,
+	}
+	if filter.UserID != "" {
+		resp.UserId = &filter.UserID
+	}
+	if filter.TeamName != "" {
+		resp.TeamName = &filter.TeamName
+	}
+	return resp
+}
+
+func applyPRTemplate(template *domain.PRTemplate, namePrefix *string, labels, defaultReviewers *[]string) {
+	if namePrefix != nil {
+		template.NamePrefix = *namePrefix
+	}
+	if labels != nil {
+		template.Labels = *labels
+	}
+	if defaultReviewers != nil {
+		template.DefaultReviewers = *defaultReviewers
+	}
+}
+
+func prTemplateToAPI(template *domain.PRTemplate) api.PRTemplate {
+	return api.PRTemplate{
+		TemplateId:       &template.ID,
+		Name:             template.Name,
+		TeamName:         template.TeamName,
+		NamePrefix:       &template.NamePrefix,
+		Labels:           &template.Labels,
+		DefaultReviewers: &template.DefaultReviewers,
+		CreatedAt:        &template.CreatedAt,
+		UpdatedAt:        &template.UpdatedAt,
 	}
 }
 
 func prToAPI(pr *domain.PullRequest) *api.PullRequest {
 	reviewerIDs := make([]string, len(pr.Reviewers))
+	var optionalIDs []string
 	for i, r := range pr.Reviewers {
 		reviewerIDs[i] = r.ID
+		if r.Optional {
+			optionalIDs = append(optionalIDs, r.ID)
+		}
+	}
+
+	var priority *api.PullRequestPriority
+	if pr.Priority != "" {
+		p := api.PullRequestPriority(pr.Priority)
+		priority = &p
 	}
 
-	var mergedAt *time.Time
-	if pr.MergedAt != nil {
-		mergedAt = pr.MergedAt
+	var warnings *[]api.PRWarning
+	if pr.OverQuota {
+		warnings = &[]api.PRWarning{{
+			Code:    string(api.TOOMANYOPENPRS),
+			Message: "author has reached the open PR quota of their team",
+		}}
 	}
 
 	return &api.PullRequest{
-		PullRequestId:     pr.ID,
-		PullRequestName:   pr.Name,
-		AuthorId:          pr.AuthorID,
-		Status:            api.PullRequestStatus(pr.Status),
-		AssignedReviewers: reviewerIDs,
-		CreatedAt:         &pr.CreatedAt,
-		MergedAt:          mergedAt,
+		PullRequestId:             pr.ID,
+		ExternalId:                nonEmptyString(&pr.ExternalID),
+		PullRequestName:           pr.Name,
+		AuthorId:                  pr.AuthorID,
+		Status:                    api.PullRequestStatus(pr.Status),
+		AssignedReviewers:         reviewerIDs,
+		OptionalReviewers:         nonEmptySlice(&optionalIDs),
+		ShadowReviewers:           nonEmptySlice(&pr.ShadowReviewers),
+		RequiredSkills:            nonEmptySlice(&pr.RequiredSkills),
+		Labels:                    nonEmptySlice(&pr.Labels),
+		Description:               nonEmptyString(&pr.Description),
+		AutoMerge:                 &pr.AutoMerge,
+		ApprovedReviewers:         nonEmptySlice(&pr.ApprovedBy),
+		ChangesRequestedReviewers: nonEmptySlice(&pr.ChangesRequestedBy),
+		Priority:                  priority,
+		RepositoryName:            nonEmptyString(&pr.Repository),
+		Checklist:                 checklistToAPI(pr.Checklist),
+		LinesChanged:              pr.Size.LinesChanged,
+		FilesChanged:              pr.Size.FilesChanged,
+		CreatedAt:                 &pr.CreatedAt,
+		MergedAt:                  pr.MergedAt,
+		ClosedAt:                  pr.ClosedAt,
+		MergedBy:                  nonEmptyString(&pr.MergedBy),
+		ReassignedBy:              nonEmptyString(&pr.ReassignedBy),
+		Warnings:                  warnings,
+	}
+}
+
+// checklistToAPI returns the checklist of a PR, or nil when it has none.
+func checklistToAPI(checklist []domain.ChecklistItem) *[]api.ChecklistItem {
+	if len(checklist) == 0 {
+		return nil
+	}
+	items := make([]api.ChecklistItem, len(checklist))
+	for i, item := range checklist {
+		items[i] = api.ChecklistItem{
+			Position:  item.Position,
+			Label:     item.Label,
+			Checked:   item.Checked,
+			CheckedAt: item.CheckedAt,
+		}
+		if item.CheckedBy != "" {
+			items[i].CheckedBy = &item.CheckedBy
+		}
+	}
+	return &items
+}
+
+// nonEmptyString returns s, or nil when it points to an empty string, for
+// optional response fields.
+func nonEmptyString(s *string) *string {
+	if *s == "" {
+		return nil
+	}
+	return s
+}
+
+// nonEmptySlice returns s, or nil when it points to an empty slice, for
+// optional response fields.
+func nonEmptySlice[T any](s *[]T) *[]T {
+	if len(*s) == 0 {
+		return nil
 	}
+	return s
+}
+
+func explanationsToAPI(explanations []domain.ReviewerExplanation) []api.AssignmentExplanation {
+	resp := make([]api.AssignmentExplanation, len(explanations))
+	for i, e := range explanations {
+		matched := e.MatchedSkills
+		if matched == nil {
+			matched = []string{}
+		}
+		resp[i] = api.AssignmentExplanation{
+			UserId:           e.UserID,
+			Hinted:           e.Hinted,
+			Rotation:         e.Rotation,
+			PreferenceWeight: e.PreferenceWeight,
+			Cooldown:         e.Cooldown,
+			MatchedSkills:    matched,
+			Strategy:         api.AssignmentExplanationStrategy(e.Strategy),
+			OpenReviews:      e.OpenReviews,
+			Candidates:       e.Candidates,
+		}
+		if e.Role != "" {
+			resp[i].Role = &e.Role
+		}
+	}
+	return resp
+}
+
+func timelineToAPI(events []domain.PREvent) []api.PRTimelineEvent {
+	resp := make([]api.PRTimelineEvent, len(events))
+	for i, e := range events {
+		resp[i] = api.PRTimelineEvent{
+			Type:       api.PRTimelineEventType(e.Type),
+			OccurredAt: e.At,
+			UserId:     e.UserID,
+		}
+		if e.ReplacedBy != "" {
+			resp[i].ReplacedBy = &e.ReplacedBy
+		}
+		if e.Reason != "" {
+			reason := api.ReassignmentReason(e.Reason)
+			resp[i].Reason = &reason
+		}
+		if e.DeclineReason != "" {
+			decline := api.DeclineReason(e.DeclineReason)
+			resp[i].DeclineReason = &decline
+		}
+		if e.Actor != "" {
+			resp[i].Actor = &e.Actor
+		}
+	}
+	return resp
+}
+
+func githubRepoToAPI(repo *domain.GitHubRepo) *api.GitHubRepository {
+	resp := &api.GitHubRepository{Repository: repo.Repository, InstallationId: repo.InstallationID}
+	if repo.TeamName != "" {
+		resp.TeamName = &repo.TeamName
+	}
+	return resp
+}
+
+func ingestionTokenToAPI(token *domain.GitHubIngestionToken) *api.GitHubIngestionToken {
+	resp := &api.GitHubIngestionToken{
+		Repository: token.Repository,
+		TeamName:   token.TeamName,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+	if token.Secret != "" {
+		resp.Secret = &token.Secret
+	}
+	return resp
+}
+
+func teamSyncReportToAPI(report *domain.GitHubTeamSyncReport) api.GitHubTeamSyncReport {
+	resp := api.GitHubTeamSyncReport{
+		Org:              report.Org,
+		StartedAt:        report.StartedAt,
+		FinishedAt:       report.FinishedAt,
+		TeamsCreated:     report.TeamsCreated,
+		UsersCreated:     report.UsersCreated,
+		UsersMoved:       report.UsersMoved,
+		UsersDeactivated: report.UsersDeactivated,
+		Conflicts:        make([]api.GitHubTeamSyncConflict, len(report.Conflicts)),
+	}
+	if report.Error != "" {
+		resp.Error = &report.Error
+	}
+	for i := range report.Conflicts {
+		c := &report.Conflicts[i]
+		conflict := api.GitHubTeamSyncConflict{Reason: api.GitHubTeamSyncConflictReason(c.Reason)}
+		if c.Login != "" {
+			conflict.GithubLogin = &c.Login
+		}
+		if c.UserID != "" {
+			conflict.UserId = &c.UserID
+		}
+		if c.TeamName != "" {
+			conflict.TeamName = &c.TeamName
+		}
+		if c.Detail != "" {
+			conflict.Detail = &c.Detail
+		}
+		resp.Conflicts[i] = conflict
+	}
+	return resp
 }
 
 func prToShortAPI(pr *domain.PullRequest) *api.PullRequestShort {
-	return &api.PullRequestShort{
+	resp := &api.PullRequestShort{
 		PullRequestId:   pr.ID,
 		PullRequestName: pr.Name,
 		AuthorId:        pr.AuthorID,
 		Status:          api.PullRequestShortStatus(pr.Status),
 	}
+	if pr.Priority != "" {
+		p := api.PullRequestPriority(pr.Priority)
+		resp.Priority = &p
+	}
+	return resp
+}
+
+func dumpToAPI(dump *domain.DataDump) *api.DataDump {
+	teams := make([]api.TeamShort, len(dump.Teams))
+	for i, t := range dump.Teams {
+		teams[i] = api.TeamShort{TeamName: t.TeamName, IsActive: t.IsActive}
+	}
+	users := make([]api.User, len(dump.Users))
+	for i := range dump.Users {
+		users[i] = *userToAPI(&dump.Users[i])
+	}
+	prs := make([]api.PullRequest, len(dump.PullRequests))
+	for i := range dump.PullRequests {
+		prs[i] = *prToAPI(&dump.PullRequests[i])
+	}
+	return &api.DataDump{Teams: teams, Users: users, PullRequests: prs}
+}
+
+func dumpFromAPI(dump *api.DataDump) *domain.DataDump {
+	teams := make([]domain.Team, len(dump.Teams))
+	for i, t := range dump.Teams {
+		teams[i] = domain.Team{TeamName: t.TeamName, IsActive: t.IsActive}
+	}
+	users := make([]domain.User, len(dump.Users))
+	for i, u := range dump.Users {
+		users[i] = domain.User{ID: u.UserId, Username: u.Username, TeamName: u.TeamName, IsActive: u.IsActive}
+		if u.Role != nil {
+			users[i].Role = *u.Role
+		}
+		if u.Skills != nil {
+			users[i].Skills = *u.Skills
+		}
+	}
+	prs := make([]domain.PullRequest, len(dump.PullRequests))
+	for i, p := range dump.PullRequests {
+		var optional []string
+		if p.OptionalReviewers != nil {
+			optional = *p.OptionalReviewers
+		}
+		reviewers := make([]domain.Reviewer, len(p.AssignedReviewers))
+		for j, id := range p.AssignedReviewers {
+			reviewers[j] = domain.Reviewer{ID: id, Optional: slices.Contains(optional, id)}
+		}
+		prs[i] = domain.PullRequest{
+			ID:        p.PullRequestId,
+			Name:      p.PullRequestName,
+			AuthorID:  p.AuthorId,
+			Status:    domain.PRStatus(p.Status),
+			Reviewers: reviewers,
+			MergedAt:  p.MergedAt,
+			ClosedAt:  p.ClosedAt,
+		}
+		if p.RequiredSkills != nil {
+			prs[i].RequiredSkills = *p.RequiredSkills
+		}
+		if p.Description != nil {
+			prs[i].Description = *p.Description
+		}
+		if p.AutoMerge != nil {
+			prs[i].AutoMerge = *p.AutoMerge
+		}
+		if p.Priority != nil {
+			prs[i].Priority = domain.PRPriority(*p.Priority)
+		}
+		if p.ApprovedReviewers != nil {
+			prs[i].ApprovedBy = *p.ApprovedReviewers
+		}
+		if p.CreatedAt != nil {
+			prs[i].CreatedAt = *p.CreatedAt
+		}
+	}
+	return &domain.DataDump{Teams: teams, Users: users, PullRequests: prs}
+}
+
+func userLoadsToAPI(loads map[string]int) []api.UserLoad {
+	result := make([]api.UserLoad, 0, len(loads))
+	for userID, count := range loads {
+		result = append(result, api.UserLoad{UserId: userID, OpenReviews: count})
+	}
+	slices.SortFunc(result, func(a, b api.UserLoad) int {
+		return strings.Compare(a.UserId, b.UserId)
+	})
+	return result
+}
+
+func notificationPreferencesToAPI(p *domain.NotificationPreferences) api.NotificationPreferences {
+	digest := api.NotificationPreferencesDigest(p.Digest)
+	resp := api.NotificationPreferences{
+		Channels:    make([]api.NotificationPreferencesChannels, len(p.Channels)),
+		MutedEvents: make([]api.NotificationPreferencesMutedEvents, len(p.MutedEvents)),
+		Timezone:    p.Timezone,
+		Digest:      &digest,
+	}
+	for i, c := range p.Channels {
+		resp.Channels[i] = api.NotificationPreferencesChannels(c)
+	}
+	for i, e := range p.MutedEvents {
+		resp.MutedEvents[i] = api.NotificationPreferencesMutedEvents(e)
+	}
+	if p.QuietHoursStart != "" {
+		resp.QuietHoursStart = &p.QuietHoursStart
+		resp.QuietHoursEnd = &p.QuietHoursEnd
+	}
+	if p.WebhookURL != "" {
+		resp.WebhookUrl = &p.WebhookURL
+	}
+	if p.Email != "" {
+		resp.Email = &p.Email
+	}
+	return resp
 }
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+const (
+	liveWriteWait  = 10 * time.Second
+	livePongWait   = 60 * time.Second
+	livePingPeriod = livePongWait * 9 / 10
+	// Clients only send control frames
+	liveReadLimit = 512
+)
+
+// The default origin check only accepts same-origin clients such as the
+// dashboard.
+var liveUpgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 4096}
+
+type liveUpdate struct {
+	Type        domain.PRUpdateType `json:"type"`
+	PullRequest *api.PullRequest    `json:"pull_request"`
+	OccurredAt  time.Time           `json:"occurred_at"`
+}
+
+// teamUpdatesSocket streams the PR updates of a team over a WebSocket. The
+// token is read from the Authorization header or, for browsers, which cannot
+// set headers on WebSocket requests, from the token query parameter.
+func (h *Handler) teamUpdatesSocket(w http.ResponseWriter, r *http.Request) {
+	sub, err := h.liveSvc.Subscribe(r.Context(), liveToken(r), chi.URLParam(r, "teamName"))
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	defer h.liveSvc.Unsubscribe(sub)
+
+	conn, err := liveUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client
+		return
+	}
+	defer conn.Close()
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		conn.SetReadLimit(liveReadLimit)
+		_ = conn.SetReadDeadline(time.Now().Add(livePongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(livePongWait))
+		})
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(livePingPeriod)
+	defer ping.Stop()
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteWait))
+			if !ok {
+				msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "client is too slow")
+				_ = conn.WriteMessage(websocket.CloseMessage, msg)
+				return
+			}
+			msg := liveUpdate{Type: update.Type, PullRequest: prToAPI(update.PR), OccurredAt: update.OccurredAt}
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ping.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
+
+func liveToken(r *http.Request) string {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return token
+	}
+	return r.URL.Query().Get("token")
+}
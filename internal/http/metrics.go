@@ -0,0 +1,160 @@
+package http
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// latencyWindow is how many of the latest requests of an endpoint its
+// percentiles are computed over, so they follow the current behaviour rather
+// than everything since startup.
+const latencyWindow = 1000
+
+// endpointKey identifies an endpoint by method and route pattern with the
+// version prefix stripped, so that /v1, /v2 and unprefixed calls of an
+// operation are counted together.
+type endpointKey struct {
+	method, route string
+}
+
+// endpointMetrics counts the requests of an endpoint since startup and keeps
+// the latencies of the latest latencyWindow of them in a ring.
+type endpointMetrics struct {
+	requests     int64
+	clientErrors int64
+	serverErrors int64
+	latencies    []time.Duration
+	next         int
+}
+
+func (m *endpointMetrics) observe(status int, d time.Duration) {
+	m.requests++
+	switch {
+	case status >= http.StatusInternalServerError:
+		m.serverErrors++
+	case status >= http.StatusBadRequest:
+		m.clientErrors++
+	}
+	if len(m.latencies) < latencyWindow {
+		m.latencies = append(m.latencies, d)
+		return
+	}
+	m.latencies[m.next] = d
+	m.next = (m.next + 1) % latencyWindow
+}
+
+// latencyStats is a summary of endpointMetrics. ErrorRate is the share of
+// requests answered with a 5xx status.
+type latencyStats struct {
+	Requests     int64
+	ClientErrors int64
+	ServerErrors int64
+	ErrorRate    float64
+	P50, P95     time.Duration
+	P99          time.Duration
+}
+
+func (m *endpointMetrics) stats() latencyStats {
+	s := latencyStats{Requests: m.requests, ClientErrors: m.clientErrors, ServerErrors: m.serverErrors}
+	if m.requests > 0 {
+		s.ErrorRate = float64(m.serverErrors) / float64(m.requests)
+	}
+	sorted := slices.Clone(m.latencies)
+	slices.Sort(sorted)
+	s.P50, s.P95, s.P99 = percentile(sorted, 0.5), percentile(sorted, 0.95), percentile(sorted, 0.99)
+	return s
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted latencies,
+// zero when there are none.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+type endpointStats struct {
+	Method, Route string
+	latencyStats
+}
+
+// serviceMetrics records the latency and status of every API request. It is
+// kept in memory per process, so each replica reports only its own traffic.
+type serviceMetrics struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	total     endpointMetrics
+	endpoints map[endpointKey]*endpointMetrics
+}
+
+func newServiceMetrics() *serviceMetrics {
+	return &serviceMetrics{startedAt: time.Now(), endpoints: make(map[endpointKey]*endpointMetrics)}
+}
+
+func (m *serviceMetrics) observe(method, route string, status int, d time.Duration) {
+	key := endpointKey{method: method, route: route}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.total.observe(status, d)
+	e, ok := m.endpoints[key]
+	if !ok {
+		e = &endpointMetrics{}
+		m.endpoints[key] = e
+	}
+	e.observe(status, d)
+}
+
+// snapshot returns the summary of all requests and of every endpoint, ordered
+// by route and method.
+func (m *serviceMetrics) snapshot() (latencyStats, []endpointStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	endpoints := make([]endpointStats, 0, len(m.endpoints))
+	for key, e := range m.endpoints {
+		endpoints = append(endpoints, endpointStats{Method: key.method, Route: key.route, latencyStats: e.stats()})
+	}
+	slices.SortFunc(endpoints, func(a, b endpointStats) int {
+		return cmp.Or(cmp.Compare(a.Route, b.Route), cmp.Compare(a.Method, b.Method))
+	})
+	return m.total.stats(), endpoints
+}
+
+// recordMetrics feeds serviceMetrics. Requests that matched no route are left
+// out, so that scans of unknown paths do not add endpoints.
+func (h *Handler) recordMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		rctx := chi.RouteContext(r.Context())
+		if rctx == nil {
+			return
+		}
+		route := rctx.RoutePattern()
+		if route == "" || strings.HasSuffix(route, "/*") {
+			return
+		}
+		for _, prefix := range []string{"/v" + apiVersion1, "/v" + apiVersion2} {
+			if rest, ok := strings.CutPrefix(route, prefix); ok && strings.HasPrefix(rest, "/") {
+				route = rest
+				break
+			}
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		h.metrics.observe(r.Method, route, status, time.Since(start))
+	})
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[float64]time.Duration{0.5: 50 * time.Millisecond, 0.95: 95 * time.Millisecond, 0.99: 99 * time.Millisecond, 1: 100 * time.Millisecond} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("p%v = %v, want %v", p*100, got, want)
+		}
+	}
+	if got := percentile(sorted[:1], 0.5); got != time.Millisecond {
+		t.Errorf("p50 of one sample = %v, want 1ms", got)
+	}
+	if got := percentile(nil, 0.99); got != 0 {
+		t.Errorf("p99 of no samples = %v, want 0", got)
+	}
+}
+
+func TestLatencyWindow(t *testing.T) {
+	var m endpointMetrics
+	for range latencyWindow {
+		m.observe(http.StatusOK, time.Second)
+	}
+	// Newer requests push the oldest ones out of the percentiles but not out
+	// of the counters
+	for range latencyWindow {
+		m.observe(http.StatusInternalServerError, time.Millisecond)
+	}
+	s := m.stats()
+	if s.Requests != 2*latencyWindow || s.ServerErrors != latencyWindow || s.ErrorRate != 0.5 {
+		t.Errorf("counters = %+v", s)
+	}
+	if s.P99 != time.Millisecond {
+		t.Errorf("p99 = %v, want 1ms", s.P99)
+	}
+}
+
+func TestRecordMetrics(t *testing.T) {
+	h := &Handler{metrics: newServiceMetrics()}
+	api := chi.NewRouter()
+	api.Get("/users/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "user_id") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	r := chi.NewRouter()
+	r.Use(h.recordMetrics)
+	r.Mount("/v1", api)
+	r.Mount("/", api)
+
+	for _, path := range []string{"/users/u1", "/v1/users/u2", "/users/missing", "/unknown"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	total, endpoints := h.metrics.snapshot()
+	if total.Requests != 3 || total.ClientErrors != 1 {
+		t.Errorf("total = %+v, want 3 requests with 1 client error", total)
+	}
+	if len(endpoints) != 1 {
+		t.Fatalf("endpoints = %+v, want only GET /users/{user_id}", endpoints)
+	}
+	if e := endpoints[0]; e.Method != http.MethodGet || e.Route != "/users/{user_id}" || e.Requests != 3 {
+		t.Errorf("endpoint = %+v", e)
+	}
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// piiLogKeys are the log attributes holding personal data.
+var piiLogKeys = map[string]bool{
+	"username":     true,
+	"email":        true,
+	"login":        true,
+	"display_name": true,
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// RedactingLogHandler removes personal data from log records while
+// domain.RedactPII is on: attributes named in piiLogKeys are replaced, email
+// addresses are masked in every message, string and error, and GitHub logins
+// are dropped from actor IDs. Usernames inside error messages are redacted
+// where the error is built, see domain.PII.
+type RedactingLogHandler struct {
+	slog.Handler
+}
+
+func NewRedactingLogHandler(h slog.Handler) *RedactingLogHandler {
+	return &RedactingLogHandler{Handler: h}
+}
+
+func (h *RedactingLogHandler) Handle(ctx context.Context, rec slog.Record) error {
+	if !domain.RedactPII() {
+		return h.Handler.Handle(ctx, rec)
+	}
+	redacted := slog.NewRecord(rec.Time, rec.Level, redactEmails(rec.Message), rec.PC)
+	rec.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.Handler.Handle(ctx, redacted)
+}
+
+func (h *RedactingLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if domain.RedactPII() {
+		redacted := make([]slog.Attr, len(attrs))
+		for i, a := range attrs {
+			redacted[i] = redactAttr(a)
+		}
+		attrs = redacted
+	}
+	return &RedactingLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *RedactingLogHandler) WithGroup(name string) slog.Handler {
+	return &RedactingLogHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if piiLogKeys[a.Key] {
+		return slog.String(a.Key, domain.Redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		s := a.Value.String()
+		// Actions triggered by GitHub webhooks are attributed to the sender's login
+		if a.Key == "actor_id" && strings.HasPrefix(s, "github:") {
+			return slog.String(a.Key, "github:"+domain.Redacted)
+		}
+		return slog.String(a.Key, redactEmails(s))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]any, len(group))
+		for i, g := range group {
+			redacted[i] = redactAttr(g)
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, redactEmails(err.Error()))
+		}
+	}
+	return a
+}
+
+func redactEmails(s string) string {
+	return emailPattern.ReplaceAllString(s, domain.Redacted)
+}
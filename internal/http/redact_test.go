@@ -0,0 +1,65 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+func TestRedactingLogHandler(t *testing.T) {
+	domain.SetRedactPII(true)
+	defer domain.SetRedactPII(false)
+
+	var buf bytes.Buffer
+	log := slog.New(NewContextLogHandler(NewRedactingLogHandler(slog.NewJSONHandler(&buf, nil))))
+	ctx := domain.WithActor(context.Background(), "github:octocat")
+	err := fmt.Errorf("%w: '%s' in team 'backend'", domain.ErrUsernameExists, domain.PII("alice"))
+	log.InfoContext(ctx, "mail to bob@example.com failed",
+		"login", "octocat",
+		"user_id", "u1",
+		"error", err,
+		slog.Group("prefs", "email", "bob@example.com", "channel", "email"),
+		"note", "contact alice@corp.example.org",
+	)
+
+	out := buf.String()
+	for _, leak := range []string{"octocat", "alice", "bob@example.com"} {
+		if strings.Contains(out, leak) {
+			t.Errorf("log record leaks %q: %s", leak, out)
+		}
+	}
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("failed to decode log record: %v", err)
+	}
+	if rec["user_id"] != "u1" {
+		t.Errorf("user_id = %v, want it kept", rec["user_id"])
+	}
+	if rec["actor_id"] != "github:[redacted]" {
+		t.Errorf("actor_id = %v", rec["actor_id"])
+	}
+	if rec["msg"] != "mail to [redacted] failed" {
+		t.Errorf("msg = %v", rec["msg"])
+	}
+	if !errors.Is(err, domain.ErrUsernameExists) || rec["error"] != "username already exists in team: '[redacted]' in team 'backend'" {
+		t.Errorf("error = %v", rec["error"])
+	}
+}
+
+func TestRedactingLogHandlerOff(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(NewRedactingLogHandler(slog.NewJSONHandler(&buf, nil)))
+	log.Info("mail to bob@example.com failed", "login", "octocat", "error", fmt.Errorf("user '%s'", domain.PII("alice")))
+	for _, kept := range []string{"bob@example.com", "octocat", "alice"} {
+		if !strings.Contains(buf.String(), kept) {
+			t.Errorf("log record lost %q without redaction: %s", kept, buf.String())
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// exposeRequestID returns the ID assigned by middleware.RequestID to the
+// client, so it can be quoted when reporting a problem.
+func exposeRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reqID := middleware.GetReqID(r.Context()); reqID != "" {
+			w.Header().Set(requestIDHeader, reqID)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ContextLogHandler adds the request ID and the actor from the context to
+// every record logged with a *Context method.
+type ContextLogHandler struct {
+	slog.Handler
+}
+
+func NewContextLogHandler(h slog.Handler) *ContextLogHandler {
+	return &ContextLogHandler{Handler: h}
+}
+
+func (h *ContextLogHandler) Handle(ctx context.Context, rec slog.Record) error {
+	if reqID := middleware.GetReqID(ctx); reqID != "" {
+		rec.AddAttrs(slog.String("request_id", reqID))
+	}
+	if actorID := domain.ActorFromContext(ctx); actorID != "" {
+		rec.AddAttrs(slog.String("actor_id", actorID))
+	}
+	return h.Handler.Handle(ctx, rec)
+}
+
+func (h *ContextLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *ContextLogHandler) WithGroup(name string) slog.Handler {
+	return &ContextLogHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -10,18 +10,66 @@ import (
 	"github.com/glebmavi/pr_reviewer_service/pkg/api"
 )
 
-func NewRouter(si api.ServerInterface) *chi.Mux {
+// NewRouter builds the HTTP router. tunables bound API reads and sample the
+// access log; changes to them apply to the next request.
+func NewRouter(h *Handler, tunables *Tunables) (*chi.Mux, error) {
+	specRouter, err := newSpecRouter()
+	if err != nil {
+		return nil, err
+	}
+	validate := h.validateRequests(specRouter)
+	bound := boundReads(tunables, specRouter)
+
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
 	r.Use(middleware.RealIP)
-	r.Use(middleware.Logger)
+	r.Use(h.accessLog(tunables))
+	r.Use(exposeRequestID)
+	r.Use(h.withActor)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(60 * time.Second))
-	r.Use(render.SetContentType(render.ContentTypeJSON))
 
-	// Mount the generated API handler
-	r.Mount("/", api.Handler(si))
+	// Live updates are long-lived, so they are kept out of the request timeout
+	r.Get("/ws/team/{teamName}", h.teamUpdatesSocket)
+
+	r.Group(func(r chi.Router) {
+		r.Use(h.recordMetrics)
+		r.Use(middleware.Timeout(60 * time.Second))
+		r.Use(render.SetContentType(render.ContentTypeJSON))
+
+		r.Group(func(r chi.Router) {
+			r.Use(bound)
+
+			// API documentation
+			r.Get("/openapi.json", openAPISpecHandler())
+			r.Get("/docs", swaggerUIHandler)
+			r.Get("/docs/errors", errorDocsHandler)
+
+			// Admin dashboard
+			r.Get("/ui", dashboardHandler)
+
+			// SCIM provisioning for identity providers
+			r.Route("/scim/v2", h.scimRoutes)
+
+			// Slack slash commands
+			r.Post("/slack/commands", h.slackCommand)
+
+			// Fault injection for end-to-end tests
+			if h.faults != nil {
+				r.Route("/test/hooks", h.testHookRoutes)
+			}
+		})
+
+		// Mount the generated API handler once per API version. Reads are
+		// bounded below the mount point, where the spec operation is known.
+		v1 := withAPIVersion(apiVersion1, bound(validate(api.Handler(h))))
+		v2 := withAPIVersion(apiVersion2, bound(validate(api.Handler(NewV2Handler(h)))))
+		r.Mount("/v1", v1)
+		r.Mount("/v2", v2)
+
+		// Unprefixed routes are kept for compatibility and default to v1
+		r.Mount("/", h.negotiateVersion(v1, v2))
+	})
 
-	return r
+	return r, nil
 }
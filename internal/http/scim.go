@@ -0,0 +1,605 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// SCIM 2.0 (RFC 7643, RFC 7644) provisioning of users and teams. The wire
+// format is fixed by the standard, so these routes are not part of the
+// generated API; SCIM groups are teams.
+const (
+	scimUserSchema       = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimEnterpriseSchema = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	scimGroupSchema      = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema       = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimPatchSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimErrorSchema      = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimConfigSchema     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	scimContentType = "application/scim+json"
+	scimBasePath    = "/scim/v2"
+	maxSCIMPayload  = 1 << 20
+	// maxSCIMPage bounds the count of a list request.
+	maxSCIMPage = 100
+)
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+type scimEnterprise struct {
+	Department string `json:"department"`
+}
+
+type scimRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimUser struct {
+	Schemas    []string        `json:"schemas"`
+	ID         string          `json:"id,omitempty"`
+	UserName   string          `json:"userName"`
+	Active     *bool           `json:"active,omitempty"`
+	Groups     []scimRef       `json:"groups,omitempty"`
+	Enterprise *scimEnterprise `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta       *scimMeta       `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	DisplayName string    `json:"displayName"`
+	Members     []scimRef `json:"members"`
+	Meta        *scimMeta `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// scimRoutes registers the SCIM endpoints. Every request needs the
+// provisioning token as a bearer token.
+func (h *Handler) scimRoutes(r chi.Router) {
+	r.Use(h.scimAuth)
+
+	r.Get("/ServiceProviderConfig", h.scimServiceProviderConfig)
+
+	r.Get("/Users", h.scimListUsers)
+	r.Post("/Users", h.scimCreateUser)
+	r.Get("/Users/{id}", h.scimGetUser)
+	r.Put("/Users/{id}", h.scimReplaceUser)
+	r.Patch("/Users/{id}", h.scimPatchUser)
+	r.Delete("/Users/{id}", h.scimDeleteUser)
+
+	r.Get("/Groups", h.scimListGroups)
+	r.Post("/Groups", h.scimCreateGroup)
+	r.Get("/Groups/{id}", h.scimGetGroup)
+	r.Patch("/Groups/{id}", h.scimPatchGroup)
+	r.Delete("/Groups/{id}", h.scimDeleteGroup)
+}
+
+func (h *Handler) scimAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if err := h.provisioningSvc.Authorize(token); err != nil {
+			h.scimServiceError(w, r, err)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxSCIMPayload)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Handler) scimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	supported := func(ok bool) map[string]bool { return map[string]bool{"supported": ok} }
+	scimJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{scimConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxSCIMPage},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]string{{
+			"type": "oauthbearertoken", "name": "Bearer token", "description": "SCIM_TOKEN of the service",
+		}},
+	})
+}
+
+// --- Users ---
+
+func (h *Handler) scimListUsers(w http.ResponseWriter, r *http.Request) {
+	username, err := scimFilter(r, "userName")
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	users, err := h.provisioningSvc.ListUsers(r.Context(), username)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	resources := make([]any, len(users))
+	for i := range users {
+		resources[i] = scimUserFromDomain(&users[i])
+	}
+	h.scimList(w, r, resources)
+}
+
+func (h *Handler) scimCreateUser(w http.ResponseWriter, r *http.Request) {
+	var req scimUser
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.scimServiceError(w, r, fmt.Errorf("%w: invalid request body", domain.ErrValidation))
+		return
+	}
+	var teamName string
+	if req.Enterprise != nil {
+		teamName = req.Enterprise.Department
+	}
+	user, err := h.provisioningSvc.CreateUser(r.Context(), req.UserName, teamName, req.Active == nil || *req.Active)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusCreated, scimUserFromDomain(user))
+}
+
+func (h *Handler) scimGetUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.provisioningSvc.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusOK, scimUserFromDomain(user))
+}
+
+// scimReplaceUser handles PUT. Attributes missing from the body keep their
+// value, except active, which defaults to true as on creation.
+func (h *Handler) scimReplaceUser(w http.ResponseWriter, r *http.Request) {
+	var req scimUser
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.scimServiceError(w, r, fmt.Errorf("%w: invalid request body", domain.ErrValidation))
+		return
+	}
+	active := req.Active == nil || *req.Active
+	patch := domain.UserPatch{Username: &req.UserName, IsActive: &active}
+	if req.Enterprise != nil && req.Enterprise.Department != "" {
+		patch.TeamName = &req.Enterprise.Department
+	}
+	h.scimUpdateUser(w, r, patch)
+}
+
+// scimPatchUser handles PATCH of active, userName and the enterprise
+// department, given by path or as attributes of a value without a path.
+func (h *Handler) scimPatchUser(w http.ResponseWriter, r *http.Request) {
+	var req scimPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.scimServiceError(w, r, fmt.Errorf("%w: invalid request body", domain.ErrValidation))
+		return
+	}
+
+	var patch domain.UserPatch
+	for _, op := range req.Operations {
+		if kind := strings.ToLower(op.Op); kind != "replace" && kind != "add" {
+			h.scimServiceError(w, r, fmt.Errorf("%w: unsupported user operation %q", domain.ErrValidation, op.Op))
+			return
+		}
+		attrs := map[string]json.RawMessage{op.Path: op.Value}
+		if op.Path == "" {
+			attrs = nil
+			if err := json.Unmarshal(op.Value, &attrs); err != nil {
+				h.scimServiceError(w, r, fmt.Errorf("%w: invalid operation value", domain.ErrValidation))
+				return
+			}
+		}
+		if err := applySCIMUserAttrs(&patch, attrs); err != nil {
+			h.scimServiceError(w, r, err)
+			return
+		}
+	}
+	h.scimUpdateUser(w, r, patch)
+}
+
+func (h *Handler) scimUpdateUser(w http.ResponseWriter, r *http.Request, patch domain.UserPatch) {
+	user, err := h.provisioningSvc.UpdateUser(r.Context(), chi.URLParam(r, "id"), patch)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusOK, scimUserFromDomain(user))
+}
+
+// scimDeleteUser deactivates the user; users are never deleted, so that their
+// review history is kept.
+func (h *Handler) scimDeleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.provisioningSvc.DeactivateUser(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func applySCIMUserAttrs(patch *domain.UserPatch, attrs map[string]json.RawMessage) error {
+	for path, raw := range attrs {
+		switch strings.ToLower(path) {
+		case "active":
+			active, err := scimBool(raw)
+			if err != nil {
+				return err
+			}
+			patch.IsActive = &active
+		case "username":
+			var username string
+			if err := json.Unmarshal(raw, &username); err != nil {
+				return fmt.Errorf("%w: userName must be a string", domain.ErrValidation)
+			}
+			patch.Username = &username
+		case strings.ToLower(scimEnterpriseSchema + ":department"):
+			var team string
+			if err := json.Unmarshal(raw, &team); err != nil {
+				return fmt.Errorf("%w: department must be a string", domain.ErrValidation)
+			}
+			patch.TeamName = &team
+		case strings.ToLower(scimEnterpriseSchema):
+			var ext scimEnterprise
+			if err := json.Unmarshal(raw, &ext); err != nil {
+				return fmt.Errorf("%w: invalid enterprise extension", domain.ErrValidation)
+			}
+			if ext.Department != "" {
+				patch.TeamName = &ext.Department
+			}
+		default:
+			// Attributes the service does not store (names, emails) are ignored
+		}
+	}
+	return nil
+}
+
+// scimBool reads a boolean, which some identity providers send as a string.
+func scimBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("%w: active must be a boolean", domain.ErrValidation)
+}
+
+func scimUserFromDomain(u *domain.User) scimUser {
+	active := u.IsActive
+	return scimUser{
+		Schemas:    []string{scimUserSchema, scimEnterpriseSchema},
+		ID:         u.ID,
+		UserName:   u.Username,
+		Active:     &active,
+		Groups:     []scimRef{{Value: strconv.Itoa(int(u.TeamID)), Display: u.TeamName}},
+		Enterprise: &scimEnterprise{Department: u.TeamName},
+		Meta:       &scimMeta{ResourceType: "User", Location: scimBasePath + "/Users/" + u.ID},
+	}
+}
+
+// --- Groups ---
+
+func (h *Handler) scimListGroups(w http.ResponseWriter, r *http.Request) {
+	teamName, err := scimFilter(r, "displayName")
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	teams, err := h.provisioningSvc.ListGroups(r.Context(), teamName)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	resources := make([]any, len(teams))
+	for i := range teams {
+		resources[i] = scimGroupFromDomain(&teams[i])
+	}
+	h.scimList(w, r, resources)
+}
+
+func (h *Handler) scimCreateGroup(w http.ResponseWriter, r *http.Request) {
+	var req scimGroup
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.scimServiceError(w, r, fmt.Errorf("%w: invalid request body", domain.ErrValidation))
+		return
+	}
+	team, err := h.provisioningSvc.CreateGroup(r.Context(), req.DisplayName, scimRefValues(req.Members))
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusCreated, scimGroupFromDomain(team))
+}
+
+func (h *Handler) scimGetGroup(w http.ResponseWriter, r *http.Request) {
+	teamID, err := scimGroupID(r)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	team, err := h.provisioningSvc.GetGroup(r.Context(), teamID)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusOK, scimGroupFromDomain(team))
+}
+
+// scimPatchGroup handles PATCH of displayName and members. Adding a member
+// moves the user into the team, removing one deactivates the user.
+func (h *Handler) scimPatchGroup(w http.ResponseWriter, r *http.Request) {
+	teamID, err := scimGroupID(r)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	var req scimPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.scimServiceError(w, r, fmt.Errorf("%w: invalid request body", domain.ErrValidation))
+		return
+	}
+
+	ctx := r.Context()
+	team, err := h.provisioningSvc.GetGroup(ctx, teamID)
+	for _, op := range req.Operations {
+		if err != nil {
+			break
+		}
+		team, err = h.scimPatchGroupOp(ctx, teamID, op)
+	}
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	scimJSON(w, http.StatusOK, scimGroupFromDomain(team))
+}
+
+// scimPatchGroupOp applies one PATCH operation to the team and returns the
+// team after it.
+func (h *Handler) scimPatchGroupOp(ctx context.Context, teamID int32, op scimPatchOperation) (*domain.Team, error) {
+	kind, path := strings.ToLower(op.Op), op.Path
+	switch {
+	case strings.EqualFold(path, "displayName") || (path == "" && kind == "replace"):
+		name, err := scimDisplayName(op)
+		if err != nil {
+			return nil, err
+		}
+		return h.provisioningSvc.RenameGroup(ctx, teamID, name)
+	case strings.EqualFold(path, "members"):
+		return h.scimPatchGroupMembers(ctx, teamID, op)
+	case kind == "remove":
+		// Azure AD and Okta remove single members as members[value eq "<id>"]
+		userID, ok := scimMemberPath(path)
+		if !ok {
+			return nil, fmt.Errorf("%w: unsupported path %q", domain.ErrValidation, path)
+		}
+		return h.provisioningSvc.RemoveGroupMembers(ctx, teamID, []string{userID})
+	default:
+		return nil, fmt.Errorf("%w: unsupported path %q", domain.ErrValidation, path)
+	}
+}
+
+// scimDisplayName returns the new displayName of a group, given by path or as
+// an attribute of a value without a path.
+func scimDisplayName(op scimPatchOperation) (string, error) {
+	var name string
+	var err error
+	if op.Path == "" {
+		var attrs struct {
+			DisplayName string `json:"displayName"`
+		}
+		err = json.Unmarshal(op.Value, &attrs)
+		name = attrs.DisplayName
+	} else {
+		err = json.Unmarshal(op.Value, &name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: displayName must be a string", domain.ErrValidation)
+	}
+	return name, nil
+}
+
+// scimPatchGroupMembers adds, removes or replaces the members of the team.
+func (h *Handler) scimPatchGroupMembers(ctx context.Context, teamID int32, op scimPatchOperation) (*domain.Team, error) {
+	var members []scimRef
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &members); err != nil {
+			return nil, fmt.Errorf("%w: members must be a list of references", domain.ErrValidation)
+		}
+	}
+	switch strings.ToLower(op.Op) {
+	case "add":
+		return h.provisioningSvc.AddGroupMembers(ctx, teamID, scimRefValues(members))
+	case "remove":
+		return h.provisioningSvc.RemoveGroupMembers(ctx, teamID, scimRefValues(members))
+	case "replace":
+		return h.provisioningSvc.SetGroupMembers(ctx, teamID, scimRefValues(members))
+	default:
+		return nil, fmt.Errorf("%w: unsupported group operation %q", domain.ErrValidation, op.Op)
+	}
+}
+
+// scimDeleteGroup deactivates the team and its members.
+func (h *Handler) scimDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	teamID, err := scimGroupID(r)
+	if err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	if err := h.provisioningSvc.DeleteGroup(r.Context(), teamID); err != nil {
+		h.scimServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func scimGroupFromDomain(t *domain.Team) scimGroup {
+	id := strconv.Itoa(int(t.ID))
+	members := make([]scimRef, len(t.Members))
+	for i, m := range t.Members {
+		members[i] = scimRef{Value: m.ID, Display: m.Username}
+	}
+	return scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          id,
+		DisplayName: t.TeamName,
+		Members:     members,
+		Meta:        &scimMeta{ResourceType: "Group", Location: scimBasePath + "/Groups/" + id},
+	}
+}
+
+// scimGroupID parses the group ID, which is the team's numeric ID.
+func scimGroupID(r *http.Request) (int32, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: group '%s'", domain.ErrNotFound, chi.URLParam(r, "id"))
+	}
+	return int32(id), nil
+}
+
+// scimMemberPath extracts the user ID from a members[value eq "<id>"] path.
+func scimMemberPath(path string) (string, bool) {
+	inner, ok := strings.CutPrefix(path, "members[")
+	if !ok {
+		return "", false
+	}
+	inner, ok = strings.CutSuffix(inner, "]")
+	if !ok {
+		return "", false
+	}
+	return scimEqFilter(inner, "value")
+}
+
+func scimRefValues(refs []scimRef) []string {
+	values := make([]string, len(refs))
+	for i, ref := range refs {
+		values[i] = ref.Value
+	}
+	return values
+}
+
+// --- Helpers ---
+
+// scimFilter returns the value of a filter=<attr> eq "<value>" query, the
+// only filter identity providers need to look resources up; an empty filter
+// returns "".
+func scimFilter(r *http.Request, attr string) (string, error) {
+	filter := r.URL.Query().Get("filter")
+	if filter == "" {
+		return "", nil
+	}
+	value, ok := scimEqFilter(filter, attr)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%w: only %s eq \"...\" filters are supported", domain.ErrValidation, attr)
+	}
+	return value, nil
+}
+
+func scimEqFilter(filter, attr string) (string, bool) {
+	fields := strings.SplitN(strings.TrimSpace(filter), " ", 3)
+	if len(fields) != 3 || !strings.EqualFold(fields[0], attr) || !strings.EqualFold(fields[1], "eq") {
+		return "", false
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(fields[2]))
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// scimList writes a page of resources, honouring the 1-based startIndex and
+// count parameters.
+func (h *Handler) scimList(w http.ResponseWriter, r *http.Request, resources []any) {
+	query := r.URL.Query()
+	start, count := 1, maxSCIMPage
+	if v := query.Get("startIndex"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.scimServiceError(w, r, fmt.Errorf("%w: startIndex must be an integer", domain.ErrValidation))
+			return
+		}
+		start = max(n, 1)
+	}
+	if v := query.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.scimServiceError(w, r, fmt.Errorf("%w: count must be an integer", domain.ErrValidation))
+			return
+		}
+		count = min(max(n, 0), maxSCIMPage)
+	}
+
+	total := len(resources)
+	from := min(start-1, total)
+	page := resources[from:min(from+count, total)]
+	scimJSON(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+// scimServiceError replies with a SCIM error for an error returned by a
+// service, using the status the API would use.
+func (h *Handler) scimServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	_, message, httpStatus := h.serviceError(r, err)
+	resp := scimErrorResponse{
+		Schemas: []string{scimErrorSchema},
+		Status:  strconv.Itoa(httpStatus),
+		Detail:  message,
+	}
+	switch httpStatus {
+	case http.StatusConflict:
+		resp.ScimType = "uniqueness"
+	case http.StatusBadRequest:
+		resp.ScimType = "invalidValue"
+	}
+	scimJSON(w, httpStatus, resp)
+}
+
+func scimJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/render"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// Slack posts slash commands form-encoded and signs them with the app's
+// signing secret, so the endpoint is not part of the generated API.
+const maxSlackPayload = 64 << 10
+
+// slackMessage is a reply to a slash command. Ephemeral replies are only shown
+// to the user who typed the command.
+type slackMessage struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// slackCommand serves the /reviews slash command. Slack shows any reply other
+// than a 200 as a failure, so errors the user can act on are sent as the
+// reply text; only bad signatures and internal errors are reported as such.
+func (h *Handler) slackCommand(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSlackPayload))
+	if err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := h.slackSvc.VerifyRequest(r.Header.Get("X-Slack-Request-Timestamp"), r.Header.Get("X-Slack-Signature"), body); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	reply, err := h.slackSvc.HandleCommand(r.Context(), form.Get("user_id"), form.Get("text"))
+	if err != nil {
+		code, message, httpStatus := h.serviceError(r, err)
+		if httpStatus >= http.StatusInternalServerError {
+			h.respondError(w, r, code, message, httpStatus)
+			return
+		}
+		if domain.RedactPII() {
+			message = redactEmails(message)
+		}
+		reply = message
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, slackMessage{ResponseType: "ephemeral", Text: reply})
+}
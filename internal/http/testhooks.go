@@ -0,0 +1,80 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/testhooks"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// testHookRoutes serves the fault injection used by end-to-end tests. They are
+// mounted only when the service runs with APP_TEST_HOOKS and are not part of
+// the public API.
+func (h *Handler) testHookRoutes(r chi.Router) {
+	r.Get("/faults", h.listFaults)
+	r.Post("/faults", h.addFault)
+	r.Delete("/faults", h.clearFaults)
+}
+
+// faultJSON is the wire format of testhooks.Fault.
+type faultJSON struct {
+	ID        int64                `json:"id"`
+	Query     string               `json:"query"`
+	LatencyMS int64                `json:"latency_ms"`
+	Error     testhooks.FaultError `json:"error"`
+	Times     int                  `json:"times"`
+	Hits      int                  `json:"hits"`
+}
+
+func faultToJSON(f testhooks.Fault) faultJSON {
+	return faultJSON{
+		ID:        f.ID,
+		Query:     f.Query,
+		LatencyMS: f.Latency.Milliseconds(),
+		Error:     f.Error,
+		Times:     f.Times,
+		Hits:      f.Hits,
+	}
+}
+
+func (h *Handler) listFaults(w http.ResponseWriter, r *http.Request) {
+	faults := h.faults.List()
+	resp := make([]faultJSON, len(faults))
+	for i, f := range faults {
+		resp[i] = faultToJSON(f)
+	}
+	render.JSON(w, r, map[string][]faultJSON{"faults": resp})
+}
+
+func (h *Handler) addFault(w http.ResponseWriter, r *http.Request) {
+	var req faultJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	fault, err := h.faults.Add(testhooks.Fault{
+		Query:   req.Query,
+		Latency: time.Duration(req.LatencyMS) * time.Millisecond,
+		Error:   req.Error,
+		Times:   req.Times,
+	})
+	if err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.log.WarnContext(r.Context(), "fault injected", "event", "test_hooks.fault_added",
+		"fault_id", fault.ID, "query", fault.Query, "latency", fault.Latency, "error", string(fault.Error), "times", fault.Times)
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, faultToJSON(fault))
+}
+
+func (h *Handler) clearFaults(w http.ResponseWriter, r *http.Request) {
+	h.faults.Clear()
+	h.log.InfoContext(r.Context(), "faults cleared", "event", "test_hooks.faults_cleared")
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5"
+)
+
+// adminTag is the OpenAPI tag of the admin operations.
+const adminTag = "Admin"
+
+// boundReads gives reads a deadline of their own, much shorter than the router
+// timeout. Writes are bounded per transaction by the service layer. Operations
+// the spec tags as Admin, such as the full export, are left alone; the spec
+// route is matched on the path below the mount point, so the middleware goes
+// inside each API version mount.
+func boundReads(tunables *Tunables, router routers.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := tunables.ReadTimeout()
+			if timeout <= 0 || r.Method != http.MethodGet || isAdminOperation(router, r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// isAdminOperation reports whether r is for an operation tagged Admin.
+func isAdminOperation(router routers.Router, r *http.Request) bool {
+	matchReq := *r
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		u := *r.URL
+		u.Path = rctx.RoutePath
+		matchReq.URL = &u
+	}
+	route, _, err := router.FindRoute(&matchReq)
+	return err == nil && slices.Contains(route.Operation.Tags, adminTag)
+}
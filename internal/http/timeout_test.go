@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/app"
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	"github.com/glebmavi/pr_reviewer_service/internal/storage/memory"
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// stalledUsers creates users and then hangs until the transaction's context
+// is done, failing the way the postgres repository does: with the context
+// error hidden behind ErrInternalError.
+type stalledUsers struct {
+	*memory.Store
+}
+
+func (s stalledUsers) CreateUser(ctx context.Context, tx domain.Tx, user *domain.User) (*domain.User, error) {
+	if _, err := s.Store.CreateUser(ctx, tx, user); err != nil {
+		return nil, err
+	}
+	<-ctx.Done()
+	return nil, fmt.Errorf("%w: %v", domain.ErrInternalError, ctx.Err())
+}
+
+// TestTransactionTimeout checks that a transaction running past TX_TIMEOUT is
+// rolled back and answered with 504 TIMEOUT.
+func TestTransactionTimeout(t *testing.T) {
+	log := slog.New(slog.DiscardHandler)
+	store := memory.NewStore()
+	uow := app.NewTimeoutUnitOfWork(store, 20*time.Millisecond)
+	h := &Handler{teamSvc: app.NewTeamService(store, stalledUsers{store}, store, nil, uow, log), log: log}
+
+	body := `{"team_name": "stalled", "members": [{"username": "alice", "is_active": true}]}`
+	rec := httptest.NewRecorder()
+	start := time.Now()
+	h.PostTeamAdd(rec, httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(body)))
+
+	if waited := time.Since(start); waited > 5*time.Second {
+		t.Errorf("request took %v, want about the 20ms timeout", waited)
+	}
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want 504: %s", rec.Code, rec.Body)
+	}
+	var resp api.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Error.Code != api.TIMEOUT {
+		t.Errorf("code = %s, want TIMEOUT", resp.Error.Code)
+	}
+
+	// Neither the team nor its member outlived the rollback
+	if _, err := store.GetTeamByName(context.Background(), "stalled"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("team after the timeout: got %v, want ErrNotFound", err)
+	}
+	users, err := store.ListUsers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) > 0 {
+		t.Errorf("users after the timeout: %v", users)
+	}
+}
+
+// TestBoundReadsSkipsAdmin checks that reads get the read timeout unless the
+// spec tags their operation as Admin, whichever version mount serves them.
+func TestBoundReadsSkipsAdmin(t *testing.T) {
+	specRouter, err := newSpecRouter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var deadline bool
+	handler := boundReads(NewTunables(time.Second, 0), specRouter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, deadline = r.Context().Deadline()
+	}))
+	r := chi.NewRouter()
+	r.Mount("/v1", handler)
+	r.Mount("/", handler)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/v1/team/get?team_name=backend", true},
+		{"/team/get?team_name=backend", true},
+		{"/v1/admin/export", false},
+		{"/admin/export", false},
+		{"/v1/admin/settings", false},
+	}
+	for _, tt := range tests {
+		deadline = false
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if deadline != tt.want {
+			t.Errorf("GET %s: deadline = %v, want %v", tt.path, deadline, tt.want)
+		}
+	}
+}
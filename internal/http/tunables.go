@@ -0,0 +1,41 @@
+package http
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// Tunables are the router settings that can be changed while the server
+// runs, for example when the config file is reloaded.
+type Tunables struct {
+	readTimeout atomic.Int64
+	// accessLogSampleRate holds the bits of a float64
+	accessLogSampleRate atomic.Uint64
+}
+
+// NewTunables returns router settings with API reads bounded by readTimeout
+// (zero leaves them to the router timeout) and accessLogSampleRate of
+// successful requests written to the access log.
+func NewTunables(readTimeout time.Duration, accessLogSampleRate float64) *Tunables {
+	t := &Tunables{}
+	t.SetReadTimeout(readTimeout)
+	t.SetAccessLogSampleRate(accessLogSampleRate)
+	return t
+}
+
+func (t *Tunables) ReadTimeout() time.Duration {
+	return time.Duration(t.readTimeout.Load())
+}
+
+func (t *Tunables) SetReadTimeout(timeout time.Duration) {
+	t.readTimeout.Store(int64(timeout))
+}
+
+func (t *Tunables) AccessLogSampleRate() float64 {
+	return math.Float64frombits(t.accessLogSampleRate.Load())
+}
+
+func (t *Tunables) SetAccessLogSampleRate(rate float64) {
+	t.accessLogSampleRate.Store(math.Float64bits(rate))
+}
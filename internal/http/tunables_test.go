@@ -0,0 +1,43 @@
+package http
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTunablesApplyToNextRequest(t *testing.T) {
+	var buf bytes.Buffer
+	h := &Handler{log: slog.New(slog.NewJSONHandler(&buf, nil))}
+	tunables := NewTunables(0, 0)
+	specRouter, err := newSpecRouter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deadline bool
+	handler := h.accessLog(tunables)(boundReads(tunables, specRouter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, deadline = r.Context().Deadline()
+	})))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	if deadline {
+		t.Error("read got a deadline with the read timeout disabled")
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("successful request was logged: %s", buf.String())
+	}
+
+	tunables.SetReadTimeout(time.Second)
+	tunables.SetAccessLogSampleRate(1)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	if !deadline {
+		t.Error("read got no deadline after the read timeout was set")
+	}
+	if buf.Len() == 0 {
+		t.Error("request was not logged after the sample rate was raised")
+	}
+}
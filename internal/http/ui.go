@@ -0,0 +1,15 @@
+package http
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed static/ui.html
+var dashboardPage []byte
+
+// dashboardHandler serves the admin dashboard. The page is a static client of the /v1 API.
+func dashboardHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(dashboardPage)
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// newSpecRouter matches requests against the embedded OpenAPI document. Servers
+// are dropped because the version prefix is already stripped by chi's Mount.
+func newSpecRouter() (routers.Router, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	spec.Servers = nil
+	return legacy.NewRouter(spec)
+}
+
+// validateRequests checks parameters and JSON bodies against the OpenAPI spec
+// and rejects invalid requests with per-field details. Requests for paths the
+// spec does not describe are passed through to the generated router.
+func (h *Handler) validateRequests(router routers.Router) func(http.Handler) http.Handler {
+	opts := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Match on the path below the mount point (/v1, /v2 or /)
+			matchReq := r.Clone(r.Context())
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+				matchReq.URL.Path = rctx.RoutePath
+			}
+			// Handlers decode bodies as JSON whatever the Content-Type says, so
+			// validate them the same way (e.g. for curl -d without a header).
+			if !strings.Contains(matchReq.Header.Get("Content-Type"), "json") {
+				matchReq.Header.Set("Content-Type", "application/json")
+			}
+
+			route, pathParams, err := router.FindRoute(matchReq)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    matchReq,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    opts,
+			})
+			// The validator consumed the body and left a fresh copy on matchReq
+			r.Body = matchReq.Body
+			if err != nil {
+				h.respondErrorDetails(w, r, api.VALIDATIONERROR, "request validation failed", http.StatusBadRequest, validationDetails(err))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func validationDetails(err error) []api.ErrorDetail {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var details []api.ErrorDetail
+		for _, inner := range e {
+			details = append(details, validationDetails(inner)...)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			details := validationDetails(e.Err)
+			for i := range details {
+				details[i].Field = e.Parameter.Name
+			}
+			if len(details) == 0 {
+				details = []api.ErrorDetail{{Field: e.Parameter.Name, Reason: e.Reason}}
+			}
+			return details
+		}
+		if e.Err != nil {
+			return validationDetails(e.Err)
+		}
+		return []api.ErrorDetail{{Field: "body", Reason: e.Reason}}
+	case *openapi3.SchemaError:
+		field := strings.Join(e.JSONPointer(), ".")
+		if field == "" {
+			field = "body"
+		}
+		return []api.ErrorDetail{{Field: field, Reason: e.Reason}}
+	case nil:
+		return nil
+	default:
+		return []api.ErrorDetail{{Field: "body", Reason: err.Error()}}
+	}
+}
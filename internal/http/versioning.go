@@ -0,0 +1,144 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+const (
+	apiVersionHeader = "X-API-Version"
+	apiVersion1      = "1"
+	apiVersion2      = "2"
+
+	// v2MediaType may be sent in the Accept header instead of X-API-Version.
+	v2MediaType = "application/vnd.pr-reviewer.v2+json"
+)
+
+// withAPIVersion tags every response with the API version that produced it.
+func withAPIVersion(version string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(apiVersionHeader, version)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// supportedAPIVersions lists the versions X-API-Version may ask for.
+var supportedAPIVersions = []string{apiVersion1, apiVersion2}
+
+// negotiateVersion serves unprefixed (legacy) routes. Clients get v1 unless
+// they explicitly ask for v2 via X-API-Version or the Accept header; asking
+// for a version that does not exist is rejected with the supported ones.
+func (h *Handler) negotiateVersion(v1, v2 http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requestedAPIVersion(r) {
+		case apiVersion1:
+			v1.ServeHTTP(w, r)
+		case apiVersion2:
+			v2.ServeHTTP(w, r)
+		default:
+			supported := strings.Join(supportedAPIVersions, ", ")
+			h.respondErrorDetails(w, r, api.VALIDATIONERROR,
+				fmt.Sprintf("unsupported API version %q, supported versions: %s", r.Header.Get(apiVersionHeader), supported),
+				http.StatusBadRequest,
+				[]api.ErrorDetail{{Field: apiVersionHeader, Reason: "must be one of " + supported}})
+		}
+	})
+}
+
+func requestedAPIVersion(r *http.Request) string {
+	if v := strings.TrimSpace(r.Header.Get(apiVersionHeader)); v != "" {
+		return strings.TrimPrefix(v, "v")
+	}
+	if strings.Contains(r.Header.Get("Accept"), v2MediaType) {
+		return apiVersion2
+	}
+	return apiVersion1
+}
+
+// V2Handler serves the /v2 API. It reuses the v1 Handler and only overrides
+// operations whose response shapes changed between versions.
+type V2Handler struct {
+	*Handler
+}
+
+func NewV2Handler(h *Handler) *V2Handler {
+	return &V2Handler{Handler: h}
+}
+
+type statItemV2 struct {
+	UserID               string  `json:"user_id"`
+	Username             string  `json:"username"`
+	ReviewCount          int64   `json:"review_count"`
+	AckedCount           int64   `json:"acked_count"`
+	AvgAckLatencySeconds float64 `json:"avg_ack_latency_seconds"`
+}
+
+type statsResponseV2 struct {
+	ReviewStats []statItemV2 `json:"review_stats"`
+	Total       int          `json:"total"`
+}
+
+type teamDeactivateResponseV2 struct {
+	DeactivatedUsersCount  int        `json:"deactivated_users_count"`
+	ReassignedReviewsCount int        `json:"reassigned_reviews_count"`
+	ScheduledAt            *time.Time `json:"scheduled_at,omitempty"`
+}
+
+func (h *V2Handler) GetStats(w http.ResponseWriter, r *http.Request, params api.GetStatsParams) {
+	page, ok := h.statsPage(w, r, params)
+	if !ok {
+		return
+	}
+
+	items := make([]statItemV2, len(page.Items))
+	for i, s := range page.Items {
+		items[i] = statItemV2{
+			UserID:               s.UserID,
+			Username:             s.Username,
+			ReviewCount:          s.ReviewCount,
+			AckedCount:           s.AckedCount,
+			AvgAckLatencySeconds: s.AvgAckLatency.Seconds(),
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, statsResponseV2{ReviewStats: items, Total: page.Total})
+}
+
+func (h *V2Handler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTeamDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, r, api.VALIDATIONERROR, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if isFuture(req.EffectiveAt) {
+		team, err := h.teamSvc.ScheduleDeactivation(r.Context(), req.TeamName, *req.EffectiveAt)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+		render.Status(r, http.StatusAccepted)
+		render.JSON(w, r, teamDeactivateResponseV2{ScheduledAt: team.DeactivateAt})
+		return
+	}
+
+	deactivatedCount, reassignedCount, err := h.teamSvc.DeactivateTeamAndReassign(r.Context(), req.TeamName)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamDeactivateResponseV2{
+		DeactivatedUsersCount:  deactivatedCount,
+		ReassignedReviewsCount: reassignedCount,
+	})
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glebmavi/pr_reviewer_service/pkg/api"
+)
+
+// TestNegotiateVersion checks which version serves unprefixed routes for the
+// X-API-Version header a client sends.
+func TestNegotiateVersion(t *testing.T) {
+	h := &Handler{log: slog.New(slog.DiscardHandler)}
+	served := func(version string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set(apiVersionHeader, version)
+		})
+	}
+	negotiate := h.negotiateVersion(served(apiVersion1), served(apiVersion2))
+
+	for _, tc := range []struct {
+		header string
+		want   string
+	}{
+		{"", apiVersion1},
+		{"1", apiVersion1},
+		{"2", apiVersion2},
+		{"v2", apiVersion2},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/team/list", nil)
+		r.Header.Set(apiVersionHeader, tc.header)
+		rec := httptest.NewRecorder()
+		negotiate.ServeHTTP(rec, r)
+		if rec.Code != http.StatusOK || rec.Header().Get(apiVersionHeader) != tc.want {
+			t.Errorf("X-API-Version %q: status %d, served by version %q, want 200 from %q", tc.header, rec.Code, rec.Header().Get(apiVersionHeader), tc.want)
+		}
+	}
+}
+
+// TestNegotiateUnknownVersion checks that asking for a version that does not
+// exist is answered with 400 VALIDATION_ERROR naming the supported versions.
+func TestNegotiateUnknownVersion(t *testing.T) {
+	h := &Handler{log: slog.New(slog.DiscardHandler)}
+	negotiate := h.negotiateVersion(http.NotFoundHandler(), http.NotFoundHandler())
+
+	for _, header := range []string{"99", "v", "2.0", "latest"} {
+		r := httptest.NewRequest(http.MethodGet, "/team/list", nil)
+		r.Header.Set(apiVersionHeader, header)
+		rec := httptest.NewRecorder()
+		negotiate.ServeHTTP(rec, r)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("X-API-Version %q: status = %d, want 400", header, rec.Code)
+			continue
+		}
+		var resp api.ErrorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Error.Code != api.VALIDATIONERROR {
+			t.Errorf("X-API-Version %q: code = %s, want VALIDATION_ERROR", header, resp.Error.Code)
+		}
+		if !strings.Contains(resp.Error.Message, "1, 2") {
+			t.Errorf("X-API-Version %q: message %q does not list the supported versions", header, resp.Error.Message)
+		}
+		if resp.Error.Details == nil || len(*resp.Error.Details) != 1 || (*resp.Error.Details)[0].Field != apiVersionHeader {
+			t.Errorf("X-API-Version %q: details = %v, want one for %s", header, resp.Error.Details, apiVersionHeader)
+		}
+	}
+}
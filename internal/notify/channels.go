@@ -0,0 +1,243 @@
+// Package notify implements the channels notifications are delivered through.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// LogChannel writes notifications to the service log. It is the default
+// channel and is useful when no chat integration is set up.
+type LogChannel struct {
+	log *slog.Logger
+}
+
+func NewLogChannel(log *slog.Logger) *LogChannel {
+	return &LogChannel{log: log}
+}
+
+func (c *LogChannel) Send(ctx context.Context, _ *domain.NotificationPreferences, n *domain.Notification) error {
+	c.log.InfoContext(ctx, "notification", "user_id", n.UserID, "event", n.Event, "pr_id", n.PRID, "message", n.Message)
+	return nil
+}
+
+// maxRecordedResponse caps how much of a webhook response body is kept in the
+// delivery log.
+const maxRecordedResponse = 4 << 10
+
+// WebhookChannel posts notifications to the user's webhook URL as
+// {"text": ...}, the payload Slack and Mattermost incoming webhooks accept.
+// Every attempt is recorded in the delivery log.
+type WebhookChannel struct {
+	http       *http.Client
+	deliveries domain.WebhookDeliveryRepository
+	log        *slog.Logger
+}
+
+func NewWebhookChannel(deliveries domain.WebhookDeliveryRepository, log *slog.Logger) *WebhookChannel {
+	return &WebhookChannel{http: &http.Client{Timeout: 10 * time.Second}, deliveries: deliveries, log: log}
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, prefs *domain.NotificationPreferences, n *domain.Notification) error {
+	if prefs.WebhookURL == "" {
+		return errors.New("no webhook URL configured")
+	}
+	payload, err := json.Marshal(map[string]string{"text": n.Message})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	d := &domain.WebhookDelivery{NotificationID: n.ID, UserID: n.UserID, URL: prefs.WebhookURL, RequestBody: string(payload)}
+	c.post(ctx, d)
+	if _, err := c.deliveries.CreateWebhookDelivery(ctx, d); err != nil {
+		c.log.WarnContext(ctx, "failed to record webhook delivery", "notification_id", n.ID, "error", err)
+	}
+	if d.Failed() {
+		return errors.New(d.Error)
+	}
+	return nil
+}
+
+// Redeliver posts the payload of an earlier attempt to the same URL and
+// records the new attempt.
+func (c *WebhookChannel) Redeliver(ctx context.Context, prev *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	d := &domain.WebhookDelivery{
+		NotificationID: prev.NotificationID,
+		UserID:         prev.UserID,
+		URL:            prev.URL,
+		RequestBody:    prev.RequestBody,
+		RedeliveryOf:   &prev.ID,
+	}
+	c.post(ctx, d)
+	return c.deliveries.CreateWebhookDelivery(ctx, d)
+}
+
+// webhookHealthWindow is how many of the latest deliveries Ping looks at.
+const webhookHealthWindow = 5
+
+// Ping reports the channel down when the latest deliveries all failed. Webhook
+// URLs are per user, so there is no single endpoint to probe; a quiet channel
+// counts as up.
+func (c *WebhookChannel) Ping(ctx context.Context) error {
+	recent, err := c.deliveries.ListWebhookDeliveries(ctx, domain.WebhookDeliveryFilter{Limit: webhookHealthWindow})
+	if err != nil {
+		return fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	if len(recent) < webhookHealthWindow {
+		return nil
+	}
+	for i := range recent {
+		if !recent[i].Failed() {
+			return nil
+		}
+	}
+	return fmt.Errorf("last %d webhook deliveries failed, latest: %s", len(recent), recent[0].Error)
+}
+
+// post sends the payload of d and fills in the outcome of the attempt.
+func (c *WebhookChannel) post(ctx context.Context, d *domain.WebhookDelivery) {
+	start := time.Now()
+	defer func() { d.Duration = time.Since(start) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, strings.NewReader(d.RequestBody))
+	if err != nil {
+		d.Error = fmt.Sprintf("failed to build webhook request: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		d.Error = fmt.Sprintf("webhook delivery failed: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxRecordedResponse))
+	d.StatusCode = resp.StatusCode
+	// Postgres text must be valid UTF-8 without NUL bytes
+	d.ResponseBody = strings.ReplaceAll(strings.ToValidUTF8(string(body), ""), "\x00", "")
+	if resp.StatusCode >= http.StatusBadRequest {
+		d.Error = fmt.Sprintf("webhook responded %s", resp.Status)
+	}
+}
+
+// EmailChannel sends notifications through an SMTP server to the address in
+// the user's preferences. The first line of the message is the subject; a
+// notification with an HTML version is sent as multipart/alternative with the
+// plain text as the fallback.
+type EmailChannel struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewEmailChannel sends through the server at addr ("host:port") as from,
+// authenticating with username and password when username is set.
+func NewEmailChannel(addr, from, username, password string) *EmailChannel {
+	c := &EmailChannel{addr: addr, from: from}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		c.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return c
+}
+
+func (c *EmailChannel) Send(_ context.Context, prefs *domain.NotificationPreferences, n *domain.Notification) error {
+	if prefs.Email == "" {
+		return errors.New("no email address configured")
+	}
+	msg, err := composeEmail(c.from, prefs.Email, n)
+	if err != nil {
+		return fmt.Errorf("failed to compose email: %w", err)
+	}
+	if err := smtp.SendMail(c.addr, c.auth, c.from, []string{prefs.Email}, msg); err != nil {
+		return fmt.Errorf("email delivery failed: %w", err)
+	}
+	return nil
+}
+
+// Ping connects to the SMTP server and issues a NOOP, for readiness checks. It
+// does not authenticate or send anything.
+func (c *EmailChannel) Ping(ctx context.Context) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("SMTP dial failed: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	host, _, _ := net.SplitHostPort(c.addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP handshake failed: %w", err)
+	}
+	defer client.Close()
+	if err := client.Noop(); err != nil {
+		return fmt.Errorf("SMTP NOOP failed: %w", err)
+	}
+	return client.Quit()
+}
+
+func composeEmail(from, to string, n *domain.Notification) ([]byte, error) {
+	subject, _, _ := strings.Cut(n.Message, "\n")
+	subject = strings.TrimSpace(subject)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nMIME-Version: 1.0\r\n",
+		from, to, mime.QEncoding.Encode("utf-8", subject), time.Now().Format(time.RFC1123Z))
+	if n.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\nContent-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&buf, n.Message); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", n.Message},
+		{"text/html; charset=utf-8", n.HTML},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qw := quotedprintable.NewWriter(w)
+	if _, err := io.WriteString(qw, body); err != nil {
+		return err
+	}
+	return qw.Close()
+}
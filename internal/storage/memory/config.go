@@ -0,0 +1,473 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// --- Repositories ---
+
+func (st *state) repositoryOut(repo domain.Repository) *domain.Repository {
+	if repo.DefaultTeamID != nil {
+		repo.DefaultTeamName = st.teamName(*repo.DefaultTeamID)
+	}
+	rules := make([]domain.RoutingRule, len(repo.RoutingRules))
+	for i, rule := range repo.RoutingRules {
+		if rule.TeamID != nil {
+			rule.TeamName = st.teamName(*rule.TeamID)
+		}
+		rule.RequiredSkills = nonNil(rule.RequiredSkills)
+		rules[i] = rule
+	}
+	repo.RoutingRules = rules
+	return &repo
+}
+
+// checkTeams fails with ErrInternalError, like a violated foreign key, when
+// some of the teams do not exist.
+func (st *state) checkTeams(teamIDs ...*int32) error {
+	for _, id := range teamIDs {
+		if id == nil {
+			continue
+		}
+		if _, ok := st.teams[*id]; !ok {
+			return domain.ErrInternalError
+		}
+	}
+	return nil
+}
+
+func (st *state) saveRepository(repo *domain.Repository, createdAt time.Time) (*domain.Repository, error) {
+	teamIDs := []*int32{repo.DefaultTeamID}
+	for _, rule := range repo.RoutingRules {
+		teamIDs = append(teamIDs, rule.TeamID)
+	}
+	if err := st.checkTeams(teamIDs...); err != nil {
+		return nil, err
+	}
+	saved := domain.Repository{
+		Name:              repo.Name,
+		DefaultTeamID:     repo.DefaultTeamID,
+		RequiredReviewers: repo.RequiredReviewers,
+		RoutingRules:      slices.Clone(repo.RoutingRules),
+		CreatedAt:         createdAt,
+	}
+	st.repositories[repo.Name] = saved
+	return st.repositoryOut(saved), nil
+}
+
+func (s *Store) CreateRepository(ctx context.Context, tx domain.Tx, repo *domain.Repository) (*domain.Repository, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Repository, error) {
+		if _, ok := st.repositories[repo.Name]; ok {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrRepoExists, repo.Name)
+		}
+		return st.saveRepository(repo, time.Now())
+	})
+}
+
+func (s *Store) UpdateRepository(ctx context.Context, tx domain.Tx, repo *domain.Repository) (*domain.Repository, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Repository, error) {
+		saved, ok := st.repositories[repo.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, repo.Name)
+		}
+		return st.saveRepository(repo, saved.CreatedAt)
+	})
+}
+
+func (s *Store) GetRepository(ctx context.Context, name string) (*domain.Repository, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.Repository, error) {
+		repo, ok := st.repositories[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, name)
+		}
+		return st.repositoryOut(repo), nil
+	})
+}
+
+func (s *Store) ListRepositories(ctx context.Context) ([]domain.Repository, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.Repository, error) {
+		repos := make([]domain.Repository, 0, len(st.repositories))
+		for _, repo := range st.repositories {
+			repos = append(repos, *st.repositoryOut(repo))
+		}
+		slices.SortFunc(repos, func(a, b domain.Repository) int { return cmp.Compare(a.Name, b.Name) })
+		return repos, nil
+	})
+}
+
+func (s *Store) DeleteRepository(ctx context.Context, name string) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		if _, ok := st.repositories[name]; !ok {
+			return fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, name)
+		}
+		delete(st.repositories, name)
+		return nil
+	})
+}
+
+// --- Review rules ---
+
+func (st *state) reviewRuleOut(rule domain.ReviewRule) *domain.ReviewRule {
+	if rule.TeamID != nil {
+		rule.TeamName = st.teamName(*rule.TeamID)
+	}
+	rule.Labels = nonNil(rule.Labels)
+	rule.Repositories = nonNil(rule.Repositories)
+	rule.RequiredSkills = nonNil(rule.RequiredSkills)
+	return &rule
+}
+
+func (st *state) saveReviewRule(rule *domain.ReviewRule, createdAt time.Time) (*domain.ReviewRule, error) {
+	if err := st.checkTeams(rule.TeamID); err != nil {
+		return nil, err
+	}
+	saved := *rule
+	saved.TeamName = ""
+	saved.Labels = slices.Clone(rule.Labels)
+	saved.Repositories = slices.Clone(rule.Repositories)
+	saved.RequiredSkills = slices.Clone(rule.RequiredSkills)
+	saved.CreatedAt = createdAt
+	st.reviewRules[rule.Name] = saved
+	return st.reviewRuleOut(saved), nil
+}
+
+func (s *Store) CreateReviewRule(ctx context.Context, tx domain.Tx, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.ReviewRule, error) {
+		if _, ok := st.reviewRules[rule.Name]; ok {
+			return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrRuleExists, rule.Name)
+		}
+		return st.saveReviewRule(rule, time.Now())
+	})
+}
+
+func (s *Store) UpdateReviewRule(ctx context.Context, tx domain.Tx, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.ReviewRule, error) {
+		saved, ok := st.reviewRules[rule.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, rule.Name)
+		}
+		return st.saveReviewRule(rule, saved.CreatedAt)
+	})
+}
+
+func (s *Store) GetReviewRule(ctx context.Context, name string) (*domain.ReviewRule, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.ReviewRule, error) {
+		rule, ok := st.reviewRules[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, name)
+		}
+		return st.reviewRuleOut(rule), nil
+	})
+}
+
+func (s *Store) ListReviewRules(ctx context.Context) ([]domain.ReviewRule, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReviewRule, error) {
+		rules := make([]domain.ReviewRule, 0, len(st.reviewRules))
+		for _, rule := range st.reviewRules {
+			rules = append(rules, *st.reviewRuleOut(rule))
+		}
+		slices.SortFunc(rules, func(a, b domain.ReviewRule) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Name, b.Name))
+		})
+		return rules, nil
+	})
+}
+
+func (s *Store) DeleteReviewRule(ctx context.Context, name string) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		if _, ok := st.reviewRules[name]; !ok {
+			return fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, name)
+		}
+		delete(st.reviewRules, name)
+		return nil
+	})
+}
+
+// --- Saved filters ---
+
+func (st *state) savedFilterOut(filter domain.SavedFilter) *domain.SavedFilter {
+	if filter.TeamID != nil {
+		filter.TeamName = st.teamName(*filter.TeamID)
+	}
+	return &filter
+}
+
+// filterNameTaken reports whether another filter of the same owner has the name.
+func (st *state) filterNameTaken(filter domain.SavedFilter) bool {
+	for id, other := range st.savedFilters {
+		sameOwner := other.UserID == filter.UserID && (other.TeamID == nil) == (filter.TeamID == nil) &&
+			(other.TeamID == nil || *other.TeamID == *filter.TeamID)
+		if id != filter.ID && sameOwner && other.Name == filter.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) CreateSavedFilter(ctx context.Context, tx domain.Tx, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.SavedFilter, error) {
+		if err := st.checkTeams(filter.TeamID); err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		saved := domain.SavedFilter{
+			Name:      filter.Name,
+			UserID:    filter.UserID,
+			TeamID:    filter.TeamID,
+			Filter:    filter.Filter,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		saved.Filter.Limit, saved.Filter.Offset = 0, 0
+		if st.filterNameTaken(saved) {
+			return nil, fmt.Errorf("%w: saved filter '%s'", domain.ErrFilterExists, filter.Name)
+		}
+		saved.ID = st.nextID()
+		st.savedFilters[saved.ID] = saved
+		return st.savedFilterOut(saved), nil
+	})
+}
+
+func (s *Store) UpdateSavedFilter(ctx context.Context, tx domain.Tx, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.SavedFilter, error) {
+		saved, ok := st.savedFilters[filter.ID]
+		if !ok {
+			return nil, fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, filter.ID)
+		}
+		saved.Name = filter.Name
+		saved.Filter = filter.Filter
+		saved.Filter.Limit, saved.Filter.Offset = 0, 0
+		saved.UpdatedAt = time.Now()
+		if st.filterNameTaken(saved) {
+			return nil, fmt.Errorf("%w: saved filter '%s'", domain.ErrFilterExists, filter.Name)
+		}
+		st.savedFilters[saved.ID] = saved
+		return st.savedFilterOut(saved), nil
+	})
+}
+
+func (s *Store) GetSavedFilter(ctx context.Context, id int64) (*domain.SavedFilter, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.SavedFilter, error) {
+		filter, ok := st.savedFilters[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, id)
+		}
+		return st.savedFilterOut(filter), nil
+	})
+}
+
+func (s *Store) ListSavedFilters(ctx context.Context, userID string, teamID int32) ([]domain.SavedFilter, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.SavedFilter, error) {
+		var filters []domain.SavedFilter
+		for _, f := range st.savedFilters {
+			if (userID != "" && f.UserID == userID) || (teamID != 0 && f.TeamID != nil && *f.TeamID == teamID) {
+				filters = append(filters, *st.savedFilterOut(f))
+			}
+		}
+		// The user's own filters, without a team, come first.
+		slices.SortFunc(filters, func(a, b domain.SavedFilter) int {
+			ownerA, ownerB := int32(-1), int32(-1)
+			if a.TeamID != nil {
+				ownerA = *a.TeamID
+			}
+			if b.TeamID != nil {
+				ownerB = *b.TeamID
+			}
+			return cmp.Or(cmp.Compare(ownerA, ownerB), cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+		})
+		return filters, nil
+	})
+}
+
+func (s *Store) DeleteSavedFilter(ctx context.Context, id int64) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		if _, ok := st.savedFilters[id]; !ok {
+			return fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, id)
+		}
+		delete(st.savedFilters, id)
+		return nil
+	})
+}
+
+func (s *Store) RenameSavedFilterTeam(ctx context.Context, tx domain.Tx, oldName, newName string) (int, error) {
+	return update(s, ctx, tx, func(st *state) (int, error) {
+		n := 0
+		for id, filter := range st.savedFilters {
+			if filter.Filter.TeamName == oldName {
+				filter.Filter.TeamName = newName
+				st.savedFilters[id] = filter
+				n++
+			}
+		}
+		return n, nil
+	})
+}
+
+// --- PR templates ---
+
+func (st *state) prTemplateOut(template domain.PRTemplate) *domain.PRTemplate {
+	template.TeamName = st.teamName(template.TeamID)
+	template.Labels = nonNil(template.Labels)
+	template.DefaultReviewers = nonNil(template.DefaultReviewers)
+	return &template
+}
+
+func (st *state) templateNameTaken(template domain.PRTemplate) bool {
+	for id, other := range st.prTemplates {
+		if id != template.ID && other.TeamID == template.TeamID && other.Name == template.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) CreatePRTemplate(ctx context.Context, tx domain.Tx, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PRTemplate, error) {
+		if _, err := st.team(template.TeamID); err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		saved := domain.PRTemplate{
+			TeamID:           template.TeamID,
+			Name:             template.Name,
+			NamePrefix:       template.NamePrefix,
+			Labels:           slices.Clone(template.Labels),
+			DefaultReviewers: slices.Clone(template.DefaultReviewers),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		if st.templateNameTaken(saved) {
+			return nil, fmt.Errorf("%w: PR template '%s'", domain.ErrTemplateExists, template.Name)
+		}
+		saved.ID = st.nextID()
+		st.prTemplates[saved.ID] = saved
+		return st.prTemplateOut(saved), nil
+	})
+}
+
+func (s *Store) UpdatePRTemplate(ctx context.Context, tx domain.Tx, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PRTemplate, error) {
+		saved, ok := st.prTemplates[template.ID]
+		if !ok {
+			return nil, fmt.Errorf("%w: PR template %d", domain.ErrNotFound, template.ID)
+		}
+		saved.Name = template.Name
+		saved.NamePrefix = template.NamePrefix
+		saved.Labels = slices.Clone(template.Labels)
+		saved.DefaultReviewers = slices.Clone(template.DefaultReviewers)
+		saved.UpdatedAt = time.Now()
+		if st.templateNameTaken(saved) {
+			return nil, fmt.Errorf("%w: PR template '%s'", domain.ErrTemplateExists, template.Name)
+		}
+		st.prTemplates[saved.ID] = saved
+		return st.prTemplateOut(saved), nil
+	})
+}
+
+func (s *Store) GetPRTemplate(ctx context.Context, id int64) (*domain.PRTemplate, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.PRTemplate, error) {
+		template, ok := st.prTemplates[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: PR template %d", domain.ErrNotFound, id)
+		}
+		return st.prTemplateOut(template), nil
+	})
+}
+
+func (s *Store) ListPRTemplates(ctx context.Context, teamID int32) ([]domain.PRTemplate, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PRTemplate, error) {
+		var templates []domain.PRTemplate
+		for _, template := range st.prTemplates {
+			if template.TeamID == teamID {
+				templates = append(templates, *st.prTemplateOut(template))
+			}
+		}
+		slices.SortFunc(templates, func(a, b domain.PRTemplate) int { return cmp.Compare(a.Name, b.Name) })
+		return templates, nil
+	})
+}
+
+func (s *Store) DeletePRTemplate(ctx context.Context, id int64) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		if _, ok := st.prTemplates[id]; !ok {
+			return fmt.Errorf("%w: PR template %d", domain.ErrNotFound, id)
+		}
+		delete(st.prTemplates, id)
+		return nil
+	})
+}
+
+// --- Settings ---
+
+func (s *Store) GetOrgSettings(ctx context.Context) (*domain.Settings, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.Settings, error) {
+		if st.orgSettings == nil {
+			return nil, fmt.Errorf("%w: org settings", domain.ErrNotFound)
+		}
+		settings := *st.orgSettings
+		return &settings, nil
+	})
+}
+
+func (s *Store) SaveOrgSettings(ctx context.Context, tx domain.Tx, settings *domain.Settings) (*domain.Settings, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Settings, error) {
+		saved := *settings
+		saved.UpdatedAt = time.Now()
+		st.orgSettings = &saved
+		out := saved
+		return &out, nil
+	})
+}
+
+func (s *Store) GetTeamSettings(ctx context.Context, teamID int32) (*domain.TeamSettings, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.TeamSettings, error) {
+		settings, ok := st.teamSettings[teamID]
+		if !ok {
+			return nil, fmt.Errorf("%w: settings of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		settings.TeamName = st.teamName(teamID)
+		return &settings, nil
+	})
+}
+
+func (s *Store) ListTeamSettings(ctx context.Context) ([]domain.TeamSettings, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.TeamSettings, error) {
+		settings := make([]domain.TeamSettings, 0, len(st.teamSettings))
+		for teamID, t := range st.teamSettings {
+			t.TeamName = st.teamName(teamID)
+			settings = append(settings, t)
+		}
+		slices.SortFunc(settings, func(a, b domain.TeamSettings) int { return cmp.Compare(a.TeamName, b.TeamName) })
+		return settings, nil
+	})
+}
+
+func (s *Store) SetTeamSettings(ctx context.Context, tx domain.Tx, settings *domain.TeamSettings) (*domain.TeamSettings, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.TeamSettings, error) {
+		team, err := st.team(settings.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		saved := *settings
+		saved.TeamName = team.TeamName
+		saved.UpdatedAt = time.Now()
+		st.teamSettings[settings.TeamID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) DeleteTeamSettings(ctx context.Context, tx domain.Tx, teamID int32) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.teamSettings[teamID]; !ok {
+			return fmt.Errorf("%w: settings of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		delete(st.teamSettings, teamID)
+		return nil
+	})
+}
@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// --- GitHubRepository Implementation ---
+//
+// Only GitHub accounts, PR links and ingestion tokens are kept; the GitHub App
+// and teams sync tables are not.
+
+func (s *Store) SetGitHubLogin(ctx context.Context, tx domain.Tx, userID, login string) (*domain.GitHubAccount, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.GitHubAccount, error) {
+		if _, err := st.user(userID); err != nil {
+			return nil, err
+		}
+		for other, l := range st.githubLogins {
+			if other != userID && l == login {
+				return nil, fmt.Errorf("%w: GitHub login '%s' is already linked to another user", domain.ErrValidation, domain.PII(login))
+			}
+		}
+		st.githubLogins[userID] = login
+		return &domain.GitHubAccount{UserID: userID, Login: login}, nil
+	})
+}
+
+func (st *state) githubAccounts(match func(userID, login string) bool) []domain.GitHubAccount {
+	accounts := []domain.GitHubAccount{}
+	for userID, login := range st.githubLogins {
+		if match(userID, login) {
+			accounts = append(accounts, domain.GitHubAccount{UserID: userID, Login: login})
+		}
+	}
+	slices.SortFunc(accounts, func(a, b domain.GitHubAccount) int { return cmp.Compare(a.UserID, b.UserID) })
+	return accounts
+}
+
+func (s *Store) GetGitHubAccountsByUserIDs(ctx context.Context, userIDs []string) ([]domain.GitHubAccount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.GitHubAccount, error) {
+		return st.githubAccounts(func(userID, _ string) bool { return slices.Contains(userIDs, userID) }), nil
+	})
+}
+
+// GetGitHubAccountsByLogins matches logins case-insensitively, as GitHub does.
+func (s *Store) GetGitHubAccountsByLogins(ctx context.Context, logins []string) ([]domain.GitHubAccount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.GitHubAccount, error) {
+		return st.githubAccounts(func(_, login string) bool {
+			return slices.ContainsFunc(logins, func(l string) bool { return strings.EqualFold(l, login) })
+		}), nil
+	})
+}
+
+func (s *Store) LinkGitHubPR(ctx context.Context, tx domain.Tx, link *domain.GitHubPRLink) (*domain.GitHubPRLink, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.GitHubPRLink, error) {
+		if _, err := st.pr(link.PRID); err != nil {
+			return nil, err
+		}
+		for prID, other := range st.githubPRLinks {
+			if prID != link.PRID && other.Repository == link.Repository && other.Number == link.Number {
+				return nil, fmt.Errorf("%w: %s#%d is already linked to another PR", domain.ErrValidation, link.Repository, link.Number)
+			}
+		}
+		saved := domain.GitHubPRLink{PRID: link.PRID, Repository: link.Repository, Number: link.Number}
+		st.githubPRLinks[link.PRID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) GetGitHubPRLink(ctx context.Context, prID string) (*domain.GitHubPRLink, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.GitHubPRLink, error) {
+		link, ok := st.githubPRLinks[prID]
+		if !ok {
+			return nil, fmt.Errorf("%w: GitHub link for PR '%s'", domain.ErrNotFound, prID)
+		}
+		return &link, nil
+	})
+}
+
+func (s *Store) GetGitHubPRLinkByNumber(ctx context.Context, repository string, number int) (*domain.GitHubPRLink, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.GitHubPRLink, error) {
+		for _, link := range st.githubPRLinks {
+			if link.Repository == repository && link.Number == number {
+				return &link, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: GitHub link for %s#%d", domain.ErrNotFound, repository, number)
+	})
+}
+
+func (s *Store) UpsertGitHubInstallation(context.Context, domain.Tx, *domain.GitHubInstallation) error {
+	return errUnsupported
+}
+
+func (s *Store) DeleteGitHubInstallation(context.Context, domain.Tx, int64) error {
+	return errUnsupported
+}
+
+func (s *Store) GetGitHubInstallationByAccount(context.Context, string) (*domain.GitHubInstallation, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) SaveGitHubInstallationToken(context.Context, int64, string, time.Time) error {
+	return errUnsupported
+}
+
+func (s *Store) AddGitHubRepos(context.Context, domain.Tx, int64, []string) error {
+	return errUnsupported
+}
+
+func (s *Store) RemoveGitHubRepos(context.Context, domain.Tx, []string) error {
+	return errUnsupported
+}
+
+func (s *Store) SetGitHubRepoTeam(context.Context, domain.Tx, string, int32) error {
+	return errUnsupported
+}
+
+func (s *Store) GetGitHubRepo(context.Context, string) (*domain.GitHubRepo, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) ListGitHubRepos(context.Context) ([]domain.GitHubRepo, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) ListGitHubTeamLinks(context.Context, string) ([]domain.GitHubTeamLink, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) LinkGitHubTeam(context.Context, domain.Tx, *domain.GitHubTeamLink) error {
+	return errUnsupported
+}
+
+func (s *Store) SetGitHubIngestionToken(ctx context.Context, tx domain.Tx, token *domain.GitHubIngestionToken) (*domain.GitHubIngestionToken, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.GitHubIngestionToken, error) {
+		if _, err := st.team(token.TeamID); err != nil {
+			return nil, err
+		}
+		if old, ok := st.ingestionTokens[token.Repository]; ok && old.TeamID != token.TeamID {
+			return nil, fmt.Errorf("%w: repository '%s' already has an ingestion token of another team", domain.ErrRepoExists, token.Repository)
+		}
+		stored := domain.GitHubIngestionToken{Repository: token.Repository, TeamID: token.TeamID, Secret: token.Secret, CreatedAt: time.Now()}
+		st.ingestionTokens[token.Repository] = stored
+		return st.ingestionTokenOut(stored), nil
+	})
+}
+
+// ingestionTokenOut copies a stored token with TeamName filled in.
+func (st *state) ingestionTokenOut(token domain.GitHubIngestionToken) *domain.GitHubIngestionToken {
+	token.TeamName = st.teamName(token.TeamID)
+	return &token
+}
+
+func (s *Store) GetGitHubIngestionToken(ctx context.Context, repository string) (*domain.GitHubIngestionToken, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.GitHubIngestionToken, error) {
+		token, ok := st.ingestionTokens[repository]
+		if !ok {
+			return nil, fmt.Errorf("%w: ingestion token of repository '%s'", domain.ErrNotFound, repository)
+		}
+		return st.ingestionTokenOut(token), nil
+	})
+}
+
+func (s *Store) ListGitHubIngestionTokens(ctx context.Context) ([]domain.GitHubIngestionToken, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.GitHubIngestionToken, error) {
+		tokens := make([]domain.GitHubIngestionToken, 0, len(st.ingestionTokens))
+		for _, token := range st.ingestionTokens {
+			out := st.ingestionTokenOut(token)
+			out.Secret = ""
+			tokens = append(tokens, *out)
+		}
+		slices.SortFunc(tokens, func(a, b domain.GitHubIngestionToken) int { return cmp.Compare(a.Repository, b.Repository) })
+		return tokens, nil
+	})
+}
+
+func (s *Store) DeleteGitHubIngestionToken(ctx context.Context, tx domain.Tx, repository string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.ingestionTokens[repository]; !ok {
+			return fmt.Errorf("%w: ingestion token of repository '%s'", domain.ErrNotFound, repository)
+		}
+		delete(st.ingestionTokens, repository)
+		return nil
+	})
+}
+
+func (s *Store) TouchGitHubIngestionToken(ctx context.Context, repository string) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		if token, ok := st.ingestionTokens[repository]; ok {
+			now := time.Now()
+			token.LastUsedAt = &now
+			st.ingestionTokens[repository] = token
+		}
+		return nil
+	})
+}
+
+func (s *Store) SaveGitHubTeamSyncReport(context.Context, domain.Tx, *domain.GitHubTeamSyncReport) error {
+	return errUnsupported
+}
+
+func (s *Store) GetLatestGitHubTeamSyncReport(context.Context) (*domain.GitHubTeamSyncReport, error) {
+	return nil, errUnsupported
+}
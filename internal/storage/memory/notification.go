@@ -0,0 +1,270 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// outboxEntry is a row of the notification outbox.
+type outboxEntry struct {
+	domain.Notification
+	createdAt      time.Time
+	deliverAfter   time.Time
+	sentAt         *time.Time
+	deadLetteredAt *time.Time
+	lastError      string
+}
+
+// --- NotificationRepository Implementation ---
+
+func prefsOut(prefs domain.NotificationPreferences) *domain.NotificationPreferences {
+	prefs.Channels = slices.Clone(prefs.Channels)
+	prefs.MutedEvents = slices.Clone(prefs.MutedEvents)
+	return &prefs
+}
+
+func (s *Store) GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.NotificationPreferences, error) {
+		prefs, ok := st.notificationPrefs[userID]
+		if !ok {
+			return nil, fmt.Errorf("%w: notification preferences of user '%s'", domain.ErrNotFound, userID)
+		}
+		return prefsOut(prefs), nil
+	})
+}
+
+func (s *Store) SetNotificationPreferences(ctx context.Context, tx domain.Tx, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.NotificationPreferences, error) {
+		if _, err := st.user(prefs.UserID); err != nil {
+			return nil, err
+		}
+		saved := *prefsOut(*prefs)
+		saved.Channels = nonNil(saved.Channels)
+		if saved.MutedEvents == nil {
+			saved.MutedEvents = []domain.NotificationEvent{}
+		}
+		st.notificationPrefs[prefs.UserID] = saved
+		return prefsOut(saved), nil
+	})
+}
+
+func (s *Store) EnqueueNotification(ctx context.Context, tx domain.Tx, n *domain.Notification, deliverAfter time.Time) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.users[n.UserID]; !ok {
+			return domain.ErrInternalError
+		}
+		id := st.nextID()
+		st.outbox[id] = outboxEntry{
+			Notification: domain.Notification{ID: id, UserID: n.UserID, Event: n.Event, PRID: n.PRID, Message: n.Message, HTML: n.HTML},
+			createdAt:    time.Now(),
+			deliverAfter: deliverAfter,
+		}
+		return nil
+	})
+}
+
+// ClaimDueNotifications returns the due notifications. There is no row
+// locking: a transaction that claims the same rows as another one conflicts
+// with it on commit and is retried.
+func (s *Store) ClaimDueNotifications(ctx context.Context, tx domain.Tx, limit int) ([]domain.Notification, error) {
+	return view(s, ctx, tx, func(st *state) ([]domain.Notification, error) {
+		now := time.Now()
+		var due []outboxEntry
+		for _, e := range st.outbox {
+			if e.sentAt == nil && e.deadLetteredAt == nil && !e.deliverAfter.After(now) {
+				due = append(due, e)
+			}
+		}
+		slices.SortFunc(due, func(a, b outboxEntry) int {
+			return cmp.Or(a.deliverAfter.Compare(b.deliverAfter), cmp.Compare(a.ID, b.ID))
+		})
+		notifications := []domain.Notification{}
+		for _, e := range due[:min(limit, len(due))] {
+			notifications = append(notifications, e.Notification)
+		}
+		return notifications, nil
+	})
+}
+
+// setOutboxEntry applies change to the notification; unknown IDs are ignored
+// like in the UPDATE statements of the Postgres backend.
+func (s *Store) setOutboxEntry(ctx context.Context, tx domain.Tx, id int64, change func(e *outboxEntry)) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		e, ok := st.outbox[id]
+		if !ok {
+			return nil
+		}
+		change(&e)
+		st.outbox[id] = e
+		return nil
+	})
+}
+
+func (s *Store) MarkNotificationSent(ctx context.Context, tx domain.Tx, id int64) error {
+	return s.setOutboxEntry(ctx, tx, id, func(e *outboxEntry) {
+		e.sentAt = ptr(time.Now())
+	})
+}
+
+func (s *Store) MarkNotificationFailed(ctx context.Context, tx domain.Tx, id int64, reason string, retryAt time.Time) error {
+	return s.setOutboxEntry(ctx, tx, id, func(e *outboxEntry) {
+		e.Attempts++
+		e.lastError = reason
+		e.deliverAfter = retryAt
+	})
+}
+
+func (s *Store) MarkNotificationDeadLettered(ctx context.Context, tx domain.Tx, id int64, reason string) error {
+	return s.setOutboxEntry(ctx, tx, id, func(e *outboxEntry) {
+		e.Attempts++
+		e.lastError = reason
+		e.deadLetteredAt = ptr(time.Now())
+	})
+}
+
+func (s *Store) ListDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.DeadLetter, error) {
+		letters := []domain.DeadLetter{}
+		for _, e := range st.outbox {
+			if e.deadLetteredAt == nil ||
+				(filter.UserID != "" && e.UserID != filter.UserID) ||
+				(filter.Event != "" && e.Event != filter.Event) {
+				continue
+			}
+			letters = append(letters, domain.DeadLetter{
+				Notification:   e.Notification,
+				LastError:      e.lastError,
+				CreatedAt:      e.createdAt,
+				DeadLetteredAt: *e.deadLetteredAt,
+			})
+		}
+		slices.SortFunc(letters, func(a, b domain.DeadLetter) int {
+			return cmp.Or(b.DeadLetteredAt.Compare(a.DeadLetteredAt), cmp.Compare(b.ID, a.ID))
+		})
+		return paginate(letters, filter.Limit, filter.Offset), nil
+	})
+}
+
+func (s *Store) ClaimDueDigests(context.Context, domain.Tx, int) ([]domain.NotificationPreferences, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) MarkDigestSent(context.Context, domain.Tx, string) error {
+	return errUnsupported
+}
+
+func (s *Store) ReplayNotifications(context.Context, domain.Tx, domain.NotificationFilter) (int, error) {
+	return 0, errUnsupported
+}
+
+// --- WebhookDeliveryRepository Implementation ---
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, d *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	return update(s, ctx, nil, func(st *state) (*domain.WebhookDelivery, error) {
+		saved := *d
+		saved.ID = st.nextID()
+		saved.Duration = d.Duration.Truncate(time.Millisecond)
+		saved.AttemptedAt = time.Now()
+		st.webhookDeliveries[saved.ID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, id int64) (*domain.WebhookDelivery, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.WebhookDelivery, error) {
+		d, ok := st.webhookDeliveries[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: webhook delivery %d", domain.ErrNotFound, id)
+		}
+		return &d, nil
+	})
+}
+
+func (s *Store) ListWebhookDeliveries(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.WebhookDelivery, error) {
+		deliveries := []domain.WebhookDelivery{}
+		for _, d := range st.webhookDeliveries {
+			switch {
+			case filter.UserID != "" && d.UserID != filter.UserID,
+				filter.Status == "failed" && d.Error == "",
+				filter.Status == "succeeded" && d.Error != "":
+				continue
+			}
+			deliveries = append(deliveries, d)
+		}
+		slices.SortFunc(deliveries, func(a, b domain.WebhookDelivery) int {
+			return cmp.Or(b.AttemptedAt.Compare(a.AttemptedAt), cmp.Compare(b.ID, a.ID))
+		})
+		return paginate(deliveries, filter.Limit, filter.Offset), nil
+	})
+}
+
+func (s *Store) HasWebhookTargets(ctx context.Context) (bool, error) {
+	return view(s, ctx, nil, func(st *state) (bool, error) {
+		for _, prefs := range st.notificationPrefs {
+			if prefs.WebhookURL != "" && slices.Contains(prefs.Channels, "webhook") {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// --- InboundWebhookRepository Implementation ---
+
+func (s *Store) CreateInboundWebhook(ctx context.Context, w *domain.InboundWebhook) (*domain.InboundWebhook, error) {
+	return update(s, ctx, nil, func(st *state) (*domain.InboundWebhook, error) {
+		saved := *w
+		saved.ID = st.nextID()
+		saved.Attempts = 1
+		saved.ReceivedAt = time.Now()
+		saved.ProcessedAt = saved.ReceivedAt
+		st.inboundWebhooks[saved.ID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) GetInboundWebhook(ctx context.Context, id int64) (*domain.InboundWebhook, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.InboundWebhook, error) {
+		w, ok := st.inboundWebhooks[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: inbound webhook %d", domain.ErrNotFound, id)
+		}
+		return &w, nil
+	})
+}
+
+func (s *Store) ListInboundWebhooks(ctx context.Context, filter domain.InboundWebhookFilter) ([]domain.InboundWebhook, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.InboundWebhook, error) {
+		webhooks := []domain.InboundWebhook{}
+		for _, w := range st.inboundWebhooks {
+			if (filter.Status != "" && w.Status != filter.Status) || (filter.Event != "" && w.Event != filter.Event) {
+				continue
+			}
+			webhooks = append(webhooks, w)
+		}
+		slices.SortFunc(webhooks, func(a, b domain.InboundWebhook) int {
+			return cmp.Or(b.ReceivedAt.Compare(a.ReceivedAt), cmp.Compare(b.ID, a.ID))
+		})
+		return paginate(webhooks, filter.Limit, filter.Offset), nil
+	})
+}
+
+func (s *Store) UpdateInboundWebhookResult(ctx context.Context, id int64, status domain.InboundWebhookStatus, errMsg string) (*domain.InboundWebhook, error) {
+	return update(s, ctx, nil, func(st *state) (*domain.InboundWebhook, error) {
+		w, ok := st.inboundWebhooks[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: inbound webhook %d", domain.ErrNotFound, id)
+		}
+		w.Status, w.Error = status, errMsg
+		w.Attempts++
+		w.ProcessedAt = time.Now()
+		st.inboundWebhooks[id] = w
+		return &w, nil
+	})
+}
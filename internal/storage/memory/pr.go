@@ -0,0 +1,974 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// pullRequest is a stored PR. Reviewers, verdicts and the checklist live in
+// their own tables and are left empty here.
+type pullRequest struct {
+	domain.PullRequest
+	leadNotifiedAt      *time.Time
+	reviewerEscalatedAt *time.Time
+}
+
+type assignmentKey struct {
+	prID   string
+	userID string
+}
+
+type assignment struct {
+	// seq keeps the reviewers of a PR in the order they were assigned.
+	seq                int64
+	optional           bool
+	assignedAt         time.Time
+	approvedAt         *time.Time
+	changesRequestedAt *time.Time
+	reviewedAt         *time.Time
+	ackedAt            *time.Time
+	ackRemindedAt      *time.Time
+}
+
+type reassignment struct {
+	prID       string
+	teamID     int32
+	fromUserID string
+	toUserID   string
+	reason     domain.ReassignmentReason
+	decline    domain.DeclineReason
+	at         time.Time
+	by         string
+}
+
+type checklistKey struct {
+	prID     string
+	position int
+}
+
+// statusOrder and priorityOrder sort PRs like the pr_status and pr_priority enums do.
+var (
+	statusOrder   = []domain.PRStatus{domain.StatusDraft, domain.StatusOpen, domain.StatusInReview, domain.StatusApproved, domain.StatusMerged, domain.StatusClosed}
+	priorityOrder = []domain.PRPriority{domain.PriorityLow, domain.PriorityNormal, domain.PriorityUrgent}
+)
+
+func compareStatus(a, b domain.PRStatus) int {
+	return cmp.Compare(slices.Index(statusOrder, a), slices.Index(statusOrder, b))
+}
+
+// compareUrgency puts more urgent priorities first.
+func compareUrgency(a, b domain.PRPriority) int {
+	return cmp.Compare(slices.Index(priorityOrder, b), slices.Index(priorityOrder, a))
+}
+
+func finished(status domain.PRStatus) bool {
+	return status == domain.StatusMerged || status == domain.StatusClosed
+}
+
+func (st *state) pr(prID string) (pullRequest, error) {
+	pr, ok := st.prs[prID]
+	if !ok {
+		return pullRequest{}, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+	}
+	return pr, nil
+}
+
+// prOut copies a stored PR, so callers cannot change the stored slices.
+func prOut(pr pullRequest) *domain.PullRequest {
+	out := pr.PullRequest
+	out.RequiredSkills = slices.Clone(out.RequiredSkills)
+	out.Labels = slices.Clone(out.Labels)
+	return &out
+}
+
+type prAssignment struct {
+	userID string
+	assignment
+}
+
+// prAssignments returns the assignments of the PR in the order they were made.
+func (st *state) prAssignments(prID string) []prAssignment {
+	var as []prAssignment
+	for key, a := range st.assignments {
+		if key.prID == prID {
+			as = append(as, prAssignment{userID: key.userID, assignment: a})
+		}
+	}
+	slices.SortFunc(as, func(a, b prAssignment) int { return cmp.Compare(a.seq, b.seq) })
+	return as
+}
+
+// reviewersByVerdict returns the reviewers whose verdict time is set, ordered
+// by that time.
+func reviewersByVerdict(as []prAssignment, at func(prAssignment) *time.Time) []string {
+	var matched []prAssignment
+	for _, a := range as {
+		if at(a) != nil {
+			matched = append(matched, a)
+		}
+	}
+	slices.SortFunc(matched, func(a, b prAssignment) int {
+		return cmp.Or(at(a).Compare(*at(b)), cmp.Compare(a.userID, b.userID))
+	})
+	ids := make([]string, len(matched))
+	for i, a := range matched {
+		ids[i] = a.userID
+	}
+	return ids
+}
+
+func approvedAt(a prAssignment) *time.Time         { return a.approvedAt }
+func changesRequestedAt(a prAssignment) *time.Time { return a.changesRequestedAt }
+
+// refreshStatus moves a PR that is ready for review by its required
+// reviewers' verdicts, as the refresh_pr_review_status trigger does.
+func (st *state) refreshStatus(prID string) {
+	pr, ok := st.prs[prID]
+	if !ok || (pr.Status != domain.StatusOpen && pr.Status != domain.StatusInReview && pr.Status != domain.StatusApproved) {
+		return
+	}
+	required, pending := 0, 0
+	for _, a := range st.prAssignments(prID) {
+		if a.optional {
+			continue
+		}
+		required++
+		if a.approvedAt == nil {
+			pending++
+		}
+	}
+	switch {
+	case required == 0:
+		pr.Status = domain.StatusOpen
+	case pending == 0:
+		pr.Status = domain.StatusApproved
+	default:
+		pr.Status = domain.StatusInReview
+	}
+	st.prs[prID] = pr
+}
+
+func (s *Store) CreatePR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) (*domain.PullRequest, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PullRequest, error) {
+		if _, ok := st.archivedPRs[pr.ID]; ok {
+			return nil, fmt.Errorf("%w: PR '%s' (archived)", domain.ErrPRExists, pr.ID)
+		}
+		if _, ok := st.prs[pr.ID]; ok {
+			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrPRExists, pr.ID)
+		}
+		if pr.ExternalID != "" {
+			for _, other := range st.prs {
+				if other.ExternalID == pr.ExternalID {
+					return nil, fmt.Errorf("%w: PR with external id '%s'", domain.ErrPRExists, pr.ExternalID)
+				}
+			}
+		}
+		if _, ok := st.repositories[pr.Repository]; pr.Repository != "" && !ok {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, pr.Repository)
+		}
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return nil, fmt.Errorf("%w: author '%s'", domain.ErrNotFound, pr.AuthorID)
+		}
+		created := domain.PullRequest{
+			ID:             pr.ID,
+			ExternalID:     pr.ExternalID,
+			Name:           pr.Name,
+			Description:    pr.Description,
+			AuthorID:       pr.AuthorID,
+			Status:         pr.Status,
+			RequiredSkills: nonNil(pr.RequiredSkills),
+			Labels:         nonNil(pr.Labels),
+			AutoMerge:      pr.AutoMerge,
+			Priority:       cmp.Or(pr.Priority, domain.PriorityNormal),
+			Repository:     pr.Repository,
+			Size:           pr.Size,
+			CreatedAt:      time.Now(),
+		}
+		st.prs[created.ID] = pullRequest{PullRequest: created}
+		return prOut(st.prs[created.ID]), nil
+	})
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return slices.Clone(s)
+}
+
+func (s *Store) GetPRByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.PullRequest, error) {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return nil, err
+		}
+		return prOut(pr), nil
+	})
+}
+
+func (s *Store) GetPRWithReviewers(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.PullRequest, error) {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return nil, err
+		}
+		return st.prWithReviewers(pr), nil
+	})
+}
+
+func (st *state) prWithReviewers(pr pullRequest) *domain.PullRequest {
+	out := prOut(pr)
+	as := st.prAssignments(pr.ID)
+	out.Reviewers = make([]domain.Reviewer, len(as))
+	for i, a := range as {
+		out.Reviewers[i] = domain.Reviewer{ID: a.userID, Username: st.users[a.userID].Username, Optional: a.optional}
+	}
+	out.ApprovedBy = reviewersByVerdict(as, approvedAt)
+	out.ChangesRequestedBy = reviewersByVerdict(as, changesRequestedAt)
+	out.ShadowReviewers = []string{}
+	for key := range st.shadowReviews {
+		if key.prID == pr.ID {
+			out.ShadowReviewers = append(out.ShadowReviewers, key.userID)
+		}
+	}
+	slices.Sort(out.ShadowReviewers)
+	out.Checklist = st.checklist(pr.ID)
+	return out
+}
+
+func (s *Store) GetPRByExternalID(ctx context.Context, externalID string) (*domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.PullRequest, error) {
+		for _, pr := range st.prs {
+			if pr.ExternalID == externalID {
+				return prOut(pr), nil
+			}
+		}
+		return nil, fmt.Errorf("%w: PR with external id '%s'", domain.ErrNotFound, externalID)
+	})
+}
+
+// timelineEvent is a PR event with the rank that orders events at the same time.
+type timelineEvent struct {
+	domain.PREvent
+	rank int
+}
+
+func (s *Store) GetPRTimeline(ctx context.Context, prID string) ([]domain.PREvent, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PREvent, error) {
+		pr, ok := st.prs[prID]
+		if !ok {
+			return []domain.PREvent{}, nil
+		}
+		events := []timelineEvent{{domain.PREvent{Type: domain.PREventCreated, At: pr.CreatedAt, UserID: pr.AuthorID}, 0}}
+		for _, a := range st.prAssignments(prID) {
+			events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventAssigned, At: a.assignedAt, UserID: a.userID}, 1})
+			if a.ackedAt != nil {
+				events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventAcked, At: *a.ackedAt, UserID: a.userID}, 2})
+			}
+			if a.changesRequestedAt != nil {
+				events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventChangesRequested, At: *a.changesRequestedAt, UserID: a.userID}, 3})
+			}
+			if a.approvedAt != nil {
+				events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventApproved, At: *a.approvedAt, UserID: a.userID}, 3})
+			}
+		}
+		for _, r := range st.reassignments {
+			if r.prID == prID {
+				events = append(events, timelineEvent{domain.PREvent{
+					Type:          domain.PREventReassigned,
+					At:            r.at,
+					UserID:        r.fromUserID,
+					ReplacedBy:    r.toUserID,
+					Reason:        r.reason,
+					DeclineReason: r.decline,
+					Actor:         r.by,
+				}, 1})
+			}
+		}
+		if pr.MergedAt != nil {
+			events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventMerged, At: *pr.MergedAt, UserID: cmp.Or(pr.MergedBy, pr.AuthorID), Actor: pr.MergedBy}, 4})
+		}
+		if pr.ClosedAt != nil {
+			events = append(events, timelineEvent{domain.PREvent{Type: domain.PREventClosed, At: *pr.ClosedAt, UserID: pr.AuthorID}, 4})
+		}
+		slices.SortStableFunc(events, func(a, b timelineEvent) int {
+			return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.rank, b.rank), cmp.Compare(a.UserID, b.UserID))
+		})
+		out := make([]domain.PREvent, len(events))
+		for i, e := range events {
+			out[i] = e.PREvent
+		}
+		return out, nil
+	})
+}
+
+// mergedPR returns the PR with Reviewers filled in the way MergePR returns it.
+func (st *state) mergedPR(pr pullRequest) *domain.PullRequest {
+	out := prOut(pr)
+	as := st.prAssignments(pr.ID)
+	out.Reviewers = make([]domain.Reviewer, len(as))
+	for i, a := range as {
+		out.Reviewers[i] = domain.Reviewer{ID: a.userID, Username: st.users[a.userID].Username}
+	}
+	return out
+}
+
+func (s *Store) MergePR(ctx context.Context, tx domain.Tx, prID, mergedBy string) (*domain.PullRequest, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PullRequest, error) {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return nil, err
+		}
+		pr.Status = domain.StatusMerged
+		pr.MergedAt = ptr(time.Now())
+		pr.MergedBy = mergedBy
+		st.prs[prID] = pr
+		return st.mergedPR(pr), nil
+	})
+}
+
+func (s *Store) MarkPRReady(ctx context.Context, tx domain.Tx, prID string) (*domain.PullRequest, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PullRequest, error) {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return nil, err
+		}
+		if pr.Status != domain.StatusDraft {
+			return nil, fmt.Errorf("%w: PR '%s' is not a draft", domain.ErrInvalidTransition, prID)
+		}
+		pr.Status = domain.StatusOpen
+		st.prs[prID] = pr
+		// A draft marked ready may already have reviewers assigned by hand.
+		st.refreshStatus(prID)
+		return prOut(st.prs[prID]), nil
+	})
+}
+
+func (s *Store) ClosePR(ctx context.Context, tx domain.Tx, prID string) (*domain.PullRequest, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PullRequest, error) {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return nil, err
+		}
+		if finished(pr.Status) {
+			return nil, fmt.Errorf("%w: PR '%s' is already finished", domain.ErrInvalidTransition, prID)
+		}
+		pr.Status = domain.StatusClosed
+		pr.ClosedAt = ptr(time.Now())
+		st.prs[prID] = pr
+		return prOut(pr), nil
+	})
+}
+
+func (st *state) reviewers(prID string, requiredOnly bool) []domain.User {
+	var users []domain.User
+	for _, a := range st.prAssignments(prID) {
+		if requiredOnly && a.optional {
+			continue
+		}
+		if user, ok := st.users[a.userID]; ok {
+			users = append(users, *st.userOut(user))
+		}
+	}
+	return users
+}
+
+func (s *Store) GetReviewers(ctx context.Context, prID string) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		return st.reviewers(prID, false), nil
+	})
+}
+
+func (s *Store) GetRequiredReviewers(ctx context.Context, prID string) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		return st.reviewers(prID, true), nil
+	})
+}
+
+func (s *Store) RemoveReviewer(ctx context.Context, tx domain.Tx, prID string, userID string) (bool, error) {
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		key := assignmentKey{prID: prID, userID: userID}
+		a, ok := st.assignments[key]
+		if !ok {
+			return false, nil
+		}
+		delete(st.assignments, key)
+		st.refreshStatus(prID)
+		return a.optional, nil
+	})
+}
+
+func (s *Store) AssignReviewers(ctx context.Context, tx domain.Tx, prID string, userIDs []string, optional bool) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, err := st.pr(prID); err != nil {
+			return domain.ErrInternalError
+		}
+		for i, userID := range userIDs {
+			if _, ok := st.users[userID]; !ok || slices.Contains(userIDs[:i], userID) {
+				return domain.ErrInternalError
+			}
+			if _, ok := st.assignments[assignmentKey{prID: prID, userID: userID}]; ok {
+				return domain.ErrInternalError
+			}
+		}
+		now := time.Now()
+		for _, userID := range userIDs {
+			st.assignments[assignmentKey{prID: prID, userID: userID}] = assignment{seq: st.nextID(), optional: optional, assignedAt: now}
+			st.budgetUsage[userID]++
+		}
+		st.refreshStatus(prID)
+		return nil
+	})
+}
+
+func (s *Store) AddShadowReviewer(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		key := assignmentKey{prID: prID, userID: userID}
+		if _, ok := st.users[userID]; !ok {
+			return domain.ErrInternalError
+		}
+		if _, ok := st.shadowReviews[key]; ok {
+			return domain.ErrInternalError
+		}
+		st.shadowReviews[key] = time.Now()
+		return nil
+	})
+}
+
+// reviewedPRs returns the PRs the user reviews, in the order of the
+// GetOpenPRsByReviewer query.
+func (st *state) reviewedPRs(userID string) []domain.PullRequest {
+	var prs []domain.PullRequest
+	for key := range st.assignments {
+		if key.userID == userID {
+			prs = append(prs, *prOut(st.prs[key.prID]))
+		}
+	}
+	slices.SortFunc(prs, func(a, b domain.PullRequest) int {
+		return cmp.Or(compareStatus(a.Status, b.Status), compareUrgency(a.Priority, b.Priority),
+			a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return prs
+}
+
+func (s *Store) GetOpenPRsByReviewer(ctx context.Context, tx domain.Tx, userID string) ([]domain.PullRequest, error) {
+	return view(s, ctx, tx, func(st *state) ([]domain.PullRequest, error) {
+		return st.reviewedPRs(userID), nil
+	})
+}
+
+func (s *Store) GetPRsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PullRequest, error) {
+		return st.reviewedPRs(userID), nil
+	})
+}
+
+// unreviewed returns the PRs ready for review without any reviewer, more
+// urgent first.
+func (st *state) unreviewed(match func(prID string) bool) []domain.PullRequest {
+	hasReviewers := make(map[string]bool)
+	for key := range st.assignments {
+		hasReviewers[key.prID] = true
+	}
+	var prs []domain.PullRequest
+	for id, pr := range st.prs {
+		if pr.Status == domain.StatusOpen && !hasReviewers[id] && match(id) {
+			prs = append(prs, *prOut(pr))
+		}
+	}
+	slices.SortFunc(prs, func(a, b domain.PullRequest) int {
+		return cmp.Or(compareUrgency(a.Priority, b.Priority), a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return prs
+}
+
+func (s *Store) GetOpenPRsWithoutReviewers(ctx context.Context) ([]domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PullRequest, error) {
+		return st.unreviewed(func(string) bool { return true }), nil
+	})
+}
+
+func (s *Store) RemoveOpenReviewsByUsers(ctx context.Context, tx domain.Tx, userIDs []string) ([]domain.RebalanceMove, error) {
+	return update(s, ctx, tx, func(st *state) ([]domain.RebalanceMove, error) {
+		var moves []domain.RebalanceMove
+		for key := range st.assignments {
+			if slices.Contains(userIDs, key.userID) && !finished(st.prs[key.prID].Status) {
+				moves = append(moves, domain.RebalanceMove{PRID: key.prID, FromUserID: key.userID})
+			}
+		}
+		slices.SortFunc(moves, func(a, b domain.RebalanceMove) int {
+			return cmp.Or(cmp.Compare(a.PRID, b.PRID), cmp.Compare(a.FromUserID, b.FromUserID))
+		})
+		for _, m := range moves {
+			delete(st.assignments, assignmentKey{prID: m.PRID, userID: m.FromUserID})
+			st.refreshStatus(m.PRID)
+		}
+		return moves, nil
+	})
+}
+
+func (s *Store) GetOpenPRsWithoutReviewersByIDs(ctx context.Context, tx domain.Tx, prIDs []string) ([]domain.PullRequest, error) {
+	return view(s, ctx, tx, func(st *state) ([]domain.PullRequest, error) {
+		return st.unreviewed(func(id string) bool { return slices.Contains(prIDs, id) }), nil
+	})
+}
+
+func (s *Store) GetOpenAssignmentsForUsers(ctx context.Context, userIDs []string) ([]domain.ReviewAssignment, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReviewAssignment, error) {
+		var as []domain.ReviewAssignment
+		for key := range st.assignments {
+			pr := st.prs[key.prID]
+			if slices.Contains(userIDs, key.userID) && !finished(pr.Status) {
+				as = append(as, domain.ReviewAssignment{PRID: key.prID, UserID: key.userID, AuthorID: pr.AuthorID})
+			}
+		}
+		slices.SortFunc(as, func(a, b domain.ReviewAssignment) int {
+			return cmp.Or(cmp.Compare(a.PRID, b.PRID), cmp.Compare(a.UserID, b.UserID))
+		})
+		return as, nil
+	})
+}
+
+func (s *Store) SearchPRs(context.Context, string, int, int) ([]domain.PRSearchHit, int, error) {
+	return nil, 0, errUnsupported
+}
+
+func (s *Store) FilterPRs(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequest, int, error) {
+	type page struct {
+		prs   []domain.PullRequest
+		total int
+	}
+	p, err := view(s, ctx, nil, func(st *state) (page, error) {
+		var prs []domain.PullRequest
+		for _, pr := range st.prs {
+			if st.matches(pr, filter) {
+				prs = append(prs, *prOut(pr))
+			}
+		}
+		slices.SortFunc(prs, func(a, b domain.PullRequest) int {
+			return cmp.Or(compareStatus(a.Status, b.Status), compareUrgency(a.Priority, b.Priority),
+				a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+		})
+		return page{prs: paginate(prs, filter.Limit, filter.Offset), total: len(prs)}, nil
+	})
+	return p.prs, p.total, err
+}
+
+func (st *state) matches(pr pullRequest, f domain.PRFilter) bool {
+	switch {
+	case f.Status != "" && pr.Status != f.Status,
+		f.AuthorID != "" && pr.AuthorID != f.AuthorID,
+		f.TeamName != "" && st.teamName(st.users[pr.AuthorID].TeamID) != f.TeamName,
+		f.Repository != "" && pr.Repository != f.Repository,
+		f.Priority != "" && pr.Priority != f.Priority,
+		f.Label != "" && !slices.Contains(pr.Labels, f.Label),
+		f.MinAgeHours > 0 && pr.CreatedAt.After(time.Now().Add(-time.Duration(f.MinAgeHours)*time.Hour)):
+		return false
+	}
+	verdict := func(a prAssignment) bool { return a.approvedAt != nil || a.changesRequestedAt != nil }
+	as := st.prAssignments(pr.ID)
+	if f.ReviewerID != "" {
+		i := slices.IndexFunc(as, func(a prAssignment) bool { return a.userID == f.ReviewerID })
+		return i >= 0 && (!f.Unreviewed || !verdict(as[i]))
+	}
+	return !f.Unreviewed || !slices.ContainsFunc(as, verdict)
+}
+
+func (s *Store) ApproveReview(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	return s.setVerdict(ctx, tx, prID, userID, func(a *assignment, now time.Time) {
+		a.approvedAt = cmp.Or(a.approvedAt, &now)
+		a.changesRequestedAt = nil
+	})
+}
+
+func (s *Store) RequestChanges(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	return s.setVerdict(ctx, tx, prID, userID, func(a *assignment, now time.Time) {
+		a.changesRequestedAt = cmp.Or(a.changesRequestedAt, &now)
+		a.approvedAt = nil
+	})
+}
+
+func (s *Store) setVerdict(ctx context.Context, tx domain.Tx, prID, userID string, verdict func(a *assignment, now time.Time)) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, err := st.pr(prID); err != nil {
+			return err
+		}
+		key := assignmentKey{prID: prID, userID: userID}
+		a, ok := st.assignments[key]
+		if !ok {
+			return fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
+		}
+		now := time.Now()
+		verdict(&a, now)
+		a.reviewedAt = cmp.Or(a.reviewedAt, &now)
+		a.ackedAt = cmp.Or(a.ackedAt, &now)
+		st.assignments[key] = a
+		st.refreshStatus(prID)
+		return nil
+	})
+}
+
+func (s *Store) GetApprovalState(ctx context.Context, tx domain.Tx, prID string) (int, int, error) {
+	type approvalState struct{ approved, total int }
+	as, err := view(s, ctx, tx, func(st *state) (approvalState, error) {
+		var as approvalState
+		for _, a := range st.prAssignments(prID) {
+			if a.optional {
+				continue
+			}
+			as.total++
+			if a.approvedAt != nil {
+				as.approved++
+			}
+		}
+		return as, nil
+	})
+	return as.approved, as.total, err
+}
+
+func (s *Store) GetApprovedReviewers(ctx context.Context, prID string) ([]string, error) {
+	return view(s, ctx, nil, func(st *state) ([]string, error) {
+		return reviewersByVerdict(st.prAssignments(prID), approvedAt), nil
+	})
+}
+
+func (s *Store) GetChangesRequestedReviewers(ctx context.Context, prID string) ([]string, error) {
+	return view(s, ctx, nil, func(st *state) ([]string, error) {
+		return reviewersByVerdict(st.prAssignments(prID), changesRequestedAt), nil
+	})
+}
+
+func (s *Store) GetRecentReviewers(ctx context.Context, authorID, excludePRID string, prCount int) ([]string, error) {
+	return view(s, ctx, nil, func(st *state) ([]string, error) {
+		var prs []domain.PullRequest
+		for id, pr := range st.prs {
+			if pr.AuthorID == authorID && id != excludePRID {
+				prs = append(prs, pr.PullRequest)
+			}
+		}
+		slices.SortFunc(prs, func(a, b domain.PullRequest) int {
+			return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.ID, a.ID))
+		})
+		var reviewers []string
+		for _, pr := range prs[:min(prCount, len(prs))] {
+			for _, a := range st.prAssignments(pr.ID) {
+				if !slices.Contains(reviewers, a.userID) {
+					reviewers = append(reviewers, a.userID)
+				}
+			}
+		}
+		return reviewers, nil
+	})
+}
+
+func (s *Store) CountOpenPRsByAuthor(ctx context.Context, tx domain.Tx, authorID string) (int, error) {
+	return view(s, ctx, tx, func(st *state) (int, error) {
+		n := 0
+		for _, pr := range st.prs {
+			if pr.AuthorID == authorID && !finished(pr.Status) {
+				n++
+			}
+		}
+		return n, nil
+	})
+}
+
+func (s *Store) GetPendingReviews(ctx context.Context, userID string) ([]domain.PendingReview, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PendingReview, error) {
+		var reviews []domain.PendingReview
+		for key, a := range st.assignments {
+			pr := st.prs[key.prID]
+			if key.userID == userID && a.approvedAt == nil && !finished(pr.Status) {
+				reviews = append(reviews, domain.PendingReview{PRID: pr.ID, PRName: pr.Name, Priority: pr.Priority, AssignedAt: a.assignedAt})
+			}
+		}
+		slices.SortFunc(reviews, func(a, b domain.PendingReview) int {
+			return cmp.Or(compareUrgency(a.Priority, b.Priority), a.AssignedAt.Compare(b.AssignedAt), cmp.Compare(a.PRID, b.PRID))
+		})
+		return reviews, nil
+	})
+}
+
+func (s *Store) ListStalledPRs(ctx context.Context, limit int) ([]domain.StalledPR, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.StalledPR, error) {
+		now := time.Now()
+		var prs []domain.StalledPR
+		for id, pr := range st.prs {
+			if stalled, ok := st.stalledPR(id, pr, now); ok {
+				prs = append(prs, stalled)
+			}
+		}
+		slices.SortFunc(prs, func(a, b domain.StalledPR) int { return a.WaitingSince.Compare(b.WaitingSince) })
+		return prs[:min(limit, len(prs))], nil
+	})
+}
+
+// stalledPR reports the PR as stalled when a step of its team's escalation
+// policy is due and not taken yet.
+func (st *state) stalledPR(id string, pr pullRequest, now time.Time) (domain.StalledPR, bool) {
+	team := st.teams[st.users[pr.AuthorID].TeamID]
+	policy := team.Escalation
+	if pr.Status != domain.StatusInReview || !policy.Enabled() || (pr.leadNotifiedAt != nil && pr.reviewerEscalatedAt != nil) {
+		return domain.StalledPR{}, false
+	}
+	waitingSince, ok := st.waitingSince(id)
+	if !ok {
+		return domain.StalledPR{}, false
+	}
+	due := func(hours int, taken *time.Time) bool {
+		return hours > 0 && taken == nil && !waitingSince.After(now.Add(-pr.Priority.EscalationDelay(hours)))
+	}
+	if !due(policy.NotifyLeadAfterHours, pr.leadNotifiedAt) && !due(policy.AddReviewerAfterHours, pr.reviewerEscalatedAt) {
+		return domain.StalledPR{}, false
+	}
+	return domain.StalledPR{
+		PRID:              id,
+		PRName:            pr.Name,
+		AuthorID:          pr.AuthorID,
+		Priority:          pr.Priority,
+		TeamID:            team.ID,
+		Policy:            policy,
+		WaitingSince:      waitingSince,
+		LeadNotified:      pr.leadNotifiedAt != nil,
+		ReviewerEscalated: pr.reviewerEscalatedAt != nil,
+	}, true
+}
+
+// waitingSince returns when the first required reviewer of the PR was
+// assigned. ok is false when it has none or one of them approved it.
+func (st *state) waitingSince(prID string) (since time.Time, ok bool) {
+	for _, a := range st.prAssignments(prID) {
+		if a.optional {
+			continue
+		}
+		if a.approvedAt != nil {
+			return time.Time{}, false
+		}
+		if since.IsZero() || a.assignedAt.Before(since) {
+			since = a.assignedAt
+		}
+	}
+	return since, !since.IsZero()
+}
+
+func (s *Store) MarkLeadNotified(ctx context.Context, tx domain.Tx, prID string) (bool, error) {
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		pr, ok := st.prs[prID]
+		if !ok || pr.leadNotifiedAt != nil {
+			return false, nil
+		}
+		pr.leadNotifiedAt = ptr(time.Now())
+		st.prs[prID] = pr
+		return true, nil
+	})
+}
+
+func (s *Store) MarkReviewerEscalated(ctx context.Context, tx domain.Tx, prID string) (bool, error) {
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		pr, ok := st.prs[prID]
+		if !ok || pr.reviewerEscalatedAt != nil {
+			return false, nil
+		}
+		pr.reviewerEscalatedAt = ptr(time.Now())
+		st.prs[prID] = pr
+		return true, nil
+	})
+}
+
+func (s *Store) SetAutoMerge(ctx context.Context, tx domain.Tx, prID string, autoMerge bool) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return err
+		}
+		pr.AutoMerge = autoMerge
+		st.prs[prID] = pr
+		return nil
+	})
+}
+
+func (s *Store) SetPriority(ctx context.Context, tx domain.Tx, prID string, priority domain.PRPriority) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		pr, err := st.pr(prID)
+		if err != nil {
+			return err
+		}
+		pr.Priority = priority
+		st.prs[prID] = pr
+		return nil
+	})
+}
+
+func (s *Store) AckReview(ctx context.Context, prID, userID string) error {
+	return exec(s, ctx, nil, func(st *state) error {
+		key := assignmentKey{prID: prID, userID: userID}
+		a, ok := st.assignments[key]
+		if !ok {
+			return fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
+		}
+		a.ackedAt = cmp.Or(a.ackedAt, ptr(time.Now()))
+		st.assignments[key] = a
+		return nil
+	})
+}
+
+func (s *Store) RecordReassignment(ctx context.Context, tx domain.Tx, prID, fromUserID, toUserID string, reason domain.ReassignmentReason, decline domain.DeclineReason) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		st.recordReassignment(prID, fromUserID, toUserID, reason, decline, domain.ActorFromContext(ctx))
+		return nil
+	})
+}
+
+func (s *Store) RecordReassignments(ctx context.Context, tx domain.Tx, moves []domain.RebalanceMove, reason domain.ReassignmentReason) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		for _, m := range moves {
+			st.recordReassignment(m.PRID, m.FromUserID, m.ToUserID, reason, "", domain.ActorFromContext(ctx))
+		}
+		return nil
+	})
+}
+
+// recordReassignment logs the reassignment under the removed reviewer's team
+// and records the actor on the PR.
+func (st *state) recordReassignment(prID, fromUserID, toUserID string, reason domain.ReassignmentReason, decline domain.DeclineReason, actor string) {
+	from, ok := st.users[fromUserID]
+	if !ok {
+		return
+	}
+	st.reassignments[st.nextID()] = reassignment{
+		prID:       prID,
+		teamID:     from.TeamID,
+		fromUserID: fromUserID,
+		toUserID:   toUserID,
+		reason:     reason,
+		decline:    decline,
+		at:         time.Now(),
+		by:         actor,
+	}
+	if pr, ok := st.prs[prID]; ok {
+		pr.ReassignedBy = actor
+		st.prs[prID] = pr
+	}
+}
+
+func (s *Store) CreateChecklist(ctx context.Context, tx domain.Tx, prID string, labels []string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		for i, label := range labels {
+			st.checklists[checklistKey{prID: prID, position: i}] = domain.ChecklistItem{Position: i, Label: label}
+		}
+		return nil
+	})
+}
+
+func (st *state) checklist(prID string) []domain.ChecklistItem {
+	items := []domain.ChecklistItem{}
+	for key, item := range st.checklists {
+		if key.prID == prID {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b domain.ChecklistItem) int { return cmp.Compare(a.Position, b.Position) })
+	return items
+}
+
+func (s *Store) GetChecklist(ctx context.Context, prID string) ([]domain.ChecklistItem, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ChecklistItem, error) {
+		return st.checklist(prID), nil
+	})
+}
+
+func (s *Store) SetChecklistItem(ctx context.Context, tx domain.Tx, prID string, position int, userID string, checked bool) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		key := checklistKey{prID: prID, position: position}
+		item, ok := st.checklists[key]
+		if !ok {
+			return fmt.Errorf("%w: PR '%s' has no checklist item %d", domain.ErrValidation, prID, position)
+		}
+		// Ticking an item again keeps who ticked it first
+		item.Checked = checked
+		if !checked {
+			item.CheckedBy, item.CheckedAt = "", nil
+		} else if item.CheckedAt == nil {
+			item.CheckedBy, item.CheckedAt = userID, ptr(time.Now())
+		}
+		st.checklists[key] = item
+		return nil
+	})
+}
+
+func (s *Store) ListUnackedReviews(ctx context.Context, remindBefore, reassignBefore time.Time, limit int) ([]domain.UnackedReview, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.UnackedReview, error) {
+		var reviews []domain.UnackedReview
+		for key, a := range st.assignments {
+			pr := st.prs[key.prID]
+			if pr.Status != domain.StatusInReview || a.optional || a.ackedAt != nil || a.approvedAt != nil {
+				continue
+			}
+			remind := !remindBefore.IsZero() && a.ackRemindedAt == nil && !a.assignedAt.After(remindBefore)
+			reassign := !reassignBefore.IsZero() && !a.assignedAt.After(reassignBefore)
+			if remind || reassign {
+				reviews = append(reviews, domain.UnackedReview{
+					PRID:       key.prID,
+					PRName:     pr.Name,
+					UserID:     key.userID,
+					AssignedAt: a.assignedAt,
+					Reminded:   a.ackRemindedAt != nil,
+				})
+			}
+		}
+		slices.SortFunc(reviews, func(a, b domain.UnackedReview) int {
+			return cmp.Or(a.AssignedAt.Compare(b.AssignedAt), cmp.Compare(a.PRID, b.PRID), cmp.Compare(a.UserID, b.UserID))
+		})
+		return reviews[:min(limit, len(reviews))], nil
+	})
+}
+
+func (s *Store) MarkAckReminded(ctx context.Context, tx domain.Tx, prID, userID string) (bool, error) {
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		key := assignmentKey{prID: prID, userID: userID}
+		a, ok := st.assignments[key]
+		if !ok || a.ackRemindedAt != nil {
+			return false, nil
+		}
+		a.ackRemindedAt = ptr(time.Now())
+		st.assignments[key] = a
+		return true, nil
+	})
+}
+
+func (s *Store) ListInactiveReviews(ctx context.Context, inactiveSince time.Time, limit int) ([]domain.InactiveReview, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.InactiveReview, error) {
+		var reviews []domain.InactiveReview
+		for key, a := range st.assignments {
+			pr := st.prs[key.prID]
+			if pr.Status != domain.StatusInReview || a.optional || a.reviewedAt != nil {
+				continue
+			}
+			if _, optedOut := st.inactiveOptOuts[st.users[key.userID].TeamID]; optedOut {
+				continue
+			}
+			lastActivity := a.assignedAt
+			if a.ackedAt != nil && a.ackedAt.After(lastActivity) {
+				lastActivity = *a.ackedAt
+			}
+			if lastActivity.After(inactiveSince) {
+				continue
+			}
+			reviews = append(reviews, domain.InactiveReview{
+				PRID:           key.prID,
+				PRName:         pr.Name,
+				UserID:         key.userID,
+				LastActivityAt: lastActivity,
+			})
+		}
+		slices.SortFunc(reviews, func(a, b domain.InactiveReview) int {
+			return cmp.Or(a.LastActivityAt.Compare(b.LastActivityAt), cmp.Compare(a.PRID, b.PRID), cmp.Compare(a.UserID, b.UserID))
+		})
+		return reviews[:min(limit, len(reviews))], nil
+	})
+}
@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// --- SlackRepository Implementation ---
+
+func (s *Store) SetSlackUserID(ctx context.Context, tx domain.Tx, userID, slackUserID string) (*domain.SlackAccount, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.SlackAccount, error) {
+		if _, err := st.user(userID); err != nil {
+			return nil, err
+		}
+		for other, id := range st.slackUsers {
+			if other != userID && id == slackUserID {
+				return nil, fmt.Errorf("%w: Slack user '%s' is already linked to another user", domain.ErrValidation, slackUserID)
+			}
+		}
+		st.slackUsers[userID] = slackUserID
+		return &domain.SlackAccount{UserID: userID, SlackUserID: slackUserID}, nil
+	})
+}
+
+func (s *Store) GetSlackAccountBySlackID(ctx context.Context, slackUserID string) (*domain.SlackAccount, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.SlackAccount, error) {
+		for userID, id := range st.slackUsers {
+			if id == slackUserID {
+				return &domain.SlackAccount{UserID: userID, SlackUserID: id}, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: Slack user '%s'", domain.ErrNotFound, slackUserID)
+	})
+}
@@ -0,0 +1,643 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// --- StatsRepository Implementation ---
+
+// reviewCounts holds the counters of the user_review_stats table, which only
+// cover live assignments.
+type reviewCounts struct {
+	total, open, merged int
+}
+
+func (st *state) reviewCounts() map[string]reviewCounts {
+	counts := make(map[string]reviewCounts)
+	for key := range st.assignments {
+		c := counts[key.userID]
+		c.total++
+		switch status := st.prs[key.prID].Status; {
+		case status == domain.StatusMerged:
+			c.merged++
+		case !finished(status):
+			c.open++
+		}
+		counts[key.userID] = c
+	}
+	return counts
+}
+
+func (s *Store) GetReviewStats(ctx context.Context, order domain.StatsOrder, limit, offset int) ([]domain.StatItem, int, error) {
+	type page struct {
+		items []domain.StatItem
+		total int
+	}
+	p, err := view(s, ctx, nil, func(st *state) (page, error) {
+		type ack struct {
+			count int64
+			sum   time.Duration
+		}
+		acks := make(map[string]ack)
+		collect := func(as map[assignmentKey]assignment) {
+			for key, a := range as {
+				if a.ackedAt != nil {
+					acks[key.userID] = ack{count: acks[key.userID].count + 1, sum: acks[key.userID].sum + a.ackedAt.Sub(a.assignedAt)}
+				}
+			}
+		}
+		collect(st.assignments)
+		collect(st.archivedAssignment)
+
+		var items []domain.StatItem
+		for userID, c := range st.reviewCounts() {
+			item := domain.StatItem{UserID: userID, Username: st.users[userID].Username, ReviewCount: int64(c.total)}
+			if a := acks[userID]; a.count > 0 {
+				item.AckedCount = a.count
+				item.AvgAckLatency = a.sum / time.Duration(a.count)
+			}
+			items = append(items, item)
+		}
+		slices.SortFunc(items, func(a, b domain.StatItem) int {
+			byCount := 0
+			if order != domain.StatsOrderUsername {
+				byCount = cmp.Compare(b.ReviewCount, a.ReviewCount)
+			}
+			return cmp.Or(byCount, cmp.Compare(a.Username, b.Username), cmp.Compare(a.UserID, b.UserID))
+		})
+		return page{items: paginate(items, limit, offset), total: len(items)}, nil
+	})
+	return p.items, p.total, err
+}
+
+func (s *Store) teamReviewCount(ctx context.Context, teamName string, count func(reviewCounts) int) (int, error) {
+	return view(s, ctx, nil, func(st *state) (int, error) {
+		team, err := st.teamByName(teamName)
+		if err != nil {
+			return 0, err
+		}
+		total := 0
+		for userID, c := range st.reviewCounts() {
+			if st.users[userID].TeamID == team.ID {
+				total += count(c)
+			}
+		}
+		return total, nil
+	})
+}
+
+func (s *Store) userReviewCount(ctx context.Context, userID string, count func(reviewCounts) int) (int, error) {
+	return view(s, ctx, nil, func(st *state) (int, error) {
+		return count(st.reviewCounts()[userID]), nil
+	})
+}
+
+func openReviews(c reviewCounts) int   { return c.open }
+func mergedReviews(c reviewCounts) int { return c.merged }
+
+func (s *Store) GetOpenReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+	return s.teamReviewCount(ctx, teamName, openReviews)
+}
+
+func (s *Store) GetMergedReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+	return s.teamReviewCount(ctx, teamName, mergedReviews)
+}
+
+func (s *Store) GetOpenReviewCountForUser(ctx context.Context, userID string) (int, error) {
+	return s.userReviewCount(ctx, userID, openReviews)
+}
+
+func (s *Store) GetMergedReviewCountForUser(ctx context.Context, userID string) (int, error) {
+	return s.userReviewCount(ctx, userID, mergedReviews)
+}
+
+func within(t, since, until time.Time) bool {
+	return !t.Before(since) && t.Before(until)
+}
+
+func (s *Store) GetMemberReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.MemberReviewCount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.MemberReviewCount, error) {
+		if teamName != "" {
+			if _, err := st.teamByName(teamName); err != nil {
+				return nil, err
+			}
+		}
+		assigned := make(map[string]int)
+		count := func(as map[assignmentKey]assignment) {
+			for key, a := range as {
+				if within(a.assignedAt, since, until) {
+					assigned[key.userID]++
+				}
+			}
+		}
+		count(st.assignments)
+		count(st.archivedAssignment)
+
+		var counts []domain.MemberReviewCount
+		for _, user := range st.users {
+			team := st.teams[user.TeamID]
+			if user.IsActive && team.IsActive && (teamName == "" || team.TeamName == teamName) {
+				counts = append(counts, domain.MemberReviewCount{TeamName: team.TeamName, UserID: user.ID, ReviewCount: assigned[user.ID]})
+			}
+		}
+		slices.SortFunc(counts, func(a, b domain.MemberReviewCount) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.UserID, b.UserID))
+		})
+		return counts, nil
+	})
+}
+
+func (s *Store) GetReassignmentCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReassignmentCount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReassignmentCount, error) {
+		if teamName != "" {
+			if _, err := st.teamByName(teamName); err != nil {
+				return nil, err
+			}
+		}
+		grouped := make(map[domain.ReassignmentCount]int)
+		for _, r := range st.reassignments {
+			team, ok := st.teams[r.teamID]
+			if !ok || !within(r.at, since, until) || (teamName != "" && team.TeamName != teamName) {
+				continue
+			}
+			grouped[domain.ReassignmentCount{TeamName: team.TeamName, UserID: r.fromUserID, Reason: r.reason, DeclineReason: r.decline}]++
+		}
+		counts := make([]domain.ReassignmentCount, 0, len(grouped))
+		for c, n := range grouped {
+			c.Count = n
+			counts = append(counts, c)
+		}
+		slices.SortFunc(counts, func(a, b domain.ReassignmentCount) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.UserID, b.UserID),
+				cmp.Compare(a.Reason, b.Reason), cmp.Compare(a.DeclineReason, b.DeclineReason))
+		})
+		return counts, nil
+	})
+}
+
+// allMergedPRs returns the live and the archived merged PRs.
+func (st *state) allMergedPRs() []pullRequest {
+	var prs []pullRequest
+	for _, table := range []map[string]pullRequest{st.prs, st.archivedPRs} {
+		for _, pr := range table {
+			if pr.Status == domain.StatusMerged && pr.MergedAt != nil {
+				prs = append(prs, pr)
+			}
+		}
+	}
+	return prs
+}
+
+func (s *Store) GetMergeCounts(ctx context.Context, since, until time.Time) ([]domain.MergeCount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.MergeCount, error) {
+		grouped := make(map[string]int)
+		for _, pr := range st.allMergedPRs() {
+			if within(*pr.MergedAt, since, until) {
+				grouped[pr.MergedBy]++
+			}
+		}
+		counts := make([]domain.MergeCount, 0, len(grouped))
+		for mergedBy, n := range grouped {
+			counts = append(counts, domain.MergeCount{MergedBy: mergedBy, Username: st.users[mergedBy].Username, Count: n})
+		}
+		slices.SortFunc(counts, func(a, b domain.MergeCount) int {
+			return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.MergedBy, b.MergedBy))
+		})
+		return counts, nil
+	})
+}
+
+func (s *Store) GetShadowReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.ShadowReviewCount, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ShadowReviewCount, error) {
+		if teamName != "" {
+			if _, err := st.teamByName(teamName); err != nil {
+				return nil, err
+			}
+		}
+		shadowed := make(map[string]int)
+		for key, at := range st.shadowReviews {
+			if within(at, since, until) {
+				shadowed[key.userID]++
+			}
+		}
+
+		var counts []domain.ShadowReviewCount
+		for _, user := range st.users {
+			team := st.teams[user.TeamID]
+			if !team.IsActive || (teamName != "" && team.TeamName != teamName) {
+				continue
+			}
+			if n := shadowed[user.ID]; user.InTraining || n > 0 {
+				counts = append(counts, domain.ShadowReviewCount{TeamName: team.TeamName, UserID: user.ID, Username: user.Username, InTraining: user.InTraining, Count: n})
+			}
+		}
+		slices.SortFunc(counts, func(a, b domain.ShadowReviewCount) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(b.Count, a.Count), cmp.Compare(a.UserID, b.UserID))
+		})
+		return counts, nil
+	})
+}
+
+func (s *Store) GetUnassignedCounts(context.Context, string, time.Time, time.Time) ([]domain.UnassignedCount, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) GetThroughputCounts(context.Context, string, []domain.ThroughputMetric, domain.SeriesInterval, time.Time, time.Time) ([]domain.ThroughputCount, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) GetRepositoryStats(context.Context, string) (*domain.RepositoryStats, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) GetReviewerTurnaround(context.Context, string, time.Time, time.Time) (*domain.ReviewerTurnaround, error) {
+	return nil, errUnsupported
+}
+
+func (s *Store) GetAuthorStats(ctx context.Context, userID string) (*domain.AuthorStats, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.AuthorStats, error) {
+		if _, err := st.user(userID); err != nil {
+			return nil, err
+		}
+		reviewers := make(map[string]int)
+		for _, table := range []map[assignmentKey]assignment{st.assignments, st.archivedAssignment} {
+			for key := range table {
+				reviewers[key.prID]++
+			}
+		}
+
+		stats := &domain.AuthorStats{UserID: userID}
+		var totalReviewers int
+		var mergeTimes []time.Duration
+		for _, table := range []map[string]pullRequest{st.prs, st.archivedPRs} {
+			for _, pr := range table {
+				if pr.AuthorID != userID {
+					continue
+				}
+				stats.OpenedPRs++
+				totalReviewers += reviewers[pr.ID]
+				switch {
+				case pr.Status == domain.StatusMerged && pr.MergedAt != nil:
+					stats.MergedPRs++
+					mergeTimes = append(mergeTimes, pr.MergedAt.Sub(pr.CreatedAt))
+				case pr.Status != domain.StatusMerged && pr.Status != domain.StatusClosed:
+					stats.OpenPRs++
+				}
+			}
+		}
+		if stats.OpenedPRs > 0 {
+			stats.AvgReviewers = float64(totalReviewers) / float64(stats.OpenedPRs)
+		}
+		if n := len(mergeTimes); n > 0 {
+			slices.Sort(mergeTimes)
+			var total time.Duration
+			for _, d := range mergeTimes {
+				total += d
+			}
+			avg := total / time.Duration(n)
+			median := (mergeTimes[(n-1)/2] + mergeTimes[n/2]) / 2
+			stats.AvgTimeToMerge = &avg
+			stats.MedianTimeToMerge = &median
+		}
+		return stats, nil
+	})
+}
+
+func (s *Store) GetOrgSummary(ctx context.Context, since, until time.Time) (*domain.OrgSummary, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.OrgSummary, error) {
+		summary := &domain.OrgSummary{Since: since, Until: until}
+		assigned := make(map[string]bool)
+		for key := range st.assignments {
+			assigned[key.prID] = true
+		}
+		for _, pr := range st.prs {
+			if pr.Status != domain.StatusMerged && pr.Status != domain.StatusClosed {
+				summary.OpenPRs++
+			}
+			if pr.Status == domain.StatusOpen && !assigned[pr.ID] {
+				summary.UnassignedPRs++
+			}
+		}
+		for _, user := range st.users {
+			if user.IsActive && st.teams[user.TeamID].IsActive {
+				summary.ActiveUsers++
+			}
+		}
+
+		var total time.Duration
+		for _, pr := range st.allMergedPRs() {
+			if within(*pr.MergedAt, since, until) {
+				summary.MergedPRs++
+				total += pr.MergedAt.Sub(pr.CreatedAt)
+			}
+		}
+		if summary.MergedPRs > 0 {
+			avg := total / time.Duration(summary.MergedPRs)
+			summary.AvgTurnaround = &avg
+		}
+		return summary, nil
+	})
+}
+
+func (st *state) authoredBy(pr pullRequest, teamName string) bool {
+	author, ok := st.users[pr.AuthorID]
+	return ok && st.teamName(author.TeamID) == teamName
+}
+
+func sortReportPRs(prs []domain.ReportPR) []domain.ReportPR {
+	slices.SortFunc(prs, func(a, b domain.ReportPR) int {
+		return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.ID, b.ID))
+	})
+	return prs
+}
+
+func (s *Store) GetTeamMergedPRs(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReportPR, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReportPR, error) {
+		prs := []domain.ReportPR{}
+		for _, pr := range st.allMergedPRs() {
+			if st.authoredBy(pr, teamName) && within(*pr.MergedAt, since, until) {
+				prs = append(prs, domain.ReportPR{ID: pr.ID, Name: pr.Name, AuthorID: pr.AuthorID, CreatedAt: pr.CreatedAt, At: *pr.MergedAt})
+			}
+		}
+		return sortReportPRs(prs), nil
+	})
+}
+
+func (s *Store) GetTeamSLABreaches(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReportPR, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReportPR, error) {
+		prs := []domain.ReportPR{}
+		for _, pr := range st.prs {
+			escalated := pr.leadNotifiedAt
+			if escalated == nil || (pr.reviewerEscalatedAt != nil && pr.reviewerEscalatedAt.Before(*escalated)) {
+				escalated = pr.reviewerEscalatedAt
+			}
+			if escalated != nil && st.authoredBy(pr, teamName) && within(*escalated, since, until) {
+				prs = append(prs, domain.ReportPR{ID: pr.ID, Name: pr.Name, AuthorID: pr.AuthorID, CreatedAt: pr.CreatedAt, At: *escalated})
+			}
+		}
+		return sortReportPRs(prs), nil
+	})
+}
+
+type userStatsKey struct {
+	day    time.Time
+	userID string
+}
+
+// userStatsRecord is a row of the user history, which keeps the team ID so
+// that the team name is resolved when read, as the SQL join does.
+type userStatsRecord struct {
+	teamID int32
+	stats  domain.UserStatsSnapshot
+}
+
+type teamStatsKey struct {
+	day    time.Time
+	teamID int32
+}
+
+// utcDay truncates t to the start of its UTC day, the granularity of the
+// history tables.
+func utcDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *Store) SnapshotStats(ctx context.Context, tx domain.Tx, day time.Time) (bool, error) {
+	day = utcDay(day)
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		counts := st.reviewCounts()
+		recorded := false
+		teams := make(map[int32]domain.TeamStatsSnapshot)
+		for userID, user := range st.users {
+			c := counts[userID]
+			if t, ok := st.teams[user.TeamID]; ok {
+				ts := teams[t.ID]
+				ts.OpenReviews += c.open
+				ts.MergedReviews += c.merged
+				if user.IsActive {
+					ts.ActiveMembers++
+				}
+				teams[t.ID] = ts
+			}
+			key := userStatsKey{day: day, userID: userID}
+			if _, ok := st.userStatsHistory[key]; ok {
+				continue
+			}
+			st.userStatsHistory[key] = userStatsRecord{
+				teamID: user.TeamID,
+				stats:  domain.UserStatsSnapshot{Date: day, TotalReviews: c.total, OpenReviews: c.open, MergedReviews: c.merged},
+			}
+			recorded = true
+		}
+		for teamID := range st.teams {
+			key := teamStatsKey{day: day, teamID: teamID}
+			if _, ok := st.teamStatsHistory[key]; ok {
+				continue
+			}
+			ts := teams[teamID]
+			ts.Date = day
+			st.teamStatsHistory[key] = ts
+			recorded = true
+		}
+		return recorded, nil
+	})
+}
+
+func (s *Store) GetUserStatsHistory(ctx context.Context, userID string, since, until time.Time) ([]domain.UserStatsSnapshot, error) {
+	since, until = utcDay(since), utcDay(until)
+	return view(s, ctx, nil, func(st *state) ([]domain.UserStatsSnapshot, error) {
+		history := []domain.UserStatsSnapshot{}
+		for key, rec := range st.userStatsHistory {
+			if key.userID == userID && !key.day.Before(since) && !key.day.After(until) {
+				snapshot := rec.stats
+				snapshot.TeamName = st.teamName(rec.teamID)
+				history = append(history, snapshot)
+			}
+		}
+		if len(history) == 0 {
+			if _, err := st.user(userID); err != nil {
+				return nil, err
+			}
+		}
+		slices.SortFunc(history, func(a, b domain.UserStatsSnapshot) int { return a.Date.Compare(b.Date) })
+		return history, nil
+	})
+}
+
+func (s *Store) GetTeamStatsHistory(ctx context.Context, teamName string, since, until time.Time) ([]domain.TeamStatsSnapshot, error) {
+	since, until = utcDay(since), utcDay(until)
+	return view(s, ctx, nil, func(st *state) ([]domain.TeamStatsSnapshot, error) {
+		team, err := st.teamByName(teamName)
+		if err != nil {
+			return nil, err
+		}
+		history := []domain.TeamStatsSnapshot{}
+		for key, snapshot := range st.teamStatsHistory {
+			if key.teamID == team.ID && !key.day.Before(since) && !key.day.After(until) {
+				history = append(history, snapshot)
+			}
+		}
+		slices.SortFunc(history, func(a, b domain.TeamStatsSnapshot) int { return a.Date.Compare(b.Date) })
+		return history, nil
+	})
+}
+
+// --- DumpRepository Implementation ---
+
+func (s *Store) ListUsers(ctx context.Context) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		users := make([]domain.User, 0, len(st.users))
+		for _, user := range st.users {
+			users = append(users, *st.userOut(user))
+		}
+		sortUsers(users)
+		return users, nil
+	})
+}
+
+func (s *Store) ListPRsWithReviewers(ctx context.Context) ([]domain.PullRequest, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.PullRequest, error) {
+		prs := make([]domain.PullRequest, 0, len(st.prs))
+		for _, pr := range st.prs {
+			out := prOut(pr)
+			as := st.prAssignments(pr.ID)
+			slices.SortFunc(as, func(a, b prAssignment) int { return cmp.Compare(a.userID, b.userID) })
+			for _, a := range as {
+				out.Reviewers = append(out.Reviewers, domain.Reviewer{ID: a.userID, Optional: a.optional})
+				if a.approvedAt != nil {
+					out.ApprovedBy = append(out.ApprovedBy, a.userID)
+				}
+			}
+			prs = append(prs, *out)
+		}
+		slices.SortFunc(prs, func(a, b domain.PullRequest) int {
+			return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+		})
+		return prs, nil
+	})
+}
+
+func (s *Store) ImportTeam(ctx context.Context, tx domain.Tx, team *domain.Team) (*domain.Team, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Team, error) {
+		if _, err := st.teamByName(team.TeamName); err == nil {
+			return nil, fmt.Errorf("%w: team '%s'", domain.ErrTeamExists, team.TeamName)
+		}
+		st.teamSeq++
+		imported := domain.Team{
+			ID:                      st.teamSeq,
+			TeamName:                team.TeamName,
+			IsActive:                team.IsActive,
+			AllowDuplicateUsernames: team.AllowDuplicateUsernames,
+			AllowSelfReview:         team.AllowSelfReview,
+		}
+		st.teams[imported.ID] = imported
+		return teamOut(imported), nil
+	})
+}
+
+func (s *Store) ImportUser(ctx context.Context, tx domain.Tx, user *domain.User) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if err := st.checkTeams(&user.TeamID); err != nil {
+			return err
+		}
+		if st.usernameTaken(user.TeamID, user.Username, user.ID) {
+			return fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(user.Username))
+		}
+		if _, ok := st.users[user.ID]; ok {
+			return fmt.Errorf("%w: user '%s' already exists", domain.ErrValidation, user.ID)
+		}
+		st.users[user.ID] = domain.User{
+			ID:       user.ID,
+			Username: user.Username,
+			TeamID:   user.TeamID,
+			IsActive: user.IsActive,
+			Role:     user.Role,
+			Skills:   nonNil(user.Skills),
+		}
+		return nil
+	})
+}
+
+func (s *Store) ImportPR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return domain.ErrInternalError
+		}
+		if _, ok := st.prs[pr.ID]; ok {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrPRExists, pr.ID)
+		}
+		st.prs[pr.ID] = pullRequest{PullRequest: domain.PullRequest{
+			ID:             pr.ID,
+			Name:           pr.Name,
+			Description:    pr.Description,
+			AuthorID:       pr.AuthorID,
+			Status:         pr.Status,
+			RequiredSkills: nonNil(pr.RequiredSkills),
+			Labels:         []string{},
+			AutoMerge:      pr.AutoMerge,
+			Priority:       cmp.Or(pr.Priority, domain.PriorityNormal),
+			CreatedAt:      pr.CreatedAt,
+			MergedAt:       pr.MergedAt,
+			ClosedAt:       pr.ClosedAt,
+		}}
+		return nil
+	})
+}
+
+// --- ArchiveRepository Implementation ---
+
+func (s *Store) ListMergedPRIDsBefore(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	return view(s, ctx, nil, func(st *state) ([]string, error) {
+		var prs []pullRequest
+		for _, pr := range st.prs {
+			if pr.Status == domain.StatusMerged && pr.MergedAt != nil && pr.MergedAt.Before(before) {
+				prs = append(prs, pr)
+			}
+		}
+		slices.SortFunc(prs, func(a, b pullRequest) int {
+			return cmp.Or(a.MergedAt.Compare(*b.MergedAt), cmp.Compare(a.ID, b.ID))
+		})
+		ids := []string{}
+		for _, pr := range prs[:min(limit, len(prs))] {
+			ids = append(ids, pr.ID)
+		}
+		return ids, nil
+	})
+}
+
+// ArchivePRs moves the PRs and their assignments into the archive; like the
+// foreign keys of the database it drops their checklists and GitHub links.
+func (s *Store) ArchivePRs(ctx context.Context, tx domain.Tx, prIDs []string) (int, error) {
+	return update(s, ctx, tx, func(st *state) (int, error) {
+		archived := 0
+		for _, prID := range prIDs {
+			pr, ok := st.prs[prID]
+			if !ok {
+				continue
+			}
+			st.archivedPRs[prID] = pr
+			delete(st.prs, prID)
+			delete(st.githubPRLinks, prID)
+			archived++
+		}
+		for key, a := range st.assignments {
+			if _, ok := st.archivedPRs[key.prID]; ok && slices.Contains(prIDs, key.prID) {
+				st.archivedAssignment[key] = a
+				delete(st.assignments, key)
+			}
+		}
+		for key := range st.checklists {
+			if slices.Contains(prIDs, key.prID) {
+				delete(st.checklists, key)
+			}
+		}
+		return archived, nil
+	})
+}
@@ -0,0 +1,284 @@
+// Package memory is a storage backend that keeps everything in process memory.
+// It implements the repositories of the domain package the way the Postgres
+// backend does, minus a few reports and bulk operations, so that services and
+// the HTTP layer can be tested without a database. Data is lost on exit.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+// errUnsupported is returned by the methods the memory store does not implement.
+var errUnsupported = fmt.Errorf("%w: not supported by the memory store", domain.ErrInternalError)
+
+// txMaxAttempts bounds how many times a conflicting transaction is run.
+const txMaxAttempts = 5
+
+// Store holds the data of every repository. The zero value is not usable, use
+// NewStore.
+type Store struct {
+	mu sync.Mutex
+	st *state
+	// version grows with every committed change, so that a transaction can
+	// tell whether the state it started from is still current.
+	version uint64
+}
+
+func NewStore() *Store {
+	return &Store{st: newState()}
+}
+
+// Ping always succeeds; it stands in for the database health check.
+func (s *Store) Ping(context.Context) error {
+	return nil
+}
+
+// --- UnitOfWork Implementation ---
+
+// memTx is a private copy of the state a transaction works on.
+type memTx struct {
+	domain.TxMarker
+	st *state
+	// done is set once the transaction is over, so that work it started in
+	// the background goes back to the committed state.
+	done atomic.Bool
+}
+
+type txKey struct{}
+
+// WithinTx runs fn on a copy of the state and swaps it in when fn succeeds.
+// Like the Postgres backend it retries fn when another change was committed
+// meanwhile. A WithinTx nested in fn joins the transaction; repository calls
+// with a nil tx do not, see run.
+func (s *Store) WithinTx(ctx context.Context, fn func(ctx context.Context, tx domain.Tx) error) error {
+	if t := activeTx(ctx); t != nil {
+		return fn(ctx, t)
+	}
+	for attempt := 1; ; attempt++ {
+		if ctx.Err() != nil {
+			return domain.ErrInternalError
+		}
+		s.mu.Lock()
+		tx := &memTx{st: s.st.clone()}
+		base := s.version
+		s.mu.Unlock()
+
+		err := fn(context.WithValue(ctx, txKey{}, tx), tx)
+		tx.done.Store(true)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if s.version == base {
+			s.st = tx.st
+			s.version++
+			s.mu.Unlock()
+			return nil
+		}
+		s.mu.Unlock()
+		if attempt == txMaxAttempts {
+			return fmt.Errorf("%w: gave up after %d attempts", domain.ErrTxConflict, attempt)
+		}
+	}
+}
+
+func activeTx(ctx context.Context) *memTx {
+	if t, ok := ctx.Value(txKey{}).(*memTx); ok && !t.done.Load() {
+		return t
+	}
+	return nil
+}
+
+// view runs fn on the state of tx, or on the committed state under the lock
+// when tx is nil.
+func view[T any](s *Store, ctx context.Context, tx domain.Tx, fn func(st *state) (T, error)) (T, error) {
+	return run(s, ctx, tx, false, fn)
+}
+
+// update is view for fn that changes the state. fn must check everything
+// before it changes anything, as there is no rollback outside a transaction.
+func update[T any](s *Store, ctx context.Context, tx domain.Tx, fn func(st *state) (T, error)) (T, error) {
+	return run(s, ctx, tx, true, fn)
+}
+
+// exec is update for fn without a result.
+func exec(s *Store, ctx context.Context, tx domain.Tx, fn func(st *state) error) error {
+	_, err := update(s, ctx, tx, func(st *state) (struct{}, error) {
+		return struct{}{}, fn(st)
+	})
+	return err
+}
+
+func run[T any](s *Store, ctx context.Context, tx domain.Tx, write bool, fn func(st *state) (T, error)) (T, error) {
+	var zero T
+	if ctx.Err() != nil {
+		return zero, domain.ErrInternalError
+	}
+	if t, ok := tx.(*memTx); ok {
+		return fn(t.st)
+	}
+	if tx != nil {
+		return zero, fmt.Errorf("%w: transaction of another storage backend", domain.ErrInternalError)
+	}
+	// As with the pool of the Postgres backend, a nil tx reads the committed
+	// state even inside a transaction. A write would be lost on rollback and
+	// make the transaction conflict with itself, so it is refused.
+	if write && activeTx(ctx) != nil {
+		return zero, fmt.Errorf("%w: write without the transaction inside a transaction", domain.ErrInternalError)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if write {
+		s.version++
+	}
+	return fn(s.st)
+}
+
+// state is every table of the store. Values in the maps are never changed in
+// place, only replaced, so that clone can share them between copies.
+type state struct {
+	teamSeq int32
+	seq     int64
+
+	teams              map[int32]domain.Team
+	reviewerPrefs      map[int32][]domain.ReviewerPreference
+	noCandidateEvents  map[int64]noCandidateEvent
+	noCandidateAlerts  map[int32]time.Time
+	budgets            map[int32]domain.ReviewBudget
+	budgetUsage        map[string]int
+	reportSchedules    map[int32]domain.TeamReportSchedule
+	prQuotas           map[int32]domain.PRQuota
+	reviewRotations    map[int32]domain.ReviewRotation
+	reviewerPools      map[int32][]string
+	inactiveOptOuts    map[int32]time.Time
+	userStatsHistory   map[userStatsKey]userStatsRecord
+	teamStatsHistory   map[teamStatsKey]domain.TeamStatsSnapshot
+	repositories       map[string]domain.Repository
+	reviewRules        map[string]domain.ReviewRule
+	savedFilters       map[int64]domain.SavedFilter
+	prTemplates        map[int64]domain.PRTemplate
+	users              map[string]domain.User
+	prs                map[string]pullRequest
+	assignments        map[assignmentKey]assignment
+	archivedPRs        map[string]pullRequest
+	archivedAssignment map[assignmentKey]assignment
+	shadowReviews      map[assignmentKey]time.Time
+	reassignments      map[int64]reassignment
+	checklists         map[checklistKey]domain.ChecklistItem
+	githubLogins       map[string]string
+	githubPRLinks      map[string]domain.GitHubPRLink
+	ingestionTokens    map[string]domain.GitHubIngestionToken
+	slackUsers         map[string]string
+	notificationPrefs  map[string]domain.NotificationPreferences
+	outbox             map[int64]outboxEntry
+	webhookDeliveries  map[int64]domain.WebhookDelivery
+	inboundWebhooks    map[int64]domain.InboundWebhook
+	// orgSettings is nil until the org-wide settings are saved.
+	orgSettings  *domain.Settings
+	teamSettings map[int32]domain.TeamSettings
+}
+
+func newState() *state {
+	return &state{
+		teams:              make(map[int32]domain.Team),
+		reviewerPrefs:      make(map[int32][]domain.ReviewerPreference),
+		noCandidateEvents:  make(map[int64]noCandidateEvent),
+		noCandidateAlerts:  make(map[int32]time.Time),
+		budgets:            make(map[int32]domain.ReviewBudget),
+		budgetUsage:        make(map[string]int),
+		reportSchedules:    make(map[int32]domain.TeamReportSchedule),
+		prQuotas:           make(map[int32]domain.PRQuota),
+		reviewRotations:    make(map[int32]domain.ReviewRotation),
+		reviewerPools:      make(map[int32][]string),
+		inactiveOptOuts:    make(map[int32]time.Time),
+		userStatsHistory:   make(map[userStatsKey]userStatsRecord),
+		teamStatsHistory:   make(map[teamStatsKey]domain.TeamStatsSnapshot),
+		repositories:       make(map[string]domain.Repository),
+		reviewRules:        make(map[string]domain.ReviewRule),
+		savedFilters:       make(map[int64]domain.SavedFilter),
+		prTemplates:        make(map[int64]domain.PRTemplate),
+		users:              make(map[string]domain.User),
+		prs:                make(map[string]pullRequest),
+		assignments:        make(map[assignmentKey]assignment),
+		archivedPRs:        make(map[string]pullRequest),
+		archivedAssignment: make(map[assignmentKey]assignment),
+		shadowReviews:      make(map[assignmentKey]time.Time),
+		reassignments:      make(map[int64]reassignment),
+		checklists:         make(map[checklistKey]domain.ChecklistItem),
+		githubLogins:       make(map[string]string),
+		githubPRLinks:      make(map[string]domain.GitHubPRLink),
+		ingestionTokens:    make(map[string]domain.GitHubIngestionToken),
+		slackUsers:         make(map[string]string),
+		notificationPrefs:  make(map[string]domain.NotificationPreferences),
+		outbox:             make(map[int64]outboxEntry),
+		webhookDeliveries:  make(map[int64]domain.WebhookDelivery),
+		inboundWebhooks:    make(map[int64]domain.InboundWebhook),
+		teamSettings:       make(map[int32]domain.TeamSettings),
+	}
+}
+
+func (st *state) clone() *state {
+	c := *st
+	c.teams = maps.Clone(st.teams)
+	c.reviewerPrefs = maps.Clone(st.reviewerPrefs)
+	c.noCandidateEvents = maps.Clone(st.noCandidateEvents)
+	c.noCandidateAlerts = maps.Clone(st.noCandidateAlerts)
+	c.budgets = maps.Clone(st.budgets)
+	c.budgetUsage = maps.Clone(st.budgetUsage)
+	c.reportSchedules = maps.Clone(st.reportSchedules)
+	c.prQuotas = maps.Clone(st.prQuotas)
+	c.reviewRotations = maps.Clone(st.reviewRotations)
+	c.reviewerPools = maps.Clone(st.reviewerPools)
+	c.inactiveOptOuts = maps.Clone(st.inactiveOptOuts)
+	c.userStatsHistory = maps.Clone(st.userStatsHistory)
+	c.teamStatsHistory = maps.Clone(st.teamStatsHistory)
+	c.repositories = maps.Clone(st.repositories)
+	c.reviewRules = maps.Clone(st.reviewRules)
+	c.savedFilters = maps.Clone(st.savedFilters)
+	c.prTemplates = maps.Clone(st.prTemplates)
+	c.users = maps.Clone(st.users)
+	c.prs = maps.Clone(st.prs)
+	c.assignments = maps.Clone(st.assignments)
+	c.archivedPRs = maps.Clone(st.archivedPRs)
+	c.archivedAssignment = maps.Clone(st.archivedAssignment)
+	c.shadowReviews = maps.Clone(st.shadowReviews)
+	c.reassignments = maps.Clone(st.reassignments)
+	c.checklists = maps.Clone(st.checklists)
+	c.githubLogins = maps.Clone(st.githubLogins)
+	c.githubPRLinks = maps.Clone(st.githubPRLinks)
+	c.ingestionTokens = maps.Clone(st.ingestionTokens)
+	c.slackUsers = maps.Clone(st.slackUsers)
+	c.notificationPrefs = maps.Clone(st.notificationPrefs)
+	c.outbox = maps.Clone(st.outbox)
+	c.webhookDeliveries = maps.Clone(st.webhookDeliveries)
+	c.inboundWebhooks = maps.Clone(st.inboundWebhooks)
+	c.teamSettings = maps.Clone(st.teamSettings)
+	return &c
+}
+
+// nextID returns a new ID for rows with a generated one.
+func (st *state) nextID() int64 {
+	st.seq++
+	return st.seq
+}
+
+// paginate returns the page of items at offset; a zero limit means no limit.
+func paginate[T any](items []T, limit, offset int) []T {
+	items = items[min(offset, len(items)):]
+	if limit > 0 {
+		items = items[:min(limit, len(items))]
+	}
+	return items
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,638 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+type noCandidateEvent struct {
+	teamID     int32
+	prID       string
+	occurredAt time.Time
+}
+
+func (st *state) team(teamID int32) (domain.Team, error) {
+	team, ok := st.teams[teamID]
+	if !ok {
+		return domain.Team{}, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return team, nil
+}
+
+func (st *state) teamByName(teamName string) (domain.Team, error) {
+	for _, team := range st.teams {
+		if team.TeamName == teamName {
+			return team, nil
+		}
+	}
+	return domain.Team{}, fmt.Errorf("%w: team '%s'", domain.ErrNotFound, teamName)
+}
+
+func (st *state) teamName(teamID int32) string {
+	return st.teams[teamID].TeamName
+}
+
+// teamOut copies a stored team, so callers cannot change the stored slices.
+func teamOut(team domain.Team) *domain.Team {
+	team.Members = nil
+	team.SizeRules = slices.Clone(team.SizeRules)
+	team.Checklist.Items = slices.Clone(team.Checklist.Items)
+	return &team
+}
+
+func sortTeams(teams []domain.Team) {
+	slices.SortFunc(teams, func(a, b domain.Team) int { return cmp.Compare(a.TeamName, b.TeamName) })
+}
+
+// setTeam applies change to the team and stores it.
+func (s *Store) setTeam(ctx context.Context, tx domain.Tx, teamID int32, change func(st *state, team *domain.Team) error) (*domain.Team, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Team, error) {
+		team, err := st.team(teamID)
+		if err != nil {
+			return nil, err
+		}
+		if err := change(st, &team); err != nil {
+			return nil, err
+		}
+		st.teams[teamID] = team
+		return teamOut(team), nil
+	})
+}
+
+func (s *Store) CreateTeam(ctx context.Context, tx domain.Tx, team *domain.Team) (*domain.Team, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Team, error) {
+		if _, err := st.teamByName(team.TeamName); err == nil {
+			return nil, fmt.Errorf("%w: team '%s'", domain.ErrTeamExists, team.TeamName)
+		}
+		st.teamSeq++
+		created := domain.Team{
+			ID:                      st.teamSeq,
+			TeamName:                team.TeamName,
+			IsActive:                true,
+			AllowDuplicateUsernames: team.AllowDuplicateUsernames,
+		}
+		st.teams[created.ID] = created
+		return teamOut(created), nil
+	})
+}
+
+func (s *Store) GetTeamByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.Team, error) {
+		team, err := st.teamByName(teamName)
+		if err != nil {
+			return nil, err
+		}
+		return teamOut(team), nil
+	})
+}
+
+func (s *Store) GetTeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.Team, error) {
+		team, err := st.teamByName(teamName)
+		if err != nil {
+			return nil, err
+		}
+		out := teamOut(team)
+		out.Members = st.teamMembers(team.ID, false)
+		if out.Members == nil {
+			out.Members = []domain.User{}
+		}
+		return out, nil
+	})
+}
+
+func (s *Store) GetTeamByID(ctx context.Context, teamID int32) (*domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.Team, error) {
+		team, err := st.team(teamID)
+		if err != nil {
+			return nil, err
+		}
+		return teamOut(team), nil
+	})
+}
+
+func (s *Store) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.Team, error) {
+		teams := make([]domain.Team, 0, len(st.teams))
+		for _, team := range st.teams {
+			teams = append(teams, *teamOut(team))
+		}
+		sortTeams(teams)
+		return teams, nil
+	})
+}
+
+func (s *Store) UpdateTeam(ctx context.Context, tx domain.Tx, oldTeamName, newTeamName string) (*domain.Team, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.Team, error) {
+		team, err := st.teamByName(oldTeamName)
+		if err != nil {
+			return nil, err
+		}
+		if other, err := st.teamByName(newTeamName); err == nil && other.ID != team.ID {
+			return nil, fmt.Errorf("%w: team '%s'", domain.ErrTeamExists, newTeamName)
+		}
+		team.TeamName = newTeamName
+		st.teams[team.ID] = team
+		return teamOut(team), nil
+	})
+}
+
+func (s *Store) DeactivateTeam(ctx context.Context, tx domain.Tx, teamName string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		team, err := st.teamByName(teamName)
+		if err != nil {
+			return err
+		}
+		team.IsActive = false
+		team.DeactivateAt = nil
+		st.teams[team.ID] = team
+		return nil
+	})
+}
+
+func (s *Store) SetTeamParent(ctx context.Context, tx domain.Tx, teamID int32, parentTeamID *int32, escalateToParent bool) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(st *state, team *domain.Team) error {
+		if parentTeamID != nil {
+			if _, err := st.team(*parentTeamID); err != nil {
+				return err
+			}
+			parentTeamID = ptr(*parentTeamID)
+		}
+		team.ParentTeamID = parentTeamID
+		team.EscalateToParent = escalateToParent
+		return nil
+	})
+}
+
+func (s *Store) ListChildTeams(ctx context.Context, teamID int32) ([]domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.Team, error) {
+		var teams []domain.Team
+		for _, team := range st.teams {
+			if team.ParentTeamID != nil && *team.ParentTeamID == teamID {
+				teams = append(teams, *teamOut(team))
+			}
+		}
+		sortTeams(teams)
+		return teams, nil
+	})
+}
+
+func (s *Store) SetTeamRequiredReviewerRole(ctx context.Context, tx domain.Tx, teamID int32, role string) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.RequiredReviewerRole = role
+		return nil
+	})
+}
+
+func (s *Store) SetTeamOptionalReviewers(ctx context.Context, tx domain.Tx, teamID int32, count int) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.OptionalReviewers = count
+		return nil
+	})
+}
+
+func (s *Store) SetTeamShadowReviewPercent(ctx context.Context, tx domain.Tx, teamID int32, percent int) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.ShadowReviewPercent = percent
+		return nil
+	})
+}
+
+func (s *Store) SetTeamEscalationPolicy(ctx context.Context, tx domain.Tx, teamID int32, policy domain.EscalationPolicy) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(st *state, team *domain.Team) error {
+		if policy.LeadUserID != "" {
+			if _, ok := st.users[policy.LeadUserID]; !ok {
+				return fmt.Errorf("%w: user '%s'", domain.ErrNotFound, policy.LeadUserID)
+			}
+		}
+		team.Escalation = policy
+		return nil
+	})
+}
+
+func (s *Store) SetTeamReviewCooldown(ctx context.Context, tx domain.Tx, teamID int32, prCount int) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.ReviewCooldownPRs = prCount
+		return nil
+	})
+}
+
+func (s *Store) SetTeamAllowDuplicateUsernames(ctx context.Context, tx domain.Tx, teamID int32, allow bool) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.AllowDuplicateUsernames = allow
+		return nil
+	})
+}
+
+func (s *Store) SetTeamAllowSelfReview(ctx context.Context, tx domain.Tx, teamID int32, allow bool) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.AllowSelfReview = allow
+		return nil
+	})
+}
+
+func (s *Store) GetTeamSizeRules(ctx context.Context, teamID int32) ([]domain.SizeRule, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.SizeRule, error) {
+		return slices.Clone(st.teams[teamID].SizeRules), nil
+	})
+}
+
+func (s *Store) SetTeamSizeRules(ctx context.Context, tx domain.Tx, teamID int32, rules []domain.SizeRule) error {
+	_, err := s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.SizeRules = slices.Clone(rules)
+		return nil
+	})
+	return err
+}
+
+func (s *Store) SetTeamChecklist(ctx context.Context, tx domain.Tx, teamID int32, checklist domain.ChecklistTemplate) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		team.Checklist = domain.ChecklistTemplate{Items: slices.Clone(checklist.Items), RequireCompletion: checklist.RequireCompletion}
+		return nil
+	})
+}
+
+func (s *Store) GetReviewerPreferences(ctx context.Context, teamID int32) ([]domain.ReviewerPreference, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReviewerPreference, error) {
+		prefs := slices.Clone(st.reviewerPrefs[teamID])
+		slices.SortFunc(prefs, func(a, b domain.ReviewerPreference) int {
+			return cmp.Or(cmp.Compare(a.AuthorID, b.AuthorID), cmp.Compare(b.Weight, a.Weight), cmp.Compare(a.ReviewerID, b.ReviewerID))
+		})
+		return prefs, nil
+	})
+}
+
+func (s *Store) SetReviewerPreferences(ctx context.Context, tx domain.Tx, teamID int32, prefs []domain.ReviewerPreference) ([]domain.ReviewerPreference, error) {
+	return update(s, ctx, tx, func(st *state) ([]domain.ReviewerPreference, error) {
+		for _, p := range prefs {
+			for _, id := range []string{p.AuthorID, p.ReviewerID} {
+				if _, ok := st.users[id]; !ok {
+					return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, id)
+				}
+			}
+		}
+		st.reviewerPrefs[teamID] = slices.Clone(prefs)
+		return prefs, nil
+	})
+}
+
+func (s *Store) ScheduleTeamDeactivation(ctx context.Context, tx domain.Tx, teamID int32, at *time.Time) (*domain.Team, error) {
+	return s.setTeam(ctx, tx, teamID, func(_ *state, team *domain.Team) error {
+		if at != nil {
+			at = ptr(*at)
+		}
+		team.DeactivateAt = at
+		return nil
+	})
+}
+
+func (s *Store) ListTeamsDueForDeactivation(ctx context.Context, limit int) ([]domain.Team, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.Team, error) {
+		now := time.Now()
+		var teams []domain.Team
+		for _, team := range st.teams {
+			if team.DeactivateAt != nil && !team.DeactivateAt.After(now) {
+				teams = append(teams, *teamOut(team))
+			}
+		}
+		slices.SortFunc(teams, func(a, b domain.Team) int {
+			return cmp.Or(a.DeactivateAt.Compare(*b.DeactivateAt), cmp.Compare(a.ID, b.ID))
+		})
+		return teams[:min(limit, len(teams))], nil
+	})
+}
+
+func (s *Store) RecordNoCandidate(ctx context.Context, teamID int32, prID string, since time.Time) (int, error) {
+	return update(s, ctx, nil, func(st *state) (int, error) {
+		st.noCandidateEvents[st.nextID()] = noCandidateEvent{teamID: teamID, prID: prID, occurredAt: time.Now()}
+		count := 0
+		for id, e := range st.noCandidateEvents {
+			if e.teamID != teamID {
+				continue
+			}
+			// Older events no longer count towards any window.
+			if e.occurredAt.Before(since) {
+				delete(st.noCandidateEvents, id)
+				continue
+			}
+			count++
+		}
+		return count, nil
+	})
+}
+
+func (s *Store) ClaimNoCandidateAlert(ctx context.Context, teamID int32, since time.Time) (bool, error) {
+	return update(s, ctx, nil, func(st *state) (bool, error) {
+		if at, ok := st.noCandidateAlerts[teamID]; ok && !at.Before(since) {
+			return false, nil
+		}
+		st.noCandidateAlerts[teamID] = time.Now()
+		return true, nil
+	})
+}
+
+func (s *Store) GetReviewBudget(ctx context.Context, teamID int32) (*domain.ReviewBudget, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.ReviewBudget, error) {
+		budget, ok := st.budgets[teamID]
+		if !ok {
+			return nil, fmt.Errorf("%w: review budget of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return &budget, nil
+	})
+}
+
+func (s *Store) ListReviewBudgets(ctx context.Context) ([]domain.ReviewBudget, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.ReviewBudget, error) {
+		var budgets []domain.ReviewBudget
+		for teamID, budget := range st.budgets {
+			if st.teams[teamID].IsActive {
+				budgets = append(budgets, budget)
+			}
+		}
+		slices.SortFunc(budgets, func(a, b domain.ReviewBudget) int { return cmp.Compare(a.TeamID, b.TeamID) })
+		return budgets, nil
+	})
+}
+
+func (s *Store) SetReviewBudget(ctx context.Context, tx domain.Tx, teamID int32, reviewsPerSprint, sprintDays int) (*domain.ReviewBudget, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.ReviewBudget, error) {
+		if _, err := st.team(teamID); err != nil {
+			return nil, err
+		}
+		budget, ok := st.budgets[teamID]
+		if !ok {
+			// Reviews taken before the first sprint do not count against it.
+			st.resetBudgetUsage(teamID)
+			budget = domain.ReviewBudget{TeamID: teamID, SprintStartedAt: time.Now()}
+		}
+		budget.ReviewsPerSprint = reviewsPerSprint
+		budget.SprintDays = sprintDays
+		st.budgets[teamID] = budget
+		return &budget, nil
+	})
+}
+
+func (s *Store) DeleteReviewBudget(ctx context.Context, tx domain.Tx, teamID int32) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.budgets[teamID]; !ok {
+			return fmt.Errorf("%w: review budget of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		delete(st.budgets, teamID)
+		return nil
+	})
+}
+
+func (s *Store) StartReviewSprint(ctx context.Context, tx domain.Tx, teamID int32, startedAt time.Time) (bool, error) {
+	return update(s, ctx, tx, func(st *state) (bool, error) {
+		budget, ok := st.budgets[teamID]
+		if !ok || !budget.SprintStartedAt.Before(startedAt) {
+			return false, nil
+		}
+		budget.SprintStartedAt = startedAt
+		budget.AlertedAt = nil
+		st.budgets[teamID] = budget
+		st.resetBudgetUsage(teamID)
+		return true, nil
+	})
+}
+
+func (st *state) resetBudgetUsage(teamID int32) {
+	for userID := range st.budgetUsage {
+		if st.users[userID].TeamID == teamID {
+			st.budgetUsage[userID] = 0
+		}
+	}
+}
+
+func (s *Store) ClaimReviewBudgetAlert(ctx context.Context, teamID int32) (bool, error) {
+	return update(s, ctx, nil, func(st *state) (bool, error) {
+		budget, ok := st.budgets[teamID]
+		if !ok || budget.AlertedAt != nil {
+			return false, nil
+		}
+		budget.AlertedAt = ptr(time.Now())
+		st.budgets[teamID] = budget
+		return true, nil
+	})
+}
+
+func (s *Store) GetReportSchedule(ctx context.Context, teamID int32) (*domain.TeamReportSchedule, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.TeamReportSchedule, error) {
+		schedule, ok := st.reportSchedules[teamID]
+		if !ok {
+			return nil, fmt.Errorf("%w: report schedule of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return &schedule, nil
+	})
+}
+
+func (s *Store) ListReportSchedules(ctx context.Context) ([]domain.TeamReportSchedule, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.TeamReportSchedule, error) {
+		var schedules []domain.TeamReportSchedule
+		for teamID, schedule := range st.reportSchedules {
+			if st.teams[teamID].IsActive {
+				schedules = append(schedules, schedule)
+			}
+		}
+		slices.SortFunc(schedules, func(a, b domain.TeamReportSchedule) int { return cmp.Compare(a.TeamID, b.TeamID) })
+		return schedules, nil
+	})
+}
+
+func (s *Store) SetReportSchedule(ctx context.Context, tx domain.Tx, schedule *domain.TeamReportSchedule) (*domain.TeamReportSchedule, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.TeamReportSchedule, error) {
+		if _, err := st.team(schedule.TeamID); err != nil {
+			return nil, err
+		}
+		saved, ok := st.reportSchedules[schedule.TeamID]
+		if !ok {
+			saved = domain.TeamReportSchedule{TeamID: schedule.TeamID, CreatedAt: time.Now()}
+		}
+		saved.Weekday = schedule.Weekday
+		saved.Hour = schedule.Hour
+		saved.Timezone = schedule.Timezone
+		st.reportSchedules[schedule.TeamID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) DeleteReportSchedule(ctx context.Context, tx domain.Tx, teamID int32) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.reportSchedules[teamID]; !ok {
+			return fmt.Errorf("%w: report schedule of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		delete(st.reportSchedules, teamID)
+		return nil
+	})
+}
+
+func (s *Store) ClaimTeamReport(ctx context.Context, teamID int32, slot time.Time) (bool, error) {
+	return update(s, ctx, nil, func(st *state) (bool, error) {
+		schedule, ok := st.reportSchedules[teamID]
+		if !ok {
+			return false, nil
+		}
+		last := schedule.CreatedAt
+		if schedule.LastSentAt != nil {
+			last = *schedule.LastSentAt
+		}
+		if !last.Before(slot) {
+			return false, nil
+		}
+		schedule.LastSentAt = &slot
+		st.reportSchedules[teamID] = schedule
+		return true, nil
+	})
+}
+
+func (s *Store) GetPRQuota(ctx context.Context, teamID int32) (*domain.PRQuota, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.PRQuota, error) {
+		quota, ok := st.prQuotas[teamID]
+		if !ok {
+			return nil, fmt.Errorf("%w: PR quota of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return &quota, nil
+	})
+}
+
+func (s *Store) SetPRQuota(ctx context.Context, tx domain.Tx, quota *domain.PRQuota) (*domain.PRQuota, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.PRQuota, error) {
+		if _, err := st.team(quota.TeamID); err != nil {
+			return nil, err
+		}
+		saved := *quota
+		st.prQuotas[quota.TeamID] = saved
+		return &saved, nil
+	})
+}
+
+func (s *Store) DeletePRQuota(ctx context.Context, tx domain.Tx, teamID int32) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.prQuotas[teamID]; !ok {
+			return fmt.Errorf("%w: PR quota of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		delete(st.prQuotas, teamID)
+		return nil
+	})
+}
+
+func (s *Store) GetReviewRotation(ctx context.Context, teamID int32) (*domain.ReviewRotation, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.ReviewRotation, error) {
+		rotation, ok := st.reviewRotations[teamID]
+		if !ok {
+			return nil, fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		rotation.MemberIDs = slices.Clone(rotation.MemberIDs)
+		rotation.Overrides = slices.Clone(rotation.Overrides)
+		return &rotation, nil
+	})
+}
+
+func (s *Store) SetReviewRotation(ctx context.Context, tx domain.Tx, rotation *domain.ReviewRotation) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, err := st.team(rotation.TeamID); err != nil {
+			return err
+		}
+		saved := domain.ReviewRotation{
+			TeamID:    rotation.TeamID,
+			StartDate: rotation.StartDate,
+			MemberIDs: slices.Clone(rotation.MemberIDs),
+			Overrides: st.reviewRotations[rotation.TeamID].Overrides,
+		}
+		st.reviewRotations[rotation.TeamID] = saved
+		return nil
+	})
+}
+
+func (s *Store) DeleteReviewRotation(ctx context.Context, tx domain.Tx, teamID int32) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, ok := st.reviewRotations[teamID]; !ok {
+			return fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		delete(st.reviewRotations, teamID)
+		return nil
+	})
+}
+
+func (s *Store) SetRotationOverride(ctx context.Context, tx domain.Tx, teamID int32, weekStart time.Time, userID string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		rotation, ok := st.reviewRotations[teamID]
+		if !ok {
+			return fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		if _, ok := st.users[userID]; !ok && userID != "" {
+			return fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		rotation.Overrides = slices.DeleteFunc(slices.Clone(rotation.Overrides), func(o domain.RotationOverride) bool {
+			return o.WeekStart.Equal(weekStart)
+		})
+		if userID != "" {
+			rotation.Overrides = append(rotation.Overrides, domain.RotationOverride{WeekStart: weekStart, UserID: userID})
+			slices.SortFunc(rotation.Overrides, func(a, b domain.RotationOverride) int {
+				return a.WeekStart.Compare(b.WeekStart)
+			})
+		}
+		st.reviewRotations[teamID] = rotation
+		return nil
+	})
+}
+
+// GetReviewerPool skips users who have since left the team.
+func (s *Store) GetReviewerPool(ctx context.Context, teamID int32) ([]string, error) {
+	return view(s, ctx, nil, func(st *state) ([]string, error) {
+		userIDs := []string{}
+		for _, id := range st.reviewerPools[teamID] {
+			if u, ok := st.users[id]; ok && u.TeamID == teamID {
+				userIDs = append(userIDs, id)
+			}
+		}
+		return userIDs, nil
+	})
+}
+
+func (s *Store) SetReviewerPool(ctx context.Context, tx domain.Tx, teamID int32, userIDs []string) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, err := st.team(teamID); err != nil {
+			return err
+		}
+		for _, id := range userIDs {
+			if _, ok := st.users[id]; !ok {
+				return fmt.Errorf("%w: user '%s'", domain.ErrNotFound, id)
+			}
+		}
+		if len(userIDs) == 0 {
+			delete(st.reviewerPools, teamID)
+			return nil
+		}
+		pool := slices.Clone(userIDs)
+		slices.Sort(pool)
+		st.reviewerPools[teamID] = pool
+		return nil
+	})
+}
+
+func (s *Store) GetInactiveReassign(ctx context.Context, teamID int32) (bool, error) {
+	return view(s, ctx, nil, func(st *state) (bool, error) {
+		_, optedOut := st.inactiveOptOuts[teamID]
+		return !optedOut, nil
+	})
+}
+
+func (s *Store) SetInactiveReassign(ctx context.Context, tx domain.Tx, teamID int32, enabled bool) error {
+	return exec(s, ctx, tx, func(st *state) error {
+		if _, err := st.team(teamID); err != nil {
+			return err
+		}
+		if enabled {
+			delete(st.inactiveOptOuts, teamID)
+		} else if _, ok := st.inactiveOptOuts[teamID]; !ok {
+			st.inactiveOptOuts[teamID] = time.Now()
+		}
+		return nil
+	})
+}
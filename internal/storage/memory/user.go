@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+)
+
+func (st *state) user(userID string) (domain.User, error) {
+	user, ok := st.users[userID]
+	if !ok {
+		return domain.User{}, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+	}
+	return user, nil
+}
+
+// userOut copies a stored user with TeamName filled in.
+func (st *state) userOut(user domain.User) *domain.User {
+	user.TeamName = st.teamName(user.TeamID)
+	user.Skills = slices.Clone(user.Skills)
+	return &user
+}
+
+// teamMembers returns the members of the team by ID.
+func (st *state) teamMembers(teamID int32, activeOnly bool) []domain.User {
+	var users []domain.User
+	for _, user := range st.users {
+		if user.TeamID == teamID && (user.IsActive || !activeOnly) {
+			users = append(users, *st.userOut(user))
+		}
+	}
+	sortUsers(users)
+	return users
+}
+
+func sortUsers(users []domain.User) {
+	slices.SortFunc(users, func(a, b domain.User) int { return cmp.Compare(a.ID, b.ID) })
+}
+
+// usernameTaken reports whether another user of the team has the username,
+// which only matters for teams that do not allow duplicates.
+func (st *state) usernameTaken(teamID int32, username, userID string) bool {
+	if st.teams[teamID].AllowDuplicateUsernames {
+		return false
+	}
+	for _, user := range st.users {
+		if user.TeamID == teamID && user.Username == username && user.ID != userID {
+			return true
+		}
+	}
+	return false
+}
+
+// setUser applies change to the user and stores it.
+func (s *Store) setUser(ctx context.Context, tx domain.Tx, userID string, change func(st *state, user *domain.User) error) (*domain.User, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.User, error) {
+		user, err := st.user(userID)
+		if err != nil {
+			return nil, err
+		}
+		if err := change(st, &user); err != nil {
+			return nil, err
+		}
+		st.users[userID] = user
+		return st.userOut(user), nil
+	})
+}
+
+func (s *Store) CreateUser(ctx context.Context, tx domain.Tx, user *domain.User) (*domain.User, error) {
+	return update(s, ctx, tx, func(st *state) (*domain.User, error) {
+		if _, err := st.team(user.TeamID); err != nil {
+			return nil, err
+		}
+		if st.usernameTaken(user.TeamID, user.Username, user.ID) {
+			return nil, fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(user.Username))
+		}
+		if _, ok := st.users[user.ID]; ok {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrValidation, user.ID)
+		}
+		created := domain.User{ID: user.ID, Username: user.Username, TeamID: user.TeamID, IsActive: true, Skills: []string{}}
+		st.users[created.ID] = created
+		return st.userOut(created), nil
+	})
+}
+
+func (s *Store) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
+	return view(s, ctx, nil, func(st *state) (*domain.User, error) {
+		user, err := st.user(userID)
+		if err != nil {
+			return nil, err
+		}
+		return st.userOut(user), nil
+	})
+}
+
+func (s *Store) GetUsersByTeam(ctx context.Context, teamID int32) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		return st.teamMembers(teamID, false), nil
+	})
+}
+
+func (s *Store) GetUsersByUsername(ctx context.Context, username, teamName string) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		var users []domain.User
+		for _, user := range st.users {
+			out := st.userOut(user)
+			if out.Username == username && (teamName == "" || out.TeamName == teamName) {
+				users = append(users, *out)
+			}
+		}
+		slices.SortFunc(users, func(a, b domain.User) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.ID, b.ID))
+		})
+		return users, nil
+	})
+}
+
+func (s *Store) UpdateUser(ctx context.Context, tx domain.Tx, user *domain.User) (*domain.User, error) {
+	return s.setUser(ctx, tx, user.ID, func(st *state, saved *domain.User) error {
+		if _, err := st.team(user.TeamID); err != nil {
+			return err
+		}
+		if (saved.TeamID != user.TeamID || saved.Username != user.Username) && st.usernameTaken(user.TeamID, user.Username, user.ID) {
+			return fmt.Errorf("%w: user '%s'", domain.ErrUsernameExists, user.ID)
+		}
+		saved.Username = user.Username
+		saved.TeamID = user.TeamID
+		saved.IsActive = user.IsActive
+		return nil
+	})
+}
+
+func (s *Store) SetUserActiveStatus(ctx context.Context, tx domain.Tx, userID string, isActive bool) (*domain.User, error) {
+	return s.setUser(ctx, tx, userID, func(_ *state, user *domain.User) error {
+		user.IsActive = isActive
+		return nil
+	})
+}
+
+func (s *Store) SetUserRole(ctx context.Context, tx domain.Tx, userID, role string) (*domain.User, error) {
+	return s.setUser(ctx, tx, userID, func(_ *state, user *domain.User) error {
+		user.Role = role
+		return nil
+	})
+}
+
+func (s *Store) SetUserInTraining(ctx context.Context, tx domain.Tx, userID string, inTraining bool) (*domain.User, error) {
+	return s.setUser(ctx, tx, userID, func(_ *state, user *domain.User) error {
+		user.InTraining = inTraining
+		return nil
+	})
+}
+
+func (s *Store) SetUserSkills(ctx context.Context, tx domain.Tx, userID string, skills []string) (*domain.User, error) {
+	return s.setUser(ctx, tx, userID, func(_ *state, user *domain.User) error {
+		user.Skills = slices.Clone(skills)
+		return nil
+	})
+}
+
+func (s *Store) MoveUserToTeam(ctx context.Context, tx domain.Tx, userID string, newTeamID int32) (*domain.User, error) {
+	return s.setUser(ctx, tx, userID, func(st *state, user *domain.User) error {
+		if _, err := st.team(newTeamID); err != nil {
+			return err
+		}
+		if user.TeamID != newTeamID && st.usernameTaken(newTeamID, user.Username, userID) {
+			return fmt.Errorf("%w: user '%s'", domain.ErrUsernameExists, userID)
+		}
+		user.TeamID = newTeamID
+		return nil
+	})
+}
+
+func (s *Store) DeactivateUsersByTeam(ctx context.Context, tx domain.Tx, teamID int32) ([]string, error) {
+	return update(s, ctx, tx, func(st *state) ([]string, error) {
+		var userIDs []string
+		for _, user := range st.teamMembers(teamID, true) {
+			user.IsActive = false
+			user.TeamName = ""
+			st.users[user.ID] = user
+			userIDs = append(userIDs, user.ID)
+		}
+		return userIDs, nil
+	})
+}
+
+func (s *Store) GetActiveUsersByTeam(ctx context.Context, teamID int32) ([]domain.User, error) {
+	return view(s, ctx, nil, func(st *state) ([]domain.User, error) {
+		return st.teamMembers(teamID, true), nil
+	})
+}
+
+func (s *Store) GetOpenReviewCounts(ctx context.Context, tx domain.Tx, userIDs []string) (map[string]int, error) {
+	return view(s, ctx, tx, func(st *state) (map[string]int, error) {
+		counts := make(map[string]int)
+		for key := range st.assignments {
+			if slices.Contains(userIDs, key.userID) && !finished(st.prs[key.prID].Status) {
+				counts[key.userID]++
+			}
+		}
+		return counts, nil
+	})
+}
+
+func (s *Store) GetOpenAuthoredCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	return view(s, ctx, nil, func(st *state) (map[string]int, error) {
+		counts := make(map[string]int)
+		for _, pr := range st.prs {
+			if slices.Contains(userIDs, pr.AuthorID) && !finished(pr.Status) {
+				counts[pr.AuthorID]++
+			}
+		}
+		return counts, nil
+	})
+}
+
+func (s *Store) GetReviewBudgetUsage(ctx context.Context, tx domain.Tx, userIDs []string) (map[string]int, error) {
+	return view(s, ctx, tx, func(st *state) (map[string]int, error) {
+		usage := make(map[string]int)
+		for _, id := range userIDs {
+			if used, ok := st.budgetUsage[id]; ok {
+				usage[id] = used
+			}
+		}
+		return usage, nil
+	})
+}
+
+func (s *Store) MergeUsers(context.Context, domain.Tx, string, string) (*domain.UserMergeResult, error) {
+	return nil, errUnsupported
+}
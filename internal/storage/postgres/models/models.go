@@ -11,11 +11,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type PrPriority string
+
+const (
+	PrPriorityLOW    PrPriority = "LOW"
+	PrPriorityNORMAL PrPriority = "NORMAL"
+	PrPriorityURGENT PrPriority = "URGENT"
+)
+
+func (e *PrPriority) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrPriority(s)
+	case string:
+		*e = PrPriority(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrPriority: %T", src)
+	}
+	return nil
+}
+
+type NullPrPriority struct {
+	PrPriority PrPriority
+	Valid      bool // Valid is true if PrPriority is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrPriority) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrPriority, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrPriority.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrPriority) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrPriority), nil
+}
+
 type PrStatus string
 
 const (
-	PrStatusOPEN   PrStatus = "OPEN"
-	PrStatusMERGED PrStatus = "MERGED"
+	PrStatusDRAFT    PrStatus = "DRAFT"
+	PrStatusOPEN     PrStatus = "OPEN"
+	PrStatusINREVIEW PrStatus = "IN_REVIEW"
+	PrStatusAPPROVED PrStatus = "APPROVED"
+	PrStatusMERGED   PrStatus = "MERGED"
+	PrStatusCLOSED   PrStatus = "CLOSED"
 )
 
 func (e *PrStatus) Scan(src interface{}) error {
@@ -53,30 +100,438 @@ func (ns NullPrStatus) Value() (driver.Value, error) {
 	return string(ns.PrStatus), nil
 }
 
-type PullRequest struct {
+type GithubAccount struct {
+	UserID string
+	Login  string
+}
+
+type GithubIngestionToken struct {
+	Repository string
+	TeamID     int32
+	Secret     string
+	CreatedAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+}
+
+type GithubInstallation struct {
+	InstallationID int64
+	AccountLogin   string
+	AccessToken    pgtype.Text
+	TokenExpiresAt pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type GithubPullRequest struct {
+	PrID       string
+	Repository string
+	Number     int32
+}
+
+type GithubRepository struct {
+	Repository     string
+	InstallationID int64
+	TeamID         pgtype.Int4
+}
+
+type GithubTeamLink struct {
+	GithubTeamID int64
+	Org          string
+	Slug         string
+	TeamID       int32
+}
+
+type GithubTeamSyncConflict struct {
+	RunID    int64
+	Position int32
+	Reason   string
+	Login    string
+	UserID   string
+	TeamName string
+	Detail   string
+}
+
+type GithubTeamSyncRun struct {
+	RunID            int64
+	Org              string
+	StartedAt        pgtype.Timestamptz
+	FinishedAt       pgtype.Timestamptz
+	TeamsCreated     int32
+	UsersCreated     int32
+	UsersMoved       int32
+	UsersDeactivated int32
+	Error            string
+}
+
+type InboundWebhook struct {
+	ID             int64
+	Source         string
+	Event          string
+	DeliveryID     string
+	IngestionToken string
+	Payload        string
+	Status         string
+	Error          string
+	Attempts       int32
+	ReceivedAt     pgtype.Timestamptz
+	ProcessedAt    pgtype.Timestamptz
+}
+
+type NoCandidateAlert struct {
+	TeamID    int32
+	AlertedAt pgtype.Timestamptz
+}
+
+type NoCandidateEvent struct {
+	ID         int64
+	TeamID     int32
+	PrID       string
+	OccurredAt pgtype.Timestamptz
+}
+
+type NotificationOutbox struct {
+	ID             int64
+	UserID         string
+	Event          string
+	PrID           pgtype.Text
+	Message        string
+	DeliverAfter   pgtype.Timestamptz
+	Attempts       int32
+	LastError      string
+	SentAt         pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	ReplayOf       pgtype.Int8
+	DeadLetteredAt pgtype.Timestamptz
+	Html           string
+}
+
+type NotificationPreference struct {
+	UserID          string
+	Channels        []string
+	MutedEvents     []string
+	QuietHoursStart pgtype.Text
+	QuietHoursEnd   pgtype.Text
+	Timezone        string
+	WebhookUrl      string
+	Digest          string
+	LastDigestAt    pgtype.Timestamptz
+	Email           string
+}
+
+type OrgSetting struct {
+	ID                bool
+	MaxReviewers      int32
+	SelectionStrategy string
+	ReviewSlaHours    int32
+	MaxOpenReviews    int32
+	AutoMerge         bool
+	ShadowReviews     bool
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type PrChecklistItem struct {
 	PrID      string
-	PrName    string
-	AuthorID  string
-	Status    PrStatus
-	CreatedAt pgtype.Timestamptz
-	MergedAt  pgtype.Timestamptz
+	Position  int32
+	Label     string
+	Checked   bool
+	CheckedBy pgtype.Text
+	CheckedAt pgtype.Timestamptz
+}
+
+type PrTemplate struct {
+	TemplateID       int64
+	TeamID           int32
+	TemplateName     string
+	NamePrefix       string
+	Labels           []string
+	DefaultReviewers []string
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+}
+
+type PullRequest struct {
+	PrID                string
+	PrName              string
+	AuthorID            string
+	Status              PrStatus
+	CreatedAt           pgtype.Timestamptz
+	MergedAt            pgtype.Timestamptz
+	RequiredSkills      []string
+	Description         string
+	AutoMerge           bool
+	LeadNotifiedAt      pgtype.Timestamptz
+	ReviewerEscalatedAt pgtype.Timestamptz
+	Priority            PrPriority
+	RepositoryName      pgtype.Text
+	LinesChanged        pgtype.Int4
+	FilesChanged        pgtype.Int4
+	ExternalID          pgtype.Text
+	Labels              []string
+	MergedBy            pgtype.Text
+	ReassignedBy        pgtype.Text
+	ClosedAt            pgtype.Timestamptz
+}
+
+type PullRequestsArchive struct {
+	PrID           string
+	PrName         string
+	AuthorID       string
+	Status         PrStatus
+	CreatedAt      pgtype.Timestamptz
+	MergedAt       pgtype.Timestamptz
+	RequiredSkills []string
+	ArchivedAt     pgtype.Timestamptz
+	Description    string
+	AutoMerge      bool
+	Priority       PrPriority
+	RepositoryName pgtype.Text
+	LinesChanged   pgtype.Int4
+	FilesChanged   pgtype.Int4
+	ExternalID     pgtype.Text
+	Labels         []string
+	MergedBy       pgtype.Text
+	ReassignedBy   pgtype.Text
+}
+
+type Reassignment struct {
+	ID           int64
+	PrID         string
+	TeamID       pgtype.Int4
+	FromUserID   pgtype.Text
+	ToUserID     pgtype.Text
+	Reason       string
+	ReassignedAt pgtype.Timestamptz
+	ReassignedBy pgtype.Text
+}
+
+type Repository struct {
+	RepositoryName    string
+	DefaultTeamID     pgtype.Int4
+	RequiredReviewers pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+}
+
+type RepositoryRoutingRule struct {
+	RepositoryName string
+	Position       int32
+	TitlePrefix    string
+	TeamID         pgtype.Int4
+	RequiredSkills []string
 }
 
 type ReviewAssignment struct {
-	PrID   string
-	UserID string
+	PrID               string
+	UserID             string
+	ApprovedAt         pgtype.Timestamptz
+	AssignedAt         pgtype.Timestamptz
+	AckedAt            pgtype.Timestamptz
+	AckRemindedAt      pgtype.Timestamptz
+	ChangesRequestedAt pgtype.Timestamptz
+	ReviewedAt         pgtype.Timestamptz
+	Optional           bool
 }
 
-type Team struct {
-	TeamID   int32
-	TeamName string
-	IsActive bool
+type ReviewAssignmentsArchive struct {
+	PrID               string
+	UserID             string
+	ApprovedAt         pgtype.Timestamptz
+	AssignedAt         pgtype.Timestamptz
+	AckedAt            pgtype.Timestamptz
+	ChangesRequestedAt pgtype.Timestamptz
+	ReviewedAt         pgtype.Timestamptz
+	Optional           bool
 }
 
-type User struct {
+type ReviewRotation struct {
+	TeamID    int32
+	StartDate pgtype.Date
+	MemberIds []string
+	UpdatedAt pgtype.Timestamptz
+}
+
+type ReviewRotationOverride struct {
+	TeamID    int32
+	WeekStart pgtype.Date
 	UserID    string
-	Username  string
+}
+
+type ReviewRule struct {
+	RuleName       string
+	Position       int32
+	Enabled        bool
+	Labels         []string
+	Repositories   []string
+	TeamID         pgtype.Int4
+	Reviewers      pgtype.Int4
+	RequiredSkills []string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type ReviewerPreference struct {
+	TeamID     int32
+	AuthorID   string
+	ReviewerID string
+	Weight     int32
+}
+
+type SavedFilter struct {
+	FilterID       int64
+	FilterName     string
+	UserID         pgtype.Text
+	TeamID         pgtype.Int4
+	Status         string
+	AuthorID       string
+	ReviewerID     string
+	TeamName       string
+	RepositoryName string
+	Priority       string
+	Label          string
+	MinAgeHours    int32
+	Unreviewed     bool
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type SlackAccount struct {
+	UserID      string
+	SlackUserID string
+}
+
+type Team struct {
+	TeamID                          int32
+	TeamName                        string
+	IsActive                        bool
+	ParentTeamID                    pgtype.Int4
+	EscalateToParent                bool
+	RequiredReviewerRole            string
+	LeadUserID                      pgtype.Text
+	EscalationNotifyAfterHours      int32
+	EscalationAddReviewerAfterHours int32
+	DeactivateAt                    pgtype.Timestamptz
+	ReviewCooldownPrs               int32
+	ChecklistItems                  []string
+	ChecklistRequired               bool
+	AllowDuplicateUsernames         bool
+	AllowSelfReview                 bool
+	OptionalReviewers               int32
+	ShadowReviewPercent             int32
+}
+
+type TeamInactiveReassignOptOut struct {
+	TeamID     int32
+	OptedOutAt pgtype.Timestamptz
+}
+
+type TeamPrQuota struct {
+	TeamID     int32
+	MaxOpenPrs int32
+	Mode       string
+}
+
+type TeamReportSchedule struct {
+	TeamID     int32
+	Weekday    int16
+	Hour       int16
+	Timezone   string
+	CreatedAt  pgtype.Timestamptz
+	LastSentAt pgtype.Timestamptz
+}
+
+type TeamReviewBudget struct {
+	TeamID           int32
+	ReviewsPerSprint int32
+	SprintDays       int32
+	SprintStartedAt  pgtype.Timestamptz
+	AlertedAt        pgtype.Timestamptz
+}
+
+type TeamReviewStat struct {
+	TeamID        int32
+	OpenReviews   int64
+	MergedReviews int64
+}
+
+type TeamReviewerPool struct {
+	TeamID int32
+	UserID string
+}
+
+type TeamSetting struct {
+	TeamID            int32
+	MaxReviewers      pgtype.Int4
+	SelectionStrategy pgtype.Text
+	ReviewSlaHours    pgtype.Int4
+	MaxOpenReviews    pgtype.Int4
+	AutoMerge         pgtype.Bool
+	ShadowReviews     pgtype.Bool
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type TeamSizeRule struct {
+	TeamID          int32
+	Position        int32
+	MaxLinesChanged pgtype.Int4
+	MaxFilesChanged pgtype.Int4
+	Reviewers       int32
+}
+
+type TeamStatsHistory struct {
+	SnapshotDate  pgtype.Date
+	TeamID        int32
+	ActiveMembers int32
+	OpenReviews   int64
+	MergedReviews int64
+}
+
+type UnassignedPrPeriod struct {
+	ID        int64
+	PrID      string
 	TeamID    int32
-	IsActive  bool
-	CreatedAt pgtype.Timestamptz
+	StartedAt pgtype.Timestamptz
+	EndedAt   pgtype.Timestamptz
+}
+
+type User struct {
+	UserID     string
+	Username   string
+	TeamID     int32
+	IsActive   bool
+	CreatedAt  pgtype.Timestamptz
+	Role       string
+	Skills     []string
+	InTraining bool
+}
+
+type UserReviewBudget struct {
+	UserID string
+	Used   int32
+}
+
+type UserReviewStat struct {
+	UserID        string
+	TotalReviews  int64
+	OpenReviews   int64
+	MergedReviews int64
+}
+
+type UserStatsHistory struct {
+	SnapshotDate  pgtype.Date
+	UserID        string
+	TeamID        pgtype.Int4
+	TotalReviews  int64
+	OpenReviews   int64
+	MergedReviews int64
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	NotificationID pgtype.Int8
+	UserID         pgtype.Text
+	Url            string
+	RequestBody    string
+	StatusCode     pgtype.Int4
+	ResponseBody   string
+	Error          string
+	DurationMs     int32
+	RedeliveryOf   pgtype.Int8
+	AttemptedAt    pgtype.Timestamptz
 }
@@ -7,79 +7,251 @@ package models
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const ackReview = `-- name: AckReview :execrows
+UPDATE review_assignments
+SET acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2
+`
+
+type AckReviewParams struct {
+	PrID   string
+	UserID string
+}
+
+func (q *Queries) AckReview(ctx context.Context, arg AckReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, ackReview, arg.PrID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const addReviewerToPR = `-- name: AddReviewerToPR :exec
-INSERT INTO review_assignments (pr_id, user_id)
-VALUES ($1, $2)
+INSERT INTO review_assignments (pr_id, user_id, optional)
+VALUES ($1, $2, $3)
 `
 
 type AddReviewerToPRParams struct {
+	PrID     string
+	UserID   string
+	Optional bool
+}
+
+func (q *Queries) AddReviewerToPR(ctx context.Context, arg AddReviewerToPRParams) error {
+	_, err := q.db.Exec(ctx, addReviewerToPR, arg.PrID, arg.UserID, arg.Optional)
+	return err
+}
+
+const addShadowReviewer = `-- name: AddShadowReviewer :exec
+INSERT INTO shadow_reviews (pr_id, user_id)
+VALUES ($1, $2)
+`
+
+type AddShadowReviewerParams struct {
 	PrID   string
 	UserID string
 }
 
-func (q *Queries) AddReviewerToPR(ctx context.Context, arg AddReviewerToPRParams) error {
-	_, err := q.db.Exec(ctx, addReviewerToPR, arg.PrID, arg.UserID)
+func (q *Queries) AddShadowReviewer(ctx context.Context, arg AddShadowReviewerParams) error {
+	_, err := q.db.Exec(ctx, addShadowReviewer, arg.PrID, arg.UserID)
 	return err
 }
 
-const countMergedReviewsByTeam = `-- name: CountMergedReviewsByTeam :one
-SELECT COUNT(pr.pr_id)
+const approveReview = `-- name: ApproveReview :execrows
+UPDATE review_assignments
+SET approved_at = COALESCE(approved_at, NOW()),
+    changes_requested_at = NULL,
+    reviewed_at = COALESCE(reviewed_at, NOW()),
+    acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2
+`
+
+type ApproveReviewParams struct {
+	PrID   string
+	UserID string
+}
+
+// An approval settles the reviewer's earlier request for changes.
+func (q *Queries) ApproveReview(ctx context.Context, arg ApproveReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveReview, arg.PrID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const closePR = `-- name: ClosePR :one
+UPDATE pull_requests
+SET status = 'CLOSED',
+    closed_at = NOW()
+WHERE pr_id = $1 AND status NOT IN ('MERGED', 'CLOSED')
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
+`
+
+func (q *Queries) ClosePR(ctx context.Context, prID string) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, closePR, prID)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const copyPRsToArchive = `-- name: CopyPRsToArchive :execrows
+INSERT INTO pull_requests_archive (pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by)
+SELECT pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by
+FROM pull_requests
+WHERE pr_id = ANY($1::text[])
+`
+
+func (q *Queries) CopyPRsToArchive(ctx context.Context, dollar_1 []string) (int64, error) {
+	result, err := q.db.Exec(ctx, copyPRsToArchive, dollar_1)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const copyReviewAssignmentsToArchive = `-- name: CopyReviewAssignmentsToArchive :exec
+INSERT INTO review_assignments_archive (pr_id, user_id, approved_at, assigned_at, acked_at, changes_requested_at, reviewed_at, optional)
+SELECT pr_id, user_id, approved_at, assigned_at, acked_at, changes_requested_at, reviewed_at, optional
+FROM review_assignments
+WHERE pr_id = ANY($1::text[])
+`
+
+func (q *Queries) CopyReviewAssignmentsToArchive(ctx context.Context, dollar_1 []string) error {
+	_, err := q.db.Exec(ctx, copyReviewAssignmentsToArchive, dollar_1)
+	return err
+}
+
+const countFilteredPRs = `-- name: CountFilteredPRs :one
+SELECT COUNT(*)
 FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-JOIN users u ON ra.user_id = u.user_id
-WHERE u.team_id = $1 AND pr.status = 'MERGED'
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE ($1::text = '' OR pr.status::text = $1::text)
+  AND ($2::text = '' OR pr.author_id = $2::text)
+  AND ($3::text = '' OR t.team_name = $3::text)
+  AND ($4::text = '' OR pr.repository_name = $4::text)
+  AND ($5::text = '' OR pr.priority::text = $5::text)
+  AND ($6::text = '' OR $6::text = ANY(pr.labels))
+  AND ($7::int = 0 OR pr.created_at <= NOW() - make_interval(hours => $7::int))
+  AND ($8::text = '' OR EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND ra.user_id = $8::text
+          AND (NOT $9::bool OR (ra.approved_at IS NULL AND ra.changes_requested_at IS NULL))))
+  AND (NOT $9::bool OR $8::text <> '' OR NOT EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND (ra.approved_at IS NOT NULL OR ra.changes_requested_at IS NOT NULL)))
 `
 
-func (q *Queries) CountMergedReviewsByTeam(ctx context.Context, teamID int32) (int64, error) {
-	row := q.db.QueryRow(ctx, countMergedReviewsByTeam, teamID)
+type CountFilteredPRsParams struct {
+	Status         string
+	AuthorID       string
+	TeamName       string
+	RepositoryName string
+	Priority       string
+	Label          string
+	MinAgeHours    int32
+	ReviewerID     string
+	Unreviewed     bool
+}
+
+func (q *Queries) CountFilteredPRs(ctx context.Context, arg CountFilteredPRsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFilteredPRs,
+		arg.Status,
+		arg.AuthorID,
+		arg.TeamName,
+		arg.RepositoryName,
+		arg.Priority,
+		arg.Label,
+		arg.MinAgeHours,
+		arg.ReviewerID,
+		arg.Unreviewed,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countMergedReviewsByTeam = `-- name: CountMergedReviewsByTeam :one
+SELECT COALESCE((SELECT merged_reviews FROM team_review_stats WHERE team_id = $1), 0)::bigint
+`
+
+func (q *Queries) CountMergedReviewsByTeam(ctx context.Context, teamID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countMergedReviewsByTeam, teamID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const countMergedReviewsByUser = `-- name: CountMergedReviewsByUser :one
-SELECT COUNT(pr.pr_id)
-FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-WHERE ra.user_id = $1 AND pr.status = 'MERGED'
+SELECT COALESCE((SELECT merged_reviews FROM user_review_stats WHERE user_id = $1), 0)::bigint
 `
 
 func (q *Queries) CountMergedReviewsByUser(ctx context.Context, userID string) (int64, error) {
 	row := q.db.QueryRow(ctx, countMergedReviewsByUser, userID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countOpenPRsByAuthor = `-- name: CountOpenPRsByAuthor :one
+SELECT COUNT(*)::int FROM pull_requests
+WHERE author_id = $1 AND status NOT IN ('MERGED', 'CLOSED')
+`
+
+// PRs of the author that are neither merged nor closed, drafts included.
+func (q *Queries) CountOpenPRsByAuthor(ctx context.Context, authorID string) (int32, error) {
+	row := q.db.QueryRow(ctx, countOpenPRsByAuthor, authorID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
 }
 
 const countOpenReviewsByTeam = `-- name: CountOpenReviewsByTeam :one
-SELECT COUNT(pr.pr_id)
-FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-JOIN users u ON ra.user_id = u.user_id
-WHERE u.team_id = $1 AND pr.status = 'OPEN'
+SELECT COALESCE((SELECT open_reviews FROM team_review_stats WHERE team_id = $1), 0)::bigint
 `
 
 func (q *Queries) CountOpenReviewsByTeam(ctx context.Context, teamID int32) (int64, error) {
 	row := q.db.QueryRow(ctx, countOpenReviewsByTeam, teamID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
 }
 
 const countOpenReviewsByUser = `-- name: CountOpenReviewsByUser :one
-SELECT COUNT(pr.pr_id)
-FROM pull_requests pr
-JOIN review_assignments ra ON pr.pr_id = ra.pr_id
-WHERE ra.user_id = $1 AND pr.status = 'OPEN'
+SELECT COALESCE((SELECT open_reviews FROM user_review_stats WHERE user_id = $1), 0)::bigint
 `
 
 func (q *Queries) CountOpenReviewsByUser(ctx context.Context, userID string) (int64, error) {
 	row := q.db.QueryRow(ctx, countOpenReviewsByUser, userID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
 }
 
 const countPRs = `-- name: CountPRs :one
@@ -93,20 +265,86 @@ func (q *Queries) CountPRs(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countReviewStats = `-- name: CountReviewStats :one
+SELECT COUNT(*) FROM user_review_stats
+WHERE total_reviews > 0
+`
+
+func (q *Queries) CountReviewStats(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countReviewStats)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchPRs = `-- name: CountSearchPRs :one
+SELECT COUNT(*)
+FROM pull_requests pr
+WHERE (setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'))
+      @@ websearch_to_tsquery('simple', $1::text)
+`
+
+func (q *Queries) CountSearchPRs(ctx context.Context, query string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchPRs, query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createChecklistItems = `-- name: CreateChecklistItems :exec
+INSERT INTO pr_checklist_items (pr_id, position, label)
+SELECT $1, t.ord - 1, t.label
+FROM unnest($2::text[]) WITH ORDINALITY AS t(label, ord)
+`
+
+type CreateChecklistItemsParams struct {
+	PrID   string
+	Labels []string
+}
+
+func (q *Queries) CreateChecklistItems(ctx context.Context, arg CreateChecklistItemsParams) error {
+	_, err := q.db.Exec(ctx, createChecklistItems, arg.PrID, arg.Labels)
+	return err
+}
+
 const createPR = `-- name: CreatePR :one
-INSERT INTO pull_requests (pr_id, pr_name, author_id)
-VALUES ($1, $2, $3)
-RETURNING pr_id, pr_name, author_id, status, created_at, merged_at
+INSERT INTO pull_requests (pr_id, pr_name, author_id, required_skills, description, auto_merge, priority, repository_name, lines_changed, files_changed, external_id, labels, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
 `
 
 type CreatePRParams struct {
-	PrID     string
-	PrName   string
-	AuthorID string
+	PrID           string
+	PrName         string
+	AuthorID       string
+	RequiredSkills []string
+	Description    string
+	AutoMerge      bool
+	Priority       PrPriority
+	RepositoryName pgtype.Text
+	LinesChanged   pgtype.Int4
+	FilesChanged   pgtype.Int4
+	ExternalID     pgtype.Text
+	Labels         []string
+	Status         PrStatus
 }
 
 func (q *Queries) CreatePR(ctx context.Context, arg CreatePRParams) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, createPR, arg.PrID, arg.PrName, arg.AuthorID)
+	row := q.db.QueryRow(ctx, createPR,
+		arg.PrID,
+		arg.PrName,
+		arg.AuthorID,
+		arg.RequiredSkills,
+		arg.Description,
+		arg.AutoMerge,
+		arg.Priority,
+		arg.RepositoryName,
+		arg.LinesChanged,
+		arg.FilesChanged,
+		arg.ExternalID,
+		arg.Labels,
+		arg.Status,
+	)
 	var i PullRequest
 	err := row.Scan(
 		&i.PrID,
@@ -115,48 +353,112 @@ func (q *Queries) CreatePR(ctx context.Context, arg CreatePRParams) (PullRequest
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
 	)
 	return i, err
 }
 
-const findReplacementCandidates = `-- name: FindReplacementCandidates :many
-SELECT u.user_id, u.username, u.team_id, u.is_active, u.created_at
-FROM users u
-WHERE u.team_id = $1
-  AND u.is_active = true
-  AND u.user_id != $2       -- Not author
-  AND u.user_id != ALL($3::varchar[]) -- Not those in arr
-ORDER BY random()
-LIMIT $4
+const deletePRs = `-- name: DeletePRs :execrows
+DELETE FROM pull_requests
+WHERE pr_id = ANY($1::text[])
 `
 
-type FindReplacementCandidatesParams struct {
-	TeamID  int32
-	UserID  string
-	Column3 []string
-	Limit   int32
+func (q *Queries) DeletePRs(ctx context.Context, dollar_1 []string) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePRs, dollar_1)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-func (q *Queries) FindReplacementCandidates(ctx context.Context, arg FindReplacementCandidatesParams) ([]User, error) {
-	rows, err := q.db.Query(ctx, findReplacementCandidates,
-		arg.TeamID,
-		arg.UserID,
-		arg.Column3,
-		arg.Limit,
+const filterPRs = `-- name: FilterPRs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.priority
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE ($1::text = '' OR pr.status::text = $1::text)
+  AND ($2::text = '' OR pr.author_id = $2::text)
+  AND ($3::text = '' OR t.team_name = $3::text)
+  AND ($4::text = '' OR pr.repository_name = $4::text)
+  AND ($5::text = '' OR pr.priority::text = $5::text)
+  AND ($6::text = '' OR $6::text = ANY(pr.labels))
+  AND ($7::int = 0 OR pr.created_at <= NOW() - make_interval(hours => $7::int))
+  AND ($8::text = '' OR EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND ra.user_id = $8::text
+          AND (NOT $9::bool OR (ra.approved_at IS NULL AND ra.changes_requested_at IS NULL))))
+  AND (NOT $9::bool OR $8::text <> '' OR NOT EXISTS (
+        SELECT 1 FROM review_assignments ra
+        WHERE ra.pr_id = pr.pr_id AND (ra.approved_at IS NOT NULL OR ra.changes_requested_at IS NOT NULL)))
+ORDER BY pr.status, pr.priority DESC, pr.created_at, pr.pr_id
+LIMIT $11 OFFSET $10
+`
+
+type FilterPRsParams struct {
+	Status         string
+	AuthorID       string
+	TeamName       string
+	RepositoryName string
+	Priority       string
+	Label          string
+	MinAgeHours    int32
+	ReviewerID     string
+	Unreviewed     bool
+	ResultOffset   int32
+	ResultLimit    int32
+}
+
+type FilterPRsRow struct {
+	PrID     string
+	PrName   string
+	AuthorID string
+	Status   PrStatus
+	Priority PrPriority
+}
+
+// PRs matching every set filter, open first, then more urgent and older ones.
+// Empty filters match any PR. Unreviewed keeps PRs without a verdict from the
+// reviewer, or from any reviewer when no reviewer is given.
+func (q *Queries) FilterPRs(ctx context.Context, arg FilterPRsParams) ([]FilterPRsRow, error) {
+	rows, err := q.db.Query(ctx, filterPRs,
+		arg.Status,
+		arg.AuthorID,
+		arg.TeamName,
+		arg.RepositoryName,
+		arg.Priority,
+		arg.Label,
+		arg.MinAgeHours,
+		arg.ReviewerID,
+		arg.Unreviewed,
+		arg.ResultOffset,
+		arg.ResultLimit,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []User
+	var items []FilterPRsRow
 	for rows.Next() {
-		var i User
+		var i FilterPRsRow
 		if err := rows.Scan(
-			&i.UserID,
-			&i.Username,
-			&i.TeamID,
-			&i.IsActive,
-			&i.CreatedAt,
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Status,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -168,8 +470,74 @@ func (q *Queries) FindReplacementCandidates(ctx context.Context, arg FindReplace
 	return items, nil
 }
 
+const getApprovalState = `-- name: GetApprovalState :one
+SELECT COUNT(*) FILTER (WHERE approved_at IS NOT NULL) AS approved,
+       COUNT(*) AS total
+FROM review_assignments
+WHERE pr_id = $1 AND NOT optional
+`
+
+type GetApprovalStateRow struct {
+	Approved int64
+	Total    int64
+}
+
+// Optional reviewers do not count.
+func (q *Queries) GetApprovalState(ctx context.Context, prID string) (GetApprovalStateRow, error) {
+	row := q.db.QueryRow(ctx, getApprovalState, prID)
+	var i GetApprovalStateRow
+	err := row.Scan(&i.Approved, &i.Total)
+	return i, err
+}
+
+const getAuthorPRStats = `-- name: GetAuthorPRStats :one
+SELECT
+    COUNT(*)::bigint AS opened_count,
+    COUNT(*) FILTER (WHERE p.status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED')::bigint AS merged_count,
+    COALESCE(AVG(p.reviewer_count), 0)::float8 AS avg_reviewers,
+    COALESCE(AVG(EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS avg_merge_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS median_merge_seconds
+FROM (
+    SELECT pr.status, pr.created_at, pr.merged_at,
+           (SELECT COUNT(*) FROM review_assignments ra WHERE ra.pr_id = pr.pr_id) AS reviewer_count
+    FROM pull_requests pr
+    WHERE pr.author_id = $1
+    UNION ALL
+    SELECT pa.status, pa.created_at, pa.merged_at,
+           (SELECT COUNT(*) FROM review_assignments_archive raa WHERE raa.pr_id = pa.pr_id) AS reviewer_count
+    FROM pull_requests_archive pa
+    WHERE pa.author_id = $1
+) p
+`
+
+type GetAuthorPRStatsRow struct {
+	OpenedCount        int64
+	OpenCount          int64
+	MergedCount        int64
+	AvgReviewers       float64
+	AvgMergeSeconds    float64
+	MedianMergeSeconds float64
+}
+
+// PR counts, reviewers per PR and time to merge of the PRs the user opened,
+// archived PRs included. The merge durations are 0 when no PR was merged.
+func (q *Queries) GetAuthorPRStats(ctx context.Context, authorID string) (GetAuthorPRStatsRow, error) {
+	row := q.db.QueryRow(ctx, getAuthorPRStats, authorID)
+	var i GetAuthorPRStatsRow
+	err := row.Scan(
+		&i.OpenedCount,
+		&i.OpenCount,
+		&i.MergedCount,
+		&i.AvgReviewers,
+		&i.AvgMergeSeconds,
+		&i.MedianMergeSeconds,
+	)
+	return i, err
+}
+
 const getAuthorTeamByPR = `-- name: GetAuthorTeamByPR :one
-SELECT t.team_id, t.team_name, t.is_active
+SELECT t.team_id, t.team_name, t.is_active, t.parent_team_id, t.escalate_to_parent, t.required_reviewer_role, t.lead_user_id, t.escalation_notify_after_hours, t.escalation_add_reviewer_after_hours, t.deactivate_at, t.review_cooldown_prs, t.checklist_items, t.checklist_required, t.allow_duplicate_usernames, t.allow_self_review, t.optional_reviewers, t.shadow_review_percent
 FROM teams t
 JOIN users u ON t.team_id = u.team_id
 JOIN pull_requests pr ON u.user_id = pr.author_id
@@ -179,12 +547,63 @@ WHERE pr.pr_id = $1
 func (q *Queries) GetAuthorTeamByPR(ctx context.Context, prID string) (Team, error) {
 	row := q.db.QueryRow(ctx, getAuthorTeamByPR, prID)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
 	return i, err
 }
 
+const getChecklistItems = `-- name: GetChecklistItems :many
+SELECT pr_id, position, label, checked, checked_by, checked_at FROM pr_checklist_items
+WHERE pr_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetChecklistItems(ctx context.Context, prID string) ([]PrChecklistItem, error) {
+	rows, err := q.db.Query(ctx, getChecklistItems, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrChecklistItem
+	for rows.Next() {
+		var i PrChecklistItem
+		if err := rows.Scan(
+			&i.PrID,
+			&i.Position,
+			&i.Label,
+			&i.Checked,
+			&i.CheckedBy,
+			&i.CheckedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOpenPRsWithoutReviewers = `-- name: GetOpenPRsWithoutReviewers :many
-SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.required_skills, pr.description, pr.auto_merge, pr.lead_notified_at, pr.reviewer_escalated_at, pr.priority, pr.repository_name, pr.lines_changed, pr.files_changed, pr.external_id, pr.labels, pr.merged_by, pr.reassigned_by, pr.closed_at
 FROM pull_requests pr
 LEFT JOIN review_assignments ra ON pr.pr_id = ra.pr_id
 WHERE pr.status = 'OPEN'
@@ -208,6 +627,68 @@ func (q *Queries) GetOpenPRsWithoutReviewers(ctx context.Context) ([]PullRequest
 			&i.Status,
 			&i.CreatedAt,
 			&i.MergedAt,
+			&i.RequiredSkills,
+			&i.Description,
+			&i.AutoMerge,
+			&i.LeadNotifiedAt,
+			&i.ReviewerEscalatedAt,
+			&i.Priority,
+			&i.RepositoryName,
+			&i.LinesChanged,
+			&i.FilesChanged,
+			&i.ExternalID,
+			&i.Labels,
+			&i.MergedBy,
+			&i.ReassignedBy,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenPRsWithoutReviewersByIDs = `-- name: GetOpenPRsWithoutReviewersByIDs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.required_skills, pr.priority, pr.repository_name, pr.labels
+FROM pull_requests pr
+WHERE pr.pr_id = ANY($1::varchar[]) AND pr.status = 'OPEN'
+  AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id)
+ORDER BY pr.priority DESC, pr.created_at, pr.pr_id
+`
+
+type GetOpenPRsWithoutReviewersByIDsRow struct {
+	PrID           string
+	PrName         string
+	AuthorID       string
+	Status         PrStatus
+	RequiredSkills []string
+	Priority       PrPriority
+	RepositoryName pgtype.Text
+	Labels         []string
+}
+
+func (q *Queries) GetOpenPRsWithoutReviewersByIDs(ctx context.Context, prIds []string) ([]GetOpenPRsWithoutReviewersByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getOpenPRsWithoutReviewersByIDs, prIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOpenPRsWithoutReviewersByIDsRow
+	for rows.Next() {
+		var i GetOpenPRsWithoutReviewersByIDsRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Status,
+			&i.RequiredSkills,
+			&i.Priority,
+			&i.RepositoryName,
+			&i.Labels,
 		); err != nil {
 			return nil, err
 		}
@@ -223,8 +704,9 @@ const getOpenReviewsForUsers = `-- name: GetOpenReviewsForUsers :many
 SELECT ra.pr_id, ra.user_id, pr.author_id
 FROM review_assignments ra
 JOIN pull_requests pr ON ra.pr_id = pr.pr_id
-WHERE pr.status = 'OPEN'
+WHERE pr.status NOT IN ('MERGED', 'CLOSED')
   AND ra.user_id = ANY($1::text[])
+ORDER BY ra.pr_id, ra.user_id
 `
 
 type GetOpenReviewsForUsersRow struct {
@@ -253,8 +735,89 @@ func (q *Queries) GetOpenReviewsForUsers(ctx context.Context, dollar_1 []string)
 	return items, nil
 }
 
+const getOrgSummary = `-- name: GetOrgSummary :one
+SELECT
+    (SELECT COUNT(*) FROM pull_requests WHERE status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    (SELECT COUNT(*) FROM pull_requests pr
+     WHERE pr.status = 'OPEN' AND NOT EXISTS (SELECT 1 FROM review_assignments ra WHERE ra.pr_id = pr.pr_id))::bigint AS unassigned_count,
+    (SELECT COUNT(*) FROM users u JOIN teams t ON t.team_id = u.team_id WHERE u.is_active AND t.is_active)::bigint AS active_users,
+    COUNT(*)::bigint AS merged_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM m.merged_at - m.created_at)), 0)::float8 AS avg_merge_seconds
+FROM (
+    SELECT pr.created_at, pr.merged_at
+    FROM pull_requests pr
+    WHERE pr.status = 'MERGED' AND pr.merged_at >= $1::timestamptz AND pr.merged_at < $2::timestamptz
+    UNION ALL
+    SELECT pa.created_at, pa.merged_at
+    FROM pull_requests_archive pa
+    WHERE pa.status = 'MERGED' AND pa.merged_at >= $1::timestamptz AND pa.merged_at < $2::timestamptz
+) m
+`
+
+type GetOrgSummaryParams struct {
+	Since pgtype.Timestamptz
+	Until pgtype.Timestamptz
+}
+
+type GetOrgSummaryRow struct {
+	OpenCount       int64
+	UnassignedCount int64
+	ActiveUsers     int64
+	MergedCount     int64
+	AvgMergeSeconds float64
+}
+
+// Org-wide top line: open PRs (drafts included), open PRs without reviewers,
+// active users of active teams and the PRs merged within [since, until) with
+// their average time from creation to merge, archived PRs included.
+func (q *Queries) GetOrgSummary(ctx context.Context, arg GetOrgSummaryParams) (GetOrgSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getOrgSummary, arg.Since, arg.Until)
+	var i GetOrgSummaryRow
+	err := row.Scan(
+		&i.OpenCount,
+		&i.UnassignedCount,
+		&i.ActiveUsers,
+		&i.MergedCount,
+		&i.AvgMergeSeconds,
+	)
+	return i, err
+}
+
+const getPRByExternalID = `-- name: GetPRByExternalID :one
+SELECT pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at FROM pull_requests
+WHERE external_id = $1
+`
+
+func (q *Queries) GetPRByExternalID(ctx context.Context, externalID pgtype.Text) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, getPRByExternalID, externalID)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
 const getPRByID = `-- name: GetPRByID :one
-SELECT pr_id, pr_name, author_id, status, created_at, merged_at FROM pull_requests
+SELECT pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at FROM pull_requests
 WHERE pr_id = $1
 `
 
@@ -268,38 +831,1194 @@ func (q *Queries) GetPRByID(ctx context.Context, prID string) (PullRequest, erro
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getPRWithReviewers = `-- name: GetPRWithReviewers :one
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.required_skills, pr.description, pr.auto_merge, pr.lead_notified_at, pr.reviewer_escalated_at, pr.priority, pr.repository_name, pr.lines_changed, pr.files_changed, pr.external_id, pr.labels, pr.merged_by, pr.reassigned_by, pr.closed_at,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'user_id', u.user_id, 'username', u.username, 'optional', ra.optional,
+                    'approved_at', ra.approved_at, 'changes_requested_at', ra.changes_requested_at))
+                 FROM review_assignments ra
+                 JOIN users u ON u.user_id = ra.user_id
+                 WHERE ra.pr_id = pr.pr_id), '[]')::jsonb AS reviewers,
+       COALESCE((SELECT json_agg(sr.user_id ORDER BY sr.user_id)
+                 FROM shadow_reviews sr WHERE sr.pr_id = pr.pr_id), '[]')::jsonb AS shadow_reviewers,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'position', c.position, 'label', c.label, 'checked', c.checked,
+                    'checked_by', c.checked_by, 'checked_at', c.checked_at) ORDER BY c.position)
+                 FROM pr_checklist_items c WHERE c.pr_id = pr.pr_id), '[]')::jsonb AS checklist
+FROM pull_requests pr
+WHERE pr.pr_id = $1
+`
+
+type GetPRWithReviewersRow struct {
+	PullRequest     PullRequest
+	Reviewers       []byte
+	ShadowReviewers []byte
+	Checklist       []byte
+}
+
+// The PR with its reviewers (and their verdicts), shadow reviewers and
+// checklist as JSON arrays, in one round trip.
+func (q *Queries) GetPRWithReviewers(ctx context.Context, prID string) (GetPRWithReviewersRow, error) {
+	row := q.db.QueryRow(ctx, getPRWithReviewers, prID)
+	var i GetPRWithReviewersRow
+	err := row.Scan(
+		&i.PullRequest.PrID,
+		&i.PullRequest.PrName,
+		&i.PullRequest.AuthorID,
+		&i.PullRequest.Status,
+		&i.PullRequest.CreatedAt,
+		&i.PullRequest.MergedAt,
+		&i.PullRequest.RequiredSkills,
+		&i.PullRequest.Description,
+		&i.PullRequest.AutoMerge,
+		&i.PullRequest.LeadNotifiedAt,
+		&i.PullRequest.ReviewerEscalatedAt,
+		&i.PullRequest.Priority,
+		&i.PullRequest.RepositoryName,
+		&i.PullRequest.LinesChanged,
+		&i.PullRequest.FilesChanged,
+		&i.PullRequest.ExternalID,
+		&i.PullRequest.Labels,
+		&i.PullRequest.MergedBy,
+		&i.PullRequest.ReassignedBy,
+		&i.PullRequest.ClosedAt,
+		&i.Reviewers,
+		&i.ShadowReviewers,
+		&i.Checklist,
 	)
 	return i, err
 }
 
 const getPRsForReviewer = `-- name: GetPRsForReviewer :many
-SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.required_skills, pr.priority, pr.repository_name, pr.labels
 FROM pull_requests pr
 JOIN review_assignments ra ON pr.pr_id = ra.pr_id
 WHERE ra.user_id = $1
+ORDER BY pr.status, pr.priority DESC, pr.created_at, pr.pr_id
 `
 
 type GetPRsForReviewerRow struct {
-	PrID     string
-	PrName   string
+	PrID           string
+	PrName         string
+	AuthorID       string
+	Status         PrStatus
+	RequiredSkills []string
+	Priority       PrPriority
+	RepositoryName pgtype.Text
+	Labels         []string
+}
+
+func (q *Queries) GetPRsForReviewer(ctx context.Context, userID string) ([]GetPRsForReviewerRow, error) {
+	rows, err := q.db.Query(ctx, getPRsForReviewer, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPRsForReviewerRow
+	for rows.Next() {
+		var i GetPRsForReviewerRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Status,
+			&i.RequiredSkills,
+			&i.Priority,
+			&i.RepositoryName,
+			&i.Labels,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentReviewersOfAuthor = `-- name: GetRecentReviewersOfAuthor :many
+SELECT DISTINCT ra.user_id
+FROM review_assignments ra
+WHERE ra.pr_id IN (
+    SELECT pr.pr_id
+    FROM pull_requests pr
+    WHERE pr.author_id = $1
+      AND pr.pr_id <> $2
+    ORDER BY pr.created_at DESC, pr.pr_id DESC
+    LIMIT $3
+)
+`
+
+type GetRecentReviewersOfAuthorParams struct {
+	AuthorID    string
+	ExcludePrID string
+	PrCount     int32
+}
+
+func (q *Queries) GetRecentReviewersOfAuthor(ctx context.Context, arg GetRecentReviewersOfAuthorParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRecentReviewersOfAuthor, arg.AuthorID, arg.ExcludePrID, arg.PrCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoryPRStats = `-- name: GetRepositoryPRStats :one
+SELECT
+    COUNT(*) FILTER (WHERE p.status NOT IN ('MERGED', 'CLOSED'))::bigint AS open_count,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED')::bigint AS merged_count,
+    COALESCE(AVG(p.reviewer_count), 0)::float8 AS avg_reviewers,
+    COALESCE(AVG(EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS avg_merge_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM p.merged_at - p.created_at)) FILTER (WHERE p.status = 'MERGED'), 0)::float8 AS median_merge_seconds
+FROM (
+    SELECT pr.status, pr.created_at, pr.merged_at,
+           (SELECT COUNT(*) FROM review_assignments ra WHERE ra.pr_id = pr.pr_id) AS reviewer_count
+    FROM pull_requests pr
+    WHERE pr.repository_name = $1
+    UNION ALL
+    SELECT pa.status, pa.created_at, pa.merged_at,
+           (SELECT COUNT(*) FROM review_assignments_archive raa WHERE raa.pr_id = pa.pr_id) AS reviewer_count
+    FROM pull_requests_archive pa
+    WHERE pa.repository_name = $1
+) p
+`
+
+type GetRepositoryPRStatsRow struct {
+	OpenCount          int64
+	MergedCount        int64
+	AvgReviewers       float64
+	AvgMergeSeconds    float64
+	MedianMergeSeconds float64
+}
+
+// PR counts, reviewers per PR and time to merge of a repository, archived PRs
+// included. The merge durations are 0 when no PR was merged.
+func (q *Queries) GetRepositoryPRStats(ctx context.Context, repositoryName pgtype.Text) (GetRepositoryPRStatsRow, error) {
+	row := q.db.QueryRow(ctx, getRepositoryPRStats, repositoryName)
+	var i GetRepositoryPRStatsRow
+	err := row.Scan(
+		&i.OpenCount,
+		&i.MergedCount,
+		&i.AvgReviewers,
+		&i.AvgMergeSeconds,
+		&i.MedianMergeSeconds,
+	)
+	return i, err
+}
+
+const getRequiredReviewersForPR = `-- name: GetRequiredReviewersForPR :many
+SELECT u.user_id, u.username, u.team_id, u.is_active, u.created_at, u.role, u.skills, u.in_training
+FROM users u
+JOIN review_assignments ra ON u.user_id = ra.user_id
+WHERE ra.pr_id = $1 AND NOT ra.optional
+`
+
+func (q *Queries) GetRequiredReviewersForPR(ctx context.Context, prID string) ([]User, error) {
+	rows, err := q.db.Query(ctx, getRequiredReviewersForPR, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamID,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewStats = `-- name: GetReviewStats :many
+SELECT s.user_id, u.username, s.total_reviews AS review_count,
+       COALESCE(a.acked_count, 0)::bigint AS acked_count,
+       COALESCE(a.avg_ack_seconds, 0)::double precision AS avg_ack_seconds
+FROM user_review_stats s
+JOIN users u ON u.user_id = s.user_id
+LEFT JOIN (
+    SELECT acks.user_id, COUNT(*) AS acked_count,
+           AVG(EXTRACT(EPOCH FROM acks.acked_at - acks.assigned_at)) AS avg_ack_seconds
+    FROM (
+        SELECT user_id, assigned_at, acked_at FROM review_assignments
+        UNION ALL
+        SELECT user_id, assigned_at, acked_at FROM review_assignments_archive
+    ) acks
+    WHERE acks.acked_at IS NOT NULL AND acks.assigned_at IS NOT NULL
+    GROUP BY acks.user_id
+) a ON a.user_id = s.user_id
+WHERE s.total_reviews > 0
+ORDER BY CASE WHEN $1::text = 'username' THEN NULL ELSE s.total_reviews END DESC NULLS LAST,
+         u.username, s.user_id
+LIMIT NULLIF($2::int, 0) OFFSET $3::int
+`
+
+type GetReviewStatsParams struct {
+	OrderBy      string
+	ResultLimit  int32
+	ResultOffset int32
+}
+
+type GetReviewStatsRow struct {
+	UserID        string
+	Username      string
+	ReviewCount   int64
+	AckedCount    int64
+	AvgAckSeconds float64
+}
+
+// Ack latency covers archived assignments too; those archived before
+// assignment times were recorded are skipped. Users with more reviews come
+// first unless order_by is 'username'; ties go by username, then user ID, so
+// pages are stable. A zero limit returns every user.
+func (q *Queries) GetReviewStats(ctx context.Context, arg GetReviewStatsParams) ([]GetReviewStatsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewStats, arg.OrderBy, arg.ResultLimit, arg.ResultOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewStatsRow
+	for rows.Next() {
+		var i GetReviewStatsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.ReviewCount,
+			&i.AckedCount,
+			&i.AvgAckSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewerTurnaround = `-- name: GetReviewerTurnaround :one
+SELECT
+    COUNT(*) FILTER (WHERE a.reviewed_at IS NOT NULL)::bigint AS reviewed_count,
+    COUNT(*) FILTER (WHERE a.reviewed_at IS NULL AND a.open)::bigint AS pending_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM a.reviewed_at - a.assigned_at)), 0)::float8 AS avg_seconds,
+    COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM a.reviewed_at - a.assigned_at)), 0)::float8 AS median_seconds
+FROM (
+    SELECT ra.assigned_at, ra.reviewed_at, pr.status NOT IN ('MERGED', 'CLOSED') AS open
+    FROM review_assignments ra
+    JOIN pull_requests pr ON pr.pr_id = ra.pr_id
+    WHERE ra.user_id = $1
+      AND ra.assigned_at >= $2::timestamptz AND ra.assigned_at < $3::timestamptz
+    UNION ALL
+    SELECT raa.assigned_at, raa.reviewed_at, false
+    FROM review_assignments_archive raa
+    WHERE raa.user_id = $1
+      AND raa.assigned_at >= $2::timestamptz AND raa.assigned_at < $3::timestamptz
+) a
+`
+
+type GetReviewerTurnaroundParams struct {
+	UserID string
+	Since  pgtype.Timestamptz
+	Until  pgtype.Timestamptz
+}
+
+type GetReviewerTurnaroundRow struct {
+	ReviewedCount int64
+	PendingCount  int64
+	AvgSeconds    float64
+	MedianSeconds float64
+}
+
+// Time from assignment to the first verdict of the user's reviews assigned
+// within [since, until), archived assignments included. Pending counts reviews
+// of open PRs still waiting for a verdict.
+func (q *Queries) GetReviewerTurnaround(ctx context.Context, arg GetReviewerTurnaroundParams) (GetReviewerTurnaroundRow, error) {
+	row := q.db.QueryRow(ctx, getReviewerTurnaround, arg.UserID, arg.Since, arg.Until)
+	var i GetReviewerTurnaroundRow
+	err := row.Scan(
+		&i.ReviewedCount,
+		&i.PendingCount,
+		&i.AvgSeconds,
+		&i.MedianSeconds,
+	)
+	return i, err
+}
+
+const getReviewersForPR = `-- name: GetReviewersForPR :many
+SELECT u.user_id, u.username, u.team_id, u.is_active, u.created_at, u.role, u.skills, u.in_training
+FROM users u
+JOIN review_assignments ra ON u.user_id = ra.user_id
+WHERE ra.pr_id = $1
+`
+
+func (q *Queries) GetReviewersForPR(ctx context.Context, prID string) ([]User, error) {
+	rows, err := q.db.Query(ctx, getReviewersForPR, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamID,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const importPR = `-- name: ImportPR :one
+INSERT INTO pull_requests (pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, priority, closed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
+`
+
+type ImportPRParams struct {
+	PrID           string
+	PrName         string
+	AuthorID       string
+	Status         PrStatus
+	CreatedAt      pgtype.Timestamptz
+	MergedAt       pgtype.Timestamptz
+	RequiredSkills []string
+	Description    string
+	AutoMerge      bool
+	Priority       PrPriority
+	ClosedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) ImportPR(ctx context.Context, arg ImportPRParams) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, importPR,
+		arg.PrID,
+		arg.PrName,
+		arg.AuthorID,
+		arg.Status,
+		arg.CreatedAt,
+		arg.MergedAt,
+		arg.RequiredSkills,
+		arg.Description,
+		arg.AutoMerge,
+		arg.Priority,
+		arg.ClosedAt,
+	)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const isPRArchived = `-- name: IsPRArchived :one
+SELECT EXISTS (SELECT 1 FROM pull_requests_archive WHERE pr_id = $1)
+`
+
+func (q *Queries) IsPRArchived(ctx context.Context, prID string) (bool, error) {
+	row := q.db.QueryRow(ctx, isPRArchived, prID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listApprovedReviewers = `-- name: ListApprovedReviewers :many
+SELECT user_id
+FROM review_assignments
+WHERE pr_id = $1 AND approved_at IS NOT NULL
+ORDER BY approved_at, user_id
+`
+
+func (q *Queries) ListApprovedReviewers(ctx context.Context, prID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listApprovedReviewers, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChangesRequestedReviewers = `-- name: ListChangesRequestedReviewers :many
+SELECT user_id
+FROM review_assignments
+WHERE pr_id = $1 AND changes_requested_at IS NOT NULL
+ORDER BY changes_requested_at, user_id
+`
+
+func (q *Queries) ListChangesRequestedReviewers(ctx context.Context, prID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listChangesRequestedReviewers, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInactiveReviews = `-- name: ListInactiveReviews :many
+SELECT ra.pr_id, ra.user_id, GREATEST(ra.assigned_at, ra.acked_at)::timestamptz AS last_activity_at, pr.pr_name
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+JOIN users u ON ra.user_id = u.user_id
+WHERE pr.status = 'IN_REVIEW'
+  AND NOT ra.optional
+  AND ra.reviewed_at IS NULL
+  AND GREATEST(ra.assigned_at, ra.acked_at) <= $1::timestamptz
+  AND NOT EXISTS (
+    SELECT 1 FROM team_inactive_reassign_opt_outs o WHERE o.team_id = u.team_id
+  )
+ORDER BY last_activity_at, ra.pr_id, ra.user_id
+LIMIT $2
+`
+
+type ListInactiveReviewsParams struct {
+	InactiveSince pgtype.Timestamptz
+	BatchSize     int32
+}
+
+type ListInactiveReviewsRow struct {
+	PrID           string
+	UserID         string
+	LastActivityAt pgtype.Timestamptz
+	PrName         string
+}
+
+// Required assignments on PRs in review whose reviewer has neither approved
+// nor requested changes, and has not acknowledged them since before $1
+// either. Reviewers from teams that opted out are skipped.
+func (q *Queries) ListInactiveReviews(ctx context.Context, arg ListInactiveReviewsParams) ([]ListInactiveReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listInactiveReviews, arg.InactiveSince, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInactiveReviewsRow
+	for rows.Next() {
+		var i ListInactiveReviewsRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.UserID,
+			&i.LastActivityAt,
+			&i.PrName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMemberReviewCounts = `-- name: ListMemberReviewCounts :many
+SELECT t.team_name, u.user_id, COUNT(a.user_id)::bigint AS review_count
+FROM users u
+JOIN teams t ON t.team_id = u.team_id
+LEFT JOIN (
+    SELECT user_id FROM review_assignments
+    WHERE assigned_at >= $1::timestamptz AND assigned_at < $2::timestamptz
+    UNION ALL
+    SELECT user_id FROM review_assignments_archive
+    WHERE assigned_at >= $1::timestamptz AND assigned_at < $2::timestamptz
+) a ON a.user_id = u.user_id
+WHERE u.is_active AND t.is_active
+  AND ($3::text = '' OR t.team_name = $3::text)
+GROUP BY t.team_name, u.user_id
+ORDER BY t.team_name, u.user_id
+`
+
+type ListMemberReviewCountsParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	TeamName string
+}
+
+type ListMemberReviewCountsRow struct {
+	TeamName    string
+	UserID      string
+	ReviewCount int64
+}
+
+// Reviews assigned within [since, until) to each active member of the active
+// teams, archived assignments included. Members without reviews count as 0.
+func (q *Queries) ListMemberReviewCounts(ctx context.Context, arg ListMemberReviewCountsParams) ([]ListMemberReviewCountsRow, error) {
+	rows, err := q.db.Query(ctx, listMemberReviewCounts, arg.Since, arg.Until, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMemberReviewCountsRow
+	for rows.Next() {
+		var i ListMemberReviewCountsRow
+		if err := rows.Scan(&i.TeamName, &i.UserID, &i.ReviewCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMergeCounts = `-- name: ListMergeCounts :many
+SELECT COALESCE(m.merged_by, '')::varchar AS merged_by, COALESCE(u.username, '')::varchar AS username, COUNT(*)::bigint AS merge_count
+FROM (
+    SELECT merged_by, merged_at FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT merged_by, merged_at FROM pull_requests_archive WHERE status = 'MERGED'
+) m
+LEFT JOIN users u ON u.user_id = m.merged_by
+WHERE m.merged_at >= $1::timestamptz AND m.merged_at < $2::timestamptz
+GROUP BY m.merged_by, u.username
+ORDER BY merge_count DESC, merged_by
+`
+
+type ListMergeCountsParams struct {
+	Since pgtype.Timestamptz
+	Until pgtype.Timestamptz
+}
+
+type ListMergeCountsRow struct {
+	MergedBy   string
+	Username   string
+	MergeCount int64
+}
+
+// PRs merged within [since, until) per recorded merger, archived PRs included;
+// merged_by is ” for merges nobody was recorded for.
+func (q *Queries) ListMergeCounts(ctx context.Context, arg ListMergeCountsParams) ([]ListMergeCountsRow, error) {
+	rows, err := q.db.Query(ctx, listMergeCounts, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMergeCountsRow
+	for rows.Next() {
+		var i ListMergeCountsRow
+		if err := rows.Scan(&i.MergedBy, &i.Username, &i.MergeCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMergedPRIDsBefore = `-- name: ListMergedPRIDsBefore :many
+SELECT pr_id
+FROM pull_requests
+WHERE status = 'MERGED' AND merged_at < $1
+ORDER BY merged_at, pr_id
+LIMIT $2
+`
+
+type ListMergedPRIDsBeforeParams struct {
+	MergedAt pgtype.Timestamptz
+	Limit    int32
+}
+
+func (q *Queries) ListMergedPRIDsBefore(ctx context.Context, arg ListMergedPRIDsBeforeParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listMergedPRIDsBefore, arg.MergedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var pr_id string
+		if err := rows.Scan(&pr_id); err != nil {
+			return nil, err
+		}
+		items = append(items, pr_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenAuthoredCounts = `-- name: ListOpenAuthoredCounts :many
+SELECT author_id, COUNT(*)::bigint AS open_prs
+FROM pull_requests
+WHERE author_id = ANY($1::varchar[]) AND status NOT IN ('MERGED', 'CLOSED')
+GROUP BY author_id
+`
+
+type ListOpenAuthoredCountsRow struct {
 	AuthorID string
-	Status   PrStatus
+	OpenPrs  int64
+}
+
+func (q *Queries) ListOpenAuthoredCounts(ctx context.Context, userIds []string) ([]ListOpenAuthoredCountsRow, error) {
+	rows, err := q.db.Query(ctx, listOpenAuthoredCounts, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenAuthoredCountsRow
+	for rows.Next() {
+		var i ListOpenAuthoredCountsRow
+		if err := rows.Scan(&i.AuthorID, &i.OpenPrs); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenReviewCounts = `-- name: ListOpenReviewCounts :many
+SELECT user_id, open_reviews
+FROM user_review_stats
+WHERE user_id = ANY($1::varchar[])
+`
+
+type ListOpenReviewCountsRow struct {
+	UserID      string
+	OpenReviews int64
+}
+
+func (q *Queries) ListOpenReviewCounts(ctx context.Context, userIds []string) ([]ListOpenReviewCountsRow, error) {
+	rows, err := q.db.Query(ctx, listOpenReviewCounts, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenReviewCountsRow
+	for rows.Next() {
+		var i ListOpenReviewCountsRow
+		if err := rows.Scan(&i.UserID, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPRTimeline = `-- name: ListPRTimeline :many
+SELECT e.event_type::varchar AS event_type, e.occurred_at::timestamptz AS occurred_at,
+       COALESCE(e.user_id, '')::varchar AS user_id, COALESCE(e.replaced_by, '')::varchar AS replaced_by,
+       COALESCE(e.reason, '')::varchar AS reason, COALESCE(e.decline_reason, '')::varchar AS decline_reason,
+       COALESCE(e.actor, '')::varchar AS actor
+FROM (
+    SELECT 0 AS rank, 'created' AS event_type, pr.created_at AS occurred_at, pr.author_id AS user_id,
+           NULL::varchar AS replaced_by, NULL::varchar AS reason, NULL::varchar AS decline_reason,
+           NULL::varchar AS actor
+    FROM pull_requests pr WHERE pr.pr_id = $1
+    UNION ALL
+    SELECT 1, 'assigned', ra.assigned_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = $1
+    UNION ALL
+    SELECT 1, 'reassigned', r.reassigned_at, r.from_user_id, r.to_user_id, r.reason, r.decline_reason, r.reassigned_by
+    FROM reassignments r WHERE r.pr_id = $1
+    UNION ALL
+    SELECT 2, 'acked', ra.acked_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = $1 AND ra.acked_at IS NOT NULL
+    UNION ALL
+    SELECT 3, 'changes_requested', ra.changes_requested_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = $1 AND ra.changes_requested_at IS NOT NULL
+    UNION ALL
+    SELECT 3, 'approved', ra.approved_at, ra.user_id, NULL, NULL, NULL, NULL
+    FROM review_assignments ra WHERE ra.pr_id = $1 AND ra.approved_at IS NOT NULL
+    UNION ALL
+    SELECT 4, 'merged', pr.merged_at, COALESCE(pr.merged_by, pr.author_id), NULL, NULL, NULL, pr.merged_by
+    FROM pull_requests pr WHERE pr.pr_id = $1 AND pr.merged_at IS NOT NULL
+    UNION ALL
+    SELECT 4, 'closed', pr.closed_at, pr.author_id, NULL, NULL, NULL, NULL
+    FROM pull_requests pr WHERE pr.pr_id = $1 AND pr.closed_at IS NOT NULL
+) e
+ORDER BY e.occurred_at, e.rank, e.user_id
+`
+
+type ListPRTimelineRow struct {
+	EventType     string
+	OccurredAt    pgtype.Timestamptz
+	UserID        string
+	ReplacedBy    string
+	Reason        string
+	DeclineReason string
+	Actor         string
+}
+
+// Lifecycle events of a PR, oldest first. Only the current reviewers' latest
+// verdicts are stored, so replaced reviewers show up through reassignments.
+func (q *Queries) ListPRTimeline(ctx context.Context, prID string) ([]ListPRTimelineRow, error) {
+	rows, err := q.db.Query(ctx, listPRTimeline, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPRTimelineRow
+	for rows.Next() {
+		var i ListPRTimelineRow
+		if err := rows.Scan(
+			&i.EventType,
+			&i.OccurredAt,
+			&i.UserID,
+			&i.ReplacedBy,
+			&i.Reason,
+			&i.DeclineReason,
+			&i.Actor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPRs = `-- name: ListPRs :many
+SELECT pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at FROM pull_requests
+ORDER BY created_at, pr_id
+`
+
+func (q *Queries) ListPRs(ctx context.Context) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, listPRs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PullRequest
+	for rows.Next() {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MergedAt,
+			&i.RequiredSkills,
+			&i.Description,
+			&i.AutoMerge,
+			&i.LeadNotifiedAt,
+			&i.ReviewerEscalatedAt,
+			&i.Priority,
+			&i.RepositoryName,
+			&i.LinesChanged,
+			&i.FilesChanged,
+			&i.ExternalID,
+			&i.Labels,
+			&i.MergedBy,
+			&i.ReassignedBy,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingReviews = `-- name: ListPendingReviews :many
+SELECT pr.pr_id, pr.pr_name, pr.priority, ra.assigned_at
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+WHERE ra.user_id = $1 AND pr.status NOT IN ('MERGED', 'CLOSED') AND ra.approved_at IS NULL
+ORDER BY pr.priority DESC, ra.assigned_at, pr.pr_id
+`
+
+type ListPendingReviewsRow struct {
+	PrID       string
+	PrName     string
+	Priority   PrPriority
+	AssignedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListPendingReviews(ctx context.Context, userID string) ([]ListPendingReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingReviews, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPendingReviewsRow
+	for rows.Next() {
+		var i ListPendingReviewsRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.Priority,
+			&i.AssignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReassignmentCounts = `-- name: ListReassignmentCounts :many
+SELECT t.team_name, COALESCE(r.from_user_id, '')::varchar AS user_id, r.reason,
+       COALESCE(r.decline_reason, '')::varchar AS decline_reason, COUNT(*)::bigint AS reassignment_count
+FROM reassignments r
+JOIN teams t ON t.team_id = r.team_id
+WHERE r.reassigned_at >= $1::timestamptz AND r.reassigned_at < $2::timestamptz
+  AND ($3::text = '' OR t.team_name = $3::text)
+GROUP BY t.team_name, r.from_user_id, r.reason, r.decline_reason
+ORDER BY t.team_name, user_id, r.reason, decline_reason
+`
+
+type ListReassignmentCountsParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	TeamName string
+}
+
+type ListReassignmentCountsRow struct {
+	TeamName          string
+	UserID            string
+	Reason            string
+	DeclineReason     string
+	ReassignmentCount int64
+}
+
+// Reassignments within [since, until) per team, removed reviewer, reason and
+// decline reason (” when none was given).
+func (q *Queries) ListReassignmentCounts(ctx context.Context, arg ListReassignmentCountsParams) ([]ListReassignmentCountsRow, error) {
+	rows, err := q.db.Query(ctx, listReassignmentCounts, arg.Since, arg.Until, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReassignmentCountsRow
+	for rows.Next() {
+		var i ListReassignmentCountsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.UserID,
+			&i.Reason,
+			&i.DeclineReason,
+			&i.ReassignmentCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewAssignments = `-- name: ListReviewAssignments :many
+SELECT pr_id, user_id, approved_at, assigned_at, acked_at, ack_reminded_at, changes_requested_at, reviewed_at, optional FROM review_assignments
+ORDER BY pr_id, user_id
+`
+
+func (q *Queries) ListReviewAssignments(ctx context.Context) ([]ReviewAssignment, error) {
+	rows, err := q.db.Query(ctx, listReviewAssignments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewAssignment
+	for rows.Next() {
+		var i ReviewAssignment
+		if err := rows.Scan(
+			&i.PrID,
+			&i.UserID,
+			&i.ApprovedAt,
+			&i.AssignedAt,
+			&i.AckedAt,
+			&i.AckRemindedAt,
+			&i.ChangesRequestedAt,
+			&i.ReviewedAt,
+			&i.Optional,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listShadowReviewCounts = `-- name: ListShadowReviewCounts :many
+SELECT t.team_name, u.user_id, u.username, u.in_training, COUNT(sr.user_id)::bigint AS shadow_review_count
+FROM users u
+JOIN teams t ON t.team_id = u.team_id
+LEFT JOIN shadow_reviews sr ON sr.user_id = u.user_id
+    AND sr.assigned_at >= $1::timestamptz AND sr.assigned_at < $2::timestamptz
+WHERE t.is_active
+  AND ($3::text = '' OR t.team_name = $3::text)
+GROUP BY t.team_name, u.user_id, u.username, u.in_training
+HAVING u.in_training OR COUNT(sr.user_id) > 0
+ORDER BY t.team_name, shadow_review_count DESC, u.user_id
+`
+
+type ListShadowReviewCountsParams struct {
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	TeamName string
+}
+
+type ListShadowReviewCountsRow struct {
+	TeamName          string
+	UserID            string
+	Username          string
+	InTraining        bool
+	ShadowReviewCount int64
+}
+
+// Shadow reviews assigned within [since, until) to each user of the active
+// teams who is in training or had any in that period.
+func (q *Queries) ListShadowReviewCounts(ctx context.Context, arg ListShadowReviewCountsParams) ([]ListShadowReviewCountsRow, error) {
+	rows, err := q.db.Query(ctx, listShadowReviewCounts, arg.Since, arg.Until, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListShadowReviewCountsRow
+	for rows.Next() {
+		var i ListShadowReviewCountsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.UserID,
+			&i.Username,
+			&i.InTraining,
+			&i.ShadowReviewCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStalledPRs = `-- name: ListStalledPRs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.priority, t.team_id, t.lead_user_id,
+       t.escalation_notify_after_hours, t.escalation_add_reviewer_after_hours,
+       pr.lead_notified_at, pr.reviewer_escalated_at,
+       MIN(ra.assigned_at)::timestamptz AS waiting_since
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+JOIN teams t ON t.team_id = u.team_id
+JOIN review_assignments ra ON ra.pr_id = pr.pr_id AND NOT ra.optional
+WHERE pr.status = 'IN_REVIEW'
+  AND (t.escalation_notify_after_hours > 0 OR t.escalation_add_reviewer_after_hours > 0)
+  AND (pr.lead_notified_at IS NULL OR pr.reviewer_escalated_at IS NULL)
+GROUP BY pr.pr_id, t.team_id
+HAVING COUNT(ra.approved_at) = 0
+   AND ((t.escalation_notify_after_hours > 0 AND pr.lead_notified_at IS NULL
+         AND MIN(ra.assigned_at) <= NOW() - make_interval(hours => t.escalation_notify_after_hours) * priority_delay_factor(pr.priority))
+     OR (t.escalation_add_reviewer_after_hours > 0 AND pr.reviewer_escalated_at IS NULL
+         AND MIN(ra.assigned_at) <= NOW() - make_interval(hours => t.escalation_add_reviewer_after_hours) * priority_delay_factor(pr.priority)))
+ORDER BY waiting_since
+LIMIT $1
+`
+
+type ListStalledPRsRow struct {
+	PrID                            string
+	PrName                          string
+	AuthorID                        string
+	Priority                        PrPriority
+	TeamID                          int32
+	LeadUserID                      pgtype.Text
+	EscalationNotifyAfterHours      int32
+	EscalationAddReviewerAfterHours int32
+	LeadNotifiedAt                  pgtype.Timestamptz
+	ReviewerEscalatedAt             pgtype.Timestamptz
+	WaitingSince                    pgtype.Timestamptz
+}
+
+// PRs in review without any approval whose oldest assignment has outlived
+// one of the author's team escalation steps that was not taken yet. Delays
+// are scaled by priority: a quarter for urgent PRs, double for low priority
+// ones. Only required reviewers are considered.
+func (q *Queries) ListStalledPRs(ctx context.Context, limit int32) ([]ListStalledPRsRow, error) {
+	rows, err := q.db.Query(ctx, listStalledPRs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStalledPRsRow
+	for rows.Next() {
+		var i ListStalledPRsRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Priority,
+			&i.TeamID,
+			&i.LeadUserID,
+			&i.EscalationNotifyAfterHours,
+			&i.EscalationAddReviewerAfterHours,
+			&i.LeadNotifiedAt,
+			&i.ReviewerEscalatedAt,
+			&i.WaitingSince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMergedPRs = `-- name: ListTeamMergedPRs :many
+SELECT p.pr_id, p.pr_name, p.author_id, p.created_at, p.merged_at::timestamptz AS merged_at
+FROM (
+    SELECT pr_id, pr_name, author_id, created_at, merged_at FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT pr_id, pr_name, author_id, created_at, merged_at FROM pull_requests_archive WHERE status = 'MERGED'
+) p
+JOIN users u ON u.user_id = p.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE t.team_name = $1::text
+  AND p.merged_at >= $2::timestamptz AND p.merged_at < $3::timestamptz
+ORDER BY p.merged_at, p.pr_id
+`
+
+type ListTeamMergedPRsParams struct {
+	TeamName string
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
 }
 
-func (q *Queries) GetPRsForReviewer(ctx context.Context, userID string) ([]GetPRsForReviewerRow, error) {
-	rows, err := q.db.Query(ctx, getPRsForReviewer, userID)
+type ListTeamMergedPRsRow struct {
+	PrID      string
+	PrName    string
+	AuthorID  string
+	CreatedAt pgtype.Timestamptz
+	MergedAt  pgtype.Timestamptz
+}
+
+// PRs by the team's members merged within [since, until), archived PRs
+// included.
+func (q *Queries) ListTeamMergedPRs(ctx context.Context, arg ListTeamMergedPRsParams) ([]ListTeamMergedPRsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMergedPRs, arg.TeamName, arg.Since, arg.Until)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPRsForReviewerRow
+	var items []ListTeamMergedPRsRow
 	for rows.Next() {
-		var i GetPRsForReviewerRow
+		var i ListTeamMergedPRsRow
 		if err := rows.Scan(
 			&i.PrID,
 			&i.PrName,
 			&i.AuthorID,
-			&i.Status,
+			&i.CreatedAt,
+			&i.MergedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -311,28 +2030,49 @@ func (q *Queries) GetPRsForReviewer(ctx context.Context, userID string) ([]GetPR
 	return items, nil
 }
 
-const getReviewStats = `-- name: GetReviewStats :many
-SELECT user_id, COUNT(*) AS review_count
-FROM review_assignments
-GROUP BY user_id
-ORDER BY review_count DESC
+const listTeamSLABreaches = `-- name: ListTeamSLABreaches :many
+SELECT p.pr_id, p.pr_name, p.author_id, p.created_at,
+       LEAST(p.lead_notified_at, p.reviewer_escalated_at)::timestamptz AS escalated_at
+FROM pull_requests p
+JOIN users u ON u.user_id = p.author_id
+JOIN teams t ON t.team_id = u.team_id
+WHERE t.team_name = $1::text
+  AND LEAST(p.lead_notified_at, p.reviewer_escalated_at) >= $2::timestamptz
+  AND LEAST(p.lead_notified_at, p.reviewer_escalated_at) < $3::timestamptz
+ORDER BY escalated_at, p.pr_id
 `
 
-type GetReviewStatsRow struct {
-	UserID      string
-	ReviewCount int64
+type ListTeamSLABreachesParams struct {
+	TeamName string
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+}
+
+type ListTeamSLABreachesRow struct {
+	PrID        string
+	PrName      string
+	AuthorID    string
+	CreatedAt   pgtype.Timestamptz
+	EscalatedAt pgtype.Timestamptz
 }
 
-func (q *Queries) GetReviewStats(ctx context.Context) ([]GetReviewStatsRow, error) {
-	rows, err := q.db.Query(ctx, getReviewStats)
+// PRs by the team's members first escalated within [since, until).
+func (q *Queries) ListTeamSLABreaches(ctx context.Context, arg ListTeamSLABreachesParams) ([]ListTeamSLABreachesRow, error) {
+	rows, err := q.db.Query(ctx, listTeamSLABreaches, arg.TeamName, arg.Since, arg.Until)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetReviewStatsRow
+	var items []ListTeamSLABreachesRow
 	for rows.Next() {
-		var i GetReviewStatsRow
-		if err := rows.Scan(&i.UserID, &i.ReviewCount); err != nil {
+		var i ListTeamSLABreachesRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.EscalatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -343,29 +2083,67 @@ func (q *Queries) GetReviewStats(ctx context.Context) ([]GetReviewStatsRow, erro
 	return items, nil
 }
 
-const getReviewersForPR = `-- name: GetReviewersForPR :many
-SELECT u.user_id, u.username, u.team_id, u.is_active, u.created_at
-FROM users u
-JOIN review_assignments ra ON u.user_id = ra.user_id
-WHERE ra.pr_id = $1
+const listThroughputCounts = `-- name: ListThroughputCounts :many
+SELECT e.metric, date_trunc($1::text, e.at, 'UTC')::timestamptz AS bucket, COUNT(*)::bigint AS event_count
+FROM (
+    SELECT 'prs_created'::text AS metric, created_at AS at, author_id AS user_id FROM pull_requests
+    UNION ALL
+    SELECT 'prs_created', created_at, author_id FROM pull_requests_archive
+    UNION ALL
+    SELECT 'prs_merged', merged_at, author_id FROM pull_requests WHERE status = 'MERGED'
+    UNION ALL
+    SELECT 'prs_merged', merged_at, author_id FROM pull_requests_archive WHERE status = 'MERGED'
+    UNION ALL
+    SELECT 'reviews_assigned', assigned_at, user_id FROM review_assignments
+    UNION ALL
+    SELECT 'reviews_assigned', assigned_at, user_id FROM review_assignments_archive
+    UNION ALL
+    SELECT 'reviews_completed', reviewed_at, user_id FROM review_assignments
+    UNION ALL
+    SELECT 'reviews_completed', reviewed_at, user_id FROM review_assignments_archive
+) e
+JOIN users u ON u.user_id = e.user_id
+LEFT JOIN teams t ON t.team_id = u.team_id
+WHERE e.metric = ANY($2::text[])
+  AND e.at >= $3::timestamptz AND e.at < $4::timestamptz
+  AND ($5::text = '' OR t.team_name = $5::text)
+GROUP BY e.metric, bucket
+ORDER BY e.metric, bucket
 `
 
-func (q *Queries) GetReviewersForPR(ctx context.Context, prID string) ([]User, error) {
-	rows, err := q.db.Query(ctx, getReviewersForPR, prID)
+type ListThroughputCountsParams struct {
+	Unit     string
+	Metrics  []string
+	Since    pgtype.Timestamptz
+	Until    pgtype.Timestamptz
+	TeamName string
+}
+
+type ListThroughputCountsRow struct {
+	Metric     string
+	Bucket     pgtype.Timestamptz
+	EventCount int64
+}
+
+// Events of the metrics within [since, until) per UTC bucket of the unit
+// ('hour', 'day' or 'week'), archived PRs included. PR events count for the
+// author's team and review events for the reviewer's team.
+func (q *Queries) ListThroughputCounts(ctx context.Context, arg ListThroughputCountsParams) ([]ListThroughputCountsRow, error) {
+	rows, err := q.db.Query(ctx, listThroughputCounts,
+		arg.Unit,
+		arg.Metrics,
+		arg.Since,
+		arg.Until,
+		arg.TeamName,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []User
+	var items []ListThroughputCountsRow
 	for rows.Next() {
-		var i User
-		if err := rows.Scan(
-			&i.UserID,
-			&i.Username,
-			&i.TeamID,
-			&i.IsActive,
-			&i.CreatedAt,
-		); err != nil {
+		var i ListThroughputCountsRow
+		if err := rows.Scan(&i.Metric, &i.Bucket, &i.EventCount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -376,26 +2154,52 @@ func (q *Queries) GetReviewersForPR(ctx context.Context, prID string) ([]User, e
 	return items, nil
 }
 
-const listPRs = `-- name: ListPRs :many
-SELECT pr_id, pr_name, author_id, status, created_at, merged_at FROM pull_requests
+const listUnackedReviews = `-- name: ListUnackedReviews :many
+SELECT ra.pr_id, ra.user_id, ra.assigned_at, ra.ack_reminded_at, pr.pr_name
+FROM review_assignments ra
+JOIN pull_requests pr ON ra.pr_id = pr.pr_id
+WHERE pr.status = 'IN_REVIEW'
+  AND NOT ra.optional
+  AND ra.acked_at IS NULL
+  AND ra.approved_at IS NULL
+  AND ((ra.ack_reminded_at IS NULL AND ra.assigned_at <= $1::timestamptz)
+    OR ra.assigned_at <= $2::timestamptz)
+ORDER BY ra.assigned_at, ra.pr_id, ra.user_id
+LIMIT $3
 `
 
-func (q *Queries) ListPRs(ctx context.Context) ([]PullRequest, error) {
-	rows, err := q.db.Query(ctx, listPRs)
+type ListUnackedReviewsParams struct {
+	RemindBefore   pgtype.Timestamptz
+	ReassignBefore pgtype.Timestamptz
+	BatchSize      int32
+}
+
+type ListUnackedReviewsRow struct {
+	PrID          string
+	UserID        string
+	AssignedAt    pgtype.Timestamptz
+	AckRemindedAt pgtype.Timestamptz
+	PrName        string
+}
+
+// Required assignments on PRs in review that were neither acknowledged nor
+// approved and are due for a reminder (assigned before $1 and not reminded
+// yet) or for reassignment (assigned before $2).
+func (q *Queries) ListUnackedReviews(ctx context.Context, arg ListUnackedReviewsParams) ([]ListUnackedReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listUnackedReviews, arg.RemindBefore, arg.ReassignBefore, arg.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []PullRequest
+	var items []ListUnackedReviewsRow
 	for rows.Next() {
-		var i PullRequest
+		var i ListUnackedReviewsRow
 		if err := rows.Scan(
 			&i.PrID,
+			&i.UserID,
+			&i.AssignedAt,
+			&i.AckRemindedAt,
 			&i.PrName,
-			&i.AuthorID,
-			&i.Status,
-			&i.CreatedAt,
-			&i.MergedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -407,16 +2211,163 @@ func (q *Queries) ListPRs(ctx context.Context) ([]PullRequest, error) {
 	return items, nil
 }
 
+const listUnassignedPRCounts = `-- name: ListUnassignedPRCounts :many
+SELECT t.team_name, d.day::date AS day, COUNT(DISTINCT p.pr_id)::bigint AS pr_count
+FROM generate_series($1::date, $2::date, interval '1 day') AS d(day)
+JOIN unassigned_pr_periods p
+  ON p.started_at < (d.day + interval '1 day') AT TIME ZONE 'UTC'
+ AND (p.ended_at IS NULL OR p.ended_at > d.day AT TIME ZONE 'UTC')
+JOIN teams t ON t.team_id = p.team_id
+WHERE $3::text = '' OR t.team_name = $3::text
+GROUP BY t.team_name, d.day
+ORDER BY t.team_name, d.day
+`
+
+type ListUnassignedPRCountsParams struct {
+	Since    pgtype.Date
+	Until    pgtype.Date
+	TeamName string
+}
+
+type ListUnassignedPRCountsRow struct {
+	TeamName string
+	Day      pgtype.Date
+	PrCount  int64
+}
+
+// Open PRs without reviewers at any moment of each UTC day in [since, until],
+// per team; days without such PRs are omitted.
+func (q *Queries) ListUnassignedPRCounts(ctx context.Context, arg ListUnassignedPRCountsParams) ([]ListUnassignedPRCountsRow, error) {
+	rows, err := q.db.Query(ctx, listUnassignedPRCounts, arg.Since, arg.Until, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnassignedPRCountsRow
+	for rows.Next() {
+		var i ListUnassignedPRCountsRow
+		if err := rows.Scan(&i.TeamName, &i.Day, &i.PrCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPR = `-- name: LockPR :one
+SELECT pr_id FROM pull_requests WHERE pr_id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockPR(ctx context.Context, prID string) (string, error) {
+	row := q.db.QueryRow(ctx, lockPR, prID)
+	var pr_id string
+	err := row.Scan(&pr_id)
+	return pr_id, err
+}
+
+const markAckReminded = `-- name: MarkAckReminded :execrows
+UPDATE review_assignments
+SET ack_reminded_at = NOW()
+WHERE pr_id = $1 AND user_id = $2 AND ack_reminded_at IS NULL
+`
+
+type MarkAckRemindedParams struct {
+	PrID   string
+	UserID string
+}
+
+func (q *Queries) MarkAckReminded(ctx context.Context, arg MarkAckRemindedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markAckReminded, arg.PrID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markLeadNotified = `-- name: MarkLeadNotified :execrows
+UPDATE pull_requests
+SET lead_notified_at = NOW()
+WHERE pr_id = $1 AND lead_notified_at IS NULL
+`
+
+func (q *Queries) MarkLeadNotified(ctx context.Context, prID string) (int64, error) {
+	result, err := q.db.Exec(ctx, markLeadNotified, prID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markPRReady = `-- name: MarkPRReady :one
+UPDATE pull_requests
+SET status = 'OPEN'
+WHERE pr_id = $1 AND status = 'DRAFT'
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
+`
+
+// Moves a draft to OPEN; the pull_requests_ready trigger then moves it on
+// by the reviewers it already has.
+func (q *Queries) MarkPRReady(ctx context.Context, prID string) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, markPRReady, prID)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const markReviewerEscalated = `-- name: MarkReviewerEscalated :execrows
+UPDATE pull_requests
+SET reviewer_escalated_at = NOW()
+WHERE pr_id = $1 AND reviewer_escalated_at IS NULL
+`
+
+func (q *Queries) MarkReviewerEscalated(ctx context.Context, prID string) (int64, error) {
+	result, err := q.db.Exec(ctx, markReviewerEscalated, prID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const mergePR = `-- name: MergePR :one
 UPDATE pull_requests
 SET status = 'MERGED',
-    merged_at = NOW()
-WHERE pr_id = $1
-RETURNING pr_id, pr_name, author_id, status, created_at, merged_at
+    merged_at = NOW(),
+    merged_by = NULLIF($1::varchar, '')
+WHERE pr_id = $2
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
 `
 
-func (q *Queries) MergePR(ctx context.Context, prID string) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, mergePR, prID)
+type MergePRParams struct {
+	MergedBy string
+	PrID     string
+}
+
+func (q *Queries) MergePR(ctx context.Context, arg MergePRParams) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, mergePR, arg.MergedBy, arg.PrID)
 	var i PullRequest
 	err := row.Scan(
 		&i.PrID,
@@ -425,10 +2376,97 @@ func (q *Queries) MergePR(ctx context.Context, prID string) (PullRequest, error)
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
 	)
 	return i, err
 }
 
+const recordReassignment = `-- name: RecordReassignment :exec
+WITH logged AS (
+    INSERT INTO reassignments (pr_id, team_id, from_user_id, to_user_id, reason, decline_reason, reassigned_by)
+    SELECT $1, u.team_id, u.user_id, NULLIF($2::varchar, ''), $3,
+           NULLIF($4::varchar, ''), NULLIF($5::varchar, '')
+    FROM users u
+    WHERE u.user_id = $6
+    RETURNING pr_id, reassigned_by
+)
+UPDATE pull_requests pr
+SET reassigned_by = logged.reassigned_by
+FROM logged
+WHERE pr.pr_id = logged.pr_id
+`
+
+type RecordReassignmentParams struct {
+	PrID          string
+	ToUserID      string
+	Reason        string
+	DeclineReason string
+	ReassignedBy  string
+	FromUserID    string
+}
+
+func (q *Queries) RecordReassignment(ctx context.Context, arg RecordReassignmentParams) error {
+	_, err := q.db.Exec(ctx, recordReassignment,
+		arg.PrID,
+		arg.ToUserID,
+		arg.Reason,
+		arg.DeclineReason,
+		arg.ReassignedBy,
+		arg.FromUserID,
+	)
+	return err
+}
+
+const recordReassignments = `-- name: RecordReassignments :exec
+WITH logged AS (
+    INSERT INTO reassignments (pr_id, team_id, from_user_id, to_user_id, reason, reassigned_by)
+    SELECT m.pr_id, u.team_id, u.user_id, NULLIF(m.to_user_id, ''), $1, NULLIF($2::varchar, '')
+    FROM (
+        SELECT unnest($3::varchar[]) AS pr_id,
+               unnest($4::varchar[]) AS from_user_id,
+               unnest($5::varchar[]) AS to_user_id
+    ) m
+    JOIN users u ON u.user_id = m.from_user_id
+    RETURNING pr_id, reassigned_by
+)
+UPDATE pull_requests pr
+SET reassigned_by = logged.reassigned_by
+FROM (SELECT DISTINCT pr_id, reassigned_by FROM logged) logged
+WHERE pr.pr_id = logged.pr_id
+`
+
+type RecordReassignmentsParams struct {
+	Reason       string
+	ReassignedBy string
+	PrIds        []string
+	FromUserIds  []string
+	ToUserIds    []string
+}
+
+func (q *Queries) RecordReassignments(ctx context.Context, arg RecordReassignmentsParams) error {
+	_, err := q.db.Exec(ctx, recordReassignments,
+		arg.Reason,
+		arg.ReassignedBy,
+		arg.PrIds,
+		arg.FromUserIds,
+		arg.ToUserIds,
+	)
+	return err
+}
+
 const removeAllReviewersFromPR = `-- name: RemoveAllReviewersFromPR :exec
 DELETE FROM review_assignments
 WHERE pr_id = $1
@@ -439,9 +2477,42 @@ func (q *Queries) RemoveAllReviewersFromPR(ctx context.Context, prID string) err
 	return err
 }
 
-const removeReviewerFromPR = `-- name: RemoveReviewerFromPR :exec
+const removeOpenReviewsByUsers = `-- name: RemoveOpenReviewsByUsers :many
+DELETE FROM review_assignments ra
+USING pull_requests pr
+WHERE ra.pr_id = pr.pr_id AND pr.status NOT IN ('MERGED', 'CLOSED') AND ra.user_id = ANY($1::varchar[])
+RETURNING ra.pr_id, ra.user_id
+`
+
+type RemoveOpenReviewsByUsersRow struct {
+	PrID   string
+	UserID string
+}
+
+func (q *Queries) RemoveOpenReviewsByUsers(ctx context.Context, userIds []string) ([]RemoveOpenReviewsByUsersRow, error) {
+	rows, err := q.db.Query(ctx, removeOpenReviewsByUsers, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RemoveOpenReviewsByUsersRow
+	for rows.Next() {
+		var i RemoveOpenReviewsByUsersRow
+		if err := rows.Scan(&i.PrID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeReviewerFromPR = `-- name: RemoveReviewerFromPR :one
 DELETE FROM review_assignments
 WHERE pr_id = $1 AND user_id = $2
+RETURNING optional
 `
 
 type RemoveReviewerFromPRParams struct {
@@ -449,7 +2520,194 @@ type RemoveReviewerFromPRParams struct {
 	UserID string
 }
 
-func (q *Queries) RemoveReviewerFromPR(ctx context.Context, arg RemoveReviewerFromPRParams) error {
-	_, err := q.db.Exec(ctx, removeReviewerFromPR, arg.PrID, arg.UserID)
-	return err
+func (q *Queries) RemoveReviewerFromPR(ctx context.Context, arg RemoveReviewerFromPRParams) (bool, error) {
+	row := q.db.QueryRow(ctx, removeReviewerFromPR, arg.PrID, arg.UserID)
+	var optional bool
+	err := row.Scan(&optional)
+	return optional, err
+}
+
+const requestChanges = `-- name: RequestChanges :execrows
+UPDATE review_assignments
+SET changes_requested_at = COALESCE(changes_requested_at, NOW()),
+    approved_at = NULL,
+    reviewed_at = COALESCE(reviewed_at, NOW()),
+    acked_at = COALESCE(acked_at, NOW())
+WHERE pr_id = $1 AND user_id = $2
+`
+
+type RequestChangesParams struct {
+	PrID   string
+	UserID string
+}
+
+// Requesting changes withdraws the reviewer's approval.
+func (q *Queries) RequestChanges(ctx context.Context, arg RequestChangesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, requestChanges, arg.PrID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchPRs = `-- name: SearchPRs :many
+SELECT pr.pr_id, pr.pr_name, pr.author_id, pr.status, pr.description,
+       ts_rank(setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'),
+               websearch_to_tsquery('simple', $1::text))::real AS rank
+FROM pull_requests pr
+WHERE (setweight(to_tsvector('simple', pr.pr_name), 'A') || setweight(to_tsvector('simple', pr.description), 'B'))
+      @@ websearch_to_tsquery('simple', $1::text)
+ORDER BY rank DESC, pr.created_at DESC, pr.pr_id
+LIMIT $3 OFFSET $2
+`
+
+type SearchPRsParams struct {
+	Query        string
+	ResultOffset int32
+	ResultLimit  int32
+}
+
+type SearchPRsRow struct {
+	PrID        string
+	PrName      string
+	AuthorID    string
+	Status      PrStatus
+	Description string
+	Rank        float32
+}
+
+func (q *Queries) SearchPRs(ctx context.Context, arg SearchPRsParams) ([]SearchPRsRow, error) {
+	rows, err := q.db.Query(ctx, searchPRs, arg.Query, arg.ResultOffset, arg.ResultLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchPRsRow
+	for rows.Next() {
+		var i SearchPRsRow
+		if err := rows.Scan(
+			&i.PrID,
+			&i.PrName,
+			&i.AuthorID,
+			&i.Status,
+			&i.Description,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setChecklistItemChecked = `-- name: SetChecklistItemChecked :execrows
+UPDATE pr_checklist_items
+SET checked = $1::bool,
+    checked_by = CASE WHEN $1::bool THEN COALESCE(checked_by, $2::varchar) END,
+    checked_at = CASE WHEN $1::bool THEN COALESCE(checked_at, NOW()) END
+WHERE pr_id = $3 AND position = $4
+`
+
+type SetChecklistItemCheckedParams struct {
+	Checked  bool
+	UserID   string
+	PrID     string
+	Position int32
+}
+
+// Ticking an item again keeps who ticked it first
+func (q *Queries) SetChecklistItemChecked(ctx context.Context, arg SetChecklistItemCheckedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setChecklistItemChecked,
+		arg.Checked,
+		arg.UserID,
+		arg.PrID,
+		arg.Position,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setPRAutoMerge = `-- name: SetPRAutoMerge :one
+UPDATE pull_requests
+SET auto_merge = $2
+WHERE pr_id = $1
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
+`
+
+type SetPRAutoMergeParams struct {
+	PrID      string
+	AutoMerge bool
+}
+
+func (q *Queries) SetPRAutoMerge(ctx context.Context, arg SetPRAutoMergeParams) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, setPRAutoMerge, arg.PrID, arg.AutoMerge)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const setPRPriority = `-- name: SetPRPriority :one
+UPDATE pull_requests
+SET priority = $2
+WHERE pr_id = $1
+RETURNING pr_id, pr_name, author_id, status, created_at, merged_at, required_skills, description, auto_merge, lead_notified_at, reviewer_escalated_at, priority, repository_name, lines_changed, files_changed, external_id, labels, merged_by, reassigned_by, closed_at
+`
+
+type SetPRPriorityParams struct {
+	PrID     string
+	Priority PrPriority
+}
+
+func (q *Queries) SetPRPriority(ctx context.Context, arg SetPRPriorityParams) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, setPRPriority, arg.PrID, arg.Priority)
+	var i PullRequest
+	err := row.Scan(
+		&i.PrID,
+		&i.PrName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.RequiredSkills,
+		&i.Description,
+		&i.AutoMerge,
+		&i.LeadNotifiedAt,
+		&i.ReviewerEscalatedAt,
+		&i.Priority,
+		&i.RepositoryName,
+		&i.LinesChanged,
+		&i.FilesChanged,
+		&i.ExternalID,
+		&i.Labels,
+		&i.MergedBy,
+		&i.ReassignedBy,
+		&i.ClosedAt,
+	)
+	return i, err
 }
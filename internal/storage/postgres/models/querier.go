@@ -6,47 +6,355 @@ package models
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AckReview(ctx context.Context, arg AckReviewParams) (int64, error)
 	ActivateTeam(ctx context.Context, teamID int32) (Team, error)
 	AddReviewerToPR(ctx context.Context, arg AddReviewerToPRParams) error
+	AddShadowReviewer(ctx context.Context, arg AddShadowReviewerParams) error
+	// An approval settles the reviewer's earlier request for changes.
+	ApproveReview(ctx context.Context, arg ApproveReviewParams) (int64, error)
+	// Picks active users whose last digest is older than their digest period.
+	ClaimDueDigests(ctx context.Context, batchSize int32) ([]NotificationPreference, error)
+	// Locks due notifications so that concurrent workers deliver each one once.
+	ClaimDueNotifications(ctx context.Context, batchSize int32) ([]NotificationOutbox, error)
+	// Marks the team as alerted unless it already was after $2.
+	ClaimNoCandidateAlert(ctx context.Context, arg ClaimNoCandidateAlertParams) (int64, error)
+	ClaimReviewBudgetAlert(ctx context.Context, teamID int32) (int64, error)
+	// Records the report of the slot as sent unless another run already did. A
+	// schedule's first report is the first slot after it was created.
+	ClaimTeamReport(ctx context.Context, arg ClaimTeamReportParams) (int64, error)
+	ClosePR(ctx context.Context, prID string) (PullRequest, error)
+	CopyPRsToArchive(ctx context.Context, dollar_1 []string) (int64, error)
+	CopyReviewAssignmentsToArchive(ctx context.Context, dollar_1 []string) error
+	// Inserting (rather than updating user_id) keeps the review counters in sync
+	// through their triggers. PRs authored by the target are skipped.
+	CopyReviewAssignmentsToUser(ctx context.Context, arg CopyReviewAssignmentsToUserParams) (int64, error)
+	CountFilteredPRs(ctx context.Context, arg CountFilteredPRsParams) (int64, error)
 	CountMergedReviewsByTeam(ctx context.Context, teamID int32) (int64, error)
 	CountMergedReviewsByUser(ctx context.Context, userID string) (int64, error)
+	CountNoCandidateEvents(ctx context.Context, arg CountNoCandidateEventsParams) (int32, error)
+	// PRs of the author that are neither merged nor closed, drafts included.
+	CountOpenPRsByAuthor(ctx context.Context, authorID string) (int32, error)
 	CountOpenReviewsByTeam(ctx context.Context, teamID int32) (int64, error)
 	CountOpenReviewsByUser(ctx context.Context, userID string) (int64, error)
 	CountPRs(ctx context.Context) (int64, error)
+	CountReviewStats(ctx context.Context) (int64, error)
+	CountSearchPRs(ctx context.Context, query string) (int64, error)
 	CountTeams(ctx context.Context) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CreateChecklistItems(ctx context.Context, arg CreateChecklistItemsParams) error
+	CreateGitHubTeamSyncConflicts(ctx context.Context, arg CreateGitHubTeamSyncConflictsParams) error
+	CreateGitHubTeamSyncRun(ctx context.Context, arg CreateGitHubTeamSyncRunParams) (int64, error)
+	CreateInboundWebhook(ctx context.Context, arg CreateInboundWebhookParams) (InboundWebhook, error)
 	CreatePR(ctx context.Context, arg CreatePRParams) (PullRequest, error)
-	CreateTeam(ctx context.Context, teamName string) (Team, error)
+	CreatePRTemplate(ctx context.Context, arg CreatePRTemplateParams) (int64, error)
+	CreateRepository(ctx context.Context, arg CreateRepositoryParams) (Repository, error)
+	CreateReviewRule(ctx context.Context, arg CreateReviewRuleParams) error
+	CreateSavedFilter(ctx context.Context, arg CreateSavedFilterParams) (int64, error)
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
 	DeactivateTeam(ctx context.Context, teamID int32) (Team, error)
 	DeactivateUsersByTeam(ctx context.Context, teamID int32) ([]string, error)
-	FindReplacementCandidates(ctx context.Context, arg FindReplacementCandidatesParams) ([]User, error)
+	DeleteDuplicateArchivedReviews(ctx context.Context, arg DeleteDuplicateArchivedReviewsParams) error
+	DeleteGitHubIngestionToken(ctx context.Context, repository string) (int64, error)
+	DeleteGitHubInstallation(ctx context.Context, installationID int64) error
+	DeleteGitHubRepositories(ctx context.Context, dollar_1 []string) error
+	DeleteInactiveReassignOptOut(ctx context.Context, teamID int32) error
+	DeleteNoCandidateEventsBefore(ctx context.Context, arg DeleteNoCandidateEventsBeforeParams) error
+	DeletePRTemplate(ctx context.Context, templateID int64) (int64, error)
+	DeletePRs(ctx context.Context, dollar_1 []string) (int64, error)
+	DeleteRepository(ctx context.Context, repositoryName string) (int64, error)
+	DeleteReviewRotation(ctx context.Context, teamID int32) (int64, error)
+	DeleteReviewRotationOverride(ctx context.Context, arg DeleteReviewRotationOverrideParams) error
+	DeleteReviewRule(ctx context.Context, ruleName string) (int64, error)
+	DeleteReviewerPool(ctx context.Context, teamID int32) error
+	DeleteReviewerPreferences(ctx context.Context, teamID int32) error
+	// Drops the reviewer's assignments on PRs written by the author.
+	DeleteReviewsOfAuthor(ctx context.Context, arg DeleteReviewsOfAuthorParams) (int64, error)
+	DeleteRoutingRules(ctx context.Context, repositoryName string) error
+	DeleteSavedFilter(ctx context.Context, filterID int64) (int64, error)
+	DeleteTeamPRQuota(ctx context.Context, teamID int32) (int64, error)
+	DeleteTeamReportSchedule(ctx context.Context, teamID int32) (int64, error)
+	DeleteTeamReviewBudget(ctx context.Context, teamID int32) (int64, error)
+	DeleteTeamSettings(ctx context.Context, teamID int32) (int64, error)
+	DeleteTeamSizeRules(ctx context.Context, teamID int32) error
+	DeleteUser(ctx context.Context, userID string) (int64, error)
+	DeleteUserReviewAssignments(ctx context.Context, userID string) (int64, error)
+	EnqueueNotification(ctx context.Context, arg EnqueueNotificationParams) error
+	// PRs matching every set filter, open first, then more urgent and older ones.
+	// Empty filters match any PR. Unreviewed keeps PRs without a verdict from the
+	// reviewer, or from any reviewer when no reviewer is given.
+	FilterPRs(ctx context.Context, arg FilterPRsParams) ([]FilterPRsRow, error)
 	GetActiveUsersFromTeamExcluding(ctx context.Context, arg GetActiveUsersFromTeamExcludingParams) ([]User, error)
+	GetApprovalState(ctx context.Context, prID string) (GetApprovalStateRow, error)
+	// PR counts, reviewers per PR and time to merge of the PRs the user opened,
+	// archived PRs included. The merge durations are 0 when no PR was merged.
+	GetAuthorPRStats(ctx context.Context, authorID string) (GetAuthorPRStatsRow, error)
 	GetAuthorTeamByPR(ctx context.Context, prID string) (Team, error)
+	GetChecklistItems(ctx context.Context, prID string) ([]PrChecklistItem, error)
+	GetGitHubIngestionToken(ctx context.Context, repository string) (GetGitHubIngestionTokenRow, error)
+	GetGitHubInstallationByAccount(ctx context.Context, lower string) (GithubInstallation, error)
+	GetGitHubPRLink(ctx context.Context, prID string) (GithubPullRequest, error)
+	GetGitHubPRLinkByNumber(ctx context.Context, arg GetGitHubPRLinkByNumberParams) (GithubPullRequest, error)
+	GetGitHubRepository(ctx context.Context, repository string) (GetGitHubRepositoryRow, error)
+	GetInboundWebhook(ctx context.Context, id int64) (InboundWebhook, error)
+	GetLatestGitHubTeamSyncRun(ctx context.Context) (GithubTeamSyncRun, error)
+	GetNotificationPreferences(ctx context.Context, userID string) (NotificationPreference, error)
 	GetOpenPRsWithoutReviewers(ctx context.Context) ([]PullRequest, error)
+	GetOpenPRsWithoutReviewersByIDs(ctx context.Context, prIds []string) ([]GetOpenPRsWithoutReviewersByIDsRow, error)
 	GetOpenReviewsForUsers(ctx context.Context, dollar_1 []string) ([]GetOpenReviewsForUsersRow, error)
+	GetOrgSettings(ctx context.Context) (OrgSetting, error)
+	// Org-wide top line: open PRs (drafts included), open PRs without reviewers,
+	// active users of active teams and the PRs merged within [since, until) with
+	// their average time from creation to merge, archived PRs included.
+	GetOrgSummary(ctx context.Context, arg GetOrgSummaryParams) (GetOrgSummaryRow, error)
+	GetPRByExternalID(ctx context.Context, externalID pgtype.Text) (PullRequest, error)
 	GetPRByID(ctx context.Context, prID string) (PullRequest, error)
+	GetPRTemplate(ctx context.Context, templateID int64) (GetPRTemplateRow, error)
+	// The PR with its reviewers (and their verdicts) and checklist as JSON arrays,
+	// in one round trip.
+	GetPRWithReviewers(ctx context.Context, prID string) (GetPRWithReviewersRow, error)
 	GetPRsForReviewer(ctx context.Context, userID string) ([]GetPRsForReviewerRow, error)
-	GetReviewStats(ctx context.Context) ([]GetReviewStatsRow, error)
+	GetRecentReviewersOfAuthor(ctx context.Context, arg GetRecentReviewersOfAuthorParams) ([]string, error)
+	GetRepository(ctx context.Context, repositoryName string) (GetRepositoryRow, error)
+	// PR counts, reviewers per PR and time to merge of a repository, archived PRs
+	// included. The merge durations are 0 when no PR was merged.
+	GetRepositoryPRStats(ctx context.Context, repositoryName pgtype.Text) (GetRepositoryPRStatsRow, error)
+	GetRequiredReviewersForPR(ctx context.Context, prID string) ([]User, error)
+	GetReviewRule(ctx context.Context, ruleName string) (GetReviewRuleRow, error)
+	GetReviewRotation(ctx context.Context, teamID int32) (ReviewRotation, error)
+	// Ack latency covers archived assignments too; those archived before
+	// assignment times were recorded are skipped. Users with more reviews come
+	// first unless order_by is 'username'; ties go by username, then user ID, so
+	// pages are stable. A zero limit returns every user.
+	GetReviewStats(ctx context.Context, arg GetReviewStatsParams) ([]GetReviewStatsRow, error)
+	// Time from assignment to the first verdict of the user's reviews assigned
+	// within [since, until), archived assignments included. Pending counts reviews
+	// of open PRs still waiting for a verdict.
+	GetReviewerTurnaround(ctx context.Context, arg GetReviewerTurnaroundParams) (GetReviewerTurnaroundRow, error)
 	GetReviewersForPR(ctx context.Context, prID string) ([]User, error)
+	GetSavedFilter(ctx context.Context, filterID int64) (GetSavedFilterRow, error)
+	GetSlackAccountBySlackID(ctx context.Context, slackUserID string) (SlackAccount, error)
 	GetTeamByID(ctx context.Context, teamID int32) (Team, error)
 	GetTeamByName(ctx context.Context, teamName string) (Team, error)
 	GetTeamMembers(ctx context.Context, teamID int32) ([]User, error)
+	GetTeamPRQuota(ctx context.Context, teamID int32) (TeamPrQuota, error)
+	GetTeamReportSchedule(ctx context.Context, teamID int32) (TeamReportSchedule, error)
+	GetTeamReviewBudget(ctx context.Context, teamID int32) (TeamReviewBudget, error)
+	GetTeamSettings(ctx context.Context, teamID int32) (TeamSetting, error)
+	// The team with its members and size rules as JSON arrays, in one round trip.
+	GetTeamWithMembers(ctx context.Context, teamName string) (GetTeamWithMembersRow, error)
 	GetUserWithTeam(ctx context.Context, userID string) (GetUserWithTeamRow, error)
 	GetUsersByIDs(ctx context.Context, dollar_1 []string) ([]User, error)
+	GetUsersByUsername(ctx context.Context, arg GetUsersByUsernameParams) ([]GetUsersByUsernameRow, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	// Whether any user is notified through the webhook channel.
+	HasWebhookTargets(ctx context.Context) (bool, error)
+	ImportPR(ctx context.Context, arg ImportPRParams) (PullRequest, error)
+	ImportTeam(ctx context.Context, arg ImportTeamParams) (Team, error)
+	InsertInactiveReassignOptOut(ctx context.Context, teamID int32) error
+	InsertNoCandidateEvent(ctx context.Context, arg InsertNoCandidateEventParams) error
+	InsertReviewerPool(ctx context.Context, arg InsertReviewerPoolParams) error
+	InsertReviewerPreference(ctx context.Context, arg InsertReviewerPreferenceParams) error
+	InsertRoutingRule(ctx context.Context, arg InsertRoutingRuleParams) error
+	InsertTeamSizeRule(ctx context.Context, arg InsertTeamSizeRuleParams) error
+	// Records the review counters and active member count of every team for the
+	// day, unless the day is already recorded.
+	InsertTeamStatsHistory(ctx context.Context, snapshotDate pgtype.Date) (int64, error)
+	// Records the review counters of every user for the day, unless the day is
+	// already recorded.
+	InsertUserStatsHistory(ctx context.Context, snapshotDate pgtype.Date) (int64, error)
+	IsInactiveReassignOptedOut(ctx context.Context, teamID int32) (bool, error)
+	IsPRArchived(ctx context.Context, prID string) (bool, error)
+	ListActiveTeamMembers(ctx context.Context, teamID int32) ([]User, error)
+	ListApprovedReviewers(ctx context.Context, prID string) ([]string, error)
+	ListChangesRequestedReviewers(ctx context.Context, prID string) ([]string, error)
+	ListChildTeams(ctx context.Context, parentTeamID pgtype.Int4) ([]Team, error)
+	// Most recently abandoned first. Empty filters match all notifications.
+	ListDeadLetteredNotifications(ctx context.Context, arg ListDeadLetteredNotificationsParams) ([]NotificationOutbox, error)
+	ListGitHubAccountsByLogins(ctx context.Context, dollar_1 []string) ([]GithubAccount, error)
+	ListGitHubAccountsByUserIDs(ctx context.Context, dollar_1 []string) ([]GithubAccount, error)
+	ListGitHubIngestionTokens(ctx context.Context) ([]ListGitHubIngestionTokensRow, error)
+	ListGitHubRepositories(ctx context.Context) ([]ListGitHubRepositoriesRow, error)
+	ListGitHubTeamLinks(ctx context.Context, lower string) ([]GithubTeamLink, error)
+	ListGitHubTeamSyncConflicts(ctx context.Context, runID int64) ([]GithubTeamSyncConflict, error)
+	// Required assignments on PRs in review whose reviewer has neither approved
+	// nor requested changes, and has not acknowledged them since before $1
+	// either. Reviewers from teams that opted out are skipped.
+	ListInactiveReviews(ctx context.Context, arg ListInactiveReviewsParams) ([]ListInactiveReviewsRow, error)
+	// Newest deliveries first. An empty status or event matches all deliveries.
+	ListInboundWebhooks(ctx context.Context, arg ListInboundWebhooksParams) ([]InboundWebhook, error)
+	// Reviews assigned within [since, until) to each active member of the active
+	// teams, archived assignments included. Members without reviews count as 0.
+	ListMemberReviewCounts(ctx context.Context, arg ListMemberReviewCountsParams) ([]ListMemberReviewCountsRow, error)
+	// PRs merged within [since, until) per recorded merger, archived PRs included;
+	// merged_by is '' for merges nobody was recorded for.
+	ListMergeCounts(ctx context.Context, arg ListMergeCountsParams) ([]ListMergeCountsRow, error)
+	ListMergedPRIDsBefore(ctx context.Context, arg ListMergedPRIDsBeforeParams) ([]string, error)
+	ListOpenAuthoredCounts(ctx context.Context, userIds []string) ([]ListOpenAuthoredCountsRow, error)
+	ListOpenReviewCounts(ctx context.Context, userIds []string) ([]ListOpenReviewCountsRow, error)
+	ListPRTemplates(ctx context.Context, teamID int32) ([]ListPRTemplatesRow, error)
+	// Lifecycle events of a PR, oldest first. Only the current reviewers' latest
+	// verdicts are stored, so replaced reviewers show up through reassignments.
+	ListPRTimeline(ctx context.Context, prID string) ([]ListPRTimelineRow, error)
 	ListPRs(ctx context.Context) ([]PullRequest, error)
+	ListPendingReviews(ctx context.Context, userID string) ([]ListPendingReviewsRow, error)
+	// Reassignments within [since, until) per team, removed reviewer, reason and
+	// decline reason ('' when none was given).
+	ListReassignmentCounts(ctx context.Context, arg ListReassignmentCountsParams) ([]ListReassignmentCountsRow, error)
+	ListRepositories(ctx context.Context) ([]ListRepositoriesRow, error)
+	ListReviewAssignments(ctx context.Context) ([]ReviewAssignment, error)
+	ListReviewBudgetUsage(ctx context.Context, userIds []string) ([]ListReviewBudgetUsageRow, error)
+	ListReviewRotationOverrides(ctx context.Context, teamID int32) ([]ReviewRotationOverride, error)
+	// Rules in evaluation order.
+	ListReviewRules(ctx context.Context) ([]ListReviewRulesRow, error)
+	ListReviewerPool(ctx context.Context, teamID int32) ([]string, error)
+	ListReviewerPreferences(ctx context.Context, teamID int32) ([]ReviewerPreference, error)
+	// Rules of the given repositories, all of them when the list is empty.
+	ListRoutingRules(ctx context.Context, repositoryNames []string) ([]ListRoutingRulesRow, error)
+	// Filters owned by the user or by the team, the user's first, by name.
+	ListSavedFilters(ctx context.Context, arg ListSavedFiltersParams) ([]ListSavedFiltersRow, error)
+	// Shadow reviews assigned within [since, until) to each user of the active
+	// teams who is in training or had any in that period.
+	ListShadowReviewCounts(ctx context.Context, arg ListShadowReviewCountsParams) ([]ListShadowReviewCountsRow, error)
+	// PRs in review without any approval whose oldest assignment has outlived
+	// one of the author's team escalation steps that was not taken yet. Delays
+	// are scaled by priority: a quarter for urgent PRs, double for low priority
+	// ones. Only required reviewers are considered.
+	ListStalledPRs(ctx context.Context, limit int32) ([]ListStalledPRsRow, error)
+	// PRs by the team's members merged within [since, until), archived PRs
+	// included.
+	ListTeamMergedPRs(ctx context.Context, arg ListTeamMergedPRsParams) ([]ListTeamMergedPRsRow, error)
+	ListTeamReportSchedules(ctx context.Context) ([]TeamReportSchedule, error)
+	ListTeamReviewBudgets(ctx context.Context) ([]TeamReviewBudget, error)
+	// PRs by the team's members first escalated within [since, until).
+	ListTeamSLABreaches(ctx context.Context, arg ListTeamSLABreachesParams) ([]ListTeamSLABreachesRow, error)
+	ListTeamSettings(ctx context.Context) ([]ListTeamSettingsRow, error)
+	ListTeamSizeRules(ctx context.Context, teamID int32) ([]TeamSizeRule, error)
+	ListTeamStatsHistory(ctx context.Context, arg ListTeamStatsHistoryParams) ([]ListTeamStatsHistoryRow, error)
 	ListTeams(ctx context.Context) ([]Team, error)
+	ListTeamsDueForDeactivation(ctx context.Context, limit int32) ([]Team, error)
+	// Events of the metrics within [since, until) per UTC bucket of the unit
+	// ('hour', 'day' or 'week'), archived PRs included. PR events count for the
+	// author's team and review events for the reviewer's team.
+	ListThroughputCounts(ctx context.Context, arg ListThroughputCountsParams) ([]ListThroughputCountsRow, error)
+	// Assignments on open PRs that were neither acknowledged nor approved and are
+	// due for a reminder (assigned before $1 and not reminded yet) or for
+	// reassignment (assigned before $2).
+	ListUnackedReviews(ctx context.Context, arg ListUnackedReviewsParams) ([]ListUnackedReviewsRow, error)
+	// Open PRs without reviewers at any moment of each UTC day in [since, until],
+	// per team; days without such PRs are omitted.
+	ListUnassignedPRCounts(ctx context.Context, arg ListUnassignedPRCountsParams) ([]ListUnassignedPRCountsRow, error)
+	ListUserStatsHistory(ctx context.Context, arg ListUserStatsHistoryParams) ([]ListUserStatsHistoryRow, error)
 	ListUsers(ctx context.Context) ([]User, error)
-	MergePR(ctx context.Context, prID string) (PullRequest, error)
+	ListUsersWithTeam(ctx context.Context) ([]ListUsersWithTeamRow, error)
+	// Newest attempts first. An empty status matches all attempts.
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	LockPR(ctx context.Context, prID string) (string, error)
+	LockUsers(ctx context.Context, dollar_1 []string) ([]string, error)
+	MarkAckReminded(ctx context.Context, arg MarkAckRemindedParams) (int64, error)
+	MarkDigestSent(ctx context.Context, userID string) error
+	MarkLeadNotified(ctx context.Context, prID string) (int64, error)
+	// Records the last failed attempt and gives up on the notification.
+	MarkNotificationDeadLettered(ctx context.Context, arg MarkNotificationDeadLetteredParams) error
+	MarkNotificationFailed(ctx context.Context, arg MarkNotificationFailedParams) error
+	MarkNotificationSent(ctx context.Context, id int64) error
+	// Moves a draft to OPEN; assigning reviewers then moves it on.
+	MarkPRReady(ctx context.Context, prID string) (PullRequest, error)
+	MarkReviewerEscalated(ctx context.Context, prID string) (int64, error)
+	MergePR(ctx context.Context, arg MergePRParams) (PullRequest, error)
+	// On PRs reviewed by both users the target keeps its assignment and takes over
+	// the source's verdict and acknowledgement. An approval by either of them
+	// wins over a request for changes.
+	MergeReviewProgress(ctx context.Context, arg MergeReviewProgressParams) error
+	MoveArchivedPRAuthor(ctx context.Context, arg MoveArchivedPRAuthorParams) error
+	MoveArchivedReviewAssignments(ctx context.Context, arg MoveArchivedReviewAssignmentsParams) error
+	MoveChecklistChecks(ctx context.Context, arg MoveChecklistChecksParams) error
+	MoveGitHubAccount(ctx context.Context, arg MoveGitHubAccountParams) (int64, error)
+	MoveNotificationPreferences(ctx context.Context, arg MoveNotificationPreferencesParams) error
+	MovePRAuthor(ctx context.Context, arg MovePRAuthorParams) (int64, error)
+	MovePRTemplateReviewers(ctx context.Context, arg MovePRTemplateReviewersParams) error
+	MovePendingNotifications(ctx context.Context, arg MovePendingNotificationsParams) error
+	MoveReassignmentHistory(ctx context.Context, arg MoveReassignmentHistoryParams) error
+	MoveReviewRotationMembers(ctx context.Context, arg MoveReviewRotationMembersParams) error
+	MoveReviewRotationOverrides(ctx context.Context, arg MoveReviewRotationOverridesParams) error
+	// Pairs that would point the target at itself or that the target already has are dropped
+	MoveReviewerPool(ctx context.Context, arg MoveReviewerPoolParams) error
+	MoveReviewerPreferences(ctx context.Context, arg MoveReviewerPreferencesParams) error
+	MoveSavedFilterReferences(ctx context.Context, arg MoveSavedFilterReferencesParams) error
+	// Filters named like one the target already has stay with the source.
+	MoveSavedFilters(ctx context.Context, arg MoveSavedFiltersParams) error
+	MoveSlackAccount(ctx context.Context, arg MoveSlackAccountParams) error
+	MoveTeamLead(ctx context.Context, arg MoveTeamLeadParams) error
 	MoveUserToTeam(ctx context.Context, arg MoveUserToTeamParams) (User, error)
+	MoveWebhookDeliveries(ctx context.Context, arg MoveWebhookDeliveriesParams) error
+	PruneGitHubTeamSyncRuns(ctx context.Context, beforeRunID int64) error
+	RecordReassignment(ctx context.Context, arg RecordReassignmentParams) error
+	RecordReassignments(ctx context.Context, arg RecordReassignmentsParams) error
 	RemoveAllReviewersFromPR(ctx context.Context, prID string) error
-	RemoveReviewerFromPR(ctx context.Context, arg RemoveReviewerFromPRParams) error
+	RemoveOpenReviewsByUsers(ctx context.Context, userIds []string) ([]RemoveOpenReviewsByUsersRow, error)
+	RemoveReviewerFromPR(ctx context.Context, arg RemoveReviewerFromPRParams) (bool, error)
+	// Filters select PRs by the name of the author's team, so they follow renames.
+	RenameSavedFilterTeam(ctx context.Context, arg RenameSavedFilterTeamParams) (int64, error)
+	// Queues copies of the matching notifications that were delivered or given up
+	// on. Pending notifications and earlier replays are skipped.
+	ReplayNotifications(ctx context.Context, arg ReplayNotificationsParams) (int64, error)
+	// Requesting changes withdraws the reviewer's approval.
+	RequestChanges(ctx context.Context, arg RequestChangesParams) (int64, error)
+	ResetTeamReviewBudgetUsage(ctx context.Context, teamID int32) error
+	SaveGitHubInstallationToken(ctx context.Context, arg SaveGitHubInstallationTokenParams) error
+	ScheduleTeamDeactivation(ctx context.Context, arg ScheduleTeamDeactivationParams) (Team, error)
+	SearchPRs(ctx context.Context, arg SearchPRsParams) ([]SearchPRsRow, error)
+	// Ticking an item again keeps who ticked it first
+	SetChecklistItemChecked(ctx context.Context, arg SetChecklistItemCheckedParams) (int64, error)
+	SetGitHubRepositoryTeam(ctx context.Context, arg SetGitHubRepositoryTeamParams) (GithubRepository, error)
+	SetPRAutoMerge(ctx context.Context, arg SetPRAutoMergeParams) (PullRequest, error)
+	SetPRPriority(ctx context.Context, arg SetPRPriorityParams) (PullRequest, error)
+	SetTeamAllowDuplicateUsernames(ctx context.Context, arg SetTeamAllowDuplicateUsernamesParams) (Team, error)
+	SetTeamAllowSelfReview(ctx context.Context, arg SetTeamAllowSelfReviewParams) (Team, error)
+	SetTeamChecklist(ctx context.Context, arg SetTeamChecklistParams) (Team, error)
+	SetTeamEscalationPolicy(ctx context.Context, arg SetTeamEscalationPolicyParams) (Team, error)
+	SetTeamOptionalReviewers(ctx context.Context, arg SetTeamOptionalReviewersParams) (Team, error)
+	SetTeamParent(ctx context.Context, arg SetTeamParentParams) (Team, error)
+	SetTeamRequiredReviewerRole(ctx context.Context, arg SetTeamRequiredReviewerRoleParams) (Team, error)
+	SetTeamReviewCooldown(ctx context.Context, arg SetTeamReviewCooldownParams) (Team, error)
+	SetTeamShadowReviewPercent(ctx context.Context, arg SetTeamShadowReviewPercentParams) (Team, error)
 	SetUserActiveStatus(ctx context.Context, arg SetUserActiveStatusParams) (User, error)
+	SetUserInTraining(ctx context.Context, arg SetUserInTrainingParams) (User, error)
+	SetUserRole(ctx context.Context, arg SetUserRoleParams) (User, error)
+	SetUserSkills(ctx context.Context, arg SetUserSkillsParams) (User, error)
+	// Moves the budget to a new sprint unless another run already did.
+	StartReviewSprint(ctx context.Context, arg StartReviewSprintParams) (int64, error)
+	TouchGitHubIngestionToken(ctx context.Context, repository string) error
+	UpdateInboundWebhookResult(ctx context.Context, arg UpdateInboundWebhookResultParams) (InboundWebhook, error)
+	UpdatePRTemplate(ctx context.Context, arg UpdatePRTemplateParams) (int64, error)
+	UpdateRepository(ctx context.Context, arg UpdateRepositoryParams) (Repository, error)
+	UpdateReviewRule(ctx context.Context, arg UpdateReviewRuleParams) (int64, error)
+	UpdateSavedFilter(ctx context.Context, arg UpdateSavedFilterParams) (int64, error)
 	UpdateTeamName(ctx context.Context, arg UpdateTeamNameParams) (Team, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpsertGitHubAccount(ctx context.Context, arg UpsertGitHubAccountParams) (GithubAccount, error)
+	// Sets the secret of a repository, unless the repository already has one of
+	// another team, in which case nothing is returned.
+	UpsertGitHubIngestionToken(ctx context.Context, arg UpsertGitHubIngestionTokenParams) (GithubIngestionToken, error)
+	UpsertGitHubInstallation(ctx context.Context, arg UpsertGitHubInstallationParams) error
+	UpsertGitHubPRLink(ctx context.Context, arg UpsertGitHubPRLinkParams) (GithubPullRequest, error)
+	UpsertGitHubRepositories(ctx context.Context, arg UpsertGitHubRepositoriesParams) error
+	UpsertGitHubTeamLink(ctx context.Context, arg UpsertGitHubTeamLinkParams) error
+	UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (NotificationPreference, error)
+	UpsertOrgSettings(ctx context.Context, arg UpsertOrgSettingsParams) (OrgSetting, error)
+	UpsertReviewRotation(ctx context.Context, arg UpsertReviewRotationParams) (ReviewRotation, error)
+	UpsertReviewRotationOverride(ctx context.Context, arg UpsertReviewRotationOverrideParams) error
+	UpsertSlackAccount(ctx context.Context, arg UpsertSlackAccountParams) (SlackAccount, error)
+	UpsertTeamPRQuota(ctx context.Context, arg UpsertTeamPRQuotaParams) (TeamPrQuota, error)
+	UpsertTeamReportSchedule(ctx context.Context, arg UpsertTeamReportScheduleParams) (TeamReportSchedule, error)
+	UpsertTeamReviewBudget(ctx context.Context, arg UpsertTeamReviewBudgetParams) (TeamReviewBudget, error)
+	UpsertTeamSettings(ctx context.Context, arg UpsertTeamSettingsParams) (TeamSetting, error)
 }
 
 var _ Querier = (*Queries)(nil)
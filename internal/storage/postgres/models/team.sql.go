@@ -7,22 +7,115 @@ package models
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const activateTeam = `-- name: ActivateTeam :one
 UPDATE teams
 SET is_active = true
 WHERE team_id = $1
-RETURNING team_id, team_name, is_active
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
 `
 
 func (q *Queries) ActivateTeam(ctx context.Context, teamID int32) (Team, error) {
 	row := q.db.QueryRow(ctx, activateTeam, teamID)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
 	return i, err
 }
 
+const claimNoCandidateAlert = `-- name: ClaimNoCandidateAlert :execrows
+INSERT INTO no_candidate_alerts (team_id, alerted_at)
+VALUES ($1, NOW())
+ON CONFLICT (team_id) DO UPDATE SET alerted_at = EXCLUDED.alerted_at
+WHERE no_candidate_alerts.alerted_at < $2
+`
+
+type ClaimNoCandidateAlertParams struct {
+	TeamID    int32
+	AlertedAt pgtype.Timestamptz
+}
+
+// Marks the team as alerted unless it already was after $2.
+func (q *Queries) ClaimNoCandidateAlert(ctx context.Context, arg ClaimNoCandidateAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimNoCandidateAlert, arg.TeamID, arg.AlertedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimReviewBudgetAlert = `-- name: ClaimReviewBudgetAlert :execrows
+UPDATE team_review_budgets
+SET alerted_at = NOW()
+WHERE team_id = $1 AND alerted_at IS NULL
+`
+
+func (q *Queries) ClaimReviewBudgetAlert(ctx context.Context, teamID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, claimReviewBudgetAlert, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimTeamReport = `-- name: ClaimTeamReport :execrows
+UPDATE team_report_schedules
+SET last_sent_at = $1
+WHERE team_id = $2 AND COALESCE(last_sent_at, created_at) < $1
+`
+
+type ClaimTeamReportParams struct {
+	Slot   pgtype.Timestamptz
+	TeamID int32
+}
+
+// Records the report of the slot as sent unless another run already did. A
+// schedule's first report is the first slot after it was created.
+func (q *Queries) ClaimTeamReport(ctx context.Context, arg ClaimTeamReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimTeamReport, arg.Slot, arg.TeamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countNoCandidateEvents = `-- name: CountNoCandidateEvents :one
+SELECT COUNT(*)::int FROM no_candidate_events
+WHERE team_id = $1 AND occurred_at >= $2
+`
+
+type CountNoCandidateEventsParams struct {
+	TeamID     int32
+	OccurredAt pgtype.Timestamptz
+}
+
+func (q *Queries) CountNoCandidateEvents(ctx context.Context, arg CountNoCandidateEventsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countNoCandidateEvents, arg.TeamID, arg.OccurredAt)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const countTeams = `-- name: CountTeams :one
 SELECT count(*) FROM teams
 `
@@ -35,58 +128,743 @@ func (q *Queries) CountTeams(ctx context.Context) (int64, error) {
 }
 
 const createTeam = `-- name: CreateTeam :one
-INSERT INTO teams (team_name)
-VALUES ($1)
-RETURNING team_id, team_name, is_active
+INSERT INTO teams (team_name, allow_duplicate_usernames)
+VALUES ($1, $2)
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
 `
 
-func (q *Queries) CreateTeam(ctx context.Context, teamName string) (Team, error) {
-	row := q.db.QueryRow(ctx, createTeam, teamName)
+type CreateTeamParams struct {
+	TeamName                string
+	AllowDuplicateUsernames bool
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.TeamName, arg.AllowDuplicateUsernames)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
 	return i, err
 }
 
 const deactivateTeam = `-- name: DeactivateTeam :one
 UPDATE teams
-SET is_active = false
+SET is_active = false, deactivate_at = NULL
 WHERE team_id = $1
-RETURNING team_id, team_name, is_active
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
 `
 
 func (q *Queries) DeactivateTeam(ctx context.Context, teamID int32) (Team, error) {
 	row := q.db.QueryRow(ctx, deactivateTeam, teamID)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const deleteInactiveReassignOptOut = `-- name: DeleteInactiveReassignOptOut :exec
+DELETE FROM team_inactive_reassign_opt_outs
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteInactiveReassignOptOut(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, deleteInactiveReassignOptOut, teamID)
+	return err
+}
+
+const deleteNoCandidateEventsBefore = `-- name: DeleteNoCandidateEventsBefore :exec
+DELETE FROM no_candidate_events
+WHERE team_id = $1 AND occurred_at < $2
+`
+
+type DeleteNoCandidateEventsBeforeParams struct {
+	TeamID     int32
+	OccurredAt pgtype.Timestamptz
+}
+
+func (q *Queries) DeleteNoCandidateEventsBefore(ctx context.Context, arg DeleteNoCandidateEventsBeforeParams) error {
+	_, err := q.db.Exec(ctx, deleteNoCandidateEventsBefore, arg.TeamID, arg.OccurredAt)
+	return err
+}
+
+const deleteReviewRotation = `-- name: DeleteReviewRotation :execrows
+DELETE FROM review_rotations
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteReviewRotation(ctx context.Context, teamID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReviewRotation, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewRotationOverride = `-- name: DeleteReviewRotationOverride :exec
+DELETE FROM review_rotation_overrides
+WHERE team_id = $1 AND week_start = $2
+`
+
+type DeleteReviewRotationOverrideParams struct {
+	TeamID    int32
+	WeekStart pgtype.Date
+}
+
+func (q *Queries) DeleteReviewRotationOverride(ctx context.Context, arg DeleteReviewRotationOverrideParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewRotationOverride, arg.TeamID, arg.WeekStart)
+	return err
+}
+
+const deleteReviewerPool = `-- name: DeleteReviewerPool :exec
+DELETE FROM team_reviewer_pool
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteReviewerPool(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, deleteReviewerPool, teamID)
+	return err
+}
+
+const deleteReviewerPreferences = `-- name: DeleteReviewerPreferences :exec
+DELETE FROM reviewer_preferences
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteReviewerPreferences(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, deleteReviewerPreferences, teamID)
+	return err
+}
+
+const deleteTeamPRQuota = `-- name: DeleteTeamPRQuota :execrows
+DELETE FROM team_pr_quotas
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamPRQuota(ctx context.Context, teamID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamPRQuota, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamReportSchedule = `-- name: DeleteTeamReportSchedule :execrows
+DELETE FROM team_report_schedules
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamReportSchedule(ctx context.Context, teamID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamReportSchedule, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamReviewBudget = `-- name: DeleteTeamReviewBudget :execrows
+DELETE FROM team_review_budgets
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamReviewBudget(ctx context.Context, teamID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeamReviewBudget, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeamSizeRules = `-- name: DeleteTeamSizeRules :exec
+DELETE FROM team_size_rules
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamSizeRules(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, deleteTeamSizeRules, teamID)
+	return err
+}
+
+const getReviewRotation = `-- name: GetReviewRotation :one
+SELECT team_id, start_date, member_ids, updated_at FROM review_rotations
+WHERE team_id = $1
+`
+
+func (q *Queries) GetReviewRotation(ctx context.Context, teamID int32) (ReviewRotation, error) {
+	row := q.db.QueryRow(ctx, getReviewRotation, teamID)
+	var i ReviewRotation
+	err := row.Scan(
+		&i.TeamID,
+		&i.StartDate,
+		&i.MemberIds,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
 
 const getTeamByID = `-- name: GetTeamByID :one
-SELECT team_id, team_name, is_active FROM teams
+SELECT team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent FROM teams
 WHERE team_id = $1
 `
 
 func (q *Queries) GetTeamByID(ctx context.Context, teamID int32) (Team, error) {
 	row := q.db.QueryRow(ctx, getTeamByID, teamID)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
 	return i, err
 }
 
 const getTeamByName = `-- name: GetTeamByName :one
-SELECT team_id, team_name, is_active FROM teams
+SELECT team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent FROM teams
 WHERE team_name = $1
 `
 
 func (q *Queries) GetTeamByName(ctx context.Context, teamName string) (Team, error) {
 	row := q.db.QueryRow(ctx, getTeamByName, teamName)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const getTeamPRQuota = `-- name: GetTeamPRQuota :one
+SELECT team_id, max_open_prs, mode FROM team_pr_quotas
+WHERE team_id = $1
+`
+
+func (q *Queries) GetTeamPRQuota(ctx context.Context, teamID int32) (TeamPrQuota, error) {
+	row := q.db.QueryRow(ctx, getTeamPRQuota, teamID)
+	var i TeamPrQuota
+	err := row.Scan(&i.TeamID, &i.MaxOpenPrs, &i.Mode)
+	return i, err
+}
+
+const getTeamReportSchedule = `-- name: GetTeamReportSchedule :one
+SELECT team_id, weekday, hour, timezone, created_at, last_sent_at FROM team_report_schedules
+WHERE team_id = $1
+`
+
+func (q *Queries) GetTeamReportSchedule(ctx context.Context, teamID int32) (TeamReportSchedule, error) {
+	row := q.db.QueryRow(ctx, getTeamReportSchedule, teamID)
+	var i TeamReportSchedule
+	err := row.Scan(
+		&i.TeamID,
+		&i.Weekday,
+		&i.Hour,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.LastSentAt,
+	)
 	return i, err
 }
 
+const getTeamReviewBudget = `-- name: GetTeamReviewBudget :one
+SELECT team_id, reviews_per_sprint, sprint_days, sprint_started_at, alerted_at FROM team_review_budgets
+WHERE team_id = $1
+`
+
+func (q *Queries) GetTeamReviewBudget(ctx context.Context, teamID int32) (TeamReviewBudget, error) {
+	row := q.db.QueryRow(ctx, getTeamReviewBudget, teamID)
+	var i TeamReviewBudget
+	err := row.Scan(
+		&i.TeamID,
+		&i.ReviewsPerSprint,
+		&i.SprintDays,
+		&i.SprintStartedAt,
+		&i.AlertedAt,
+	)
+	return i, err
+}
+
+const getTeamWithMembers = `-- name: GetTeamWithMembers :one
+SELECT t.team_id, t.team_name, t.is_active, t.parent_team_id, t.escalate_to_parent, t.required_reviewer_role, t.lead_user_id, t.escalation_notify_after_hours, t.escalation_add_reviewer_after_hours, t.deactivate_at, t.review_cooldown_prs, t.checklist_items, t.checklist_required, t.allow_duplicate_usernames, t.allow_self_review, t.optional_reviewers, t.shadow_review_percent,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'user_id', u.user_id, 'username', u.username, 'is_active', u.is_active,
+                    'role', u.role, 'skills', u.skills, 'in_training', u.in_training))
+                 FROM users u WHERE u.team_id = t.team_id), '[]')::jsonb AS members,
+       COALESCE((SELECT json_agg(json_build_object(
+                    'max_lines_changed', s.max_lines_changed, 'max_files_changed', s.max_files_changed,
+                    'reviewers', s.reviewers) ORDER BY s.position)
+                 FROM team_size_rules s WHERE s.team_id = t.team_id), '[]')::jsonb AS size_rules
+FROM teams t
+WHERE t.team_name = $1
+`
+
+type GetTeamWithMembersRow struct {
+	Team      Team
+	Members   []byte
+	SizeRules []byte
+}
+
+// The team with its members and size rules as JSON arrays, in one round trip.
+func (q *Queries) GetTeamWithMembers(ctx context.Context, teamName string) (GetTeamWithMembersRow, error) {
+	row := q.db.QueryRow(ctx, getTeamWithMembers, teamName)
+	var i GetTeamWithMembersRow
+	err := row.Scan(
+		&i.Team.TeamID,
+		&i.Team.TeamName,
+		&i.Team.IsActive,
+		&i.Team.ParentTeamID,
+		&i.Team.EscalateToParent,
+		&i.Team.RequiredReviewerRole,
+		&i.Team.LeadUserID,
+		&i.Team.EscalationNotifyAfterHours,
+		&i.Team.EscalationAddReviewerAfterHours,
+		&i.Team.DeactivateAt,
+		&i.Team.ReviewCooldownPrs,
+		&i.Team.ChecklistItems,
+		&i.Team.ChecklistRequired,
+		&i.Team.AllowDuplicateUsernames,
+		&i.Team.AllowSelfReview,
+		&i.Team.OptionalReviewers,
+		&i.Team.ShadowReviewPercent,
+		&i.Members,
+		&i.SizeRules,
+	)
+	return i, err
+}
+
+const importTeam = `-- name: ImportTeam :one
+INSERT INTO teams (team_name, is_active, allow_duplicate_usernames, allow_self_review)
+VALUES ($1, $2, $3, $4)
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type ImportTeamParams struct {
+	TeamName                string
+	IsActive                bool
+	AllowDuplicateUsernames bool
+	AllowSelfReview         bool
+}
+
+func (q *Queries) ImportTeam(ctx context.Context, arg ImportTeamParams) (Team, error) {
+	row := q.db.QueryRow(ctx, importTeam,
+		arg.TeamName,
+		arg.IsActive,
+		arg.AllowDuplicateUsernames,
+		arg.AllowSelfReview,
+	)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const insertInactiveReassignOptOut = `-- name: InsertInactiveReassignOptOut :exec
+INSERT INTO team_inactive_reassign_opt_outs (team_id)
+VALUES ($1)
+ON CONFLICT (team_id) DO NOTHING
+`
+
+func (q *Queries) InsertInactiveReassignOptOut(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, insertInactiveReassignOptOut, teamID)
+	return err
+}
+
+const insertNoCandidateEvent = `-- name: InsertNoCandidateEvent :exec
+INSERT INTO no_candidate_events (team_id, pr_id)
+VALUES ($1, $2)
+`
+
+type InsertNoCandidateEventParams struct {
+	TeamID int32
+	PrID   string
+}
+
+func (q *Queries) InsertNoCandidateEvent(ctx context.Context, arg InsertNoCandidateEventParams) error {
+	_, err := q.db.Exec(ctx, insertNoCandidateEvent, arg.TeamID, arg.PrID)
+	return err
+}
+
+const insertReviewerPool = `-- name: InsertReviewerPool :exec
+INSERT INTO team_reviewer_pool (team_id, user_id)
+SELECT $1::integer, unnest($2::text[])
+`
+
+type InsertReviewerPoolParams struct {
+	TeamID  int32
+	UserIds []string
+}
+
+func (q *Queries) InsertReviewerPool(ctx context.Context, arg InsertReviewerPoolParams) error {
+	_, err := q.db.Exec(ctx, insertReviewerPool, arg.TeamID, arg.UserIds)
+	return err
+}
+
+const insertReviewerPreference = `-- name: InsertReviewerPreference :exec
+INSERT INTO reviewer_preferences (team_id, author_id, reviewer_id, weight)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertReviewerPreferenceParams struct {
+	TeamID     int32
+	AuthorID   string
+	ReviewerID string
+	Weight     int32
+}
+
+func (q *Queries) InsertReviewerPreference(ctx context.Context, arg InsertReviewerPreferenceParams) error {
+	_, err := q.db.Exec(ctx, insertReviewerPreference,
+		arg.TeamID,
+		arg.AuthorID,
+		arg.ReviewerID,
+		arg.Weight,
+	)
+	return err
+}
+
+const insertTeamSizeRule = `-- name: InsertTeamSizeRule :exec
+INSERT INTO team_size_rules (team_id, position, max_lines_changed, max_files_changed, reviewers)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertTeamSizeRuleParams struct {
+	TeamID          int32
+	Position        int32
+	MaxLinesChanged pgtype.Int4
+	MaxFilesChanged pgtype.Int4
+	Reviewers       int32
+}
+
+func (q *Queries) InsertTeamSizeRule(ctx context.Context, arg InsertTeamSizeRuleParams) error {
+	_, err := q.db.Exec(ctx, insertTeamSizeRule,
+		arg.TeamID,
+		arg.Position,
+		arg.MaxLinesChanged,
+		arg.MaxFilesChanged,
+		arg.Reviewers,
+	)
+	return err
+}
+
+const isInactiveReassignOptedOut = `-- name: IsInactiveReassignOptedOut :one
+SELECT EXISTS (
+    SELECT 1 FROM team_inactive_reassign_opt_outs WHERE team_id = $1
+)
+`
+
+func (q *Queries) IsInactiveReassignOptedOut(ctx context.Context, teamID int32) (bool, error) {
+	row := q.db.QueryRow(ctx, isInactiveReassignOptedOut, teamID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listChildTeams = `-- name: ListChildTeams :many
+SELECT team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent FROM teams
+WHERE parent_team_id = $1
+ORDER BY team_name
+`
+
+func (q *Queries) ListChildTeams(ctx context.Context, parentTeamID pgtype.Int4) ([]Team, error) {
+	rows, err := q.db.Query(ctx, listChildTeams, parentTeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.TeamName,
+			&i.IsActive,
+			&i.ParentTeamID,
+			&i.EscalateToParent,
+			&i.RequiredReviewerRole,
+			&i.LeadUserID,
+			&i.EscalationNotifyAfterHours,
+			&i.EscalationAddReviewerAfterHours,
+			&i.DeactivateAt,
+			&i.ReviewCooldownPrs,
+			&i.ChecklistItems,
+			&i.ChecklistRequired,
+			&i.AllowDuplicateUsernames,
+			&i.AllowSelfReview,
+			&i.OptionalReviewers,
+			&i.ShadowReviewPercent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewRotationOverrides = `-- name: ListReviewRotationOverrides :many
+SELECT team_id, week_start, user_id FROM review_rotation_overrides
+WHERE team_id = $1
+ORDER BY week_start
+`
+
+func (q *Queries) ListReviewRotationOverrides(ctx context.Context, teamID int32) ([]ReviewRotationOverride, error) {
+	rows, err := q.db.Query(ctx, listReviewRotationOverrides, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewRotationOverride
+	for rows.Next() {
+		var i ReviewRotationOverride
+		if err := rows.Scan(&i.TeamID, &i.WeekStart, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewerPool = `-- name: ListReviewerPool :many
+SELECT p.user_id FROM team_reviewer_pool p
+JOIN users u ON u.user_id = p.user_id AND u.team_id = p.team_id
+WHERE p.team_id = $1
+ORDER BY p.user_id
+`
+
+// Users who have since left the team are skipped.
+func (q *Queries) ListReviewerPool(ctx context.Context, teamID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, listReviewerPool, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewerPreferences = `-- name: ListReviewerPreferences :many
+SELECT team_id, author_id, reviewer_id, weight FROM reviewer_preferences
+WHERE team_id = $1
+ORDER BY author_id, weight DESC, reviewer_id
+`
+
+func (q *Queries) ListReviewerPreferences(ctx context.Context, teamID int32) ([]ReviewerPreference, error) {
+	rows, err := q.db.Query(ctx, listReviewerPreferences, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewerPreference
+	for rows.Next() {
+		var i ReviewerPreference
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.AuthorID,
+			&i.ReviewerID,
+			&i.Weight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamReportSchedules = `-- name: ListTeamReportSchedules :many
+SELECT s.team_id, s.weekday, s.hour, s.timezone, s.created_at, s.last_sent_at FROM team_report_schedules s
+JOIN teams t ON t.team_id = s.team_id
+WHERE t.is_active
+ORDER BY s.team_id
+`
+
+func (q *Queries) ListTeamReportSchedules(ctx context.Context) ([]TeamReportSchedule, error) {
+	rows, err := q.db.Query(ctx, listTeamReportSchedules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamReportSchedule
+	for rows.Next() {
+		var i TeamReportSchedule
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Weekday,
+			&i.Hour,
+			&i.Timezone,
+			&i.CreatedAt,
+			&i.LastSentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamReviewBudgets = `-- name: ListTeamReviewBudgets :many
+SELECT b.team_id, b.reviews_per_sprint, b.sprint_days, b.sprint_started_at, b.alerted_at FROM team_review_budgets b
+JOIN teams t ON t.team_id = b.team_id
+WHERE t.is_active
+ORDER BY b.team_id
+`
+
+func (q *Queries) ListTeamReviewBudgets(ctx context.Context) ([]TeamReviewBudget, error) {
+	rows, err := q.db.Query(ctx, listTeamReviewBudgets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamReviewBudget
+	for rows.Next() {
+		var i TeamReviewBudget
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.ReviewsPerSprint,
+			&i.SprintDays,
+			&i.SprintStartedAt,
+			&i.AlertedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamSizeRules = `-- name: ListTeamSizeRules :many
+SELECT team_id, position, max_lines_changed, max_files_changed, reviewers FROM team_size_rules
+WHERE team_id = $1
+ORDER BY position
+`
+
+func (q *Queries) ListTeamSizeRules(ctx context.Context, teamID int32) ([]TeamSizeRule, error) {
+	rows, err := q.db.Query(ctx, listTeamSizeRules, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamSizeRule
+	for rows.Next() {
+		var i TeamSizeRule
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Position,
+			&i.MaxLinesChanged,
+			&i.MaxFilesChanged,
+			&i.Reviewers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTeams = `-- name: ListTeams :many
-SELECT team_id, team_name, is_active FROM teams
+SELECT team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent FROM teams
+ORDER BY team_name
 `
 
 func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
@@ -98,7 +876,25 @@ func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
 	var items []Team
 	for rows.Next() {
 		var i Team
-		if err := rows.Scan(&i.TeamID, &i.TeamName, &i.IsActive); err != nil {
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.TeamName,
+			&i.IsActive,
+			&i.ParentTeamID,
+			&i.EscalateToParent,
+			&i.RequiredReviewerRole,
+			&i.LeadUserID,
+			&i.EscalationNotifyAfterHours,
+			&i.EscalationAddReviewerAfterHours,
+			&i.DeactivateAt,
+			&i.ReviewCooldownPrs,
+			&i.ChecklistItems,
+			&i.ChecklistRequired,
+			&i.AllowDuplicateUsernames,
+			&i.AllowSelfReview,
+			&i.OptionalReviewers,
+			&i.ShadowReviewPercent,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -109,11 +905,467 @@ func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
 	return items, nil
 }
 
+const listTeamsDueForDeactivation = `-- name: ListTeamsDueForDeactivation :many
+SELECT team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent FROM teams
+WHERE deactivate_at <= NOW()
+ORDER BY deactivate_at, team_id
+LIMIT $1
+`
+
+func (q *Queries) ListTeamsDueForDeactivation(ctx context.Context, limit int32) ([]Team, error) {
+	rows, err := q.db.Query(ctx, listTeamsDueForDeactivation, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.TeamName,
+			&i.IsActive,
+			&i.ParentTeamID,
+			&i.EscalateToParent,
+			&i.RequiredReviewerRole,
+			&i.LeadUserID,
+			&i.EscalationNotifyAfterHours,
+			&i.EscalationAddReviewerAfterHours,
+			&i.DeactivateAt,
+			&i.ReviewCooldownPrs,
+			&i.ChecklistItems,
+			&i.ChecklistRequired,
+			&i.AllowDuplicateUsernames,
+			&i.AllowSelfReview,
+			&i.OptionalReviewers,
+			&i.ShadowReviewPercent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resetTeamReviewBudgetUsage = `-- name: ResetTeamReviewBudgetUsage :exec
+UPDATE user_review_budgets ub
+SET used = 0
+FROM users u
+WHERE u.user_id = ub.user_id AND u.team_id = $1
+`
+
+func (q *Queries) ResetTeamReviewBudgetUsage(ctx context.Context, teamID int32) error {
+	_, err := q.db.Exec(ctx, resetTeamReviewBudgetUsage, teamID)
+	return err
+}
+
+const scheduleTeamDeactivation = `-- name: ScheduleTeamDeactivation :one
+UPDATE teams
+SET deactivate_at = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type ScheduleTeamDeactivationParams struct {
+	TeamID       int32
+	DeactivateAt pgtype.Timestamptz
+}
+
+func (q *Queries) ScheduleTeamDeactivation(ctx context.Context, arg ScheduleTeamDeactivationParams) (Team, error) {
+	row := q.db.QueryRow(ctx, scheduleTeamDeactivation, arg.TeamID, arg.DeactivateAt)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamAllowDuplicateUsernames = `-- name: SetTeamAllowDuplicateUsernames :one
+UPDATE teams
+SET allow_duplicate_usernames = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamAllowDuplicateUsernamesParams struct {
+	TeamID                  int32
+	AllowDuplicateUsernames bool
+}
+
+func (q *Queries) SetTeamAllowDuplicateUsernames(ctx context.Context, arg SetTeamAllowDuplicateUsernamesParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamAllowDuplicateUsernames, arg.TeamID, arg.AllowDuplicateUsernames)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamAllowSelfReview = `-- name: SetTeamAllowSelfReview :one
+UPDATE teams
+SET allow_self_review = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamAllowSelfReviewParams struct {
+	TeamID          int32
+	AllowSelfReview bool
+}
+
+func (q *Queries) SetTeamAllowSelfReview(ctx context.Context, arg SetTeamAllowSelfReviewParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamAllowSelfReview, arg.TeamID, arg.AllowSelfReview)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamChecklist = `-- name: SetTeamChecklist :one
+UPDATE teams
+SET checklist_items = $2, checklist_required = $3
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamChecklistParams struct {
+	TeamID            int32
+	ChecklistItems    []string
+	ChecklistRequired bool
+}
+
+func (q *Queries) SetTeamChecklist(ctx context.Context, arg SetTeamChecklistParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamChecklist, arg.TeamID, arg.ChecklistItems, arg.ChecklistRequired)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamEscalationPolicy = `-- name: SetTeamEscalationPolicy :one
+UPDATE teams
+SET lead_user_id = $2, escalation_notify_after_hours = $3, escalation_add_reviewer_after_hours = $4
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamEscalationPolicyParams struct {
+	TeamID                          int32
+	LeadUserID                      pgtype.Text
+	EscalationNotifyAfterHours      int32
+	EscalationAddReviewerAfterHours int32
+}
+
+func (q *Queries) SetTeamEscalationPolicy(ctx context.Context, arg SetTeamEscalationPolicyParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamEscalationPolicy,
+		arg.TeamID,
+		arg.LeadUserID,
+		arg.EscalationNotifyAfterHours,
+		arg.EscalationAddReviewerAfterHours,
+	)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamOptionalReviewers = `-- name: SetTeamOptionalReviewers :one
+UPDATE teams
+SET optional_reviewers = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamOptionalReviewersParams struct {
+	TeamID            int32
+	OptionalReviewers int32
+}
+
+func (q *Queries) SetTeamOptionalReviewers(ctx context.Context, arg SetTeamOptionalReviewersParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamOptionalReviewers, arg.TeamID, arg.OptionalReviewers)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamParent = `-- name: SetTeamParent :one
+UPDATE teams
+SET parent_team_id = $2, escalate_to_parent = $3
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamParentParams struct {
+	TeamID           int32
+	ParentTeamID     pgtype.Int4
+	EscalateToParent bool
+}
+
+func (q *Queries) SetTeamParent(ctx context.Context, arg SetTeamParentParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamParent, arg.TeamID, arg.ParentTeamID, arg.EscalateToParent)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamRequiredReviewerRole = `-- name: SetTeamRequiredReviewerRole :one
+UPDATE teams
+SET required_reviewer_role = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamRequiredReviewerRoleParams struct {
+	TeamID               int32
+	RequiredReviewerRole string
+}
+
+func (q *Queries) SetTeamRequiredReviewerRole(ctx context.Context, arg SetTeamRequiredReviewerRoleParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamRequiredReviewerRole, arg.TeamID, arg.RequiredReviewerRole)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamReviewCooldown = `-- name: SetTeamReviewCooldown :one
+UPDATE teams
+SET review_cooldown_prs = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamReviewCooldownParams struct {
+	TeamID            int32
+	ReviewCooldownPrs int32
+}
+
+func (q *Queries) SetTeamReviewCooldown(ctx context.Context, arg SetTeamReviewCooldownParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamReviewCooldown, arg.TeamID, arg.ReviewCooldownPrs)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const setTeamShadowReviewPercent = `-- name: SetTeamShadowReviewPercent :one
+UPDATE teams
+SET shadow_review_percent = $2
+WHERE team_id = $1
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
+`
+
+type SetTeamShadowReviewPercentParams struct {
+	TeamID              int32
+	ShadowReviewPercent int32
+}
+
+func (q *Queries) SetTeamShadowReviewPercent(ctx context.Context, arg SetTeamShadowReviewPercentParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamShadowReviewPercent, arg.TeamID, arg.ShadowReviewPercent)
+	var i Team
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const startReviewSprint = `-- name: StartReviewSprint :execrows
+UPDATE team_review_budgets
+SET sprint_started_at = $1, alerted_at = NULL
+WHERE team_id = $2 AND sprint_started_at < $1
+`
+
+type StartReviewSprintParams struct {
+	StartedAt pgtype.Timestamptz
+	TeamID    int32
+}
+
+// Moves the budget to a new sprint unless another run already did.
+func (q *Queries) StartReviewSprint(ctx context.Context, arg StartReviewSprintParams) (int64, error) {
+	result, err := q.db.Exec(ctx, startReviewSprint, arg.StartedAt, arg.TeamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateTeamName = `-- name: UpdateTeamName :one
 UPDATE teams
 SET team_name = $2
 WHERE team_id = $1
-RETURNING team_id, team_name, is_active
+RETURNING team_id, team_name, is_active, parent_team_id, escalate_to_parent, required_reviewer_role, lead_user_id, escalation_notify_after_hours, escalation_add_reviewer_after_hours, deactivate_at, review_cooldown_prs, checklist_items, checklist_required, allow_duplicate_usernames, allow_self_review, optional_reviewers, shadow_review_percent
 `
 
 type UpdateTeamNameParams struct {
@@ -124,6 +1376,155 @@ type UpdateTeamNameParams struct {
 func (q *Queries) UpdateTeamName(ctx context.Context, arg UpdateTeamNameParams) (Team, error) {
 	row := q.db.QueryRow(ctx, updateTeamName, arg.TeamID, arg.TeamName)
 	var i Team
-	err := row.Scan(&i.TeamID, &i.TeamName, &i.IsActive)
+	err := row.Scan(
+		&i.TeamID,
+		&i.TeamName,
+		&i.IsActive,
+		&i.ParentTeamID,
+		&i.EscalateToParent,
+		&i.RequiredReviewerRole,
+		&i.LeadUserID,
+		&i.EscalationNotifyAfterHours,
+		&i.EscalationAddReviewerAfterHours,
+		&i.DeactivateAt,
+		&i.ReviewCooldownPrs,
+		&i.ChecklistItems,
+		&i.ChecklistRequired,
+		&i.AllowDuplicateUsernames,
+		&i.AllowSelfReview,
+		&i.OptionalReviewers,
+		&i.ShadowReviewPercent,
+	)
+	return i, err
+}
+
+const upsertReviewRotation = `-- name: UpsertReviewRotation :one
+INSERT INTO review_rotations (team_id, start_date, member_ids)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET start_date = EXCLUDED.start_date,
+        member_ids = EXCLUDED.member_ids,
+        updated_at = NOW()
+RETURNING team_id, start_date, member_ids, updated_at
+`
+
+type UpsertReviewRotationParams struct {
+	TeamID    int32
+	StartDate pgtype.Date
+	MemberIds []string
+}
+
+func (q *Queries) UpsertReviewRotation(ctx context.Context, arg UpsertReviewRotationParams) (ReviewRotation, error) {
+	row := q.db.QueryRow(ctx, upsertReviewRotation, arg.TeamID, arg.StartDate, arg.MemberIds)
+	var i ReviewRotation
+	err := row.Scan(
+		&i.TeamID,
+		&i.StartDate,
+		&i.MemberIds,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertReviewRotationOverride = `-- name: UpsertReviewRotationOverride :exec
+INSERT INTO review_rotation_overrides (team_id, week_start, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id, week_start) DO UPDATE SET user_id = EXCLUDED.user_id
+`
+
+type UpsertReviewRotationOverrideParams struct {
+	TeamID    int32
+	WeekStart pgtype.Date
+	UserID    string
+}
+
+func (q *Queries) UpsertReviewRotationOverride(ctx context.Context, arg UpsertReviewRotationOverrideParams) error {
+	_, err := q.db.Exec(ctx, upsertReviewRotationOverride, arg.TeamID, arg.WeekStart, arg.UserID)
+	return err
+}
+
+const upsertTeamPRQuota = `-- name: UpsertTeamPRQuota :one
+INSERT INTO team_pr_quotas (team_id, max_open_prs, mode)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET max_open_prs = EXCLUDED.max_open_prs,
+        mode = EXCLUDED.mode
+RETURNING team_id, max_open_prs, mode
+`
+
+type UpsertTeamPRQuotaParams struct {
+	TeamID     int32
+	MaxOpenPrs int32
+	Mode       string
+}
+
+func (q *Queries) UpsertTeamPRQuota(ctx context.Context, arg UpsertTeamPRQuotaParams) (TeamPrQuota, error) {
+	row := q.db.QueryRow(ctx, upsertTeamPRQuota, arg.TeamID, arg.MaxOpenPrs, arg.Mode)
+	var i TeamPrQuota
+	err := row.Scan(&i.TeamID, &i.MaxOpenPrs, &i.Mode)
+	return i, err
+}
+
+const upsertTeamReportSchedule = `-- name: UpsertTeamReportSchedule :one
+INSERT INTO team_report_schedules (team_id, weekday, hour, timezone)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id) DO UPDATE
+    SET weekday = EXCLUDED.weekday,
+        hour = EXCLUDED.hour,
+        timezone = EXCLUDED.timezone
+RETURNING team_id, weekday, hour, timezone, created_at, last_sent_at
+`
+
+type UpsertTeamReportScheduleParams struct {
+	TeamID   int32
+	Weekday  int16
+	Hour     int16
+	Timezone string
+}
+
+func (q *Queries) UpsertTeamReportSchedule(ctx context.Context, arg UpsertTeamReportScheduleParams) (TeamReportSchedule, error) {
+	row := q.db.QueryRow(ctx, upsertTeamReportSchedule,
+		arg.TeamID,
+		arg.Weekday,
+		arg.Hour,
+		arg.Timezone,
+	)
+	var i TeamReportSchedule
+	err := row.Scan(
+		&i.TeamID,
+		&i.Weekday,
+		&i.Hour,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.LastSentAt,
+	)
+	return i, err
+}
+
+const upsertTeamReviewBudget = `-- name: UpsertTeamReviewBudget :one
+INSERT INTO team_review_budgets (team_id, reviews_per_sprint, sprint_days)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id) DO UPDATE
+    SET reviews_per_sprint = EXCLUDED.reviews_per_sprint,
+        sprint_days = EXCLUDED.sprint_days
+RETURNING team_id, reviews_per_sprint, sprint_days, sprint_started_at, alerted_at
+`
+
+type UpsertTeamReviewBudgetParams struct {
+	TeamID           int32
+	ReviewsPerSprint int32
+	SprintDays       int32
+}
+
+func (q *Queries) UpsertTeamReviewBudget(ctx context.Context, arg UpsertTeamReviewBudgetParams) (TeamReviewBudget, error) {
+	row := q.db.QueryRow(ctx, upsertTeamReviewBudget, arg.TeamID, arg.ReviewsPerSprint, arg.SprintDays)
+	var i TeamReviewBudget
+	err := row.Scan(
+		&i.TeamID,
+		&i.ReviewsPerSprint,
+		&i.SprintDays,
+		&i.SprintStartedAt,
+		&i.AlertedAt,
+	)
 	return i, err
 }
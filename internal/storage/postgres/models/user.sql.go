@@ -7,8 +7,35 @@ package models
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const copyReviewAssignmentsToUser = `-- name: CopyReviewAssignmentsToUser :execrows
+INSERT INTO review_assignments (pr_id, user_id, approved_at, assigned_at, acked_at, ack_reminded_at, changes_requested_at, reviewed_at, optional)
+SELECT s.pr_id, $1, s.approved_at, s.assigned_at, s.acked_at, s.ack_reminded_at, s.changes_requested_at, s.reviewed_at, s.optional
+FROM review_assignments s
+JOIN pull_requests pr ON pr.pr_id = s.pr_id
+WHERE s.user_id = $2
+  AND pr.author_id <> $1
+ON CONFLICT (pr_id, user_id) DO NOTHING
+`
+
+type CopyReviewAssignmentsToUserParams struct {
+	TargetID string
+	SourceID string
+}
+
+// Inserting (rather than updating user_id) keeps the review counters in sync
+// through their triggers. PRs authored by the target are skipped.
+func (q *Queries) CopyReviewAssignmentsToUser(ctx context.Context, arg CopyReviewAssignmentsToUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, copyReviewAssignmentsToUser, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countUsers = `-- name: CountUsers :one
 SELECT count(*) FROM users
 `
@@ -23,7 +50,7 @@ func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (user_id, username, team_id, is_active)
 VALUES ($1, $2, $3, $4)
-RETURNING user_id, username, team_id, is_active, created_at
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
 `
 
 type CreateUserParams struct {
@@ -47,6 +74,9 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.TeamID,
 		&i.IsActive,
 		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
 	)
 	return i, err
 }
@@ -79,8 +109,74 @@ func (q *Queries) DeactivateUsersByTeam(ctx context.Context, teamID int32) ([]st
 	return items, nil
 }
 
+const deleteDuplicateArchivedReviews = `-- name: DeleteDuplicateArchivedReviews :exec
+DELETE FROM review_assignments_archive s
+USING review_assignments_archive t
+WHERE s.pr_id = t.pr_id
+  AND s.user_id = $1
+  AND t.user_id = $2
+`
+
+type DeleteDuplicateArchivedReviewsParams struct {
+	SourceID string
+	TargetID string
+}
+
+func (q *Queries) DeleteDuplicateArchivedReviews(ctx context.Context, arg DeleteDuplicateArchivedReviewsParams) error {
+	_, err := q.db.Exec(ctx, deleteDuplicateArchivedReviews, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const deleteReviewsOfAuthor = `-- name: DeleteReviewsOfAuthor :execrows
+DELETE FROM review_assignments ra
+USING pull_requests pr
+WHERE pr.pr_id = ra.pr_id
+  AND ra.user_id = $1
+  AND pr.author_id = $2
+`
+
+type DeleteReviewsOfAuthorParams struct {
+	ReviewerID string
+	AuthorID   string
+}
+
+// Drops the reviewer's assignments on PRs written by the author.
+func (q *Queries) DeleteReviewsOfAuthor(ctx context.Context, arg DeleteReviewsOfAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReviewsOfAuthor, arg.ReviewerID, arg.AuthorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, userID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserReviewAssignments = `-- name: DeleteUserReviewAssignments :execrows
+DELETE FROM review_assignments
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserReviewAssignments(ctx context.Context, userID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserReviewAssignments, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getActiveUsersFromTeamExcluding = `-- name: GetActiveUsersFromTeamExcluding :many
-SELECT user_id, username, team_id, is_active, created_at
+SELECT user_id, username, team_id, is_active, created_at, role, skills, in_training
 FROM users
 WHERE team_id = $1
   AND is_active = true
@@ -107,6 +203,9 @@ func (q *Queries) GetActiveUsersFromTeamExcluding(ctx context.Context, arg GetAc
 			&i.TeamID,
 			&i.IsActive,
 			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
 		); err != nil {
 			return nil, err
 		}
@@ -119,7 +218,7 @@ func (q *Queries) GetActiveUsersFromTeamExcluding(ctx context.Context, arg GetAc
 }
 
 const getTeamMembers = `-- name: GetTeamMembers :many
-SELECT user_id, username, team_id, is_active, created_at FROM users
+SELECT user_id, username, team_id, is_active, created_at, role, skills, in_training FROM users
 WHERE team_id = $1
 `
 
@@ -138,6 +237,9 @@ func (q *Queries) GetTeamMembers(ctx context.Context, teamID int32) ([]User, err
 			&i.TeamID,
 			&i.IsActive,
 			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
 		); err != nil {
 			return nil, err
 		}
@@ -150,7 +252,7 @@ func (q *Queries) GetTeamMembers(ctx context.Context, teamID int32) ([]User, err
 }
 
 const getUserWithTeam = `-- name: GetUserWithTeam :one
-SELECT u.user_id, u.username, u.is_active, t.team_id, t.team_name, t.is_active as team_is_active
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name, t.is_active as team_is_active
 FROM users u
 JOIN teams t ON u.team_id = t.team_id
 WHERE u.user_id = $1
@@ -160,6 +262,9 @@ type GetUserWithTeamRow struct {
 	UserID       string
 	Username     string
 	IsActive     bool
+	Role         string
+	Skills       []string
+	InTraining   bool
 	TeamID       int32
 	TeamName     string
 	TeamIsActive bool
@@ -172,6 +277,9 @@ func (q *Queries) GetUserWithTeam(ctx context.Context, userID string) (GetUserWi
 		&i.UserID,
 		&i.Username,
 		&i.IsActive,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
 		&i.TeamID,
 		&i.TeamName,
 		&i.TeamIsActive,
@@ -180,7 +288,7 @@ func (q *Queries) GetUserWithTeam(ctx context.Context, userID string) (GetUserWi
 }
 
 const getUsersByIDs = `-- name: GetUsersByIDs :many
-SELECT user_id, username, team_id, is_active, created_at FROM users
+SELECT user_id, username, team_id, is_active, created_at, role, skills, in_training FROM users
 WHERE user_id = ANY($1::varchar[])
 `
 
@@ -199,6 +307,9 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, dollar_1 []string) ([]User,
 			&i.TeamID,
 			&i.IsActive,
 			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
 		); err != nil {
 			return nil, err
 		}
@@ -210,8 +321,130 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, dollar_1 []string) ([]User,
 	return items, nil
 }
 
+const getUsersByUsername = `-- name: GetUsersByUsername :many
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name, t.is_active as team_is_active
+FROM users u
+JOIN teams t ON u.team_id = t.team_id
+WHERE u.username = $1::text
+  AND ($2::text = '' OR t.team_name = $2::text)
+ORDER BY t.team_name, u.user_id
+`
+
+type GetUsersByUsernameParams struct {
+	Username string
+	TeamName string
+}
+
+type GetUsersByUsernameRow struct {
+	UserID       string
+	Username     string
+	IsActive     bool
+	Role         string
+	Skills       []string
+	InTraining   bool
+	TeamID       int32
+	TeamName     string
+	TeamIsActive bool
+}
+
+func (q *Queries) GetUsersByUsername(ctx context.Context, arg GetUsersByUsernameParams) ([]GetUsersByUsernameRow, error) {
+	rows, err := q.db.Query(ctx, getUsersByUsername, arg.Username, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsersByUsernameRow
+	for rows.Next() {
+		var i GetUsersByUsernameRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.IsActive,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+			&i.TeamID,
+			&i.TeamName,
+			&i.TeamIsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveTeamMembers = `-- name: ListActiveTeamMembers :many
+SELECT user_id, username, team_id, is_active, created_at, role, skills, in_training FROM users
+WHERE team_id = $1 AND is_active = true
+ORDER BY user_id
+`
+
+func (q *Queries) ListActiveTeamMembers(ctx context.Context, teamID int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, listActiveTeamMembers, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamID,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewBudgetUsage = `-- name: ListReviewBudgetUsage :many
+SELECT user_id, used
+FROM user_review_budgets
+WHERE user_id = ANY($1::varchar[])
+`
+
+type ListReviewBudgetUsageRow struct {
+	UserID string
+	Used   int32
+}
+
+func (q *Queries) ListReviewBudgetUsage(ctx context.Context, userIds []string) ([]ListReviewBudgetUsageRow, error) {
+	rows, err := q.db.Query(ctx, listReviewBudgetUsage, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReviewBudgetUsageRow
+	for rows.Next() {
+		var i ListReviewBudgetUsageRow
+		if err := rows.Scan(&i.UserID, &i.Used); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
-SELECT user_id, username, team_id, is_active, created_at FROM users
+SELECT user_id, username, team_id, is_active, created_at, role, skills, in_training FROM users
 `
 
 func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
@@ -229,6 +462,56 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 			&i.TeamID,
 			&i.IsActive,
 			&i.CreatedAt,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersWithTeam = `-- name: ListUsersWithTeam :many
+SELECT u.user_id, u.username, u.is_active, u.role, u.skills, u.in_training, t.team_id, t.team_name
+FROM users u
+JOIN teams t ON u.team_id = t.team_id
+ORDER BY u.user_id
+`
+
+type ListUsersWithTeamRow struct {
+	UserID     string
+	Username   string
+	IsActive   bool
+	Role       string
+	Skills     []string
+	InTraining bool
+	TeamID     int32
+	TeamName   string
+}
+
+func (q *Queries) ListUsersWithTeam(ctx context.Context) ([]ListUsersWithTeamRow, error) {
+	rows, err := q.db.Query(ctx, listUsersWithTeam)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersWithTeamRow
+	for rows.Next() {
+		var i ListUsersWithTeamRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.IsActive,
+			&i.Role,
+			&i.Skills,
+			&i.InTraining,
+			&i.TeamID,
+			&i.TeamName,
 		); err != nil {
 			return nil, err
 		}
@@ -240,11 +523,367 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 	return items, nil
 }
 
+const lockUsers = `-- name: LockUsers :many
+SELECT user_id FROM users
+WHERE user_id = ANY($1::text[])
+ORDER BY user_id
+FOR UPDATE
+`
+
+func (q *Queries) LockUsers(ctx context.Context, dollar_1 []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, lockUsers, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeReviewProgress = `-- name: MergeReviewProgress :exec
+UPDATE review_assignments t
+SET approved_at = COALESCE(t.approved_at, s.approved_at),
+    changes_requested_at = CASE
+        WHEN t.approved_at IS NULL AND s.approved_at IS NULL
+        THEN COALESCE(t.changes_requested_at, s.changes_requested_at)
+    END,
+    reviewed_at = LEAST(t.reviewed_at, s.reviewed_at),
+    acked_at = COALESCE(t.acked_at, s.acked_at),
+    optional = t.optional AND s.optional
+FROM review_assignments s
+WHERE s.pr_id = t.pr_id
+  AND s.user_id = $1
+  AND t.user_id = $2
+`
+
+type MergeReviewProgressParams struct {
+	SourceID string
+	TargetID string
+}
+
+// On PRs reviewed by both users the target keeps its assignment and takes over
+// the source's verdict and acknowledgement. An approval by either of them
+// wins over a request for changes.
+func (q *Queries) MergeReviewProgress(ctx context.Context, arg MergeReviewProgressParams) error {
+	_, err := q.db.Exec(ctx, mergeReviewProgress, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const moveArchivedPRAuthor = `-- name: MoveArchivedPRAuthor :exec
+UPDATE pull_requests_archive
+SET author_id = $1
+WHERE author_id = $2
+`
+
+type MoveArchivedPRAuthorParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveArchivedPRAuthor(ctx context.Context, arg MoveArchivedPRAuthorParams) error {
+	_, err := q.db.Exec(ctx, moveArchivedPRAuthor, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveArchivedReviewAssignments = `-- name: MoveArchivedReviewAssignments :exec
+UPDATE review_assignments_archive
+SET user_id = $1
+WHERE user_id = $2
+`
+
+type MoveArchivedReviewAssignmentsParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveArchivedReviewAssignments(ctx context.Context, arg MoveArchivedReviewAssignmentsParams) error {
+	_, err := q.db.Exec(ctx, moveArchivedReviewAssignments, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveChecklistChecks = `-- name: MoveChecklistChecks :exec
+UPDATE pr_checklist_items
+SET checked_by = $1
+WHERE checked_by = $2
+`
+
+type MoveChecklistChecksParams struct {
+	TargetID pgtype.Text
+	SourceID pgtype.Text
+}
+
+func (q *Queries) MoveChecklistChecks(ctx context.Context, arg MoveChecklistChecksParams) error {
+	_, err := q.db.Exec(ctx, moveChecklistChecks, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveGitHubAccount = `-- name: MoveGitHubAccount :execrows
+UPDATE github_accounts ga
+SET user_id = $1
+WHERE ga.user_id = $2
+  AND NOT EXISTS (SELECT 1 FROM github_accounts t WHERE t.user_id = $1)
+`
+
+type MoveGitHubAccountParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveGitHubAccount(ctx context.Context, arg MoveGitHubAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveGitHubAccount, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const moveNotificationPreferences = `-- name: MoveNotificationPreferences :exec
+UPDATE notification_preferences np
+SET user_id = $1
+WHERE np.user_id = $2
+  AND NOT EXISTS (SELECT 1 FROM notification_preferences t WHERE t.user_id = $1)
+`
+
+type MoveNotificationPreferencesParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveNotificationPreferences(ctx context.Context, arg MoveNotificationPreferencesParams) error {
+	_, err := q.db.Exec(ctx, moveNotificationPreferences, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const movePRAuthor = `-- name: MovePRAuthor :execrows
+UPDATE pull_requests
+SET author_id = $1
+WHERE author_id = $2
+`
+
+type MovePRAuthorParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MovePRAuthor(ctx context.Context, arg MovePRAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, movePRAuthor, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const movePRTemplateReviewers = `-- name: MovePRTemplateReviewers :exec
+UPDATE pr_templates
+SET default_reviewers = CASE
+        WHEN $1::text = ANY(default_reviewers) THEN array_remove(default_reviewers, $2::text)
+        ELSE array_replace(default_reviewers, $2::text, $1::text)
+    END
+WHERE $2::text = ANY(default_reviewers)
+`
+
+type MovePRTemplateReviewersParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MovePRTemplateReviewers(ctx context.Context, arg MovePRTemplateReviewersParams) error {
+	_, err := q.db.Exec(ctx, movePRTemplateReviewers, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const movePendingNotifications = `-- name: MovePendingNotifications :exec
+UPDATE notification_outbox
+SET user_id = $1
+WHERE user_id = $2 AND sent_at IS NULL
+`
+
+type MovePendingNotificationsParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MovePendingNotifications(ctx context.Context, arg MovePendingNotificationsParams) error {
+	_, err := q.db.Exec(ctx, movePendingNotifications, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveReassignmentHistory = `-- name: MoveReassignmentHistory :exec
+UPDATE reassignments
+SET from_user_id = CASE WHEN from_user_id = $1 THEN $2 ELSE from_user_id END,
+    to_user_id = CASE WHEN to_user_id = $1 THEN $2 ELSE to_user_id END
+WHERE from_user_id = $1 OR to_user_id = $1
+`
+
+type MoveReassignmentHistoryParams struct {
+	SourceID pgtype.Text
+	TargetID pgtype.Text
+}
+
+func (q *Queries) MoveReassignmentHistory(ctx context.Context, arg MoveReassignmentHistoryParams) error {
+	_, err := q.db.Exec(ctx, moveReassignmentHistory, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const moveReviewRotationMembers = `-- name: MoveReviewRotationMembers :exec
+UPDATE review_rotations
+SET member_ids = CASE
+        WHEN $1::text = ANY(member_ids) THEN array_remove(member_ids, $2::text)
+        ELSE array_replace(member_ids, $2::text, $1::text)
+    END
+WHERE $2::text = ANY(member_ids)
+`
+
+type MoveReviewRotationMembersParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveReviewRotationMembers(ctx context.Context, arg MoveReviewRotationMembersParams) error {
+	_, err := q.db.Exec(ctx, moveReviewRotationMembers, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveReviewRotationOverrides = `-- name: MoveReviewRotationOverrides :exec
+UPDATE review_rotation_overrides
+SET user_id = $1
+WHERE user_id = $2
+`
+
+type MoveReviewRotationOverridesParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveReviewRotationOverrides(ctx context.Context, arg MoveReviewRotationOverridesParams) error {
+	_, err := q.db.Exec(ctx, moveReviewRotationOverrides, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveReviewerPool = `-- name: MoveReviewerPool :exec
+UPDATE team_reviewer_pool p
+SET user_id = $1
+WHERE p.user_id = $2
+  AND NOT EXISTS (SELECT 1 FROM team_reviewer_pool t WHERE t.team_id = p.team_id AND t.user_id = $1)
+`
+
+type MoveReviewerPoolParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveReviewerPool(ctx context.Context, arg MoveReviewerPoolParams) error {
+	_, err := q.db.Exec(ctx, moveReviewerPool, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveReviewerPreferences = `-- name: MoveReviewerPreferences :exec
+INSERT INTO reviewer_preferences (team_id, author_id, reviewer_id, weight)
+SELECT p.team_id,
+       CASE WHEN p.author_id = $1 THEN $2 ELSE p.author_id END AS author_id,
+       CASE WHEN p.reviewer_id = $1 THEN $2 ELSE p.reviewer_id END AS reviewer_id,
+       p.weight
+FROM reviewer_preferences p
+WHERE (p.author_id = $1 OR p.reviewer_id = $1)
+  AND p.author_id <> $2
+  AND p.reviewer_id <> $2
+ON CONFLICT DO NOTHING
+`
+
+type MoveReviewerPreferencesParams struct {
+	SourceID string
+	TargetID string
+}
+
+// Pairs that would point the target at itself or that the target already has are dropped
+func (q *Queries) MoveReviewerPreferences(ctx context.Context, arg MoveReviewerPreferencesParams) error {
+	_, err := q.db.Exec(ctx, moveReviewerPreferences, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const moveSavedFilterReferences = `-- name: MoveSavedFilterReferences :exec
+UPDATE saved_filters
+SET author_id = CASE WHEN author_id = $1::text THEN $2::text ELSE author_id END,
+    reviewer_id = CASE WHEN reviewer_id = $1::text THEN $2::text ELSE reviewer_id END
+WHERE author_id = $1::text OR reviewer_id = $1::text
+`
+
+type MoveSavedFilterReferencesParams struct {
+	SourceID string
+	TargetID string
+}
+
+func (q *Queries) MoveSavedFilterReferences(ctx context.Context, arg MoveSavedFilterReferencesParams) error {
+	_, err := q.db.Exec(ctx, moveSavedFilterReferences, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const moveSavedFilters = `-- name: MoveSavedFilters :exec
+UPDATE saved_filters sf
+SET user_id = $1
+WHERE sf.user_id = $2
+  AND NOT EXISTS (SELECT 1 FROM saved_filters t WHERE t.user_id = $1 AND t.filter_name = sf.filter_name)
+`
+
+type MoveSavedFiltersParams struct {
+	TargetID pgtype.Text
+	SourceID pgtype.Text
+}
+
+// Filters named like one the target already has stay with the source.
+func (q *Queries) MoveSavedFilters(ctx context.Context, arg MoveSavedFiltersParams) error {
+	_, err := q.db.Exec(ctx, moveSavedFilters, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveSlackAccount = `-- name: MoveSlackAccount :exec
+UPDATE slack_accounts sa
+SET user_id = $1
+WHERE sa.user_id = $2
+  AND NOT EXISTS (SELECT 1 FROM slack_accounts t WHERE t.user_id = $1)
+`
+
+type MoveSlackAccountParams struct {
+	TargetID string
+	SourceID string
+}
+
+func (q *Queries) MoveSlackAccount(ctx context.Context, arg MoveSlackAccountParams) error {
+	_, err := q.db.Exec(ctx, moveSlackAccount, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveTeamLead = `-- name: MoveTeamLead :exec
+UPDATE teams
+SET lead_user_id = $1
+WHERE lead_user_id = $2
+`
+
+type MoveTeamLeadParams struct {
+	TargetID pgtype.Text
+	SourceID pgtype.Text
+}
+
+func (q *Queries) MoveTeamLead(ctx context.Context, arg MoveTeamLeadParams) error {
+	_, err := q.db.Exec(ctx, moveTeamLead, arg.TargetID, arg.SourceID)
+	return err
+}
+
 const moveUserToTeam = `-- name: MoveUserToTeam :one
 UPDATE users
 SET team_id = $2
 WHERE user_id = $1
-RETURNING user_id, username, team_id, is_active, created_at
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
 `
 
 type MoveUserToTeamParams struct {
@@ -261,15 +900,34 @@ func (q *Queries) MoveUserToTeam(ctx context.Context, arg MoveUserToTeamParams)
 		&i.TeamID,
 		&i.IsActive,
 		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
 	)
 	return i, err
 }
 
+const moveWebhookDeliveries = `-- name: MoveWebhookDeliveries :exec
+UPDATE webhook_deliveries
+SET user_id = $1
+WHERE user_id = $2
+`
+
+type MoveWebhookDeliveriesParams struct {
+	TargetID pgtype.Text
+	SourceID pgtype.Text
+}
+
+func (q *Queries) MoveWebhookDeliveries(ctx context.Context, arg MoveWebhookDeliveriesParams) error {
+	_, err := q.db.Exec(ctx, moveWebhookDeliveries, arg.TargetID, arg.SourceID)
+	return err
+}
+
 const setUserActiveStatus = `-- name: SetUserActiveStatus :one
 UPDATE users
 SET is_active = $2
 WHERE user_id = $1
-RETURNING user_id, username, team_id, is_active, created_at
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
 `
 
 type SetUserActiveStatusParams struct {
@@ -286,6 +944,93 @@ func (q *Queries) SetUserActiveStatus(ctx context.Context, arg SetUserActiveStat
 		&i.TeamID,
 		&i.IsActive,
 		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
+	)
+	return i, err
+}
+
+const setUserInTraining = `-- name: SetUserInTraining :one
+UPDATE users
+SET in_training = $2
+WHERE user_id = $1
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
+`
+
+type SetUserInTrainingParams struct {
+	UserID     string
+	InTraining bool
+}
+
+func (q *Queries) SetUserInTraining(ctx context.Context, arg SetUserInTrainingParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserInTraining, arg.UserID, arg.InTraining)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.TeamID,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
+	)
+	return i, err
+}
+
+const setUserRole = `-- name: SetUserRole :one
+UPDATE users
+SET role = $2
+WHERE user_id = $1
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
+`
+
+type SetUserRoleParams struct {
+	UserID string
+	Role   string
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserRole, arg.UserID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.TeamID,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
+	)
+	return i, err
+}
+
+const setUserSkills = `-- name: SetUserSkills :one
+UPDATE users
+SET skills = $2
+WHERE user_id = $1
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
+`
+
+type SetUserSkillsParams struct {
+	UserID string
+	Skills []string
+}
+
+func (q *Queries) SetUserSkills(ctx context.Context, arg SetUserSkillsParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserSkills, arg.UserID, arg.Skills)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.TeamID,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
 	)
 	return i, err
 }
@@ -296,7 +1041,7 @@ SET username = $2,
     team_id = $3,
     is_active = $4
 WHERE user_id = $1
-RETURNING user_id, username, team_id, is_active, created_at
+RETURNING user_id, username, team_id, is_active, created_at, role, skills, in_training
 `
 
 type UpdateUserParams struct {
@@ -320,6 +1065,9 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.TeamID,
 		&i.IsActive,
 		&i.CreatedAt,
+		&i.Role,
+		&i.Skills,
+		&i.InTraining,
 	)
 	return i, err
 }
@@ -1,23 +1,33 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/glebmavi/pr_reviewer_service/internal/domain"
 	"github.com/glebmavi/pr_reviewer_service/internal/storage/postgres/models"
+	"github.com/glebmavi/pr_reviewer_service/internal/testhooks"
 )
 
 type Repository struct {
 	pool *pgxpool.Pool
 	log  *slog.Logger
+	// faults are injected into queries by end-to-end tests; nil otherwise.
+	faults *testhooks.Faults
 }
 
 func NewRepository(pool *pgxpool.Pool, log *slog.Logger) *Repository {
@@ -27,32 +37,216 @@ func NewRepository(pool *pgxpool.Pool, log *slog.Logger) *Repository {
 	}
 }
 
-func (r *Repository) querier(tx pgx.Tx) models.Querier {
+// InjectFaults runs every query through faults first. Only for test hooks.
+func (r *Repository) InjectFaults(faults *testhooks.Faults) {
+	r.faults = faults
+}
+
+// querier runs queries in tx, which must come from WithinTx, or on the pool
+// when tx is nil. Queries in a transaction of another backend fail.
+func (r *Repository) querier(tx domain.Tx) models.Querier {
 	if tx != nil {
-		return models.New(tx)
+		pgTx, ok := tx.(*conflictTrackingTx)
+		if !ok {
+			r.log.Error("transaction of another storage backend", "tx_type", fmt.Sprintf("%T", tx))
+			return models.New(errDB{err: errForeignTx})
+		}
+		return models.New(pgTx)
+	}
+	if r.faults != nil {
+		return models.New(faultyDB{DBTX: r.pool, faults: r.faults})
 	}
 	return models.New(r.pool)
 }
 
-// --- Transactor Implementation ---
+// faultyDB applies the injected faults to queries run on the pool.
+type faultyDB struct {
+	models.DBTX
+	faults *testhooks.Faults
+}
+
+func (db faultyDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if err := db.faults.Apply(ctx, sql); err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return db.DBTX.Exec(ctx, sql, args...)
+}
+
+func (db faultyDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if err := db.faults.Apply(ctx, sql); err != nil {
+		return nil, err
+	}
+	return db.DBTX.Query(ctx, sql, args...)
+}
+
+func (db faultyDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if err := db.faults.Apply(ctx, sql); err != nil {
+		return errRow{err: err}
+	}
+	return db.DBTX.QueryRow(ctx, sql, args...)
+}
+
+type errRow struct {
+	err error
+}
+
+func (row errRow) Scan(...any) error {
+	return row.err
+}
+
+var errForeignTx = errors.New("transaction was not begun by the postgres repository")
+
+// errDB fails every query.
+type errDB struct {
+	err error
+}
+
+func (db errDB) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, db.err
+}
+
+func (db errDB) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, db.err
+}
+
+func (db errDB) QueryRow(context.Context, string, ...any) pgx.Row {
+	return errRow{err: db.err}
+}
+
+// --- UnitOfWork Implementation ---
+
+const (
+	// txMaxAttempts bounds how many times a conflicting transaction is run.
+	txMaxAttempts = 5
+	// txRetryBackoff is the delay before the first retry; it doubles with
+	// each further attempt.
+	txRetryBackoff = 10 * time.Millisecond
+)
+
+// WithinTx retries transactions that failed on a serialization failure or a
+// deadlock. Repository methods hide database errors behind
+// domain.ErrInternalError, so conflicts are spotted on the transaction itself.
+func (r *Repository) WithinTx(ctx context.Context, fn func(ctx context.Context, tx domain.Tx) error) error {
+	for attempt := 1; ; attempt++ {
+		conflict, err := r.runTx(ctx, fn)
+		if !conflict {
+			return err
+		}
+		if attempt == txMaxAttempts {
+			r.log.WarnContext(ctx, "giving up on conflicting transaction", "attempts", attempt, "error", err)
+			return fmt.Errorf("%w: gave up after %d attempts", domain.ErrTxConflict, attempt)
+		}
+		delay := txRetryBackoff << (attempt - 1)
+		delay = delay/2 + rand.N(delay/2+1)
+		r.log.DebugContext(ctx, "transaction conflict, retrying", "attempt", attempt, "retry_in", delay.String(), "error", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (r *Repository) runTx(ctx context.Context, fn func(ctx context.Context, tx domain.Tx) error) (conflict bool, err error) {
+	pgTx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := pgTx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			r.log.ErrorContext(ctx, "failed to rollback transaction", "error", err)
+		}
+	}()
+
+	tx := &conflictTrackingTx{Tx: pgTx, faults: r.faults}
+	if err := fn(ctx, tx); err != nil {
+		return tx.conflict || isTxConflict(err), err
+	}
+	if err := pgTx.Commit(ctx); err != nil {
+		return isTxConflict(err), fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return false, nil
+}
+
+// isTxConflict reports errors after which the whole transaction may succeed
+// when run again.
+func isTxConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgerrcode.SerializationFailure || pgErr.Code == pgerrcode.DeadlockDetected
+}
+
+// conflictTrackingTx remembers whether any statement run through the queries
+// hit a transaction conflict. Injected faults count as well.
+type conflictTrackingTx struct {
+	domain.TxMarker
+	pgx.Tx
+	faults   *testhooks.Faults
+	conflict bool
+}
+
+func (tx *conflictTrackingTx) track(err error) error {
+	if err != nil && isTxConflict(err) {
+		tx.conflict = true
+	}
+	return err
+}
+
+func (tx *conflictTrackingTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if err := tx.faults.Apply(ctx, sql); err != nil {
+		return pgconn.CommandTag{}, tx.track(err)
+	}
+	tag, err := tx.Tx.Exec(ctx, sql, args...)
+	return tag, tx.track(err)
+}
+
+func (tx *conflictTrackingTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if err := tx.faults.Apply(ctx, sql); err != nil {
+		return nil, tx.track(err)
+	}
+	rows, err := tx.Tx.Query(ctx, sql, args...)
+	if err != nil {
+		return rows, tx.track(err)
+	}
+	return &conflictTrackingRows{Rows: rows, tx: tx}, nil
+}
+
+func (tx *conflictTrackingTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if err := tx.faults.Apply(ctx, sql); err != nil {
+		return conflictTrackingRow{row: errRow{err: err}, tx: tx}
+	}
+	return conflictTrackingRow{row: tx.Tx.QueryRow(ctx, sql, args...), tx: tx}
+}
+
+type conflictTrackingRows struct {
+	pgx.Rows
+	tx *conflictTrackingTx
+}
+
+func (rows *conflictTrackingRows) Err() error {
+	return rows.tx.track(rows.Rows.Err())
+}
 
-func (r *Repository) BeginTx(ctx context.Context) (pgx.Tx, error) {
-	return r.pool.Begin(ctx)
+type conflictTrackingRow struct {
+	row pgx.Row
+	tx  *conflictTrackingTx
 }
 
-func (r *Repository) CommitTx(ctx context.Context, tx pgx.Tx) error {
-	return tx.Commit(ctx)
+func (row conflictTrackingRow) Scan(dest ...any) error {
+	return row.tx.track(row.row.Scan(dest...))
 }
 
-func (r *Repository) RollbackTx(ctx context.Context, tx pgx.Tx) error {
-	return tx.Rollback(ctx)
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.pool.Ping(ctx)
 }
 
 // --- TeamRepository Implementation ---
 
-func (r *Repository) CreateTeam(ctx context.Context, tx pgx.Tx, team *domain.Team) (*domain.Team, error) {
+func (r *Repository) CreateTeam(ctx context.Context, tx domain.Tx, team *domain.Team) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbTeam, err := q.CreateTeam(ctx, team.TeamName)
+	dbTeam, err := q.CreateTeam(ctx, models.CreateTeamParams{TeamName: team.TeamName, AllowDuplicateUsernames: team.AllowDuplicateUsernames})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
@@ -60,7 +254,7 @@ func (r *Repository) CreateTeam(ctx context.Context, tx pgx.Tx, team *domain.Tea
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.Team{ID: dbTeam.TeamID, TeamName: dbTeam.TeamName, IsActive: dbTeam.IsActive}, nil
+	return teamFromDB(dbTeam), nil
 }
 
 func (r *Repository) GetTeamByName(ctx context.Context, teamName string) (*domain.Team, error) {
@@ -72,7 +266,53 @@ func (r *Repository) GetTeamByName(ctx context.Context, teamName string) (*domai
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.Team{ID: dbTeam.TeamID, TeamName: dbTeam.TeamName, IsActive: dbTeam.IsActive}, nil
+	return teamFromDB(dbTeam), nil
+}
+
+// teamMemberJSON and sizeRuleJSON mirror the JSON built by GetTeamWithMembers.
+type teamMemberJSON struct {
+	UserID     string   `json:"user_id"`
+	Username   string   `json:"username"`
+	IsActive   bool     `json:"is_active"`
+	Role       string   `json:"role"`
+	Skills     []string `json:"skills"`
+	InTraining bool     `json:"in_training"`
+}
+
+type sizeRuleJSON struct {
+	MaxLinesChanged *int `json:"max_lines_changed"`
+	MaxFilesChanged *int `json:"max_files_changed"`
+	Reviewers       int  `json:"reviewers"`
+}
+
+func (r *Repository) GetTeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error) {
+	q := r.querier(nil)
+	row, err := q.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: team '%s'", domain.ErrNotFound, teamName)
+		}
+		return nil, domain.ErrInternalError
+	}
+	team := teamFromDB(row.Team)
+
+	var members []teamMemberJSON
+	var rules []sizeRuleJSON
+	if err := json.Unmarshal(row.Members, &members); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if err := json.Unmarshal(row.SizeRules, &rules); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	team.Members = make([]domain.User, len(members))
+	for i, m := range members {
+		team.Members[i] = domain.User{ID: m.UserID, Username: m.Username, TeamID: team.ID, IsActive: m.IsActive, Role: m.Role, Skills: m.Skills, InTraining: m.InTraining}
+	}
+	team.SizeRules = make([]domain.SizeRule, len(rules))
+	for i, rule := range rules {
+		team.SizeRules[i] = domain.SizeRule{MaxLinesChanged: rule.MaxLinesChanged, MaxFilesChanged: rule.MaxFilesChanged, Reviewers: rule.Reviewers}
+	}
+	return team, nil
 }
 
 func (r *Repository) GetTeamByID(ctx context.Context, teamID int32) (*domain.Team, error) {
@@ -84,10 +324,23 @@ func (r *Repository) GetTeamByID(ctx context.Context, teamID int32) (*domain.Tea
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.Team{ID: dbTeam.TeamID, TeamName: dbTeam.TeamName, IsActive: dbTeam.IsActive}, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) UpdateTeam(ctx context.Context, tx pgx.Tx, oldTeamName, newTeamName string) (*domain.Team, error) {
+func (r *Repository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	q := r.querier(nil)
+	dbTeams, err := q.ListTeams(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	teams := make([]domain.Team, len(dbTeams))
+	for i, t := range dbTeams {
+		teams[i] = *teamFromDB(t)
+	}
+	return teams, nil
+}
+
+func (r *Repository) UpdateTeam(ctx context.Context, tx domain.Tx, oldTeamName, newTeamName string) (*domain.Team, error) {
 	q := r.querier(tx)
 	team, err := r.GetTeamByName(ctx, oldTeamName)
 	if err != nil {
@@ -107,10 +360,10 @@ func (r *Repository) UpdateTeam(ctx context.Context, tx pgx.Tx, oldTeamName, new
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.Team{ID: dbTeam.TeamID, TeamName: dbTeam.TeamName, IsActive: dbTeam.IsActive}, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) DeactivateTeam(ctx context.Context, tx pgx.Tx, teamName string) error {
+func (r *Repository) DeactivateTeam(ctx context.Context, tx domain.Tx, teamName string) error {
 	q := r.querier(tx)
 	team, err := r.GetTeamByName(ctx, teamName)
 	if err != nil {
@@ -122,342 +375,3928 @@ func (r *Repository) DeactivateTeam(ctx context.Context, tx pgx.Tx, teamName str
 	return nil
 }
 
-// --- UserRepository Implementation ---
-
-func (r *Repository) CreateUser(ctx context.Context, tx pgx.Tx, user *domain.User) (*domain.User, error) {
+func (r *Repository) SetTeamParent(ctx context.Context, tx domain.Tx, teamID int32, parentTeamID *int32, escalateToParent bool) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbUser, err := q.CreateUser(ctx, models.CreateUserParams{
-		UserID:   user.ID,
-		Username: user.Username,
-		TeamID:   user.TeamID,
-		IsActive: true,
-	})
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
-			return nil, fmt.Errorf("%w: user '%s'", domain.ErrValidation, user.ID)
-		}
-		return nil, domain.ErrInternalError
+	params := models.SetTeamParentParams{
+		TeamID:           teamID,
+		EscalateToParent: escalateToParent,
 	}
-	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, IsActive: dbUser.IsActive}, nil
-}
-
-func (r *Repository) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
-	q := r.querier(nil)
-	dbUser, err := q.GetUserWithTeam(ctx, userID)
+	if parentTeamID != nil {
+		params.ParentTeamID = pgtype.Int4{Int32: *parentTeamID, Valid: true}
+	}
+	dbTeam, err := q.SetTeamParent(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, TeamName: dbUser.TeamName, IsActive: dbUser.IsActive}, nil
-}
-
-func (r *Repository) GetUsersByTeam(ctx context.Context, teamID int32) ([]domain.User, error) {
-	q := r.querier(nil)
-	dbUsers, err := q.GetTeamMembers(ctx, teamID)
-	if err != nil {
-		return nil, domain.ErrInternalError
-	}
-	users := make([]domain.User, len(dbUsers))
-	for i, u := range dbUsers {
-		users[i] = domain.User{ID: u.UserID, Username: u.Username, TeamID: u.TeamID, IsActive: u.IsActive}
-	}
-	return users, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) UpdateUser(ctx context.Context, tx pgx.Tx, user *domain.User) (*domain.User, error) {
+func (r *Repository) SetTeamRequiredReviewerRole(ctx context.Context, tx domain.Tx, teamID int32, role string) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbUser, err := q.UpdateUser(ctx, models.UpdateUserParams{
-		UserID:   user.ID,
-		Username: user.Username,
-		TeamID:   user.TeamID,
-		IsActive: user.IsActive,
+	dbTeam, err := q.SetTeamRequiredReviewerRole(ctx, models.SetTeamRequiredReviewerRoleParams{
+		TeamID:               teamID,
+		RequiredReviewerRole: role,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, user.ID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, IsActive: dbUser.IsActive}, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) SetUserActiveStatus(ctx context.Context, tx pgx.Tx, userID string, isActive bool) (*domain.User, error) {
+func (r *Repository) SetTeamOptionalReviewers(ctx context.Context, tx domain.Tx, teamID int32, count int) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbUser, err := q.SetUserActiveStatus(ctx, models.SetUserActiveStatusParams{
-		UserID:   userID,
-		IsActive: isActive,
+	dbTeam, err := q.SetTeamOptionalReviewers(ctx, models.SetTeamOptionalReviewersParams{
+		TeamID:            teamID,
+		OptionalReviewers: int32(count),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-
-	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, IsActive: dbUser.IsActive}, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) MoveUserToTeam(ctx context.Context, tx pgx.Tx, userID string, newTeamID int32) (*domain.User, error) {
+func (r *Repository) SetTeamShadowReviewPercent(ctx context.Context, tx domain.Tx, teamID int32, percent int) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbUser, err := q.MoveUserToTeam(ctx, models.MoveUserToTeamParams{
-		UserID: userID,
-		TeamID: newTeamID,
+	dbTeam, err := q.SetTeamShadowReviewPercent(ctx, models.SetTeamShadowReviewPercentParams{
+		TeamID:              teamID,
+		ShadowReviewPercent: int32(percent),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, IsActive: dbUser.IsActive}, nil
-}
-
-func (r *Repository) DeactivateUsersByTeam(ctx context.Context, tx pgx.Tx, teamID int32) ([]string, error) {
-	q := r.querier(tx)
-	userIDs, err := q.DeactivateUsersByTeam(ctx, teamID)
-	if err != nil {
-		return nil, domain.ErrInternalError
-	}
-	return userIDs, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) FindReviewCandidates(ctx context.Context, teamID int32, authorID string, excludeUserIDs []string, limit int) ([]domain.User, error) {
+func (r *Repository) ListChildTeams(ctx context.Context, teamID int32) ([]domain.Team, error) {
 	q := r.querier(nil)
-	dbUsers, err := q.FindReplacementCandidates(ctx, models.FindReplacementCandidatesParams{
-		TeamID:  teamID,
-		UserID:  authorID,
-		Column3: excludeUserIDs,
-		Limit:   int32(limit),
-	})
+	dbTeams, err := q.ListChildTeams(ctx, pgtype.Int4{Int32: teamID, Valid: true})
 	if err != nil {
 		return nil, domain.ErrInternalError
 	}
-	users := make([]domain.User, len(dbUsers))
-	for i, u := range dbUsers {
-		users[i] = domain.User{ID: u.UserID, Username: u.Username, TeamID: u.TeamID, IsActive: u.IsActive}
+	teams := make([]domain.Team, len(dbTeams))
+	for i, t := range dbTeams {
+		teams[i] = *teamFromDB(t)
 	}
-	return users, nil
+	return teams, nil
 }
 
-// --- PullRequestRepository Implementation ---
-
-func (r *Repository) CreatePR(ctx context.Context, tx pgx.Tx, pr *domain.PullRequest) (*domain.PullRequest, error) {
+func (r *Repository) SetTeamEscalationPolicy(ctx context.Context, tx domain.Tx, teamID int32, policy domain.EscalationPolicy) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbPR, err := q.CreatePR(ctx, models.CreatePRParams{
-		PrID:     pr.ID,
-		PrName:   pr.Name,
-		AuthorID: pr.AuthorID,
+	dbTeam, err := q.SetTeamEscalationPolicy(ctx, models.SetTeamEscalationPolicyParams{
+		TeamID:                          teamID,
+		LeadUserID:                      pgtype.Text{String: policy.LeadUserID, Valid: policy.LeadUserID != ""},
+		EscalationNotifyAfterHours:      int32(policy.NotifyLeadAfterHours),
+		EscalationAddReviewerAfterHours: int32(policy.AddReviewerAfterHours),
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+		}
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case pgerrcode.UniqueViolation:
-				return nil, fmt.Errorf("%w: PR '%s'", domain.ErrPRExists, pr.ID)
-			case pgerrcode.ForeignKeyViolation:
-				return nil, fmt.Errorf("%w: author '%s'", domain.ErrNotFound, pr.AuthorID)
-			}
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, policy.LeadUserID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	return &domain.PullRequest{ID: dbPR.PrID, Name: dbPR.PrName, AuthorID: dbPR.AuthorID, Status: domain.PRStatus(dbPR.Status), CreatedAt: dbPR.CreatedAt.Time}, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) GetPRByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	q := r.querier(nil)
-	dbPR, err := q.GetPRByID(ctx, prID)
+func (r *Repository) SetTeamReviewCooldown(ctx context.Context, tx domain.Tx, teamID int32, prCount int) (*domain.Team, error) {
+	q := r.querier(tx)
+	dbTeam, err := q.SetTeamReviewCooldown(ctx, models.SetTeamReviewCooldownParams{TeamID: teamID, ReviewCooldownPrs: int32(prCount)})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	pr := &domain.PullRequest{
-		ID:        dbPR.PrID,
-		Name:      dbPR.PrName,
-		AuthorID:  dbPR.AuthorID,
-		Status:    domain.PRStatus(dbPR.Status),
-		CreatedAt: dbPR.CreatedAt.Time,
-	}
-	if dbPR.MergedAt.Valid {
-		pr.MergedAt = &dbPR.MergedAt.Time
-	}
-	return pr, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) MergePR(ctx context.Context, tx pgx.Tx, prID string) (*domain.PullRequest, error) {
+func (r *Repository) SetTeamAllowDuplicateUsernames(ctx context.Context, tx domain.Tx, teamID int32, allow bool) (*domain.Team, error) {
 	q := r.querier(tx)
-	mergedDBPR, err := q.MergePR(ctx, prID)
+	dbTeam, err := q.SetTeamAllowDuplicateUsernames(ctx, models.SetTeamAllowDuplicateUsernamesParams{TeamID: teamID, AllowDuplicateUsernames: allow})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
+	return teamFromDB(dbTeam), nil
+}
 
-	reviewersUser, err := q.GetReviewersForPR(ctx, prID)
+func (r *Repository) SetTeamAllowSelfReview(ctx context.Context, tx domain.Tx, teamID int32, allow bool) (*domain.Team, error) {
+	q := r.querier(tx)
+	dbTeam, err := q.SetTeamAllowSelfReview(ctx, models.SetTeamAllowSelfReviewParams{TeamID: teamID, AllowSelfReview: allow})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
 		}
 		return nil, domain.ErrInternalError
 	}
-	reviewers := make([]domain.Reviewer, len(reviewersUser))
-	for i, reviewer := range reviewersUser {
-		reviewers[i] = domain.Reviewer{ID: reviewer.UserID, Username: reviewer.Username}
-	}
-
-	pr := &domain.PullRequest{
-		ID:        mergedDBPR.PrID,
-		Name:      mergedDBPR.PrName,
-		AuthorID:  mergedDBPR.AuthorID,
-		Status:    domain.PRStatus(mergedDBPR.Status),
-		Reviewers: reviewers,
-		CreatedAt: mergedDBPR.CreatedAt.Time,
-	}
-	if mergedDBPR.MergedAt.Valid {
-		pr.MergedAt = &mergedDBPR.MergedAt.Time
-	}
-	return pr, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) GetReviewers(ctx context.Context, prID string) ([]domain.User, error) {
+func (r *Repository) GetTeamSizeRules(ctx context.Context, teamID int32) ([]domain.SizeRule, error) {
 	q := r.querier(nil)
-	dbReviewers, err := q.GetReviewersForPR(ctx, prID)
+	rows, err := q.ListTeamSizeRules(ctx, teamID)
 	if err != nil {
 		return nil, domain.ErrInternalError
 	}
-	reviewers := make([]domain.User, len(dbReviewers))
-	for i, rev := range dbReviewers {
-		reviewers[i] = domain.User{ID: rev.UserID, Username: rev.Username}
+	rules := make([]domain.SizeRule, len(rows))
+	for i, row := range rows {
+		rules[i] = domain.SizeRule{
+			MaxLinesChanged: intFromInt4(row.MaxLinesChanged),
+			MaxFilesChanged: intFromInt4(row.MaxFilesChanged),
+			Reviewers:       int(row.Reviewers),
+		}
 	}
-	return reviewers, nil
+	return rules, nil
 }
 
-func (r *Repository) RemoveReviewer(ctx context.Context, tx pgx.Tx, prID string, userID string) error {
+func (r *Repository) SetTeamSizeRules(ctx context.Context, tx domain.Tx, teamID int32, rules []domain.SizeRule) error {
 	q := r.querier(tx)
-	if err := q.RemoveReviewerFromPR(ctx, models.RemoveReviewerFromPRParams{PrID: prID, UserID: userID}); err != nil {
+	if err := q.DeleteTeamSizeRules(ctx, teamID); err != nil {
 		return domain.ErrInternalError
 	}
-	return nil
-}
-
-func (r *Repository) AssignReviewers(ctx context.Context, tx pgx.Tx, prID string, userIDs []string) error {
-	q := r.querier(tx)
-	for _, userID := range userIDs {
-		if err := q.AddReviewerToPR(ctx, models.AddReviewerToPRParams{PrID: prID, UserID: userID}); err != nil {
+	for i, rule := range rules {
+		err := q.InsertTeamSizeRule(ctx, models.InsertTeamSizeRuleParams{
+			TeamID:          teamID,
+			Position:        int32(i),
+			MaxLinesChanged: int4FromInt(rule.MaxLinesChanged),
+			MaxFilesChanged: int4FromInt(rule.MaxFilesChanged),
+			Reviewers:       int32(rule.Reviewers),
+		})
+		if err != nil {
 			return domain.ErrInternalError
 		}
 	}
 	return nil
 }
 
-func (r *Repository) GetOpenPRsByReviewer(ctx context.Context, tx pgx.Tx, userID string) ([]domain.PullRequest, error) {
+func (r *Repository) SetTeamChecklist(ctx context.Context, tx domain.Tx, teamID int32, checklist domain.ChecklistTemplate) (*domain.Team, error) {
 	q := r.querier(tx)
-	dbPRs, err := q.GetPRsForReviewer(ctx, userID)
+	dbTeam, err := q.SetTeamChecklist(ctx, models.SetTeamChecklistParams{
+		TeamID:            teamID,
+		ChecklistItems:    nonNilStrings(checklist.Items),
+		ChecklistRequired: checklist.RequireCompletion,
+	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+		}
 		return nil, domain.ErrInternalError
 	}
-	prs := make([]domain.PullRequest, len(dbPRs))
-	for i, p := range dbPRs {
-		prs[i] = domain.PullRequest{ID: p.PrID, AuthorID: p.AuthorID}
-	}
-	return prs, nil
+	return teamFromDB(dbTeam), nil
 }
 
-func (r *Repository) GetPRsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+func (r *Repository) GetReviewerPreferences(ctx context.Context, teamID int32) ([]domain.ReviewerPreference, error) {
 	q := r.querier(nil)
-	dbPRs, err := q.GetPRsForReviewer(ctx, userID)
+	rows, err := q.ListReviewerPreferences(ctx, teamID)
 	if err != nil {
 		return nil, domain.ErrInternalError
 	}
-	prs := make([]domain.PullRequest, len(dbPRs))
-	for i, p := range dbPRs {
-		prs[i] = domain.PullRequest{ID: p.PrID, AuthorID: p.AuthorID}
+	prefs := make([]domain.ReviewerPreference, len(rows))
+	for i, row := range rows {
+		prefs[i] = domain.ReviewerPreference{AuthorID: row.AuthorID, ReviewerID: row.ReviewerID, Weight: int(row.Weight)}
 	}
-	return prs, nil
+	return prefs, nil
 }
 
-func (r *Repository) GetOpenPRsWithoutReviewers(ctx context.Context) ([]domain.PullRequest, error) {
-	q := r.querier(nil)
-	dbPRs, err := q.GetOpenPRsWithoutReviewers(ctx)
-	if err != nil {
+func (r *Repository) SetReviewerPreferences(ctx context.Context, tx domain.Tx, teamID int32, prefs []domain.ReviewerPreference) ([]domain.ReviewerPreference, error) {
+	q := r.querier(tx)
+	if err := q.DeleteReviewerPreferences(ctx, teamID); err != nil {
 		return nil, domain.ErrInternalError
 	}
-	prs := make([]domain.PullRequest, len(dbPRs))
-	for i, p := range dbPRs {
-		prs[i] = domain.PullRequest{
-			ID:        p.PrID,
-			Name:      p.PrName,
-			AuthorID:  p.AuthorID,
-			Status:    domain.PRStatus(p.Status),
-			CreatedAt: p.CreatedAt.Time,
+	for _, p := range prefs {
+		err := q.InsertReviewerPreference(ctx, models.InsertReviewerPreferenceParams{
+			TeamID:     teamID,
+			AuthorID:   p.AuthorID,
+			ReviewerID: p.ReviewerID,
+			Weight:     int32(p.Weight),
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+				return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, p.AuthorID)
+			}
+			return nil, domain.ErrInternalError
 		}
 	}
-	return prs, nil
+	return prefs, nil
 }
 
-// --- StatsRepository Implementation ---
+func (r *Repository) ScheduleTeamDeactivation(ctx context.Context, tx domain.Tx, teamID int32, at *time.Time) (*domain.Team, error) {
+	q := r.querier(tx)
+	params := models.ScheduleTeamDeactivationParams{TeamID: teamID}
+	if at != nil {
+		params.DeactivateAt = pgtype.Timestamptz{Time: *at, Valid: true}
+	}
+	dbTeam, err := q.ScheduleTeamDeactivation(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return teamFromDB(dbTeam), nil
+}
 
-func (r *Repository) GetReviewStats(ctx context.Context) ([]domain.StatItem, error) {
+func (r *Repository) ListTeamsDueForDeactivation(ctx context.Context, limit int) ([]domain.Team, error) {
 	q := r.querier(nil)
-	dbStats, err := q.GetReviewStats(ctx)
+	dbTeams, err := q.ListTeamsDueForDeactivation(ctx, int32(limit))
 	if err != nil {
 		return nil, domain.ErrInternalError
 	}
-	stats := make([]domain.StatItem, len(dbStats))
-	for i, s := range dbStats {
-		stats[i] = domain.StatItem{
-			UserID:      s.UserID,
-			ReviewCount: s.ReviewCount,
-		}
+	teams := make([]domain.Team, len(dbTeams))
+	for i, t := range dbTeams {
+		teams[i] = *teamFromDB(t)
 	}
-	return stats, nil
+	return teams, nil
 }
 
-func (r *Repository) GetOpenReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+func (r *Repository) RecordNoCandidate(ctx context.Context, teamID int32, prID string, since time.Time) (int, error) {
 	q := r.querier(nil)
-	team, err := r.GetTeamByName(ctx, teamName)
-	if err != nil {
-		return 0, err
+	sinceTS := pgtype.Timestamptz{Time: since, Valid: true}
+	if err := q.InsertNoCandidateEvent(ctx, models.InsertNoCandidateEventParams{TeamID: teamID, PrID: prID}); err != nil {
+		return 0, domain.ErrInternalError
 	}
-	count, err := q.CountOpenReviewsByTeam(ctx, team.ID)
+	// Older events no longer count towards any window.
+	if err := q.DeleteNoCandidateEventsBefore(ctx, models.DeleteNoCandidateEventsBeforeParams{TeamID: teamID, OccurredAt: sinceTS}); err != nil {
+		return 0, domain.ErrInternalError
+	}
+	count, err := q.CountNoCandidateEvents(ctx, models.CountNoCandidateEventsParams{TeamID: teamID, OccurredAt: sinceTS})
 	if err != nil {
 		return 0, domain.ErrInternalError
 	}
 	return int(count), nil
 }
 
-func (r *Repository) GetMergedReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+func (r *Repository) ClaimNoCandidateAlert(ctx context.Context, teamID int32, since time.Time) (bool, error) {
 	q := r.querier(nil)
-	team, err := r.GetTeamByName(ctx, teamName)
+	rows, err := q.ClaimNoCandidateAlert(ctx, models.ClaimNoCandidateAlertParams{
+		TeamID:    teamID,
+		AlertedAt: pgtype.Timestamptz{Time: since, Valid: true},
+	})
 	if err != nil {
-		return 0, err
+		return false, domain.ErrInternalError
 	}
-	count, err := q.CountMergedReviewsByTeam(ctx, team.ID)
+	return rows > 0, nil
+}
+
+func (r *Repository) GetReviewBudget(ctx context.Context, teamID int32) (*domain.ReviewBudget, error) {
+	q := r.querier(nil)
+	row, err := q.GetTeamReviewBudget(ctx, teamID)
 	if err != nil {
-		return 0, domain.ErrInternalError
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: review budget of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
 	}
-	return int(count), nil
+	return reviewBudgetFromDB(row), nil
 }
 
-func (r *Repository) GetOpenReviewCountForUser(ctx context.Context, userID string) (int, error) {
+func (r *Repository) ListReviewBudgets(ctx context.Context) ([]domain.ReviewBudget, error) {
 	q := r.querier(nil)
-	count, err := q.CountOpenReviewsByUser(ctx, userID)
+	rows, err := q.ListTeamReviewBudgets(ctx)
 	if err != nil {
-		return 0, domain.ErrInternalError
+		return nil, domain.ErrInternalError
 	}
-	return int(count), nil
+	budgets := make([]domain.ReviewBudget, len(rows))
+	for i, row := range rows {
+		budgets[i] = *reviewBudgetFromDB(row)
+	}
+	return budgets, nil
 }
 
-func (r *Repository) GetMergedReviewCountForUser(ctx context.Context, userID string) (int, error) {
+func (r *Repository) SetReviewBudget(ctx context.Context, tx domain.Tx, teamID int32, reviewsPerSprint, sprintDays int) (*domain.ReviewBudget, error) {
+	q := r.querier(tx)
+	_, err := q.GetTeamReviewBudget(ctx, teamID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrInternalError
+	}
+	// Reviews taken before the first sprint do not count against it.
+	if errors.Is(err, pgx.ErrNoRows) {
+		if err := q.ResetTeamReviewBudgetUsage(ctx, teamID); err != nil {
+			return nil, domain.ErrInternalError
+		}
+	}
+	row, err := q.UpsertTeamReviewBudget(ctx, models.UpsertTeamReviewBudgetParams{
+		TeamID:           teamID,
+		ReviewsPerSprint: int32(reviewsPerSprint),
+		SprintDays:       int32(sprintDays),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return reviewBudgetFromDB(row), nil
+}
+
+func (r *Repository) DeleteReviewBudget(ctx context.Context, tx domain.Tx, teamID int32) error {
+	q := r.querier(tx)
+	rows, err := q.DeleteTeamReviewBudget(ctx, teamID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: review budget of team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return nil
+}
+
+func (r *Repository) StartReviewSprint(ctx context.Context, tx domain.Tx, teamID int32, startedAt time.Time) (bool, error) {
+	q := r.querier(tx)
+	rows, err := q.StartReviewSprint(ctx, models.StartReviewSprintParams{
+		TeamID:    teamID,
+		StartedAt: pgtype.Timestamptz{Time: startedAt, Valid: true},
+	})
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	if rows == 0 {
+		return false, nil
+	}
+	if err := q.ResetTeamReviewBudgetUsage(ctx, teamID); err != nil {
+		return false, domain.ErrInternalError
+	}
+	return true, nil
+}
+
+func (r *Repository) ClaimReviewBudgetAlert(ctx context.Context, teamID int32) (bool, error) {
 	q := r.querier(nil)
-	count, err := q.CountMergedReviewsByUser(ctx, userID)
+	rows, err := q.ClaimReviewBudgetAlert(ctx, teamID)
 	if err != nil {
-		return 0, domain.ErrInternalError
+		return false, domain.ErrInternalError
 	}
-	return int(count), nil
+	return rows > 0, nil
+}
+
+func reviewBudgetFromDB(b models.TeamReviewBudget) *domain.ReviewBudget {
+	budget := &domain.ReviewBudget{
+		TeamID:           b.TeamID,
+		ReviewsPerSprint: int(b.ReviewsPerSprint),
+		SprintDays:       int(b.SprintDays),
+		SprintStartedAt:  b.SprintStartedAt.Time,
+	}
+	if b.AlertedAt.Valid {
+		at := b.AlertedAt.Time
+		budget.AlertedAt = &at
+	}
+	return budget
+}
+
+func (r *Repository) GetReportSchedule(ctx context.Context, teamID int32) (*domain.TeamReportSchedule, error) {
+	row, err := r.querier(nil).GetTeamReportSchedule(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: report schedule of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return reportScheduleFromDB(row), nil
+}
+
+func (r *Repository) ListReportSchedules(ctx context.Context) ([]domain.TeamReportSchedule, error) {
+	rows, err := r.querier(nil).ListTeamReportSchedules(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	schedules := make([]domain.TeamReportSchedule, len(rows))
+	for i, row := range rows {
+		schedules[i] = *reportScheduleFromDB(row)
+	}
+	return schedules, nil
+}
+
+func (r *Repository) SetReportSchedule(ctx context.Context, tx domain.Tx, schedule *domain.TeamReportSchedule) (*domain.TeamReportSchedule, error) {
+	row, err := r.querier(tx).UpsertTeamReportSchedule(ctx, models.UpsertTeamReportScheduleParams{
+		TeamID:   schedule.TeamID,
+		Weekday:  int16(schedule.Weekday),
+		Hour:     int16(schedule.Hour),
+		Timezone: schedule.Timezone,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, schedule.TeamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return reportScheduleFromDB(row), nil
+}
+
+func (r *Repository) DeleteReportSchedule(ctx context.Context, tx domain.Tx, teamID int32) error {
+	rows, err := r.querier(tx).DeleteTeamReportSchedule(ctx, teamID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: report schedule of team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return nil
+}
+
+func (r *Repository) ClaimTeamReport(ctx context.Context, teamID int32, slot time.Time) (bool, error) {
+	rows, err := r.querier(nil).ClaimTeamReport(ctx, models.ClaimTeamReportParams{
+		Slot:   pgtype.Timestamptz{Time: slot, Valid: true},
+		TeamID: teamID,
+	})
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return rows > 0, nil
+}
+
+func reportScheduleFromDB(s models.TeamReportSchedule) *domain.TeamReportSchedule {
+	schedule := &domain.TeamReportSchedule{
+		TeamID:    s.TeamID,
+		Weekday:   time.Weekday(s.Weekday),
+		Hour:      int(s.Hour),
+		Timezone:  s.Timezone,
+		CreatedAt: s.CreatedAt.Time,
+	}
+	if s.LastSentAt.Valid {
+		at := s.LastSentAt.Time
+		schedule.LastSentAt = &at
+	}
+	return schedule
+}
+
+func (r *Repository) GetPRQuota(ctx context.Context, teamID int32) (*domain.PRQuota, error) {
+	row, err := r.querier(nil).GetTeamPRQuota(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR quota of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prQuotaFromDB(row), nil
+}
+
+func (r *Repository) SetPRQuota(ctx context.Context, tx domain.Tx, quota *domain.PRQuota) (*domain.PRQuota, error) {
+	row, err := r.querier(tx).UpsertTeamPRQuota(ctx, models.UpsertTeamPRQuotaParams{
+		TeamID:     quota.TeamID,
+		MaxOpenPrs: int32(quota.MaxOpenPRs),
+		Mode:       string(quota.Mode),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, quota.TeamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prQuotaFromDB(row), nil
+}
+
+func (r *Repository) DeletePRQuota(ctx context.Context, tx domain.Tx, teamID int32) error {
+	rows, err := r.querier(tx).DeleteTeamPRQuota(ctx, teamID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: PR quota of team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return nil
+}
+
+func prQuotaFromDB(q models.TeamPrQuota) *domain.PRQuota {
+	return &domain.PRQuota{
+		TeamID:     q.TeamID,
+		MaxOpenPRs: int(q.MaxOpenPrs),
+		Mode:       domain.PRQuotaMode(q.Mode),
+	}
+}
+
+func (r *Repository) GetReviewRotation(ctx context.Context, teamID int32) (*domain.ReviewRotation, error) {
+	q := r.querier(nil)
+	row, err := q.GetReviewRotation(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	overrides, err := q.ListReviewRotationOverrides(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	rotation := &domain.ReviewRotation{
+		TeamID:    row.TeamID,
+		StartDate: row.StartDate.Time,
+		MemberIDs: row.MemberIds,
+		Overrides: make([]domain.RotationOverride, len(overrides)),
+	}
+	for i, o := range overrides {
+		rotation.Overrides[i] = domain.RotationOverride{WeekStart: o.WeekStart.Time, UserID: o.UserID}
+	}
+	return rotation, nil
+}
+
+func (r *Repository) SetReviewRotation(ctx context.Context, tx domain.Tx, rotation *domain.ReviewRotation) error {
+	_, err := r.querier(tx).UpsertReviewRotation(ctx, models.UpsertReviewRotationParams{
+		TeamID:    rotation.TeamID,
+		StartDate: pgtype.Date{Time: rotation.StartDate, Valid: true},
+		MemberIds: rotation.MemberIDs,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, rotation.TeamID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) DeleteReviewRotation(ctx context.Context, tx domain.Tx, teamID int32) error {
+	rows, err := r.querier(tx).DeleteReviewRotation(ctx, teamID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return nil
+}
+
+func (r *Repository) SetRotationOverride(ctx context.Context, tx domain.Tx, teamID int32, weekStart time.Time, userID string) error {
+	q := r.querier(tx)
+	week := pgtype.Date{Time: weekStart, Valid: true}
+	if userID == "" {
+		if err := q.DeleteReviewRotationOverride(ctx, models.DeleteReviewRotationOverrideParams{TeamID: teamID, WeekStart: week}); err != nil {
+			return domain.ErrInternalError
+		}
+		return nil
+	}
+	err := q.UpsertReviewRotationOverride(ctx, models.UpsertReviewRotationOverrideParams{TeamID: teamID, WeekStart: week, UserID: userID})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			if pgErr.ConstraintName == "review_rotation_overrides_user_id_fkey" {
+				return fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			}
+			return fmt.Errorf("%w: review rotation of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) GetReviewerPool(ctx context.Context, teamID int32) ([]string, error) {
+	q := r.querier(nil)
+	userIDs, err := q.ListReviewerPool(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return nonNilStrings(userIDs), nil
+}
+
+func (r *Repository) SetReviewerPool(ctx context.Context, tx domain.Tx, teamID int32, userIDs []string) error {
+	q := r.querier(tx)
+	if err := q.DeleteReviewerPool(ctx, teamID); err != nil {
+		return domain.ErrInternalError
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if err := q.InsertReviewerPool(ctx, models.InsertReviewerPoolParams{TeamID: teamID, UserIds: userIDs}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return fmt.Errorf("%w: user or team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) GetInactiveReassign(ctx context.Context, teamID int32) (bool, error) {
+	q := r.querier(nil)
+	optedOut, err := q.IsInactiveReassignOptedOut(ctx, teamID)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return !optedOut, nil
+}
+
+func (r *Repository) SetInactiveReassign(ctx context.Context, tx domain.Tx, teamID int32, enabled bool) error {
+	q := r.querier(tx)
+	if enabled {
+		if err := q.DeleteInactiveReassignOptOut(ctx, teamID); err != nil {
+			return domain.ErrInternalError
+		}
+		return nil
+	}
+	if err := q.InsertInactiveReassignOptOut(ctx, teamID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func teamFromDB(t models.Team) *domain.Team {
+	team := &domain.Team{
+		ID:                   t.TeamID,
+		TeamName:             t.TeamName,
+		IsActive:             t.IsActive,
+		EscalateToParent:     t.EscalateToParent,
+		RequiredReviewerRole: t.RequiredReviewerRole,
+		OptionalReviewers:    int(t.OptionalReviewers),
+		ShadowReviewPercent:  int(t.ShadowReviewPercent),
+		Escalation: domain.EscalationPolicy{
+			LeadUserID:            t.LeadUserID.String,
+			NotifyLeadAfterHours:  int(t.EscalationNotifyAfterHours),
+			AddReviewerAfterHours: int(t.EscalationAddReviewerAfterHours),
+		},
+		ReviewCooldownPRs:       int(t.ReviewCooldownPrs),
+		Checklist:               domain.ChecklistTemplate{Items: t.ChecklistItems, RequireCompletion: t.ChecklistRequired},
+		AllowDuplicateUsernames: t.AllowDuplicateUsernames,
+		AllowSelfReview:         t.AllowSelfReview,
+	}
+	if t.ParentTeamID.Valid {
+		parentID := t.ParentTeamID.Int32
+		team.ParentTeamID = &parentID
+	}
+	if t.DeactivateAt.Valid {
+		at := t.DeactivateAt.Time
+		team.DeactivateAt = &at
+	}
+	return team
+}
+
+// --- RepositoryRepository Implementation ---
+
+func (r *Repository) CreateRepository(ctx context.Context, tx domain.Tx, repo *domain.Repository) (*domain.Repository, error) {
+	q := r.querier(tx)
+	_, err := q.CreateRepository(ctx, models.CreateRepositoryParams{
+		RepositoryName:    repo.Name,
+		DefaultTeamID:     int4FromPtr(repo.DefaultTeamID),
+		RequiredReviewers: requiredReviewersToDB(repo.RequiredReviewers),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrRepoExists, repo.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	if err := insertRoutingRules(ctx, q, repo.Name, repo.RoutingRules); err != nil {
+		return nil, err
+	}
+	return r.getRepository(ctx, q, repo.Name)
+}
+
+func (r *Repository) UpdateRepository(ctx context.Context, tx domain.Tx, repo *domain.Repository) (*domain.Repository, error) {
+	q := r.querier(tx)
+	_, err := q.UpdateRepository(ctx, models.UpdateRepositoryParams{
+		RepositoryName:    repo.Name,
+		DefaultTeamID:     int4FromPtr(repo.DefaultTeamID),
+		RequiredReviewers: requiredReviewersToDB(repo.RequiredReviewers),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, repo.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	if err := q.DeleteRoutingRules(ctx, repo.Name); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if err := insertRoutingRules(ctx, q, repo.Name, repo.RoutingRules); err != nil {
+		return nil, err
+	}
+	return r.getRepository(ctx, q, repo.Name)
+}
+
+func (r *Repository) GetRepository(ctx context.Context, name string) (*domain.Repository, error) {
+	return r.getRepository(ctx, r.querier(nil), name)
+}
+
+func (r *Repository) getRepository(ctx context.Context, q models.Querier, name string) (*domain.Repository, error) {
+	row, err := q.GetRepository(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	rules, err := q.ListRoutingRules(ctx, []string{name})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	repo := repositoryFromDB(models.Repository{
+		RepositoryName:    row.RepositoryName,
+		DefaultTeamID:     row.DefaultTeamID,
+		RequiredReviewers: row.RequiredReviewers,
+		CreatedAt:         row.CreatedAt,
+	}, row.DefaultTeamName)
+	repo.RoutingRules = routingRulesFromDB(rules)[name]
+	return repo, nil
+}
+
+func (r *Repository) ListRepositories(ctx context.Context) ([]domain.Repository, error) {
+	q := r.querier(nil)
+	rows, err := q.ListRepositories(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	rules, err := q.ListRoutingRules(ctx, []string{})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	rulesByRepo := routingRulesFromDB(rules)
+
+	repos := make([]domain.Repository, len(rows))
+	for i, row := range rows {
+		repos[i] = *repositoryFromDB(models.Repository{
+			RepositoryName:    row.RepositoryName,
+			DefaultTeamID:     row.DefaultTeamID,
+			RequiredReviewers: row.RequiredReviewers,
+			CreatedAt:         row.CreatedAt,
+		}, row.DefaultTeamName)
+		repos[i].RoutingRules = rulesByRepo[row.RepositoryName]
+	}
+	return repos, nil
+}
+
+func (r *Repository) DeleteRepository(ctx context.Context, name string) error {
+	q := r.querier(nil)
+	n, err := q.DeleteRepository(ctx, name)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, name)
+	}
+	return nil
+}
+
+func insertRoutingRules(ctx context.Context, q models.Querier, repoName string, rules []domain.RoutingRule) error {
+	for i, rule := range rules {
+		err := q.InsertRoutingRule(ctx, models.InsertRoutingRuleParams{
+			RepositoryName: repoName,
+			Position:       int32(i),
+			TitlePrefix:    rule.TitlePrefix,
+			TeamID:         int4FromPtr(rule.TeamID),
+			RequiredSkills: nonNilStrings(rule.RequiredSkills),
+		})
+		if err != nil {
+			return domain.ErrInternalError
+		}
+	}
+	return nil
+}
+
+func repositoryFromDB(r models.Repository, defaultTeamName string) *domain.Repository {
+	repo := &domain.Repository{
+		Name:              r.RepositoryName,
+		DefaultTeamName:   defaultTeamName,
+		RequiredReviewers: int(r.RequiredReviewers.Int32),
+		CreatedAt:         r.CreatedAt.Time,
+	}
+	if r.DefaultTeamID.Valid {
+		repo.DefaultTeamID = &r.DefaultTeamID.Int32
+	}
+	return repo
+}
+
+func routingRulesFromDB(rows []models.ListRoutingRulesRow) map[string][]domain.RoutingRule {
+	rules := make(map[string][]domain.RoutingRule)
+	for _, row := range rows {
+		rule := domain.RoutingRule{TitlePrefix: row.TitlePrefix, TeamName: row.TeamName, RequiredSkills: row.RequiredSkills}
+		if row.TeamID.Valid {
+			rule.TeamID = &row.TeamID.Int32
+		}
+		rules[row.RepositoryName] = append(rules[row.RepositoryName], rule)
+	}
+	return rules
+}
+
+// --- ReviewRuleRepository Implementation ---
+
+func (r *Repository) CreateReviewRule(ctx context.Context, tx domain.Tx, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	q := r.querier(tx)
+	err := q.CreateReviewRule(ctx, models.CreateReviewRuleParams{
+		RuleName:       rule.Name,
+		Position:       int32(rule.Position),
+		Enabled:        rule.Enabled,
+		Labels:         nonNilStrings(rule.Labels),
+		Repositories:   nonNilStrings(rule.Repositories),
+		TeamID:         int4FromPtr(rule.TeamID),
+		Reviewers:      requiredReviewersToDB(rule.Reviewers),
+		RequiredSkills: nonNilStrings(rule.RequiredSkills),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrRuleExists, rule.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return r.getReviewRule(ctx, q, rule.Name)
+}
+
+func (r *Repository) UpdateReviewRule(ctx context.Context, tx domain.Tx, rule *domain.ReviewRule) (*domain.ReviewRule, error) {
+	q := r.querier(tx)
+	n, err := q.UpdateReviewRule(ctx, models.UpdateReviewRuleParams{
+		RuleName:       rule.Name,
+		Position:       int32(rule.Position),
+		Enabled:        rule.Enabled,
+		Labels:         nonNilStrings(rule.Labels),
+		Repositories:   nonNilStrings(rule.Repositories),
+		TeamID:         int4FromPtr(rule.TeamID),
+		Reviewers:      requiredReviewersToDB(rule.Reviewers),
+		RequiredSkills: nonNilStrings(rule.RequiredSkills),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, rule.Name)
+	}
+	return r.getReviewRule(ctx, q, rule.Name)
+}
+
+func (r *Repository) GetReviewRule(ctx context.Context, name string) (*domain.ReviewRule, error) {
+	return r.getReviewRule(ctx, r.querier(nil), name)
+}
+
+func (r *Repository) getReviewRule(ctx context.Context, q models.Querier, name string) (*domain.ReviewRule, error) {
+	row, err := q.GetReviewRule(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return reviewRuleFromDB(models.ReviewRule{
+		RuleName:       row.RuleName,
+		Position:       row.Position,
+		Enabled:        row.Enabled,
+		Labels:         row.Labels,
+		Repositories:   row.Repositories,
+		TeamID:         row.TeamID,
+		Reviewers:      row.Reviewers,
+		RequiredSkills: row.RequiredSkills,
+		CreatedAt:      row.CreatedAt,
+	}, row.TeamName), nil
+}
+
+func (r *Repository) ListReviewRules(ctx context.Context) ([]domain.ReviewRule, error) {
+	rows, err := r.querier(nil).ListReviewRules(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	rules := make([]domain.ReviewRule, len(rows))
+	for i, row := range rows {
+		rules[i] = *reviewRuleFromDB(models.ReviewRule{
+			RuleName:       row.RuleName,
+			Position:       row.Position,
+			Enabled:        row.Enabled,
+			Labels:         row.Labels,
+			Repositories:   row.Repositories,
+			TeamID:         row.TeamID,
+			Reviewers:      row.Reviewers,
+			RequiredSkills: row.RequiredSkills,
+			CreatedAt:      row.CreatedAt,
+		}, row.TeamName)
+	}
+	return rules, nil
+}
+
+func (r *Repository) DeleteReviewRule(ctx context.Context, name string) error {
+	n, err := r.querier(nil).DeleteReviewRule(ctx, name)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: review rule '%s'", domain.ErrNotFound, name)
+	}
+	return nil
+}
+
+func reviewRuleFromDB(r models.ReviewRule, teamName string) *domain.ReviewRule {
+	rule := &domain.ReviewRule{
+		Name:           r.RuleName,
+		Position:       int(r.Position),
+		Enabled:        r.Enabled,
+		Labels:         r.Labels,
+		Repositories:   r.Repositories,
+		TeamName:       teamName,
+		Reviewers:      int(r.Reviewers.Int32),
+		RequiredSkills: r.RequiredSkills,
+		CreatedAt:      r.CreatedAt.Time,
+	}
+	if r.TeamID.Valid {
+		rule.TeamID = &r.TeamID.Int32
+	}
+	return rule
+}
+
+func int4FromPtr(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func int4FromInt(v *int) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
+
+func intFromInt4(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int32)
+	return &n
+}
+
+func requiredReviewersToDB(n int) pgtype.Int4 {
+	if n == 0 {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(n), Valid: true}
+}
+
+// --- SavedFilterRepository Implementation ---
+
+func (r *Repository) CreateSavedFilter(ctx context.Context, tx domain.Tx, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	q := r.querier(tx)
+	f := filter.Filter
+	id, err := q.CreateSavedFilter(ctx, models.CreateSavedFilterParams{
+		FilterName:     filter.Name,
+		UserID:         pgtype.Text{String: filter.UserID, Valid: filter.UserID != ""},
+		TeamID:         int4FromPtr(filter.TeamID),
+		Status:         string(f.Status),
+		AuthorID:       f.AuthorID,
+		ReviewerID:     f.ReviewerID,
+		TeamName:       f.TeamName,
+		RepositoryName: f.Repository,
+		Priority:       string(f.Priority),
+		Label:          f.Label,
+		MinAgeHours:    int32(f.MinAgeHours),
+		Unreviewed:     f.Unreviewed,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: saved filter '%s'", domain.ErrFilterExists, filter.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return r.getSavedFilter(ctx, q, id)
+}
+
+func (r *Repository) UpdateSavedFilter(ctx context.Context, tx domain.Tx, filter *domain.SavedFilter) (*domain.SavedFilter, error) {
+	q := r.querier(tx)
+	f := filter.Filter
+	n, err := q.UpdateSavedFilter(ctx, models.UpdateSavedFilterParams{
+		FilterID:       filter.ID,
+		FilterName:     filter.Name,
+		Status:         string(f.Status),
+		AuthorID:       f.AuthorID,
+		ReviewerID:     f.ReviewerID,
+		TeamName:       f.TeamName,
+		RepositoryName: f.Repository,
+		Priority:       string(f.Priority),
+		Label:          f.Label,
+		MinAgeHours:    int32(f.MinAgeHours),
+		Unreviewed:     f.Unreviewed,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: saved filter '%s'", domain.ErrFilterExists, filter.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, filter.ID)
+	}
+	return r.getSavedFilter(ctx, q, filter.ID)
+}
+
+func (r *Repository) GetSavedFilter(ctx context.Context, id int64) (*domain.SavedFilter, error) {
+	return r.getSavedFilter(ctx, r.querier(nil), id)
+}
+
+func (r *Repository) getSavedFilter(ctx context.Context, q models.Querier, id int64) (*domain.SavedFilter, error) {
+	row, err := q.GetSavedFilter(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, id)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return savedFilterFromDB(models.SavedFilter{
+		FilterID:       row.FilterID,
+		FilterName:     row.FilterName,
+		UserID:         row.UserID,
+		TeamID:         row.TeamID,
+		Status:         row.Status,
+		AuthorID:       row.AuthorID,
+		ReviewerID:     row.ReviewerID,
+		TeamName:       row.TeamName,
+		RepositoryName: row.RepositoryName,
+		Priority:       row.Priority,
+		Label:          row.Label,
+		MinAgeHours:    row.MinAgeHours,
+		Unreviewed:     row.Unreviewed,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}, row.OwnerTeamName), nil
+}
+
+func (r *Repository) ListSavedFilters(ctx context.Context, userID string, teamID int32) ([]domain.SavedFilter, error) {
+	rows, err := r.querier(nil).ListSavedFilters(ctx, models.ListSavedFiltersParams{UserID: userID, TeamID: teamID})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	filters := make([]domain.SavedFilter, len(rows))
+	for i, row := range rows {
+		filters[i] = *savedFilterFromDB(models.SavedFilter{
+			FilterID:       row.FilterID,
+			FilterName:     row.FilterName,
+			UserID:         row.UserID,
+			TeamID:         row.TeamID,
+			Status:         row.Status,
+			AuthorID:       row.AuthorID,
+			ReviewerID:     row.ReviewerID,
+			TeamName:       row.TeamName,
+			RepositoryName: row.RepositoryName,
+			Priority:       row.Priority,
+			Label:          row.Label,
+			MinAgeHours:    row.MinAgeHours,
+			Unreviewed:     row.Unreviewed,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		}, row.OwnerTeamName)
+	}
+	return filters, nil
+}
+
+func (r *Repository) DeleteSavedFilter(ctx context.Context, id int64) error {
+	n, err := r.querier(nil).DeleteSavedFilter(ctx, id)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: saved filter %d", domain.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *Repository) RenameSavedFilterTeam(ctx context.Context, tx domain.Tx, oldName, newName string) (int, error) {
+	n, err := r.querier(tx).RenameSavedFilterTeam(ctx, models.RenameSavedFilterTeamParams{NewName: newName, OldName: oldName})
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(n), nil
+}
+
+func savedFilterFromDB(f models.SavedFilter, teamName string) *domain.SavedFilter {
+	filter := &domain.SavedFilter{
+		ID:       f.FilterID,
+		Name:     f.FilterName,
+		UserID:   f.UserID.String,
+		TeamName: teamName,
+		Filter: domain.PRFilter{
+			Status:      domain.PRStatus(f.Status),
+			AuthorID:    f.AuthorID,
+			ReviewerID:  f.ReviewerID,
+			TeamName:    f.TeamName,
+			Repository:  f.RepositoryName,
+			Priority:    domain.PRPriority(f.Priority),
+			Label:       f.Label,
+			MinAgeHours: int(f.MinAgeHours),
+			Unreviewed:  f.Unreviewed,
+		},
+		CreatedAt: f.CreatedAt.Time,
+		UpdatedAt: f.UpdatedAt.Time,
+	}
+	if f.TeamID.Valid {
+		filter.TeamID = &f.TeamID.Int32
+	}
+	return filter
+}
+
+// --- PRTemplateRepository Implementation ---
+
+func (r *Repository) CreatePRTemplate(ctx context.Context, tx domain.Tx, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	q := r.querier(tx)
+	id, err := q.CreatePRTemplate(ctx, models.CreatePRTemplateParams{
+		TeamID:           template.TeamID,
+		TemplateName:     template.Name,
+		NamePrefix:       template.NamePrefix,
+		Labels:           nonNilStrings(template.Labels),
+		DefaultReviewers: nonNilStrings(template.DefaultReviewers),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return nil, fmt.Errorf("%w: PR template '%s'", domain.ErrTemplateExists, template.Name)
+			case pgerrcode.ForeignKeyViolation:
+				return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, template.TeamID)
+			}
+		}
+		return nil, domain.ErrInternalError
+	}
+	return r.getPRTemplate(ctx, q, id)
+}
+
+func (r *Repository) UpdatePRTemplate(ctx context.Context, tx domain.Tx, template *domain.PRTemplate) (*domain.PRTemplate, error) {
+	q := r.querier(tx)
+	n, err := q.UpdatePRTemplate(ctx, models.UpdatePRTemplateParams{
+		TemplateID:       template.ID,
+		TemplateName:     template.Name,
+		NamePrefix:       template.NamePrefix,
+		Labels:           nonNilStrings(template.Labels),
+		DefaultReviewers: nonNilStrings(template.DefaultReviewers),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: PR template '%s'", domain.ErrTemplateExists, template.Name)
+		}
+		return nil, domain.ErrInternalError
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w: PR template %d", domain.ErrNotFound, template.ID)
+	}
+	return r.getPRTemplate(ctx, q, template.ID)
+}
+
+func (r *Repository) GetPRTemplate(ctx context.Context, id int64) (*domain.PRTemplate, error) {
+	return r.getPRTemplate(ctx, r.querier(nil), id)
+}
+
+func (r *Repository) getPRTemplate(ctx context.Context, q models.Querier, id int64) (*domain.PRTemplate, error) {
+	row, err := q.GetPRTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR template %d", domain.ErrNotFound, id)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prTemplateFromDB(models.PrTemplate{
+		TemplateID:       row.TemplateID,
+		TeamID:           row.TeamID,
+		TemplateName:     row.TemplateName,
+		NamePrefix:       row.NamePrefix,
+		Labels:           row.Labels,
+		DefaultReviewers: row.DefaultReviewers,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}, row.TeamName), nil
+}
+
+func (r *Repository) ListPRTemplates(ctx context.Context, teamID int32) ([]domain.PRTemplate, error) {
+	rows, err := r.querier(nil).ListPRTemplates(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	templates := make([]domain.PRTemplate, len(rows))
+	for i, row := range rows {
+		templates[i] = *prTemplateFromDB(models.PrTemplate{
+			TemplateID:       row.TemplateID,
+			TeamID:           row.TeamID,
+			TemplateName:     row.TemplateName,
+			NamePrefix:       row.NamePrefix,
+			Labels:           row.Labels,
+			DefaultReviewers: row.DefaultReviewers,
+			CreatedAt:        row.CreatedAt,
+			UpdatedAt:        row.UpdatedAt,
+		}, row.TeamName)
+	}
+	return templates, nil
+}
+
+func (r *Repository) DeletePRTemplate(ctx context.Context, id int64) error {
+	n, err := r.querier(nil).DeletePRTemplate(ctx, id)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: PR template %d", domain.ErrNotFound, id)
+	}
+	return nil
+}
+
+func prTemplateFromDB(t models.PrTemplate, teamName string) *domain.PRTemplate {
+	return &domain.PRTemplate{
+		ID:               t.TemplateID,
+		TeamID:           t.TeamID,
+		TeamName:         teamName,
+		Name:             t.TemplateName,
+		NamePrefix:       t.NamePrefix,
+		Labels:           t.Labels,
+		DefaultReviewers: t.DefaultReviewers,
+		CreatedAt:        t.CreatedAt.Time,
+		UpdatedAt:        t.UpdatedAt.Time,
+	}
+}
+
+// --- UserRepository Implementation ---
+
+// usernameUniqueConstraint is raised by the trigger that keeps usernames unique
+// within teams that do not allow duplicates.
+const usernameUniqueConstraint = "users_team_username_unique"
+
+func isUsernameConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == usernameUniqueConstraint
+}
+
+func (r *Repository) CreateUser(ctx context.Context, tx domain.Tx, user *domain.User) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.CreateUser(ctx, models.CreateUserParams{
+		UserID:   user.ID,
+		Username: user.Username,
+		TeamID:   user.TeamID,
+		IsActive: true,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			if pgErr.ConstraintName == usernameUniqueConstraint {
+				return nil, fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(user.Username))
+			}
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrValidation, user.ID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
+	q := r.querier(nil)
+	dbUser, err := q.GetUserWithTeam(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.User{ID: dbUser.UserID, Username: dbUser.Username, TeamID: dbUser.TeamID, TeamName: dbUser.TeamName, IsActive: dbUser.IsActive, Role: dbUser.Role, Skills: dbUser.Skills, InTraining: dbUser.InTraining}, nil
+}
+
+func (r *Repository) GetUsersByUsername(ctx context.Context, username, teamName string) ([]domain.User, error) {
+	q := r.querier(nil)
+	rows, err := q.GetUsersByUsername(ctx, models.GetUsersByUsernameParams{Username: username, TeamName: teamName})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	users := make([]domain.User, len(rows))
+	for i, u := range rows {
+		users[i] = domain.User{ID: u.UserID, Username: u.Username, TeamID: u.TeamID, TeamName: u.TeamName, IsActive: u.IsActive, Role: u.Role, Skills: u.Skills, InTraining: u.InTraining}
+	}
+	return users, nil
+}
+
+func (r *Repository) GetUsersByTeam(ctx context.Context, teamID int32) ([]domain.User, error) {
+	q := r.querier(nil)
+	dbUsers, err := q.GetTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	users := make([]domain.User, len(dbUsers))
+	for i, u := range dbUsers {
+		users[i] = *userFromDB(u)
+	}
+	return users, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, tx domain.Tx, user *domain.User) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.UpdateUser(ctx, models.UpdateUserParams{
+		UserID:   user.ID,
+		Username: user.Username,
+		TeamID:   user.TeamID,
+		IsActive: user.IsActive,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, user.ID)
+		}
+		if isUsernameConflict(err) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrUsernameExists, user.ID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) SetUserActiveStatus(ctx context.Context, tx domain.Tx, userID string, isActive bool) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.SetUserActiveStatus(ctx, models.SetUserActiveStatusParams{
+		UserID:   userID,
+		IsActive: isActive,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) SetUserRole(ctx context.Context, tx domain.Tx, userID, role string) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.SetUserRole(ctx, models.SetUserRoleParams{
+		UserID: userID,
+		Role:   role,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) SetUserInTraining(ctx context.Context, tx domain.Tx, userID string, inTraining bool) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.SetUserInTraining(ctx, models.SetUserInTrainingParams{
+		UserID:     userID,
+		InTraining: inTraining,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) SetUserSkills(ctx context.Context, tx domain.Tx, userID string, skills []string) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.SetUserSkills(ctx, models.SetUserSkillsParams{
+		UserID: userID,
+		Skills: nonNilStrings(skills),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) MoveUserToTeam(ctx context.Context, tx domain.Tx, userID string, newTeamID int32) (*domain.User, error) {
+	q := r.querier(tx)
+	dbUser, err := q.MoveUserToTeam(ctx, models.MoveUserToTeamParams{
+		UserID: userID,
+		TeamID: newTeamID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+		}
+		if isUsernameConflict(err) {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrUsernameExists, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return userFromDB(dbUser), nil
+}
+
+func (r *Repository) DeactivateUsersByTeam(ctx context.Context, tx domain.Tx, teamID int32) ([]string, error) {
+	q := r.querier(tx)
+	userIDs, err := q.DeactivateUsersByTeam(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return userIDs, nil
+}
+
+func (r *Repository) GetActiveUsersByTeam(ctx context.Context, teamID int32) ([]domain.User, error) {
+	q := r.querier(nil)
+	dbUsers, err := q.ListActiveTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	users := make([]domain.User, len(dbUsers))
+	for i, u := range dbUsers {
+		users[i] = *userFromDB(u)
+	}
+	return users, nil
+}
+
+func (r *Repository) GetOpenReviewCounts(ctx context.Context, tx domain.Tx, userIDs []string) (map[string]int, error) {
+	q := r.querier(tx)
+	rows, err := q.ListOpenReviewCounts(ctx, userIDs)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = int(row.OpenReviews)
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetReviewBudgetUsage(ctx context.Context, tx domain.Tx, userIDs []string) (map[string]int, error) {
+	q := r.querier(tx)
+	rows, err := q.ListReviewBudgetUsage(ctx, userIDs)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	usage := make(map[string]int, len(rows))
+	for _, row := range rows {
+		usage[row.UserID] = int(row.Used)
+	}
+	return usage, nil
+}
+
+func (r *Repository) GetOpenAuthoredCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	q := r.querier(nil)
+	rows, err := q.ListOpenAuthoredCounts(ctx, userIDs)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.AuthorID] = int(row.OpenPrs)
+	}
+	return counts, nil
+}
+
+func (r *Repository) MergeUsers(ctx context.Context, tx domain.Tx, sourceID, targetID string) (*domain.UserMergeResult, error) {
+	q := r.querier(tx)
+	locked, err := q.LockUsers(ctx, []string{sourceID, targetID})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if len(locked) < 2 {
+		return nil, fmt.Errorf("%w: user '%s' or '%s'", domain.ErrNotFound, sourceID, targetID)
+	}
+
+	result := &domain.UserMergeResult{SourceUserID: sourceID, TargetUserID: targetID}
+	if err := mergeReviews(ctx, q, sourceID, targetID, result); err != nil {
+		return nil, err
+	}
+
+	prs, err := q.MovePRAuthor(ctx, models.MovePRAuthorParams{SourceID: sourceID, TargetID: targetID})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	result.PullRequestsMoved = int(prs)
+	if err := q.MoveArchivedPRAuthor(ctx, models.MoveArchivedPRAuthorParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return nil, domain.ErrInternalError
+	}
+
+	if err := moveUserIdentity(ctx, q, sourceID, targetID, result); err != nil {
+		return nil, err
+	}
+
+	if _, err := q.DeleteUser(ctx, sourceID); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return result, nil
+}
+
+// mergeReviews hands the source's review assignments, live and archived, over
+// to the target. The target's own assignments on PRs written by the source
+// are dropped, since the source's PRs become the target's.
+func mergeReviews(ctx context.Context, q models.Querier, sourceID, targetID string, result *domain.UserMergeResult) error {
+	if err := q.MergeReviewProgress(ctx, models.MergeReviewProgressParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	selfReviews, err := q.DeleteReviewsOfAuthor(ctx, models.DeleteReviewsOfAuthorParams{ReviewerID: targetID, AuthorID: sourceID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	moved, err := q.CopyReviewAssignmentsToUser(ctx, models.CopyReviewAssignmentsToUserParams{SourceID: sourceID, TargetID: targetID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	deleted, err := q.DeleteUserReviewAssignments(ctx, sourceID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	result.ReviewsMoved = int(moved)
+	result.ReviewsDropped = int(selfReviews + deleted - moved)
+
+	if err := q.DeleteDuplicateArchivedReviews(ctx, models.DeleteDuplicateArchivedReviewsParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveArchivedReviewAssignments(ctx, models.MoveArchivedReviewAssignmentsParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// moveUserIdentity moves the source's GitHub and Slack accounts, notification
+// settings, pending notifications, reassignment history, checklist ticks,
+// reviewer preferences, saved filters and team lead roles. Where the target already
+// has its own account, settings or filter of the same name, those are kept.
+func moveUserIdentity(ctx context.Context, q models.Querier, sourceID, targetID string, result *domain.UserMergeResult) error {
+	if err := moveUserAccounts(ctx, q, sourceID, targetID, result); err != nil {
+		return err
+	}
+	if err := moveUserHistory(ctx, q, sourceID, targetID); err != nil {
+		return err
+	}
+	return moveUserReviewSettings(ctx, q, sourceID, targetID)
+}
+
+// moveUserAccounts moves the source's GitHub and Slack accounts, notification
+// settings, pending notifications and webhook deliveries.
+func moveUserAccounts(ctx context.Context, q models.Querier, sourceID, targetID string, result *domain.UserMergeResult) error {
+	moved, err := q.MoveGitHubAccount(ctx, models.MoveGitHubAccountParams{SourceID: sourceID, TargetID: targetID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	result.GitHubAccountMoved = moved > 0
+	if err := q.MoveSlackAccount(ctx, models.MoveSlackAccountParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+
+	if err := q.MoveNotificationPreferences(ctx, models.MoveNotificationPreferencesParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MovePendingNotifications(ctx, models.MovePendingNotificationsParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveWebhookDeliveries(ctx, models.MoveWebhookDeliveriesParams{SourceID: pgtype.Text{String: sourceID, Valid: true}, TargetID: pgtype.Text{String: targetID, Valid: true}}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// moveUserHistory moves the source's reassignment history and checklist ticks.
+func moveUserHistory(ctx context.Context, q models.Querier, sourceID, targetID string) error {
+	if err := q.MoveReassignmentHistory(ctx, models.MoveReassignmentHistoryParams{SourceID: pgtype.Text{String: sourceID, Valid: true}, TargetID: pgtype.Text{String: targetID, Valid: true}}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveChecklistChecks(ctx, models.MoveChecklistChecksParams{SourceID: pgtype.Text{String: sourceID, Valid: true}, TargetID: pgtype.Text{String: targetID, Valid: true}}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// moveUserReviewSettings moves the source's reviewer preferences, saved
+// filters, template, rotation and pool memberships and team lead roles.
+func moveUserReviewSettings(ctx context.Context, q models.Querier, sourceID, targetID string) error {
+	if err := q.MoveReviewerPreferences(ctx, models.MoveReviewerPreferencesParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveSavedFilters(ctx, models.MoveSavedFiltersParams{SourceID: pgtype.Text{String: sourceID, Valid: true}, TargetID: pgtype.Text{String: targetID, Valid: true}}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveSavedFilterReferences(ctx, models.MoveSavedFilterReferencesParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MovePRTemplateReviewers(ctx, models.MovePRTemplateReviewersParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveReviewRotationMembers(ctx, models.MoveReviewRotationMembersParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveReviewRotationOverrides(ctx, models.MoveReviewRotationOverridesParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	if err := q.MoveReviewerPool(ctx, models.MoveReviewerPoolParams{SourceID: sourceID, TargetID: targetID}); err != nil {
+		return domain.ErrInternalError
+	}
+	err := q.MoveTeamLead(ctx, models.MoveTeamLeadParams{
+		SourceID: pgtype.Text{String: sourceID, Valid: true},
+		TargetID: pgtype.Text{String: targetID, Valid: true},
+	})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func userFromDB(u models.User) *domain.User {
+	return &domain.User{ID: u.UserID, Username: u.Username, TeamID: u.TeamID, IsActive: u.IsActive, Role: u.Role, Skills: u.Skills, InTraining: u.InTraining}
+}
+
+// --- PullRequestRepository Implementation ---
+
+func (r *Repository) CreatePR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) (*domain.PullRequest, error) {
+	q := r.querier(tx)
+	archived, err := q.IsPRArchived(ctx, pr.ID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if archived {
+		return nil, fmt.Errorf("%w: PR '%s' (archived)", domain.ErrPRExists, pr.ID)
+	}
+	dbPR, err := q.CreatePR(ctx, models.CreatePRParams{
+		PrID:           pr.ID,
+		PrName:         pr.Name,
+		AuthorID:       pr.AuthorID,
+		RequiredSkills: nonNilStrings(pr.RequiredSkills),
+		Labels:         nonNilStrings(pr.Labels),
+		Description:    pr.Description,
+		AutoMerge:      pr.AutoMerge,
+		Priority:       priorityToDB(pr.Priority),
+		RepositoryName: pgtype.Text{String: pr.Repository, Valid: pr.Repository != ""},
+		LinesChanged:   int4FromInt(pr.Size.LinesChanged),
+		FilesChanged:   int4FromInt(pr.Size.FilesChanged),
+		ExternalID:     pgtype.Text{String: pr.ExternalID, Valid: pr.ExternalID != ""},
+		Status:         models.PrStatus(pr.Status),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch {
+			case pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "pull_requests_external_id_key":
+				return nil, fmt.Errorf("%w: PR with external id '%s'", domain.ErrPRExists, pr.ExternalID)
+			case pgErr.Code == pgerrcode.UniqueViolation:
+				return nil, fmt.Errorf("%w: PR '%s'", domain.ErrPRExists, pr.ID)
+			case pgErr.Code == pgerrcode.ForeignKeyViolation && pgErr.ConstraintName == "pull_requests_repository_name_fkey":
+				return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, pr.Repository)
+			case pgErr.Code == pgerrcode.ForeignKeyViolation:
+				return nil, fmt.Errorf("%w: author '%s'", domain.ErrNotFound, pr.AuthorID)
+			}
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prFromDB(dbPR), nil
+}
+
+func (r *Repository) GetPRByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	q := r.querier(nil)
+	dbPR, err := q.GetPRByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prFromDB(dbPR), nil
+}
+
+// prReviewerJSON and checklistItemJSON mirror the JSON built by
+// GetPRWithReviewers.
+type prReviewerJSON struct {
+	UserID             string     `json:"user_id"`
+	Username           string     `json:"username"`
+	Optional           bool       `json:"optional"`
+	ApprovedAt         *time.Time `json:"approved_at"`
+	ChangesRequestedAt *time.Time `json:"changes_requested_at"`
+}
+
+type checklistItemJSON struct {
+	Position  int        `json:"position"`
+	Label     string     `json:"label"`
+	Checked   bool       `json:"checked"`
+	CheckedBy *string    `json:"checked_by"`
+	CheckedAt *time.Time `json:"checked_at"`
+}
+
+func (r *Repository) GetPRWithReviewers(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	q := r.querier(nil)
+	row, err := q.GetPRWithReviewers(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	pr := prFromDB(row.PullRequest)
+
+	var reviewers []prReviewerJSON
+	var checklist []checklistItemJSON
+	if err := json.Unmarshal(row.Reviewers, &reviewers); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if err := json.Unmarshal(row.ShadowReviewers, &pr.ShadowReviewers); err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if err := json.Unmarshal(row.Checklist, &checklist); err != nil {
+		return nil, domain.ErrInternalError
+	}
+
+	pr.Reviewers = make([]domain.Reviewer, len(reviewers))
+	for i, rev := range reviewers {
+		pr.Reviewers[i] = domain.Reviewer{ID: rev.UserID, Username: rev.Username, Optional: rev.Optional}
+	}
+	pr.ApprovedBy = reviewersByVerdict(reviewers, func(rev prReviewerJSON) *time.Time { return rev.ApprovedAt })
+	pr.ChangesRequestedBy = reviewersByVerdict(reviewers, func(rev prReviewerJSON) *time.Time { return rev.ChangesRequestedAt })
+
+	pr.Checklist = make([]domain.ChecklistItem, len(checklist))
+	for i, item := range checklist {
+		pr.Checklist[i] = domain.ChecklistItem{Position: item.Position, Label: item.Label, Checked: item.Checked, CheckedAt: item.CheckedAt}
+		if item.CheckedBy != nil {
+			pr.Checklist[i].CheckedBy = *item.CheckedBy
+		}
+	}
+	return pr, nil
+}
+
+func (r *Repository) GetPRTimeline(ctx context.Context, prID string) ([]domain.PREvent, error) {
+	rows, err := r.querier(nil).ListPRTimeline(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	events := make([]domain.PREvent, len(rows))
+	for i, row := range rows {
+		events[i] = domain.PREvent{
+			Type:          domain.PREventType(row.EventType),
+			At:            row.OccurredAt.Time,
+			UserID:        row.UserID,
+			ReplacedBy:    row.ReplacedBy,
+			Reason:        domain.ReassignmentReason(row.Reason),
+			DeclineReason: domain.DeclineReason(row.DeclineReason),
+			Actor:         row.Actor,
+		}
+	}
+	return events, nil
+}
+
+// reviewersByVerdict returns the IDs of the reviewers whose verdict time is
+// set, ordered by that time like ListApprovedReviewers does.
+func reviewersByVerdict(reviewers []prReviewerJSON, at func(prReviewerJSON) *time.Time) []string {
+	var matched []prReviewerJSON
+	for _, rev := range reviewers {
+		if at(rev) != nil {
+			matched = append(matched, rev)
+		}
+	}
+	slices.SortFunc(matched, func(a, b prReviewerJSON) int {
+		if c := at(a).Compare(*at(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+	ids := make([]string, len(matched))
+	for i, rev := range matched {
+		ids[i] = rev.UserID
+	}
+	return ids
+}
+
+func (r *Repository) GetPRByExternalID(ctx context.Context, externalID string) (*domain.PullRequest, error) {
+	q := r.querier(nil)
+	dbPR, err := q.GetPRByExternalID(ctx, pgtype.Text{String: externalID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR with external id '%s'", domain.ErrNotFound, externalID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prFromDB(dbPR), nil
+}
+
+func prFromDB(dbPR models.PullRequest) *domain.PullRequest {
+	pr := &domain.PullRequest{
+		ID:             dbPR.PrID,
+		ExternalID:     dbPR.ExternalID.String,
+		Name:           dbPR.PrName,
+		Description:    dbPR.Description,
+		AuthorID:       dbPR.AuthorID,
+		Status:         domain.PRStatus(dbPR.Status),
+		RequiredSkills: dbPR.RequiredSkills,
+		Labels:         dbPR.Labels,
+		AutoMerge:      dbPR.AutoMerge,
+		Priority:       domain.PRPriority(dbPR.Priority),
+		Repository:     dbPR.RepositoryName.String,
+		MergedBy:       dbPR.MergedBy.String,
+		ReassignedBy:   dbPR.ReassignedBy.String,
+		Size:           prSizeFromDB(dbPR),
+		CreatedAt:      dbPR.CreatedAt.Time,
+	}
+	if dbPR.MergedAt.Valid {
+		pr.MergedAt = &dbPR.MergedAt.Time
+	}
+	if dbPR.ClosedAt.Valid {
+		pr.ClosedAt = &dbPR.ClosedAt.Time
+	}
+	return pr
+}
+
+func (r *Repository) MergePR(ctx context.Context, tx domain.Tx, prID, mergedBy string) (*domain.PullRequest, error) {
+	q := r.querier(tx)
+	mergedDBPR, err := q.MergePR(ctx, models.MergePRParams{PrID: prID, MergedBy: mergedBy})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+
+	reviewersUser, err := q.GetReviewersForPR(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	reviewers := make([]domain.Reviewer, len(reviewersUser))
+	for i, reviewer := range reviewersUser {
+		reviewers[i] = domain.Reviewer{ID: reviewer.UserID, Username: reviewer.Username}
+	}
+
+	pr := &domain.PullRequest{
+		ID:             mergedDBPR.PrID,
+		Name:           mergedDBPR.PrName,
+		Description:    mergedDBPR.Description,
+		AuthorID:       mergedDBPR.AuthorID,
+		Status:         domain.PRStatus(mergedDBPR.Status),
+		Reviewers:      reviewers,
+		RequiredSkills: mergedDBPR.RequiredSkills,
+		Labels:         mergedDBPR.Labels,
+		AutoMerge:      mergedDBPR.AutoMerge,
+		Priority:       domain.PRPriority(mergedDBPR.Priority),
+		Repository:     mergedDBPR.RepositoryName.String,
+		MergedBy:       mergedDBPR.MergedBy.String,
+		ReassignedBy:   mergedDBPR.ReassignedBy.String,
+		CreatedAt:      mergedDBPR.CreatedAt.Time,
+	}
+	if mergedDBPR.MergedAt.Valid {
+		pr.MergedAt = &mergedDBPR.MergedAt.Time
+	}
+	return pr, nil
+}
+
+func (r *Repository) MarkPRReady(ctx context.Context, tx domain.Tx, prID string) (*domain.PullRequest, error) {
+	dbPR, err := r.querier(tx).MarkPRReady(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s' is not a draft", domain.ErrInvalidTransition, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prFromDB(dbPR), nil
+}
+
+func (r *Repository) ClosePR(ctx context.Context, tx domain.Tx, prID string) (*domain.PullRequest, error) {
+	dbPR, err := r.querier(tx).ClosePR(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: PR '%s' is already finished", domain.ErrInvalidTransition, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return prFromDB(dbPR), nil
+}
+
+func (r *Repository) GetReviewers(ctx context.Context, prID string) ([]domain.User, error) {
+	q := r.querier(nil)
+	dbReviewers, err := q.GetReviewersForPR(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	reviewers := make([]domain.User, len(dbReviewers))
+	for i, rev := range dbReviewers {
+		reviewers[i] = domain.User{ID: rev.UserID, Username: rev.Username, TeamID: rev.TeamID, Role: rev.Role, Skills: rev.Skills}
+	}
+	return reviewers, nil
+}
+
+func (r *Repository) GetRequiredReviewers(ctx context.Context, prID string) ([]domain.User, error) {
+	q := r.querier(nil)
+	dbReviewers, err := q.GetRequiredReviewersForPR(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	reviewers := make([]domain.User, len(dbReviewers))
+	for i, rev := range dbReviewers {
+		reviewers[i] = domain.User{ID: rev.UserID, Username: rev.Username, TeamID: rev.TeamID, Role: rev.Role, Skills: rev.Skills}
+	}
+	return reviewers, nil
+}
+
+func (r *Repository) RemoveReviewer(ctx context.Context, tx domain.Tx, prID string, userID string) (bool, error) {
+	q := r.querier(tx)
+	optional, err := q.RemoveReviewerFromPR(ctx, models.RemoveReviewerFromPRParams{PrID: prID, UserID: userID})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return false, domain.ErrInternalError
+	}
+	return optional, nil
+}
+
+func (r *Repository) RemoveOpenReviewsByUsers(ctx context.Context, tx domain.Tx, userIDs []string) ([]domain.RebalanceMove, error) {
+	q := r.querier(tx)
+	rows, err := q.RemoveOpenReviewsByUsers(ctx, userIDs)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	removed := make([]domain.RebalanceMove, len(rows))
+	for i, row := range rows {
+		removed[i] = domain.RebalanceMove{PRID: row.PrID, FromUserID: row.UserID}
+	}
+	return removed, nil
+}
+
+func (r *Repository) AssignReviewers(ctx context.Context, tx domain.Tx, prID string, userIDs []string, optional bool) error {
+	q := r.querier(tx)
+	for _, userID := range userIDs {
+		if err := q.AddReviewerToPR(ctx, models.AddReviewerToPRParams{PrID: prID, UserID: userID, Optional: optional}); err != nil {
+			return domain.ErrInternalError
+		}
+	}
+	return nil
+}
+
+func (r *Repository) AddShadowReviewer(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	if err := r.querier(tx).AddShadowReviewer(ctx, models.AddShadowReviewerParams{PrID: prID, UserID: userID}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// ApproveReview locks the PR row first so that concurrent approvals are counted
+// one after another and the last one always sees all the others.
+func (r *Repository) ApproveReview(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	q := r.querier(tx)
+	if _, err := q.LockPR(ctx, prID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return domain.ErrInternalError
+	}
+	updated, err := q.ApproveReview(ctx, models.ApproveReviewParams{PrID: prID, UserID: userID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
+	}
+	return nil
+}
+
+// RequestChanges locks the PR row like ApproveReview, so that an auto-merge
+// never counts an approval that is being withdrawn.
+func (r *Repository) RequestChanges(ctx context.Context, tx domain.Tx, prID, userID string) error {
+	q := r.querier(tx)
+	if _, err := q.LockPR(ctx, prID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return domain.ErrInternalError
+	}
+	updated, err := q.RequestChanges(ctx, models.RequestChangesParams{PrID: prID, UserID: userID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
+	}
+	return nil
+}
+
+func (r *Repository) GetApprovalState(ctx context.Context, tx domain.Tx, prID string) (int, int, error) {
+	q := r.querier(tx)
+	state, err := q.GetApprovalState(ctx, prID)
+	if err != nil {
+		return 0, 0, domain.ErrInternalError
+	}
+	return int(state.Approved), int(state.Total), nil
+}
+
+func (r *Repository) GetApprovedReviewers(ctx context.Context, prID string) ([]string, error) {
+	q := r.querier(nil)
+	userIDs, err := q.ListApprovedReviewers(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return userIDs, nil
+}
+
+func (r *Repository) GetChangesRequestedReviewers(ctx context.Context, prID string) ([]string, error) {
+	q := r.querier(nil)
+	userIDs, err := q.ListChangesRequestedReviewers(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return userIDs, nil
+}
+
+func (r *Repository) GetRecentReviewers(ctx context.Context, authorID, excludePRID string, prCount int) ([]string, error) {
+	q := r.querier(nil)
+	userIDs, err := q.GetRecentReviewersOfAuthor(ctx, models.GetRecentReviewersOfAuthorParams{
+		AuthorID:    authorID,
+		ExcludePrID: excludePRID,
+		PrCount:     int32(prCount),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return userIDs, nil
+}
+
+func (r *Repository) CountOpenPRsByAuthor(ctx context.Context, tx domain.Tx, authorID string) (int, error) {
+	q := r.querier(tx)
+	if tx != nil {
+		if _, err := q.LockUsers(ctx, []string{authorID}); err != nil {
+			return 0, domain.ErrInternalError
+		}
+	}
+	n, err := q.CountOpenPRsByAuthor(ctx, authorID)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(n), nil
+}
+
+func (r *Repository) GetPendingReviews(ctx context.Context, userID string) ([]domain.PendingReview, error) {
+	q := r.querier(nil)
+	rows, err := q.ListPendingReviews(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	reviews := make([]domain.PendingReview, len(rows))
+	for i, row := range rows {
+		reviews[i] = domain.PendingReview{PRID: row.PrID, PRName: row.PrName, Priority: domain.PRPriority(row.Priority), AssignedAt: row.AssignedAt.Time}
+	}
+	return reviews, nil
+}
+
+func (r *Repository) ListStalledPRs(ctx context.Context, limit int) ([]domain.StalledPR, error) {
+	q := r.querier(nil)
+	rows, err := q.ListStalledPRs(ctx, int32(limit))
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.StalledPR, len(rows))
+	for i, row := range rows {
+		prs[i] = domain.StalledPR{
+			PRID:     row.PrID,
+			PRName:   row.PrName,
+			AuthorID: row.AuthorID,
+			Priority: domain.PRPriority(row.Priority),
+			TeamID:   row.TeamID,
+			Policy: domain.EscalationPolicy{
+				LeadUserID:            row.LeadUserID.String,
+				NotifyLeadAfterHours:  int(row.EscalationNotifyAfterHours),
+				AddReviewerAfterHours: int(row.EscalationAddReviewerAfterHours),
+			},
+			WaitingSince:      row.WaitingSince.Time,
+			LeadNotified:      row.LeadNotifiedAt.Valid,
+			ReviewerEscalated: row.ReviewerEscalatedAt.Valid,
+		}
+	}
+	return prs, nil
+}
+
+func (r *Repository) MarkLeadNotified(ctx context.Context, tx domain.Tx, prID string) (bool, error) {
+	q := r.querier(tx)
+	rows, err := q.MarkLeadNotified(ctx, prID)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return rows > 0, nil
+}
+
+func (r *Repository) MarkReviewerEscalated(ctx context.Context, tx domain.Tx, prID string) (bool, error) {
+	q := r.querier(tx)
+	rows, err := q.MarkReviewerEscalated(ctx, prID)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return rows > 0, nil
+}
+
+func (r *Repository) SetAutoMerge(ctx context.Context, tx domain.Tx, prID string, autoMerge bool) error {
+	q := r.querier(tx)
+	if _, err := q.SetPRAutoMerge(ctx, models.SetPRAutoMergeParams{PrID: prID, AutoMerge: autoMerge}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) SetPriority(ctx context.Context, tx domain.Tx, prID string, priority domain.PRPriority) error {
+	q := r.querier(tx)
+	if _, err := q.SetPRPriority(ctx, models.SetPRPriorityParams{PrID: prID, Priority: models.PrPriority(priority)}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, prID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) AckReview(ctx context.Context, prID, userID string) error {
+	q := r.querier(nil)
+	updated, err := q.AckReview(ctx, models.AckReviewParams{PrID: prID, UserID: userID})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: user '%s' on PR '%s'", domain.ErrNotAssigned, userID, prID)
+	}
+	return nil
+}
+
+func (r *Repository) RecordReassignment(ctx context.Context, tx domain.Tx, prID, fromUserID, toUserID string, reason domain.ReassignmentReason, decline domain.DeclineReason) error {
+	q := r.querier(tx)
+	err := q.RecordReassignment(ctx, models.RecordReassignmentParams{
+		PrID:          prID,
+		FromUserID:    fromUserID,
+		ToUserID:      toUserID,
+		Reason:        string(reason),
+		DeclineReason: string(decline),
+		ReassignedBy:  domain.ActorFromContext(ctx),
+	})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) RecordReassignments(ctx context.Context, tx domain.Tx, moves []domain.RebalanceMove, reason domain.ReassignmentReason) error {
+	if len(moves) == 0 {
+		return nil
+	}
+	params := models.RecordReassignmentsParams{
+		Reason:       string(reason),
+		ReassignedBy: domain.ActorFromContext(ctx),
+		PrIds:        make([]string, len(moves)),
+		FromUserIds:  make([]string, len(moves)),
+		ToUserIds:    make([]string, len(moves)),
+	}
+	for i, m := range moves {
+		params.PrIds[i] = m.PRID
+		params.FromUserIds[i] = m.FromUserID
+		params.ToUserIds[i] = m.ToUserID
+	}
+	if err := r.querier(tx).RecordReassignments(ctx, params); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) CreateChecklist(ctx context.Context, tx domain.Tx, prID string, labels []string) error {
+	q := r.querier(tx)
+	if err := q.CreateChecklistItems(ctx, models.CreateChecklistItemsParams{PrID: prID, Labels: labels}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) GetChecklist(ctx context.Context, prID string) ([]domain.ChecklistItem, error) {
+	q := r.querier(nil)
+	rows, err := q.GetChecklistItems(ctx, prID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	items := make([]domain.ChecklistItem, len(rows))
+	for i, row := range rows {
+		items[i] = domain.ChecklistItem{
+			Position:  int(row.Position),
+			Label:     row.Label,
+			Checked:   row.Checked,
+			CheckedBy: row.CheckedBy.String,
+		}
+		if row.CheckedAt.Valid {
+			at := row.CheckedAt.Time
+			items[i].CheckedAt = &at
+		}
+	}
+	return items, nil
+}
+
+func (r *Repository) SetChecklistItem(ctx context.Context, tx domain.Tx, prID string, position int, userID string, checked bool) error {
+	q := r.querier(tx)
+	updated, err := q.SetChecklistItemChecked(ctx, models.SetChecklistItemCheckedParams{
+		Checked:  checked,
+		UserID:   userID,
+		PrID:     prID,
+		Position: int32(position),
+	})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: PR '%s' has no checklist item %d", domain.ErrValidation, prID, position)
+	}
+	return nil
+}
+
+func (r *Repository) ListUnackedReviews(ctx context.Context, remindBefore, reassignBefore time.Time, limit int) ([]domain.UnackedReview, error) {
+	q := r.querier(nil)
+	rows, err := q.ListUnackedReviews(ctx, models.ListUnackedReviewsParams{
+		RemindBefore:   pgtype.Timestamptz{Time: remindBefore, Valid: !remindBefore.IsZero()},
+		ReassignBefore: pgtype.Timestamptz{Time: reassignBefore, Valid: !reassignBefore.IsZero()},
+		BatchSize:      int32(limit),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	reviews := make([]domain.UnackedReview, len(rows))
+	for i, row := range rows {
+		reviews[i] = domain.UnackedReview{
+			PRID:       row.PrID,
+			PRName:     row.PrName,
+			UserID:     row.UserID,
+			AssignedAt: row.AssignedAt.Time,
+			Reminded:   row.AckRemindedAt.Valid,
+		}
+	}
+	return reviews, nil
+}
+
+func (r *Repository) MarkAckReminded(ctx context.Context, tx domain.Tx, prID, userID string) (bool, error) {
+	q := r.querier(tx)
+	rows, err := q.MarkAckReminded(ctx, models.MarkAckRemindedParams{PrID: prID, UserID: userID})
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return rows > 0, nil
+}
+
+func (r *Repository) ListInactiveReviews(ctx context.Context, inactiveSince time.Time, limit int) ([]domain.InactiveReview, error) {
+	q := r.querier(nil)
+	rows, err := q.ListInactiveReviews(ctx, models.ListInactiveReviewsParams{
+		InactiveSince: pgtype.Timestamptz{Time: inactiveSince, Valid: true},
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	reviews := make([]domain.InactiveReview, len(rows))
+	for i, row := range rows {
+		reviews[i] = domain.InactiveReview{
+			PRID:           row.PrID,
+			PRName:         row.PrName,
+			UserID:         row.UserID,
+			LastActivityAt: row.LastActivityAt.Time,
+		}
+	}
+	return reviews, nil
+}
+
+func (r *Repository) GetOpenAssignmentsForUsers(ctx context.Context, userIDs []string) ([]domain.ReviewAssignment, error) {
+	q := r.querier(nil)
+	rows, err := q.GetOpenReviewsForUsers(ctx, nonNilStrings(userIDs))
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	assignments := make([]domain.ReviewAssignment, len(rows))
+	for i, row := range rows {
+		assignments[i] = domain.ReviewAssignment{PRID: row.PrID, UserID: row.UserID, AuthorID: row.AuthorID}
+	}
+	return assignments, nil
+}
+
+func (r *Repository) GetOpenPRsByReviewer(ctx context.Context, tx domain.Tx, userID string) ([]domain.PullRequest, error) {
+	q := r.querier(tx)
+	dbPRs, err := q.GetPRsForReviewer(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.PullRequest, len(dbPRs))
+	for i, p := range dbPRs {
+		prs[i] = domain.PullRequest{ID: p.PrID, Name: p.PrName, AuthorID: p.AuthorID, Status: domain.PRStatus(p.Status), RequiredSkills: p.RequiredSkills, Labels: p.Labels, Priority: domain.PRPriority(p.Priority), Repository: p.RepositoryName.String}
+	}
+	return prs, nil
+}
+
+func (r *Repository) GetPRsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+	q := r.querier(nil)
+	dbPRs, err := q.GetPRsForReviewer(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.PullRequest, len(dbPRs))
+	for i, p := range dbPRs {
+		prs[i] = domain.PullRequest{ID: p.PrID, Name: p.PrName, AuthorID: p.AuthorID, Status: domain.PRStatus(p.Status), RequiredSkills: p.RequiredSkills, Labels: p.Labels, Priority: domain.PRPriority(p.Priority), Repository: p.RepositoryName.String}
+	}
+	return prs, nil
+}
+
+func (r *Repository) GetOpenPRsWithoutReviewers(ctx context.Context) ([]domain.PullRequest, error) {
+	q := r.querier(nil)
+	dbPRs, err := q.GetOpenPRsWithoutReviewers(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.PullRequest, len(dbPRs))
+	for i, p := range dbPRs {
+		prs[i] = domain.PullRequest{
+			ID:             p.PrID,
+			Name:           p.PrName,
+			Description:    p.Description,
+			AuthorID:       p.AuthorID,
+			Status:         domain.PRStatus(p.Status),
+			RequiredSkills: p.RequiredSkills,
+			Labels:         p.Labels,
+			Priority:       domain.PRPriority(p.Priority),
+			CreatedAt:      p.CreatedAt.Time,
+		}
+	}
+	return prs, nil
+}
+
+func (r *Repository) GetOpenPRsWithoutReviewersByIDs(ctx context.Context, tx domain.Tx, prIDs []string) ([]domain.PullRequest, error) {
+	q := r.querier(tx)
+	dbPRs, err := q.GetOpenPRsWithoutReviewersByIDs(ctx, prIDs)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.PullRequest, len(dbPRs))
+	for i, p := range dbPRs {
+		prs[i] = domain.PullRequest{ID: p.PrID, Name: p.PrName, AuthorID: p.AuthorID, Status: domain.PRStatus(p.Status), RequiredSkills: p.RequiredSkills, Labels: p.Labels, Priority: domain.PRPriority(p.Priority), Repository: p.RepositoryName.String}
+	}
+	return prs, nil
+}
+
+func (r *Repository) SearchPRs(ctx context.Context, query string, limit, offset int) ([]domain.PRSearchHit, int, error) {
+	q := r.querier(nil)
+	total, err := q.CountSearchPRs(ctx, query)
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	rows, err := q.SearchPRs(ctx, models.SearchPRsParams{
+		Query:        query,
+		ResultLimit:  int32(limit),
+		ResultOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	hits := make([]domain.PRSearchHit, len(rows))
+	for i, p := range rows {
+		hits[i] = domain.PRSearchHit{
+			PullRequest: domain.PullRequest{ID: p.PrID, Name: p.PrName, Description: p.Description, AuthorID: p.AuthorID, Status: domain.PRStatus(p.Status)},
+			Rank:        p.Rank,
+		}
+	}
+	return hits, int(total), nil
+}
+
+func (r *Repository) FilterPRs(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequest, int, error) {
+	q := r.querier(nil)
+	total, err := q.CountFilteredPRs(ctx, models.CountFilteredPRsParams{
+		Status:         string(filter.Status),
+		AuthorID:       filter.AuthorID,
+		TeamName:       filter.TeamName,
+		RepositoryName: filter.Repository,
+		Priority:       string(filter.Priority),
+		Label:          filter.Label,
+		MinAgeHours:    int32(filter.MinAgeHours),
+		ReviewerID:     filter.ReviewerID,
+		Unreviewed:     filter.Unreviewed,
+	})
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	rows, err := q.FilterPRs(ctx, models.FilterPRsParams{
+		Status:         string(filter.Status),
+		AuthorID:       filter.AuthorID,
+		TeamName:       filter.TeamName,
+		RepositoryName: filter.Repository,
+		Priority:       string(filter.Priority),
+		Label:          filter.Label,
+		MinAgeHours:    int32(filter.MinAgeHours),
+		ReviewerID:     filter.ReviewerID,
+		Unreviewed:     filter.Unreviewed,
+		ResultLimit:    int32(filter.Limit),
+		ResultOffset:   int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	prs := make([]domain.PullRequest, len(rows))
+	for i, p := range rows {
+		prs[i] = domain.PullRequest{ID: p.PrID, Name: p.PrName, AuthorID: p.AuthorID, Status: domain.PRStatus(p.Status), Priority: domain.PRPriority(p.Priority)}
+	}
+	return prs, int(total), nil
+}
+
+// --- StatsRepository Implementation ---
+
+func (r *Repository) GetReviewStats(ctx context.Context, order domain.StatsOrder, limit, offset int) ([]domain.StatItem, int, error) {
+	q := r.querier(nil)
+	total, err := q.CountReviewStats(ctx)
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	dbStats, err := q.GetReviewStats(ctx, models.GetReviewStatsParams{
+		OrderBy:      string(order),
+		ResultLimit:  int32(limit),
+		ResultOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, domain.ErrInternalError
+	}
+	stats := make([]domain.StatItem, len(dbStats))
+	for i, s := range dbStats {
+		stats[i] = domain.StatItem{
+			UserID:        s.UserID,
+			Username:      s.Username,
+			ReviewCount:   s.ReviewCount,
+			AckedCount:    s.AckedCount,
+			AvgAckLatency: time.Duration(s.AvgAckSeconds * float64(time.Second)),
+		}
+	}
+	return stats, int(total), nil
+}
+
+func (r *Repository) GetOpenReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+	q := r.querier(nil)
+	team, err := r.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.CountOpenReviewsByTeam(ctx, team.ID)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(count), nil
+}
+
+func (r *Repository) GetMergedReviewCountForTeam(ctx context.Context, teamName string) (int, error) {
+	q := r.querier(nil)
+	team, err := r.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.CountMergedReviewsByTeam(ctx, team.ID)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(count), nil
+}
+
+func (r *Repository) GetOpenReviewCountForUser(ctx context.Context, userID string) (int, error) {
+	q := r.querier(nil)
+	count, err := q.CountOpenReviewsByUser(ctx, userID)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(count), nil
+}
+
+func (r *Repository) GetMergedReviewCountForUser(ctx context.Context, userID string) (int, error) {
+	q := r.querier(nil)
+	count, err := q.CountMergedReviewsByUser(ctx, userID)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(count), nil
+}
+
+func (r *Repository) GetMemberReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.MemberReviewCount, error) {
+	q := r.querier(nil)
+	if teamName != "" {
+		if _, err := r.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := q.ListMemberReviewCounts(ctx, models.ListMemberReviewCountsParams{
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+		TeamName: teamName,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.MemberReviewCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.MemberReviewCount{TeamName: row.TeamName, UserID: row.UserID, ReviewCount: int(row.ReviewCount)}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetMergeCounts(ctx context.Context, since, until time.Time) ([]domain.MergeCount, error) {
+	rows, err := r.querier(nil).ListMergeCounts(ctx, models.ListMergeCountsParams{
+		Since: pgtype.Timestamptz{Time: since, Valid: true},
+		Until: pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.MergeCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.MergeCount{MergedBy: row.MergedBy, Username: row.Username, Count: int(row.MergeCount)}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetShadowReviewCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.ShadowReviewCount, error) {
+	if teamName != "" {
+		if _, err := r.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := r.querier(nil).ListShadowReviewCounts(ctx, models.ListShadowReviewCountsParams{
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+		TeamName: teamName,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.ShadowReviewCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.ShadowReviewCount{
+			TeamName:   row.TeamName,
+			UserID:     row.UserID,
+			Username:   row.Username,
+			InTraining: row.InTraining,
+			Count:      int(row.ShadowReviewCount),
+		}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetTeamMergedPRs(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReportPR, error) {
+	rows, err := r.querier(nil).ListTeamMergedPRs(ctx, models.ListTeamMergedPRsParams{
+		TeamName: teamName,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.ReportPR, len(rows))
+	for i, row := range rows {
+		prs[i] = domain.ReportPR{ID: row.PrID, Name: row.PrName, AuthorID: row.AuthorID, CreatedAt: row.CreatedAt.Time, At: row.MergedAt.Time}
+	}
+	return prs, nil
+}
+
+func (r *Repository) GetTeamSLABreaches(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReportPR, error) {
+	rows, err := r.querier(nil).ListTeamSLABreaches(ctx, models.ListTeamSLABreachesParams{
+		TeamName: teamName,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prs := make([]domain.ReportPR, len(rows))
+	for i, row := range rows {
+		prs[i] = domain.ReportPR{ID: row.PrID, Name: row.PrName, AuthorID: row.AuthorID, CreatedAt: row.CreatedAt.Time, At: row.EscalatedAt.Time}
+	}
+	return prs, nil
+}
+
+func (r *Repository) SnapshotStats(ctx context.Context, tx domain.Tx, day time.Time) (bool, error) {
+	q := r.querier(tx)
+	date := pgtype.Date{Time: day, Valid: true}
+	users, err := q.InsertUserStatsHistory(ctx, date)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	teams, err := q.InsertTeamStatsHistory(ctx, date)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return users+teams > 0, nil
+}
+
+func (r *Repository) GetUserStatsHistory(ctx context.Context, userID string, since, until time.Time) ([]domain.UserStatsSnapshot, error) {
+	rows, err := r.querier(nil).ListUserStatsHistory(ctx, models.ListUserStatsHistoryParams{
+		UserID: userID,
+		Since:  pgtype.Date{Time: since, Valid: true},
+		Until:  pgtype.Date{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	if len(rows) == 0 {
+		if _, err := r.GetUserByID(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	history := make([]domain.UserStatsSnapshot, len(rows))
+	for i, row := range rows {
+		history[i] = domain.UserStatsSnapshot{
+			Date:          row.SnapshotDate.Time,
+			TeamName:      row.TeamName,
+			TotalReviews:  int(row.TotalReviews),
+			OpenReviews:   int(row.OpenReviews),
+			MergedReviews: int(row.MergedReviews),
+		}
+	}
+	return history, nil
+}
+
+func (r *Repository) GetTeamStatsHistory(ctx context.Context, teamName string, since, until time.Time) ([]domain.TeamStatsSnapshot, error) {
+	team, err := r.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.querier(nil).ListTeamStatsHistory(ctx, models.ListTeamStatsHistoryParams{
+		TeamID: team.ID,
+		Since:  pgtype.Date{Time: since, Valid: true},
+		Until:  pgtype.Date{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	history := make([]domain.TeamStatsSnapshot, len(rows))
+	for i, row := range rows {
+		history[i] = domain.TeamStatsSnapshot{
+			Date:          row.SnapshotDate.Time,
+			ActiveMembers: int(row.ActiveMembers),
+			OpenReviews:   int(row.OpenReviews),
+			MergedReviews: int(row.MergedReviews),
+		}
+	}
+	return history, nil
+}
+
+func (r *Repository) GetThroughputCounts(ctx context.Context, teamName string, metrics []domain.ThroughputMetric, interval domain.SeriesInterval, since, until time.Time) ([]domain.ThroughputCount, error) {
+	if teamName != "" {
+		if _, err := r.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	names := make([]string, len(metrics))
+	for i, m := range metrics {
+		names[i] = string(m)
+	}
+	rows, err := r.querier(nil).ListThroughputCounts(ctx, models.ListThroughputCountsParams{
+		Unit:     string(interval),
+		Metrics:  names,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+		TeamName: teamName,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.ThroughputCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.ThroughputCount{Metric: domain.ThroughputMetric(row.Metric), Bucket: row.Bucket.Time.UTC(), Count: int(row.EventCount)}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetReassignmentCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.ReassignmentCount, error) {
+	q := r.querier(nil)
+	if teamName != "" {
+		if _, err := r.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := q.ListReassignmentCounts(ctx, models.ListReassignmentCountsParams{
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+		TeamName: teamName,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.ReassignmentCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.ReassignmentCount{
+			TeamName:      row.TeamName,
+			UserID:        row.UserID,
+			Reason:        domain.ReassignmentReason(row.Reason),
+			DeclineReason: domain.DeclineReason(row.DeclineReason),
+			Count:         int(row.ReassignmentCount),
+		}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetUnassignedCounts(ctx context.Context, teamName string, since, until time.Time) ([]domain.UnassignedCount, error) {
+	q := r.querier(nil)
+	if teamName != "" {
+		if _, err := r.GetTeamByName(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := q.ListUnassignedPRCounts(ctx, models.ListUnassignedPRCountsParams{
+		Since:    pgtype.Date{Time: since, Valid: true},
+		Until:    pgtype.Date{Time: until, Valid: true},
+		TeamName: teamName,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	counts := make([]domain.UnassignedCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domain.UnassignedCount{
+			TeamName: row.TeamName,
+			Day:      row.Day.Time,
+			Count:    int(row.PrCount),
+		}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetRepositoryStats(ctx context.Context, repositoryName string) (*domain.RepositoryStats, error) {
+	q := r.querier(nil)
+	if _, err := q.GetRepository(ctx, repositoryName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, repositoryName)
+		}
+		return nil, domain.ErrInternalError
+	}
+	row, err := q.GetRepositoryPRStats(ctx, pgtype.Text{String: repositoryName, Valid: true})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	stats := &domain.RepositoryStats{
+		RepositoryName: repositoryName,
+		OpenPRs:        int(row.OpenCount),
+		MergedPRs:      int(row.MergedCount),
+		AvgReviewers:   row.AvgReviewers,
+	}
+	if row.MergedCount > 0 {
+		avg := time.Duration(row.AvgMergeSeconds * float64(time.Second))
+		median := time.Duration(row.MedianMergeSeconds * float64(time.Second))
+		stats.AvgTimeToMerge = &avg
+		stats.MedianTimeToMerge = &median
+	}
+	return stats, nil
+}
+
+func (r *Repository) GetReviewerTurnaround(ctx context.Context, userID string, since, until time.Time) (*domain.ReviewerTurnaround, error) {
+	q := r.querier(nil)
+	if _, err := r.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	row, err := q.GetReviewerTurnaround(ctx, models.GetReviewerTurnaroundParams{
+		UserID: userID,
+		Since:  pgtype.Timestamptz{Time: since, Valid: true},
+		Until:  pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	t := &domain.ReviewerTurnaround{
+		UserID:        userID,
+		Since:         since,
+		Until:         until,
+		ReviewedCount: int(row.ReviewedCount),
+		PendingCount:  int(row.PendingCount),
+	}
+	if row.ReviewedCount > 0 {
+		avg := time.Duration(row.AvgSeconds * float64(time.Second))
+		median := time.Duration(row.MedianSeconds * float64(time.Second))
+		t.AvgTurnaround = &avg
+		t.MedianTurnaround = &median
+	}
+	return t, nil
+}
+
+func (r *Repository) GetAuthorStats(ctx context.Context, userID string) (*domain.AuthorStats, error) {
+	q := r.querier(nil)
+	if _, err := r.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	row, err := q.GetAuthorPRStats(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	stats := &domain.AuthorStats{
+		UserID:       userID,
+		OpenedPRs:    int(row.OpenedCount),
+		OpenPRs:      int(row.OpenCount),
+		MergedPRs:    int(row.MergedCount),
+		AvgReviewers: row.AvgReviewers,
+	}
+	if row.MergedCount > 0 {
+		avg := time.Duration(row.AvgMergeSeconds * float64(time.Second))
+		median := time.Duration(row.MedianMergeSeconds * float64(time.Second))
+		stats.AvgTimeToMerge = &avg
+		stats.MedianTimeToMerge = &median
+	}
+	return stats, nil
+}
+
+func (r *Repository) GetOrgSummary(ctx context.Context, since, until time.Time) (*domain.OrgSummary, error) {
+	row, err := r.querier(nil).GetOrgSummary(ctx, models.GetOrgSummaryParams{
+		Since: pgtype.Timestamptz{Time: since, Valid: true},
+		Until: pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	summary := &domain.OrgSummary{
+		Since:         since,
+		Until:         until,
+		OpenPRs:       int(row.OpenCount),
+		UnassignedPRs: int(row.UnassignedCount),
+		ActiveUsers:   int(row.ActiveUsers),
+		MergedPRs:     int(row.MergedCount),
+	}
+	if row.MergedCount > 0 {
+		avg := time.Duration(row.AvgMergeSeconds * float64(time.Second))
+		summary.AvgTurnaround = &avg
+	}
+	return summary, nil
+}
+
+// --- DumpRepository Implementation ---
+
+func (r *Repository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	q := r.querier(nil)
+	dbUsers, err := q.ListUsersWithTeam(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	users := make([]domain.User, len(dbUsers))
+	for i, u := range dbUsers {
+		users[i] = domain.User{ID: u.UserID, Username: u.Username, TeamID: u.TeamID, TeamName: u.TeamName, IsActive: u.IsActive, Role: u.Role, Skills: u.Skills, InTraining: u.InTraining}
+	}
+	return users, nil
+}
+
+func (r *Repository) ListPRsWithReviewers(ctx context.Context) ([]domain.PullRequest, error) {
+	q := r.querier(nil)
+	dbPRs, err := q.ListPRs(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	dbAssignments, err := q.ListReviewAssignments(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+
+	reviewersByPR := make(map[string][]domain.Reviewer)
+	approvedByPR := make(map[string][]string)
+	for _, a := range dbAssignments {
+		reviewersByPR[a.PrID] = append(reviewersByPR[a.PrID], domain.Reviewer{ID: a.UserID, Optional: a.Optional})
+		if a.ApprovedAt.Valid {
+			approvedByPR[a.PrID] = append(approvedByPR[a.PrID], a.UserID)
+		}
+	}
+
+	prs := make([]domain.PullRequest, len(dbPRs))
+	for i, p := range dbPRs {
+		prs[i] = domain.PullRequest{
+			ID:             p.PrID,
+			Name:           p.PrName,
+			Description:    p.Description,
+			AuthorID:       p.AuthorID,
+			Status:         domain.PRStatus(p.Status),
+			Reviewers:      reviewersByPR[p.PrID],
+			RequiredSkills: p.RequiredSkills,
+			AutoMerge:      p.AutoMerge,
+			Priority:       domain.PRPriority(p.Priority),
+			ApprovedBy:     approvedByPR[p.PrID],
+			CreatedAt:      p.CreatedAt.Time,
+		}
+		if p.MergedAt.Valid {
+			prs[i].MergedAt = &p.MergedAt.Time
+		}
+	}
+	return prs, nil
+}
+
+func (r *Repository) ImportTeam(ctx context.Context, tx domain.Tx, team *domain.Team) (*domain.Team, error) {
+	q := r.querier(tx)
+	dbTeam, err := q.ImportTeam(ctx, models.ImportTeamParams{
+		TeamName:                team.TeamName,
+		IsActive:                team.IsActive,
+		AllowDuplicateUsernames: team.AllowDuplicateUsernames,
+		AllowSelfReview:         team.AllowSelfReview,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, fmt.Errorf("%w: team '%s'", domain.ErrTeamExists, team.TeamName)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return teamFromDB(dbTeam), nil
+}
+
+func (r *Repository) ImportUser(ctx context.Context, tx domain.Tx, user *domain.User) error {
+	q := r.querier(tx)
+	_, err := q.CreateUser(ctx, models.CreateUserParams{
+		UserID:   user.ID,
+		Username: user.Username,
+		TeamID:   user.TeamID,
+		IsActive: user.IsActive,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			if pgErr.ConstraintName == usernameUniqueConstraint {
+				return fmt.Errorf("%w: '%s'", domain.ErrUsernameExists, domain.PII(user.Username))
+			}
+			return fmt.Errorf("%w: user '%s' already exists", domain.ErrValidation, user.ID)
+		}
+		return domain.ErrInternalError
+	}
+	if user.Role != "" {
+		if _, err := q.SetUserRole(ctx, models.SetUserRoleParams{UserID: user.ID, Role: user.Role}); err != nil {
+			return domain.ErrInternalError
+		}
+	}
+	if len(user.Skills) > 0 {
+		if _, err := q.SetUserSkills(ctx, models.SetUserSkillsParams{UserID: user.ID, Skills: user.Skills}); err != nil {
+			return domain.ErrInternalError
+		}
+	}
+	return nil
+}
+
+func (r *Repository) ImportPR(ctx context.Context, tx domain.Tx, pr *domain.PullRequest) error {
+	q := r.querier(tx)
+	params := models.ImportPRParams{
+		PrID:           pr.ID,
+		PrName:         pr.Name,
+		AuthorID:       pr.AuthorID,
+		Status:         models.PrStatus(pr.Status),
+		CreatedAt:      pgtype.Timestamptz{Time: pr.CreatedAt, Valid: true},
+		RequiredSkills: nonNilStrings(pr.RequiredSkills),
+		Description:    pr.Description,
+		AutoMerge:      pr.AutoMerge,
+		Priority:       priorityToDB(pr.Priority),
+	}
+	if pr.MergedAt != nil {
+		params.MergedAt = pgtype.Timestamptz{Time: *pr.MergedAt, Valid: true}
+	}
+	if pr.ClosedAt != nil {
+		params.ClosedAt = pgtype.Timestamptz{Time: *pr.ClosedAt, Valid: true}
+	}
+	if _, err := q.ImportPR(ctx, params); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return fmt.Errorf("%w: PR '%s'", domain.ErrPRExists, pr.ID)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// priorityToDB defaults an unset priority to NORMAL.
+func prSizeFromDB(pr models.PullRequest) domain.PRSize {
+	return domain.PRSize{LinesChanged: intFromInt4(pr.LinesChanged), FilesChanged: intFromInt4(pr.FilesChanged)}
+}
+
+func priorityToDB(p domain.PRPriority) models.PrPriority {
+	if p == "" {
+		return models.PrPriorityNORMAL
+	}
+	return models.PrPriority(p)
+}
+
+// nonNilStrings keeps NOT NULL array columns from receiving NULL for a nil slice.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// --- ArchiveRepository Implementation ---
+
+func (r *Repository) ListMergedPRIDsBefore(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	q := r.querier(nil)
+	ids, err := q.ListMergedPRIDsBefore(ctx, models.ListMergedPRIDsBeforeParams{
+		MergedAt: pgtype.Timestamptz{Time: before, Valid: true},
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return ids, nil
+}
+
+// ArchivePRs copies the PRs and their assignments into the archive tables and
+// removes them from the live ones. Assignments are copied first because
+// deleting a PR cascades to review_assignments.
+func (r *Repository) ArchivePRs(ctx context.Context, tx domain.Tx, prIDs []string) (int, error) {
+	q := r.querier(tx)
+	if _, err := q.CopyPRsToArchive(ctx, prIDs); err != nil {
+		return 0, domain.ErrInternalError
+	}
+	if err := q.CopyReviewAssignmentsToArchive(ctx, prIDs); err != nil {
+		return 0, domain.ErrInternalError
+	}
+	deleted, err := q.DeletePRs(ctx, prIDs)
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(deleted), nil
+}
+
+// --- GitHubRepository Implementation ---
+
+func (r *Repository) SetGitHubLogin(ctx context.Context, tx domain.Tx, userID, login string) (*domain.GitHubAccount, error) {
+	q := r.querier(tx)
+	account, err := q.UpsertGitHubAccount(ctx, models.UpsertGitHubAccountParams{UserID: userID, Login: login})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return nil, fmt.Errorf("%w: GitHub login '%s' is already linked to another user", domain.ErrValidation, domain.PII(login))
+			case pgerrcode.ForeignKeyViolation:
+				return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			}
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.GitHubAccount{UserID: account.UserID, Login: account.Login}, nil
+}
+
+func (r *Repository) GetGitHubAccountsByUserIDs(ctx context.Context, userIDs []string) ([]domain.GitHubAccount, error) {
+	q := r.querier(nil)
+	accounts, err := q.ListGitHubAccountsByUserIDs(ctx, nonNilStrings(userIDs))
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return githubAccountsFromDB(accounts), nil
+}
+
+// GetGitHubAccountsByLogins matches logins case-insensitively, as GitHub does.
+func (r *Repository) GetGitHubAccountsByLogins(ctx context.Context, logins []string) ([]domain.GitHubAccount, error) {
+	lowered := make([]string, len(logins))
+	for i, l := range logins {
+		lowered[i] = strings.ToLower(l)
+	}
+
+	q := r.querier(nil)
+	accounts, err := q.ListGitHubAccountsByLogins(ctx, lowered)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return githubAccountsFromDB(accounts), nil
+}
+
+func (r *Repository) LinkGitHubPR(ctx context.Context, tx domain.Tx, link *domain.GitHubPRLink) (*domain.GitHubPRLink, error) {
+	q := r.querier(tx)
+	dbLink, err := q.UpsertGitHubPRLink(ctx, models.UpsertGitHubPRLinkParams{
+		PrID:       link.PRID,
+		Repository: link.Repository,
+		Number:     int32(link.Number),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return nil, fmt.Errorf("%w: %s#%d is already linked to another PR", domain.ErrValidation, link.Repository, link.Number)
+			case pgerrcode.ForeignKeyViolation:
+				return nil, fmt.Errorf("%w: PR '%s'", domain.ErrNotFound, link.PRID)
+			}
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.GitHubPRLink{PRID: dbLink.PrID, Repository: dbLink.Repository, Number: int(dbLink.Number)}, nil
+}
+
+func (r *Repository) GetGitHubPRLink(ctx context.Context, prID string) (*domain.GitHubPRLink, error) {
+	q := r.querier(nil)
+	dbLink, err := q.GetGitHubPRLink(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: GitHub link for PR '%s'", domain.ErrNotFound, prID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.GitHubPRLink{PRID: dbLink.PrID, Repository: dbLink.Repository, Number: int(dbLink.Number)}, nil
+}
+
+func githubAccountsFromDB(accounts []models.GithubAccount) []domain.GitHubAccount {
+	res := make([]domain.GitHubAccount, len(accounts))
+	for i, a := range accounts {
+		res[i] = domain.GitHubAccount{UserID: a.UserID, Login: a.Login}
+	}
+	return res
+}
+
+func (r *Repository) GetGitHubPRLinkByNumber(ctx context.Context, repository string, number int) (*domain.GitHubPRLink, error) {
+	q := r.querier(nil)
+	dbLink, err := q.GetGitHubPRLinkByNumber(ctx, models.GetGitHubPRLinkByNumberParams{Repository: repository, Number: int32(number)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: GitHub link for %s#%d", domain.ErrNotFound, repository, number)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.GitHubPRLink{PRID: dbLink.PrID, Repository: dbLink.Repository, Number: int(dbLink.Number)}, nil
+}
+
+func (r *Repository) UpsertGitHubInstallation(ctx context.Context, tx domain.Tx, installation *domain.GitHubInstallation) error {
+	q := r.querier(tx)
+	if err := q.UpsertGitHubInstallation(ctx, models.UpsertGitHubInstallationParams{
+		InstallationID: installation.ID,
+		AccountLogin:   installation.AccountLogin,
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) DeleteGitHubInstallation(ctx context.Context, tx domain.Tx, installationID int64) error {
+	q := r.querier(tx)
+	if err := q.DeleteGitHubInstallation(ctx, installationID); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) GetGitHubInstallationByAccount(ctx context.Context, login string) (*domain.GitHubInstallation, error) {
+	q := r.querier(nil)
+	dbInst, err := q.GetGitHubInstallationByAccount(ctx, login)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: GitHub App installation for '%s'", domain.ErrNotFound, login)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.GitHubInstallation{
+		ID:             dbInst.InstallationID,
+		AccountLogin:   dbInst.AccountLogin,
+		Token:          dbInst.AccessToken.String,
+		TokenExpiresAt: dbInst.TokenExpiresAt.Time,
+	}, nil
+}
+
+func (r *Repository) SaveGitHubInstallationToken(ctx context.Context, installationID int64, token string, expiresAt time.Time) error {
+	q := r.querier(nil)
+	if err := q.SaveGitHubInstallationToken(ctx, models.SaveGitHubInstallationTokenParams{
+		InstallationID: installationID,
+		AccessToken:    pgtype.Text{String: token, Valid: true},
+		TokenExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) AddGitHubRepos(ctx context.Context, tx domain.Tx, installationID int64, repositories []string) error {
+	q := r.querier(tx)
+	if err := q.UpsertGitHubRepositories(ctx, models.UpsertGitHubRepositoriesParams{
+		Repositories:   nonNilStrings(repositories),
+		InstallationID: installationID,
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) RemoveGitHubRepos(ctx context.Context, tx domain.Tx, repositories []string) error {
+	q := r.querier(tx)
+	if err := q.DeleteGitHubRepositories(ctx, nonNilStrings(repositories)); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) SetGitHubRepoTeam(ctx context.Context, tx domain.Tx, repository string, teamID int32) error {
+	q := r.querier(tx)
+	if _, err := q.SetGitHubRepositoryTeam(ctx, models.SetGitHubRepositoryTeamParams{
+		Repository: repository,
+		TeamID:     pgtype.Int4{Int32: teamID, Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: repository '%s' has no GitHub App installation", domain.ErrNotFound, repository)
+		}
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) GetGitHubRepo(ctx context.Context, repository string) (*domain.GitHubRepo, error) {
+	q := r.querier(nil)
+	row, err := q.GetGitHubRepository(ctx, repository)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: repository '%s'", domain.ErrNotFound, repository)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return githubRepoFromDB(row.Repository, row.InstallationID, row.TeamID, row.TeamName), nil
+}
+
+func (r *Repository) ListGitHubRepos(ctx context.Context) ([]domain.GitHubRepo, error) {
+	q := r.querier(nil)
+	rows, err := q.ListGitHubRepositories(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	repos := make([]domain.GitHubRepo, len(rows))
+	for i, row := range rows {
+		repos[i] = *githubRepoFromDB(row.Repository, row.InstallationID, row.TeamID, row.TeamName)
+	}
+	return repos, nil
+}
+
+func githubRepoFromDB(repository string, installationID int64, teamID pgtype.Int4, teamName string) *domain.GitHubRepo {
+	repo := &domain.GitHubRepo{Repository: repository, InstallationID: installationID, TeamName: teamName}
+	if teamID.Valid {
+		repo.TeamID = &teamID.Int32
+	}
+	return repo
+}
+
+func (r *Repository) SetGitHubIngestionToken(ctx context.Context, tx domain.Tx, token *domain.GitHubIngestionToken) (*domain.GitHubIngestionToken, error) {
+	q := r.querier(tx)
+	row, err := q.UpsertGitHubIngestionToken(ctx, models.UpsertGitHubIngestionTokenParams{
+		Repository: token.Repository,
+		TeamID:     token.TeamID,
+		Secret:     token.Secret,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: repository '%s' already has an ingestion token of another team", domain.ErrRepoExists, token.Repository)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return ingestionTokenFromDB(row.Repository, row.TeamID, token.TeamName, row.Secret, row.CreatedAt, row.LastUsedAt), nil
+}
+
+func (r *Repository) GetGitHubIngestionToken(ctx context.Context, repository string) (*domain.GitHubIngestionToken, error) {
+	row, err := r.querier(nil).GetGitHubIngestionToken(ctx, repository)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: ingestion token of repository '%s'", domain.ErrNotFound, repository)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return ingestionTokenFromDB(row.Repository, row.TeamID, row.TeamName, row.Secret, row.CreatedAt, row.LastUsedAt), nil
+}
+
+func (r *Repository) ListGitHubIngestionTokens(ctx context.Context) ([]domain.GitHubIngestionToken, error) {
+	rows, err := r.querier(nil).ListGitHubIngestionTokens(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	tokens := make([]domain.GitHubIngestionToken, len(rows))
+	for i, row := range rows {
+		tokens[i] = *ingestionTokenFromDB(row.Repository, row.TeamID, row.TeamName, "", row.CreatedAt, row.LastUsedAt)
+	}
+	return tokens, nil
+}
+
+func (r *Repository) DeleteGitHubIngestionToken(ctx context.Context, tx domain.Tx, repository string) error {
+	n, err := r.querier(tx).DeleteGitHubIngestionToken(ctx, repository)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: ingestion token of repository '%s'", domain.ErrNotFound, repository)
+	}
+	return nil
+}
+
+func (r *Repository) TouchGitHubIngestionToken(ctx context.Context, repository string) error {
+	if err := r.querier(nil).TouchGitHubIngestionToken(ctx, repository); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func ingestionTokenFromDB(repository string, teamID int32, teamName, secret string, createdAt, lastUsedAt pgtype.Timestamptz) *domain.GitHubIngestionToken {
+	token := &domain.GitHubIngestionToken{
+		Repository: repository,
+		TeamID:     teamID,
+		TeamName:   teamName,
+		Secret:     secret,
+		CreatedAt:  createdAt.Time,
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	return token
+}
+
+func (r *Repository) ListGitHubTeamLinks(ctx context.Context, org string) ([]domain.GitHubTeamLink, error) {
+	rows, err := r.querier(nil).ListGitHubTeamLinks(ctx, org)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	links := make([]domain.GitHubTeamLink, len(rows))
+	for i, row := range rows {
+		links[i] = domain.GitHubTeamLink{GitHubTeamID: row.GithubTeamID, Org: row.Org, Slug: row.Slug, TeamID: row.TeamID}
+	}
+	return links, nil
+}
+
+func (r *Repository) LinkGitHubTeam(ctx context.Context, tx domain.Tx, link *domain.GitHubTeamLink) error {
+	err := r.querier(tx).UpsertGitHubTeamLink(ctx, models.UpsertGitHubTeamLinkParams{
+		GithubTeamID: link.GitHubTeamID,
+		Org:          link.Org,
+		Slug:         link.Slug,
+		TeamID:       link.TeamID,
+	})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+// githubTeamSyncRunsKept is how many GitHub teams sync reports are kept.
+const githubTeamSyncRunsKept = 100
+
+func (r *Repository) SaveGitHubTeamSyncReport(ctx context.Context, tx domain.Tx, report *domain.GitHubTeamSyncReport) error {
+	q := r.querier(tx)
+	id, err := q.CreateGitHubTeamSyncRun(ctx, models.CreateGitHubTeamSyncRunParams{
+		Org:              report.Org,
+		StartedAt:        pgtype.Timestamptz{Time: report.StartedAt, Valid: true},
+		FinishedAt:       pgtype.Timestamptz{Time: report.FinishedAt, Valid: true},
+		TeamsCreated:     int32(report.TeamsCreated),
+		UsersCreated:     int32(report.UsersCreated),
+		UsersMoved:       int32(report.UsersMoved),
+		UsersDeactivated: int32(report.UsersDeactivated),
+		Error:            report.Error,
+	})
+	if err != nil {
+		return domain.ErrInternalError
+	}
+
+	if len(report.Conflicts) > 0 {
+		params := models.CreateGitHubTeamSyncConflictsParams{RunID: id}
+		for _, c := range report.Conflicts {
+			params.Reasons = append(params.Reasons, string(c.Reason))
+			params.Logins = append(params.Logins, c.Login)
+			params.UserIds = append(params.UserIds, c.UserID)
+			params.TeamNames = append(params.TeamNames, c.TeamName)
+			params.Details = append(params.Details, c.Detail)
+		}
+		if err := q.CreateGitHubTeamSyncConflicts(ctx, params); err != nil {
+			return domain.ErrInternalError
+		}
+	}
+	if err := q.PruneGitHubTeamSyncRuns(ctx, id-githubTeamSyncRunsKept); err != nil {
+		return domain.ErrInternalError
+	}
+	report.ID = id
+	return nil
+}
+
+func (r *Repository) GetLatestGitHubTeamSyncReport(ctx context.Context) (*domain.GitHubTeamSyncReport, error) {
+	q := r.querier(nil)
+	run, err := q.GetLatestGitHubTeamSyncRun(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: no GitHub teams sync has run yet", domain.ErrNotFound)
+		}
+		return nil, domain.ErrInternalError
+	}
+	conflicts, err := q.ListGitHubTeamSyncConflicts(ctx, run.RunID)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+
+	report := &domain.GitHubTeamSyncReport{
+		ID:               run.RunID,
+		Org:              run.Org,
+		StartedAt:        run.StartedAt.Time,
+		FinishedAt:       run.FinishedAt.Time,
+		TeamsCreated:     int(run.TeamsCreated),
+		UsersCreated:     int(run.UsersCreated),
+		UsersMoved:       int(run.UsersMoved),
+		UsersDeactivated: int(run.UsersDeactivated),
+		Conflicts:        make([]domain.GitHubTeamSyncConflict, len(conflicts)),
+		Error:            run.Error,
+	}
+	for i, c := range conflicts {
+		report.Conflicts[i] = domain.GitHubTeamSyncConflict{
+			Reason:   domain.GitHubTeamSyncConflictReason(c.Reason),
+			Login:    c.Login,
+			UserID:   c.UserID,
+			TeamName: c.TeamName,
+			Detail:   c.Detail,
+		}
+	}
+	return report, nil
+}
+
+// --- SlackRepository Implementation ---
+
+func (r *Repository) SetSlackUserID(ctx context.Context, tx domain.Tx, userID, slackUserID string) (*domain.SlackAccount, error) {
+	q := r.querier(tx)
+	account, err := q.UpsertSlackAccount(ctx, models.UpsertSlackAccountParams{UserID: userID, SlackUserID: slackUserID})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return nil, fmt.Errorf("%w: Slack user '%s' is already linked to another user", domain.ErrValidation, slackUserID)
+			case pgerrcode.ForeignKeyViolation:
+				return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, userID)
+			}
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.SlackAccount{UserID: account.UserID, SlackUserID: account.SlackUserID}, nil
+}
+
+func (r *Repository) GetSlackAccountBySlackID(ctx context.Context, slackUserID string) (*domain.SlackAccount, error) {
+	q := r.querier(nil)
+	account, err := q.GetSlackAccountBySlackID(ctx, slackUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: Slack user '%s'", domain.ErrNotFound, slackUserID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return &domain.SlackAccount{UserID: account.UserID, SlackUserID: account.SlackUserID}, nil
+}
+
+// --- NotificationRepository Implementation ---
+
+func (r *Repository) GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	q := r.querier(nil)
+	dbPrefs, err := q.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: notification preferences of user '%s'", domain.ErrNotFound, userID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return notificationPreferencesFromDB(dbPrefs), nil
+}
+
+func (r *Repository) SetNotificationPreferences(ctx context.Context, tx domain.Tx, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	muted := make([]string, len(prefs.MutedEvents))
+	for i, e := range prefs.MutedEvents {
+		muted[i] = string(e)
+	}
+
+	q := r.querier(tx)
+	dbPrefs, err := q.UpsertNotificationPreferences(ctx, models.UpsertNotificationPreferencesParams{
+		UserID:          prefs.UserID,
+		Channels:        nonNilStrings(prefs.Channels),
+		MutedEvents:     muted,
+		QuietHoursStart: pgtype.Text{String: prefs.QuietHoursStart, Valid: prefs.QuietHoursStart != ""},
+		QuietHoursEnd:   pgtype.Text{String: prefs.QuietHoursEnd, Valid: prefs.QuietHoursEnd != ""},
+		Timezone:        prefs.Timezone,
+		WebhookUrl:      prefs.WebhookURL,
+		Digest:          string(prefs.Digest),
+		Email:           prefs.Email,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: user '%s'", domain.ErrNotFound, prefs.UserID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return notificationPreferencesFromDB(dbPrefs), nil
+}
+
+func (r *Repository) EnqueueNotification(ctx context.Context, tx domain.Tx, n *domain.Notification, deliverAfter time.Time) error {
+	q := r.querier(tx)
+	if err := q.EnqueueNotification(ctx, models.EnqueueNotificationParams{
+		UserID:       n.UserID,
+		Event:        string(n.Event),
+		PrID:         pgtype.Text{String: n.PRID, Valid: n.PRID != ""},
+		Message:      n.Message,
+		DeliverAfter: pgtype.Timestamptz{Time: deliverAfter, Valid: true},
+		Html:         n.HTML,
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) ClaimDueNotifications(ctx context.Context, tx domain.Tx, limit int) ([]domain.Notification, error) {
+	q := r.querier(tx)
+	rows, err := q.ClaimDueNotifications(ctx, int32(limit))
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	notifications := make([]domain.Notification, len(rows))
+	for i, row := range rows {
+		notifications[i] = notificationFromDB(row)
+	}
+	return notifications, nil
+}
+
+func notificationFromDB(row models.NotificationOutbox) domain.Notification {
+	return domain.Notification{
+		ID:       row.ID,
+		UserID:   row.UserID,
+		Event:    domain.NotificationEvent(row.Event),
+		PRID:     row.PrID.String,
+		Message:  row.Message,
+		HTML:     row.Html,
+		Attempts: int(row.Attempts),
+	}
+}
+
+func (r *Repository) MarkNotificationSent(ctx context.Context, tx domain.Tx, id int64) error {
+	q := r.querier(tx)
+	if err := q.MarkNotificationSent(ctx, id); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) MarkNotificationFailed(ctx context.Context, tx domain.Tx, id int64, reason string, retryAt time.Time) error {
+	q := r.querier(tx)
+	if err := q.MarkNotificationFailed(ctx, models.MarkNotificationFailedParams{
+		ID:           id,
+		LastError:    reason,
+		DeliverAfter: pgtype.Timestamptz{Time: retryAt, Valid: true},
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) MarkNotificationDeadLettered(ctx context.Context, tx domain.Tx, id int64, reason string) error {
+	q := r.querier(tx)
+	if err := q.MarkNotificationDeadLettered(ctx, models.MarkNotificationDeadLetteredParams{
+		ID:        id,
+		LastError: reason,
+	}); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) ListDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	q := r.querier(nil)
+	rows, err := q.ListDeadLetteredNotifications(ctx, models.ListDeadLetteredNotificationsParams{
+		UserID:       filter.UserID,
+		Event:        string(filter.Event),
+		ResultLimit:  int32(filter.Limit),
+		ResultOffset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	letters := make([]domain.DeadLetter, len(rows))
+	for i, row := range rows {
+		letters[i] = domain.DeadLetter{
+			Notification:   notificationFromDB(row),
+			LastError:      row.LastError,
+			CreatedAt:      row.CreatedAt.Time,
+			DeadLetteredAt: row.DeadLetteredAt.Time,
+		}
+	}
+	return letters, nil
+}
+
+func (r *Repository) ClaimDueDigests(ctx context.Context, tx domain.Tx, limit int) ([]domain.NotificationPreferences, error) {
+	q := r.querier(tx)
+	rows, err := q.ClaimDueDigests(ctx, int32(limit))
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	prefs := make([]domain.NotificationPreferences, len(rows))
+	for i, row := range rows {
+		prefs[i] = *notificationPreferencesFromDB(row)
+	}
+	return prefs, nil
+}
+
+func (r *Repository) MarkDigestSent(ctx context.Context, tx domain.Tx, userID string) error {
+	q := r.querier(tx)
+	if err := q.MarkDigestSent(ctx, userID); err != nil {
+		return domain.ErrInternalError
+	}
+	return nil
+}
+
+func (r *Repository) ReplayNotifications(ctx context.Context, tx domain.Tx, filter domain.NotificationFilter) (int, error) {
+	q := r.querier(tx)
+	n, err := q.ReplayNotifications(ctx, models.ReplayNotificationsParams{
+		Since:  pgtype.Timestamptz{Time: filter.Since, Valid: true},
+		Until:  pgtype.Timestamptz{Time: filter.Until, Valid: true},
+		Event:  string(filter.Event),
+		UserID: filter.UserID,
+	})
+	if err != nil {
+		return 0, domain.ErrInternalError
+	}
+	return int(n), nil
+}
+
+func notificationPreferencesFromDB(p models.NotificationPreference) *domain.NotificationPreferences {
+	muted := make([]domain.NotificationEvent, len(p.MutedEvents))
+	for i, e := range p.MutedEvents {
+		muted[i] = domain.NotificationEvent(e)
+	}
+	return &domain.NotificationPreferences{
+		UserID:          p.UserID,
+		Channels:        p.Channels,
+		MutedEvents:     muted,
+		QuietHoursStart: p.QuietHoursStart.String,
+		QuietHoursEnd:   p.QuietHoursEnd.String,
+		Timezone:        p.Timezone,
+		WebhookURL:      p.WebhookUrl,
+		Email:           p.Email,
+		Digest:          domain.DigestFrequency(p.Digest),
+	}
+}
+
+// --- WebhookDeliveryRepository Implementation ---
+
+func (r *Repository) CreateWebhookDelivery(ctx context.Context, d *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	q := r.querier(nil)
+	params := models.CreateWebhookDeliveryParams{
+		NotificationID: pgtype.Int8{Int64: d.NotificationID, Valid: d.NotificationID != 0},
+		UserID:         pgtype.Text{String: d.UserID, Valid: d.UserID != ""},
+		Url:            d.URL,
+		RequestBody:    d.RequestBody,
+		StatusCode:     pgtype.Int4{Int32: int32(d.StatusCode), Valid: d.StatusCode != 0},
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		DurationMs:     int32(d.Duration.Milliseconds()),
+	}
+	if d.RedeliveryOf != nil {
+		params.RedeliveryOf = pgtype.Int8{Int64: *d.RedeliveryOf, Valid: true}
+	}
+	row, err := q.CreateWebhookDelivery(ctx, params)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return webhookDeliveryFromDB(row), nil
+}
+
+func (r *Repository) GetWebhookDelivery(ctx context.Context, id int64) (*domain.WebhookDelivery, error) {
+	q := r.querier(nil)
+	row, err := q.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: webhook delivery %d", domain.ErrNotFound, id)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return webhookDeliveryFromDB(row), nil
+}
+
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, error) {
+	q := r.querier(nil)
+	rows, err := q.ListWebhookDeliveries(ctx, models.ListWebhookDeliveriesParams{
+		UserID:       filter.UserID,
+		Status:       filter.Status,
+		ResultLimit:  int32(filter.Limit),
+		ResultOffset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	deliveries := make([]domain.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = *webhookDeliveryFromDB(row)
+	}
+	return deliveries, nil
+}
+
+func (r *Repository) HasWebhookTargets(ctx context.Context) (bool, error) {
+	exists, err := r.querier(nil).HasWebhookTargets(ctx)
+	if err != nil {
+		return false, domain.ErrInternalError
+	}
+	return exists, nil
+}
+
+func webhookDeliveryFromDB(row models.WebhookDelivery) *domain.WebhookDelivery {
+	d := &domain.WebhookDelivery{
+		ID:             row.ID,
+		NotificationID: row.NotificationID.Int64,
+		UserID:         row.UserID.String,
+		URL:            row.Url,
+		RequestBody:    row.RequestBody,
+		StatusCode:     int(row.StatusCode.Int32),
+		ResponseBody:   row.ResponseBody,
+		Error:          row.Error,
+		Duration:       time.Duration(row.DurationMs) * time.Millisecond,
+		AttemptedAt:    row.AttemptedAt.Time,
+	}
+	if row.RedeliveryOf.Valid {
+		d.RedeliveryOf = &row.RedeliveryOf.Int64
+	}
+	return d
+}
+
+// --- InboundWebhookRepository Implementation ---
+
+func (r *Repository) CreateInboundWebhook(ctx context.Context, w *domain.InboundWebhook) (*domain.InboundWebhook, error) {
+	row, err := r.querier(nil).CreateInboundWebhook(ctx, models.CreateInboundWebhookParams{
+		Source:         w.Source,
+		Event:          w.Event,
+		DeliveryID:     w.DeliveryID,
+		IngestionToken: w.IngestionToken,
+		Payload:        w.Payload,
+		Status:         string(w.Status),
+		Error:          w.Error,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return inboundWebhookFromDB(row), nil
+}
+
+func (r *Repository) GetInboundWebhook(ctx context.Context, id int64) (*domain.InboundWebhook, error) {
+	row, err := r.querier(nil).GetInboundWebhook(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: inbound webhook %d", domain.ErrNotFound, id)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return inboundWebhookFromDB(row), nil
+}
+
+func (r *Repository) ListInboundWebhooks(ctx context.Context, filter domain.InboundWebhookFilter) ([]domain.InboundWebhook, error) {
+	rows, err := r.querier(nil).ListInboundWebhooks(ctx, models.ListInboundWebhooksParams{
+		Status:       string(filter.Status),
+		Event:        filter.Event,
+		ResultLimit:  int32(filter.Limit),
+		ResultOffset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	webhooks := make([]domain.InboundWebhook, len(rows))
+	for i, row := range rows {
+		webhooks[i] = *inboundWebhookFromDB(row)
+	}
+	return webhooks, nil
+}
+
+func (r *Repository) UpdateInboundWebhookResult(ctx context.Context, id int64, status domain.InboundWebhookStatus, errMsg string) (*domain.InboundWebhook, error) {
+	row, err := r.querier(nil).UpdateInboundWebhookResult(ctx, models.UpdateInboundWebhookResultParams{ID: id, Status: string(status), Error: errMsg})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: inbound webhook %d", domain.ErrNotFound, id)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return inboundWebhookFromDB(row), nil
+}
+
+func inboundWebhookFromDB(row models.InboundWebhook) *domain.InboundWebhook {
+	return &domain.InboundWebhook{
+		ID:             row.ID,
+		Source:         row.Source,
+		Event:          row.Event,
+		DeliveryID:     row.DeliveryID,
+		IngestionToken: row.IngestionToken,
+		Payload:        row.Payload,
+		Status:         domain.InboundWebhookStatus(row.Status),
+		Error:          row.Error,
+		Attempts:       int(row.Attempts),
+		ReceivedAt:     row.ReceivedAt.Time,
+		ProcessedAt:    row.ProcessedAt.Time,
+	}
+}
+
+// --- SettingsRepository Implementation ---
+
+func (r *Repository) GetOrgSettings(ctx context.Context) (*domain.Settings, error) {
+	row, err := r.querier(nil).GetOrgSettings(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: org settings", domain.ErrNotFound)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return orgSettingsFromDB(row), nil
+}
+
+func (r *Repository) SaveOrgSettings(ctx context.Context, tx domain.Tx, s *domain.Settings) (*domain.Settings, error) {
+	row, err := r.querier(tx).UpsertOrgSettings(ctx, models.UpsertOrgSettingsParams{
+		MaxReviewers:      int32(s.MaxReviewers),
+		SelectionStrategy: string(s.SelectionStrategy),
+		ReviewSlaHours:    int32(s.ReviewSLAHours),
+		MaxOpenReviews:    int32(s.MaxOpenReviews),
+		AutoMerge:         s.AutoMerge,
+		ShadowReviews:     s.ShadowReviews,
+	})
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	return orgSettingsFromDB(row), nil
+}
+
+func (r *Repository) GetTeamSettings(ctx context.Context, teamID int32) (*domain.TeamSettings, error) {
+	row, err := r.querier(nil).GetTeamSettings(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: settings of team with id '%d'", domain.ErrNotFound, teamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return teamSettingsFromDB(models.ListTeamSettingsRow{
+		TeamID:            row.TeamID,
+		MaxReviewers:      row.MaxReviewers,
+		SelectionStrategy: row.SelectionStrategy,
+		ReviewSlaHours:    row.ReviewSlaHours,
+		MaxOpenReviews:    row.MaxOpenReviews,
+		AutoMerge:         row.AutoMerge,
+		ShadowReviews:     row.ShadowReviews,
+		UpdatedAt:         row.UpdatedAt,
+	}), nil
+}
+
+func (r *Repository) ListTeamSettings(ctx context.Context) ([]domain.TeamSettings, error) {
+	rows, err := r.querier(nil).ListTeamSettings(ctx)
+	if err != nil {
+		return nil, domain.ErrInternalError
+	}
+	settings := make([]domain.TeamSettings, len(rows))
+	for i, row := range rows {
+		settings[i] = *teamSettingsFromDB(row)
+	}
+	return settings, nil
+}
+
+func (r *Repository) SetTeamSettings(ctx context.Context, tx domain.Tx, s *domain.TeamSettings) (*domain.TeamSettings, error) {
+	params := models.UpsertTeamSettingsParams{
+		TeamID:         s.TeamID,
+		MaxReviewers:   int4FromInt(s.MaxReviewers),
+		ReviewSlaHours: int4FromInt(s.ReviewSLAHours),
+		MaxOpenReviews: int4FromInt(s.MaxOpenReviews),
+	}
+	if s.SelectionStrategy != nil {
+		params.SelectionStrategy = pgtype.Text{String: string(*s.SelectionStrategy), Valid: true}
+	}
+	if s.AutoMerge != nil {
+		params.AutoMerge = pgtype.Bool{Bool: *s.AutoMerge, Valid: true}
+	}
+	if s.ShadowReviews != nil {
+		params.ShadowReviews = pgtype.Bool{Bool: *s.ShadowReviews, Valid: true}
+	}
+	row, err := r.querier(tx).UpsertTeamSettings(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, fmt.Errorf("%w: team with id '%d'", domain.ErrNotFound, s.TeamID)
+		}
+		return nil, domain.ErrInternalError
+	}
+	return teamSettingsFromDB(models.ListTeamSettingsRow{
+		TeamID:            row.TeamID,
+		TeamName:          s.TeamName,
+		MaxReviewers:      row.MaxReviewers,
+		SelectionStrategy: row.SelectionStrategy,
+		ReviewSlaHours:    row.ReviewSlaHours,
+		MaxOpenReviews:    row.MaxOpenReviews,
+		AutoMerge:         row.AutoMerge,
+		ShadowReviews:     row.ShadowReviews,
+		UpdatedAt:         row.UpdatedAt,
+	}), nil
+}
+
+func (r *Repository) DeleteTeamSettings(ctx context.Context, tx domain.Tx, teamID int32) error {
+	rows, err := r.querier(tx).DeleteTeamSettings(ctx, teamID)
+	if err != nil {
+		return domain.ErrInternalError
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: settings of team with id '%d'", domain.ErrNotFound, teamID)
+	}
+	return nil
+}
+
+func orgSettingsFromDB(row models.OrgSetting) *domain.Settings {
+	return &domain.Settings{
+		MaxReviewers:      int(row.MaxReviewers),
+		SelectionStrategy: domain.SelectionStrategy(row.SelectionStrategy),
+		ReviewSLAHours:    int(row.ReviewSlaHours),
+		MaxOpenReviews:    int(row.MaxOpenReviews),
+		AutoMerge:         row.AutoMerge,
+		ShadowReviews:     row.ShadowReviews,
+		UpdatedAt:         row.UpdatedAt.Time,
+	}
+}
+
+func teamSettingsFromDB(row models.ListTeamSettingsRow) *domain.TeamSettings {
+	s := &domain.TeamSettings{
+		TeamID:         row.TeamID,
+		TeamName:       row.TeamName,
+		MaxReviewers:   intFromInt4(row.MaxReviewers),
+		ReviewSLAHours: intFromInt4(row.ReviewSlaHours),
+		MaxOpenReviews: intFromInt4(row.MaxOpenReviews),
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+	if row.SelectionStrategy.Valid {
+		strategy := domain.SelectionStrategy(row.SelectionStrategy.String)
+		s.SelectionStrategy = &strategy
+	}
+	if row.AutoMerge.Valid {
+		s.AutoMerge = &row.AutoMerge.Bool
+	}
+	if row.ShadowReviews.Valid {
+		s.ShadowReviews = &row.ShadowReviews.Bool
+	}
+	return s
 }
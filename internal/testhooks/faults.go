@@ -0,0 +1,172 @@
+// Package testhooks lets end-to-end tests make chosen database queries slow
+// or fail, so transaction rollback and retries can be checked
+// deterministically. The hooks are only wired in when the service runs with
+// APP_TEST_HOOKS.
+package testhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// FaultError is the failure a fault injects.
+type FaultError string
+
+const (
+	// FaultNone only delays the query.
+	FaultNone FaultError = ""
+	// FaultInternal fails the query like a lost connection.
+	FaultInternal FaultError = "internal"
+	// FaultSerialization and FaultDeadlock fail the query with a transaction
+	// conflict, after which the whole transaction is retried.
+	FaultSerialization FaultError = "serialization_failure"
+	FaultDeadlock      FaultError = "deadlock"
+)
+
+func (e FaultError) Valid() bool {
+	switch e {
+	case FaultNone, FaultInternal, FaultSerialization, FaultDeadlock:
+		return true
+	}
+	return false
+}
+
+// ErrInjected is the error of FaultInternal.
+var ErrInjected = errors.New("testhooks: injected failure")
+
+func (e FaultError) err() error {
+	switch e {
+	case FaultInternal:
+		return ErrInjected
+	case FaultSerialization:
+		return &pgconn.PgError{Code: pgerrcode.SerializationFailure, Message: "testhooks: injected serialization failure"}
+	case FaultDeadlock:
+		return &pgconn.PgError{Code: pgerrcode.DeadlockDetected, Message: "testhooks: injected deadlock"}
+	}
+	return nil
+}
+
+// Fault delays and/or fails calls of one query, named as in the sqlc query
+// files (e.g. CreateTeam).
+type Fault struct {
+	ID      int64
+	Query   string
+	Latency time.Duration
+	Error   FaultError
+	// Times is how many calls the fault applies to; 0 means every call.
+	Times int
+	// Hits counts the calls the fault was applied to.
+	Hits int
+}
+
+func (f *Fault) active() bool {
+	return f.Times == 0 || f.Hits < f.Times
+}
+
+// Faults is the set of faults in effect. A nil *Faults injects nothing.
+type Faults struct {
+	mu     sync.Mutex
+	faults []*Fault
+	nextID int64
+}
+
+func NewFaults() *Faults {
+	return &Faults{}
+}
+
+// Add puts a fault into effect. Faults on the same query apply in the order
+// they were added, each until it is used up.
+func (f *Faults) Add(fault Fault) (Fault, error) {
+	if fault.Query == "" {
+		return Fault{}, errors.New("query is required")
+	}
+	if !fault.Error.Valid() {
+		return Fault{}, fmt.Errorf("unknown error %q", fault.Error)
+	}
+	if fault.Latency < 0 || fault.Times < 0 {
+		return Fault{}, errors.New("latency and times cannot be negative")
+	}
+	if fault.Latency == 0 && fault.Error == FaultNone {
+		return Fault{}, errors.New("a fault needs a latency or an error")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	fault.ID = f.nextID
+	fault.Hits = 0
+	f.faults = append(f.faults, &fault)
+	return fault, nil
+}
+
+// List returns the faults added since the last Clear, used up ones included.
+func (f *Faults) List() []Fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	list := make([]Fault, len(f.faults))
+	for i, fault := range f.faults {
+		list[i] = *fault
+	}
+	return list
+}
+
+func (f *Faults) Clear() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = nil
+}
+
+// Apply is called before every query with its SQL. It waits out the latency
+// of the first active fault on the query and returns the error it injects.
+func (f *Faults) Apply(ctx context.Context, sql string) error {
+	if f == nil {
+		return nil
+	}
+	name := QueryName(sql)
+	if name == "" {
+		return nil
+	}
+
+	f.mu.Lock()
+	var fault Fault
+	for _, candidate := range f.faults {
+		if candidate.Query == name && candidate.active() {
+			candidate.Hits++
+			fault = *candidate
+			break
+		}
+	}
+	f.mu.Unlock()
+	if fault.ID == 0 {
+		return nil
+	}
+
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return fault.Error.err()
+}
+
+// QueryName returns the name sqlc puts in the leading "-- name: X :kind"
+// comment of its queries, "" for other SQL.
+func QueryName(sql string) string {
+	rest, ok := strings.CutPrefix(sql, "-- name: ")
+	if !ok {
+		return ""
+	}
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
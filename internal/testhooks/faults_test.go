@@ -0,0 +1,77 @@
+package testhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const createTeamSQL = "-- name: CreateTeam :one\nINSERT INTO teams (team_name) VALUES ($1)"
+
+func TestFaultsApplyInTurn(t *testing.T) {
+	faults := NewFaults()
+	if _, err := faults.Add(Fault{Query: "CreateTeam", Error: FaultSerialization, Times: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := faults.Add(Fault{Query: "CreateTeam", Error: FaultInternal, Times: 1}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var pgErr *pgconn.PgError
+	if err := faults.Apply(ctx, createTeamSQL); !errors.As(err, &pgErr) || pgErr.Code != pgerrcode.SerializationFailure {
+		t.Errorf("first call: got %v, want a serialization failure", err)
+	}
+	if err := faults.Apply(ctx, createTeamSQL); !errors.Is(err, ErrInjected) {
+		t.Errorf("second call: got %v, want ErrInjected", err)
+	}
+	if err := faults.Apply(ctx, createTeamSQL); err != nil {
+		t.Errorf("third call: got %v, want the faults used up", err)
+	}
+	if err := faults.Apply(ctx, "-- name: GetTeamByName :one\nSELECT 1"); err != nil {
+		t.Errorf("other query: got %v", err)
+	}
+
+	for _, f := range faults.List() {
+		if f.Hits != 1 {
+			t.Errorf("fault %d has %d hits, want 1", f.ID, f.Hits)
+		}
+	}
+	faults.Clear()
+	if len(faults.List()) != 0 {
+		t.Error("faults were not cleared")
+	}
+}
+
+func TestFaultsNil(t *testing.T) {
+	var faults *Faults
+	if err := faults.Apply(context.Background(), createTeamSQL); err != nil {
+		t.Errorf("nil faults injected %v", err)
+	}
+}
+
+func TestFaultsAddValidation(t *testing.T) {
+	faults := NewFaults()
+	for _, f := range []Fault{
+		{Error: FaultInternal},
+		{Query: "CreateTeam"},
+		{Query: "CreateTeam", Error: "meteor"},
+		{Query: "CreateTeam", Error: FaultInternal, Times: -1},
+	} {
+		if _, err := faults.Add(f); err == nil {
+			t.Errorf("Add(%+v) succeeded", f)
+		}
+	}
+}
+
+func TestQueryName(t *testing.T) {
+	if got := QueryName(createTeamSQL); got != "CreateTeam" {
+		t.Errorf("QueryName = %q", got)
+	}
+	if got := QueryName("SELECT 1"); got != "" {
+		t.Errorf("QueryName of plain SQL = %q", got)
+	}
+}
@@ -17,446 +17,6443 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AssignmentExplanationStrategy.
+const (
+	AssignmentExplanationStrategyLeastLoaded    AssignmentExplanationStrategy = "least_loaded"
+	AssignmentExplanationStrategyRandom         AssignmentExplanationStrategy = "random"
+	AssignmentExplanationStrategyWeightedRandom AssignmentExplanationStrategy = "weighted_random"
+)
+
+// Defines values for DeclineReason.
+const (
+	ConflictOfInterest DeclineReason = "conflict_of_interest"
+	LacksContext       DeclineReason = "lacks_context"
+	OnLeave            DeclineReason = "on_leave"
+	Overloaded         DeclineReason = "overloaded"
+)
+
+// Defines values for DependencyStatusStatus.
+const (
+	DependencyStatusStatusDown DependencyStatusStatus = "down"
+	DependencyStatusStatusUp   DependencyStatusStatus = "up"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	INTERNALERROR   ErrorResponseErrorCode = "INTERNAL_ERROR"
-	NOCANDIDATE     ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED     ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND        ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS        ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED        ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS      ErrorResponseErrorCode = "TEAM_EXISTS"
-	USERNOTACTIVE   ErrorResponseErrorCode = "USER_NOT_ACTIVE"
-	VALIDATIONERROR ErrorResponseErrorCode = "VALIDATION_ERROR"
+	CONCURRENTUPDATE         ErrorResponseErrorCode = "CONCURRENT_UPDATE"
+	HASOPENREVIEWS           ErrorResponseErrorCode = "HAS_OPEN_REVIEWS"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDSTATUSTRANSITION  ErrorResponseErrorCode = "INVALID_STATUS_TRANSITION"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED                 ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	PRTEMPLATEEXISTS         ErrorResponseErrorCode = "PR_TEMPLATE_EXISTS"
+	REPOSITORYEXISTS         ErrorResponseErrorCode = "REPOSITORY_EXISTS"
+	REVIEWREQUIREMENTSNOTMET ErrorResponseErrorCode = "REVIEW_REQUIREMENTS_NOT_MET"
+	REVIEWRULEEXISTS         ErrorResponseErrorCode = "REVIEW_RULE_EXISTS"
+	SAVEDFILTEREXISTS        ErrorResponseErrorCode = "SAVED_FILTER_EXISTS"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TIMEOUT                  ErrorResponseErrorCode = "TIMEOUT"
+	TOOMANYOPENPRS           ErrorResponseErrorCode = "TOO_MANY_OPEN_PRS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	USERNAMEEXISTS           ErrorResponseErrorCode = "USERNAME_EXISTS"
+	USERNOTACTIVE            ErrorResponseErrorCode = "USER_NOT_ACTIVE"
+	VALIDATIONERROR          ErrorResponseErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for EventReplayRequestEvent.
+const (
+	EventReplayRequestEventAckReminder      EventReplayRequestEvent = "ack_reminder"
+	EventReplayRequestEventDigest           EventReplayRequestEvent = "digest"
+	EventReplayRequestEventNoCandidateSpike EventReplayRequestEvent = "no_candidate_spike"
+	EventReplayRequestEventPrStalled        EventReplayRequestEvent = "pr_stalled"
+	EventReplayRequestEventReviewBudgetLow  EventReplayRequestEvent = "review_budget_low"
+	EventReplayRequestEventReviewReassigned EventReplayRequestEvent = "review_reassigned"
+	EventReplayRequestEventReviewRequested  EventReplayRequestEvent = "review_requested"
+	EventReplayRequestEventTeamReport       EventReplayRequestEvent = "team_report"
+)
+
+// Defines values for GitHubTeamSyncConflictReason.
+const (
+	GitHubTeamSyncConflictReasonFailed        GitHubTeamSyncConflictReason = "failed"
+	GitHubTeamSyncConflictReasonNoGithubLogin GitHubTeamSyncConflictReason = "no_github_login"
+	GitHubTeamSyncConflictReasonSeveralTeams  GitHubTeamSyncConflictReason = "several_teams"
+	GitHubTeamSyncConflictReasonTeamInactive  GitHubTeamSyncConflictReason = "team_inactive"
+	GitHubTeamSyncConflictReasonTeamNameTaken GitHubTeamSyncConflictReason = "team_name_taken"
+	GitHubTeamSyncConflictReasonUserInactive  GitHubTeamSyncConflictReason = "user_inactive"
+	GitHubTeamSyncConflictReasonUsernameTaken GitHubTeamSyncConflictReason = "username_taken"
+)
+
+// Defines values for InboundWebhookSource.
+const (
+	Github InboundWebhookSource = "github"
+)
+
+// Defines values for InboundWebhookStatus.
+const (
+	InboundWebhookStatusFailed    InboundWebhookStatus = "failed"
+	InboundWebhookStatusProcessed InboundWebhookStatus = "processed"
+)
+
+// Defines values for NotificationPreferencesChannels.
+const (
+	Email   NotificationPreferencesChannels = "email"
+	Log     NotificationPreferencesChannels = "log"
+	Webhook NotificationPreferencesChannels = "webhook"
+)
+
+// Defines values for NotificationPreferencesDigest.
+const (
+	Daily  NotificationPreferencesDigest = "daily"
+	Off    NotificationPreferencesDigest = "off"
+	Weekly NotificationPreferencesDigest = "weekly"
+)
+
+// Defines values for NotificationPreferencesMutedEvents.
+const (
+	NotificationPreferencesMutedEventsAckReminder      NotificationPreferencesMutedEvents = "ack_reminder"
+	NotificationPreferencesMutedEventsNoCandidateSpike NotificationPreferencesMutedEvents = "no_candidate_spike"
+	NotificationPreferencesMutedEventsPrStalled        NotificationPreferencesMutedEvents = "pr_stalled"
+	NotificationPreferencesMutedEventsReviewBudgetLow  NotificationPreferencesMutedEvents = "review_budget_low"
+	NotificationPreferencesMutedEventsReviewReassigned NotificationPreferencesMutedEvents = "review_reassigned"
+	NotificationPreferencesMutedEventsReviewRequested  NotificationPreferencesMutedEvents = "review_requested"
+	NotificationPreferencesMutedEventsTeamReport       NotificationPreferencesMutedEvents = "team_report"
+)
+
+// Defines values for PRQuotaMode.
+const (
+	Block PRQuotaMode = "block"
+	Warn  PRQuotaMode = "warn"
+)
+
+// Defines values for PRTimelineEventType.
+const (
+	Acked            PRTimelineEventType = "acked"
+	Approved         PRTimelineEventType = "approved"
+	Assigned         PRTimelineEventType = "assigned"
+	ChangesRequested PRTimelineEventType = "changes_requested"
+	Closed           PRTimelineEventType = "closed"
+	Created          PRTimelineEventType = "created"
+	Merged           PRTimelineEventType = "merged"
+	Reassigned       PRTimelineEventType = "reassigned"
 )
 
 // Defines values for PullRequestStatus.
 const (
-	PullRequestStatusMERGED PullRequestStatus = "MERGED"
-	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+	PullRequestStatusAPPROVED PullRequestStatus = "APPROVED"
+	PullRequestStatusCLOSED   PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT    PullRequestStatus = "DRAFT"
+	PullRequestStatusINREVIEW PullRequestStatus = "IN_REVIEW"
+	PullRequestStatusMERGED   PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN     PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestFilterStatus.
+const (
+	PullRequestFilterStatusAPPROVED PullRequestFilterStatus = "APPROVED"
+	PullRequestFilterStatusCLOSED   PullRequestFilterStatus = "CLOSED"
+	PullRequestFilterStatusDRAFT    PullRequestFilterStatus = "DRAFT"
+	PullRequestFilterStatusINREVIEW PullRequestFilterStatus = "IN_REVIEW"
+	PullRequestFilterStatusMERGED   PullRequestFilterStatus = "MERGED"
+	PullRequestFilterStatusOPEN     PullRequestFilterStatus = "OPEN"
+)
+
+// Defines values for PullRequestPriority.
+const (
+	LOW    PullRequestPriority = "LOW"
+	NORMAL PullRequestPriority = "NORMAL"
+	URGENT PullRequestPriority = "URGENT"
+)
+
+// Defines values for PullRequestSearchHitStatus.
+const (
+	PullRequestSearchHitStatusAPPROVED PullRequestSearchHitStatus = "APPROVED"
+	PullRequestSearchHitStatusCLOSED   PullRequestSearchHitStatus = "CLOSED"
+	PullRequestSearchHitStatusDRAFT    PullRequestSearchHitStatus = "DRAFT"
+	PullRequestSearchHitStatusINREVIEW PullRequestSearchHitStatus = "IN_REVIEW"
+	PullRequestSearchHitStatusMERGED   PullRequestSearchHitStatus = "MERGED"
+	PullRequestSearchHitStatusOPEN     PullRequestSearchHitStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	PullRequestShortStatusAPPROVED PullRequestShortStatus = "APPROVED"
+	PullRequestShortStatusCLOSED   PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT    PullRequestShortStatus = "DRAFT"
+	PullRequestShortStatusINREVIEW PullRequestShortStatus = "IN_REVIEW"
+	PullRequestShortStatusMERGED   PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN     PullRequestShortStatus = "OPEN"
 )
 
-// CountResponse defines model for CountResponse.
-type CountResponse struct {
-	Count int `json:"count"`
+// Defines values for ReadinessResponseStatus.
+const (
+	ReadinessResponseStatusDegraded ReadinessResponseStatus = "degraded"
+	ReadinessResponseStatusDown     ReadinessResponseStatus = "down"
+	ReadinessResponseStatusOk       ReadinessResponseStatus = "ok"
+)
+
+// Defines values for ReassignmentReason.
+const (
+	Deactivation ReassignmentReason = "deactivation"
+	Handoff      ReassignmentReason = "handoff"
+	Inactive     ReassignmentReason = "inactive"
+	Manual       ReassignmentReason = "manual"
+	Rebalance    ReassignmentReason = "rebalance"
+	TeamMove     ReassignmentReason = "team_move"
+	Unacked      ReassignmentReason = "unacked"
+)
+
+// Defines values for SettingsSelectionStrategy.
+const (
+	SettingsSelectionStrategyLeastLoaded    SettingsSelectionStrategy = "least_loaded"
+	SettingsSelectionStrategyRandom         SettingsSelectionStrategy = "random"
+	SettingsSelectionStrategyWeightedRandom SettingsSelectionStrategy = "weighted_random"
+)
+
+// Defines values for SettingsOverrideSelectionStrategy.
+const (
+	LeastLoaded    SettingsOverrideSelectionStrategy = "least_loaded"
+	Random         SettingsOverrideSelectionStrategy = "random"
+	WeightedRandom SettingsOverrideSelectionStrategy = "weighted_random"
+)
+
+// Defines values for ThroughputMetric.
+const (
+	PrsCreated       ThroughputMetric = "prs_created"
+	PrsMerged        ThroughputMetric = "prs_merged"
+	ReviewsAssigned  ThroughputMetric = "reviews_assigned"
+	ReviewsCompleted ThroughputMetric = "reviews_completed"
+)
+
+// Defines values for UserDeactivationResultStatus.
+const (
+	AlreadyInactive UserDeactivationResultStatus = "already_inactive"
+	Deactivated     UserDeactivationResultStatus = "deactivated"
+	NotFound        UserDeactivationResultStatus = "not_found"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
+// Defines values for GetAdminNotificationsFailedParamsEvent.
+const (
+	GetAdminNotificationsFailedParamsEventAckReminder      GetAdminNotificationsFailedParamsEvent = "ack_reminder"
+	GetAdminNotificationsFailedParamsEventDigest           GetAdminNotificationsFailedParamsEvent = "digest"
+	GetAdminNotificationsFailedParamsEventNoCandidateSpike GetAdminNotificationsFailedParamsEvent = "no_candidate_spike"
+	GetAdminNotificationsFailedParamsEventPrStalled        GetAdminNotificationsFailedParamsEvent = "pr_stalled"
+	GetAdminNotificationsFailedParamsEventReviewBudgetLow  GetAdminNotificationsFailedParamsEvent = "review_budget_low"
+	GetAdminNotificationsFailedParamsEventReviewReassigned GetAdminNotificationsFailedParamsEvent = "review_reassigned"
+	GetAdminNotificationsFailedParamsEventReviewRequested  GetAdminNotificationsFailedParamsEvent = "review_requested"
+	GetAdminNotificationsFailedParamsEventTeamReport       GetAdminNotificationsFailedParamsEvent = "team_report"
+)
+
+// Defines values for GetAdminWebhooksDeliveriesParamsStatus.
+const (
+	GetAdminWebhooksDeliveriesParamsStatusFailed    GetAdminWebhooksDeliveriesParamsStatus = "failed"
+	GetAdminWebhooksDeliveriesParamsStatusSucceeded GetAdminWebhooksDeliveriesParamsStatus = "succeeded"
+)
+
+// Defines values for GetAdminWebhooksInboundParamsStatus.
+const (
+	GetAdminWebhooksInboundParamsStatusFailed    GetAdminWebhooksInboundParamsStatus = "failed"
+	GetAdminWebhooksInboundParamsStatusProcessed GetAdminWebhooksInboundParamsStatus = "processed"
+)
+
+// Defines values for GetPullRequestGetPullRequestIdParamsInclude.
+const (
+	Timeline GetPullRequestGetPullRequestIdParamsInclude = "timeline"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	APPROVED GetPullRequestListParamsStatus = "APPROVED"
+	CLOSED   GetPullRequestListParamsStatus = "CLOSED"
+	DRAFT    GetPullRequestListParamsStatus = "DRAFT"
+	INREVIEW GetPullRequestListParamsStatus = "IN_REVIEW"
+	MERGED   GetPullRequestListParamsStatus = "MERGED"
+	OPEN     GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for GetStatsParamsSort.
+const (
+	ReviewCount GetStatsParamsSort = "review_count"
+	Username    GetStatsParamsSort = "username"
+)
+
+// Defines values for GetStatsTimeseriesParamsInterval.
+const (
+	Day  GetStatsTimeseriesParamsInterval = "day"
+	Hour GetStatsTimeseriesParamsInterval = "hour"
+	Week GetStatsTimeseriesParamsInterval = "week"
+)
+
+// Defines values for GetTeamReportParamsFormat.
+const (
+	Html GetTeamReportParamsFormat = "html"
+	Json GetTeamReportParamsFormat = "json"
+)
+
+// Defines values for PostUsersMoveToTeamJSONBodyOpenReviews.
+const (
+	Ask      PostUsersMoveToTeamJSONBodyOpenReviews = "ask"
+	Keep     PostUsersMoveToTeamJSONBodyOpenReviews = "keep"
+	Reassign PostUsersMoveToTeamJSONBodyOpenReviews = "reassign"
+)
+
+// ArchiveRequest defines model for ArchiveRequest.
+type ArchiveRequest struct {
+	// MergedBeforeDays Архивировать PR, смерженные более указанного числа дней назад
+	MergedBeforeDays int `json:"merged_before_days"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
-	} `json:"error"`
+// ArchiveResponse defines model for ArchiveResponse.
+type ArchiveResponse struct {
+	ArchivedCount int `json:"archived_count"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
-type ErrorResponseErrorCode string
+// AssignmentExplanation Критерии выбора ревьювера в порядке их приоритета: подсказка (ревьювер по умолчанию из шаблона или трейлер), дежурство недели, предпочтение автора, перерыв после недавних ревью автора, совпадающие навыки; среди равных кандидатов выбирает стратегия команды
+type AssignmentExplanation struct {
+	// Candidates Сколько кандидатов подходило для выбора
+	Candidates int `json:"candidates"`
 
-// PullRequest defines model for PullRequest.
-type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// Cooldown Недавно ревьюил автора и выбран только потому, что других кандидатов не было
+	Cooldown bool `json:"cooldown"`
+
+	// Hinted Ревьювер по умолчанию из шаблона PR или указан в трейлере Reviewers
+	Hinted bool `json:"hinted"`
+
+	// MatchedSkills Навыки ревьювера из требуемых PR
+	MatchedSkills []string `json:"matched_skills"`
+
+	// OpenReviews Открытые ревью на момент выбора; есть, только если их учитывали стратегия или лимит открытых ревью
+	OpenReviews *int `json:"open_reviews,omitempty"`
+
+	// PreferenceWeight Вес предпочтения автора для этого ревьювера; 0 — предпочтения нет
+	PreferenceWeight int `json:"preference_weight"`
+
+	// Role Обязательная роль, под которую выбран ревьювер; отсутствует, если выбран не под роль
+	Role *string `json:"role,omitempty"`
+
+	// Rotation Дежурный ревьювер команды на этой неделе
+	Rotation bool `json:"rotation"`
+
+	// Strategy Стратегия выбора между одинаково подходящими кандидатами
+	Strategy AssignmentExplanationStrategy `json:"strategy"`
+	UserId   string                        `json:"user_id"`
 }
 
-// PullRequestStatus defines model for PullRequest.Status.
-type PullRequestStatus string
+// AssignmentExplanationStrategy Стратегия выбора между одинаково подходящими кандидатами
+type AssignmentExplanationStrategy string
 
-// PullRequestCreateRequest defines model for PullRequestCreateRequest.
-type PullRequestCreateRequest struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestName string `json:"pull_request_name"`
+// AuthorStatsResponse defines model for AuthorStatsResponse.
+type AuthorStatsResponse struct {
+	// AvgReviewersPerPr Среднее число назначенных ревьюеров на PR
+	AvgReviewersPerPr float64 `json:"avg_reviewers_per_pr"`
+
+	// AvgTimeToMergeSeconds Среднее время от создания PR до merge; отсутствует, пока нет влитых PR
+	AvgTimeToMergeSeconds *float64 `json:"avg_time_to_merge_seconds,omitempty"`
+
+	// MedianTimeToMergeSeconds Медиана времени от создания PR до merge; отсутствует, пока нет влитых PR
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds,omitempty"`
+	MergedPrs                int      `json:"merged_prs"`
+	OpenPrs                  int      `json:"open_prs"`
+
+	// OpenedPrs Всего PR, открытых пользователем, включая влитые, закрытые и архивные
+	OpenedPrs int    `json:"opened_prs"`
+	UserId    string `json:"user_id"`
 }
 
-// PullRequestShort defines model for PullRequestShort.
-type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Checked   bool       `json:"checked"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+
+	// CheckedBy Ревьювер, первым отметивший пункт
+	CheckedBy *string `json:"checked_by,omitempty"`
+	Label     string  `json:"label"`
+
+	// Position Номер пункта, начиная с 0
+	Position int `json:"position"`
 }
 
-// PullRequestShortStatus defines model for PullRequestShort.Status.
-type PullRequestShortStatus string
+// ChecklistTemplate Пункты чек-листа, которые копируются в каждый новый PR, ревьюеры которого подбираются из команды. Изменение шаблона не затрагивает уже созданные PR.
+type ChecklistTemplate struct {
+	Items []string `json:"items"`
 
-// StatItem defines model for StatItem.
-type StatItem struct {
-	ReviewCount *int64  `json:"review_count,omitempty"`
-	UserId      *string `json:"user_id,omitempty"`
+	// RequireCompletion Запретить слияние PR, пока не отмечены все пункты его чек-листа
+	RequireCompletion *bool `json:"require_completion,omitempty"`
 }
 
-// StatsResponse defines model for StatsResponse.
-type StatsResponse struct {
-	ReviewStats *[]StatItem `json:"review_stats,omitempty"`
+// CountResponse defines model for CountResponse.
+type CountResponse struct {
+	Count int `json:"count"`
 }
 
-// Team defines model for Team.
-type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+// DataDump defines model for DataDump.
+type DataDump struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	Teams        []TeamShort   `json:"teams"`
+	Users        []User        `json:"users"`
 }
 
-// TeamDeactivateRequest defines model for TeamDeactivateRequest.
-type TeamDeactivateRequest struct {
-	TeamName string `json:"team_name"`
+// DeclineReason Почему ревьювер отказался от ревью при ручном переназначении: conflict_of_interest — конфликт интересов, overloaded — перегружен, on_leave — в отпуске или отсутствует, lacks_context — не хватает контекста.
+type DeclineReason string
+
+// DeclineReasonCount defines model for DeclineReasonCount.
+type DeclineReasonCount struct {
+	Count int `json:"count"`
+
+	// Reason Почему ревьювер отказался от ревью при ручном переназначении: conflict_of_interest — конфликт интересов, overloaded — перегружен, on_leave — в отпуске или отсутствует, lacks_context — не хватает контекста.
+	Reason DeclineReason `json:"reason"`
 }
 
-// TeamDeactivateResponse defines model for TeamDeactivateResponse.
-type TeamDeactivateResponse struct {
-	DeactivatedUsersCount  *int `json:"deactivated_users_count,omitempty"`
-	ReassignedReviewsCount *int `json:"reassigned_reviews_count,omitempty"`
+// DependencyStatus defines model for DependencyStatus.
+type DependencyStatus struct {
+	// Critical Критичная зависимость; её отказ делает сервис неготовым
+	Critical  bool                   `json:"critical"`
+	Error     *string                `json:"error,omitempty"`
+	LatencyMs int64                  `json:"latency_ms"`
+	Name      string                 `json:"name"`
+	Status    DependencyStatusStatus `json:"status"`
 }
 
-// TeamMember defines model for TeamMember.
-type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+// DependencyStatusStatus defines model for DependencyStatus.Status.
+type DependencyStatusStatus string
+
+// EndpointLatencyStats defines model for EndpointLatencyStats.
+type EndpointLatencyStats struct {
+	// ClientErrors Ответы 4xx
+	ClientErrors int64 `json:"client_errors"`
+
+	// ErrorRate Доля ответов 5xx
+	ErrorRate float64 `json:"error_rate"`
+	Method    string  `json:"method"`
+	P50Ms     float64 `json:"p50_ms"`
+	P95Ms     float64 `json:"p95_ms"`
+	P99Ms     float64 `json:"p99_ms"`
+
+	// Path Шаблон пути, например /stats/user/{user_id}/turnaround
+	Path     string `json:"path"`
+	Requests int64  `json:"requests"`
+
+	// ServerErrors Ответы 5xx
+	ServerErrors int64 `json:"server_errors"`
 }
 
-// User defines model for User.
-type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+// ErrorCodeInfo defines model for ErrorCodeInfo.
+type ErrorCodeInfo struct {
+	// Code Значение поля error.code в ErrorResponse
+	Code string `json:"code"`
+
+	// Description Когда возвращается код и как клиенту на него реагировать
+	Description string `json:"description"`
+	DocsUrl     string `json:"docs_url"`
+
+	// HttpStatus HTTP-статус ответа с этим кодом
+	HttpStatus int `json:"http_status"`
 }
 
-// UserAddRequest defines model for UserAddRequest.
-type UserAddRequest struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	Username string `json:"username"`
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Field Путь к полю в теле запроса (например, members.0.username) или имя параметра
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
 }
 
-// PullRequestIdParam defines model for PullRequestIdParam.
-type PullRequestIdParam = string
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
 
-// TeamNameParam defines model for TeamNameParam.
-type TeamNameParam = string
+		// Details Ошибки валидации по отдельным полям запроса
+		Details *[]ErrorDetail `json:"details,omitempty"`
 
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
+		// DocsUrl Ссылка на описание кода ошибки на странице /docs/errors (фрагмент — сам код)
+		DocsUrl *string `json:"docs_url,omitempty"`
+		Message string  `json:"message"`
 
-// UserIdParam defines model for UserIdParam.
-type UserIdParam = string
+		// RequestId Идентификатор запроса (совпадает с заголовком X-Request-ID), укажите его при обращении в поддержку
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
 
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
 
-// PostPullRequestAssignJSONBody defines parameters for PostPullRequestAssign.
-type PostPullRequestAssignJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
-	UserId        string `json:"user_id"`
+// EscalationPolicy Эскалация PR команды, по которым нет активности ревьюеров (ни одного одобрения). Задаётся через /team/edit; 0 часов отключает шаг.
+type EscalationPolicy struct {
+	// AddReviewerAfterHours Через сколько часов ожидания автоматически добавить ещё одного ревьюера
+	AddReviewerAfterHours int `json:"add_reviewer_after_hours"`
+
+	// LeadUserId Лид команды, которому отправляется уведомление
+	LeadUserId *string `json:"lead_user_id,omitempty"`
+
+	// NotifyLeadAfterHours Через сколько часов ожидания уведомить лида
+	NotifyLeadAfterHours int `json:"notify_lead_after_hours"`
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+// EventReplayRequest defines model for EventReplayRequest.
+type EventReplayRequest struct {
+	// Event Тип события; если не задан — все типы
+	Event *EventReplayRequestEvent `json:"event,omitempty"`
+
+	// Since Начало интервала (включительно) по времени возникновения события
+	Since time.Time `json:"since"`
+
+	// Until Конец интервала (не включительно); по умолчанию — текущее время
+	Until *time.Time `json:"until,omitempty"`
+
+	// UserId Получатель; если не задан — все получатели
+	UserId *string `json:"user_id,omitempty"`
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+// EventReplayRequestEvent Тип события; если не задан — все типы
+type EventReplayRequestEvent string
+
+// EventReplayResponse defines model for EventReplayResponse.
+type EventReplayResponse struct {
+	// ReplayedCount Сколько событий поставлено в очередь на повторную доставку
+	ReplayedCount int `json:"replayed_count"`
 }
 
-// PostTeamEditJSONBody defines parameters for PostTeamEdit.
-type PostTeamEditJSONBody struct {
-	NewTeamName string `json:"new_team_name"`
-	OldTeamName string `json:"old_team_name"`
+// FailedNotification defines model for FailedNotification.
+type FailedNotification struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+	Event     string    `json:"event"`
+
+	// FailedAt Когда доставка была прекращена
+	FailedAt time.Time `json:"failed_at"`
+	Id       int64     `json:"id"`
+
+	// LastError Ошибка последней попытки доставки
+	LastError string `json:"last_error"`
+	Message   string `json:"message"`
+
+	// PullRequestId PR, к которому относится уведомление (отсутствует у сводок)
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+	UserId        string  `json:"user_id"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// FailedNotificationList defines model for FailedNotificationList.
+type FailedNotificationList struct {
+	Notifications []FailedNotification `json:"notifications"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// FairnessResponse defines model for FairnessResponse.
+type FairnessResponse struct {
+	Teams       []TeamFairness `json:"teams"`
+	WindowEnd   time.Time      `json:"window_end"`
+	WindowStart time.Time      `json:"window_start"`
 }
 
-// PostUsersMoveToTeamJSONBody defines parameters for PostUsersMoveToTeam.
-type PostUsersMoveToTeamJSONBody struct {
-	NewTeamName string `json:"new_team_name"`
+// GitHubAccount defines model for GitHubAccount.
+type GitHubAccount struct {
+	// GithubLogin Логин пользователя на GitHub
+	GithubLogin string `json:"github_login"`
 	UserId      string `json:"user_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// GitHubIngestionToken defines model for GitHubIngestionToken.
+type GitHubIngestionToken struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// LastUsedAt Когда токен последний раз подтвердил доставку; отсутствует, если ещё не использовался
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	// Repository Репозиторий в формате owner/name
+	Repository string `json:"repository"`
+
+	// Secret Секрет вебхука; возвращается только при выпуске
+	Secret *string `json:"secret,omitempty"`
+
+	// TeamName Команда, в которой создаются PR репозитория
+	TeamName string `json:"team_name"`
 }
 
-// PostPullRequestAssignJSONRequestBody defines body for PostPullRequestAssign for application/json ContentType.
-type PostPullRequestAssignJSONRequestBody PostPullRequestAssignJSONBody
+// GitHubPullRequestLink defines model for GitHubPullRequestLink.
+type GitHubPullRequestLink struct {
+	// Number Номер pull request'а на GitHub
+	Number        int    `json:"number"`
+	PullRequestId string `json:"pull_request_id"`
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody = PullRequestCreateRequest
+	// Repository Репозиторий в формате owner/name
+	Repository string `json:"repository"`
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+	// SyncError Ошибка синхронизации ревьюеров с GitHub; связь при этом сохраняется
+	SyncError *string `json:"sync_error,omitempty"`
+}
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+// GitHubRepository defines model for GitHubRepository.
+type GitHubRepository struct {
+	// InstallationId Установка GitHub App, через которую доступен репозиторий
+	InstallationId int64 `json:"installation_id"`
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+	// Repository Репозиторий в формате owner/name
+	Repository string `json:"repository"`
 
-// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
-type PostTeamDeactivateJSONRequestBody = TeamDeactivateRequest
+	// TeamName Команда, из которой назначаются ревьюеры; пусто — репозиторий не подключён
+	TeamName *string `json:"team_name,omitempty"`
+}
 
-// PostTeamEditJSONRequestBody defines body for PostTeamEdit for application/json ContentType.
-type PostTeamEditJSONRequestBody PostTeamEditJSONBody
+// GitHubTeamSyncConflict defines model for GitHubTeamSyncConflict.
+type GitHubTeamSyncConflict struct {
+	Detail      *string `json:"detail,omitempty"`
+	GithubLogin *string `json:"github_login,omitempty"`
 
-// PostUsersAddJSONRequestBody defines body for PostUsersAdd for application/json ContentType.
-type PostUsersAddJSONRequestBody = UserAddRequest
+	// Reason several_teams — логин состоит в нескольких командах на GitHub, пользователь оставлен или переведён в team_name; team_name_taken — команда с таким названием уже синхронизируется с другой командой GitHub; team_inactive — команда деактивирована; username_taken — пользователя с таким логином создать нельзя; user_inactive — пользователь деактивирован и не переводится автоматически; no_github_login — у участника синхронизируемой команды нет привязанного логина GitHub; failed — изменение не удалось применить (см. detail)
+	Reason   GitHubTeamSyncConflictReason `json:"reason"`
+	TeamName *string                      `json:"team_name,omitempty"`
+	UserId   *string                      `json:"user_id,omitempty"`
+}
 
-// PostUsersEditJSONRequestBody defines body for PostUsersEdit for application/json ContentType.
-type PostUsersEditJSONRequestBody = User
+// GitHubTeamSyncConflictReason several_teams — логин состоит в нескольких командах на GitHub, пользователь оставлен или переведён в team_name; team_name_taken — команда с таким названием уже синхронизируется с другой командой GitHub; team_inactive — команда деактивирована; username_taken — пользователя с таким логином создать нельзя; user_inactive — пользователь деактивирован и не переводится автоматически; no_github_login — у участника синхронизируемой команды нет привязанного логина GitHub; failed — изменение не удалось применить (см. detail)
+type GitHubTeamSyncConflictReason string
 
-// PostUsersMoveToTeamJSONRequestBody defines body for PostUsersMoveToTeam for application/json ContentType.
-type PostUsersMoveToTeamJSONRequestBody PostUsersMoveToTeamJSONBody
+// GitHubTeamSyncReport defines model for GitHubTeamSyncReport.
+type GitHubTeamSyncReport struct {
+	Conflicts []GitHubTeamSyncConflict `json:"conflicts"`
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+	// Error Ошибка чтения организации; в этом случае ничего не изменено
+	Error      *string   `json:"error,omitempty"`
+	FinishedAt time.Time `json:"finished_at"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Check service health
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// Назначить ревьювера на PR
-	// (POST /pullRequest/assign)
-	PostPullRequestAssign(w http.ResponseWriter, r *http.Request)
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Получить информацию о PR по ID
-	// (GET /pullRequest/get/{pull_request_id})
-	GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Получить список открытых PR без ревьюверов
-	// (GET /pullRequest/open-without-reviewers)
-	GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Получить статистику по ревью
-	// (GET /stats)
-	GetStats(w http.ResponseWriter, r *http.Request)
-	// Получить количество закрытых PR у команды
-	// (GET /stats/team/{team_name}/merged-review-count)
-	GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
-	// Получить количество назначенных OPEN PR у команды
-	// (GET /stats/team/{team_name}/open-review-count)
-	GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
-	// Получить количество закрытых PR у пользователя
-	// (GET /stats/user/{user_id}/merged-review-count)
-	GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam)
-	// Получить количество назначенных OPEN PR у пользователя
-	// (GET /stats/user/{user_id}/open-review-count)
-	GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
-	// Массово деактивировать команду и переназначить ревью
-	// (POST /team/deactivate)
-	PostTeamDeactivate(w http.ResponseWriter, r *http.Request)
-	// Изменить имя команды
-	// (POST /team/edit)
-	PostTeamEdit(w http.ResponseWriter, r *http.Request)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
-	// Добавить пользователя
-	// (POST /users/add)
-	PostUsersAdd(w http.ResponseWriter, r *http.Request)
-	// Изменить пользователя
-	// (POST /users/edit)
-	PostUsersEdit(w http.ResponseWriter, r *http.Request)
-	// Получить пользователя по ID
-	// (GET /users/get/{user_id})
-	GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
-	// Переместить пользователя в другую команду
-	// (POST /users/moveToTeam)
-	PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Org Организация на GitHub
+	Org              string    `json:"org"`
+	StartedAt        time.Time `json:"started_at"`
+	TeamsCreated     int       `json:"teams_created"`
+	UsersCreated     int       `json:"users_created"`
+	UsersDeactivated int       `json:"users_deactivated"`
+	UsersMoved       int       `json:"users_moved"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// ImportResponse defines model for ImportResponse.
+type ImportResponse struct {
+	AssignmentsImported  int `json:"assignments_imported"`
+	PullRequestsImported int `json:"pull_requests_imported"`
+	TeamsImported        int `json:"teams_imported"`
+	UsersImported        int `json:"users_imported"`
+}
 
-type Unimplemented struct{}
+// InboundWebhook defines model for InboundWebhook.
+type InboundWebhook struct {
+	// Attempts Сколько раз доставка обрабатывалась
+	Attempts int `json:"attempts"`
 
-// Check service health
-// (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// DeliveryId Идентификатор доставки у отправителя; отсутствует, если не передан
+	DeliveryId *string `json:"delivery_id,omitempty"`
+
+	// Error Ошибка последней обработки; отсутствует для обработанных
+	Error *string `json:"error,omitempty"`
+
+	// Event Тип события, например `pull_request`
+	Event string `json:"event"`
+	Id    int64  `json:"id"`
+
+	// IngestionToken Репозиторий, токен приёма которого подтвердил доставку; отсутствует для секрета GitHub App
+	IngestionToken *string `json:"ingestion_token,omitempty"`
+
+	// Payload Тело запроса
+	Payload string `json:"payload"`
+
+	// ProcessedAt Время последней обработки
+	ProcessedAt time.Time            `json:"processed_at"`
+	ReceivedAt  time.Time            `json:"received_at"`
+	Source      InboundWebhookSource `json:"source"`
+	Status      InboundWebhookStatus `json:"status"`
 }
 
-// Назначить ревьювера на PR
-// (POST /pullRequest/assign)
-func (_ Unimplemented) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// InboundWebhookSource defines model for InboundWebhook.Source.
+type InboundWebhookSource string
+
+// InboundWebhookStatus defines model for InboundWebhook.Status.
+type InboundWebhookStatus string
+
+// InboundWebhookList defines model for InboundWebhookList.
+type InboundWebhookList struct {
+	Webhooks []InboundWebhook `json:"webhooks"`
 }
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-// (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// MergeCount defines model for MergeCount.
+type MergeCount struct {
+	// MergedBy Кто влил PR — user_id или другой актор из X-Actor-Id
+	MergedBy    string `json:"merged_by"`
+	MergedCount int    `json:"merged_count"`
+
+	// Username Имя пользователя, если merged_by — user_id
+	Username *string `json:"username,omitempty"`
 }
 
-// Получить информацию о PR по ID
-// (GET /pullRequest/get/{pull_request_id})
-func (_ Unimplemented) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam) {
-	w.WriteHeader(http.StatusNotImplemented)
+// MergeStatsResponse defines model for MergeStatsResponse.
+type MergeStatsResponse struct {
+	// Total Сколько PR влито за окно
+	Total int `json:"total"`
+
+	// Unattributed Сколько из них влито без указания, кем (до появления merged_by, сервисом или без актора)
+	Unattributed int `json:"unattributed"`
+
+	// Users По убыванию числа влитых PR
+	Users       []MergeCount `json:"users"`
+	WindowEnd   time.Time    `json:"window_end"`
+	WindowStart time.Time    `json:"window_start"`
 }
 
-// Пометить PR как MERGED (идемпотентная операция)
-// (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Channels Каналы доставки уведомлений; пустой список отключает уведомления. Канал email доступен, если задан SMTP_ADDR
+	Channels []NotificationPreferencesChannels `json:"channels"`
+
+	// Digest Периодическая сводка по ожидающим ревью; при включённой сводке отдельные уведомления о назначении не отправляются
+	Digest *NotificationPreferencesDigest `json:"digest,omitempty"`
+
+	// Email Адрес электронной почты, обязателен для канала email
+	Email *string `json:"email,omitempty"`
+
+	// MutedEvents События, о которых пользователь не хочет получать уведомления
+	MutedEvents []NotificationPreferencesMutedEvents `json:"muted_events"`
+
+	// QuietHoursEnd Конец тихих часов; уведомления, возникшие в тихие часы, доставляются после него
+	QuietHoursEnd *string `json:"quiet_hours_end,omitempty"`
+
+	// QuietHoursStart Начало тихих часов (HH:MM, в часовом поясе пользователя)
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+
+	// WebhookUrl URL входящего вебхука (Slack-совместимый формат), обязателен для канала webhook
+	WebhookUrl *string `json:"webhook_url,omitempty"`
 }
 
-// Получить список открытых PR без ревьюверов
-// (GET /pullRequest/open-without-reviewers)
-func (_ Unimplemented) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// NotificationPreferencesChannels defines model for NotificationPreferences.Channels.
+type NotificationPreferencesChannels string
+
+// NotificationPreferencesDigest Периодическая сводка по ожидающим ревью; при включённой сводке отдельные уведомления о назначении не отправляются
+type NotificationPreferencesDigest string
+
+// NotificationPreferencesMutedEvents defines model for NotificationPreferences.MutedEvents.
+type NotificationPreferencesMutedEvents string
+
+// OrgSettings defines model for OrgSettings.
+type OrgSettings struct {
+	// Defaults Значения настроек по умолчанию для всей организации или действующие для команды
+	Defaults Settings `json:"defaults"`
+
+	// Teams Команды с переопределениями, по имени команды
+	Teams []TeamSettings `json:"teams"`
+
+	// UpdatedAt Когда настройки сохранялись в последний раз; нет, пока действуют встроенные
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
-// Переназначить конкретного ревьювера на другого из его команды
-// (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// OrgSummaryResponse defines model for OrgSummaryResponse.
+type OrgSummaryResponse struct {
+	// ActiveUsers Активные пользователи активных команд
+	ActiveUsers int `json:"active_users"`
+
+	// AvgTurnaroundSeconds Среднее время от создания PR до мержа по смёрженным за неделю; отсутствует, если ничего не смёржено
+	AvgTurnaroundSeconds *float64 `json:"avg_turnaround_seconds,omitempty"`
+
+	// MergedPrs PR, смёрженные за неделю (включая архивные)
+	MergedPrs int `json:"merged_prs"`
+
+	// OpenPrs Открытые PR, включая черновики
+	OpenPrs int `json:"open_prs"`
+
+	// UnassignedPrs Открытые PR, у которых нет ни одного ревьюера
+	UnassignedPrs int       `json:"unassigned_prs"`
+	WindowEnd     time.Time `json:"window_end"`
+	WindowStart   time.Time `json:"window_start"`
 }
 
-// Получить статистику по ревью
-// (GET /stats)
-func (_ Unimplemented) GetStats(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// OverrideTeamReviewRotationRequest defines model for OverrideTeamReviewRotationRequest.
+type OverrideTeamReviewRotationRequest struct {
+	TeamName string `json:"team_name"`
+
+	// UserId Участник команды, дежурящий вместо очередного; без поля неделя возвращается ротации
+	UserId *string `json:"user_id,omitempty"`
+
+	// WeekStart Любая дата недели, начиная с текущей
+	WeekStart openapi_types.Date `json:"week_start"`
 }
 
-// Получить количество закрытых PR у команды
-// (GET /stats/team/{team_name}/merged-review-count)
-func (_ Unimplemented) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PRQuotaMode warn — PR сверх квоты создаётся с предупреждением TOO_MANY_OPEN_PRS; block — создание отклоняется с кодом TOO_MANY_OPEN_PRS
+type PRQuotaMode string
+
+// PRTemplate Шаблон PR команды team_name; команда не меняется при редактировании
+type PRTemplate struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DefaultReviewers user_id участников команды, которые назначаются ревьюерами в первую очередь. Подсказка, а не требование: неактивные, занятые сверх ограничений и не входящие в команду ревьюеров PR пропускаются
+	DefaultReviewers *[]string `json:"default_reviewers,omitempty"`
+
+	// Labels Метки, добавляемые к меткам PR
+	Labels *[]string `json:"labels,omitempty"`
+	Name   string    `json:"name"`
+
+	// NamePrefix Префикс названия PR, например "[payments] "
+	NamePrefix *string    `json:"name_prefix,omitempty"`
+	TeamName   string     `json:"team_name"`
+	TemplateId *int64     `json:"template_id,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 }
 
-// Получить количество назначенных OPEN PR у команды
-// (GET /stats/team/{team_name}/open-review-count)
-func (_ Unimplemented) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PRTimelineEvent defines model for PRTimelineEvent.
+type PRTimelineEvent struct {
+	// Actor Кто выполнил reassigned или merged, если известно
+	Actor *string `json:"actor,omitempty"`
+
+	// DeclineReason Почему ревьювер отказался от ревью при ручном переназначении: conflict_of_interest — конфликт интересов, overloaded — перегружен, on_leave — в отпуске или отсутствует, lacks_context — не хватает контекста.
+	DeclineReason *DeclineReason `json:"decline_reason,omitempty"`
+	OccurredAt    time.Time      `json:"occurred_at"`
+
+	// Reason Причина снятия ревьювера с PR: deactivation — деактивация пользователя или команды, manual — /pullRequest/reassign, handoff — /pullRequest/reassignAll, unacked — назначение не подтверждено вовремя, rebalance — выравнивание нагрузки команды, team_move — переход ревьювера в другую команду, inactive — ревьювер слишком долго ничего не делал с ревью.
+	Reason *ReassignmentReason `json:"reason,omitempty"`
+
+	// ReplacedBy Новый ревьюер при reassigned; отсутствует, если замена не найдена
+	ReplacedBy *string             `json:"replaced_by,omitempty"`
+	Type       PRTimelineEventType `json:"type"`
+
+	// UserId Автор PR для created, ревьюер для assigned, acked, changes_requested и approved, снятый ревьюер для reassigned, влившийся пользователь или автор для merged
+	UserId string `json:"user_id"`
 }
 
-// Получить количество закрытых PR у пользователя
-// (GET /stats/user/{user_id}/merged-review-count)
-func (_ Unimplemented) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PRTimelineEventType defines model for PRTimelineEvent.Type.
+type PRTimelineEventType string
+
+// PRWarning defines model for PRWarning.
+type PRWarning struct {
+	// Code TOO_MANY_OPEN_PRS — автор превысил квоту открытых PR своей команды (см. /team/setPRQuota)
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// Получить количество назначенных OPEN PR у пользователя
-// (GET /stats/user/{user_id}/open-review-count)
-func (_ Unimplemented) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// ApprovedReviewers user_id ревьюверов, одобривших PR
+	ApprovedReviewers *[]string `json:"approved_reviewers,omitempty"`
+
+	// AssignedReviewers user_id назначенных ревьюверов (0..2 обязательных, при эскалации до 3, плюс необязательные)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+
+	// AssignmentExplanations Почему были выбраны автоматически назначенные этим запросом ревьюверы; присутствует только в ответах на создание и переназначение при explain=true
+	AssignmentExplanations *[]AssignmentExplanation `json:"assignment_explanations,omitempty"`
+	AuthorId               string                   `json:"author_id"`
+
+	// AutoMerge PR автоматически переводится в MERGED, когда все обязательные ревьюверы его одобрили
+	AutoMerge *bool `json:"auto_merge,omitempty"`
+
+	// ChangesRequestedReviewers user_id ревьюверов, запросивших изменения и ещё не одобривших PR
+	ChangesRequestedReviewers *[]string `json:"changes_requested_reviewers,omitempty"`
+
+	// Checklist Чек-лист ревью, скопированный из шаблона команды при создании PR
+	Checklist *[]ChecklistItem `json:"checklist,omitempty"`
+	ClosedAt  *time.Time       `json:"closedAt"`
+	CreatedAt *time.Time       `json:"createdAt"`
+
+	// Description Описание PR, участвует в полнотекстовом поиске
+	Description *string `json:"description,omitempty"`
+
+	// ExternalId Идентификатор PR во внешней системе (см. /pullRequest/getByExternalId)
+	ExternalId   *string `json:"external_id,omitempty"`
+	FilesChanged *int    `json:"files_changed,omitempty"`
+
+	// Labels Метки PR, по которым срабатывают правила ревью
+	Labels       *[]string  `json:"labels,omitempty"`
+	LinesChanged *int       `json:"lines_changed,omitempty"`
+	MergedAt     *time.Time `json:"mergedAt"`
+
+	// MergedBy Кто перевёл PR в MERGED (X-Actor-Id запроса); отсутствует, если это сделал сервис или актор не указан
+	MergedBy *string `json:"merged_by,omitempty"`
+
+	// OptionalReviewers user_id необязательных ревьюверов из assigned_reviewers. Их одобрения не учитываются при автослиянии и проверке обязательной роли, а эскалация и напоминания о подтверждении их не касаются
+	OptionalReviewers *[]string `json:"optional_reviewers,omitempty"`
+
+	// Priority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName string               `json:"pull_request_name"`
+
+	// ReassignedBy Кто выполнил последнее переназначение ревьюера; отсутствует, если это сделал сервис или актор не указан
+	ReassignedBy *string `json:"reassigned_by,omitempty"`
+
+	// RepositoryName Репозиторий, настройки которого определяют назначение ревьюеров
+	RepositoryName *string `json:"repository_name,omitempty"`
+
+	// RequiredSkills Навыки, которым отдаётся предпочтение при подборе ревьюеров
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// ShadowReviewers user_id теневых ревьюверов — пользователей на обучении, которые следят за ревью PR. Они не входят в assigned_reviewers, не влияют на статус PR и не учитываются в статистике ревью (см. /stats/shadow)
+	ShadowReviewers *[]string `json:"shadow_reviewers,omitempty"`
+
+	// Status DRAFT — черновик без автоматического назначения ревьюеров (см. /pullRequest/ready); OPEN — без обязательных ревьюеров; IN_REVIEW — не все обязательные ревьюеры одобрили PR; APPROVED — одобрен всеми обязательными ревьюерами; MERGED — влит; CLOSED — закрыт без слияния (см. /pullRequest/close). Между OPEN, IN_REVIEW и APPROVED сервис переводит PR сам по назначениям и вердиктам ревьюеров.
+	Status PullRequestStatus `json:"status"`
+
+	// Timeline События жизненного цикла PR от старых к новым; присутствует только при include=timeline
+	Timeline *[]PRTimelineEvent `json:"timeline,omitempty"`
+
+	// Warnings Предупреждения о созданном PR; присутствуют только в ответе на создание
+	Warnings *[]PRWarning `json:"warnings,omitempty"`
 }
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PullRequestStatus DRAFT — черновик без автоматического назначения ревьюеров (см. /pullRequest/ready); OPEN — без обязательных ревьюеров; IN_REVIEW — не все обязательные ревьюеры одобрили PR; APPROVED — одобрен всеми обязательными ревьюерами; MERGED — влит; CLOSED — закрыт без слияния (см. /pullRequest/close). Между OPEN, IN_REVIEW и APPROVED сервис переводит PR сам по назначениям и вердиктам ревьюеров.
+type PullRequestStatus string
+
+// PullRequestCreateRequest defines model for PullRequestCreateRequest.
+type PullRequestCreateRequest struct {
+	AuthorId  string `json:"author_id"`
+	AutoMerge *bool  `json:"auto_merge,omitempty"`
+
+	// Description Описание PR. Трейлеры в последнем абзаце влияют на подбор ревьюеров: `Review-Team: <команда>` подбирает их из указанной команды (вместо команды автора или репозитория, но не правила ревью), а `Reviewers: alice, @bob` назначает названных участников этой команды (по имени или user_id) в первую очередь, если они могут ревьюить PR. Неизвестные команды и имена игнорируются.
+	Description *string `json:"description,omitempty"`
+
+	// Draft Создать черновик (DRAFT); ревьюеры назначаются, когда он помечен готовым (см. /pullRequest/ready)
+	Draft *bool `json:"draft,omitempty"`
+
+	// ExternalId Уникальный идентификатор PR во внешней системе для поиска через /pullRequest/getByExternalId
+	ExternalId *string `json:"external_id,omitempty"`
+
+	// FilesChanged Число изменённых файлов
+	FilesChanged *int `json:"files_changed,omitempty"`
+
+	// Labels Метки PR; первое подходящее правило ревью (см. /reviewRule/add) меняет команду и число ревьюеров
+	Labels *[]string `json:"labels,omitempty"`
+
+	// LinesChanged Число изменённых строк; вместе с files_changed проверяется правилами size_rules команды ревьюеров
+	LinesChanged *int `json:"lines_changed,omitempty"`
+
+	// Priority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+	Priority *PullRequestPriority `json:"priority,omitempty"`
+
+	// PullRequestId Собственный идентификатор PR (например, github:org/repo#123). Если не задан, генерируется UUID. В путях вида /pullRequest/{pull_request_id} спецсимволы нужно экранировать.
+	PullRequestId   *string `json:"pull_request_id,omitempty"`
+	PullRequestName string  `json:"pull_request_name"`
+
+	// RepositoryName Репозиторий PR. Если задан, ревьюеры подбираются по его настройкам: команда и навыки из первого подходящего правила маршрутизации, иначе команда по умолчанию; их число — required_reviewers.
+	RepositoryName *string `json:"repository_name,omitempty"`
+
+	// RequiredSkills Желаемые навыки ревьюеров. Сначала выбираются пользователи с совпадающими навыками, при их нехватке — остальные участники команды.
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// TemplateId Шаблон PR команды автора (см. /prTemplate/add): его префикс добавляется к названию PR, если название с него ещё не начинается, метки — к меткам PR, а ревьюеры по умолчанию назначаются в первую очередь, если могут ревьюить PR
+	TemplateId *int64 `json:"template_id,omitempty"`
 }
 
-// Массово деактивировать команду и переназначить ревью
-// (POST /team/deactivate)
-func (_ Unimplemented) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PullRequestFilter Фильтр списка PR (см. /pullRequest/list); незаданные поля подходят под любой PR. В author_id и reviewer_id можно указать "@me" — пользователя из заголовка X-Actor-Id.
+type PullRequestFilter struct {
+	AuthorId *string `json:"author_id,omitempty"`
+	Label    *string `json:"label,omitempty"`
+
+	// MinAgeHours PR создан не меньше указанного числа часов назад
+	MinAgeHours *int `json:"min_age_hours,omitempty"`
+
+	// Priority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+	Priority       *PullRequestPriority     `json:"priority,omitempty"`
+	RepositoryName *string                  `json:"repository_name,omitempty"`
+	ReviewerId     *string                  `json:"reviewer_id,omitempty"`
+	Status         *PullRequestFilterStatus `json:"status,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName *string `json:"team_name,omitempty"`
+
+	// Unreviewed Только PR без вердикта (approve или request changes) от reviewer_id, а если reviewer_id не задан — ни от одного ревьюера
+	Unreviewed *bool `json:"unreviewed,omitempty"`
 }
 
-// Изменить имя команды
-// (POST /team/edit)
-func (_ Unimplemented) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PullRequestFilterStatus defines model for PullRequestFilter.Status.
+type PullRequestFilterStatus string
+
+// PullRequestListResponse defines model for PullRequestListResponse.
+type PullRequestListResponse struct {
+	Limit        int                `json:"limit"`
+	Offset       int                `json:"offset"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+
+	// Total Общее число подходящих PR без учёта пагинации
+	Total int `json:"total"`
+}
+
+// PullRequestPriority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+type PullRequestPriority string
+
+// PullRequestSearchHit defines model for PullRequestSearchHit.
+type PullRequestSearchHit struct {
+	AuthorId        string  `json:"author_id"`
+	Description     *string `json:"description,omitempty"`
+	PullRequestId   string  `json:"pull_request_id"`
+	PullRequestName string  `json:"pull_request_name"`
+
+	// Rank Релевантность совпадения, результаты отсортированы по убыванию
+	Rank   float32                    `json:"rank"`
+	Status PullRequestSearchHitStatus `json:"status"`
+}
+
+// PullRequestSearchHitStatus defines model for PullRequestSearchHit.Status.
+type PullRequestSearchHitStatus string
+
+// PullRequestSearchResponse defines model for PullRequestSearchResponse.
+type PullRequestSearchResponse struct {
+	Limit        int                    `json:"limit"`
+	Offset       int                    `json:"offset"`
+	PullRequests []PullRequestSearchHit `json:"pull_requests"`
+
+	// Total Общее число найденных PR без учёта пагинации
+	Total int `json:"total"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId string `json:"author_id"`
+
+	// Priority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+	Priority        *PullRequestPriority   `json:"priority,omitempty"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	// Degraded Имена недоступных необязательных зависимостей, работающих в деградированном режиме
+	Degraded     []string                `json:"degraded"`
+	Dependencies []DependencyStatus      `json:"dependencies"`
+	Status       ReadinessResponseStatus `json:"status"`
+}
+
+// ReadinessResponseStatus defines model for ReadinessResponse.Status.
+type ReadinessResponseStatus string
+
+// ReasonCount defines model for ReasonCount.
+type ReasonCount struct {
+	Count int `json:"count"`
+
+	// Reason Причина снятия ревьювера с PR: deactivation — деактивация пользователя или команды, manual — /pullRequest/reassign, handoff — /pullRequest/reassignAll, unacked — назначение не подтверждено вовремя, rebalance — выравнивание нагрузки команды, team_move — переход ревьювера в другую команду, inactive — ревьювер слишком долго ничего не делал с ревью.
+	Reason ReassignmentReason `json:"reason"`
+}
+
+// ReassignAllResponse defines model for ReassignAllResponse.
+type ReassignAllResponse struct {
+	// MovedCount Сколько ревью передано
+	MovedCount int             `json:"moved_count"`
+	Moves      []RebalanceMove `json:"moves"`
+}
+
+// ReassignmentGroup defines model for ReassignmentGroup.
+type ReassignmentGroup struct {
+	// DeclineReasons Причины отказа по убыванию частоты; учитываются только переназначения с указанной причиной
+	DeclineReasons []DeclineReasonCount `json:"decline_reasons"`
+
+	// Reasons Причины по убыванию частоты
+	Reasons  []ReasonCount `json:"reasons"`
+	TeamName string        `json:"team_name"`
+	Total    int           `json:"total"`
+
+	// UserId Снятый ревьювер; только в списке users
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// ReassignmentReason Причина снятия ревьювера с PR: deactivation — деактивация пользователя или команды, manual — /pullRequest/reassign, handoff — /pullRequest/reassignAll, unacked — назначение не подтверждено вовремя, rebalance — выравнивание нагрузки команды, team_move — переход ревьювера в другую команду, inactive — ревьювер слишком долго ничего не делал с ревью.
+type ReassignmentReason string
+
+// ReassignmentStatsResponse defines model for ReassignmentStatsResponse.
+type ReassignmentStatsResponse struct {
+	// DeclineReasons Причины отказа по убыванию частоты; учитываются только переназначения с указанной причиной
+	DeclineReasons []DeclineReasonCount `json:"decline_reasons"`
+
+	// Reasons Причины по убыванию частоты
+	Reasons []ReasonCount `json:"reasons"`
+
+	// Teams Команды по убыванию числа переназначений
+	Teams []ReassignmentGroup `json:"teams"`
+	Total int                 `json:"total"`
+
+	// Users Снятые ревьюверы по убыванию числа переназначений
+	Users       []ReassignmentGroup `json:"users"`
+	WindowEnd   time.Time           `json:"window_end"`
+	WindowStart time.Time           `json:"window_start"`
+}
+
+// RebalanceMove defines model for RebalanceMove.
+type RebalanceMove struct {
+	FromUserId    string `json:"from_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+	ToUserId      string `json:"to_user_id"`
+}
+
+// RebalanceRequest defines model for RebalanceRequest.
+type RebalanceRequest struct {
+	TeamName string `json:"team_name"`
+}
+
+// RebalanceResponse defines model for RebalanceResponse.
+type RebalanceResponse struct {
+	LoadAfter  []UserLoad      `json:"load_after"`
+	LoadBefore []UserLoad      `json:"load_before"`
+	Moves      []RebalanceMove `json:"moves"`
+	TeamName   string          `json:"team_name"`
+}
+
+// ReportPR defines model for ReportPR.
+type ReportPR struct {
+	// At Когда PR был смёржен или впервые эскалирован
+	At              time.Time `json:"at"`
+	AuthorId        string    `json:"author_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DefaultTeamName Команда ревьюеров по умолчанию; если не задана, используется команда автора
+	DefaultTeamName *string `json:"default_team_name,omitempty"`
+	RepositoryName  string  `json:"repository_name"`
+
+	// RequiredReviewers Сколько ревьюеров назначается на новый PR; если не задано — 2
+	RequiredReviewers *int `json:"required_reviewers,omitempty"`
+
+	// RoutingRules Правила проверяются по порядку, применяется первое подходящее
+	RoutingRules *[]RoutingRule `json:"routing_rules,omitempty"`
+}
+
+// RepositoryStatsResponse defines model for RepositoryStatsResponse.
+type RepositoryStatsResponse struct {
+	// AvgReviewersPerPr Среднее число назначенных ревьюеров на PR
+	AvgReviewersPerPr float64 `json:"avg_reviewers_per_pr"`
+
+	// AvgTimeToMergeSeconds Среднее время от создания PR до merge; отсутствует, пока нет влитых PR
+	AvgTimeToMergeSeconds *float64 `json:"avg_time_to_merge_seconds,omitempty"`
+
+	// MedianTimeToMergeSeconds Медиана времени от создания PR до merge; отсутствует, пока нет влитых PR
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds,omitempty"`
+	MergedPrs                int      `json:"merged_prs"`
+	OpenPrs                  int      `json:"open_prs"`
+	RepositoryName           string   `json:"repository_name"`
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ReviewBudgetMember defines model for ReviewBudgetMember.
+type ReviewBudgetMember struct {
+	IsActive bool `json:"is_active"`
+
+	// Remaining Сколько ревью он ещё может получить до конца спринта
+	Remaining int `json:"remaining"`
+
+	// Used Сколько ревью пользователь получил в текущем спринте
+	Used     int    `json:"used"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ReviewRotationWeek defines model for ReviewRotationWeek.
+type ReviewRotationWeek struct {
+	// Overridden Дежурный назначен вручную вместо очередного по ротации
+	Overridden bool `json:"overridden"`
+
+	// UserId Дежурный ревьюер; отсутствует для недель до начала ротации
+	UserId   *string `json:"user_id,omitempty"`
+	Username *string `json:"username,omitempty"`
+
+	// WeekStart Понедельник недели (UTC)
+	WeekStart openapi_types.Date `json:"week_start"`
+}
+
+// ReviewRule Правило ревью: если у PR есть любая из labels или он относится к любому из repositories, ревьюеры подбираются из team_name, их число — reviewers, а required_skills добавляются к навыкам PR. Незаданные действия оставляют то, что PR получил бы без правила. Правило применяется после настроек репозитория и заменяет их; size_rules команды по-прежнему могут уменьшить число ревьюеров. Включённые правила проверяются по возрастанию position (затем по имени), применяется первое подходящее.
+type ReviewRule struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Enabled   *bool      `json:"enabled,omitempty"`
+	Labels    *[]string  `json:"labels,omitempty"`
+	Position  *int       `json:"position,omitempty"`
+
+	// Repositories Имена настроенных репозиториев (см. /repository/add)
+	Repositories   *[]string `json:"repositories,omitempty"`
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+	Reviewers      *int      `json:"reviewers,omitempty"`
+	RuleName       string    `json:"rule_name"`
+	TeamName       *string   `json:"team_name,omitempty"`
+}
+
+// ReviewRuleDryRunRequest defines model for ReviewRuleDryRunRequest.
+type ReviewRuleDryRunRequest struct {
+	// PullRequest Пример PR; сохраняться он не будет
+	PullRequest struct {
+		AuthorId        string    `json:"author_id"`
+		FilesChanged    *int      `json:"files_changed,omitempty"`
+		Labels          *[]string `json:"labels,omitempty"`
+		LinesChanged    *int      `json:"lines_changed,omitempty"`
+		PullRequestName *string   `json:"pull_request_name,omitempty"`
+		RepositoryName  *string   `json:"repository_name,omitempty"`
+		RequiredSkills  *[]string `json:"required_skills,omitempty"`
+	} `json:"pull_request"`
+
+	// Rule Правило ревью: если у PR есть любая из labels или он относится к любому из repositories, ревьюеры подбираются из team_name, их число — reviewers, а required_skills добавляются к навыкам PR. Незаданные действия оставляют то, что PR получил бы без правила. Правило применяется после настроек репозитория и заменяет их; size_rules команды по-прежнему могут уменьшить число ревьюеров. Включённые правила проверяются по возрастанию position (затем по имени), применяется первое подходящее.
+	Rule *ReviewRule `json:"rule,omitempty"`
+}
+
+// ReviewRuleDryRunResult defines model for ReviewRuleDryRunResult.
+type ReviewRuleDryRunResult struct {
+	RequiredSkills []string `json:"required_skills"`
+
+	// Reviewers Сколько ревьюеров было бы назначено
+	Reviewers int `json:"reviewers"`
+
+	// RuleName Сработавшее правило; отсутствует, если ни одно не подошло
+	RuleName *string `json:"rule_name,omitempty"`
+
+	// TeamName Команда, из которой были бы подобраны ревьюеры
+	TeamName string `json:"team_name"`
+}
+
+// ReviewerPreference defines model for ReviewerPreference.
+type ReviewerPreference struct {
+	AuthorId string `json:"author_id"`
+
+	// ReviewerId Участник команды, которому отдаётся приоритет при ревью PR автора
+	ReviewerId string `json:"reviewer_id"`
+
+	// Weight Чем больше вес, тем раньше ревьюер выбирается среди доступных кандидатов
+	Weight int `json:"weight"`
+}
+
+// ReviewerSuggestion defines model for ReviewerSuggestion.
+type ReviewerSuggestion struct {
+	// InCooldown Пользователь ревьюил недавние PR автора и выбирается автоматически в последнюю очередь
+	InCooldown bool `json:"in_cooldown"`
+
+	// LoadScore От 1 (нет открытых ревью) к 0 (достигнуто ограничение REVIEWER_MAX_OPEN_REVIEWS); без ограничения — 1 / (1 + open_reviews)
+	LoadScore     float64  `json:"load_score"`
+	MatchedSkills []string `json:"matched_skills"`
+
+	// OnRotation Пользователь дежурит по ревью на этой неделе и выбирается раньше остальных
+	OnRotation  bool `json:"on_rotation"`
+	OpenReviews int  `json:"open_reviews"`
+
+	// OverCapacity Пользователь достиг ограничения на открытые ревью или исчерпал бюджет ревью на спринт и автоматически не выбирается
+	OverCapacity bool `json:"over_capacity"`
+
+	// PreferenceWeight Вес предпочтения автора для этого ревьювера; 0 — предпочтения нет
+	PreferenceWeight int `json:"preference_weight"`
+
+	// SkillMatchScore Доля навыков PR, которые есть у пользователя; 1, если PR не требует навыков
+	SkillMatchScore float64 `json:"skill_match_score"`
+	UserId          string  `json:"user_id"`
+	Username        string  `json:"username"`
+}
+
+// ReviewerSuggestionsResponse defines model for ReviewerSuggestionsResponse.
+type ReviewerSuggestionsResponse struct {
+	PullRequestId string               `json:"pull_request_id"`
+	Suggestions   []ReviewerSuggestion `json:"suggestions"`
+}
+
+// ReviewerTurnaroundResponse defines model for ReviewerTurnaroundResponse.
+type ReviewerTurnaroundResponse struct {
+	// AvgTurnaroundSeconds Среднее время от назначения до первого решения; отсутствует, пока решений нет
+	AvgTurnaroundSeconds *float64 `json:"avg_turnaround_seconds,omitempty"`
+
+	// MedianTurnaroundSeconds Медиана времени от назначения до первого решения; отсутствует, пока решений нет
+	MedianTurnaroundSeconds *float64 `json:"median_turnaround_seconds,omitempty"`
+
+	// PendingCount Назначения на открытые PR, ещё ожидающие решения ревьювера
+	PendingCount int `json:"pending_count"`
+
+	// ReviewedCount Назначения, по которым ревьювер одобрил PR или запросил изменения
+	ReviewedCount int       `json:"reviewed_count"`
+	UserId        string    `json:"user_id"`
+	WindowEnd     time.Time `json:"window_end"`
+	WindowStart   time.Time `json:"window_start"`
+}
+
+// RoutingRule defines model for RoutingRule.
+type RoutingRule struct {
+	// RequiredSkills Навыки, добавляемые к required_skills PR
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// TeamName Команда, из которой подбираются ревьюеры; по умолчанию — как без правила
+	TeamName *string `json:"team_name,omitempty"`
+
+	// TitlePrefix Префикс названия PR (без учёта регистра), например "docs:"
+	TitlePrefix string `json:"title_prefix"`
+}
+
+// SavedFilter Именованный фильтр списка PR. Владелец — пользователь user_id или команда team_name (задаётся ровно одно из полей и не меняется при редактировании).
+type SavedFilter struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Filter Фильтр списка PR (см. /pullRequest/list); незаданные поля подходят под любой PR. В author_id и reviewer_id можно указать "@me" — пользователя из заголовка X-Actor-Id.
+	Filter    PullRequestFilter `json:"filter"`
+	FilterId  *int64            `json:"filter_id,omitempty"`
+	Name      string            `json:"name"`
+	TeamName  *string           `json:"team_name,omitempty"`
+	UpdatedAt *time.Time        `json:"updated_at,omitempty"`
+	UserId    *string           `json:"user_id,omitempty"`
+}
+
+// ServiceLatencyStats defines model for ServiceLatencyStats.
+type ServiceLatencyStats struct {
+	// ClientErrors Ответы 4xx
+	ClientErrors int64 `json:"client_errors"`
+
+	// ErrorRate Доля ответов 5xx
+	ErrorRate float64 `json:"error_rate"`
+	P50Ms     float64 `json:"p50_ms"`
+	P95Ms     float64 `json:"p95_ms"`
+	P99Ms     float64 `json:"p99_ms"`
+	Requests  int64   `json:"requests"`
+
+	// ServerErrors Ответы 5xx
+	ServerErrors int64 `json:"server_errors"`
+}
+
+// ServiceStatsResponse defines model for ServiceStatsResponse.
+type ServiceStatsResponse struct {
+	// Endpoints Эндпоинты по шаблону пути, затем по методу
+	Endpoints     []EndpointLatencyStats `json:"endpoints"`
+	StartedAt     time.Time              `json:"started_at"`
+	Total         ServiceLatencyStats    `json:"total"`
+	UptimeSeconds float64                `json:"uptime_seconds"`
+}
+
+// SetTeamInactiveReassignRequest defines model for SetTeamInactiveReassignRequest.
+type SetTeamInactiveReassignRequest struct {
+	// Enabled false отключает переназначение неактивных ревьюеров для команды
+	Enabled  bool   `json:"enabled"`
+	TeamName string `json:"team_name"`
+}
+
+// SetTeamPRQuotaRequest defines model for SetTeamPRQuotaRequest.
+type SetTeamPRQuotaRequest struct {
+	// MaxOpenPrs 0 снимает квоту
+	MaxOpenPrs int `json:"max_open_prs"`
+
+	// Mode warn — PR сверх квоты создаётся с предупреждением TOO_MANY_OPEN_PRS; block — создание отклоняется с кодом TOO_MANY_OPEN_PRS
+	Mode     *PRQuotaMode `json:"mode,omitempty"`
+	TeamName string       `json:"team_name"`
+}
+
+// SetTeamReportScheduleRequest defines model for SetTeamReportScheduleRequest.
+type SetTeamReportScheduleRequest struct {
+	// Enabled false отключает отчёты команды
+	Enabled bool `json:"enabled"`
+
+	// Hour Час отправки в часовом поясе timezone
+	Hour     *int   `json:"hour,omitempty"`
+	TeamName string `json:"team_name"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone *string  `json:"timezone,omitempty"`
+	Weekday  *Weekday `json:"weekday,omitempty"`
+}
+
+// SetTeamReviewBudgetRequest defines model for SetTeamReviewBudgetRequest.
+type SetTeamReviewBudgetRequest struct {
+	// ReviewsPerSprint Сколько ревью может получить каждый участник команды за спринт; 0 удаляет бюджет
+	ReviewsPerSprint int `json:"reviews_per_sprint"`
+
+	// SprintDays Длина спринта в днях
+	SprintDays *int   `json:"sprint_days,omitempty"`
+	TeamName   string `json:"team_name"`
+}
+
+// SetTeamReviewRotationRequest defines model for SetTeamReviewRotationRequest.
+type SetTeamReviewRotationRequest struct {
+	// MemberIds Участники команды в порядке дежурства; пустой список удаляет ротацию вместе с заменами
+	MemberIds []string `json:"member_ids"`
+
+	// StartDate Дата в первой неделе ротации; по умолчанию текущая неделя
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+	TeamName  string              `json:"team_name"`
+}
+
+// SetTeamReviewerPoolRequest defines model for SetTeamReviewerPoolRequest.
+type SetTeamReviewerPoolRequest struct {
+	// UserIds Участники команды; пустой список удаляет пул
+	UserIds []string `json:"user_ids"`
+}
+
+// Settings Значения настроек по умолчанию для всей организации или действующие для команды
+type Settings struct {
+	Features SettingsFeatures `json:"features"`
+
+	// MaxOpenReviews Сколько открытых ревью может быть у пользователя, чтобы получить новый обычный PR (0 — без ограничения)
+	MaxOpenReviews int `json:"max_open_reviews"`
+
+	// MaxReviewers Число обязательных ревьюеров PR, если репозиторий или правило ревью не задают другое
+	MaxReviewers int `json:"max_reviewers"`
+
+	// ReviewSlaHours Через сколько часов ожидающее ревью помечается в сводке как просроченное
+	ReviewSlaHours int `json:"review_sla_hours"`
+
+	// SelectionStrategy Как выбирать между одинаково подходящими кандидатами: случайно (`random`), сначала тех, у кого меньше открытых ревью (`least_loaded`), или случайно с весом 1/(1 + число открытых ревью) (`weighted_random`). Случайный выбор воспроизводим: он определяется идентификатором PR.
+	SelectionStrategy SettingsSelectionStrategy `json:"selection_strategy"`
+}
+
+// SettingsSelectionStrategy Как выбирать между одинаково подходящими кандидатами: случайно (`random`), сначала тех, у кого меньше открытых ревью (`least_loaded`), или случайно с весом 1/(1 + число открытых ревью) (`weighted_random`). Случайный выбор воспроизводим: он определяется идентификатором PR.
+type SettingsSelectionStrategy string
+
+// SettingsFeatures defines model for SettingsFeatures.
+type SettingsFeatures struct {
+	// AutoMerge Разрешён ли автоматический merge одобренных PR
+	AutoMerge bool `json:"auto_merge"`
+
+	// ShadowReviews Назначаются ли теневые ревьюеры по доле `shadow_review_percent` команды
+	ShadowReviews bool `json:"shadow_reviews"`
+}
+
+// SettingsFeaturesOverride defines model for SettingsFeaturesOverride.
+type SettingsFeaturesOverride struct {
+	AutoMerge     *bool `json:"auto_merge,omitempty"`
+	ShadowReviews *bool `json:"shadow_reviews,omitempty"`
+}
+
+// SettingsOverride Переопределения настроек команды; отсутствующие поля наследуются от организации
+type SettingsOverride struct {
+	Features          *SettingsFeaturesOverride          `json:"features,omitempty"`
+	MaxOpenReviews    *int                               `json:"max_open_reviews,omitempty"`
+	MaxReviewers      *int                               `json:"max_reviewers,omitempty"`
+	ReviewSlaHours    *int                               `json:"review_sla_hours,omitempty"`
+	SelectionStrategy *SettingsOverrideSelectionStrategy `json:"selection_strategy,omitempty"`
+}
+
+// SettingsOverrideSelectionStrategy defines model for SettingsOverride.SelectionStrategy.
+type SettingsOverrideSelectionStrategy string
+
+// ShadowReviewCount defines model for ShadowReviewCount.
+type ShadowReviewCount struct {
+	// InTraining На обучении ли пользователь сейчас
+	InTraining bool `json:"in_training"`
+
+	// ShadowReviewCount Сколько PR пользователь получил как теневой ревьювер за окно
+	ShadowReviewCount int    `json:"shadow_review_count"`
+	TeamName          string `json:"team_name"`
+	UserId            string `json:"user_id"`
+	Username          string `json:"username"`
+}
+
+// ShadowReviewStatsResponse defines model for ShadowReviewStatsResponse.
+type ShadowReviewStatsResponse struct {
+	// Total Сколько теневых ревью назначено за окно
+	Total int `json:"total"`
+
+	// Users Пользователи на обучении и все, кто получал теневые ревью за окно, по командам, затем по убыванию числа теневых ревью
+	Users       []ShadowReviewCount `json:"users"`
+	WindowEnd   time.Time           `json:"window_end"`
+	WindowStart time.Time           `json:"window_start"`
+}
+
+// SizeRule Правило числа ревьюеров по размеру PR. Правила проверяются по порядку; первое, в границы которого укладывается PR, задаёт число ревьюеров, но не больше обычного (2 или required_reviewers репозитория). Незаданная граница подходит любому PR; заданная не подходит PR, размер которого по ней неизвестен. PR без lines_changed и files_changed правилами не проверяются.
+type SizeRule struct {
+	MaxFilesChanged *int `json:"max_files_changed,omitempty"`
+	MaxLinesChanged *int `json:"max_lines_changed,omitempty"`
+	Reviewers       int  `json:"reviewers"`
+}
+
+// SlackAccount defines model for SlackAccount.
+type SlackAccount struct {
+	// SlackUserId ID пользователя в Slack (например, U024BE7LH)
+	SlackUserId string `json:"slack_user_id"`
+	UserId      string `json:"user_id"`
+}
+
+// StatItem defines model for StatItem.
+type StatItem struct {
+	// AckedCount Сколько назначений пользователь подтвердил
+	AckedCount *int64 `json:"acked_count,omitempty"`
+
+	// AvgAckLatencySeconds Среднее время от назначения до подтверждения, в секундах
+	AvgAckLatencySeconds *float64 `json:"avg_ack_latency_seconds,omitempty"`
+	ReviewCount          *int64   `json:"review_count,omitempty"`
+	UserId               *string  `json:"user_id,omitempty"`
+	Username             *string  `json:"username,omitempty"`
+}
+
+// StatsResponse defines model for StatsResponse.
+type StatsResponse struct {
+	ReviewStats *[]StatItem `json:"review_stats,omitempty"`
+
+	// Total Общее число пользователей с ревью без учёта пагинации
+	Total *int `json:"total,omitempty"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// AllowDuplicateUsernames Разрешить нескольким участникам команды одно и то же имя (режим совместимости). По умолчанию имена участников уникальны в пределах команды.
+	AllowDuplicateUsernames *bool `json:"allow_duplicate_usernames,omitempty"`
+
+	// AllowSelfReview Разрешено ли назначать автора ревьюером собственного PR (см. /team/edit); отсутствует, если запрещено
+	AllowSelfReview *bool `json:"allow_self_review,omitempty"`
+
+	// Checklist Шаблон чек-листа ревью (см. /team/edit); отсутствует, если не задан
+	Checklist *ChecklistTemplate `json:"checklist,omitempty"`
+
+	// DeactivateAt Запланированное время деактивации команды (см. /team/deactivate)
+	DeactivateAt *time.Time `json:"deactivate_at,omitempty"`
+
+	// Escalation Эскалация PR команды, по которым нет активности ревьюеров (ни одного одобрения). Задаётся через /team/edit; 0 часов отключает шаг.
+	Escalation *EscalationPolicy `json:"escalation,omitempty"`
+	Members    []TeamMember      `json:"members"`
+
+	// OptionalReviewers Число необязательных ревьюеров новых PR (см. /team/setReviewerRequirements); отсутствует, если 0
+	OptionalReviewers *int `json:"optional_reviewers,omitempty"`
+
+	// ReviewCooldownPrs Период «остывания» ревьюеров (см. /team/edit); отсутствует, если выключен
+	ReviewCooldownPrs *int `json:"review_cooldown_prs,omitempty"`
+
+	// ShadowReviewPercent Доля новых PR (в процентах), получающих теневого ревьюера (см. /team/setReviewerRequirements); отсутствует, если 0
+	ShadowReviewPercent *int `json:"shadow_review_percent,omitempty"`
+
+	// SizeRules Правила числа ревьюеров по размеру PR (см. /team/edit)
+	SizeRules *[]SizeRule `json:"size_rules,omitempty"`
+	TeamName  string      `json:"team_name"`
+}
+
+// TeamCapacity defines model for TeamCapacity.
+type TeamCapacity struct {
+	// AvailableCount Сколько участников может получить ещё одно ревью
+	AvailableCount int `json:"available_count"`
+
+	// Capacity Ограничение на число открытых ревью (REVIEWER_MAX_OPEN_REVIEWS); отсутствует, если ограничения нет
+	Capacity *int `json:"capacity,omitempty"`
+
+	// Members Сначала участники, которые могут получить ревью, от наименее загруженных
+	Members  []UserWorkload `json:"members"`
+	TeamName string         `json:"team_name"`
+}
+
+// TeamDeactivateRequest defines model for TeamDeactivateRequest.
+type TeamDeactivateRequest struct {
+	// EffectiveAt Когда деактивировать команду. Время в будущем планирует деактивацию (повторный вызов переносит её), отсутствующее или прошедшее время деактивирует сразу.
+	EffectiveAt *time.Time `json:"effective_at,omitempty"`
+	TeamName    string     `json:"team_name"`
+}
+
+// TeamDeactivateResponse defines model for TeamDeactivateResponse.
+type TeamDeactivateResponse struct {
+	DeactivatedUsersCount  *int `json:"deactivated_users_count,omitempty"`
+	ReassignedReviewsCount *int `json:"reassigned_reviews_count,omitempty"`
+
+	// ScheduledAt Время запланированной деактивации, если она отложена
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Gini Коэффициент Джини (0 — ревью распределены поровну)
+	Gini float64 `json:"gini"`
+
+	// MaxMinRatio Отношение максимума к минимуму; отсутствует, если у кого-то ноль ревью
+	MaxMinRatio *float64 `json:"max_min_ratio,omitempty"`
+	MaxReviews  int      `json:"max_reviews"`
+	Mean        float64  `json:"mean"`
+
+	// Members Число активных участников команды
+	Members    int `json:"members"`
+	MinReviews int `json:"min_reviews"`
+
+	// ReviewCounts Число назначенных ревью по user_id
+	ReviewCounts map[string]int `json:"review_counts"`
+
+	// Stddev Стандартное отклонение числа ревью на участника
+	Stddev       float64 `json:"stddev"`
+	TeamName     string  `json:"team_name"`
+	TotalReviews int     `json:"total_reviews"`
+}
+
+// TeamHierarchy defines model for TeamHierarchy.
+type TeamHierarchy struct {
+	// Children Имена дочерних команд
+	Children         []string `json:"children"`
+	EscalateToParent bool     `json:"escalate_to_parent"`
+	ParentTeamName   *string  `json:"parent_team_name"`
+	TeamName         string   `json:"team_name"`
+}
+
+// TeamInactiveReassign defines model for TeamInactiveReassign.
+type TeamInactiveReassign struct {
+	// Enabled Переназначаются ли неактивные ревьюеры команды
+	Enabled  bool   `json:"enabled"`
+	TeamName string `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive *bool  `json:"is_active,omitempty"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// TeamPRQuota defines model for TeamPRQuota.
+type TeamPRQuota struct {
+	// MaxOpenPrs Сколько PR (включая черновики) может быть открыто у каждого участника; 0 — квоты нет
+	MaxOpenPrs int `json:"max_open_prs"`
+
+	// Mode warn — PR сверх квоты создаётся с предупреждением TOO_MANY_OPEN_PRS; block — создание отклоняется с кодом TOO_MANY_OPEN_PRS
+	Mode     *PRQuotaMode `json:"mode,omitempty"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamReport defines model for TeamReport.
+type TeamReport struct {
+	// AvgTurnaroundSeconds Среднее время от создания PR до мержа; отсутствует, если ничего не смёржено
+	AvgTurnaroundSeconds *float64     `json:"avg_turnaround_seconds,omitempty"`
+	Fairness             TeamFairness `json:"fairness"`
+
+	// MergedPrs PR участников команды, смёрженные за неделю (включая архивные)
+	MergedPrs []ReportPR `json:"merged_prs"`
+
+	// SlaBreaches PR, эскалированные за неделю из-за отсутствия активности ревьюеров
+	SlaBreaches []ReportPR `json:"sla_breaches"`
+	TeamName    string     `json:"team_name"`
+	WindowEnd   time.Time  `json:"window_end"`
+	WindowStart time.Time  `json:"window_start"`
+}
+
+// TeamReportSchedule defines model for TeamReportSchedule.
+type TeamReportSchedule struct {
+	Enabled bool `json:"enabled"`
+	Hour    *int `json:"hour,omitempty"`
+
+	// LastSentAt Когда был отправлен последний отчёт; отсутствует, если ещё не отправлялся
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	TeamName   string     `json:"team_name"`
+	Timezone   *string    `json:"timezone,omitempty"`
+	Weekday    *Weekday   `json:"weekday,omitempty"`
+}
+
+// TeamReviewBudget defines model for TeamReviewBudget.
+type TeamReviewBudget struct {
+	// Members Сначала участники с наибольшим остатком; пусто, если бюджета нет
+	Members []ReviewBudgetMember `json:"members"`
+
+	// ReviewsPerSprint Бюджет каждого участника на спринт; 0 — бюджета нет
+	ReviewsPerSprint int `json:"reviews_per_sprint"`
+
+	// SprintDays Отсутствует, если бюджета нет
+	SprintDays *int `json:"sprint_days,omitempty"`
+
+	// SprintEndsAt Когда начнётся следующий спринт и использованные ревью обнулятся; отсутствует, если бюджета нет
+	SprintEndsAt *time.Time `json:"sprint_ends_at,omitempty"`
+
+	// SprintStartedAt Начало текущего спринта; отсутствует, если бюджета нет
+	SprintStartedAt *time.Time `json:"sprint_started_at,omitempty"`
+	TeamName        string     `json:"team_name"`
+
+	// Total Общий бюджет команды — сумма бюджетов активных участников
+	Total int `json:"total"`
+
+	// Used Сколько ревью из общего бюджета использовали активные участники
+	Used int `json:"used"`
+}
+
+// TeamReviewRotation defines model for TeamReviewRotation.
+type TeamReviewRotation struct {
+	// MemberIds Участники ротации в порядке дежурства; пусто, если ротации нет
+	MemberIds []string `json:"member_ids"`
+
+	// StartDate Понедельник первой недели ротации; отсутствует, если ротации нет
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+	TeamName  string              `json:"team_name"`
+
+	// Weeks Дежурные ближайших недель, начиная с текущей
+	Weeks []ReviewRotationWeek `json:"weeks"`
+}
+
+// TeamReviewerPool defines model for TeamReviewerPool.
+type TeamReviewerPool struct {
+	// Members Участники, из которых подбираются ревьюеры; пусто, если подбираются из всех участников
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamReviewerPreferences defines model for TeamReviewerPreferences.
+type TeamReviewerPreferences struct {
+	Preferences []ReviewerPreference `json:"preferences"`
+	TeamName    string               `json:"team_name"`
+}
+
+// TeamReviewerRequirements defines model for TeamReviewerRequirements.
+type TeamReviewerRequirements struct {
+	// OptionalReviewers Сколько необязательных ревьюеров назначается новым PR сверх обязательных. Если не передано, значение не меняется
+	OptionalReviewers *int `json:"optional_reviewers,omitempty"`
+
+	// RequiredReviewerRole Роль, которая должна быть хотя бы у одного обязательного ревьюера PR; пустая строка снимает требование
+	RequiredReviewerRole string `json:"required_reviewer_role"`
+
+	// ShadowReviewPercent Доля новых PR (в процентах), которым назначается теневой ревьювер из участников команды на обучении. Если не передано, значение не меняется
+	ShadowReviewPercent *int   `json:"shadow_review_percent,omitempty"`
+	TeamName            string `json:"team_name"`
+}
+
+// TeamSetParentRequest defines model for TeamSetParentRequest.
+type TeamSetParentRequest struct {
+	// EscalateToParent Искать ревьюеров в родительской команде, если в самой команде нет кандидатов
+	EscalateToParent *bool `json:"escalate_to_parent,omitempty"`
+
+	// ParentTeamName Имя родительской команды; пустое значение или null отвязывает команду от родителя
+	ParentTeamName *string `json:"parent_team_name"`
+	TeamName       string  `json:"team_name"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// Effective Значения настроек по умолчанию для всей организации или действующие для команды
+	Effective Settings `json:"effective"`
+
+	// Overrides Переопределения настроек команды; отсутствующие поля наследуются от организации
+	Overrides SettingsOverride `json:"overrides"`
+	TeamName  string           `json:"team_name"`
+
+	// UpdatedAt Когда переопределения менялись в последний раз; нет, если их нет
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TeamShort defines model for TeamShort.
+type TeamShort struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+}
+
+// TeamSnapshot Команда с участниками и настройками для переноса в другую установку сервиса (см. /team/{team_name}/export и /team/import). Родительская команда, PR и запланированная деактивация в снимок не входят.
+type TeamSnapshot struct {
+	AllowDuplicateUsernames *bool `json:"allow_duplicate_usernames,omitempty"`
+	AllowSelfReview         *bool `json:"allow_self_review,omitempty"`
+
+	// Checklist Пункты чек-листа, которые копируются в каждый новый PR, ревьюеры которого подбираются из команды. Изменение шаблона не затрагивает уже созданные PR.
+	Checklist *ChecklistTemplate `json:"checklist,omitempty"`
+
+	// Escalation Эскалация PR команды, по которым нет активности ревьюеров (ни одного одобрения). Задаётся через /team/edit; 0 часов отключает шаг.
+	Escalation *EscalationPolicy `json:"escalation,omitempty"`
+
+	// InactiveReassignOptOut Команда отказалась от переназначения неактивных ревьюеров
+	InactiveReassignOptOut *bool                `json:"inactive_reassign_opt_out,omitempty"`
+	Members                []TeamSnapshotMember `json:"members"`
+	OptionalReviewers      *int                 `json:"optional_reviewers,omitempty"`
+	PrQuota                *TeamSnapshotPRQuota `json:"pr_quota,omitempty"`
+
+	// PrTemplates Шаблоны PR команды; при загрузке team_name шаблонов заменяется на команду снимка
+	PrTemplates          *[]PRTemplate               `json:"pr_templates,omitempty"`
+	ReportSchedule       *TeamSnapshotReportSchedule `json:"report_schedule,omitempty"`
+	RequiredReviewerRole *string                     `json:"required_reviewer_role,omitempty"`
+
+	// ReviewBudget Бюджет ревью команды; после загрузки первый спринт начинается заново
+	ReviewBudget      *TeamSnapshotReviewBudget `json:"review_budget,omitempty"`
+	ReviewCooldownPrs *int                      `json:"review_cooldown_prs,omitempty"`
+
+	// ReviewRotation Ротация дежурных ревьюеров без ручных замен отдельных недель
+	ReviewRotation *TeamSnapshotReviewRotation `json:"review_rotation,omitempty"`
+
+	// ReviewRules Правила, подбирающие ревьюеров из команды. При загрузке team_name правил заменяется на команду снимка, а их repositories должны быть уже настроены
+	ReviewRules *[]ReviewRule `json:"review_rules,omitempty"`
+
+	// ReviewerPool ID участников пула ревьюеров; отсутствует, если пул не задан
+	ReviewerPool *[]string `json:"reviewer_pool,omitempty"`
+
+	// ReviewerPreferences Предпочтения между участниками команды; предпочтения авторов из других команд не выгружаются
+	ReviewerPreferences *[]ReviewerPreference `json:"reviewer_preferences,omitempty"`
+	ShadowReviewPercent *int                  `json:"shadow_review_percent,omitempty"`
+	SizeRules           *[]SizeRule           `json:"size_rules,omitempty"`
+	TeamName            string                `json:"team_name"`
+}
+
+// TeamSnapshotMember defines model for TeamSnapshotMember.
+type TeamSnapshotMember struct {
+	InTraining *bool     `json:"in_training,omitempty"`
+	IsActive   bool      `json:"is_active"`
+	Role       *string   `json:"role,omitempty"`
+	Skills     *[]string `json:"skills,omitempty"`
+	UserId     string    `json:"user_id"`
+	Username   string    `json:"username"`
+}
+
+// TeamSnapshotPRQuota defines model for TeamSnapshotPRQuota.
+type TeamSnapshotPRQuota struct {
+	MaxOpenPrs int `json:"max_open_prs"`
+
+	// Mode warn — PR сверх квоты создаётся с предупреждением TOO_MANY_OPEN_PRS; block — создание отклоняется с кодом TOO_MANY_OPEN_PRS
+	Mode *PRQuotaMode `json:"mode,omitempty"`
+}
+
+// TeamSnapshotReportSchedule defines model for TeamSnapshotReportSchedule.
+type TeamSnapshotReportSchedule struct {
+	Hour     int     `json:"hour"`
+	Timezone *string `json:"timezone,omitempty"`
+	Weekday  Weekday `json:"weekday"`
+}
+
+// TeamSnapshotReviewBudget Бюджет ревью команды; после загрузки первый спринт начинается заново
+type TeamSnapshotReviewBudget struct {
+	ReviewsPerSprint int  `json:"reviews_per_sprint"`
+	SprintDays       *int `json:"sprint_days,omitempty"`
+}
+
+// TeamSnapshotReviewRotation Ротация дежурных ревьюеров без ручных замен отдельных недель
+type TeamSnapshotReviewRotation struct {
+	MemberIds []string           `json:"member_ids"`
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// TeamStatsHistoryPoint defines model for TeamStatsHistoryPoint.
+type TeamStatsHistoryPoint struct {
+	ActiveMembers int                `json:"active_members"`
+	Date          openapi_types.Date `json:"date"`
+	MergedReviews int                `json:"merged_reviews"`
+	OpenReviews   int                `json:"open_reviews"`
+}
+
+// TeamStatsHistoryResponse defines model for TeamStatsHistoryResponse.
+type TeamStatsHistoryResponse struct {
+	Points   []TeamStatsHistoryPoint `json:"points"`
+	TeamName string                  `json:"team_name"`
+}
+
+// ThroughputMetric prs_created и prs_merged — созданные и влитые PR (по команде автора), reviews_assigned — назначения ревьюеров, reviews_completed — первые решения ревьюеров, одобрение или запрос изменений (по команде ревьюера)
+type ThroughputMetric string
+
+// TimeSeries defines model for TimeSeries.
+type TimeSeries struct {
+	// Datapoints Пары [значение, начало интервала в миллисекундах Unix] по всем интервалам диапазона, включая пустые
+	Datapoints [][]float64 `json:"datapoints"`
+
+	// Target prs_created и prs_merged — созданные и влитые PR (по команде автора), reviews_assigned — назначения ревьюеров, reviews_completed — первые решения ревьюеров, одобрение или запрос изменений (по команде ревьюера)
+	Target ThroughputMetric `json:"target"`
+}
+
+// UnassignedDay defines model for UnassignedDay.
+type UnassignedDay struct {
+	// Date День по UTC
+	Date openapi_types.Date `json:"date"`
+
+	// UnassignedCount Сколько открытых PR команды оставались без ревьюверов в какой-либо момент этого дня
+	UnassignedCount int `json:"unassigned_count"`
+}
+
+// UnassignedStatsResponse defines model for UnassignedStatsResponse.
+type UnassignedStatsResponse struct {
+	// Teams Команды по убыванию peak_count
+	Teams       []UnassignedTeamSeries `json:"teams"`
+	WindowEnd   openapi_types.Date     `json:"window_end"`
+	WindowStart openapi_types.Date     `json:"window_start"`
+}
+
+// UnassignedTeamSeries defines model for UnassignedTeamSeries.
+type UnassignedTeamSeries struct {
+	// Days Все дни окна по порядку, включая дни без таких PR
+	Days []UnassignedDay `json:"days"`
+
+	// PeakCount Наибольшее значение unassigned_count за окно
+	PeakCount int    `json:"peak_count"`
+	TeamName  string `json:"team_name"`
+}
+
+// User defines model for User.
+type User struct {
+	// InTraining Пользователь на обучении: не назначается ревьюером автоматически, а становится теневым ревьюером части PR команды; изменяется через /users/setInTraining. Отсутствует, если false
+	InTraining *bool `json:"in_training,omitempty"`
+	IsActive   bool  `json:"is_active"`
+
+	// Role Роль ревьюера (например, senior); изменяется через /users/setRole
+	Role *string `json:"role,omitempty"`
+
+	// Skills Навыки пользователя (например, go, frontend, db); изменяются через /users/setSkills
+	Skills   *[]string `json:"skills,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// UserAddRequest defines model for UserAddRequest.
+type UserAddRequest struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	Username string `json:"username"`
+}
+
+// UserDeactivationResult defines model for UserDeactivationResult.
+type UserDeactivationResult struct {
+	// Reassigned Ревью на открытых PR, переданные другим ревьюерам
+	Reassigned []RebalanceMove              `json:"reassigned"`
+	Status     UserDeactivationResultStatus `json:"status"`
+
+	// UnassignedPullRequestIds Открытые PR, с которых пользователь снят без замены (подходящих кандидатов нет)
+	UnassignedPullRequestIds []string `json:"unassigned_pull_request_ids"`
+	UserId                   string   `json:"user_id"`
+}
+
+// UserDeactivationResultStatus defines model for UserDeactivationResult.Status.
+type UserDeactivationResultStatus string
+
+// UserLoad defines model for UserLoad.
+type UserLoad struct {
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+}
+
+// UserMergeRequest defines model for UserMergeRequest.
+type UserMergeRequest struct {
+	// SourceUserId Дубликат, который будет удалён
+	SourceUserId string `json:"source_user_id"`
+
+	// TargetUserId Пользователь, который остаётся
+	TargetUserId string `json:"target_user_id"`
+}
+
+// UserMergeResponse defines model for UserMergeResponse.
+type UserMergeResponse struct {
+	// GithubAccountMoved Перенесена ли привязка к GitHub (у оставшегося пользователя её не было)
+	GithubAccountMoved bool `json:"github_account_moved"`
+
+	// PullRequestsMoved PR, автором которых стал оставшийся пользователь (без учёта архива)
+	PullRequestsMoved int `json:"pull_requests_moved"`
+
+	// ReviewsDropped Назначения, снятые при слиянии: на PR, где оставшийся пользователь уже был ревьюером или стал автором
+	ReviewsDropped int `json:"reviews_dropped"`
+
+	// ReviewsMoved Назначения ревьюером, перенесённые на оставшегося пользователя
+	ReviewsMoved int    `json:"reviews_moved"`
+	SourceUserId string `json:"source_user_id"`
+	TargetUserId string `json:"target_user_id"`
+}
+
+// UserMoveResponse defines model for UserMoveResponse.
+type UserMoveResponse struct {
+	// InTraining Пользователь на обучении: не назначается ревьюером автоматически, а становится теневым ревьюером части PR команды; изменяется через /users/setInTraining. Отсутствует, если false
+	InTraining *bool `json:"in_training,omitempty"`
+	IsActive   bool  `json:"is_active"`
+
+	// MovedReviewsCount Сколько ревью на открытых PR прежней команды передано другим ревьюерам
+	MovedReviewsCount int `json:"moved_reviews_count"`
+
+	// Role Роль ревьюера (например, senior); изменяется через /users/setRole
+	Role *string `json:"role,omitempty"`
+
+	// Skills Навыки пользователя (например, go, frontend, db); изменяются через /users/setSkills
+	Skills   *[]string `json:"skills,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// UserStatsHistoryPoint defines model for UserStatsHistoryPoint.
+type UserStatsHistoryPoint struct {
+	Date          openapi_types.Date `json:"date"`
+	MergedReviews int                `json:"merged_reviews"`
+	OpenReviews   int                `json:"open_reviews"`
+
+	// TeamName Команда пользователя на день снимка; отсутствует, если команды уже нет
+	TeamName     *string `json:"team_name,omitempty"`
+	TotalReviews int     `json:"total_reviews"`
+}
+
+// UserStatsHistoryResponse defines model for UserStatsHistoryResponse.
+type UserStatsHistoryResponse struct {
+	Points []UserStatsHistoryPoint `json:"points"`
+	UserId string                  `json:"user_id"`
+}
+
+// UserWorkload defines model for UserWorkload.
+type UserWorkload struct {
+	// CanTakeReview Пользователь не отсутствует и не достиг capacity
+	CanTakeReview bool `json:"can_take_review"`
+
+	// Capacity Сколько открытых ревью может быть у пользователя, чтобы получить новый обычный PR (REVIEWER_MAX_OPEN_REVIEWS); отсутствует, если ограничения нет. Срочные PR его не учитывают.
+	Capacity *int `json:"capacity,omitempty"`
+
+	// IsAway Пользователь деактивирован и не назначается ревьюером
+	IsAway bool `json:"is_away"`
+
+	// OpenAuthoredPrs Число открытых PR, автором которых является пользователь
+	OpenAuthoredPrs int `json:"open_authored_prs"`
+
+	// OpenReviews Число открытых PR, на которые пользователь назначен ревьюером
+	OpenReviews int    `json:"open_reviews"`
+	TeamName    string `json:"team_name"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	AttemptedAt time.Time `json:"attempted_at"`
+	DurationMs  int64     `json:"duration_ms"`
+
+	// Error Причина неудачи; отсутствует для успешных доставок
+	Error *string `json:"error,omitempty"`
+	Id    int64   `json:"id"`
+
+	// NotificationId Уведомление, которое доставлялось (отсутствует, если оно удалено)
+	NotificationId *int64 `json:"notification_id,omitempty"`
+
+	// RedeliveryOf Исходная доставка, если это повтор
+	RedeliveryOf *int64  `json:"redelivery_of,omitempty"`
+	RequestBody  string  `json:"request_body"`
+	ResponseBody *string `json:"response_body,omitempty"`
+
+	// StatusCode Код ответа; отсутствует, если ответ не получен
+	StatusCode *int   `json:"status_code,omitempty"`
+	Url        string `json:"url"`
+	UserId     string `json:"user_id"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// HistoryFromParam defines model for HistoryFromParam.
+type HistoryFromParam = openapi_types.Date
+
+// HistoryToParam defines model for HistoryToParam.
+type HistoryToParam = openapi_types.Date
+
+// PullRequestIdParam defines model for PullRequestIdParam.
+type PullRequestIdParam = string
+
+// TeamNameParam defines model for TeamNameParam.
+type TeamNameParam = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdParam defines model for UserIdParam.
+type UserIdParam = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// GetAdminNotificationsFailedParams defines parameters for GetAdminNotificationsFailed.
+type GetAdminNotificationsFailedParams struct {
+	// UserId Только уведомления этого пользователя
+	UserId *string                                 `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Event  *GetAdminNotificationsFailedParamsEvent `form:"event,omitempty" json:"event,omitempty"`
+	Limit  *int                                    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                                    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAdminNotificationsFailedParamsEvent defines parameters for GetAdminNotificationsFailed.
+type GetAdminNotificationsFailedParamsEvent string
+
+// GetAdminWebhooksDeliveriesParams defines parameters for GetAdminWebhooksDeliveries.
+type GetAdminWebhooksDeliveriesParams struct {
+	// UserId Только доставки для этого пользователя
+	UserId *string                                 `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *GetAdminWebhooksDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                                    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                                    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAdminWebhooksDeliveriesParamsStatus defines parameters for GetAdminWebhooksDeliveries.
+type GetAdminWebhooksDeliveriesParamsStatus string
+
+// GetAdminWebhooksInboundParams defines parameters for GetAdminWebhooksInbound.
+type GetAdminWebhooksInboundParams struct {
+	Status *GetAdminWebhooksInboundParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Event Только доставки этого события, например `pull_request`
+	Event  *string `form:"event,omitempty" json:"event,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAdminWebhooksInboundParamsStatus defines parameters for GetAdminWebhooksInbound.
+type GetAdminWebhooksInboundParamsStatus string
+
+// GetGithubIngestionTokensParams defines parameters for GetGithubIngestionTokens.
+type GetGithubIngestionTokensParams struct {
+	// TeamName Только токены команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// PostGithubIngestionTokensCreateJSONBody defines parameters for PostGithubIngestionTokensCreate.
+type PostGithubIngestionTokensCreateJSONBody struct {
+	// Repository Репозиторий в формате owner/name
+	Repository string `json:"repository"`
+	TeamName   string `json:"team_name"`
+}
+
+// PostGithubIngestionTokensRevokeJSONBody defines parameters for PostGithubIngestionTokensRevoke.
+type PostGithubIngestionTokensRevokeJSONBody struct {
+	Repository string `json:"repository"`
+}
+
+// PostGithubLinkPullRequestJSONBody defines parameters for PostGithubLinkPullRequest.
+type PostGithubLinkPullRequestJSONBody struct {
+	Number        int    `json:"number"`
+	PullRequestId string `json:"pull_request_id"`
+	Repository    string `json:"repository"`
+}
+
+// PostGithubSetRepositoryTeamJSONBody defines parameters for PostGithubSetRepositoryTeam.
+type PostGithubSetRepositoryTeamJSONBody struct {
+	Repository string `json:"repository"`
+	TeamName   string `json:"team_name"`
+}
+
+// PostGithubWebhookJSONBody defines parameters for PostGithubWebhook.
+type PostGithubWebhookJSONBody map[string]interface{}
+
+// PostGithubWebhookParams defines parameters for PostGithubWebhook.
+type PostGithubWebhookParams struct {
+	XGitHubEvent     string `json:"X-GitHub-Event"`
+	XHubSignature256 string `json:"X-Hub-Signature-256"`
+
+	// XGitHubDelivery Идентификатор доставки на GitHub; сохраняется в журнале
+	XGitHubDelivery *string `json:"X-GitHub-Delivery,omitempty"`
+}
+
+// PostPrTemplateDeleteJSONBody defines parameters for PostPrTemplateDelete.
+type PostPrTemplateDeleteJSONBody struct {
+	TemplateId int64 `json:"template_id"`
+}
+
+// PostPrTemplateEditJSONBody defines parameters for PostPrTemplateEdit.
+type PostPrTemplateEditJSONBody struct {
+	DefaultReviewers *[]string `json:"default_reviewers,omitempty"`
+	Labels           *[]string `json:"labels,omitempty"`
+	Name             string    `json:"name"`
+	NamePrefix       *string   `json:"name_prefix,omitempty"`
+	TemplateId       int64     `json:"template_id"`
+}
+
+// GetPrTemplateGetParams defines parameters for GetPrTemplateGet.
+type GetPrTemplateGetParams struct {
+	TemplateId int64 `form:"template_id" json:"template_id"`
+}
+
+// GetPrTemplateListParams defines parameters for GetPrTemplateList.
+type GetPrTemplateListParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostPullRequestApproveJSONBody defines parameters for PostPullRequestApprove.
+type PostPullRequestApproveJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestAssignJSONBody defines parameters for PostPullRequestAssign.
+type PostPullRequestAssignJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// Explain true — добавить в ответ assignment_explanations, объяснение выбора каждого назначенного ревьювера
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+}
+
+// GetPullRequestGetPullRequestIdParams defines parameters for GetPullRequestGetPullRequestId.
+type GetPullRequestGetPullRequestIdParams struct {
+	// Include timeline — добавить в ответ историю событий PR (создание, назначения, переназначения, вердикты, слияние, закрытие)
+	Include *GetPullRequestGetPullRequestIdParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetPullRequestGetPullRequestIdParamsInclude defines parameters for GetPullRequestGetPullRequestId.
+type GetPullRequestGetPullRequestIdParamsInclude string
+
+// GetPullRequestGetByExternalIdParams defines parameters for GetPullRequestGetByExternalId.
+type GetPullRequestGetByExternalIdParams struct {
+	ExternalId string `form:"external_id" json:"external_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	FilterId   *int64                          `form:"filter_id,omitempty" json:"filter_id,omitempty"`
+	Status     *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId   *string                         `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                         `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName       *string              `form:"team_name,omitempty" json:"team_name,omitempty"`
+	RepositoryName *string              `form:"repository_name,omitempty" json:"repository_name,omitempty"`
+	Priority       *PullRequestPriority `form:"priority,omitempty" json:"priority,omitempty"`
+	Label          *string              `form:"label,omitempty" json:"label,omitempty"`
+	MinAgeHours    *int                 `form:"min_age_hours,omitempty" json:"min_age_hours,omitempty"`
+	Unreviewed     *bool                `form:"unreviewed,omitempty" json:"unreviewed,omitempty"`
+	Limit          *int                 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset         *int                 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	// MergedBy user_id того, кто влил PR; по умолчанию — актор запроса (X-Actor-Id)
+	MergedBy      *string `json:"merged_by,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	// DeclineReason Почему ревьювер отказался от ревью при ручном переназначении: conflict_of_interest — конфликт интересов, overloaded — перегружен, on_leave — в отпуске или отсутствует, lacks_context — не хватает контекста.
+	DeclineReason *DeclineReason `json:"decline_reason,omitempty"`
+	OldUserId     string         `json:"old_user_id"`
+	PullRequestId string         `json:"pull_request_id"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// Explain true — добавить в ответ assignment_explanations, объяснение выбора нового ревьювера
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+}
+
+// PostPullRequestReassignAllJSONBody defines parameters for PostPullRequestReassignAll.
+type PostPullRequestReassignAllJSONBody struct {
+	FromUserId string `json:"from_user_id"`
+
+	// ToUserId Кому передать ревью; если не указан, ревьюверы подбираются автоматически
+	ToUserId *string `json:"to_user_id,omitempty"`
+}
+
+// PostPullRequestRequestChangesJSONBody defines parameters for PostPullRequestRequestChanges.
+type PostPullRequestRequestChangesJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// GetPullRequestSearchParams defines parameters for GetPullRequestSearch.
+type GetPullRequestSearchParams struct {
+	Q      string `form:"q" json:"q"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int   `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostPullRequestSetAutoMergeJSONBody defines parameters for PostPullRequestSetAutoMerge.
+type PostPullRequestSetAutoMergeJSONBody struct {
+	AutoMerge     bool   `json:"auto_merge"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestSetPriorityJSONBody defines parameters for PostPullRequestSetPriority.
+type PostPullRequestSetPriorityJSONBody struct {
+	// Priority Приоритет PR. URGENT PR назначаются ревьюерам сверх лимита открытых ревью и эскалируются в 4 раза быстрее, LOW — в 2 раза медленнее; в списках ревьюера срочные PR идут первыми.
+	Priority      PullRequestPriority `json:"priority"`
+	PullRequestId string              `json:"pull_request_id"`
+}
+
+// PostPullRequestPullRequestIdAckJSONBody defines parameters for PostPullRequestPullRequestIdAck.
+type PostPullRequestPullRequestIdAckJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// PostPullRequestPullRequestIdChecklistJSONBody defines parameters for PostPullRequestPullRequestIdChecklist.
+type PostPullRequestPullRequestIdChecklistJSONBody struct {
+	Items []struct {
+		Checked  bool `json:"checked"`
+		Position int  `json:"position"`
+	} `json:"items"`
+	UserId string `json:"user_id"`
+}
+
+// GetPullRequestPullRequestIdSuggestReviewersParams defines parameters for GetPullRequestPullRequestIdSuggestReviewers.
+type GetPullRequestPullRequestIdSuggestReviewersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostRepositoryDeleteJSONBody defines parameters for PostRepositoryDelete.
+type PostRepositoryDeleteJSONBody struct {
+	RepositoryName string `json:"repository_name"`
+}
+
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	RepositoryName string `form:"repository_name" json:"repository_name"`
+}
+
+// PostReviewRuleDeleteJSONBody defines parameters for PostReviewRuleDelete.
+type PostReviewRuleDeleteJSONBody struct {
+	RuleName string `json:"rule_name"`
+}
+
+// GetReviewRuleGetParams defines parameters for GetReviewRuleGet.
+type GetReviewRuleGetParams struct {
+	RuleName string `form:"rule_name" json:"rule_name"`
+}
+
+// PostSavedFilterDeleteJSONBody defines parameters for PostSavedFilterDelete.
+type PostSavedFilterDeleteJSONBody struct {
+	FilterId int64 `json:"filter_id"`
+}
+
+// PostSavedFilterEditJSONBody defines parameters for PostSavedFilterEdit.
+type PostSavedFilterEditJSONBody struct {
+	// Filter Фильтр списка PR (см. /pullRequest/list); незаданные поля подходят под любой PR. В author_id и reviewer_id можно указать "@me" — пользователя из заголовка X-Actor-Id.
+	Filter   PullRequestFilter `json:"filter"`
+	FilterId int64             `json:"filter_id"`
+	Name     string            `json:"name"`
+}
+
+// GetSavedFilterGetParams defines parameters for GetSavedFilterGet.
+type GetSavedFilterGetParams struct {
+	FilterId int64 `form:"filter_id" json:"filter_id"`
+}
+
+// GetSavedFilterListParams defines parameters for GetSavedFilterList.
+type GetSavedFilterListParams struct {
+	UserId   *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	Sort   *GetStatsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetStatsParamsSort defines parameters for GetStats.
+type GetStatsParamsSort string
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+
+	// TeamName Ограничить отчёт одной командой
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsHistoryTeamTeamNameParams defines parameters for GetStatsHistoryTeamTeamName.
+type GetStatsHistoryTeamTeamNameParams struct {
+	// From Первый день (UTC); по умолчанию за 29 дней до to
+	From *HistoryFromParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день (UTC) включительно; по умолчанию сегодня
+	To *HistoryToParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsHistoryUserUserIdParams defines parameters for GetStatsHistoryUserUserId.
+type GetStatsHistoryUserUserIdParams struct {
+	// From Первый день (UTC); по умолчанию за 29 дней до to
+	From *HistoryFromParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день (UTC) включительно; по умолчанию сегодня
+	To *HistoryToParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsMergesParams defines parameters for GetStatsMerges.
+type GetStatsMergesParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+}
+
+// GetStatsReassignmentsParams defines parameters for GetStatsReassignments.
+type GetStatsReassignmentsParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+
+	// TeamName Ограничить отчёт одной командой
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsShadowParams defines parameters for GetStatsShadow.
+type GetStatsShadowParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+
+	// TeamName Ограничить отчёт одной командой
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsTimeseriesParams defines parameters for GetStatsTimeseries.
+type GetStatsTimeseriesParams struct {
+	// Metric Метрики (параметр можно повторять); по умолчанию все
+	Metric   *[]ThroughputMetric               `form:"metric,omitempty" json:"metric,omitempty"`
+	Interval *GetStatsTimeseriesParamsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// From Начало диапазона; по умолчанию 30 интервалов до to, включая текущий
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно); по умолчанию сейчас
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Ограничить ряды одной командой
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsTimeseriesParamsInterval defines parameters for GetStatsTimeseries.
+type GetStatsTimeseriesParamsInterval string
+
+// GetStatsUnassignedParams defines parameters for GetStatsUnassigned.
+type GetStatsUnassignedParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+
+	// TeamName Ограничить отчёт одной командой
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsUserUserIdTurnaroundParams defines parameters for GetStatsUserUserIdTurnaround.
+type GetStatsUserUserIdTurnaroundParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+}
+
+// PostTeamEditJSONBody defines parameters for PostTeamEdit.
+type PostTeamEditJSONBody struct {
+	// AllowDuplicateUsernames Запретить повторяющиеся имена можно, только если их в команде уже нет
+	AllowDuplicateUsernames *bool `json:"allow_duplicate_usernames,omitempty"`
+
+	// AllowSelfReview Разрешить назначать автора ревьюером собственного PR, ревьюеры которого подбираются из этой команды (команды из одного человека, срочные исправления): вручную через /pullRequest/assign или автоматически, если других кандидатов нет. Каждое такое назначение записывается в лог событием pr.self_review_assigned.
+	AllowSelfReview *bool `json:"allow_self_review,omitempty"`
+
+	// Checklist Пункты чек-листа, которые копируются в каждый новый PR, ревьюеры которого подбираются из команды. Изменение шаблона не затрагивает уже созданные PR.
+	Checklist *ChecklistTemplate `json:"checklist,omitempty"`
+
+	// Escalation Эскалация PR команды, по которым нет активности ревьюеров (ни одного одобрения). Задаётся через /team/edit; 0 часов отключает шаг.
+	Escalation  *EscalationPolicy `json:"escalation,omitempty"`
+	NewTeamName *string           `json:"new_team_name,omitempty"`
+	OldTeamName string            `json:"old_team_name"`
+
+	// ReviewCooldownPrs Ревьюеры последних N PR автора выбираются для его следующего PR в последнюю очередь, чтобы PR автора видели разные участники команды. Действует при подборе ревьюеров из этой команды; 0 отключает правило.
+	ReviewCooldownPrs *int        `json:"review_cooldown_prs,omitempty"`
+	SizeRules         *[]SizeRule `json:"size_rules,omitempty"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamHierarchyParams defines parameters for GetTeamHierarchy.
+type GetTeamHierarchyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamInactiveReassignParams defines parameters for GetTeamInactiveReassign.
+type GetTeamInactiveReassignParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamPrQuotaParams defines parameters for GetTeamPrQuota.
+type GetTeamPrQuotaParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamReportParams defines parameters for GetTeamReport.
+type GetTeamReportParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery              `form:"team_name" json:"team_name"`
+	Format   *GetTeamReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTeamReportParamsFormat defines parameters for GetTeamReport.
+type GetTeamReportParamsFormat string
+
+// GetTeamReportScheduleParams defines parameters for GetTeamReportSchedule.
+type GetTeamReportScheduleParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamReviewBudgetParams defines parameters for GetTeamReviewBudget.
+type GetTeamReviewBudgetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamReviewerPreferencesParams defines parameters for GetTeamReviewerPreferences.
+type GetTeamReviewerPreferencesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamRotationParams defines parameters for GetTeamRotation.
+type GetTeamRotationParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Weeks Сколько недель расписания вернуть, начиная с текущей
+	Weeks *int `form:"weeks,omitempty" json:"weeks,omitempty"`
+}
+
+// GetUsersGetByUsernameParams defines parameters for GetUsersGetByUsername.
+type GetUsersGetByUsernameParams struct {
+	// Username Имя пользователя (с учётом регистра)
+	Username string `form:"username" json:"username"`
+
+	// TeamName Команда пользователя
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersMoveToTeamJSONBody defines parameters for PostUsersMoveToTeam.
+type PostUsersMoveToTeamJSONBody struct {
+	NewTeamName string                                  `json:"new_team_name"`
+	OpenReviews *PostUsersMoveToTeamJSONBodyOpenReviews `json:"open_reviews,omitempty"`
+	UserId      string                                  `json:"user_id"`
+}
+
+// PostUsersMoveToTeamJSONBodyOpenReviews defines parameters for PostUsersMoveToTeam.
+type PostUsersMoveToTeamJSONBodyOpenReviews string
+
+// PostUsersSetInTrainingJSONBody defines parameters for PostUsersSetInTraining.
+type PostUsersSetInTrainingJSONBody struct {
+	InTraining bool   `json:"in_training"`
+	UserId     string `json:"user_id"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// PostUsersSetIsActiveBatchJSONBody defines parameters for PostUsersSetIsActiveBatch.
+type PostUsersSetIsActiveBatchJSONBody struct {
+	UserIds []string `json:"user_ids"`
+}
+
+// PostUsersSetRoleJSONBody defines parameters for PostUsersSetRole.
+type PostUsersSetRoleJSONBody struct {
+	Role   string `json:"role"`
+	UserId string `json:"user_id"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostAdminArchiveJSONRequestBody defines body for PostAdminArchive for application/json ContentType.
+type PostAdminArchiveJSONRequestBody = ArchiveRequest
+
+// PostAdminEventsReplayJSONRequestBody defines body for PostAdminEventsReplay for application/json ContentType.
+type PostAdminEventsReplayJSONRequestBody = EventReplayRequest
+
+// PostAdminImportJSONRequestBody defines body for PostAdminImport for application/json ContentType.
+type PostAdminImportJSONRequestBody = DataDump
+
+// PostAdminRebalanceJSONRequestBody defines body for PostAdminRebalance for application/json ContentType.
+type PostAdminRebalanceJSONRequestBody = RebalanceRequest
+
+// PutAdminSettingsJSONRequestBody defines body for PutAdminSettings for application/json ContentType.
+type PutAdminSettingsJSONRequestBody = Settings
+
+// PutAdminSettingsTeamsTeamNameJSONRequestBody defines body for PutAdminSettingsTeamsTeamName for application/json ContentType.
+type PutAdminSettingsTeamsTeamNameJSONRequestBody = SettingsOverride
+
+// PostAdminUsersMergeJSONRequestBody defines body for PostAdminUsersMerge for application/json ContentType.
+type PostAdminUsersMergeJSONRequestBody = UserMergeRequest
+
+// PostGithubIngestionTokensCreateJSONRequestBody defines body for PostGithubIngestionTokensCreate for application/json ContentType.
+type PostGithubIngestionTokensCreateJSONRequestBody PostGithubIngestionTokensCreateJSONBody
+
+// PostGithubIngestionTokensRevokeJSONRequestBody defines body for PostGithubIngestionTokensRevoke for application/json ContentType.
+type PostGithubIngestionTokensRevokeJSONRequestBody PostGithubIngestionTokensRevokeJSONBody
+
+// PostGithubLinkPullRequestJSONRequestBody defines body for PostGithubLinkPullRequest for application/json ContentType.
+type PostGithubLinkPullRequestJSONRequestBody PostGithubLinkPullRequestJSONBody
+
+// PostGithubLinkUserJSONRequestBody defines body for PostGithubLinkUser for application/json ContentType.
+type PostGithubLinkUserJSONRequestBody = GitHubAccount
+
+// PostGithubSetRepositoryTeamJSONRequestBody defines body for PostGithubSetRepositoryTeam for application/json ContentType.
+type PostGithubSetRepositoryTeamJSONRequestBody PostGithubSetRepositoryTeamJSONBody
+
+// PostGithubWebhookJSONRequestBody defines body for PostGithubWebhook for application/json ContentType.
+type PostGithubWebhookJSONRequestBody PostGithubWebhookJSONBody
+
+// PostPrTemplateAddJSONRequestBody defines body for PostPrTemplateAdd for application/json ContentType.
+type PostPrTemplateAddJSONRequestBody = PRTemplate
+
+// PostPrTemplateDeleteJSONRequestBody defines body for PostPrTemplateDelete for application/json ContentType.
+type PostPrTemplateDeleteJSONRequestBody PostPrTemplateDeleteJSONBody
+
+// PostPrTemplateEditJSONRequestBody defines body for PostPrTemplateEdit for application/json ContentType.
+type PostPrTemplateEditJSONRequestBody PostPrTemplateEditJSONBody
+
+// PostPullRequestApproveJSONRequestBody defines body for PostPullRequestApprove for application/json ContentType.
+type PostPullRequestApproveJSONRequestBody PostPullRequestApproveJSONBody
+
+// PostPullRequestAssignJSONRequestBody defines body for PostPullRequestAssign for application/json ContentType.
+type PostPullRequestAssignJSONRequestBody PostPullRequestAssignJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody = PullRequestCreateRequest
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestReassignAllJSONRequestBody defines body for PostPullRequestReassignAll for application/json ContentType.
+type PostPullRequestReassignAllJSONRequestBody PostPullRequestReassignAllJSONBody
+
+// PostPullRequestRequestChangesJSONRequestBody defines body for PostPullRequestRequestChanges for application/json ContentType.
+type PostPullRequestRequestChangesJSONRequestBody PostPullRequestRequestChangesJSONBody
+
+// PostPullRequestSetAutoMergeJSONRequestBody defines body for PostPullRequestSetAutoMerge for application/json ContentType.
+type PostPullRequestSetAutoMergeJSONRequestBody PostPullRequestSetAutoMergeJSONBody
+
+// PostPullRequestSetPriorityJSONRequestBody defines body for PostPullRequestSetPriority for application/json ContentType.
+type PostPullRequestSetPriorityJSONRequestBody PostPullRequestSetPriorityJSONBody
+
+// PostPullRequestPullRequestIdAckJSONRequestBody defines body for PostPullRequestPullRequestIdAck for application/json ContentType.
+type PostPullRequestPullRequestIdAckJSONRequestBody PostPullRequestPullRequestIdAckJSONBody
+
+// PostPullRequestPullRequestIdChecklistJSONRequestBody defines body for PostPullRequestPullRequestIdChecklist for application/json ContentType.
+type PostPullRequestPullRequestIdChecklistJSONRequestBody PostPullRequestPullRequestIdChecklistJSONBody
+
+// PostRepositoryAddJSONRequestBody defines body for PostRepositoryAdd for application/json ContentType.
+type PostRepositoryAddJSONRequestBody = Repository
+
+// PostRepositoryDeleteJSONRequestBody defines body for PostRepositoryDelete for application/json ContentType.
+type PostRepositoryDeleteJSONRequestBody PostRepositoryDeleteJSONBody
+
+// PostRepositoryEditJSONRequestBody defines body for PostRepositoryEdit for application/json ContentType.
+type PostRepositoryEditJSONRequestBody = Repository
+
+// PostReviewRuleAddJSONRequestBody defines body for PostReviewRuleAdd for application/json ContentType.
+type PostReviewRuleAddJSONRequestBody = ReviewRule
+
+// PostReviewRuleDeleteJSONRequestBody defines body for PostReviewRuleDelete for application/json ContentType.
+type PostReviewRuleDeleteJSONRequestBody PostReviewRuleDeleteJSONBody
+
+// PostReviewRuleDryRunJSONRequestBody defines body for PostReviewRuleDryRun for application/json ContentType.
+type PostReviewRuleDryRunJSONRequestBody = ReviewRuleDryRunRequest
+
+// PostReviewRuleEditJSONRequestBody defines body for PostReviewRuleEdit for application/json ContentType.
+type PostReviewRuleEditJSONRequestBody = ReviewRule
+
+// PostSavedFilterAddJSONRequestBody defines body for PostSavedFilterAdd for application/json ContentType.
+type PostSavedFilterAddJSONRequestBody = SavedFilter
+
+// PostSavedFilterDeleteJSONRequestBody defines body for PostSavedFilterDelete for application/json ContentType.
+type PostSavedFilterDeleteJSONRequestBody PostSavedFilterDeleteJSONBody
+
+// PostSavedFilterEditJSONRequestBody defines body for PostSavedFilterEdit for application/json ContentType.
+type PostSavedFilterEditJSONRequestBody PostSavedFilterEditJSONBody
+
+// PostSlackLinkUserJSONRequestBody defines body for PostSlackLinkUser for application/json ContentType.
+type PostSlackLinkUserJSONRequestBody = SlackAccount
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody = TeamDeactivateRequest
+
+// PostTeamEditJSONRequestBody defines body for PostTeamEdit for application/json ContentType.
+type PostTeamEditJSONRequestBody PostTeamEditJSONBody
+
+// PostTeamImportJSONRequestBody defines body for PostTeamImport for application/json ContentType.
+type PostTeamImportJSONRequestBody = TeamSnapshot
+
+// PostTeamOverrideRotationJSONRequestBody defines body for PostTeamOverrideRotation for application/json ContentType.
+type PostTeamOverrideRotationJSONRequestBody = OverrideTeamReviewRotationRequest
+
+// PostTeamSetInactiveReassignJSONRequestBody defines body for PostTeamSetInactiveReassign for application/json ContentType.
+type PostTeamSetInactiveReassignJSONRequestBody = SetTeamInactiveReassignRequest
+
+// PostTeamSetPRQuotaJSONRequestBody defines body for PostTeamSetPRQuota for application/json ContentType.
+type PostTeamSetPRQuotaJSONRequestBody = SetTeamPRQuotaRequest
+
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody = TeamSetParentRequest
+
+// PostTeamSetReportScheduleJSONRequestBody defines body for PostTeamSetReportSchedule for application/json ContentType.
+type PostTeamSetReportScheduleJSONRequestBody = SetTeamReportScheduleRequest
+
+// PostTeamSetReviewBudgetJSONRequestBody defines body for PostTeamSetReviewBudget for application/json ContentType.
+type PostTeamSetReviewBudgetJSONRequestBody = SetTeamReviewBudgetRequest
+
+// PostTeamSetReviewerPreferencesJSONRequestBody defines body for PostTeamSetReviewerPreferences for application/json ContentType.
+type PostTeamSetReviewerPreferencesJSONRequestBody = TeamReviewerPreferences
+
+// PostTeamSetReviewerRequirementsJSONRequestBody defines body for PostTeamSetReviewerRequirements for application/json ContentType.
+type PostTeamSetReviewerRequirementsJSONRequestBody = TeamReviewerRequirements
+
+// PostTeamSetRotationJSONRequestBody defines body for PostTeamSetRotation for application/json ContentType.
+type PostTeamSetRotationJSONRequestBody = SetTeamReviewRotationRequest
+
+// PostTeamTeamNameReviewerPoolJSONRequestBody defines body for PostTeamTeamNameReviewerPool for application/json ContentType.
+type PostTeamTeamNameReviewerPoolJSONRequestBody = SetTeamReviewerPoolRequest
+
+// PostUsersAddJSONRequestBody defines body for PostUsersAdd for application/json ContentType.
+type PostUsersAddJSONRequestBody = UserAddRequest
+
+// PostUsersEditJSONRequestBody defines body for PostUsersEdit for application/json ContentType.
+type PostUsersEditJSONRequestBody = User
+
+// PostUsersMoveToTeamJSONRequestBody defines body for PostUsersMoveToTeam for application/json ContentType.
+type PostUsersMoveToTeamJSONRequestBody PostUsersMoveToTeamJSONBody
+
+// PostUsersSetInTrainingJSONRequestBody defines body for PostUsersSetInTraining for application/json ContentType.
+type PostUsersSetInTrainingJSONRequestBody PostUsersSetInTrainingJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetIsActiveBatchJSONRequestBody defines body for PostUsersSetIsActiveBatch for application/json ContentType.
+type PostUsersSetIsActiveBatchJSONRequestBody PostUsersSetIsActiveBatchJSONBody
+
+// PostUsersSetRoleJSONRequestBody defines body for PostUsersSetRole for application/json ContentType.
+type PostUsersSetRoleJSONRequestBody PostUsersSetRoleJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
+// PostUsersUserIdNotificationPreferencesJSONRequestBody defines body for PostUsersUserIdNotificationPreferences for application/json ContentType.
+type PostUsersUserIdNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Архивировать давно смерженные PR
+	// (POST /admin/archive)
+	PostAdminArchive(w http.ResponseWriter, r *http.Request)
+	// Повторно доставить отправленные уведомления
+	// (POST /admin/events/replay)
+	PostAdminEventsReplay(w http.ResponseWriter, r *http.Request)
+	// Выгрузить все данные (команды, пользователи, PR и назначения) в JSON
+	// (GET /admin/export)
+	GetAdminExport(w http.ResponseWriter, r *http.Request)
+	// Загрузить дамп, полученный из /admin/export
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request)
+	// Очередь недоставленных уведомлений
+	// (GET /admin/notifications/failed)
+	GetAdminNotificationsFailed(w http.ResponseWriter, r *http.Request, params GetAdminNotificationsFailedParams)
+	// Выровнять нагрузку по открытым ревью внутри команды
+	// (POST /admin/rebalance)
+	PostAdminRebalance(w http.ResponseWriter, r *http.Request)
+	// Настройки организации
+	// (GET /admin/settings)
+	GetAdminSettings(w http.ResponseWriter, r *http.Request)
+	// Заменить настройки организации
+	// (PUT /admin/settings)
+	PutAdminSettings(w http.ResponseWriter, r *http.Request)
+	// Настройки команды
+	// (GET /admin/settings/teams/{team_name})
+	GetAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string)
+	// Заменить переопределения команды
+	// (PUT /admin/settings/teams/{team_name})
+	PutAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string)
+	// Слить дубликат пользователя с основной записью
+	// (POST /admin/users/merge)
+	PostAdminUsersMerge(w http.ResponseWriter, r *http.Request)
+	// Журнал доставок вебхуков
+	// (GET /admin/webhooks/deliveries)
+	GetAdminWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params GetAdminWebhooksDeliveriesParams)
+	// Повторить неудачную доставку вебхука
+	// (POST /admin/webhooks/deliveries/{delivery_id}/redeliver)
+	PostAdminWebhooksDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, deliveryId int64)
+	// Журнал входящих вебхуков
+	// (GET /admin/webhooks/inbound)
+	GetAdminWebhooksInbound(w http.ResponseWriter, r *http.Request, params GetAdminWebhooksInboundParams)
+	// Повторно обработать входящий вебхук
+	// (POST /admin/webhooks/inbound/{webhook_id}/reprocess)
+	PostAdminWebhooksInboundWebhookIdReprocess(w http.ResponseWriter, r *http.Request, webhookId int64)
+	// Получить список всех кодов ошибок API
+	// (GET /errors)
+	GetErrors(w http.ResponseWriter, r *http.Request)
+	// Токены приёма вебхуков
+	// (GET /github/ingestionTokens)
+	GetGithubIngestionTokens(w http.ResponseWriter, r *http.Request, params GetGithubIngestionTokensParams)
+	// Выпустить токен приёма вебхуков репозитория
+	// (POST /github/ingestionTokens/create)
+	PostGithubIngestionTokensCreate(w http.ResponseWriter, r *http.Request)
+	// Отозвать токен приёма вебхуков репозитория
+	// (POST /github/ingestionTokens/revoke)
+	PostGithubIngestionTokensRevoke(w http.ResponseWriter, r *http.Request)
+	// Связать PR с pull request'ом на GitHub
+	// (POST /github/linkPullRequest)
+	PostGithubLinkPullRequest(w http.ResponseWriter, r *http.Request)
+	// Привязать логин GitHub к пользователю
+	// (POST /github/linkUser)
+	PostGithubLinkUser(w http.ResponseWriter, r *http.Request)
+	// Репозитории, на которые установлено GitHub App
+	// (GET /github/repositories)
+	GetGithubRepositories(w http.ResponseWriter, r *http.Request)
+	// Подключить репозиторий к команде
+	// (POST /github/setRepositoryTeam)
+	PostGithubSetRepositoryTeam(w http.ResponseWriter, r *http.Request)
+	// Синхронизировать команды с командами организации на GitHub
+	// (POST /github/syncTeams)
+	PostGithubSyncTeams(w http.ResponseWriter, r *http.Request)
+	// Отчёт о последней синхронизации команд с GitHub
+	// (GET /github/teamSyncReport)
+	GetGithubTeamSyncReport(w http.ResponseWriter, r *http.Request)
+	// Приём вебхуков GitHub App
+	// (POST /github/webhook)
+	PostGithubWebhook(w http.ResponseWriter, r *http.Request, params PostGithubWebhookParams)
+	// Check service health
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Проверить готовность сервиса и его зависимостей
+	// (GET /health/ready)
+	GetHealthReady(w http.ResponseWriter, r *http.Request)
+	// Создать шаблон PR команды
+	// (POST /prTemplate/add)
+	PostPrTemplateAdd(w http.ResponseWriter, r *http.Request)
+	// Удалить шаблон PR
+	// (POST /prTemplate/delete)
+	PostPrTemplateDelete(w http.ResponseWriter, r *http.Request)
+	// Переименовать шаблон PR и заменить его настройки
+	// (POST /prTemplate/edit)
+	PostPrTemplateEdit(w http.ResponseWriter, r *http.Request)
+	// Получить шаблон PR
+	// (GET /prTemplate/get)
+	GetPrTemplateGet(w http.ResponseWriter, r *http.Request, params GetPrTemplateGetParams)
+	// Получить шаблоны PR команды по имени
+	// (GET /prTemplate/list)
+	GetPrTemplateList(w http.ResponseWriter, r *http.Request, params GetPrTemplateListParams)
+	// Одобрить PR от имени назначенного ревьювера (идемпотентная операция)
+	// (POST /pullRequest/approve)
+	PostPullRequestApprove(w http.ResponseWriter, r *http.Request)
+	// Назначить ревьювера на PR
+	// (POST /pullRequest/assign)
+	PostPullRequestAssign(w http.ResponseWriter, r *http.Request)
+	// Закрыть PR без слияния
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams)
+	// Получить информацию о PR по ID
+	// (GET /pullRequest/get/{pull_request_id})
+	GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam, params GetPullRequestGetPullRequestIdParams)
+	// Получить PR по внешнему идентификатору
+	// (GET /pullRequest/getByExternalId)
+	GetPullRequestGetByExternalId(w http.ResponseWriter, r *http.Request, params GetPullRequestGetByExternalIdParams)
+	// Получить PR, подходящие под фильтр
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	// Получить список открытых PR без ревьюверов
+	// (GET /pullRequest/open-without-reviewers)
+	GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request)
+	// Пометить черновик готовым к ревью
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Передать все открытые ревью пользователя другому (например, на время отпуска)
+	// (POST /pullRequest/reassignAll)
+	PostPullRequestReassignAll(w http.ResponseWriter, r *http.Request)
+	// Запросить изменения в PR от имени назначенного ревьювера (идемпотентная операция)
+	// (POST /pullRequest/requestChanges)
+	PostPullRequestRequestChanges(w http.ResponseWriter, r *http.Request)
+	// Полнотекстовый поиск PR по названию и описанию
+	// (GET /pullRequest/search)
+	GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams)
+	// Включить или выключить автоматический merge PR
+	// (POST /pullRequest/setAutoMerge)
+	PostPullRequestSetAutoMerge(w http.ResponseWriter, r *http.Request)
+	// Изменить приоритет PR
+	// (POST /pullRequest/setPriority)
+	PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request)
+	// Подтвердить, что ревьювер увидел назначение (идемпотентная операция)
+	// (POST /pullRequest/{pull_request_id}/ack)
+	PostPullRequestPullRequestIdAck(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam)
+	// Отметить пункты чек-листа PR
+	// (POST /pullRequest/{pull_request_id}/checklist)
+	PostPullRequestPullRequestIdChecklist(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam)
+	// Предложить ревьюверов для ручного назначения
+	// (GET /pullRequest/{pull_request_id}/suggestReviewers)
+	GetPullRequestPullRequestIdSuggestReviewers(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam, params GetPullRequestPullRequestIdSuggestReviewersParams)
+	// Создать репозиторий с настройками назначения ревьюеров
+	// (POST /repository/add)
+	PostRepositoryAdd(w http.ResponseWriter, r *http.Request)
+	// Удалить репозиторий
+	// (POST /repository/delete)
+	PostRepositoryDelete(w http.ResponseWriter, r *http.Request)
+	// Заменить настройки репозитория
+	// (POST /repository/edit)
+	PostRepositoryEdit(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams)
+	// Получить список репозиториев
+	// (GET /repository/list)
+	GetRepositoryList(w http.ResponseWriter, r *http.Request)
+	// Создать правило ревью
+	// (POST /reviewRule/add)
+	PostReviewRuleAdd(w http.ResponseWriter, r *http.Request)
+	// Удалить правило ревью
+	// (POST /reviewRule/delete)
+	PostReviewRuleDelete(w http.ResponseWriter, r *http.Request)
+	// Проверить, как был бы направлен PR, ничего не сохраняя
+	// (POST /reviewRule/dryRun)
+	PostReviewRuleDryRun(w http.ResponseWriter, r *http.Request)
+	// Заменить правило ревью
+	// (POST /reviewRule/edit)
+	PostReviewRuleEdit(w http.ResponseWriter, r *http.Request)
+	// Получить правило ревью
+	// (GET /reviewRule/get)
+	GetReviewRuleGet(w http.ResponseWriter, r *http.Request, params GetReviewRuleGetParams)
+	// Получить правила ревью в порядке проверки
+	// (GET /reviewRule/list)
+	GetReviewRuleList(w http.ResponseWriter, r *http.Request)
+	// Сохранить фильтр списка PR для пользователя или команды
+	// (POST /savedFilter/add)
+	PostSavedFilterAdd(w http.ResponseWriter, r *http.Request)
+	// Удалить сохранённый фильтр
+	// (POST /savedFilter/delete)
+	PostSavedFilterDelete(w http.ResponseWriter, r *http.Request)
+	// Переименовать фильтр и заменить его условия
+	// (POST /savedFilter/edit)
+	PostSavedFilterEdit(w http.ResponseWriter, r *http.Request)
+	// Получить сохранённый фильтр
+	// (GET /savedFilter/get)
+	GetSavedFilterGet(w http.ResponseWriter, r *http.Request, params GetSavedFilterGetParams)
+	// Получить фильтры пользователя и команды
+	// (GET /savedFilter/list)
+	GetSavedFilterList(w http.ResponseWriter, r *http.Request, params GetSavedFilterListParams)
+	// Привязать пользователя Slack к пользователю
+	// (POST /slack/linkUser)
+	PostSlackLinkUser(w http.ResponseWriter, r *http.Request)
+	// Получить статистику по ревью
+	// (GET /stats)
+	GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams)
+	// Статистика PR автора
+	// (GET /stats/author/{user_id})
+	GetStatsAuthorUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Отчёт о равномерности распределения ревью внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// История статистики команды
+	// (GET /stats/history/team/{team_name})
+	GetStatsHistoryTeamTeamName(w http.ResponseWriter, r *http.Request, teamName TeamNameParam, params GetStatsHistoryTeamTeamNameParams)
+	// История статистики пользователя
+	// (GET /stats/history/user/{user_id})
+	GetStatsHistoryUserUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam, params GetStatsHistoryUserUserIdParams)
+	// Кто вливает PR
+	// (GET /stats/merges)
+	GetStatsMerges(w http.ResponseWriter, r *http.Request, params GetStatsMergesParams)
+	// Статистика переназначений ревьюверов
+	// (GET /stats/reassignments)
+	GetStatsReassignments(w http.ResponseWriter, r *http.Request, params GetStatsReassignmentsParams)
+	// Статистика PR репозитория
+	// (GET /stats/repo/{repository_name})
+	GetStatsRepoRepositoryName(w http.ResponseWriter, r *http.Request, repositoryName string)
+	// Задержки и ошибки API
+	// (GET /stats/service)
+	GetStatsService(w http.ResponseWriter, r *http.Request)
+	// Статистика теневых ревью
+	// (GET /stats/shadow)
+	GetStatsShadow(w http.ResponseWriter, r *http.Request, params GetStatsShadowParams)
+	// Сводка по организации
+	// (GET /stats/summary)
+	GetStatsSummary(w http.ResponseWriter, r *http.Request)
+	// Получить количество закрытых PR у команды
+	// (GET /stats/team/{team_name}/merged-review-count)
+	GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Получить количество назначенных OPEN PR у команды
+	// (GET /stats/team/{team_name}/open-review-count)
+	GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Временные ряды пропускной способности ревью
+	// (GET /stats/timeseries)
+	GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params GetStatsTimeseriesParams)
+	// Динамика PR без ревьюверов
+	// (GET /stats/unassigned)
+	GetStatsUnassigned(w http.ResponseWriter, r *http.Request, params GetStatsUnassignedParams)
+	// Получить количество закрытых PR у пользователя
+	// (GET /stats/user/{user_id}/merged-review-count)
+	GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Получить количество назначенных OPEN PR у пользователя
+	// (GET /stats/user/{user_id}/open-review-count)
+	GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Скорость ревью пользователя
+	// (GET /stats/user/{user_id}/turnaround)
+	GetStatsUserUserIdTurnaround(w http.ResponseWriter, r *http.Request, userId UserIdParam, params GetStatsUserUserIdTurnaroundParams)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Массово деактивировать команду и переназначить ревью
+	// (POST /team/deactivate)
+	PostTeamDeactivate(w http.ResponseWriter, r *http.Request)
+	// Изменить команду
+	// (POST /team/edit)
+	PostTeamEdit(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Получить родительскую и дочерние команды
+	// (GET /team/hierarchy)
+	GetTeamHierarchy(w http.ResponseWriter, r *http.Request, params GetTeamHierarchyParams)
+	// Создать команду из снимка
+	// (POST /team/import)
+	PostTeamImport(w http.ResponseWriter, r *http.Request)
+	// Узнать, переназначаются ли неактивные ревьюеры команды
+	// (GET /team/inactiveReassign)
+	GetTeamInactiveReassign(w http.ResponseWriter, r *http.Request, params GetTeamInactiveReassignParams)
+	// Получить список всех команд
+	// (GET /team/list)
+	GetTeamList(w http.ResponseWriter, r *http.Request)
+	// Заменить дежурного ревьюера на неделю
+	// (POST /team/overrideRotation)
+	PostTeamOverrideRotation(w http.ResponseWriter, r *http.Request)
+	// Получить квоту открытых PR команды
+	// (GET /team/prQuota)
+	GetTeamPrQuota(w http.ResponseWriter, r *http.Request, params GetTeamPrQuotaParams)
+	// Получить еженедельный отчёт команды
+	// (GET /team/report)
+	GetTeamReport(w http.ResponseWriter, r *http.Request, params GetTeamReportParams)
+	// Получить расписание отчётов команды
+	// (GET /team/reportSchedule)
+	GetTeamReportSchedule(w http.ResponseWriter, r *http.Request, params GetTeamReportScheduleParams)
+	// Получить бюджет ревью команды
+	// (GET /team/reviewBudget)
+	GetTeamReviewBudget(w http.ResponseWriter, r *http.Request, params GetTeamReviewBudgetParams)
+	// Получить предпочтительных ревьюеров команды
+	// (GET /team/reviewerPreferences)
+	GetTeamReviewerPreferences(w http.ResponseWriter, r *http.Request, params GetTeamReviewerPreferencesParams)
+	// Получить ротацию дежурных ревьюеров команды
+	// (GET /team/rotation)
+	GetTeamRotation(w http.ResponseWriter, r *http.Request, params GetTeamRotationParams)
+	// Включить или отключить переназначение неактивных ревьюеров команды
+	// (POST /team/setInactiveReassign)
+	PostTeamSetInactiveReassign(w http.ResponseWriter, r *http.Request)
+	// Ограничить число открытых PR у участников команды
+	// (POST /team/setPRQuota)
+	PostTeamSetPRQuota(w http.ResponseWriter, r *http.Request)
+	// Назначить или снять родительскую команду
+	// (POST /team/setParent)
+	PostTeamSetParent(w http.ResponseWriter, r *http.Request)
+	// Настроить еженедельный отчёт команды
+	// (POST /team/setReportSchedule)
+	PostTeamSetReportSchedule(w http.ResponseWriter, r *http.Request)
+	// Задать бюджет ревью на спринт
+	// (POST /team/setReviewBudget)
+	PostTeamSetReviewBudget(w http.ResponseWriter, r *http.Request)
+	// Задать предпочтительных ревьюеров для авторов
+	// (POST /team/setReviewerPreferences)
+	PostTeamSetReviewerPreferences(w http.ResponseWriter, r *http.Request)
+	// Задать обязательную роль ревьюера для PR команды
+	// (POST /team/setReviewerRequirements)
+	PostTeamSetReviewerRequirements(w http.ResponseWriter, r *http.Request)
+	// Задать ротацию дежурных ревьюеров команды
+	// (POST /team/setRotation)
+	PostTeamSetRotation(w http.ResponseWriter, r *http.Request)
+	// Загрузка участников команды
+	// (GET /team/{team_name}/capacity)
+	GetTeamTeamNameCapacity(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Выгрузить снимок команды
+	// (GET /team/{team_name}/export)
+	GetTeamTeamNameExport(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Получить пул ревьюеров команды
+	// (GET /team/{team_name}/reviewerPool)
+	GetTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Задать пул ревьюеров команды
+	// (POST /team/{team_name}/reviewerPool)
+	PostTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName TeamNameParam)
+	// Добавить пользователя
+	// (POST /users/add)
+	PostUsersAdd(w http.ResponseWriter, r *http.Request)
+	// Изменить пользователя
+	// (POST /users/edit)
+	PostUsersEdit(w http.ResponseWriter, r *http.Request)
+	// Получить пользователя по ID
+	// (GET /users/get/{user_id})
+	GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Найти пользователя по имени
+	// (GET /users/getByUsername)
+	GetUsersGetByUsername(w http.ResponseWriter, r *http.Request, params GetUsersGetByUsernameParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Переместить пользователя в другую команду
+	// (POST /users/moveToTeam)
+	PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request)
+	// Отметить пользователя как находящегося на обучении
+	// (POST /users/setInTraining)
+	PostUsersSetInTraining(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Деактивировать нескольких пользователей
+	// (POST /users/setIsActiveBatch)
+	PostUsersSetIsActiveBatch(w http.ResponseWriter, r *http.Request)
+	// Установить роль пользователя
+	// (POST /users/setRole)
+	PostUsersSetRole(w http.ResponseWriter, r *http.Request)
+	// Установить навыки пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(w http.ResponseWriter, r *http.Request)
+	// Получить настройки уведомлений пользователя
+	// (GET /users/{user_id}/notificationPreferences)
+	GetUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Сохранить настройки уведомлений пользователя
+	// (POST /users/{user_id}/notificationPreferences)
+	PostUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+	// Текущая нагрузка пользователя
+	// (GET /users/{user_id}/workload)
+	GetUsersUserIdWorkload(w http.ResponseWriter, r *http.Request, userId UserIdParam)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Архивировать давно смерженные PR
+// (POST /admin/archive)
+func (_ Unimplemented) PostAdminArchive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторно доставить отправленные уведомления
+// (POST /admin/events/replay)
+func (_ Unimplemented) PostAdminEventsReplay(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить все данные (команды, пользователи, PR и назначения) в JSON
+// (GET /admin/export)
+func (_ Unimplemented) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить дамп, полученный из /admin/export
+// (POST /admin/import)
+func (_ Unimplemented) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Очередь недоставленных уведомлений
+// (GET /admin/notifications/failed)
+func (_ Unimplemented) GetAdminNotificationsFailed(w http.ResponseWriter, r *http.Request, params GetAdminNotificationsFailedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выровнять нагрузку по открытым ревью внутри команды
+// (POST /admin/rebalance)
+func (_ Unimplemented) PostAdminRebalance(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настройки организации
+// (GET /admin/settings)
+func (_ Unimplemented) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить настройки организации
+// (PUT /admin/settings)
+func (_ Unimplemented) PutAdminSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настройки команды
+// (GET /admin/settings/teams/{team_name})
+func (_ Unimplemented) GetAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить переопределения команды
+// (PUT /admin/settings/teams/{team_name})
+func (_ Unimplemented) PutAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request, teamName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Слить дубликат пользователя с основной записью
+// (POST /admin/users/merge)
+func (_ Unimplemented) PostAdminUsersMerge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал доставок вебхуков
+// (GET /admin/webhooks/deliveries)
+func (_ Unimplemented) GetAdminWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params GetAdminWebhooksDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторить неудачную доставку вебхука
+// (POST /admin/webhooks/deliveries/{delivery_id}/redeliver)
+func (_ Unimplemented) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, deliveryId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал входящих вебхуков
+// (GET /admin/webhooks/inbound)
+func (_ Unimplemented) GetAdminWebhooksInbound(w http.ResponseWriter, r *http.Request, params GetAdminWebhooksInboundParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторно обработать входящий вебхук
+// (POST /admin/webhooks/inbound/{webhook_id}/reprocess)
+func (_ Unimplemented) PostAdminWebhooksInboundWebhookIdReprocess(w http.ResponseWriter, r *http.Request, webhookId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список всех кодов ошибок API
+// (GET /errors)
+func (_ Unimplemented) GetErrors(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Токены приёма вебхуков
+// (GET /github/ingestionTokens)
+func (_ Unimplemented) GetGithubIngestionTokens(w http.ResponseWriter, r *http.Request, params GetGithubIngestionTokensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпустить токен приёма вебхуков репозитория
+// (POST /github/ingestionTokens/create)
+func (_ Unimplemented) PostGithubIngestionTokensCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать токен приёма вебхуков репозитория
+// (POST /github/ingestionTokens/revoke)
+func (_ Unimplemented) PostGithubIngestionTokensRevoke(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Связать PR с pull request'ом на GitHub
+// (POST /github/linkPullRequest)
+func (_ Unimplemented) PostGithubLinkPullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Привязать логин GitHub к пользователю
+// (POST /github/linkUser)
+func (_ Unimplemented) PostGithubLinkUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Репозитории, на которые установлено GitHub App
+// (GET /github/repositories)
+func (_ Unimplemented) GetGithubRepositories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подключить репозиторий к команде
+// (POST /github/setRepositoryTeam)
+func (_ Unimplemented) PostGithubSetRepositoryTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Синхронизировать команды с командами организации на GitHub
+// (POST /github/syncTeams)
+func (_ Unimplemented) PostGithubSyncTeams(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отчёт о последней синхронизации команд с GitHub
+// (GET /github/teamSyncReport)
+func (_ Unimplemented) GetGithubTeamSyncReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Приём вебхуков GitHub App
+// (POST /github/webhook)
+func (_ Unimplemented) PostGithubWebhook(w http.ResponseWriter, r *http.Request, params PostGithubWebhookParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check service health
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Проверить готовность сервиса и его зависимостей
+// (GET /health/ready)
+func (_ Unimplemented) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать шаблон PR команды
+// (POST /prTemplate/add)
+func (_ Unimplemented) PostPrTemplateAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить шаблон PR
+// (POST /prTemplate/delete)
+func (_ Unimplemented) PostPrTemplateDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать шаблон PR и заменить его настройки
+// (POST /prTemplate/edit)
+func (_ Unimplemented) PostPrTemplateEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить шаблон PR
+// (GET /prTemplate/get)
+func (_ Unimplemented) GetPrTemplateGet(w http.ResponseWriter, r *http.Request, params GetPrTemplateGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить шаблоны PR команды по имени
+// (GET /prTemplate/list)
+func (_ Unimplemented) GetPrTemplateList(w http.ResponseWriter, r *http.Request, params GetPrTemplateListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Одобрить PR от имени назначенного ревьювера (идемпотентная операция)
+// (POST /pullRequest/approve)
+func (_ Unimplemented) PostPullRequestApprove(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить ревьювера на PR
+// (POST /pullRequest/assign)
+func (_ Unimplemented) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрыть PR без слияния
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить информацию о PR по ID
+// (GET /pullRequest/get/{pull_request_id})
+func (_ Unimplemented) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam, params GetPullRequestGetPullRequestIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR по внешнему идентификатору
+// (GET /pullRequest/getByExternalId)
+func (_ Unimplemented) GetPullRequestGetByExternalId(w http.ResponseWriter, r *http.Request, params GetPullRequestGetByExternalIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR, подходящие под фильтр
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список открытых PR без ревьюверов
+// (GET /pullRequest/open-without-reviewers)
+func (_ Unimplemented) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить черновик готовым к ревью
+// (POST /pullRequest/ready)
+func (_ Unimplemented) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Передать все открытые ревью пользователя другому (например, на время отпуска)
+// (POST /pullRequest/reassignAll)
+func (_ Unimplemented) PostPullRequestReassignAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запросить изменения в PR от имени назначенного ревьювера (идемпотентная операция)
+// (POST /pullRequest/requestChanges)
+func (_ Unimplemented) PostPullRequestRequestChanges(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Полнотекстовый поиск PR по названию и описанию
+// (GET /pullRequest/search)
+func (_ Unimplemented) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включить или выключить автоматический merge PR
+// (POST /pullRequest/setAutoMerge)
+func (_ Unimplemented) PostPullRequestSetAutoMerge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить приоритет PR
+// (POST /pullRequest/setPriority)
+func (_ Unimplemented) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подтвердить, что ревьювер увидел назначение (идемпотентная операция)
+// (POST /pullRequest/{pull_request_id}/ack)
+func (_ Unimplemented) PostPullRequestPullRequestIdAck(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить пункты чек-листа PR
+// (POST /pullRequest/{pull_request_id}/checklist)
+func (_ Unimplemented) PostPullRequestPullRequestIdChecklist(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Предложить ревьюверов для ручного назначения
+// (GET /pullRequest/{pull_request_id}/suggestReviewers)
+func (_ Unimplemented) GetPullRequestPullRequestIdSuggestReviewers(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdParam, params GetPullRequestPullRequestIdSuggestReviewersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать репозиторий с настройками назначения ревьюеров
+// (POST /repository/add)
+func (_ Unimplemented) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить репозиторий
+// (POST /repository/delete)
+func (_ Unimplemented) PostRepositoryDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить настройки репозитория
+// (POST /repository/edit)
+func (_ Unimplemented) PostRepositoryEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить репозиторий
+// (GET /repository/get)
+func (_ Unimplemented) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список репозиториев
+// (GET /repository/list)
+func (_ Unimplemented) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать правило ревью
+// (POST /reviewRule/add)
+func (_ Unimplemented) PostReviewRuleAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить правило ревью
+// (POST /reviewRule/delete)
+func (_ Unimplemented) PostReviewRuleDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Проверить, как был бы направлен PR, ничего не сохраняя
+// (POST /reviewRule/dryRun)
+func (_ Unimplemented) PostReviewRuleDryRun(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить правило ревью
+// (POST /reviewRule/edit)
+func (_ Unimplemented) PostReviewRuleEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правило ревью
+// (GET /reviewRule/get)
+func (_ Unimplemented) GetReviewRuleGet(w http.ResponseWriter, r *http.Request, params GetReviewRuleGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила ревью в порядке проверки
+// (GET /reviewRule/list)
+func (_ Unimplemented) GetReviewRuleList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сохранить фильтр списка PR для пользователя или команды
+// (POST /savedFilter/add)
+func (_ Unimplemented) PostSavedFilterAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить сохранённый фильтр
+// (POST /savedFilter/delete)
+func (_ Unimplemented) PostSavedFilterDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать фильтр и заменить его условия
+// (POST /savedFilter/edit)
+func (_ Unimplemented) PostSavedFilterEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить сохранённый фильтр
+// (GET /savedFilter/get)
+func (_ Unimplemented) GetSavedFilterGet(w http.ResponseWriter, r *http.Request, params GetSavedFilterGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить фильтры пользователя и команды
+// (GET /savedFilter/list)
+func (_ Unimplemented) GetSavedFilterList(w http.ResponseWriter, r *http.Request, params GetSavedFilterListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Привязать пользователя Slack к пользователю
+// (POST /slack/linkUser)
+func (_ Unimplemented) PostSlackLinkUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить статистику по ревью
+// (GET /stats)
+func (_ Unimplemented) GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика PR автора
+// (GET /stats/author/{user_id})
+func (_ Unimplemented) GetStatsAuthorUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отчёт о равномерности распределения ревью внутри команд
+// (GET /stats/fairness)
+func (_ Unimplemented) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История статистики команды
+// (GET /stats/history/team/{team_name})
+func (_ Unimplemented) GetStatsHistoryTeamTeamName(w http.ResponseWriter, r *http.Request, teamName TeamNameParam, params GetStatsHistoryTeamTeamNameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История статистики пользователя
+// (GET /stats/history/user/{user_id})
+func (_ Unimplemented) GetStatsHistoryUserUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam, params GetStatsHistoryUserUserIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Кто вливает PR
+// (GET /stats/merges)
+func (_ Unimplemented) GetStatsMerges(w http.ResponseWriter, r *http.Request, params GetStatsMergesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика переназначений ревьюверов
+// (GET /stats/reassignments)
+func (_ Unimplemented) GetStatsReassignments(w http.ResponseWriter, r *http.Request, params GetStatsReassignmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика PR репозитория
+// (GET /stats/repo/{repository_name})
+func (_ Unimplemented) GetStatsRepoRepositoryName(w http.ResponseWriter, r *http.Request, repositoryName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задержки и ошибки API
+// (GET /stats/service)
+func (_ Unimplemented) GetStatsService(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика теневых ревью
+// (GET /stats/shadow)
+func (_ Unimplemented) GetStatsShadow(w http.ResponseWriter, r *http.Request, params GetStatsShadowParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сводка по организации
+// (GET /stats/summary)
+func (_ Unimplemented) GetStatsSummary(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить количество закрытых PR у команды
+// (GET /stats/team/{team_name}/merged-review-count)
+func (_ Unimplemented) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить количество назначенных OPEN PR у команды
+// (GET /stats/team/{team_name}/open-review-count)
+func (_ Unimplemented) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Временные ряды пропускной способности ревью
+// (GET /stats/timeseries)
+func (_ Unimplemented) GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params GetStatsTimeseriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Динамика PR без ревьюверов
+// (GET /stats/unassigned)
+func (_ Unimplemented) GetStatsUnassigned(w http.ResponseWriter, r *http.Request, params GetStatsUnassignedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить количество закрытых PR у пользователя
+// (GET /stats/user/{user_id}/merged-review-count)
+func (_ Unimplemented) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить количество назначенных OPEN PR у пользователя
+// (GET /stats/user/{user_id}/open-review-count)
+func (_ Unimplemented) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Скорость ревью пользователя
+// (GET /stats/user/{user_id}/turnaround)
+func (_ Unimplemented) GetStatsUserUserIdTurnaround(w http.ResponseWriter, r *http.Request, userId UserIdParam, params GetStatsUserUserIdTurnaroundParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массово деактивировать команду и переназначить ревью
+// (POST /team/deactivate)
+func (_ Unimplemented) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить команду
+// (POST /team/edit)
+func (_ Unimplemented) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить родительскую и дочерние команды
+// (GET /team/hierarchy)
+func (_ Unimplemented) GetTeamHierarchy(w http.ResponseWriter, r *http.Request, params GetTeamHierarchyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду из снимка
+// (POST /team/import)
+func (_ Unimplemented) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Узнать, переназначаются ли неактивные ревьюеры команды
+// (GET /team/inactiveReassign)
+func (_ Unimplemented) GetTeamInactiveReassign(w http.ResponseWriter, r *http.Request, params GetTeamInactiveReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список всех команд
+// (GET /team/list)
+func (_ Unimplemented) GetTeamList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить дежурного ревьюера на неделю
+// (POST /team/overrideRotation)
+func (_ Unimplemented) PostTeamOverrideRotation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить квоту открытых PR команды
+// (GET /team/prQuota)
+func (_ Unimplemented) GetTeamPrQuota(w http.ResponseWriter, r *http.Request, params GetTeamPrQuotaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить еженедельный отчёт команды
+// (GET /team/report)
+func (_ Unimplemented) GetTeamReport(w http.ResponseWriter, r *http.Request, params GetTeamReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить расписание отчётов команды
+// (GET /team/reportSchedule)
+func (_ Unimplemented) GetTeamReportSchedule(w http.ResponseWriter, r *http.Request, params GetTeamReportScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить бюджет ревью команды
+// (GET /team/reviewBudget)
+func (_ Unimplemented) GetTeamReviewBudget(w http.ResponseWriter, r *http.Request, params GetTeamReviewBudgetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить предпочтительных ревьюеров команды
+// (GET /team/reviewerPreferences)
+func (_ Unimplemented) GetTeamReviewerPreferences(w http.ResponseWriter, r *http.Request, params GetTeamReviewerPreferencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ротацию дежурных ревьюеров команды
+// (GET /team/rotation)
+func (_ Unimplemented) GetTeamRotation(w http.ResponseWriter, r *http.Request, params GetTeamRotationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включить или отключить переназначение неактивных ревьюеров команды
+// (POST /team/setInactiveReassign)
+func (_ Unimplemented) PostTeamSetInactiveReassign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ограничить число открытых PR у участников команды
+// (POST /team/setPRQuota)
+func (_ Unimplemented) PostTeamSetPRQuota(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить или снять родительскую команду
+// (POST /team/setParent)
+func (_ Unimplemented) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настроить еженедельный отчёт команды
+// (POST /team/setReportSchedule)
+func (_ Unimplemented) PostTeamSetReportSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать бюджет ревью на спринт
+// (POST /team/setReviewBudget)
+func (_ Unimplemented) PostTeamSetReviewBudget(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать предпочтительных ревьюеров для авторов
+// (POST /team/setReviewerPreferences)
+func (_ Unimplemented) PostTeamSetReviewerPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать обязательную роль ревьюера для PR команды
+// (POST /team/setReviewerRequirements)
+func (_ Unimplemented) PostTeamSetReviewerRequirements(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать ротацию дежурных ревьюеров команды
+// (POST /team/setRotation)
+func (_ Unimplemented) PostTeamSetRotation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка участников команды
+// (GET /team/{team_name}/capacity)
+func (_ Unimplemented) GetTeamTeamNameCapacity(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить снимок команды
+// (GET /team/{team_name}/export)
+func (_ Unimplemented) GetTeamTeamNameExport(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить пул ревьюеров команды
+// (GET /team/{team_name}/reviewerPool)
+func (_ Unimplemented) GetTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать пул ревьюеров команды
+// (POST /team/{team_name}/reviewerPool)
+func (_ Unimplemented) PostTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request, teamName TeamNameParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить пользователя
+// (POST /users/add)
+func (_ Unimplemented) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить пользователя
+// (POST /users/edit)
+func (_ Unimplemented) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить пользователя по ID
+// (GET /users/get/{user_id})
+func (_ Unimplemented) GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Найти пользователя по имени
+// (GET /users/getByUsername)
+func (_ Unimplemented) GetUsersGetByUsername(w http.ResponseWriter, r *http.Request, params GetUsersGetByUsernameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переместить пользователя в другую команду
+// (POST /users/moveToTeam)
+func (_ Unimplemented) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить пользователя как находящегося на обучении
+// (POST /users/setInTraining)
+func (_ Unimplemented) PostUsersSetInTraining(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Деактивировать нескольких пользователей
+// (POST /users/setIsActiveBatch)
+func (_ Unimplemented) PostUsersSetIsActiveBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить роль пользователя
+// (POST /users/setRole)
+func (_ Unimplemented) PostUsersSetRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить навыки пользователя
+// (POST /users/setSkills)
+func (_ Unimplemented) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки уведомлений пользователя
+// (GET /users/{user_id}/notificationPreferences)
+func (_ Unimplemented) GetUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сохранить настройки уведомлений пользователя
+// (POST /users/{user_id}/notificationPreferences)
+func (_ Unimplemented) PostUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Текущая нагрузка пользователя
+// (GET /users/{user_id}/workload)
+func (_ Unimplemented) GetUsersUserIdWorkload(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PostAdminArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminArchive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminArchive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminEventsReplay operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminEventsReplay(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminEventsReplay(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminNotificationsFailed operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminNotificationsFailed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminNotificationsFailedParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "event" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event", r.URL.Query(), &params.Event)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminNotificationsFailed(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminRebalance operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRebalance(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminRebalance(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSettings(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSettingsTeamsTeamName operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSettingsTeamsTeamName(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminSettingsTeamsTeamName operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSettingsTeamsTeamName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminSettingsTeamsTeamName(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminUsersMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminUsersMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWebhooksDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminWebhooksDeliveriesParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminWebhooksDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminWebhooksDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "delivery_id", chi.URLParam(r, "delivery_id"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminWebhooksDeliveriesDeliveryIdRedeliver(w, r, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminWebhooksInbound operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWebhooksInbound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminWebhooksInboundParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "event" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event", r.URL.Query(), &params.Event)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminWebhooksInbound(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminWebhooksInboundWebhookIdReprocess operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWebhooksInboundWebhookIdReprocess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", chi.URLParam(r, "webhook_id"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminWebhooksInboundWebhookIdReprocess(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetErrors operation middleware
+func (siw *ServerInterfaceWrapper) GetErrors(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetErrors(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGithubIngestionTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetGithubIngestionTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGithubIngestionTokensParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGithubIngestionTokens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubIngestionTokensCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubIngestionTokensCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubIngestionTokensCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubIngestionTokensRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubIngestionTokensRevoke(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubIngestionTokensRevoke(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubLinkPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubLinkPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubLinkPullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubLinkUser operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubLinkUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubLinkUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGithubRepositories operation middleware
+func (siw *ServerInterfaceWrapper) GetGithubRepositories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGithubRepositories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubSetRepositoryTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubSetRepositoryTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubSetRepositoryTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubSyncTeams operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubSyncTeams(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubSyncTeams(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGithubTeamSyncReport operation middleware
+func (siw *ServerInterfaceWrapper) GetGithubTeamSyncReport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGithubTeamSyncReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGithubWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostGithubWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGithubWebhookParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Event", Err: err})
+			return
+		}
+
+		params.XGitHubEvent = XGitHubEvent
+
+	} else {
+		err := fmt.Errorf("Header parameter X-GitHub-Event is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Event", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Hub-Signature-256", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Hub-Signature-256", Err: err})
+			return
+		}
+
+		params.XHubSignature256 = XHubSignature256
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Hub-Signature-256 is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Hub-Signature-256", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "X-GitHub-Delivery" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Delivery")]; found {
+		var XGitHubDelivery string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Delivery", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Delivery", valueList[0], &XGitHubDelivery, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Delivery", Err: err})
+			return
+		}
+
+		params.XGitHubDelivery = &XGitHubDelivery
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGithubWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPrTemplateAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostPrTemplateAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPrTemplateAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPrTemplateDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostPrTemplateDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPrTemplateDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPrTemplateEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostPrTemplateEdit(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPrTemplateEdit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPrTemplateGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPrTemplateGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPrTemplateGetParams
+
+	// ------------- Required query parameter "template_id" -------------
+
+	if paramValue := r.URL.Query().Get("template_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "template_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "template_id", r.URL.Query(), &params.TemplateId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPrTemplateGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPrTemplateList operation middleware
+func (siw *ServerInterfaceWrapper) GetPrTemplateList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPrTemplateListParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPrTemplateList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestApprove(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestApprove(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestAssign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestAssign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestClose(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "explain", r.URL.Query(), &params.Explain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGetPullRequestId operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetPullRequestIdParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGetPullRequestId(w, r, pullRequestId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGetByExternalId operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGetByExternalId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetByExternalIdParams
+
+	// ------------- Required query parameter "external_id" -------------
+
+	if paramValue := r.URL.Query().Get("external_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "external_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "external_id", r.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "external_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGetByExternalId(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "filter_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter_id", r.URL.Query(), &params.FilterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_age_hours" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_age_hours", r.URL.Query(), &params.MinAgeHours)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_age_hours", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unreviewed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unreviewed", r.URL.Query(), &params.Unreviewed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unreviewed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestOpenWithoutReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestOpenWithoutReviewers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "explain", r.URL.Query(), &params.Explain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassignAll operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassignAll(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassignAll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestRequestChanges operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRequestChanges(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestRequestChanges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestSetAutoMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSetAutoMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestSetAutoMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestSetPriority operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestSetPriority(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestPullRequestIdAck operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPullRequestIdAck(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestPullRequestIdAck(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestPullRequestIdChecklist operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPullRequestIdChecklist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestPullRequestIdChecklist(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestPullRequestIdSuggestReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestPullRequestIdSuggestReviewers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestPullRequestIdSuggestReviewersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestPullRequestIdSuggestReviewers(w, r, pullRequestId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryEdit(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryEdit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
+
+	// ------------- Required query parameter "repository_name" -------------
+
+	if paramValue := r.URL.Query().Get("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryList operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReviewRuleAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostReviewRuleAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReviewRuleAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReviewRuleDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostReviewRuleDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReviewRuleDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReviewRuleDryRun operation middleware
+func (siw *ServerInterfaceWrapper) PostReviewRuleDryRun(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReviewRuleDryRun(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReviewRuleEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostReviewRuleEdit(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReviewRuleEdit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReviewRuleGet operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewRuleGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReviewRuleGetParams
+
+	// ------------- Required query parameter "rule_name" -------------
+
+	if paramValue := r.URL.Query().Get("rule_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rule_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "rule_name", r.URL.Query(), &params.RuleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReviewRuleGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReviewRuleList operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewRuleList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReviewRuleList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSavedFilterAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostSavedFilterAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSavedFilterAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSavedFilterDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostSavedFilterDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSavedFilterDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSavedFilterEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostSavedFilterEdit(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSavedFilterEdit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavedFilterGet operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedFilterGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSavedFilterGetParams
+
+	// ------------- Required query parameter "filter_id" -------------
+
+	if paramValue := r.URL.Query().Get("filter_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "filter_id", r.URL.Query(), &params.FilterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedFilterGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavedFilterList operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedFilterList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSavedFilterListParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedFilterList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSlackLinkUser operation middleware
+func (siw *ServerInterfaceWrapper) PostSlackLinkUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSlackLinkUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsAuthorUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsAuthorUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsAuthorUserId(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsHistoryTeamTeamName operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsHistoryTeamTeamName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsHistoryTeamTeamNameParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsHistoryTeamTeamName(w, r, teamName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsHistoryUserUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsHistoryUserUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsHistoryUserUserIdParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsHistoryUserUserId(w, r, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsMerges operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsMerges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsMergesParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsMerges(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReassignments operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReassignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReassignmentsParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReassignments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsRepoRepositoryName operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsRepoRepositoryName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "repository_name" -------------
+	var repositoryName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository_name", chi.URLParam(r, "repository_name"), &repositoryName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsRepoRepositoryName(w, r, repositoryName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsService operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsService(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsService(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsShadow operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsShadow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsShadowParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsShadow(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsSummary(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsSummary(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTeamTeamNameMergedReviewCount operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeamTeamNameMergedReviewCount(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTeamTeamNameOpenReviewCount operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeamTeamNameOpenReviewCount(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTimeseries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTimeseriesParams
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", r.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTimeseries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsUnassigned operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsUnassigned(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsUnassignedParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsUnassigned(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsUserUserIdMergedReviewCount operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsUserUserIdMergedReviewCount(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsUserUserIdOpenReviewCount operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsUserUserIdOpenReviewCount(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsUserUserIdTurnaround operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsUserUserIdTurnaround(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsUserUserIdTurnaroundParams
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window_days", r.URL.Query(), &params.WindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsUserUserIdTurnaround(w, r, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamEdit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamHierarchy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamHierarchy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamHierarchyParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamHierarchy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamInactiveReassign operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamInactiveReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamInactiveReassignParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamInactiveReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamOverrideRotation operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamOverrideRotation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamOverrideRotation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamPrQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamPrQuota(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamPrQuotaParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamPrQuota(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReportParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReportScheduleParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReportSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReviewBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReviewBudget(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReviewBudgetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReviewBudget(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReviewerPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReviewerPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReviewerPreferencesParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReviewerPreferences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// Добавить пользователя
-// (POST /users/add)
-func (_ Unimplemented) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// GetTeamRotation operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamRotation(w http.ResponseWriter, r *http.Request) {
 
-// Изменить пользователя
-// (POST /users/edit)
-func (_ Unimplemented) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Получить пользователя по ID
-// (GET /users/get/{user_id})
-func (_ Unimplemented) GetUsersGetUserId(w http.ResponseWriter, r *http.Request, userId UserIdParam) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamRotationParams
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Required query parameter "team_name" -------------
 
-// Переместить пользователя в другую команду
-// (POST /users/moveToTeam)
-func (_ Unimplemented) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// ------------- Optional query parameter "weeks" -------------
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "weeks", r.URL.Query(), &params.Weeks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weeks", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetTeamRotation(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -466,11 +6463,11 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestAssign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestAssign(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetInactiveReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetInactiveReassign(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestAssign(w, r)
+		siw.Handler.PostTeamSetInactiveReassign(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -480,11 +6477,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestAssign(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetPRQuota operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetPRQuota(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostTeamSetPRQuota(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -494,22 +6491,25 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetPullRequestGetPullRequestId operation middleware
-func (siw *ServerInterfaceWrapper) GetPullRequestGetPullRequestId(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostTeamSetParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "pull_request_id" -------------
-	var pullRequestId PullRequestIdParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetParent(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReportSchedule(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPullRequestGetPullRequestId(w, r, pullRequestId)
+		siw.Handler.PostTeamSetReportSchedule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -519,11 +6519,11 @@ func (siw *ServerInterfaceWrapper) GetPullRequestGetPullRequestId(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetReviewBudget operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewBudget(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostTeamSetReviewBudget(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -533,11 +6533,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPullRequestOpenWithoutReviewers operation middleware
-func (siw *ServerInterfaceWrapper) GetPullRequestOpenWithoutReviewers(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetReviewerPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewerPreferences(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPullRequestOpenWithoutReviewers(w, r)
+		siw.Handler.PostTeamSetReviewerPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -547,11 +6547,11 @@ func (siw *ServerInterfaceWrapper) GetPullRequestOpenWithoutReviewers(w http.Res
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetReviewerRequirements operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewerRequirements(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostTeamSetReviewerRequirements(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -561,11 +6561,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetStats operation middleware
-func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+// PostTeamSetRotation operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetRotation(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStats(w, r)
+		siw.Handler.PostTeamSetRotation(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -575,8 +6575,8 @@ func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsTeamTeamNameMergedReviewCount operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameMergedReviewCount(w http.ResponseWriter, r *http.Request) {
+// GetTeamTeamNameCapacity operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTeamNameCapacity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -590,7 +6590,7 @@ func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameMergedReviewCount(w http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsTeamTeamNameMergedReviewCount(w, r, teamName)
+		siw.Handler.GetTeamTeamNameCapacity(w, r, teamName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -600,8 +6600,8 @@ func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameMergedReviewCount(w http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsTeamTeamNameOpenReviewCount operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameOpenReviewCount(w http.ResponseWriter, r *http.Request) {
+// GetTeamTeamNameExport operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTeamNameExport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -615,7 +6615,7 @@ func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameOpenReviewCount(w http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsTeamTeamNameOpenReviewCount(w, r, teamName)
+		siw.Handler.GetTeamTeamNameExport(w, r, teamName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -625,22 +6625,22 @@ func (siw *ServerInterfaceWrapper) GetStatsTeamTeamNameOpenReviewCount(w http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsUserUserIdMergedReviewCount operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsUserUserIdMergedReviewCount(w http.ResponseWriter, r *http.Request) {
+// GetTeamTeamNameReviewerPool operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserIdParam
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNameParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsUserUserIdMergedReviewCount(w, r, userId)
+		siw.Handler.GetTeamTeamNameReviewerPool(w, r, teamName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -650,22 +6650,22 @@ func (siw *ServerInterfaceWrapper) GetStatsUserUserIdMergedReviewCount(w http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsUserUserIdOpenReviewCount operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsUserUserIdOpenReviewCount(w http.ResponseWriter, r *http.Request) {
+// PostTeamTeamNameReviewerPool operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameReviewerPool(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserIdParam
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNameParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsUserUserIdOpenReviewCount(w, r, userId)
+		siw.Handler.PostTeamTeamNameReviewerPool(w, r, teamName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -675,11 +6675,11 @@ func (siw *ServerInterfaceWrapper) GetStatsUserUserIdOpenReviewCount(w http.Resp
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostUsersAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostUsersAdd(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -689,11 +6689,11 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamDeactivate operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+// PostUsersEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamDeactivate(w, r)
+		siw.Handler.PostUsersEdit(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -703,11 +6703,22 @@ func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamEdit operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamEdit(w http.ResponseWriter, r *http.Request) {
+// GetUsersGetUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamEdit(w, r)
+		siw.Handler.GetUsersGetUserId(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -717,31 +6728,39 @@ func (siw *ServerInterfaceWrapper) PostTeamEdit(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetUsersGetByUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetByUsername(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetUsersGetByUsernameParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "username" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetUsersGetByUsername(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -751,11 +6770,31 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersAdd(w http.ResponseWriter, r *http.Request) {
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersAdd(w, r)
+		siw.Handler.GetUsersGetReview(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -765,11 +6804,81 @@ func (siw *ServerInterfaceWrapper) PostUsersAdd(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersEdit operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersEdit(w http.ResponseWriter, r *http.Request) {
+// PostUsersMoveToTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMoveToTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetInTraining operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetInTraining(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetInTraining(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActiveBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActiveBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActiveBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetRole operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetRole(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetRole(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersEdit(w, r)
+		siw.Handler.PostUsersSetSkills(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -779,8 +6888,8 @@ func (siw *ServerInterfaceWrapper) PostUsersEdit(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetUserId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetUserId(w http.ResponseWriter, r *http.Request) {
+// GetUsersUserIdNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -794,7 +6903,7 @@ func (siw *ServerInterfaceWrapper) GetUsersGetUserId(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetUserId(w, r, userId)
+		siw.Handler.GetUsersUserIdNotificationPreferences(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -804,31 +6913,22 @@ func (siw *ServerInterfaceWrapper) GetUsersGetUserId(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostUsersUserIdNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdNotificationPreferences(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostUsersUserIdNotificationPreferences(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -838,25 +6938,22 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersMoveToTeam operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersMoveToTeam(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersMoveToTeam(w, r)
-	}))
+// GetUsersUserIdWorkload operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdWorkload(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdParam
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.GetUsersUserIdWorkload(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -979,42 +7076,267 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/archive", wrapper.PostAdminArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/events/replay", wrapper.PostAdminEventsReplay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export", wrapper.GetAdminExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/notifications/failed", wrapper.GetAdminNotificationsFailed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/rebalance", wrapper.PostAdminRebalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/settings", wrapper.GetAdminSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/settings", wrapper.PutAdminSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/settings/teams/{team_name}", wrapper.GetAdminSettingsTeamsTeamName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/settings/teams/{team_name}", wrapper.PutAdminSettingsTeamsTeamName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/merge", wrapper.PostAdminUsersMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/webhooks/deliveries", wrapper.GetAdminWebhooksDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/webhooks/deliveries/{delivery_id}/redeliver", wrapper.PostAdminWebhooksDeliveriesDeliveryIdRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/webhooks/inbound", wrapper.GetAdminWebhooksInbound)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/webhooks/inbound/{webhook_id}/reprocess", wrapper.PostAdminWebhooksInboundWebhookIdReprocess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/errors", wrapper.GetErrors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/github/ingestionTokens", wrapper.GetGithubIngestionTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/ingestionTokens/create", wrapper.PostGithubIngestionTokensCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/ingestionTokens/revoke", wrapper.PostGithubIngestionTokensRevoke)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/linkPullRequest", wrapper.PostGithubLinkPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/linkUser", wrapper.PostGithubLinkUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/github/repositories", wrapper.GetGithubRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/setRepositoryTeam", wrapper.PostGithubSetRepositoryTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/syncTeams", wrapper.PostGithubSyncTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/github/teamSyncReport", wrapper.GetGithubTeamSyncReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/github/webhook", wrapper.PostGithubWebhook)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/prTemplate/add", wrapper.PostPrTemplateAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/prTemplate/delete", wrapper.PostPrTemplateDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/prTemplate/edit", wrapper.PostPrTemplateEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/prTemplate/get", wrapper.GetPrTemplateGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/prTemplate/list", wrapper.GetPrTemplateList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/approve", wrapper.PostPullRequestApprove)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/assign", wrapper.PostPullRequestAssign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/pullRequest/get/{pull_request_id}", wrapper.GetPullRequestGetPullRequestId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/getByExternalId", wrapper.GetPullRequestGetByExternalId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/pullRequest/open-without-reviewers", wrapper.GetPullRequestOpenWithoutReviewers)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassignAll", wrapper.PostPullRequestReassignAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/requestChanges", wrapper.PostPullRequestRequestChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/search", wrapper.GetPullRequestSearch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/setAutoMerge", wrapper.PostPullRequestSetAutoMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/setPriority", wrapper.PostPullRequestSetPriority)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/{pull_request_id}/ack", wrapper.PostPullRequestPullRequestIdAck)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/{pull_request_id}/checklist", wrapper.PostPullRequestPullRequestIdChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/{pull_request_id}/suggestReviewers", wrapper.GetPullRequestPullRequestIdSuggestReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/add", wrapper.PostRepositoryAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/delete", wrapper.PostRepositoryDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/edit", wrapper.PostRepositoryEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/list", wrapper.GetRepositoryList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reviewRule/add", wrapper.PostReviewRuleAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reviewRule/delete", wrapper.PostReviewRuleDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reviewRule/dryRun", wrapper.PostReviewRuleDryRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reviewRule/edit", wrapper.PostReviewRuleEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reviewRule/get", wrapper.GetReviewRuleGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reviewRule/list", wrapper.GetReviewRuleList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/savedFilter/add", wrapper.PostSavedFilterAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/savedFilter/delete", wrapper.PostSavedFilterDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/savedFilter/edit", wrapper.PostSavedFilterEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/savedFilter/get", wrapper.GetSavedFilterGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/savedFilter/list", wrapper.GetSavedFilterList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/slack/linkUser", wrapper.PostSlackLinkUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stats", wrapper.GetStats)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/author/{user_id}", wrapper.GetStatsAuthorUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/history/team/{team_name}", wrapper.GetStatsHistoryTeamTeamName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/history/user/{user_id}", wrapper.GetStatsHistoryUserUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/merges", wrapper.GetStatsMerges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reassignments", wrapper.GetStatsReassignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/repo/{repository_name}", wrapper.GetStatsRepoRepositoryName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/service", wrapper.GetStatsService)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/shadow", wrapper.GetStatsShadow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/summary", wrapper.GetStatsSummary)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stats/team/{team_name}/merged-review-count", wrapper.GetStatsTeamTeamNameMergedReviewCount)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stats/team/{team_name}/open-review-count", wrapper.GetStatsTeamTeamNameOpenReviewCount)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/timeseries", wrapper.GetStatsTimeseries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/unassigned", wrapper.GetStatsUnassigned)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stats/user/{user_id}/merged-review-count", wrapper.GetStatsUserUserIdMergedReviewCount)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stats/user/{user_id}/open-review-count", wrapper.GetStatsUserUserIdOpenReviewCount)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/user/{user_id}/turnaround", wrapper.GetStatsUserUserIdTurnaround)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
@@ -1027,6 +7349,75 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/hierarchy", wrapper.GetTeamHierarchy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.PostTeamImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/inactiveReassign", wrapper.GetTeamInactiveReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/list", wrapper.GetTeamList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/overrideRotation", wrapper.PostTeamOverrideRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/prQuota", wrapper.GetTeamPrQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/report", wrapper.GetTeamReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/reportSchedule", wrapper.GetTeamReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/reviewBudget", wrapper.GetTeamReviewBudget)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/reviewerPreferences", wrapper.GetTeamReviewerPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/rotation", wrapper.GetTeamRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setInactiveReassign", wrapper.PostTeamSetInactiveReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setPRQuota", wrapper.PostTeamSetPRQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setParent", wrapper.PostTeamSetParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReportSchedule", wrapper.PostTeamSetReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewBudget", wrapper.PostTeamSetReviewBudget)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewerPreferences", wrapper.PostTeamSetReviewerPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewerRequirements", wrapper.PostTeamSetReviewerRequirements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setRotation", wrapper.PostTeamSetRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/{team_name}/capacity", wrapper.GetTeamTeamNameCapacity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/{team_name}/export", wrapper.GetTeamTeamNameExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/{team_name}/reviewerPool", wrapper.GetTeamTeamNameReviewerPool)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/{team_name}/reviewerPool", wrapper.PostTeamTeamNameReviewerPool)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/add", wrapper.PostUsersAdd)
 	})
@@ -1036,15 +7427,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/get/{user_id}", wrapper.GetUsersGetUserId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getByUsername", wrapper.GetUsersGetByUsername)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/moveToTeam", wrapper.PostUsersMoveToTeam)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setInTraining", wrapper.PostUsersSetInTraining)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActiveBatch", wrapper.PostUsersSetIsActiveBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setRole", wrapper.PostUsersSetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/notificationPreferences", wrapper.GetUsersUserIdNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/notificationPreferences", wrapper.PostUsersUserIdNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/workload", wrapper.GetUsersUserIdWorkload)
+	})
 
 	return r
 }
@@ -1052,60 +7467,651 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcbW8bx/H/Kov9/4E4wMmUZTtA9I6xFVdALKsUnRY1BOLEW0uXkHfM3dGJIRDQQ9q0",
-	"VRA1QF8ERZM0yBegFbOiZZH6CrPfqJjdvSfeA48Pkuw2bxKR3NudnZ35zfxm57xL63azZVvM8ly6vEtb",
-	"uqM3mccc8Wm93WhU2Gdt5nqrxjr+hN8azK07ZsszbYsuU/gOXkIPBvwA+vxL6MMZdPkBDPkewceJep5q",
-	"1MThLd3boRq19CbDT+1Go+bIETXToBrFD6bDDLrsOW2mUbe+w5o6Lus9b+EjrueY1jbtdDRaZXpzTW+y",
-	"LMl+hoGUB17zr2EAQ+gR6MM5PyZwBkM4hy4M4CU/ShfOY3qzJv6eTqzftpnzfB5ifSYmmlmuxy5zpjlG",
-	"uIChEPUUhnAivu7Ba36crrW2y5zJj1LKlqWx6WUbUd00wnX8H4VL3LPblldhbsu2XCY8xrFbzPFMJn6u",
-	"48+RWUzLY9vMEXsMl3yixm1q/jh76xNW92hHoyuOYzvRBdgXerPVkH/ib3IZA59ae1Stffjo8dp9qtEm",
-	"c119G791mGu3nTojlu2Rp3bbMsTycUGDqUblN+RKVruJclZXyg9rK79f3ahuUI2uV2J/P1ypPFjBtVGO",
-	"8sbG6oM19bF2r7x2f/V+ubqifvWl/Lj8EX69+mittlKpPKpQjT7eWKnUxAz3qqsf4wOra9WVylr5IzUk",
-	"1JJ/JpHdphlTXNEGi2gnqfGR8VIvaQcTAcOk3nTXNbctZtQc9sxknyv8jJuxMj4CA+jCKf6Xf4VmDQN+",
-	"xP9I+B704IR/zb+BE+jxPTRocmPx5s2ld9GaPdZ0U7YbCKo7jv4cP+ttb8cWVp42uu4w3WNGWezhqe00",
-	"dY8uU0P32IJnCkix2o2GvoUGJz0jRffO9mwzjMJ+mqCxMdJ9U0a5nu613ajFPlpfWaMaVbaZtJ2R805G",
-	"oOTCUZ0GS2ppZz7Gbu4J5WcbUe7JFVFI3uYSWxkj7MaO7cwm5Ft6sml62fB0b9VjzaQ+5OHXAuAPPMK0",
-	"vPfuUC0RCbQgCqWeX+rSbnbEUeuj7OJzABT/77CndJn+XynM8EoqkJWC7STwI00CTGiSCzdZc0vhXKE1",
-	"cZaH4pk01AqTmrFWHc1/fCE2M8S+z/S6Zz7L87qpVi6yXtaJGcEYo4am4NYyswZcfgRmckZnnZ3SekIQ",
-	"060JSZiU6qnebngjmL1l2w2mW/lWK38rpsIwAwue0SKCpOkVc8MxwielzTvVS91L1DrH76tsGJmGOfMO",
-	"i+9iQtlxCtN6aovJTQ8jPV2vkIqKg6QsTLbJLI9sMOeZWWfkRpW5Hqnq7qca+VBvNMjS4tJdTGyeMceV",
-	"+dGtm4s3F1F2u8UsvWXSZXr75uLN24jcurcjdFLaYXrD28E/t5nQGWpMxwxr1aDL9AHzfiNH4CalB4oH",
-	"lxYXkxmZL5zpEjmvBEC33WzqSETovR1W/5S4atiOP7Onb7uoOrXUJj5UaoWhsyR9Vhyp7aaIuW67XiTU",
-	"Sn0pVsJc7wPbeC6zcctj0tf1Vqth1sUMpU9cW8wdcpa44RQJwrkxKD+M+o+m20WcWHXSj6HwxvICSjQh",
-	"F0uPsMZ/xfPpRN6NarizeGdu8sSZW4pE6xXk+a+hn0Vbv0YZe1LQV5LzSiHfLyCk4okqNmOKLkQPbBm+",
-	"l2vAKT+O66LLD+TSXSKTK7KOxOyZ3min0s4o9QtpZ123kHBK0ye2RaQQOJfQhWV75QDOImL9mKMK6MIZ",
-	"sn48QRjkyZRkkaFkaLDo40I8KUInwutnPlf4Hrp8jx/yPws618cjfCkKOSG7gwu+B104QQNIMsA+Px5B",
-	"HpwzGNIXB5QgiF15ZuKwfEiKOIWbAkyS/hUGJklYJgamSMkiQhdo+1ZqEr5My4ZBXKY79R1a+FQyaVUh",
-	"FLo12S5aThbJf0LbS4iJtxEOE5uNsG2KAW/h1uLC0p3qraXl23eW7773Bxpl00ibUxgUbTkLtxYXC+gu",
-	"JE2SK8VNfCRGOJPBayrWJ+CN78MQTuEldK8BXuFvcCLLgqVoHVW6SRxV+dHEuJoBhEE9LISb9QoxDaI3",
-	"HKYbzwn7wkRXnCPcoJ4P4d/QI3yfH/K/QI/v8wM44YfQ4wejOPKTfyICQ0QIIghEqClUkSiqfoVzwBn+",
-	"NEjgDmIZWUqvT0EfTkeq1sHsiFDFkWmbeaXdEePv5OV5kfnin1YF149cZDxJV3g4pJRy0dHZvMbU5Qd4",
-	"wf8KPQx+In5eQ6KSTETidiXD9mFoJX0Y8C/FqaNV/Qn6/BsCQ2FxFzAkq/eL24LAxMJB6qEYPUOMyobc",
-	"PAAdm2SPSaQvL32eQ+AKo1JW3JpPnFJ55NVHKjgRwUog55Afi7StT3xx3lB/O0eAV/6Gz5xhfuzn7Deg",
-	"L548R3cTWfSAHwggPyYwhAuZMgrHPH63uC8iGV/43PR27La3ELvdKADMj1rM+p18thI8OiOuFio2JurY",
-	"yUJnEnV/ggvoixTmTLKWXMDj+5HhQuNnfI8f8QOR7+PxvIAenKbGzeLq9+t/hdGw4j8wAyDajRAqFCgs",
-	"5bp8jvviXHnltplxVIstcf2oehtR9W4qqs4zt8c9tBp6nRm1LbTP9l06PxAdmTznJhM5+xB+gWEKPaVj",
-	"r2gcGl9pswB4w49i9l6SRCOCnvAjWVwR5YMBDP+nqzyiAsGPZYKGYWEfXkOPBNWb6Uo8PialFnnu6ZZh",
-	"GqrIEJeLHwgugTGXH8KFXxk5UzytL1mKwsdM0Ua6C0LpLFtVd4gyKVGArvvyENMiHsPM3q9GKf+doB5F",
-	"4AU/SingpIH8ef4mYh0T0eYNVUP361VKSOLZxNsxXaXp6yxeXWT5X7KIleaqii4gZxxgyBQ5zSATRGSN",
-	"C16ijDhEDJO0syf/Hu2ZyomswS1pVu4iLlvpJTK/+G1uahbCDxQr3xelzzPc/kVMN+NTk9E5+GFijlBR",
-	"ctMRDZXQT0q7wXVQRzIyQ+V/C8ENZK4aq0xv+r1wgqMZMgcULVQTU/R4s9+lsvN4j1faGf1DRBhVN+EH",
-	"GAOvg6cLOXLKXNAdy9rPUnYCp6LuHktm0YCyHK2A/QgWMbX1II341XbeDttJb27DnHVyM8I8s7Srss3p",
-	"QOixyxzZXDo7BEUbaH81Imt8sjRJ+XASIMpu+i1sS5MDUmhJs8LRr3Z01XY0HpQmMykR33TDyK/KYNgp",
-	"G8YslZigye7JbtiyIYsJYYcPLTfMOqMdLTZmKT7mA3tLGFukbYi29OdN8eZHYTpRDQjUnG9YPdVjGN1w",
-	"pAFKNqUV0UDeQwVUsqXXP2WqYz6rmOLLWkBRReoZ8UAcvULFGIyeuHiFnvg99NCH+J6gCmfIzfgRvJJY",
-	"jDxwyPfJDeFR+KkvitJ7GkGvQccTJF4Vmedxxxp/AyEkysGhXeJN6+jRTHvrGkt5DgnfJwKxumIG/2Wg",
-	"c+iTG+Hp82/5QQmG8EIV2V7zY1lBSUUq6MGraFEfzS8GVmHT6XjMCptYZ2hJG+ccyc7cK24fy2jXLZAq",
-	"v4w1JvVlSV/6qxZh2JmVEr/74I3O9v8pzHNfFngz95xm3rLymVX4Sa1AJMyVGaY33lBXcNS8uiYt9nkt",
-	"v6fWbhi1CbrF48O1kQWuu3syjOSJl+2SbyKKgpsqCV5PVb2IAReNNpcUHfYJP0AfwZhIZGAU6jqfJHB8",
-	"F+g5aKtIfTE003MUh8miMjj+AZu+hCJf0ZyStUTCfCJNe2MSP21WB4o274yc21ta5CmQumSYpHi/ZTxT",
-	"QkbsTkOViulp5E2LufGX4qtPRHqTXZTvvwFUfAIQ+7vIW8WFUXD1OIZdCwuIGc34FEA8M2MOUOzgri4s",
-	"T2ws8cj8FlVuEpFuGiMRXaN+US8v7olH1f+naBC9spJd5vnHokqWqt7iul3GllKaR1OtQNZji1iAGjmd",
-	"Bcwr/Yl2+8jlL7VZaHMkGyrY1+rOsd9uglfOwvcq48IU6g6KN/G9I7vU//v8Zb3yDj/SCPyCw3M7jQo1",
-	"qmT7VtN+xqp28Ap4fjB+GA6+Olo+hV29WVR88pjvl1fOoYe52BsV91XvW4E3cNJ7hc5VbjkuLpwETUH8",
-	"kH8zQlRyTdpl3qobvos4xqY3IqNnuMmJ0NOnesNlxRF5zHvgU5j/uHe759wu21Yv8CdVkEbAxxL3HFX5",
-	"KxXwtiKx5IdI9f1beW0IrzLB9i2KJj/LDjG1OVWY/RJeQxd+IZE670C9IdGfKDvvBN/t+v/wlqwJdLTg",
-	"Czk48kWsWy/yvXrHPvKNvIjtbHb+EwAA//9VuHCwvU4AAA==",
+	"H4sIAAAAAAAC/+z9C28bWZYmiv6VAGcOWsIJW7LTruqU0cCobGWmzvVDTcmV1Z3pS4fJkMUxRapJyo/O",
+	"a8CSypVZoyyrM2/2VKGnq7KyqoEeYDAALZs29aKB/gURf+H+kou91trvvSOCkmzJNXlwpjpNkRH7sfba",
+	"6/Gtb31RqraWV1rNuNntlKa+KK1E7Wg57sZt+Ncn9U631X70Ubu1PMf+wD6rxZ1qu77SrbeapalS8n3S",
+	"T58k2+lmshMkL5N+cpB+HYzdXLg8filI3iTDIN1I9pNhspd+mfSSg2SQPguS10kvOP8h+/5B0scfDoNu",
+	"qxSW6uyh/7Aatx+VwlIzWo5LU6XFdmu5FJY61aV4OWJDWGy1l6NuaapUi7pxKSx1H62w73W67Xrzbunx",
+	"45APfKHlH/YwXUv2kj6MYWANPki2k91kL32WfpkM0vWkn+ylXycHydA/q3Qt6ScvkiF7YrrlmUu3NeJM",
+	"5lYbjXL8D6txpztb883mdzT49WSQ/jIZJLtJL11PhumTgP08oN/zIa1E3SU5opXVRqPSxm9U6rVSWGL/",
+	"qLfjWmmq216N1eHaw1uIo+Xr0XLsG9mfYXV3kx5fv6QfJINkP90Kkt1kmOzD8r1MN92D68bRcgX++3DD",
+	"+ltY/WMYlrmNhxzXzU7cPsw2MpmDob5Ohsl20iOJ3HKv2monbo++lTg234odfmzG0h1mcI/5H0ErTber",
+	"S/X7MZdqprXarZW43a3H8PfluH03rlXuxIutdlypRY86jvn8U/okfZoMku1kkD7hA0+/DubKITvJ+6DW",
+	"XrEpJwfpJhOP52yaST/ps8PPROc1CAkTnhdMIzBFwVRKT9FrB/S1l6WwtFxv1pdXl0tTk+Kc15vd+G7c",
+	"huWXq/GZawa3xI9ad/5rXO2WHodyITorrWYntlciwi/UKtXWarOrrKzvxcYPnC/tdOp3m8txszvzcKUR",
+	"NSNcUWuB/yV9gpqT/d9kEMAd8ZxJTNIL0idJP9lOv06fJdvwjV6QbIMkpU/SreRlsgsHMn0aJG/g90Px",
+	"tPWkNwXfTF6ma7QPu0kvGDOf6b9+BsnrIP0q6SXPk71kyDaJfbaXDIJ0HZ6ywzY6fTIe4q3wKt1In6Rr",
+	"6XqynQwD2Fv28V4yCHF87N9s7F+C7DPV0g+SXrKNJyTpsa/BNPvpk3STzxTuH/64XrINw3uqrI3xjHQN",
+	"5PQNk6eklz5Lf40vOoCvbSa7yeBSkK7ReAbsQfDUdJOt4y6qtGQAP15nj+J7MoBv9tN19mu2AniGXyQD",
+	"Sx9+3iyFhpBVo2atzu4v1zH7Idkl7bCbDD2DwL18yv4XtmHIVn0v3eLDg+mX7EMTlqqtVqPWeuASv98r",
+	"qzpU5W2Q7GnrGgjZZP9KDoJ0XR0x2yj2wX66EQawwWx46ZN0A9bHt7AHoDHSTTYdOfQ7rVYjjpps6Ets",
+	"GjXHwP94BCmeKwtBVlQUO1q6YCf9oBzfr8cP4nbHObrlqFtdimuVzr16o9FxLq+QOedhhsHhO5+nG0k/",
+	"2QcpnCuXwlK9Gy93HGpeDCRqt6NH7N+tlbhZacNIXYP4Q7qe7LITla6DilZPDhzqfRBduLY0YboUJH0Q",
+	"9a9DY7v7cCoHqHvSDbT+2JFlpgKbq+OA4Iqz/0n22deDZKgOTDvSTjFeaceLcTtuVuPKg7h+d6nrmOu3",
+	"bGgebcMGoUk0Hp/0N+k6v5ysLboUTAb/vyffZTzxgGkE53jbrUbs2o7kebrFRE6xl3tsHE9whUM66qBS",
+	"cLDpRvqMdoafPmOkl2A107V0A/53Pdlm4pSuh8peqb/Hk4fv4S+2DWs2h67v5vqOq3y4+XcC+2LRVCLK",
+	"Gi32jno99J1nq9NtR934rsvI+sEWLvXWBFl+lbxMNwJSlkz178KtMNT0aLoFl8M+Wx1TP/XYx6WwFDeZ",
+	"MfJZqR01a+BgNeKo0600WlEtrpXCEopiXKvQF245VpEbc06DUjUspNUnph+qN4elcpQdEsrSdVKUS8Bp",
+	"rax2l1rt+W7U7WSYSffvkpaJ253KStyurLSdu/OE/EUwArm9N+RmHpP2L7nFqJ16OHJ0L/RQCUrPr7V6",
+	"p6H4fs3V5Tt4zNiwuvXluNJtVcAirHTiaqtZ6+SOLdmGf4I7M4RrPRkmr+E+xMM9V0afGx6bccSYSDHj",
+	"CpUBc4r3UCGmTwtPYzmu1aNm4Zn8K1ovMNSemAmqpVMxGzDNV9odlzlNV1bmX+WvTRXPIwjghFiXiNvP",
+	"YosTqtGKXrqlzCzphxBu0e5JtpA94f+Ae+PU84c43coElbXQli10nzfX6b28FFfvNeqd7mw3XrbPbZX9",
+	"OVbHp6hZ+mMl6lqBljNMFl2XAv/NnUf5hpmw6ZmG3sft2gf/ZJBsp19hTOlNupEcJLvpuuttjehO3HDa",
+	"QSutTt1zOf0eTZr0ifJwcDBQ+wz4nbsWTJZy/UzxHj4YsQTZ27EQL680oq7LCPieDyrdDEAb7p4BWVyj",
+	"YcqrHySRXV5vkgFaAnBgt8ATZJcWu+k28UYdUoQRvHNNraabyjPJ2sGbkDs28rnMKNXv7rNB8rvkNakX",
+	"7rmZriFYFGDWsMexixnMQfCXNliMQNdIGC6YK591+ErC9BX/sRw9vBo373aXSlPnJychSsD/fc4hM8vR",
+	"w1n86flJ216mva2wmG4j9kjQb5MeGn0Qy2ExD7Sj0i2aP2gfVVdK4YbrjS35NtNVigiyz/o8DqJvusMI",
+	"MsQQF8MpcSwM4b+4i4Y1/NGMK1E3urK6vGI/W42L6lv2n9vxYmmq9J8mZOh8gsJTE0q41uXQsLhh8Yex",
+	"KOb8UqvtfBTTucUfxYJ79lOMZcLR8UeHxhI4ly+uNurNuBxHnVbTE2b/EiyRDYcZDTcceal7eEThflfc",
+	"OIj+BKAevgQ9sC9CKZbVNUgGU0G11Vxs1KvdSmuxwsShHXe66Oiwg3+Q/hL8tF12/Q8gnAnPwtBKGLTu",
+	"x200gMk5ole9SJ/gUU8OwqDVrDTi6H6MX9nGebxJNyAc1Rf+oMcKaUTVe51KtdXsxg9pZOyIpU/pTkfF",
+	"QqNlV/wuHiNUJ9xod02T3bhi/OwfNE7Q7spLnZa8tpOX+bkqeNyYGHEJyJJC7SWW+NEzwqzjGq/EzVrc",
+	"rD5iJv1qxzHGdr1br0aNjIjkIP2SbkmQvG2w45mzNMSYAEQHvlHEMyB/jsfI8NpnP4O9S17g/YOWgNPn",
+	"i9vtVtt51bNrtFl9VFnuaGZKvdn9yQWnUYZhdMeTOmJFuJCsrpTCkuEaeSw4ymXQM0K5jNoIXVsy06yt",
+	"tOrN7tWoy/cFnapG48ZiaeozOzrfXWrV3GYPy2LY+/Y/5XUMNw7bQjR4KDiM9tAEG3xngimviS/IIn08",
+	"0V1tN6N2a7VZK+WtAY2MxuGYa7Zwz8ft+/VqrK3D41tshdjmX27V4tnmYgv252HE7mc8UjX2irly5dpM",
+	"+eOZK6XQmP1cWTEy9jC8NKBbmYW3XqG6eA7G/ddg41DI65vkALa/2qmsthulqdIE++8JEMXOf1LfuNTt",
+	"rlS4+FyY/PCxFeKFUTosCVX59rmHshXAO86yXzH9CPMXl7hD92iPtY8tWHUvwRkEM2sbrLpf43HEa2MX",
+	"40oU7dhl/4epeYj8sWgJt+NkMAzsODXz4xyYWDyHtGqrZo76k4WFuTOouNkImKYYQv4AEhjMPIeA0SDZ",
+	"p8Gzqy3fYIeN0F+tL58yZudhZVtxJe5G9YatOhfrcaPmNulRtnb5Dj/DkDL6n6hF2UlkKY1eMGaezDBY",
+	"jpn73Dk7eZadS6ZrxsU1SVnXN8wjhdhUH+1sZ9BO3DLZJxlnIr7vXQnVslQOpdDWdDqv31iofHTj5vUr",
+	"GedJ/c5y3OlEd9kv23GntdquxkGz1Q0WSQspyfap0lKr052YvnO5NrN47vwHF85Msv/vHMxI3x0xKPfR",
+	"5Dp/YWb6WmXmF7PzC/OlsHRzfqZ8ffrajPykPDN3Y3524Ub579TPfj4782mlfPOq8sX56Z/PXKl8NHt1",
+	"YaYsP50rVxZmrs1dnV6Y0T5U/1volbly5fLVG/Pw37PXfz59dfZKZX5heuHmfGWhPH19fnZh9sb1Ugjr",
+	"Oz0/P/vxdfjq9RuVy9PXr8xemV6Yob/ylYVnTLOfVWbK5RtlmmIFnnB5YfbnM8p0Zv725mx55trM9YV5",
+	"+MK1mQX2/evTNxc+uVGe/Xt42eUb1y/fLJdnri9Ubs7RGxdmr83cuMm+/Mn0fOXG3Mz1Cj6TTXDhxo3K",
+	"tenrf4efz5XnYXILbJ2v0qBuOXUcO3TuLAYLFjyHVArPM0Co9leYN2X5H1AeL3lUHQMOpGyTfeMAqhmW",
+	"rPtK1QUO/0LVfVawcQ0yW+Qf9gJy4tcwIsf9elDZQ3V2B6D6yI9msbtfJf1APUbBWPpLcrJ53oZZyPBk",
+	"rinHXZpBHLkvXFpDnrdRcA2GWtPTr2QK4rcY6mcP/grRheAXZ8gHPDN7ZTzkybhXmMHmjjL5NskQcxfp",
+	"r7kTE4ikaPKSYAi76Uau+UJ3A18JW+kZ30eF4tSNnWrUgNj7XKtRr7qCYf+bcu97KKcUhNViKyGJrhrx",
+	"2Rex1x4ErgaYn4WLcuAKmI9R2BdC2xTdwdvyOfmAg3Rr/GwAUQ12aL4hk4CZJfCN18EE82sn4lq9y5Je",
+	"ECKFreQWPsVNcUdZ7OfFWStwE9VqImRZiRa7cbuy1Fp1hnH/XbwY1kgmF7U3M2lQQtg8f8eWj1yUPvx8",
+	"EOBsyVGBe7if/jr9Rl8UY+l6OXgTSPbUKkqA15jE/2CjszdUjbTtpxvc9UWgAVNG3CJLN5itg2ZNskeS",
+	"3Xed3GarW198VIHxvIWF1QZC60cKdjRMjm+coV82nGfrfswiWiuN6JEXwBSz7zgW4E/JIHmDwUaw99kE",
+	"Lyl5UB6oxOlTeAICdey7yRuAs4m0H4yYh3cou8aMykYD/hFV71Xa8XK9WYvbJbZNFZGuq3RW6vcQ+gbP",
+	"uLNauxuzzOGDEoa4Ku14hYWtQvmWCGBD8ORa/S6btuuW7NSb1dgNN4AjCtk2GbfB25KZnB605jjpICOL",
+	"hH4EQgAhuCyy3vrilsKCiYPVZrfe8PguTOH9yj1q2DDf0P1IU7gSMTS0AdeGmvErPmbv4f8e3rcBx4pG",
+	"VEzMkjfmL5OB/WrjYOGe554VXwy4DX9XMW6ZECR1gyFDQ7cPKDAQgyHF9PgF8jL9Gi0XmB1HWRwgdOGl",
+	"8nPtkvapEWO4rml/FNUbce060zf1qgApGPdRtxsvr3Q9acZqO466I+a+hNKx/rII46lErsVVnHNtKZIe",
+	"hz/1OMRkV7FyeoWlFAW0QFyswcALwkny2tg9BXsncZpsZ9+AUPAbV5nKYFSD04RUW+OBDMuu5zoFeygZ",
+	"ZF6kwZg7vBywOPsa6Df2i93x0lFAG5DRlbldlBA59VBKobb8mvyp4lNM2K/WXXdiU/lG8bSH/fTcJIj+",
+	"Is+Q2824kwEoGT3Nw5/p8sQe1Ju11oNK3KwVP830m043ahfWAcZCaI/QRsHzWK7F+bje/WT1znS16k4h",
+	"3K13l1bvVBqtu/Wm0+wcQn71wIsqR1WMbykdDyJJG5N/TrNNZrbUW82F1r246co8jK504dSsdvK1K/gG",
+	"u0mfVsaoXQED/DVHgK0Txu8lYl2NK6oIoI4cjAMEYa+Ze4HJusIqnBmDnTqrx/GgKt6AOTYgVcgmxMKL",
+	"v4R/oT/UD1oPmnF7ohm5X9GJq+3YffnjxUN4H4ZGfYru+CV/ONlAAKObzpI7IsnnGoOsCXFvJPlRgILY",
+	"1nX/joIgEHAFFvl/Yq9OumW/3LYx+IKHWqmKIqJ+OVdS2FfrzXsOVYxgqCxsCrsBA7oB/4pHicShFV7X",
+	"OSca1r49T0SkHjWrhewJSB4epE9hJw8gAcNjd46ARrpG63AJLmoGmE2/5kJGINJ9EAd4Yo/VknHBzN14",
+	"Vy2XIgq0cf6tL2vLqu96vQm+IdyLbrfhz6RmDigSxnc8mF5ZCdWAjIn/JQ2VbrB8O8cAWztYCouYge9A",
+	"MkY56IPktXnUNdyCPO4mrOkS5jrZkg7R43viHr0EPHNHklJ/IygJc3P9IgKYlEfN6mUCINiCUhPpJWvl",
+	"zNs/I8Gjr2snvh+3o0YF7A5YjWRPmApwWGCdMD2K5RdajIgKNZS9SZ+qSin02RtfB6Z/KBJXHBsC5jlb",
+	"cvZmIRuX5H9WutG9uClBKGIMkAZcBwj3INnngrHNo+jJvpL6NVUMQObkjbUmi1JAxtT3sA+4zoFB1ZtR",
+	"tVvnABZ9SBAOl8FZkSJlg7sU8PSdOiWfoWZMTuyXUHB0362jf93Hh6Rb+BZjkN7d8Q5XpsnlRgFonpbM",
+	"H3G9FDRbFVVU8fhtBBTYWEvXKXbUy9iZZN/aCawVAFME06PbWC+hVBEqy9QTm4YeFC7EwIIrwiTTDbaW",
+	"7NfpmrhP6IsY9WT5i/2zAZ7OcQ1MpJ0u1WTAbeaf8B0hp1D7grZlGC7UDjt3A51RP02hHt6iz8j26rqr",
+	"jNFJRy4VdVpx182jEx1OXCFLQqu/YQr+RdLTTYpLcFspZgJF21AOQIhRjtB214Rl6LrMFuvNemdpRLel",
+	"1b7rnIo14Hx/DdzLEV8PclohY9YdAQMIY5Gv1GKQ2byvLbfuu79gyCBbGW1S+gqbYzcHqr/ONcZQkVKX",
+	"pM8uM9nOqHUR5budSh2+65u4hv7M+S7OKvs7OJes77jgqPIH1hO8Qwzds3QuV/MOQ2V8Gt9ZarXuZcdZ",
+	"s+PKTzhS0IiCUnqXZfCUSkJ2i3ztNGBrcaN+P24/Gjldrb+ZlYDq6TmeXdgqEgHQLk6I8ztjxocMt8o1",
+	"wQzswDckUQms/6LHa6wyA9kFsmcOGOFtVahuHykkXecRo0qXh4yKOCShHu1hH6bfMBvCV+lwyIgPX9x0",
+	"TQZJNKfNNfmV6BEDGDvXt4/5ORONYj+j3arGHW/U61tZvFZEdkaIQ1VjIDcY5a5B/JaKsEK7xp2+tCC4",
+	"YqpZFpAr7E7vlVF3vvChBOkq4Xd1bsYC56s9d8T9Af6xuDGkPzQ30C6e7xrgNVYv5oGhc3aMR06MNxTo",
+	"Q/1bssdiaMxqJhNSuG6aq4TeA6hQ5q3/4sx0tdtqn5mtudM+8O4MJDw3jZ3Kmwu1y2FS1K+YoTr6Avhl",
+	"+lXJGKd3gXPqYrutbtTIvfnmyrTeuPSv8dLb1Q1OdYGaUbfbrt9ZdfMf6A+HLSFiDPUtzxEHohKxoDrf",
+	"Bb95DOpBQX1sicQus0bFGoUajh8LS0g68NlSLpLeuLc8suNOn7ORPafLntihFHoYq+600PFSjsR7kiQC",
+	"8TE2nC+bSybVPN2cqPR2FXksRc1m7ERT/guGKpK9dNO4CMEkciRTd9RIG4bi35BM7DohYo6HpFtnA/nq",
+	"IF6O6g0rrqnaVxI+MX9tYa4yfeWKJgf8+mi0mDtBmrIUluDBbh/ahG8iyAYWaDFabbBtbS0ulkI3gduA",
+	"YiM8CII1pBQy4Wacgq4i9pl9JWx5SaZK1EBkcsBXlT+sb8NZ+55VDVzV9QNhoBroMx5LVUIbNOWo3ngE",
+	"Cxnfazxyrh+urIMnil0WbFGC9Dcwrt10nYI9ODFOnAH4uKFOgYEWHJmwu1w8kh4KiPN+YYekAne+2+fQ",
+	"jVcdXuktEqcIGysyQ1zLugHVSb/2bIBLKE8KOVZE7P9htR53EX7HdaEXkgWr+JT9PwVBeMmzEKGGGgP/",
+	"pk9VEPAQZIRgbt1mqCseRTAt3qcXcEeuMEOuzUb3/x77bPLcrc8mz3x46/9z/rPJMx/cGp/6bPLMRfzo",
+	"P7skRp2x0OQZ8DnnrIOxTz6ZunYthBmJT3m55TDdUuFdluUyftQ5sKvmH1vN2An6lIPZEYMJZqevTzuc",
+	"t5lVdk9MXGt1qq0HrjeRKnUD22+Wr7I8r2RQoVialjcOxuZZNeUZGtU+MgmxESBZjJJJGh9BI0gdn4Py",
+	"5lefoSuURXRdrTfad+fjbrfevNtxZWzghsi1QMQT1EpmfwKMMQCsySjCUFAN9eWxYqBcDhQfSICmxcFY",
+	"uFxaHaJZMb1SUwAafiDbAcb4MV2HdoOejsXS9q+DZNv0UCUY4xKF+tVSejbzHe6BM50AuEl6k2AaLOjP",
+	"GnIh9jALnMOkYHV5OWpn4CkxhF/xGbf/JHH7cG+7dUIy4PbzQGWgE5vqtKeB70aUax4/2Y0gdSRrhiVE",
+	"0m80msd98l8Eh1P6zBc90aNlRuTdePTwMNwyNmTQMeC+PWANDQ1VzgbXi9ubUYlrcnjW2FiMd1B2H1P/",
+	"Ax00qft+dJuP8Kp0w7JyKItm1YY4yiDsQZxCV0lhyjGWKNQPpCYkziN+P26367WYKUPk+CsTj5a33KBw",
+	"+s3Ce2jZULtaRHJnIh0Zg1qIC3OoYaz5Dl4S7jevJVZEeysDsvUEA8OYJXPf/PE9r4H0P9JnEKDfCjhJ",
+	"mknzadHraAD8HVNp50qDCstShuba0rny3662utE1UYTN/bkHUbtpOXTsQwgbzZXR6WJoEtC+26DINtXc",
+	"+zcKfoBu53SD/usVphoIiWCVPV4K7jRa1Xu8Pk9Rudwz28WafbUqaE2pdrYfqSWlaXLwEqfpP1fOoCFS",
+	"OQOsyjQVpWHCH6iwvq+NW9CQUDIELjYFGYEiNzIctB1HtRvNxiNOgOwoGoWtlmRZ9kx5aNPCJgyTbW1y",
+	"ev0WXh1FkEjIF0i2DvJdAWhLK5A4GyTfm4y8YcDXkxOBKuvVn4I/6UaCICs7SLdI/6sSPGQ0LPh7EQnY",
+	"kTCPbY36sB+Y899wwfHmyri5Q4HtFOvweVM1PDOYmM45mJiATstDb4dpi1At7IPSOfAg+lAYsE/fYlWv",
+	"eoTwSPxQXMkrpFPnCpBOsZ9VGPVi/aGTHoDFRyAZiYQoCogJrC+Hn/Z56bOV6BHkZ28Fn5dKoTWkEUEi",
+	"XVIFFU9yznPUVNNE8w4Oc17dlCpy3G7dvlBfjhkpzgxPW1rmeKudkehIN/GmhNOwF8hwCQ9no72gWqsD",
+	"2J8+6Yqhm5EDiHoqh6L1CUutanW13R4x01bsXeVY5vblC6GqqupLCv1e8MfpGoDrdbloRYz918hSoXDD",
+	"MeneodvSmfDED2QETcI9lLJIrUYyAhK+ENz9u3FHC7ZFKyttwofg5rLvNVodT4jMb8D9E69mIxeJWVg0",
+	"tNBaKvwzH2IYwAjDwBogU8h8hOCycGW+43mknHfIE3fEnyhCZu6I5oD7mdsSArEncjygvnNsMPZXXVrl",
+	"crlP66dRu8ke5eXh0JfYMnEQxKcM+Q0tyiYgCfeknbZhM4Byi26ImXDdpiF8Hxa7d+IuWY0jsiWMyimg",
+	"NShxaC8ShEIWjMlRDYxsSrU/ycXoNOLCoSowinwu321JTjB59ux5K8SHKY30aaig+jWyBKowDD5g3wAf",
+	"mnjEnA9Cf33UyTL1WIllg4ZODjkfFWvqZNrpphBVFy+BY6WSPoITByYryTDZt9aQMO5PkoGtcI0anG2N",
+	"Poljt223Y5DFDtjnGwLrUm/+DbvFdSsv6+ZxN75w7QBwTvtKV6JVToLsCvJkLrgHyLwdIOMO2veCKwtD",
+	"9h6ZcmyG4CVRT5xWwK2x6xpq/wgnXJUTecZNpDObqVGadgyqocopbd18EwqRqTL6kBNQvNG8QGSLd3YX",
+	"0VU11wu6+A5GwALoxMiueYE9MO03v5qrjUbE4pA+35MMgaM8IpvX7Q8GZxCG+oQXyxUBJ8PZg8JoSYip",
+	"5qeSgb8sL37I8lJRY1Q8JcJaIPvDoo1fEf6MySi8H+gkxK27Im/Bibtx92ePZui1s7VxN+y6EXcqeIo8",
+	"eNl8F1Iw9ZokO9AERoOcYrZBQEEh5aR1pSh+ZJipnzN0tMCOIjoFMF5CG6bfINJLKMJgTIK4DDjieAEL",
+	"H+H1bKc57eeeQfo5UDMcYMYdGG2hnKh5mEHUKGiG9H2GhdsYAb1jWzqM4Tp9qupKtcGH0eJEyVODhhKG",
+	"qkoQPcBrFl8Lr9/1XDQI/HgCh3cA4SDTFCKlDuEBZOiB/5bYDx3fKkOS1KEF5gCHdq1w4MaU55V2vdWu",
+	"dx+NQO08x39SsFxV+443hiEdoWxso+HyWyDZfrYRZIb3TvBEyBpEXz2lByDtyNHa2Ggt4YwgjKDIigyT",
+	"bfdg0S8q1BQpNFUy/EMNufsbh9HZ4yT2bEaeMRYX8s5SxLJNRYy0dbK5tv26xl+SJ9reoULYkJAtK/rM",
+	"ZZaFBzCLKd/F6POD5A+Y4DOiu2AS2Iou5F9EVSV2O9BYWKFDVrbq2xa/oJt+AApOGRy/9JFwGJd2PEfr",
+	"eGKHcoM8RLJXytMfLcCCmylWBajq9hiGPB9tSLwd4gdn1mHJsEE/Gr8UsOgFbjq9ssDNRM+9FMxy9kxJ",
+	"dj6Cc0KuieGTBHPlS8H03Fz5xs9nruBztQuO3oCZC/db9u36fMh0XOJGBDyVoLqXAiQyxQ+Vfip8RdQb",
+	"Mt1yLiaY5Iyq8F9FFye2rqGyQMlATkrXr5brR8GgHlnAzm1mfwM4Jq8N2cX2T45t0tnlQehKYYmND+hN",
+	"aYClsMTHVwpLgucV18aNz6Podh6WMQBg6Wty9kQ6/1dw+Paotd2QOhQCoAEhJbI5yH7hYAJ8K6g3q43V",
+	"Wvw3YoQFXS8zYu/CYmOQsONLlriSrGjv6I1EwL9hku6aWPrMmpgWJem7YyTFp8lDnXl1HDbphG3uqEER",
+	"tYDFDszlhBgvg1fqjzeOFHqhPPpi1OjEriDHqP7r2SD5k9pmERql2PbZfgC+GZbIum4s9ep3nNWp4DYi",
+	"O84wkMdU8Pnq5OQHVT2RDZ/Ft41WOOhQ8+iK3cfWDirrgA39j0YXTbQUnYw1cDfzomSfEzoOHsJt0Zhy",
+	"Koga9WocBv/lTuvObSNZzfE/MtdIF5ArDS5785mzM3GHOAkyhcZzkt5qUmiIv9+HJPWGFi2i+nsQD9aV",
+	"VEu/yS5IyroO5JhgYV8kB7SSSpuks64ER1iqtaPFrku8HdpX8i9YtsUYXAHjlxxXsRM0oMceh5zAS/QM",
+	"CvQmGRnWhrt/RmYQx+jmzcNwR4vscJCuDC/1NFLhjIiPns4+f/GimWFXwNKffz7/f//nQhEiKzop2g9q",
+	"PR/4QfglJCX3yFPIIQMuEmq6JM4Ctks32032zcM9dNnMqOnLq414IqqxI6ZgbUy8BtMnsstitgeUA2HI",
+	"DWKNuLrc99y9pGLamFMTaBunBUpMRJHQgmCMdur/GFfaq424YyoE58yzd/T4gxpO620NzY3koNCpc3SG",
+	"wGLaqVb77gQLBvync+c/YCbyP7u5ZkOmRsA75dpQLOjNm7NXzgbJt9QhJt3CgkE2np5+WL8wJvcYK776",
+	"6a+oDdA22FRM0wH9Dru50t8gfSlYakrTEFTC2XCaIoe9aIDoUOESvHr+2So9c7XS83TOg3tS4Jv10Esv",
+	"2Z+yYHQDrS05lXFK9fHC1a9WocWX9gF7aPok/QquvnWVCgXYtcjfsd7vZk6+FPC6F366mT8nAjsyaOq+",
+	"W/MjQP+d94niWK4Dd59s7nUFyQ/CosHS0HTTufpOiH26hua92RN+X1/+Hi+ywLgSj5uKjmMsuoEuNNUs",
+	"qVV5hjll1macHTHYauC0RkJtavamMCDaHAUK98mU1l1BgaUZWDvROMgErD3DpIoC7Vf+igpeNBLSkpEK",
+	"QJg/PpRAvgFxbZnQPoyKu46hs+e7G7BZ3EzNtE9hK3NpLbK8P8vXy/HmPqo3uk4myX9jpz/9mmkZWY+7",
+	"i2EAl+nIUqHjWHIj9ZteobJlKhyqgXwZMAgGgFNJU34biAkEgA4jav96DReQrgThPMHyfV76L8vx56Vs",
+	"SjRUg2bHkJ7COeBqGprt1Pq7yC7Xm5Xobuzrp4ABJBEc0KDPX6dfGeF7GZORhexq34UD+uLLt22aOC5B",
+	"h6IWW1awVd4xRryKskPq+gxy/46+AjQVN+GKTsJAsVkj3BeMEQiLe7d0WDlybxzjasqSgU4SOkMTfxf1",
+	"v+jLnVmJ83nT4dg9zlYPjJfEX6jWqC/XPRwcrcXFTtwtQGt1mL6u3o6sPrqMPyTPyT1SDA+7W3/6VNlF",
+	"dvGyVBHaMj1OCWiWtxTRyh2FigHXTCxQjnqeU06qI445oKDEOvhuTHXeLH88c30BplG0tEBD+O+h8YLT",
+	"NtGP8reBDqqzukdfoBpMakJA1mo/6YfB1Ruf8rat55Vv7UNwbo+Cz/2kjzx7ys1jJzd6CLNgdy1v+Qz+",
+	"D1yvam/wZKBH16/e+BTampWvTV9lDclg0dwlLorUxVG7uvRJfeSgpxHELNKs4LDuSYRs0Q6fhK0s2FC8",
+	"vwHvOy2NV1FnjwGWdAMNAISwUIoacoda6Y1iLGl8K6ols9hoIfWeWW/59q6B4wuRw6LmnFOUjVOoKYXM",
+	"HllbqhD7g3Tz9OlKvBVGPJunBnpy+k+Ca/nLcVSrZ3fiqMV329AO28nGpVRxaGQ9B6LK2JvpdrWOZmHk",
+	"MFB5CtEhZ1/fxnJYrGB7qeNGJTr6FTysP5JLXeM9sWnKhU6p1Uj7cRYkQbDp3CuFckmL9pZWGtXKX6qD",
+	"9uztW2xE7qoiGr0bOX/KdKPhl0Cgci3anErtfK+Sb3qo1Nizi+95Ob4TNaJmNb7Wuh/npnXVcfM3ZS0C",
+	"W8qP263VFdchVKvJfGnxAYZP6LLnnqf7eg9EWApKmi/5wDxG4t+HiqOKbkc69I0cGPugaPbc0U3fccAK",
+	"r0eBJSg6spwh5VRZ8jvbTYbnyxQ4K8DA6L9kIRgUm7sfcM6D4qX0/PLmSxtawpcnw2VPCwJ1TxBXAdMy",
+	"4VTbwjcI5spTgaBurreILl8nrBco2IyQ0Z4VdQ2D5ai5GjXgiWYOFWYSBktRs9ZaXPR/ZbrRCIPVJtTx",
+	"cU/eUTIj20tYMNwh8jEI/pUwaHMVw7sCblIw/wBnKx/aY1dhugGl4vb0YEuZzuHxNDi46C27VhuuVyT1",
+	"xAiolkgMA62ZgPl7Dt76Cn+FseI9nuwwOdVVBKzyKN3FU7cdEkRst0phiTYFaT6oxFOsGS8WZvOGnhw4",
+	"ZqdnqEpsDovnj9r3vdK++aRa3gEJglHvUic7o4xUv9azfLji5Kg/qOQOjhq0UzO3U0yo6r/cuAhlsazq",
+	"ZqClLhbbreWKv+dGMa+z26oUbtthu4TaELSHZc7nUARHXlsi51XeWEuL94cubJff7MTtq4za24VTYY+7",
+	"Ey+22vGxPO9YPYbwcCvLR6HPLlSXzr34jBl0ruxq0pBJ6DdXpvJmg0BN2FbbSrS2b0SWuZdemGU+O+Jz",
+	"mCaRxxXmOXIoRmvrGnW9u+RrIXeclEjF02yOWgc/PMTT5Bp7uamdMBXoUbLrTesVLHpSAUQX8vl4bMDK",
+	"CJEFuQgWoBZnQxExTpkyV85YFgTQnEcMFOZ8P8jr79hurTKKToS6eawvBf+j4ecMRBJDVjNU3UtGxBZq",
+	"Xa80rF0ObLGw3YAjZ+DFPK4lb7O9rMuFfyfHoGc8mWLnKytxu7LSzmXJNIPpmRQXqohgZroAeyUbFjvF",
+	"FQ6yP34GT3hsRr2iYFo94A1fDaL9QiSctXrULDyTf4V5DFBJiJlwPPkpmI1KKZrN+pnVTLPgnWL+QHmB",
+	"NpbQLcTuY8G+9DMgCr8W886z+omodyrkK099YYEM2AiXozqn7SkcgAUQOyG8EPZj8KYjbgq2Eh5ykP4K",
+	"A0Oohlius+drHFEbMRbsoUBSBpPsER25ZKXc1wfT9w3Ga62ovUyKdtMWvwmVbaE5q1vh32tOWPppHDs6",
+	"crWQ4LTm7Kf0HWcc5XhkQ9XBAYX1OsA4UR4fKZkKHnZRRcb88U9zTLqeze18JelPuaypgNF83tOMPcwh",
+	"Rf0+Gapv5yyv4qNkEIzdXLg8PjL3qfLWUN3PDJFgV262qaCemCmldH0D9G2fIAeE9etxMB5WPghPAM78",
+	"kIMUJMXOrgQJAlsS+6nQdfW4MwKUmv1UGK+hB5Asa5p7gYE6NqCs8sG7BuZXVhxZiEiFiJz3mjS6FUDs",
+	"LsSOlIguM1TN83ST5991xDajAtW2xW+VKa0QegoP+q6niizQ2Pd4ycggfXopo3aCPeaMKLY84HRXChB2",
+	"Q8E8Dng1lL/ohAFE9f4mhDItbrYCiXH6BCctglwgTSxNMCbSzfsmM/744Y1cF7L0OByyuMmIXGpayZn2",
+	"VUVJyjqjw9bt8EXSXjeZBzlVj2oeEqBnMvJz09iQRyYXSlUTt3sAhT5S/t5RVTDKjxUPcAQfbLURVw7J",
+	"BjtCzEe+Jlu1X2k/Kq/6CcrVuIQXi4jEssxb1bs1sBONJ2QoQM7PoV1yP10fEWtt1eQVLas7ArtS9isO",
+	"XThUpLil6KiNXc+G/7fpKs+OO4pLPytqVVCqOqsNh1Ad37EbOfCCXItDukRNb9wN9dBOrMOblq1RGROe",
+	"XYtZsI2FAHGrid9kmH6V7KkDOwTcPaTqB5WmZ0fhnXwubaahQkBp2lUjoQHkNtny7ZeduC2bz42K6DMq",
+	"D0btmKAuzj6ngLVYg3TgtUpOz8lzcoOQD+L63SUf6eF+AJbuHpWBYLV6GJBJQluDfzMpfZX6NYX1n3yq",
+	"QeBC2e2ShAyo9QKVt/K7jF9J3tvMq3303RBzztr4+dW71LLX3vh6s1JttRqAeXM7Sy4XXa2zYnbzAUG6",
+	"tgVrhMmj4F7ELF5Qk2MifWYVgTl9Vki4dKqUW7I7sQTnoGQXhMyPxh9nrsdkMMb3FgkLmJ4Bn9pB2t8P",
+	"EEs6U65cm/4FciPjJ/PjlxSOIeuX6Rb4R+eCiWDsXPB/BxBcwk3ujH/eLBgSi7rVpUPq/Vaz0qboxAgy",
+	"IBuhsEMrQgr8uCKeSHBUSP+675UG7QyadZvpU+duq4vlCQbej9uVarQSVT1FH/75iZ337RtxghntfbSa",
+	"DmKnT9dIcBmWGzzNZ8lLir5Zq6bEt4JkkHlKiHjKWkxnZRKzC/kdUPEqy2/Z0z1MbsqZxYNNzbZxn40S",
+	"qW1OxTdJgCffEw/IarU3D8S5AsLtPdPfyZ46PFCATTDs1iA8YJJu4K3swKZdCs4p1sNcWW/2wcNY2quK",
+	"ndC3GJLUDoGmAV0raCkLl1joWiHU7gnzTBW7ezJyP0VywR35oBEy/eYgDsH7pL44a6YLoslbdpLrGJrB",
+	"OdFmvDm0ykbAfpZ+xb9SICuj/iDZkSdzhBRTkenl55dO5RQZup8leX2499+7xuy8IqgcH9IwZvvhvjEn",
+	"h0p1O1NUVDvK8DxMy8YLDaZCqgkUvBuS5nzPQXA+cn7mhDBqUrNmodWMRTZlwqkglAR7EYc9k3/V213J",
+	"eNColPFH9XjdeQHTz73kJYFAIomkpzCAarFnd4PdbuMoLZyCMbvWDkb8AjmzoEm+s89TrVXtTDk7PGWG",
+	"GU2nXh2/S3Lmo/txzUsnwQO8OlM/TNjDMgFR/j0qTN2DdtEZzA5fB4K324brJz2Z78HYvt56D/MEB7zz",
+	"wgFxTtG7kFB3cPjGdONvK/C/KFa7YOkibY/46eF7db2FyPVxNADLUtbu5mC0hk6Jjtv369X4atTlVXqO",
+	"zs3VRh0avbTbLW87VaADTTeDCw8fFuF3CUvwtEo76ma6EJJplLkQFx8+LGgYXJysoKIt8uUPL47y5Q+L",
+	"f1ktay6wJJ24zez4Qut8sdg6W8gZUYCsb6r5cm1/xHqKtRLrkCFTOfiyuFlbadWbXddE/zdoNOBEZD63",
+	"QOwr/U7AY0SyLmzuoqUy90lkXqYbRWF3MzQe7Si461Xbo2JrRQVDdt9z+yiCwmBPVW33XMGzK2PbAlBr",
+	"PE7C/eV+uPe0y2hgZ6laiNc2eJNpWr5W3VvgCRWdW7GzM4c8ZbRUsrp4eqCEoue93tndDrwcElPO55Wx",
+	"RtQSzbs0y9HDir8N9iTU/IFp0+NsldSqzR+vnnSX7dbifP5l2fT3CEB7dUYZK4PY+nkW6lhtxMcpO2x9",
+	"wGJMN4tsPuOq0nL7H4Z2hqKXruG7uN1LUXDJRyU6A6VbwDTPTtY/tpqxulHnP8jbp5xiWP5MdbilmwuX",
+	"S+4h07h2xLiC2enr0w6jeWaVLfrEtVan2nrga2Vdi3JZIz6lrx3x1KgATK9kUEgNgJydlXZ9xGL3LHwl",
+	"c3UYXT6Y7BuZGTRqI6EEhVlEFZL9Pc77pwWUjYObe3JxapVa9Kijbfu5C6FtKO3JUmEFEUpUEAyZ8FR9",
+	"/YeTeaCNQ+oAx9bk7nZuz/hlQOJW6rVOfprTqu8VGSvC7id9JUVCsLTeJbQi1igtIvyzYbJr7qgKgtRB",
+	"ncjXqHRsTfaTgWp3ZLZ1nvTYGJWazzbuiR2WoTcjpaMjNv1uvoLk7Rlt8PMBl4e/MOS+5gpJ3J5rtfy9",
+	"P8kXOoyAjLD37IvJ3ggBHHcsyzvdrqeRw28dwUsNw+jbV24IYYOSHXaRPUle4J8lua0IJChQzXRDhD19",
+	"1pRRyhlH3dV23Mm3b3GaH/HvP1YsByVjmKnOM/naFP0OLT+yE0scdyoxIdqFIGuT8CtEwLYDcapJo0+N",
+	"IxeJmeIcEy16mFldpfCDF++Fo1PLOkGFO2LjvUzqWg0WNo0g6gMmeZyQuigQEOZY6TQiH00oQ4QQ5z11",
+	"FRIXuEL/qUfm+0ZqV7YCUAAN27yZMd0AFNKkEDn875eiG0y/lDeTTtyIq2zMlU6X+cZ3nS3U8B0qyzKI",
+	"1L5oyQPjgXsbM5ZOqkZkWDaBK3C5TLFZobgyxjIWzRu73Wa0D8u3x7EftgrfX2esFiEcBtGwSaVgzTpV",
+	"Y7cbcdTpVlgSM66xp5PsWCNglyCAeMAuPjcB4Am1IiwL3TF2GxOdrN6Q5gG81cor8DDiqkLzN+YXodUz",
+	"pH4XuKz7UwTDNHqzSanwEshTMxydZwMHVApL6koIqI8YcT5HlX7endLkOCwONRlKrZt1o3ykqGYLX+bt",
+	"D5z8kXngmPNi2PNA64Bugx6SHSwyM9s/HsgaMtsF01rFdXKSY0phA0ie0jzO2ccLYkDIr9IPbmuvYgZq",
+	"NW52b+f7iTbkiy+ZNfwim3ADS1DivM0oslZFKG75+9X3WlAbVLraOckwNkzzyUrtStPhjQICET34FP5U",
+	"pPJ1GSXHZmCIiXsMjRHv5qNdd4e5Vo5F/9hyAdKExrWH+a7erHTb3mLG3zv6LpKO8CWu0ArFqzxfHRTk",
+	"sZsr+19oFhLhtS8VB28aa6TVX+PUdr0A6ZzkzrFAilRPyV3xqGyPe+lu5ex7Tmzex5qqr7+3h6cDbV5g",
+	"aX1cPt97+lI4+38SlnEt6TOwGW/hPBRmxJ7/8tCHqAAxZIo12bdzDVk0Qt4V0vtZZCo167S+XyxCfoag",
+	"+fo/xsUqLpUF9bFsIMc2RDahFtOoDxyF4UHvQBVCnEX6d7+iCLPWBBhYxSCPT5LADU1wxZRcfHbRn9bA",
+	"TsPGCw+UXjh2XuXZ11k6PHWN43aZJoZ8lLklPc0NSQBHrJakAk2H9YSDpG//jk1d3RZ71XhH0T4PXqk9",
+	"6/rJwVmFfVkrWGKH3NH/Sm90JdsAWrvuwiqw637Ewiv2kxELqQ5lUFg53KwulvONqHpvulp1X+wd9teK",
+	"t4p89koGReR2AM929de6OXn+ws9mfnr1k/HSUeAK8rLTx+mcZzfqshCqw5pmjIcFTQgXv1uOUSEJKpmr",
+	"uVcM7MBwpmxGDUzuvh2gqbOHPUAKt9EA20038B5Ln6rDzsIv6OZYgZke3gRybnGGkcKtbI5ZKXadcrE5",
+	"ls4a7sbkGmPnEejjrQVhQXGHvDcarQeV2upKo15lPa/4KneyPXsZ5+xrEbcBw5uaDbmgAbSRXZEwsgDt",
+	"rFdQSgLCOiZ5xnn/BZ4skTzmA3Ylfe+JX8vWpO5mqxtmN07MhyhubC99aoz5rKcIA1ewEzcW6RbNWTmy",
+	"aJXOXTxMASuq1mNYlzyth9bUkO5DpesUcwEm4lq9O+7yss2KSo757YOYon3tAZEps64uxdV7rKUVCdGN",
+	"xdLUZ9mn5zL/Ce+GVnp8K8xqrwZaavcMGyYMvudq1jnaZHVuMGumQN5GtLhxxcnZ91u2XMkeyZrOkK+r",
+	"XAeLsp1m1KYh3z3u4/LL5zroVKOGqP7KxCyJb861GvUqci9Cbq24RmRKhRiPXKVosGpRo2iaIquZgZv6",
+	"a0jOkSn9HYYDwHeW0UZgRKqdIjIyWQjeKS43rKJxw3EoRDYASuj/+F9UVyrdva3/2HPN63CijahyBLb0",
+	"k4NCs3AGN7OLstQV50pzmP6KAuK99Ol4qDnNor2EFj5xtd96xzso+VhyCf4O40M6drGw185928feeRyZ",
+	"3ZTO+S2PoXBZqbA0i56ieoMBcor2i3BdwJlgGlFIgxaCXHGnyZhRC/oHZ10vmgWFEkvBWGYRcO6R9JaZ",
+	"+gokFfVrLaqWkzMXdWBXR0r6IGuJ5QxDxTMYiEofuiOR/f6VTMeUwuLsvp+22vcaHobfQwqtKXr5YnxF",
+	"XKh+vODiIgue3/dc95Ki17jPtR7LBpk/q8yQdsA2kbkIxjvVfpBManbLhWfY/V8YhEoO8zXXPBz1SlRg",
+	"TPi+GQ99yZU+GtkieT8Ee/QlZ+PwGi9yoJD1ZsbsxtnPmz4j5XiQPkU21d9QgH+nBi5Np5LTj4fhkUUc",
+	"LPPbHUKg1twCo2z86wxTccdjH+rqQ1Qb7qG+ZB8UXHSfC/hRVG83444jq3u33qy7T0D6m/SXLNMNQ+xj",
+	"Ift34KCxCk/CsyhqE3nDzLQg5VZ5MVG6MV6UDOFhhfWJbTNb1VPYcECiTCp+HxYW2l6Be9ijtsYwYPwM",
+	"QrV5GlzBPJxBN/UArzb9Vio4iUxig2XmWBUrDPHfEootbeHdnfewJ4utjquew8ighnngK1GtVke7f07P",
+	"C1k/zfIEsul/0ehS+PdNUe90a7X4vjNEts4TMtCokfw2goUTnSSKkdPsC9xhhV4xMSjQuyhrtQtYdOZT",
+	"9B3UBZGkTqxWiDrA3FOfIv6kHrdZ90QXjfpSvVFrx82MQscecnIgj8YBuAiqOI5U70pOL7Ahr0TtWNPd",
+	"KmEG/E0nZm+uNsCo8HrUhy32sMcUynXxralZIzNCgYMAZBz4wS92KYwTAnMiFTBKLCGbPTmXw/GtkXP4",
+	"hk2VMKOW69iQhLFkWzjxLDsmjscQnNHdZDDuRKqqTgw4XaImQSQZTYUliFx4idBmpmdyeuqBZDHQW6Pk",
+	"8PKho4OfvGLLV4isTu/MpTf3SIbFLo1FxWTLC8YJ884iVrc76+dbBKE5YlQYiHWQaP9nltzC5fpU6pjx",
+	"4n2PqIOKq3iyEVXutOOouhQ7ZxR6+qJ4R81yxmfwY2MvOTeSUJQ86+AIAB3H1LLtghNCaKinMgutoZHm",
+	"a5ukyG72SeZlfZnXnb8Uz8Vr2ulWOuyyz3Prse2OVqu3hzzsGmEdJndFqWCR40+BrIOkbzw+3Ur22J18",
+	"LP6zXuV3UoV4ZhWerxzrMLGtgBN+DBRcC0sOclY5sN2Hyb5ak6NuhFJNJ3pVFD+1Vm8HL9lqTm3hN3IY",
+	"+dezTR4nLmzPdAqUApp+c7b8jvqeuFnr5B433OoDyS2iwnt/DafMYszTuhoZWl1vh/GcxRXYAcOnFzml",
+	"nlkWO5g0c72s30a8knAP9d4XsPFa4eVbH2/B3rlODMMg2dHebqYyIQi0BvGW/aSnfRVNiyIhieNpRjLA",
+	"wqrnYpnNVXNJFNUpGL6RpY3yeTJyKltFoxGOcsyLYuv1rkcudNWrO0cudNXLw7QnWYo113HPrFX1NPfw",
+	"lK0O7LLV3PCeZ/xHqlzFK7eT22UFUJqs/voVK1ACSM1TrZVKyJXlgKCSDBqk6o+d0e4wrWdNXs2pp+CW",
+	"Ty5bVLHqdhQT4M+OfJZOTAYaYwRiMpcdkNHuBGHffqV0DGiIt5SqtQnBHbH9Ff2PI/FtygebHeUmj22S",
+	"6vjyJqrCAeyZFsKcWDjOUXEnnq6EWPTLagCxcJRJ41Nv+e3ZIPlnDZj0RrR26lEVgbvVuUGx5q+n9cCI",
+	"DdB3pd1quCv5hqSHxClE5x6L415hEFcUSz9lX2J/ZiXRvExV9KhyrIEPBzJXVg4wKj6qI9ulrvYKww3n",
+	"8lX45PpOS+0tQV10rk2PaOSWDg2S107NY+EmXWUrb0eOCpAEHZrtwymAvnM/H3fnIH7uz+E7w/8iTAzM",
+	"P1a26XcYKjIAEfKQbwfpE16PgBJLSNcdbVOSvnrDAFa6l+w7viZ6JLqbCRRLVti5lHSr2Dj1WzHpO2QC",
+	"gQEsH0LUeezAypoUA+RAkVL93Syk8XYSKhnSIfgvPMiOoqWfnF+elX0WrhdV60RHIW/0e8hvsutq+aFF",
+	"VOzXdmsF9KEBpXGJhE4VUW5mFvYaM06yXK1QWW7vVi05Y/Y5nTEPqWTkU73DaUYrnaVWN78785oT0071",
+	"gmqd8478C7Gf6AgdYlZCKgzo7shvOUrx7KYbUG0BDs4AfqHhCL8QU3w8ET9kkVM2BvxbfZn9m8Hi/+jQ",
+	"Bz2rC3SIzM9ZOJWeB8csuDHwJh5Sz0VmRXMKinVXnVRmtUFBaH0OFn1EBPpRAdN1ytdWOIio0lrpVlqr",
+	"+VJFkIMeLP8eCBHm8TLIDDnJUj6ZofNGOQy6m5+SUVHexe3RlXblH3j2tOhoeMIVf96l7XSZ+kpBQboJ",
+	"Em9fik+SgYp4fA1REHHSNOZQtA2MDo9KU3L9ihQnBAEihRZ9rqxKpx1oZoe80lHyJUXXzMi0ZHoCnqZR",
+	"lTsiuF/8rUpSwA+cF+JysUCnRHiC2uRmtMGISJrysCI48NCOIEiGf9N85NELpYAIq4ozRU0tRT2cmEE/",
+	"Vrjk1Y6Sis+Wbio+2wbWXRktJdPN4iXmah88fxO6ygoFhKySUbfXA4xpTrh9kRA5/NpR7DN687yKEThx",
+	"kdK7euBIsiaf5eDSQjkNeqRckQVhgaaUDkIcvC3iXKXwLYZ9vN71KO6kXpExcqnEO4q3GRdiHv2JfQvn",
+	"dYL3qeDDNAN7J53T81aqMDqqeEO9QwCSHGxauTAjz9VpTYTjEEYh7s2g5j3uJD7/OQEm8mer5/Ez0thq",
+	"0s3SaLJ5tn7hDUQOBwlztXSvkvKQd95r4SANLZ/CnXr3UeaeG5UytzD5rZNoIZfSNsM6cQZkBYfsltqy",
+	"zx+qpvrxJ3AN4dekWcF7d6rxbjUDZa21nmkszFC7XG/yf+alAEfr0q/8NpcXFlaalft/Uu+wNsNzrbqL",
+	"5ILcOcVVcuDWiw1WIKOyOxrm9Dw0pkyvMoZpdY0z3lxkQTI6uolWC8XdRmulj++Gzug0sLDUbq3eXVpZ",
+	"7V6Lu+161T5EK6wWCFvLsOAH+yeuFeEXFOAnZWlZWBeibbzZF5ZlWeFdpXJ/PAz4+eelRfB4p0fvovKR",
+	"dUjLK424y38u1WZWWzHxFINSUQnyqp2+rC5fyY57hsYreuMaz6WysKA3+LoqIAi+FspHYoIO/rmwtFBf",
+	"jufjdt2V0qxF3cjbA+R7AKBuBp+ZUW6RVEc8Dt46uKqEP8PyVLZMEGHVmU+Cm836w1tEPoT0yPuOh7AP",
+	"sSPeG9hwqOQKAxMkS+F4tp1sIR9GbC1KU5999kF47qc/mbz40/N/Pcn+v1vhZ5PwyU8ufngeP7mlWPPi",
+	"P4rVIXE7XtXL5x2H0/x31C7i+psH0DrI+JhQ3T/XSb7Z5NJyJXrk3P3YA7E4ILKdAI2pXCW9Kt5UlPfH",
+	"rBi2okqy3y0BizC4xy9jowEgpZuQZpBFkYHygqU0Eeu/TzV3Sl9WZOfPhyLRnK0pZq94HsFfHC13suOb",
+	"6aaH324lju6J8t1ixcRiWJjuAW0wGo3dyPjoo5HXwfJkr7AyFYdoO+Ga30KXEEzwcKLBnouCztYz9BsS",
+	"v3UQswFn1x1xE664tYOyr242XhW723elH00ZPSKfZpb9oMogrLZzszoFvPui3addafspitZ4IAM294+f",
+	"RBmCfloiaWAhD8xeoPypIjw1cEbHhW2gxCBF1/bXwQRUVzO2jtnmAi3M2SAXWwwpeQ+hUsH4iBus4mAV",
+	"sRnnOnGz3mqPjzK7cqvhBgAXaPTpp8VzjO1uKwwW261mN27WwqB2xxhl+ixrlPO8BfQhW4W+JU5aZ/yo",
+	"eLKWncTpWs0L/zhCAnnUWRxq7IKwALrVdFYbjjlIDgKnbGvFxw77IzRBP+S7iECxffZ7yX7xkPCdqBE1",
+	"q/G11v3YA+PtrnZU1mmFgIF5qw3GY/OowrOlpbDUbHUri6xIz2n4K5eB0cPb19rQ7MecrmnYrPSp7xQC",
+	"vzQ7Wev8hlTyLpvBmMpNiq0VMOhugXgIVTE+0vE7DL0lLrbKW1HKXjGfYF5l/CwOBGWcU/J/iEFrD/WN",
+	"5xpzGL3HvNNabVdjP/9o8h2zNsF0BlyXAc7bIRoWjFvyDj3fQGLI3ibwUjLe5bnp7XfyEqVvRBYmJ6Sl",
+	"z9IaSs7a+Wz2u/Xu0uqdSoTcrpXl1v2cEvI+OLegbgRdzAAhYVietBt8XO9+snonGEs3xDSTbTDvXsC/",
+	"t/wXH6Op4VTFrP4uGY67MXCKKHd8owb1p6bJ9s2jT4Pb08c5SHayRvm1q6O0UtjaG89o3N6p1NqtlZW4",
+	"5jENrM7tXAmh3qaeyWAspVuKvdhDZf8CQ04jzYYne2HBnfYlb83CF0tb08+bmdP1SdTvi0S89kMV9MKo",
+	"Pr+RdxhdeaPIl3Oktv4ocOyPdljN5bGlwy3iofu8es9+67529IvxcbJflh6HVlKOvcomQipce+WxUHiG",
+	"+xXn7N41owUaZrmA6ZIT+nDNw17AW7SEBTID7zTqP0IP/wwte8B5yw7IzOEokSIoCn2DJFREqwI9PHkN",
+	"Xz3tZ4fJY5jbd2x5DLdcHI8RlxX5VNnzrDlUo2alG92L/WTDGUGIvmfbZff+l5zoIHkRCGpFJwGwn3fx",
+	"VDbde3tMjqzTGLWCO+AZIo30gw8R4qDpMw6NtY88cyUfRI9G2VMPKWFyILe0aHzJuc1wHKPV7lKr7aMS",
+	"0RoNujzTbNNsixgR+kpTDddcnSuW0/4xb2QcS6fyZr7JiOGplkzmCr7jpj+5ARZDq9q7KoUvtFSMS0l9",
+	"Gt9ZarXuXYkb9ftx28UT22Wo3FF73ddWgeWvWVnuFGwZELfbrbYHmjfgSBI8qeDzsY8uedUgovZZWg4M",
+	"kq84KOKlktAZJruuoddrBUfcbHXri/UqztPpXP4ZuN1fwv27p2Qu1R4ofX1QwCkC/2ZuSwF9doDZGfCC",
+	"4RXD8WLdKNpxjTa90lp01SSla9TIRdQPyGEiQJUPAxNagUpyWnQMGNy406o98sCV0QbwfwOjKJVqq+Yz",
+	"sF5SFRRyBhS6JfjXla42ezzk75zIarsxolowTr449HT8242SsTz6oQr1g1ngaF+tu4IxJAP1EbCaxnNz",
+	"S9CVV7iHKTBxEj+33GrWQIfxOGRnlT4Qf+muxh38rwdxrcn/u7u02qb/XGzX8T86rBMg+09Hazwo/Vhs",
+	"OR1eTzFxsoORRdYDd4867e8GvzgzXe222mdma8EYOyrBucnJAKlLk23+zXFsEtVTy/FkizJm84Co8TSG",
+	"1pKZ6Q6WkSFGI0AkvOQVfdsBR5CRx59uUOUQFh6hHcWMi34QrdTPLq8iOC0QWQ32BzaBSr0mi+ippGjA",
+	"+0fvQdq6p8FOACWT9Hg+EpyLbdn6kQz/O48C6FMlgpt3HokOq916txEjlxkHLQfT8D1WGx7Mx+379Woc",
+	"jC3EnW6wEHXuhcFHUaMRnJ88f5Epu/txu4Obdu7s5NlJbk9EK/XSVOmDs5NnP2CGetRdAtGeiGrL9eYE",
+	"I7ykVMNKq9PNjKFxUmaMRwvuOIVI7TkuYdIX840XW+0YoIgB0dPtcNOjl7wM7c7xDkgRIsy3LRY2XGsI",
+	"kbJWZGeD5J+ML8yVSXVtAw5qG8ocfq2SJaiW9T7ocPBC3yRDgsGolXEu41d2VibqpgE5HLsgp3/CEqhX",
+	"ah3zAcKU3shuCmry03UA0l/yYh28g3gXtS+ZXM+VK9Ply5/M/nymMv3Rwky5cmX67+bHUaaYjgMJn60x",
+	"yWp1utNs26dp14Vu/RndK1XI1GEXlBUsa6u3mhP/tYMATtR9eZqRns4j3491TUiNSfiVBsJ4fnLy+N+O",
+	"z8fXO+7DPb7ooFWGnohd+lSXvAAJ7y4c44BnmMmXOVymg3fBpGfjY2pS0b+kf+C+6awuL0fMfC0pR8Gg",
+	"dn8Jlgv2qHacYWxRHLEK5M9KICylW+zRpC/i+zD2drzSiB5laI0fyEIakFoeigTvS84v/wZYatINh3W4",
+	"EwbuPBV8GECbaoa9WGdejtUrQIsMJwPdZBPc3QP7bwNBfq88jc49mZZokiKWYxshLHALwbWyC+QF/yLm",
+	"Zpq0eqdFhfxmQFwLZuWt3nqEwgaXnGsGvc1Erwm1NfzANK6Jj4bX9g80i5V4U/XPjG6BHq0yA7JRRtEY",
+	"VbUIuOAXJZCx0pQoZsPnQBy5U29W49JUid15Z85Nnjl/YWFycgr+/79XLMep0up5Tm5f4ADeBzYGNuwT",
+	"0lnaCEbXW4Z0K3pLP3aaBbRzOvWYE+DCdp0OIoStqBXoarNbb4zjPC68w3lkhyQPoEM/hKlNpfy92v6C",
+	"+rKb2sdi7hTUba5Dn62sH3JaYYK66gf345jOLX7tLcr3lagbXVldXnGu5neghd4EEuqRPjUX7ltRAvia",
+	"r9M2BxBKfMiYSffraUw4EFwBDmtznB2c/2f+xvXMpUV2gowLkM8KCHb2qBR032jCqpfBpmvpGmaPwSAF",
+	"PgX89ZAKLuH/QkjESjd5GjCq8Uq49Sy8LpSRjwd6Ie8rtVxpW3If7SBBEXvvawjUAtVc5q0wuyyk6/hN",
+	"TV2w3p3CxkllKonvDK5mtf9Quvnula84ZrIXcPpV+k2yR/9AaWAGCY7tw3c4NiMBiKYZHFEoD8aRA5ku",
+	"WHYQtvo1vwPZQUnXTY3x26Rnagx6TmhEsvglxN6lK84sBaCGPTsTi1GdWJ3dRYbf6v4nB+24rbgC9qfj",
+	"3iAcNT+nuHTSNh0muy4GRpNrJ30KWDPZUCzZN57CYyXKr/qIDPoKUNEA+cdmpvpd98Ye88BpplAa7QDb",
+	"nXNY6O25G/MLgcsNue3SP/xyu67u00e4TUBGFS3HXSiJ+8zarT9pDd9cu6QVL/hRG3X2uH9YZeHBsIS5",
+	"DxX5Jk6PFRT9wvlTmLX2Qx4XdJjKK22G52/gfFm/5Xa8XG/W4jZ7XqtSjZq1OkteVDor9XtxyeDEqDRa",
+	"D3jSBUk65Dc0oF6tfjfudF0xRc8kGvXluj4JEe88PxmOUDTte0FrcbETe96QU7T/+NZbvDNQ+FR5hFi0",
+	"z1B+6TLr/XbgKTHm+4ZzLvuz6X2e0y1TYf9B1wEHviVInzqXINnJVNdtjvkdKdLpoywyIUEUaqReG71k",
+	"X1YmW10HD9XLKkBKqvyuhoQ9I27JJzR81uqsp9J4EHRmEKhlLDrFpbQTz8o4qgJrEpTnioG5wbdbYWLR",
+	"1o89fJ/RiOgUtxtI8+LkfVO+R93EccDQ7NHJwrkj6MqMNVRKWkM3HQ37s1D9Xqt6n7iL4VWv0CulQWWa",
+	"wgJ4/pasYfH8EwpjKO/PUBx/4E0nAiPbo54Rg2gufYoK7sLJGaWmb5/0HE6q6Aa4xY0yhSmCoECm7tjX",
+	"IETb4PmtI7OS1bjKq986Cm9kcRPU1m1vPC3oBf8fEkZ5Grpv6w7mlNqY1lXRTfFOPFkv8E1MIJ7zQzp/",
+	"ddpo7+pugpsFyhpIaZJlhOucZP2XUA2NjuyOlcEzQINrOXSWTKWS6ct7Xmgh3YHomzRMn9I8wB0MtRwn",
+	"ejQQ4pAsVtKVNLZs4PoMOwbsKpcD+3zsthc3djsMbl+Z/XhmfqFy4+cz5Ss3KZt0ezzLuhZco29Rq9xo",
+	"3xWvcRsixiKb5/L39i4w8XqBYgTXB4QxHAeM4Zl9bfOVu6P4QdLDQZdy6VEVAdTERgnJB64Jivip+lVm",
+	"RVgNSvaNU53s+871GqRRX/LUBFUX8QlgDHcD2Tmd1+CqQ2YOmSJYjBmYgaBZq90WkjNwil6NvqtDjMmP",
+	"lV5s4i8X1Q6S4A2eF24Oa/3ECIbYhxfCUiduxFXAnXS67agb32Wy1YhZYyYGbY1rxRMOuji/u/s57yT9",
+	"oMiXXrFgHrDT4my4xmbHgfbpPPEr+bDawL5ugaq2o/LYjngBFz/+GL21h28HjJC9ltmmTi1wNlebs3J+",
+	"+J/rnOH8LQmkxnhdSLcbS/IeWIW5U3DeOnp8CsIcDLojoxwuBnqh/7yBpVuFL7SRBFO/1aYE7Cm0ilYP",
+	"BFBLxU71sWAM3SySceWa4liddN1zVkOVC57w8v8NFYWsjaSJiax9xvxGulNtaiGr2wuOqli3F+gXodu9",
+	"nC0pl8KoyLVrH+5jvoPV21a5WM+NfD1KLvp3e00ek1Y6Ffdjppi/B9rTur5H0UuZVziyTJDMemOC3+bl",
+	"Ps1QBQ8bBnrtZEGutH1oPasjIg3WM46H5A2gCKT9BNovKt8Y11K/HC3iaMoswW3joVEYnW7IwujQaToN",
+	"LIyJMzZLcQHkNIGb4qXJ+0zpeuLYFI2GDfWtl6gqrWIOUbXNg5R9yqpnFIXzKLa9Aj78mttZC2SfRLal",
+	"uCvCuc8MHrI6ug6UxR9FaZtVw6XVn9p1vqPBpyyqg2NT18q43QX/aO44q+rPO0rXz1n13R+Eb3lFRkUR",
+	"JQNpvbyEdMkJwQUOi9XKoiXZQ6IBGW1fw3DDGp0HdqjeJzjXD8SjyRLyOllHhtJZAz1Fxi6lK0R9AbsK",
+	"Mm+tB1h10pnQK1ZG8jgVuKoFDPXqb0h6sb88T59C9cZgBDgBKHp4pFaEFAYk9OYfqHbkAgPTDpJvxume",
+	"sQEGfCIAnvoSKz1UUJWskoOVLlzdJBT+rlU5FVx4+HDi4sOHWWFRqg3qXJGbNArmwNoUXsh3EqADwc9j",
+	"ow4WOZyis1qtxnHNSXv6Iw4gu3DMCwL4PvOgvv8J//+uVmoZFam6qqEGQKMoxYkvRFlnvfZ4QlR5Zpj6",
+	"fzB62mM7RnbWXiV9oamMoi9Cbq7LMp+b5atKG6oBQDpFyzms6WfITlUPY8+ToQSA7vJuwNjbmRegsh8a",
+	"yCq6fhTE1LrRClRqQHyvJkfpRqbNaesxLrWztbJY0iLhKmU3MgNWudWy7/Joeo6BKMN6o91AJ39Cv9MG",
+	"0NMYqXqO6/Ddm1ruEWZGABzSni/Vuv7oFdMe9eYdYPAbzZ6yK2t4+wdOfzWBzgt/ERhE6LIfqIFBYQAW",
+	"M6ym9HKOfuhTUSGGGV6nG6il0T7FiEIPsn7rii9PNla6cTYwdmtA9Zn9zMErfYoU7ZdVP2QaTbO0DW61",
+	"Utw6WWm3qnEHe9OTpeK2TkaxxBQTTF1+CHUbvlBwW3VMb5fCgpjOH+2nHOw9ygeJi9d8+latHUz6ukb4",
+	"SzOfts1CyUOZT6QAJ76gT8h0ooOUZToJZdLjtdFoPq2ZGWWg+pCKyrqS7BOndstxHDK1L8+Aeu9IvCg1",
+	"lulJYJni1x3A0r+AdZSJpGzTbTPZcarTbRYc3qBCb8WdJ3NNKEitrhJx8bIewywLgukOXZQjVuSXVrfH",
+	"MYy7GJDmFUeD9JtkX6Ae8TVfCVZt3bVWGU3Eo9AFhuuPrSZwYvPf9Jy3ybZ9WYpyIk61cBvobm4j+vMg",
+	"6fsCBYWMVF0vMAOVC24RA1WK/Cm2T/UputWePPh0Xw9VsnRNbGmdzb1797pRHbRitJpj6yUHFD9TxPXd",
+	"G7H2aEcp+TSmxIsZ1ctqR9PfHt0NRycj+Edodo7ANNLfZiYKnnaW0QfZeBKF1Y4sP0Cofh1Mz81CeO+T",
+	"hYW5M5wAAyBgyL8aYCU8hHkBqJns65x3uxAxhXYg6Sa+6IWMgRs3Ci+P5Xs/4FoTEaH7yT5bYJ1zI12j",
+	"AUBMgUCse9DxjYqW1jmWPfhk4drVMxyRKkDzE7VWtUOLzSnWFP1uJvmFC98zGOCSPq0xe15ltd1QFdwQ",
+	"CETMEKfbVp7BfT+iljF6ywtZKkR9BEO43KrFs4wzKI/4iB5ukx558sPUcOYr6rGx6zpRKlGivseCs4ff",
+	"JNvaw5jMKgfqkzhqdJfoRJGbVm+yiqZ6q7nQuhc3M07Yn/jlCD1okVoZGyw94bsaEhCUqd43cCEOhCv7",
+	"zAnE+hgGMWuMYaSKtXV1XGZC3GXpq1iiTOzQMYpcVyxuIZHDPKy+LLmSR+8oJHk/KEKkWVDb7wFKQpNE",
+	"xd7L8gNwRTNFfwIboGUY/v9f4sthb3xCrIPqGRA6VwvHuM/Dlott3hGS1dxuZk6a8RU6izjBYHplhVGa",
+	"KmOyyceEYWpxsJi+FAV8BSzAb+fqgdhtHjnQDFvGEPVnz2q42WSlQa4/Xyn8dTQulRYIrisU3SBWm++Q",
+	"Ws5kwk6NSilth0Pn0wTdtT0iKtagHvZK6dVacGHyQ962UHa166mTZqYHBbpVh4Stjc9HcCrUyyjYRwBS",
+	"iHbk7ABG1eV44k5UvSdbZhFHaol/+jj0qkL1Uc5mKYZswA4CJ1j6hJon9YPWg2bcnuBcqRabdfHWUspo",
+	"1N+5lWgeyuPcsWlN9wXgUJ5/ksLCBfPXaJi/a6/Gs3VISJexf6fxvnnXjBBerWiCtlTl4Fc6Lj4Zjhge",
+	"WM/JukE94xrxYm3H91v34kz6GCurIMNIKrOIFpaBD/dNK3Q/xK8JnZs+U3XuubPF9WYZx3348tUszVdY",
+	"Lx1OF13IMuGNhXz3Z9BvBhyITHSyy4+jWTqv3YRvWZYb9ea9udVGQ+1s5CuoF3DONYRVKs0ztxzV4aIT",
+	"sAaIF+msHvhxGvGmNMYwdPuGF872AA9LfWD0fsADV4HbmNYrWbTWdTTclXhf66/jWuqNFUYYnUS43UhI",
+	"U4r5KCSiyVCahxrh7fduKw7gP2JMQLR7ABH3IVVISLuc88z6jvpVY1+PYBxRg96pC+d1dCZCKVfaZ85N",
+	"Tp4rheqZNoyoDHOJP/yLnDb41oudxNrFFZD5vFA3lWhYh9NNk8dsJyn7yLbV4/HikfzaqkfmF/6pw5tS",
+	"9obtREA7oZ0rwQ+FUxMBY7X5zlz5nSt3QZ+RiSXd5lQW6dcMpp+uafP8K9RlcrIFtLTo+UrqOevkw3eP",
+	"cOQJoN1o3YUIU6vabVWj7qE5OXFK0wj3PpkzpL3ckNb/AZHqgbhgpbydopOzJweJ50b5hJvRxuiheFSa",
+	"0fv+7jXP3ifaTXWScL6UleBX8q5/ptknTdwCOhbbE9ktq98+1oiqOY4R4qpleZHlxVS1t4weWXUYnMxC",
+	"MnfM5ToP3B1uyIvjGAHq/qFE/3K2j3VDFtNnpX8ZBu3vKZkKdE8+e12lbGY0Hk42BWjDiGFIdNwcTisj",
+	"tJfcnZLeGcR9APY+1omR3bwGz99mi80YlaRBq/HU/yZdt97FHujtxk2Vp+LEpBu0uNnm5Ly1rqcx2nZq",
+	"ImXHfXupR7p4bOoNEXxAdSB0kz19wSh+m8kTLnozuBQB/MSpd3ZcmUUxex4XeuJeqV3jAOVpmUfNKlRe",
+	"Z2iX36oMKjzQbvpziAZ7JqpqxFJhVaizPD74eHbhk5s/qyzMTF+br8z/3fXLlRvlj4Friii1RQ61Jwrg",
+	"rZ73kkzeZEUy9UxoU9oNnOpIYN3Qo3b5w9vJMH2mv3IDW0Jp9Huqo+1y0MVLqUuLc3we35nT+SljCBUi",
+	"pzUQCwEzPKBmJe6MDEmzjFlYHe8k4wF5/a4aQVjMN1ndBUzLTlDvORT5JStG4uKhVegPFCA2Dg++r7Tj",
+	"If4qbDJFZG9MqHcl8g5q5dju5Vwj4uC8dZUJdf+PmtUy8p3mctf5TudJIKh+8CiKLalBNTKxnu1/OqRf",
+	"7RtiU6EVVj/FvdauvgW51rSxZe+diFw4HSKiGpZ696E9kJuvHRFnOUkHG7R/3ialWTG5oNT+aBBkJc2h",
+	"QQZu15vAjAzLfJuFjbVPKqqLc5v1g6epGgYG58ImdG66kbzhhK4eLwfqWPWqAP0qSwboWnCMrGaKEZ7X",
+	"+XDtev0Ggr3ublpJnz/CoGIEIgwjSK1eywkQW1Af2O3g2kz545kr42AnUIN1agvXN5eb18PA5aFe/uzW",
+	"ecFmxeEjxsWngqbdXRPYRXebjJtPZ372yY0b/6/K/Mzl8swCYYq1DpE9eWkn28kbQhcIbh0FN7Wh5018",
+	"nt4geW1OlqUR9s8G2YCacbu2xpvg09HcIYEkmV2Gr35JCHAl8yOAYIbYa4IH6RLoY4428gDzhqaJZqJY",
+	"TAIiObqeWZBEhE0K6S7n8z8cNuScwvbBO6AlB9b+l2fmrk7/XeXT2etXbnx6O9TwL0bYC0q0PFUHDNAP",
+	"6FnJMZrsKtTP/jG6qqayK6Y8xVJ2P0ODGqYwoluIpbvgYzzbBONwczeMfimOiFQeXfBfnEH9cWaGKpuK",
+	"s5OF3key583X7zaBcerM+Ys/GfW5ZldXaiPPTsYvOWkDSIgtCVIlXjJYXZK+e6PAG8xeHFFimou4PFye",
+	"ParV6uxPUWNOiX7gQh1T7vwH/bBrR+pURMGx1khVkoNkX34oNCMO9tw7HixXCT3Jq0wqwdBZBZTVgDuF",
+	"CvDSzPU7/QBnpJzdevbv8wOqSwimzjDaCW7tNtP1NeLtTuudAJ/7yBjr5aW4ei/o0NeW+JOdyG7860Q7",
+	"jmqPlPFZJqQGMkj63Gt9hTyEoKjTJ0RVjauotKjnpcivqcMJNr2llk3AKNjXbUX+twA2bUBRV4USxngK",
+	"0+PMvk1eY7W5qGobd9lqajhYbVDKrNig1nrQ9KFXg4uTH2QP98DdEzh74BBsYi3IUV1gd9U+Fm+gazAe",
+	"CPNVH2x8t80Idg0mmfOTkxwtqkwULcWX4MBQF6tAXobpBkcHu6AWFOPqi0uaTQL+BDEgT0EGSloZZOut",
+	"kutHtXoz7nRynDxlLV4QqGk7GGvd41qCryYQLV2c/OAdD9AWq54p/6LFrXWKXOqK3AIekhJzVgRWlRCw",
+	"QoUj43gL236fHllpL8TLK42oG09EtVp2an1OfHe6VjtK7oOKrlUuzc9YLv1WWGpEd+IG/PvO6t3SLWFm",
+	"3Fm9u1h/SGZHZaUds39NlRbrD6cCI2OyEj1aZttYPDU/V+YTe9cYYPPNhmj9T+oGPQTUh0RznZ6UfPqV",
+	"HOKPMF9APZpxRRPe+5W2qbw5wwBxfTxivW9HM7kjaz1krmy8UznsUsA69omvxY04sxjmzxx8JCRPsrAO",
+	"tUGkG7KljsEnXAozdckVHMRxAXC79FjCx+VXNxsFVsrPjw+Uq51jTv95IrlIdSS5KJM/40h53lCXuaJC",
+	"Ftfq3aL3ykyt3j02SXDcMgqExE6TaxgReRON8hueZV+OHl6Nm3e7S5KsRPzbUcui3Wn2r+3XHp+M05hP",
+	"OsM/wj0oE3vyBP14E+ac67+UW5A3tONfkdk860YcKFV+3JQmG9lusVFQl5GX7QsGSEX2cdz1BBetQmX1",
+	"LJ5Wlo7ix/OU32hWpf3h7rRGvVNQEIDMyZIE14zlVyZ4L4O/BVk58s4Wgk2qW2wBJnMgkMoivifl5Vly",
+	"AA0rAkc/cqmZchSGLFWYiFZW2q37WTa2QvcOeVI1QRrILhSBoGqzs9N9o7koxXz6FtM+q/aBPCr20UQs",
+	"tBFm4+F/zIcGY7wAyWxrCCnadfjtcxEwHDgSYLtWg3amlr0ZGqXQY5oW7wihhqxaHT+OXzcki5TdiGeN",
+	"XnPDf/r2bDBlPUgea1bsJSytfsCGwBsh+7+w2l1q8XVjy2h3K4N/1KbZdXV+8vzFM+cmz5y/sHDu/NQH",
+	"F6Yu/uTvS9klVNrf6JqcrtWCThy1qywgTgyJUyUU0RHiPFK03EAX87QoiUwESZySQpui5hxtPIgKbgoM",
+	"TW3lJOBuqrJApUgaAK7F+1FjNRbEOvjqWgwDrNA2sH3vdCImBqVq1Gy2ugFJG0IxauxJMMVmqztNYmaM",
+	"J78gQsF+2HplmOxnjfX6jYXK9Pz87MfXjeFyWWe5GRg3jS7otoLuUr1DI38cHuO2kkVMiyyBo0deABPY",
+	"ZOwqb+ssLzN3U2O9YzJX3MEYdDrpJ/twC61TzplC3EO6TgiGNa5ek/LsOe9JWPGcOIFyM+DXjytU8N5r",
+	"+OPRf3/Ud9uSixPRfoUPxlvWjjr2mjdePA4lCbIctJouNVnt1u/HxrCylKSCvGZrkTGmm/Mz5QpoxMsL",
+	"sz+f0Ua22lF0IQ7hWNUf9Btj8Liv5E2L/TnUhtlEkzRI9py9phxtCcVXlEoHXX3BnumOXrZiqjZanSIt",
+	"9mWaGtGOl6/emJ+5cjbQhuXtkrXt6a7E+VK1nvYI+ePNQbRugmoTbo0V4FJALb74n3d4vF5KuETM4/Lx",
+	"o7fNQ8AFTPbLsFxvxWA/koWeo6Pfie29AsdvdAMbRPAdmNMosqXHWQvdHu2OKVBNicdFrTsh55IP5320",
+	"uD2XAE1JVbXiNKGybTRaD+Iauwxw1/EyeAt2Jz/VwZi4nMbFiVdURTAmxj3uamVIXyPDUiBR2LO3SE8X",
+	"17UWOV+2ruGUZ5lMkuzoIiQa7GCCRyBHrdozCc8lQw5U4ocrjagJ7+2E1L0s3QION3FdKU39VXIWHl8u",
+	"aE77KPTZAOpNF5TyTqvViKPmYbCUahjA1DE5WuIwLjZuz0gt9M69I4UKI8vUqDKE0VxtNI5Lw96Ym7l+",
+	"AvrVho68y+CsqPgOjQIrgfL8KiuEn24el/ad+cXs/MK8pn3nykG9FkQNwFEG8cM6U0zHrG+15JYhR3wJ",
+	"4ofduN2MGuwjQfmCPH8cyJf0SRnjNMax6ejzZKgUJATUQnY73QQA2B5b8W0OE9Tsw/SpK8pNBFqIK+4H",
+	"dxqt6r1gbOHGjcq16et/V2HyW5krz49/3szGp1ACLqMC/8Cy1qFB3nlXTGNbdp9WB6vUYRS/Y+7G3Ykv",
+	"jF14nJnLkT/W/zVbGzmxo/16jn1esqH83fpy3Kg3C11aBOYk+mW9XAUs/EIkYFTr6iEBg7/iVrzkFa6h",
+	"dsfDUxWbAYjDPFdbvVltrNZiZ38bPnNXV5tbJxgX+YPSfP2Uki2ZCS1AI0s+Tqywx27JkMqavTLSkfnZ",
+	"oxnSULO14odF+1WhdLiiBzPT4ZmAmh9lRZeVYEw2xCb8nvjaIP01MSJsjefJFJcdBfjexwL8ga/4KN0o",
+	"LmZGUt1KOKsEyhZbwlwZVRAi2ylgAjlQSHYOoa/aJszY+OM6LM4mUBKKYjyuDLEgb7He6EIEN6SOPDwA",
+	"h7eyqMjVGwMhKkjphSRq1jrR/bj2ETyUoa6JwGELEd5KJaAEW/aoIUSf91RSCKyRmaCvNlFl9abfBsLu",
+	"ZZPmxjH8U2muKCh7YIs/L/2X5fjzkighdTb8xcv4NbRzGoLZhq3vfnFmutpttc/Msi7m/+STOKM+I6eP",
+	"mkb71ykIqhH7VRoNQjN6v9gr5emPFkohGvZhafZ6pTzz89mZT0thaXpurnzj5+Dti9gv+f/FW8qKLTxM",
+	"PzVly0ujFRMaeAq14BVip4dvueAdKuf2OfQjVtr1Vrve1esPC2ryOf5b79MBDXqYYS3Xm5XoblxZaq22",
+	"dRnKbDfnedpqkza1lh0b+LHDnnevmRrJLjgy+uUIJqHdpCeu9FOBOFXvlowue++eliP3JhzZnoX7HSvY",
+	"jCaI8KH29OImB6FWCsYbr8G3jxB1w6BS5c4jZ9StaLJDeYqpuCnRHPCOnizikq6jybYH8YC58iUq3tgA",
+	"K2Av/ZJE/Rle+pg/hFiC3hZ6TF7v466GDH/ZOZh3DWk6JTkYibA6bXl/cQreUrYG7bhKeeZvb86WZ67N",
+	"XF+Yh2z5tZkFM4LYjONaJ4iEiR08qHeXgnarEQeflzpxs95qf146zqhi8gM5J07ed6LX95rvSLMBf4PO",
+	"zN66Z2p844+6BWMZqzQOUFNfKknEfrdkIyT0o74UxCLb4Emywuix2es/n746e6UyvzC9cHO+slCevj4/",
+	"uzB747ojEvk9jLcv+mDMlTlTm4C0vhUIU2slbp5hW99a7Z7Rio4KREturMTNT/G3ZfHTd4L7lmOYXwIG",
+	"sBHR33Nl1wb429m5otCUNnREfosvv6BCGAmowVxZcOKYAmReXGAGp0XBvYXYgKg+9JcKuYQRWNoIug5C",
+	"jbfJOm6eJhEqh5kRlWV/3gYEDfWLZw3BfM/et/UEktu5oeDpU7FCyXYgXNoC4A/JGPAj+OO4DI/jMSvE",
+	"Lp6EZSFJG7RagL8cZIf3djItBdo6Bq0TOxJEzVpAUMA7cbW1HAeONPGxTNy8W0MLDOIAgORdr+ZDlf2G",
+	"1k36no+izkeDBJf5D04BHoTTKZ4u0EctrrLsWoUtLftjqXU/bjdajDqFafZGraJVxBzSPTXfki27V/Db",
+	"ZfzyY2MYX7wFN1N/xcnr/g+Y7r/4FnU/ahM2h5VGVBXxh4ul47sJjIf7whL55yJnK9sl/U2FujV87+9u",
+	"ZVe1DU9pn6Cx5ID+BcgMANMcJH29aQIxr7EvMeNb5XaEFM/4/9EAfp4uA1UvSigDkZw5HHqf31NO/P7l",
+	"qFmr1wjTqI8LOwmbPLvJLmVdBgilIRcoo6Kpcnn6+pXZK9MLOoK/2SLgfkDnhV1kQZWPJ6g3A5awOVo9",
+	"1nPWF/4vqSxr9LqEDNSMj7vAgXwCBnnR3zKj+gqPtKSbfYFVya9F/tfLA1TM2ppuNDJc6G8Z7ViQ06nA",
+	"HXRabLeWK+Ie0DxNdiSZldhtyS9o3QZEPgatUmDWm5IeNXtKus5O+PN0U/zO2T/hpazcUWn+DxCaxsG7",
+	"ksKIR6XOBsk3EKKQY8ScPQL7XvEeCJClkAEy6tW4lwzsvYS/Kf0I0qfcGLdCYtpLD8iw3rEeyTk3nwOF",
+	"o9IF3IvCk2GL7cApDsV8fiE5R7BNVfng5me3pX7yQZa9ov/cxdTTUv/sSnenG7acaPUzXN6oNYtsdRRa",
+	"W5FuGpuRPsvdjFzrR5vjO7Fbl6FSHHvGTZ0P4d/oUrm2K8tatbdytGfY4nCx9PhWYcWvCGluf2tbZUgM",
+	"8js0C3WFOVC1o0L3TsTmp7pz3TvmHlKvEasgUlijlNvgl4y4RylUMoJ15rnlxVWzffhL0+hb6PYBehAJ",
+	"BiQe1QCKjkvjoxgAWLOxFDF+/wwb4Afe9gAXySIYcVgtodG1YEiWYzK8pHGZCLJaF3GJEpOSVMaKh8QQ",
+	"b3IZ3hA9qjSkuEmXbjlGGIyZL1R6OelpcKv38s54IHIdYmicRGWCeeGdCSaQE1+QWD6e6K62m1Gb8dQX",
+	"umC1nfmRC+U0VMr/Vm/ha0iEQRvyngW63z+KC2U3JPzd6P7FTuPppL6giJ0X+azJGlqVkv1/W++rB/4I",
+	"cnwT3/0Z+AmHr419Xkp/iRk/uDjwSmPlQl+y/0qffl4KgxvlMDhDP8HGgbwZPMsyKraHsrS0jpwFahBQ",
+	"VAp8O6V6PYR8AbDx45qJFn3JIAs1rODU85HC8zwGWgAr/A+H6ufxI67SRg/Aoo+GrHR1e6FGUSSx7z4c",
+	"+z01xRp6WrWDSjH7clDLCwt66QBGJAekPHbpNejNy0mrPYyUM8UMxYFxZkZJqHXi7vRqt3XNhDxa00e2",
+	"N+PsD5QAh9pFcUekEDUDisxeE3qwLcEHYUDUn4Wp6ArYSvPqHI9WHm1Qmh0qFaY+xs7lHUsuS3nFabeZ",
+	"fm+wrva0mhmVr/3/dFPJ1BvfGh18BTFLumn+xRteSnaoId4o5DedWJZG5PcQ3+GNfYbU/KKPLQadvpEA",
+	"Sqmu3yB9ijthhNLTp0R/CXcCtHCEKPClgDTpBqHBbRCVi5H+bAE9MifLSQ7vcIm1K90sfzxzfeGwOfUV",
+	"ZRNGLmk5Fj0jRnDatcz3lgS6CMN/1DC6hvmdSf1kH+RR9IZVYD8RVe9lYjNFczBqcka1HutkMrzi3kbS",
+	"124NzJJoETAt9MO6eVqRJLYYMnEjmbS0Lpzul7PyTcgqW98QlS59pwbDD9+AZkYLUe0amtnfOzuBI7Jg",
+	"v+GFHqrhJjtcHkCFzAto6ccb1+pdhArYVxp/wXT13rEQIBwF0lQ0bFU4JPW+BKC+9x6P95yy9v2LPulb",
+	"gY7L1yzOAQfSfAIb1jYFkvac7YzfVpzJVspV1nCQl937ulCn65BsEKSXoDZ462P4ZEhfwcasajtfrdWu",
+	"FWkzYe8idar2j8Zo3FyZOijLW6KXbumv7rluBrsSTv4EARzpBkAv1sXNwVCrChcAUvioROwjerqwZGzK",
+	"u2fYA5kPJKAzOpM7dL84QP4eDZUGKH4X23qfNVCGEbJHqxb2qNr8spCFk9bpVLby2RclkE8ZloMScRDL",
+	"SfaCorpflMGI/9D/Lt7idNHFO/MKtnUDmv8sFI+3bxSI283ioM7ZzYRGvrNCmuFpv7v+3TgLDIWNyNC9",
+	"k2np8615PmXkOV3ntiIliYW++DFY8fapy6Wq5l4JLT7rKGRsWe+IHkpn9e5dSLfahXsWXEhDAaSbCB3Q",
+	"sXchv8ag3RuP6VJdnFVTJurdQmwdyh7UNzvN83V33aTp0zCg0Hn6JN1KXia7ygMsz4GEWSlamOLccS/h",
+	"GV+i3YGuizIMjWUH50LY4wF1mcftxrt0AHclgJCfsqiNNFTTLf1Ja2Zaic8TY1zDZPuSiBkh/JJZRWto",
+	"dmE5B7r4xJFsVhnKtSZDwpJU8KZ4CxXmKX3Fm+S/EEkL3Z3LAnWEQIoEVpB9yUP34JdUjCvZiagY0vFC",
+	"zvoMi7OjMgKOgz9qwhet+sX8nJl2i8+bZ+GYiO5GzJ1dVFJnF/MyZ7feaqdiXAhaF1YHlNOPyNIQxLnw",
+	"nOKglNCxwiw/3iruANX3pJv2gLnKQ+8OyUM6V1Qb66PkzeUlloxE+Y2Ry+K7x9QY2dnOOLRYkqZKUXU5",
+	"ntC+gUaeWmN0Liy1W6vdevNupb3aIACn+oZuXF0686Bd7+JJ79a7DaXFcq1V7UzB6RUP79yrN7BJc+1O",
+	"6Zb9iztTo4Ez+azedfdl880ONOgbKOQecI7NZAevqlPXh1nNVP/Yh9m/eX5u3ZyGy088wmB3kySn/6BQ",
+	"3wdFCQlprMcOJZTXq3mujE83xmgyEyJboD0O3miCV2bwPnLrZOkckH2RrqVrgN2EZfEn0+TROub+zpYO",
+	"zPOOzR8cX1dnr4idYH9n95hG7fTsFPbComp2fM7BAQzAc0E3JYBcDBbmqYVWTAT3oTbrK7jZ1yGpR3mM",
+	"0KLDRCaZX6FZj35ZnpgesfX0cV5wk+/qgrN2wsBNpps/XnCZx4oiH7vZ11+6aR6335qNia2OxB5lXvgM",
+	"5nQqloJRuFOxTdFZHDZ56+Rk3Llz741etqiUjqaZ8/oWy7Ukst23zz+lbt+ofYddi9FPtnMXca3IQ3LW",
+	"lLlX5dVGXMQ75N89oncIHLQwok5cXeVonLY6uqnP0CVkVBL4R+EGfhCWmPvHnT7xiFDzBaOVlU5cLY3g",
+	"vPHJvXvnTX+zAwfEjYeh5rSdVo6HH902e9sO665ptuPQTUAkBchxqm13K+tgH7ePI89prncjvnp8fo21",
+	"B+gb9E8ETWKMxhbSYbYvc3RJaD8qrzYLNHDXS4aTYcD2JhQlj2/Q0WbfUWr7dd5A414BbJiv4UCynezT",
+	"kRg6ug/IfKXqRGEzIqi/eQVlNcLY3wfYCdXGcl6sPW2k7h4H+ywF8Ucn7NYJZ/MBE5TThCt+TJWOznZn",
+	"rqv0MV6QGTdt8evzEPcnznqkdmmTb+Ey5cPorDZoFA5LVqvZyfDMT8VFS3hSXY3Ytb2nsTHak2Jeg+1g",
+	"fi+VDcejcU1DyKPneEp7YmFAwWOBj8WHYoQQt4rqzpxI0J9Bc7JxDggIq+Q38TMjBpQf57mUlSU/YoWA",
+	"nOtbjRaNZlFPnoxFbVTY/mhT+1bpmCJERzVjcgNC/JvFA0LiOjw9oaDiAvweGLJWT7ejykB++Id/9R2G",
+	"f+SWjRj+UZdjpKXraeQrNmhKsdQ5MZN3dY1WXtkO47z88hFDQdjhikCpSn+hqfMXQq3v0xRr6GWxgYZq",
+	"IyF+r/CGRY8CxgtbW42poUFHpwc5Vzw4pMz3XUeHrFcbgvRvSjMa06358SrL4Xgqfqm96/DRn5HKGlGE",
+	"bH+BZoAMQnCWWfz3l9rmq+15VYfYEWHiUpIMXA/S2kQJiqmMLnrmKmqMjYoEu5RNkQCV8ohjjlDJDnvF",
+	"GuvpRHbix8cXs9LO84lm4P9tlDZXRt49v4dkcQExfa9M8TiiP+MSjhGqBLieDkeUK35nfcFgoqIfLHGs",
+	"ZPSH9YkjPY8P48QLKUa5xFxVzj9eYTmH8S/peuLEg/wrdNuYD+RRL825JGzXhhqPKa5pclxL5deFfUv1",
+	"TPp9y/yL59bpOJ2n/BZyJMiP7x4apa2z+oZ00287STpkpYTE+K3etkwE6YOxzPaH+q98AxjHZpQKkRwr",
+	"w8CDnBy4GKkHyD362mrmzB7sqc5QFrZ472NZDDgyi1mxDr633kVYQDtbo8JCFEFAhtYTuAuVLt4QTw9U",
+	"Bu+BFMf30qvL0R4FD/Gozk8jqt6baNSb92524rZq2WaiGm5TAKxzm4V65tlDgrH0N/BXNjJgTQxu0+Or",
+	"reXlqFnr3B7XWoOoaVCTQNIzPZZwUajSBMM45HC2sUsbVo9vEsfbNlSc7TBD4Bt/NSXMwJdDhT9e5Ut0",
+	"hPgSrIZCt31z8vyFn8389OonmVSxmSeaPXG6imTi79qOtt5d8EyguJibdnqM69n86lucArcxLfHbNUmm",
+	"PQ96dqopxu3kp5ikaPfi1EG0wbv+aSs6iX2XKyNG6+y3ajwFptR6lYeegVmcR56HsrEjsyw6rXZ3igKw",
+	"yMAP9km6pttLWH6SDBSK1YGKogdIvVKUys4uWEHsYbYJ872vwFYdKFutoSgWhnXEQpEBI2DSbDJ56bHf",
+	"/wYx/ulGQFhnYgJlxdxr4OU8x5uDLYNouQH1oQEQOr0Gnqde+muVHIn41d+4l9xnWsH+FTKo2E64y1NL",
+	"6vaUwlLcXF3GihPtY77mSjhhdD7Zv1wKWdiKPNpYKl/H0mwWYEUj3uglecIqGShpwHd6AqPFLNKuxHMU",
+	"ZIjVPDFz7umGNXdFRYFYKypqApFPknver7P+nasMu3jec7jYCQe8CPg1B8jeSE1rrUcMRJNJJCtI10iB",
+	"HADhv9RYnpbWGFMfaFRvL5MhkVoyxbqRfpl+Q2VESU8QSffOBsl3yRDnIHmBCiwsp/DJ0iHTsL7M3Jqt",
+	"jVwljz9TKXOO0q0lun9Xlv1WVuJ2ZaUNbVvYH7r15bjCKYwqnbjaatY6pamfnp8EfRLX6lHT96WLF+hL",
+	"0HF9hc3tQ1iPJv7jPP6D/+3c+cPmC3ExD6kQFJKK98hWcU1lrqzPJut8L0b1djPuZNgiP6j0hpox4EFI",
+	"ITW8SVrRDx7Um7XWg0otetQJ6NzuBGMK4WAv3VIOnoiR7BLvB9mXsh/LAX1k0ogY3+KtV4RXR8pEswdg",
+	"ciKmMuAlVGx+z3nvjiluZoBnCEqawIGgrcBcEh1dief6N+kvmT/LtHUCPGxMmbwCFQ7EXvDTg2Sodozb",
+	"5yT8bHbsX2BgIu8jfZZuZCmVj/imFjJQlH1x3+4fqBT0H/zkYr79YHLAaYwkA8H6hgpXbdWmbhP7wNPq",
+	"9t1EmrKONF/izFP9BznFN0ZzPXYvnMqCDYtBSWwSt+sPqIEzUK2i2oE/sQS2NOodNetA7gNdGJ4YsZtM",
+	"FbVU70DxGdv0iS/E1meYIv8McVRUMbwTBIZtby5cHpf8g3DI13CCTDBhSNvaiAfSsujZWsXUPNSkXIsB",
+	"p2vQvBBFoNtSGVYHss0+MAwOpWHxhLSWbNi3D80z5MAlTydSwkg86zoCdV+REoJ/ZIEJmCLOUief4AYs",
+	"xNEy+3/X8dyNZqrwH0oun5wf0Es/areWR/3NQutIJlGxw8YmpK5OtsGhC5x5ZQ9OpDtcQNBwFtjptoSP",
+	"wXINPfA0XkOHBSY6cPWgof3BT34ibu/3QIH9DgtcBJWFvfYZQWSvKtL7cb0TReSLQL0N9QIhGrDiXiIn",
+	"mqVsRDg7/SXWJ4E9tSOyFmAQcj5/lVN7nx5FLeb6QN0AgZ10XbzOivegDYkOm9laJN3CR+JbB1QGcEDd",
+	"SVkV00ukdhVU1Sg3cJsx8jqmLvdweaBQcJ2XCTKNejYwRUiFcPGAPs/HY6XWENjOA97q02wGK1ZF8TEF",
+	"EYXew6Tv3/dkW0Re2Uh1Kd4Qp5ln5pUaPOh7IPOZZtoy6WW89EAVCkV8ewXuD+atHoej+/7fHWw6P94d",
+	"p9bHlicXY9vYBEJqgWRwmFvGc6Yy7xsImRT2zSGWJoJkx++Jc3UsubAhYgdvTPbwYmPvoT72lTuPgrny",
+	"eJZmuIbzOxE39W0ecJhXfhxK966gCjjd4h1rTBn7F96fQSHq1ilrbfnhfbiR3q9oiGdNsMwiVztrwudr",
+	"fJquwVU3iqA5fWFqYzEUL0TGcgchOiXBIDIDK7GLlscbXq0MMYbkQHuq/QcM2bCn9EKtGzeRpQuyWMED",
+	"uZ/RrR+yX4ZxauS4tO4gGObHaNJ2ukEX6z561hgm8r4L2sn/OxH+bEqyXOdXxdSkk0gvwyqV5xiHTzfp",
+	"A1YMma5htEs89wVbheQ1i6xB1G/09iWhWEihmGimm1n6oazJ74/RrLdX+CbXeUS1lSV86dN3f8uP7CF6",
+	"soO+g77jpI3N0cIrrYkvDP6rQ2bS9DRY4GVtfMcJsn/BCDnTN4winIXGsT2sBDq5x6mxXqTfcBWJUav/",
+	"6/xHwVhUXY7/r/MfcZLa8Wx9sdKShFDuQJWx2L+DmXrZ0uC8rkTdpZMkMstN0p07+2Fmmu6vf3IhP013",
+	"4YPzZpru/E/VPN2FfAbhQ5D2Hi5d59mw94WXzZO2yyXts5VLJ27fr1djvzb5LtnjJwqzaBAQMACM0J0a",
+	"WyUMMUGWfgXhkT30494gM0eQPAdMDVo2ANTcxa72oAkhZzUYR6SR1DdaB3zQNpD3+opgR4wXRrN9OFED",
+	"DxL12HB3k9fU0ojhhaihASqP9Ff0O/RCVy5OhsHKhxfZF1Y+/FAoNNJDoFO3Yao9bhxaXXTOTU5O6uPu",
+	"MWqIZDvotrpRA2cI9vgrCtKIPj7Wz84GMROjSjvqUrTlJbiCW+pQ2LJcfPjwbJD8q56DlKwZop0tONj7",
+	"aJVbZpsdO6OdRwi+tZBGEzd3YlRrxJSuwXd09hNoWNEzAn59M9Y3pB1gDVvSDY78AsKMQO1TDvh7OEUm",
+	"dRe2+UT7nIXqtsjIx17liOCGBSRiDr19aBaHEL9D5ulAvU3IEr4iX/P9Fkt9wMTeTQbawdm1wxA5Xw+m",
+	"52azdclSVGs9KOwnrlMp0bZBjHJccQfRw31cnlNXCAW7XoHH9Rz2W7pkf/IN0S0XjmAuDwVts6KM5CU2",
+	"pGe958l7gy9CBNYJCZKvzJQ5XPgfHZy3duJggZF+YdS4jCLmWiOY99S38c8nUzXwh/p0wx8gGzAUpxYO",
+	"KwVVBH6ZRGdg3pLgUVCKGpsbvqILmh+qdINiEmbLHBb0nCtzw8RJTK9l7TOwvqFqtLDnpmvJfvoNqFPR",
+	"Jsmr3H4qVFpIvHtPTR9Mda0wGaewig64w0XgilfZSZV52pAjOxTVbv1+DKUajOT1p+RHrLabUbu12qxJ",
+	"/+DDD35i+gcXzqn+wbkLjBcEIwnCgwi5woqbbPjnJ89fPDN57sy5DxbOnZ+anJyanPz7kvhOpxu1u8q3",
+	"Jn+ifKuwb3GjfZdWJyexgkm2Xce5kX+iq4eZ4i9wnxR6uqwTY8JVMJhfO4Pe2xlEd2eVvbKnqJgLCCrX",
+	"UIddJmz4kUAYb1PhwgBzldkebykGdjA7dO+BUrW4gXYdMwH1pkZuoEHRKFgDS37YQTu89NxYiZs/ys77",
+	"ITvO/nwBY146hBjVl+NO3K7HnRFCBDsWFHbNTBExW3uT8kLJtvpj5pCBO45uJcAOgjEEyoUKpIRyxgcy",
+	"RRJwaMwl42NcCszd9DVHeKh88WsE4Y2LGkt9EL0Qx809JObeIjyCZbRDvHy7rTDwOiRng+Tf4CbYR95S",
+	"NZKgdSDkq6R7+PvBBBjGMpn8JY4YQRU9sdMft6PFqBkF83V2Swf/z/yN68FYLepGK616s9vhxVcA4wk+",
+	"M/s/h5qdsR0k++narXG7hgrKsdJNDL28ombv4A8NeRdqniqCsaEJx8eGcV6KBiV7vDAl3cThTs/N8h2e",
+	"bS7Wm/XuIz14RB2Qe9wIw+B6lsWzICU5L6irx1DGzJp5bcpvZFdoCLJ8PX4p8JX4Y4ynFJbihyuNVi3m",
+	"AV+X07Mcd9v1aikctXR9YandWr27tLLavYZPeGy39u10HzHrDQgsvI0RmYvXvh81PMVnteiRUnPG6N9K",
+	"IX34II7vearNHB1gsJhwaKE/kp5/IT+YtM8ndd9znkH2ReZPQ/DH52ayY1xy8nvUom58hmnCUpFJ/Qtq",
+	"lfRXjikFYxQOcKPsMmSHSU6yg2rQ5ye3jmP4LvddHvZT6rwXOxr15XgeNUARUoc/8jnbFI3S+fxKde2U",
+	"S+9kKgIHUMvfJ0Q53D7KmJRKQOPwhBgPhVsl2bHkVvxMlvn2KcbtOIXvgR31rRLS57zMfLdhX0XUGWUd",
+	"OfDRbNHLFooEP6RTO2KahZ21dEuF/JqBg/SpMypqK8A1tFXwielXeF2GpmHmwda4giZkRtLNB+JPZhwA",
+	"aqAxNpMXA5aCM8ItAipwNFgHUrO80FPMBNyx8DFzZSf0VGtZbaJiNEgv9DoWUVgwCa0gkEjQnw2Sb7Ug",
+	"Jrv2yVhDUIzRfdteHsGjhc3dcfIDigcNUdQgCUJfhMyQxcv+WkJvLWwxGy6D0nBstZUWoyPeo0gbxPj3",
+	"odn0Ex6TuASbqw5d7tQga4V0JLa26J5gORsf709shrKyDLmb8jj9GPF+axhjscj58e7vtAIFRZO+n0Vr",
+	"33GUn1IP65f7bM2vFXocKoQnYe9HD+AdQ8H3X1oI5vAsL0cJ4x0GzG3I0ujhPClJRw3m/ShH71qO8kN6",
+	"xyBSMmvjt1O/1VNQeW2muZrcp9yUrOTiZh6vXAc7d4jNn9In4jNHpxwyhJTuH4Nkh+f4HeOhbGFRREF2",
+	"AO93vnZYokJ2jwyBJzIwxZierk6jPVosoy/P6oLclKOWPb3vZRFlAi7KJclJ0O0i9R0ntj3B1PvhCTq8",
+	"kxj1yENCKLdHBMvTHLE5xHK8fIdLqEbEonBRTZWmGwwlxcRSI/TTvvOz1h24X9QWZ6NjRdmUjq//gzJR",
+	"NixzwvVOBVPiPLZbZAWyflRgSe5E1XssPa63fdNJ0flYCyyUk3U806jWvLfe6ST/hsgr/muAEIkwoJjV",
+	"IHmJ7m+6NV6clFsRBABo4k9YUL+0MDN9rTLzi9n5hflSWFqOO53orubWBVGjHUe1R0H8sN7pdoydO05/",
+	"J6OFqRIMxKu05yGk0FMc5L7nNEDVy4nTNfvR4GAFY1J2mK88wSNssvtjBuvEuKLqmPBqqq4Ww5mK8rpU",
+	"sB9ekd99O13V9JecUJdFcxDFnWZ2NUkYlGrbsKyodiU5C1+wF8v5yfOjnSs28NpqI65VIgHrOXfuzOS5",
+	"hckPR4b1jDD777TpkmogbeKw7yjSGC8uxoiGirrvXgc6j31yoM3k4CS4yUaOv/wrqAnMhw+9sudSMyp0",
+	"XJXAgWn/ZaiNnJ6RjjYHktLCHM8YU7ju5rzN+EFFXAfjodZaEh8laZjZ+Dke+DdY0pDsoVgmg6yXxJ1q",
+	"1IBdHRfcEAOo0PiP/0UW5ab0Uf5jz1XalfF4QTTaatRaDwDFN64XfsDp34VcAEaeVWWR8eTqUly9x5j7",
+	"L+mJKa11PvtLcoC15Mm2IKmg9VPHMW41YJOkTI4pcx7GHjqZrPSUedkZ4+3U/zGusIaEnawBGyPUxzRO",
+	"b1R94qRvX76/ZkkfdDkHDF6aHNhXa86+RY0Gc/lW8cwjeJOJYWc8SAYTElhje/ZaesVauX0O7CHaXqUP",
+	"9Fw5f0CduLFIxWnjwCZjN4HoG+zuIUJOnoNa6OHqUH4Fq6SRrYaXsii06Ua6hg9JRemTYgc1lPR9nOdM",
+	"gRyqfZFqP4pzCl+u1USNXiVaZI1IqLvehb8OS404qlUMp6LZ6tYXH1XgT9oPzl94HJZajVrF6S74vQWv",
+	"hDg0Iq+76afrpGhdMpv0hcySrSlBNKFeICTlZCCyivIK6at3W7ouIQ13Wq1GHDXZtCx5cgz7j8phk2Al",
+	"pV84fHR4eQ8drX5VOv4XHDz0UhHfdb5IrymXZqSM0s1gzPg3fFlPnn4J9jG0kGR3BdXyDmX5/CBdE8pH",
+	"MO2NTwHwi8rsoSJFYrQmVmS7rAnMDAn3gdYIUW08cMmSi6GylZzlfYBkmriX6Hit8xLiPvAl/YtIgfd5",
+	"KnTopAUQQAwHrxR7IFuCFxrWEOrrVtpnFcmo8DQXnm9blsRVlBur5l9ciJdXGsyVeBwaJzvTkBLfnGs1",
+	"6lXAaWlGgiMDaJ1txzccl7TzNBiiauMMrpvEsKbu1QOhxBxFz6DKuz6/DDimRrwifcbch6EQuZeMxiv9",
+	"Ml3H3XO8G6SnzxuVg2zgDWHehqa2B0Jm0eeb+8Ki8hBPJHtL32kcZBzOS8GkIHPF8LF90Q9R0ERI9WIO",
+	"+XlYksZF8f419X+MeVPb5ejhLP7m3KSFetJb0enSdNLt52Tczllz79CDdkPwE3R0SDtCeOl0dCc1g3a+",
+	"1m6FgkbWtSwayBW7/l1Gq8WLJbZ04HD3sry4nJ5w7PvOZnAFqxj+FpIoR6djsAK/pyaUHB71kP4heZ7+",
+	"N4zGGkf1fa2zKBDOzBLJpXrcjtrVpUd5gvmJ+OKJiGfxfZcDdWtpAO5h7jTdev+FIH3CKxuoe+YaQMqf",
+	"EWUot13IMvWV2FhyUV9eabWzQk4/qAFyO+TFzBGFSTPk8XJeyc7dAvimXRYWP2Svv6TaWmBVc9Iu3qKT",
+	"R6NeKra8cW9gDYyoY8f6lKF6M+PDxNt1CtAg+bNluWmtILHPj+CPIM1mEM8Cm8ce3Sj7Wjd9lURtoM7y",
+	"leE5SLye6BOEQB6IuGVFAmZxM99eEmG+Ga10llrdd93Q3n73CBnBS7iqopapj/Ikdn94Enh5VWhQGxCi",
+	"P/0m2dPkBltCoN1j6v0sW+mELbzQcDx0YnqcjRqsnSv7JsPO3Uj5PksrZSrAJlosnEUu736cNb9/yq9J",
+	"a7zuFHaPupINkx0s1Xjf7ss/U3hmnRi47TyMEiUQtZsmu4IzalbsJjVa/TqFhzrZvoNiI6Ywl9hlMGoD",
+	"WXXCBVolKz+E8kKjW03WgrXux+12vRaXW10Ro8pOlN8wf3GEqLcT1aM5LB9QMaFK7PAToH8YofMnHzIb",
+	"PiLI+OBPMA2vD8RDDdcTu9vjVqWCjlDDHO8abMMrq9W+nqwWbk9rNpcBeRP8tUMmyHY04RSqO2kBwF/T",
+	"b/D/QE8r6AJApB4OUi0thsEe9yrdSJ8Iy9xs+SZST3yhMxPWK+2/XW11ozzFN0dfO+WX5VwZh+neom1c",
+	"aZvY9H1lb4AJpRuuCY1w8bVj7kL6yJVEKdJrQ7IIeQAZGTYwqtD6NRh9a+mWVnc85aA04uWAfqoinZQo",
+	"9PaBQhe7SB8ozE5DrkqixxnCekzBR6gIHcEEx+r4xMigfnA7wLlRnncf8xJbbNpiOFIHE+5dMNla7ZrG",
+	"bi5cVsqFrJ9DZjvA0uy/WeouN7RCMQd5IndKOXEu5T1CrjNlf2+ec1H8f8G0x8xwiER7QOd4J5HveiQF",
+	"4cGZ44w9XAJwMiWZAP2TrY6LRuDWW7+bYR0ACBs/7E7AOLQnmCPKpIB772OffTrsCk8KomuHvknmqqp5",
+	"AvblXVpl/dun/O4yRlvcqFMVyvsZGM2b1UgSwkzjn63W9ByOMehv0mec5s8iJ7ZC81R5o5GABHTPDLAu",
+	"HUKNuyoKhboKk04dqHU/4l4ZZqpTZR6nXnKVsbpkxFju91yjPVdmo9oVI8po3J5rx4txO25WNXqsDHHQ",
+	"f/JeSIU+ZGdxE5lrzHD6kvhB/yIyPskbY2YKWY/BfirxIcWFSIm6uJWcIx+i+ZqCXFKgZpKB4mAijPGN",
+	"EflVjH9WfeFhQePMlYx1hAAz5LTC3a/o0j4R1gs0juhP58GFcSNbwdmoSCLZGxCzPsg2YxAmb3pgZCrk",
+	"DPGFfbSrXyM5l7ISLCmhIFEHUtnrdOVPHNAoJ7kXxLyVgDpQB2RdEDKGdlSL21X5KGagbq7HpcE0BzbX",
+	"/TrUCPOQqEPfbw9rBQvVeapIL6iIp/MnWENaOPgmjhlmC902jjxqkCl5T5PaYqbPtOjU0TVcJ+7OOpI7",
+	"njz3P/ODS+04k4Ng9vr05YXZn89UyjM/n535tFKemZ6fn/34emX6o4UZC2Jr8NZg/R9h9bSIxAEH1sse",
+	"VC+hB50LZBrS92VtOvum2qBTrU2HpxjV6aydjlnfQVhJJZPuAETt2+BfrRFZwFNnTEX/nmpRdoxVseMB",
+	"BIj9ktrPpxtIHARv2pPYWlc1PbBcyxZrO9jJwv0AQp7yJnIxy9R/79GdeqIyeWnjMzm9tyPFcSmIm9Gd",
+	"RlybChajRid2YjD7nFFrT2coKILrzMr4zztk/CilADiT0hTM5Kilz/PujOkJJj4Omww1Ex/vQyHbtyrJ",
+	"o0JZq0qnqJjwNQzrO9Kkx6CXeZzdr46VaLvjOPKGXxKTLQ6RPo4p9jWdZk62ypCkcvuhoPwPkHKQeDL3",
+	"MEGk6lr6FVHCUYPmDaNYgzJTyluSYbAcPaxwwnkkOjsQWhNR6bCurxDVFDyI2s1AL1AWzGXkGqQb9F+v",
+	"SAqwyGDhxo3Ktenrf1dhFC2VufK84Bveo+fWm3c72PPGeOmdRqt6TwhJMtRujHQNlxeJkq23nMVJ4SXx",
+	"FYzvK5QhtjEf17ufrN4JpldWQtf6YM+bHv+aWHJtJFktcBSVyMXrKDQSyl6Vpj4IS8tYXA/rUzomzUjj",
+	"PEGFWDTh5dZ/J8yyAO4BT2O9FzrZyW77pb9jIrIruXkRRtK3UZuWI8f6tejZFToiqPakkiJowbcCT8Xo",
+	"pfRjAVvnHPEBt6Uw4baRvPFeJ6Hb8/WWqznAse7ytUEgmsmDgkdo5lOKZQxU1HAyGM/TM7isR6+9VJaT",
+	"I+fxXxUPTMX18Znl1p06Vt2MgKrkszhBJXQUJPdp1U2FGGAgjrbLfLZtW/beA3X2e4vlgKNUuJOWhVsv",
+	"XE7TibtlK3HnUWRQWBuQtlFgBtuKY88LUWUzh4A3eFCT1Zak2Z6sSEHqDQWYa79GpAN97FfgclJ3iH9G",
+	"1IciNh2TlONUjMo2YC+Il6N6g5tWa8CHq3uW28EnC9euhlakEosB6DHpJu/9yKQUAVDb1P6NNw1KN8BR",
+	"ZrPZNhOt6Ub6lO8mLOlzWCkGYN8BU2/HXOUdxyortZcY61OdrUGOyrVyskf3dVHfQg+BqQ9DHzCQMdj/",
+	"Y6vJPp1ZZeXqE9danSr0vWORR9Z7YKq03GrWqMnBKHagPqkTBQYeMod8OoCBpn04dKJokv77oVrFuXgb",
+	"SAjQqXqq2+uJKx1YzfqDXBWJVOsKcT/G5bD7c2elXW92reabam58aoTaaArSifiiNKHNTFaIXWxQab8B",
+	"1508VSU5G5ps/NLoHDM4DebK2i993Vv1QKn5C61LkCArxXVXVkTDxF2yyUidBRS/lhXxSkWT7ITqBibo",
+	"MQ51DDZsjwcJQnfDI6MUfyAbDbqQDQdWU1ZX1928m0JDQBz6nrAFFmvZ8b+RuxS6Bx5LSEAd9YkjxItC",
+	"MhT1z5zEU2SAK0fsPVD6v6WWDpkwkQPjLBbS8hZYxEswxmKEAhJLGlmnBntTCHDBrMhjILXgWt1k4EjX",
+	"SM30EPbvJAPhiNQsHIWr6GeMZ9mfKwxlzzBRvZb0xtU8P3UF1yLkMnr5hp69ick5Tsmwjxa0Ge5R2JnZ",
+	"6hUk8oKklHdbCmlJExh0aGW5okrZZ1+UotXuUkulDRDUUpIU4EFcv7sESvV42Ha9wKGTUKFHwC8ZRjXH",
+	"MJ28XvUL22mhPRGawk19kqF1D4O5IrtTS0YV1MplFEmU8gy1XFSTUu04VjysIfQAu8LL/JGnNuoZIm3g",
+	"qc9Zog3y5DsYwNkzOBMFNdaOYF4Zx/ZB8Pd0i0xR2b0EzPIt2VAbW865K5DSNXkdKK8X4bQ+2LMMAMua",
+	"5It2RRR64AEZEVU/SPqut3tDz2b/IU8RKU/e7WOOUYaSPe8qqIk1kTiCKm6B9EQNwebXAbwTV4Li40q7",
+	"1YB6grhZb7VLx6mBtamcoAq2x2Gcrz+h1Gs9E06v/j0Ctfj7Y/66DhFEKkkf2FpDsRRHCoM4yo9zkbAu",
+	"mKsCQ+0HSAm7C5qjRzwo+0YnRxfUUcOyQuFxhTXQPBskfyRuxM2krxrlm1zBq1pNB11aLCbgPQesTAgp",
+	"MfTWwoN03Rk1Q9fjgjbEPJ12DIXaSEpVqddgB5F7CrikPmDlWXKJlALtyYulY3XH35eSbQ01egryYn82",
+	"o4VFiq9lpbM8Puy8bfM45Humyd4qxlXlUqpGK1G13n2UWYmrddLUq0/yqpiG8A+AmaomHJM0FdXA/RcE",
+	"zc6UK9emf4EQIfxknjpnonHqhMkzA49VuAtr1gFMy4C2c4T6Zb4gh4W4v4MeY+xVYpwu2futwob1XhKz",
+	"mBM4IprFZg8bpYJFfw84GmhPbEE/EM48zsx6ItULHTlKm8AbCcp0lndxuEKtMAPoZENffC8sVs84UHBP",
+	"TtgQnDFyTF9rOzDQmJUVEjhQxYQJR1PLaNgK3IPUQg0GV+AMzjw8Uln3OzqBmdxiGlXX+3b6vk03DTK9",
+	"dM0/n4LnThQgtlqNvMpDvpNl9TenXBy0sbpjdhuJq1/F+1ll6J5LvmyEPn/pDzID6wmvQzxLb7ucDAg+",
+	"7AWjs+oPuwMJ0QFz1A3ajCxZ+3TchxnUCf8h++BuPcWWBqjsDA9bcGygfnwFpCTDZPtSQLFQiPm75s5G",
+	"dEaAtg8IHpSFbQRPeINCT6L/xtlAVizhDvLOHyNCJ0VNS4F72SbJFLj5AWRntzkKSP3ZBu+TobaqgVfT",
+	"+krnNoNrnyZJxVAajJY/NcsZfWtq6JBeLfGMaT7trcN5pziZE/dNc9XlyaeJvz8KKdhfml96BMXPjAIm",
+	"wJ38jp6sC2znMC09CzaK78Tt6VptJOE/d6xvH0nQ1BvvWHo93pyfKV+fvjbj6vfIydaNdo9BvQk402Nt",
+	"++id8GG4/OlHgtLfZr31Ng+wu+kzpQNOmewOkN21FiRWE3KzKZtHyg/Vh2lUQXt3mn1k4daRaKe6y/G7",
+	"Zkb+9i2KuNWm4jAifjfuylbsWS4d/JT+72zt9Pbu90qv1hnCt1TvcQN/z5TgD8HslTwp+Nmjm6JHh7cL",
+	"v80T6H9vuqZZTOAT6gJ9Nki+AW9KMvPD01iIaZd98yVim98gj8Ia+41+nnrJ/qVAbfAHjQKUV2hLSKk6",
+	"k3HL2+c39DMjXpj8EGOGcJ4PkqEyVwczuSdexs+UsvbWuXI3IfIt+hj1CKGA4T5aeS+wJIXNYtxDorIq",
+	"B6DfNKqpsFxvXo2bd7tLKoGKoCMMv8gxU/36yTUimcYLMzgQf1QlRa7nPSN07XIa0s2TNkyngr+CjkJ/",
+	"FdyJG63m3U7QbQWd+H7cjhrQdqMTBitRpyP1xbGasr8TLC2iEwcPRmC3yA3KlUEWzByEXhy3k677yai3",
+	"RNEpXd95urksuknm3c70zcPdzsfVXoo1bayQNexBg6pfwY9X2mfOTU5af+Odpmq1oBOzWtESpP67q53S",
+	"VIklF2G8WrepjAajxsgKcurPySaUHmp9ZQS2itKb3fEvhsZg3G3vMvj658p/Jfvk/2XZMnPlv4Lk2gsM",
+	"v2RQumvxQjWksc2bpWaereXW/XihBc3E8qDx/QBYIagoJIDqoCeSkx7rhrCbES8fYpeuLJtM15TxZaiG",
+	"A3diL7OxK3wnM9irIeIx/rxjUabci+MVTCB6l5z3WfpGsuUZ3WnDgFMvwaNcbfpfqsPLJKPqJfvZg+Zc",
+	"hEriYd9IyCb7wZiaZ7VRnn18ZrqhDxFJ6fiMxYBfe20ZZnGOh0HUuUeriOjdAyIG/xLSFty70xAg8sX7",
+	"AuQ6sFhQPHwsn0zPazgLrYMpROBfi1I0rPWisDxbpD3cdTIS+NZlcGbZrDzsyq9cu/HzGW0UwRh7sJdJ",
+	"AQ7jNXn+Dh8/0VV8gea1yjnGemDk/2bDhWEIOq2oc8/BBH4oZa8P66R7nLLFZ2t/OHVuiepftK17jKEg",
+	"3tV95xijQnzKPLeqSvffRJ174wFPMzpvm75sgaAVmjk7Q2Qp4s+b9q0uxWRN79LuHIoBQvHSRNjXOBA+",
+	"LrSjOqO3yrvJzVeThpbpVso5K1Jj1wCYGGQEEXlbkgdjRo9zuB5EJ3roD6FUblANxItkyMvMVDxOusUH",
+	"oaac95Et1B6VkKSBqFxIn4rKhaHrTmXsFPtng85SVGs94B3LV+J2FVh/toPMapZsjT+vbdUR8qj1ZqUr",
+	"dtzqOXsxywvQfvqFo/n6IfS7+szToN19cQs1D8trCjzG3nvkQDB8Kzs++UqGmZSAR+6haZ5u8RJ5Xttj",
+	"a4Jc3dOZpt7HuamieeXbRxF+2W6ZCDuLesDKL49L8sUT357cG/gJ9xK4GkrnNqLOWCr+pgJHrYjv/hd7",
+	"+P6sXk50ANNfguf1QquPJldqcLhMlXLQfhZ1q0sZ9/x3GnPpIH1Cjoo/1J/TfBe+MfB0l8RHm/6c140n",
+	"hlGlbF11gtOnmaOkBqcYBcLuxaxUjCVgt8l8QOwdib2VwZAWzBqwgmDTZOA5Ya5ks9WtLLZWmzVZyc70",
+	"6lf4Q5tkiqVf9tJnyXN9GvCPIWDCnssagl14lYIF207XkOLjDVGgQaVErv2gScFxQbF4ZVGOQsDvK4HD",
+	"zJwI0M/P4lfPTU4CAT3/p9We06lgO+9Gq7bjzmqDgrWSORv+udhuLVcMLZoVvu22KrohdkuJ2NZiUNpR",
+	"Nwbd3ORvqhhPZCOx47rG2NQHC8Ed8bEflB5nbblYl4KhYiajV/gcoXCM/d7Ri1XfbP6aQkHgP7KcKTDi",
+	"fI2nl2JgspBn3+/yPeOYWVmNqDPJ95AEg5e08zLD59RqE5zn8RNA8PlIMbjHr1JNnZuczNCiNlTIuC34",
+	"D+jy0/LF2Qo67wIrtxoFrUT45lHIi4za7qL2YZtGeBwxL3jWj87QqbDHePH0YU2v+Xv1RqNTTHbpu0eQ",
+	"3g697bPS3VYpLNXulEZI8nXEUIXKtqT5GNJ39Jq/KPk+bRQH7/mpU0oKD+n0CGjeRLPVrS/SrN3933x9",
+	"bfI7YktewD1H0WPodyLsL/v6VJ314p8Qe3DdM71TizP0DbhIY5GBhxb4fYYfHhSc4yjnIMy7bN627Bzy",
+	"+qouRc1mjBdYo3UXqM7uLLVakE2s1e/GbFKlWlRvMLzb8mo3rlXi+0gFxfyTf1itx90KIybuVFgUa6o0",
+	"+ddTk5Ml/S/AgMHIL87j3zKJiuH1ldV2ozRVWup2VzpTExPso87ZTiOq3jtbbbGAfvt+vRp3JhYmJycn",
+	"fsb+5xe/+EVx6ozMI/HubsRRTuYPivb7RhKEW8J8ihjYPGN7L7SGstxvU2+47s8Hrfa9RiuqHY4kg2pW",
+	"nyPuQWlCb/QTQuIzf4pTJPn6gOFwEGgI1LLKZKYPhQpSIQDJG7YN4UbeSNdphAPRaD5dS7+RyCQJWJZQ",
+	"l0CkJjfp3WYVZrKvDIGwVb0sUDOq0k/5mp/qagExSs/VrbNwvP9ouz8J2ucemXDFZug4Z+zBcfu+G6s+",
+	"PTcb3D8XjBFw4RV2XlBawCQ9Xk5N5H6/TAasPQGi1PGumrh/ztFqFB59PhgjsK6DdS/pK+eHarJpZlsi",
+	"7E2dGoghxGvkUs7Q8x51rOdBWmmZvuBIdqyefByKD3D9lA8UhKn2+Sdx1OguqZ/MdyP9K4y5v1Pvttr1",
+	"2PgceKNWG/rH89H9uPZRvdE1R1BeiJdXWEca7ePp2nK9qX6AXbq0JzL7gUVR//8DAA0xe98ReQMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,11 +2,26 @@ package e2e
 
 type ErrorResponse struct {
 	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
+		Code      string        `json:"code"`
+		Details   []ErrorDetail `json:"details,omitempty"`
+		Message   string        `json:"message"`
+		RequestId string        `json:"request_id,omitempty"`
+		DocsUrl   string        `json:"docs_url,omitempty"`
 	} `json:"error"`
 }
 
+type ErrorCodeInfo struct {
+	Code        string `json:"code"`
+	HttpStatus  int    `json:"http_status"`
+	Description string `json:"description"`
+	DocsUrl     string `json:"docs_url"`
+}
+
+type ErrorDetail struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
@@ -14,15 +29,43 @@ type TeamMember struct {
 }
 
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	AllowDuplicateUsernames bool               `json:"allow_duplicate_usernames,omitempty"`
+	AllowSelfReview         bool               `json:"allow_self_review,omitempty"`
+	Checklist               *ChecklistTemplate `json:"checklist,omitempty"`
+	DeactivateAt            *string            `json:"deactivate_at,omitempty"`
+	Escalation              *EscalationPolicy  `json:"escalation,omitempty"`
+	Members                 []TeamMember       `json:"members"`
+	OptionalReviewers       int                `json:"optional_reviewers,omitempty"`
+	ReviewCooldownPrs       int                `json:"review_cooldown_prs,omitempty"`
+	ShadowReviewPercent     int                `json:"shadow_review_percent,omitempty"`
+	SizeRules               []SizeRule         `json:"size_rules,omitempty"`
+	TeamName                string             `json:"team_name"`
 }
 
-type User struct {
+type EscalationPolicy struct {
+	AddReviewerAfterHours int    `json:"add_reviewer_after_hours"`
+	LeadUserId            string `json:"lead_user_id,omitempty"`
+	NotifyLeadAfterHours  int    `json:"notify_lead_after_hours"`
+}
+
+type TeamShort struct {
 	IsActive bool   `json:"is_active"`
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+}
+
+type User struct {
+	InTraining bool      `json:"in_training,omitempty"`
+	IsActive   bool      `json:"is_active"`
+	Role       *string   `json:"role,omitempty"`
+	Skills     *[]string `json:"skills,omitempty"`
+	TeamName   string    `json:"team_name"`
+	UserId     string    `json:"user_id"`
+	Username   string    `json:"username"`
+}
+
+type UserMoveResponse struct {
+	User
+	MovedReviewsCount int `json:"moved_reviews_count"`
 }
 
 type UserAddRequest struct {
@@ -32,22 +75,62 @@ type UserAddRequest struct {
 }
 
 type PullRequest struct {
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	AuthorId          string   `json:"author_id"`
-	CreatedAt         *string  `json:"createdAt,omitempty"`
-	MergedAt          *string  `json:"mergedAt,omitempty"`
-	PullRequestId     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	Status            string   `json:"status"`
+	ApprovedReviewers         []string          `json:"approved_reviewers,omitempty"`
+	AssignedReviewers         []string          `json:"assigned_reviewers"`
+	AuthorId                  string            `json:"author_id"`
+	AutoMerge                 bool              `json:"auto_merge,omitempty"`
+	ChangesRequestedReviewers []string          `json:"changes_requested_reviewers,omitempty"`
+	Checklist                 []ChecklistItem   `json:"checklist,omitempty"`
+	CreatedAt                 *string           `json:"createdAt,omitempty"`
+	Description               string            `json:"description,omitempty"`
+	ExternalId                string            `json:"external_id,omitempty"`
+	FilesChanged              *int              `json:"files_changed,omitempty"`
+	LinesChanged              *int              `json:"lines_changed,omitempty"`
+	MergedAt                  *string           `json:"mergedAt,omitempty"`
+	ClosedAt                  *string           `json:"closedAt,omitempty"`
+	OptionalReviewers         []string          `json:"optional_reviewers,omitempty"`
+	PullRequestId             string            `json:"pull_request_id"`
+	PullRequestName           string            `json:"pull_request_name"`
+	Priority                  string            `json:"priority,omitempty"`
+	RequiredSkills            []string          `json:"required_skills,omitempty"`
+	Labels                    []string          `json:"labels,omitempty"`
+	RepositoryName            string            `json:"repository_name,omitempty"`
+	Status                    string            `json:"status"`
+	MergedBy                  string            `json:"merged_by,omitempty"`
+	ReassignedBy              string            `json:"reassigned_by,omitempty"`
+	ShadowReviewers           []string          `json:"shadow_reviewers,omitempty"`
+	Timeline                  []PRTimelineEvent `json:"timeline,omitempty"`
+	Warnings                  []PRWarning       `json:"warnings,omitempty"`
+}
+
+type PRWarning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type PRTimelineEvent struct {
+	Type          string `json:"type"`
+	OccurredAt    string `json:"occurred_at"`
+	UserId        string `json:"user_id"`
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+	Actor         string `json:"actor,omitempty"`
 }
 
 type PullRequestShort struct {
 	AuthorId        string `json:"author_id"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+	Priority        string `json:"priority,omitempty"`
 	Status          string `json:"status"`
 }
 
+type UserReviewsResponse struct {
+	UserId       string             `json:"user_id"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
 type PullRequestCreateRequest struct {
 	AuthorId        string `json:"author_id"`
 	PullRequestName string `json:"pull_request_name"`
@@ -58,12 +141,141 @@ type CountResponse struct {
 }
 
 type StatItem struct {
-	ReviewCount *int64  `json:"review_count,omitempty"`
-	UserId      *string `json:"user_id,omitempty"`
+	ReviewCount          *int64   `json:"review_count,omitempty"`
+	UserId               *string  `json:"user_id,omitempty"`
+	Username             *string  `json:"username,omitempty"`
+	AckedCount           *int64   `json:"acked_count,omitempty"`
+	AvgAckLatencySeconds *float64 `json:"avg_ack_latency_seconds,omitempty"`
 }
 
 type StatsResponse struct {
 	ReviewStats *[]StatItem `json:"review_stats,omitempty"`
+	Total       *int        `json:"total,omitempty"`
+}
+
+type RoutingRule struct {
+	TitlePrefix    string   `json:"title_prefix"`
+	TeamName       string   `json:"team_name,omitempty"`
+	RequiredSkills []string `json:"required_skills,omitempty"`
+}
+
+type Repository struct {
+	RepositoryName    string        `json:"repository_name"`
+	DefaultTeamName   string        `json:"default_team_name,omitempty"`
+	RequiredReviewers int           `json:"required_reviewers,omitempty"`
+	RoutingRules      []RoutingRule `json:"routing_rules,omitempty"`
+	CreatedAt         *string       `json:"created_at,omitempty"`
+}
+
+type MergeCount struct {
+	MergedBy    string `json:"merged_by"`
+	Username    string `json:"username,omitempty"`
+	MergedCount int    `json:"merged_count"`
+}
+
+type MergeStatsResponse struct {
+	WindowStart  string       `json:"window_start"`
+	WindowEnd    string       `json:"window_end"`
+	Total        int          `json:"total"`
+	Unattributed int          `json:"unattributed"`
+	Users        []MergeCount `json:"users"`
+}
+
+type ShadowReviewCount struct {
+	TeamName          string `json:"team_name"`
+	UserId            string `json:"user_id"`
+	Username          string `json:"username"`
+	InTraining        bool   `json:"in_training"`
+	ShadowReviewCount int    `json:"shadow_review_count"`
+}
+
+type ShadowReviewStatsResponse struct {
+	WindowStart string              `json:"window_start"`
+	WindowEnd   string              `json:"window_end"`
+	Total       int                 `json:"total"`
+	Users       []ShadowReviewCount `json:"users"`
+}
+
+type ReviewRule struct {
+	RuleName       string   `json:"rule_name"`
+	Position       int      `json:"position,omitempty"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+	Labels         []string `json:"labels,omitempty"`
+	Repositories   []string `json:"repositories,omitempty"`
+	TeamName       string   `json:"team_name,omitempty"`
+	Reviewers      int      `json:"reviewers,omitempty"`
+	RequiredSkills []string `json:"required_skills,omitempty"`
+	CreatedAt      *string  `json:"created_at,omitempty"`
+}
+
+type ReviewRuleDryRunResult struct {
+	RuleName       string   `json:"rule_name,omitempty"`
+	TeamName       string   `json:"team_name"`
+	Reviewers      int      `json:"reviewers"`
+	RequiredSkills []string `json:"required_skills"`
+}
+
+type EventReplayResponse struct {
+	ReplayedCount int `json:"replayed_count"`
+}
+
+type LiveUpdate struct {
+	Type        string      `json:"type"`
+	PullRequest PullRequest `json:"pull_request"`
+	OccurredAt  string      `json:"occurred_at"`
+}
+
+type WebhookDelivery struct {
+	Id             int64  `json:"id"`
+	NotificationId *int64 `json:"notification_id,omitempty"`
+	UserId         string `json:"user_id"`
+	Url            string `json:"url"`
+	RequestBody    string `json:"request_body"`
+	StatusCode     *int   `json:"status_code,omitempty"`
+	ResponseBody   string `json:"response_body,omitempty"`
+	Error          string `json:"error,omitempty"`
+	DurationMs     int64  `json:"duration_ms"`
+	RedeliveryOf   *int64 `json:"redelivery_of,omitempty"`
+	AttemptedAt    string `json:"attempted_at"`
+}
+
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+type FailedNotification struct {
+	Attempts      int     `json:"attempts"`
+	CreatedAt     string  `json:"created_at"`
+	Event         string  `json:"event"`
+	FailedAt      string  `json:"failed_at"`
+	Id            int64   `json:"id"`
+	LastError     string  `json:"last_error"`
+	Message       string  `json:"message"`
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+	UserId        string  `json:"user_id"`
+}
+
+type FailedNotificationList struct {
+	Notifications []FailedNotification `json:"notifications"`
+}
+
+type TeamFairness struct {
+	TeamName     string         `json:"team_name"`
+	Members      int            `json:"members"`
+	TotalReviews int            `json:"total_reviews"`
+	MinReviews   int            `json:"min_reviews"`
+	MaxReviews   int            `json:"max_reviews"`
+	Mean         float64        `json:"mean"`
+	Stddev       float64        `json:"stddev"`
+	Gini         float64        `json:"gini"`
+	MaxMinRatio  *float64       `json:"max_min_ratio,omitempty"`
+	ReviewCounts map[string]int `json:"review_counts"`
+}
+
+type FairnessResponse struct {
+	WindowStart string         `json:"window_start"`
+	WindowEnd   string         `json:"window_end"`
+	Teams       []TeamFairness `json:"teams"`
 }
 
 type TeamDeactivateRequest struct {
@@ -71,11 +283,478 @@ type TeamDeactivateRequest struct {
 }
 
 type TeamDeactivateResponse struct {
-	DeactivatedUsersCount  *int `json:"deactivated_users_count,omitempty"`
-	ReassignedReviewsCount *int `json:"reassigned_reviews_count,omitempty"`
+	DeactivatedUsersCount  *int    `json:"deactivated_users_count,omitempty"`
+	ReassignedReviewsCount *int    `json:"reassigned_reviews_count,omitempty"`
+	ScheduledAt            *string `json:"scheduled_at,omitempty"`
 }
 
 type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 	IsActive bool   `json:"is_active"`
 }
+
+type DataDump struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	Teams        []TeamShort   `json:"teams"`
+	Users        []User        `json:"users"`
+}
+
+type ImportResponse struct {
+	AssignmentsImported  int `json:"assignments_imported"`
+	PullRequestsImported int `json:"pull_requests_imported"`
+	TeamsImported        int `json:"teams_imported"`
+	UsersImported        int `json:"users_imported"`
+}
+
+type TeamHierarchy struct {
+	Children         []string `json:"children"`
+	EscalateToParent bool     `json:"escalate_to_parent"`
+	ParentTeamName   *string  `json:"parent_team_name"`
+	TeamName         string   `json:"team_name"`
+}
+
+type RebalanceMove struct {
+	FromUserId    string `json:"from_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+	ToUserId      string `json:"to_user_id"`
+}
+
+type UserDeactivationResult struct {
+	UserId                   string          `json:"user_id"`
+	Status                   string          `json:"status"`
+	Reassigned               []RebalanceMove `json:"reassigned"`
+	UnassignedPullRequestIds []string        `json:"unassigned_pull_request_ids"`
+}
+
+type UserDeactivationBatchResponse struct {
+	Results []UserDeactivationResult `json:"results"`
+}
+
+type ReassignAllResponse struct {
+	MovedCount int             `json:"moved_count"`
+	Moves      []RebalanceMove `json:"moves"`
+}
+
+type UserLoad struct {
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+}
+
+type RebalanceResponse struct {
+	LoadAfter  []UserLoad      `json:"load_after"`
+	LoadBefore []UserLoad      `json:"load_before"`
+	Moves      []RebalanceMove `json:"moves"`
+	TeamName   string          `json:"team_name"`
+}
+
+type ArchiveResponse struct {
+	ArchivedCount int `json:"archived_count"`
+}
+
+type PullRequestSearchHit struct {
+	AuthorId        string  `json:"author_id"`
+	Description     string  `json:"description,omitempty"`
+	PullRequestId   string  `json:"pull_request_id"`
+	PullRequestName string  `json:"pull_request_name"`
+	Rank            float32 `json:"rank"`
+	Status          string  `json:"status"`
+}
+
+type PullRequestSearchResponse struct {
+	Limit        int                    `json:"limit"`
+	Offset       int                    `json:"offset"`
+	PullRequests []PullRequestSearchHit `json:"pull_requests"`
+	Total        int                    `json:"total"`
+}
+
+type DependencyStatus struct {
+	Critical  bool   `json:"critical"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Degraded     []string           `json:"degraded"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+	Status       string             `json:"status"`
+}
+
+type GitHubAccount struct {
+	GithubLogin string `json:"github_login"`
+	UserId      string `json:"user_id"`
+}
+
+type SlackAccount struct {
+	SlackUserId string `json:"slack_user_id"`
+	UserId      string `json:"user_id"`
+}
+
+type SlackMessage struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+type GitHubPullRequestLink struct {
+	Number        int    `json:"number"`
+	PullRequestId string `json:"pull_request_id"`
+	Repository    string `json:"repository"`
+	SyncError     string `json:"sync_error,omitempty"`
+}
+
+type GitHubRepository struct {
+	InstallationId int64  `json:"installation_id"`
+	Repository     string `json:"repository"`
+	TeamName       string `json:"team_name,omitempty"`
+}
+
+type GitHubRepositoriesResponse struct {
+	Repositories []GitHubRepository `json:"repositories"`
+}
+
+type NotificationPreferences struct {
+	Channels        []string `json:"channels"`
+	Digest          string   `json:"digest,omitempty"`
+	MutedEvents     []string `json:"muted_events"`
+	QuietHoursEnd   string   `json:"quiet_hours_end,omitempty"`
+	QuietHoursStart string   `json:"quiet_hours_start,omitempty"`
+	Timezone        string   `json:"timezone"`
+	WebhookUrl      string   `json:"webhook_url,omitempty"`
+	Email           string   `json:"email,omitempty"`
+}
+
+type UserMergeResponse struct {
+	SourceUserId       string `json:"source_user_id"`
+	TargetUserId       string `json:"target_user_id"`
+	ReviewsMoved       int    `json:"reviews_moved"`
+	ReviewsDropped     int    `json:"reviews_dropped"`
+	PullRequestsMoved  int    `json:"pull_requests_moved"`
+	GithubAccountMoved bool   `json:"github_account_moved"`
+}
+
+type ReviewerPreference struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+	Weight     int    `json:"weight"`
+}
+
+type TeamReviewerPreferences struct {
+	TeamName    string               `json:"team_name"`
+	Preferences []ReviewerPreference `json:"preferences"`
+}
+
+type ChecklistTemplate struct {
+	Items             []string `json:"items"`
+	RequireCompletion bool     `json:"require_completion,omitempty"`
+}
+
+type ChecklistItem struct {
+	Checked   bool    `json:"checked"`
+	CheckedAt *string `json:"checked_at,omitempty"`
+	CheckedBy string  `json:"checked_by,omitempty"`
+	Label     string  `json:"label"`
+	Position  int     `json:"position"`
+}
+
+type SizeRule struct {
+	MaxFilesChanged *int `json:"max_files_changed,omitempty"`
+	MaxLinesChanged *int `json:"max_lines_changed,omitempty"`
+	Reviewers       int  `json:"reviewers"`
+}
+
+type ReasonCount struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
+
+type ReassignmentGroup struct {
+	TeamName       string        `json:"team_name"`
+	UserId         string        `json:"user_id,omitempty"`
+	Total          int           `json:"total"`
+	Reasons        []ReasonCount `json:"reasons"`
+	DeclineReasons []ReasonCount `json:"decline_reasons"`
+}
+
+type ReassignmentStatsResponse struct {
+	WindowStart    string              `json:"window_start"`
+	WindowEnd      string              `json:"window_end"`
+	Total          int                 `json:"total"`
+	Reasons        []ReasonCount       `json:"reasons"`
+	DeclineReasons []ReasonCount       `json:"decline_reasons"`
+	Teams          []ReassignmentGroup `json:"teams"`
+	Users          []ReassignmentGroup `json:"users"`
+}
+
+type RepositoryStatsResponse struct {
+	RepositoryName           string   `json:"repository_name"`
+	OpenPrs                  int      `json:"open_prs"`
+	MergedPrs                int      `json:"merged_prs"`
+	AvgReviewersPerPr        float64  `json:"avg_reviewers_per_pr"`
+	AvgTimeToMergeSeconds    *float64 `json:"avg_time_to_merge_seconds,omitempty"`
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds,omitempty"`
+}
+
+type ReviewerTurnaroundResponse struct {
+	UserId                  string   `json:"user_id"`
+	WindowStart             string   `json:"window_start"`
+	WindowEnd               string   `json:"window_end"`
+	ReviewedCount           int      `json:"reviewed_count"`
+	PendingCount            int      `json:"pending_count"`
+	AvgTurnaroundSeconds    *float64 `json:"avg_turnaround_seconds,omitempty"`
+	MedianTurnaroundSeconds *float64 `json:"median_turnaround_seconds,omitempty"`
+}
+
+type UnassignedDay struct {
+	Date            string `json:"date"`
+	UnassignedCount int    `json:"unassigned_count"`
+}
+
+type UnassignedTeamSeries struct {
+	TeamName  string          `json:"team_name"`
+	PeakCount int             `json:"peak_count"`
+	Days      []UnassignedDay `json:"days"`
+}
+
+type UnassignedStatsResponse struct {
+	WindowStart string                 `json:"window_start"`
+	WindowEnd   string                 `json:"window_end"`
+	Teams       []UnassignedTeamSeries `json:"teams"`
+}
+
+type UserWorkload struct {
+	UserId          string `json:"user_id"`
+	Username        string `json:"username"`
+	TeamName        string `json:"team_name"`
+	OpenReviews     int    `json:"open_reviews"`
+	OpenAuthoredPrs int    `json:"open_authored_prs"`
+	Capacity        *int   `json:"capacity,omitempty"`
+	IsAway          bool   `json:"is_away"`
+	CanTakeReview   bool   `json:"can_take_review"`
+}
+
+type TeamCapacity struct {
+	TeamName       string         `json:"team_name"`
+	Capacity       *int           `json:"capacity,omitempty"`
+	AvailableCount int            `json:"available_count"`
+	Members        []UserWorkload `json:"members"`
+}
+
+type ReviewBudgetMember struct {
+	UserId    string `json:"user_id"`
+	Username  string `json:"username"`
+	IsActive  bool   `json:"is_active"`
+	Used      int    `json:"used"`
+	Remaining int    `json:"remaining"`
+}
+
+type TeamReviewBudget struct {
+	TeamName         string               `json:"team_name"`
+	ReviewsPerSprint int                  `json:"reviews_per_sprint"`
+	SprintDays       *int                 `json:"sprint_days,omitempty"`
+	SprintStartedAt  *string              `json:"sprint_started_at,omitempty"`
+	SprintEndsAt     *string              `json:"sprint_ends_at,omitempty"`
+	Used             int                  `json:"used"`
+	Total            int                  `json:"total"`
+	Members          []ReviewBudgetMember `json:"members"`
+}
+
+type TeamPRQuota struct {
+	TeamName   string  `json:"team_name"`
+	MaxOpenPrs int     `json:"max_open_prs"`
+	Mode       *string `json:"mode,omitempty"`
+}
+
+type TeamReportSchedule struct {
+	TeamName   string  `json:"team_name"`
+	Enabled    bool    `json:"enabled"`
+	Weekday    *string `json:"weekday,omitempty"`
+	Hour       *int    `json:"hour,omitempty"`
+	Timezone   *string `json:"timezone,omitempty"`
+	LastSentAt *string `json:"last_sent_at,omitempty"`
+}
+
+type ReportPR struct {
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorId        string `json:"author_id"`
+	CreatedAt       string `json:"created_at"`
+	At              string `json:"at"`
+}
+
+type TeamReport struct {
+	TeamName             string       `json:"team_name"`
+	WindowStart          string       `json:"window_start"`
+	WindowEnd            string       `json:"window_end"`
+	MergedPrs            []ReportPR   `json:"merged_prs"`
+	AvgTurnaroundSeconds *float64     `json:"avg_turnaround_seconds,omitempty"`
+	SlaBreaches          []ReportPR   `json:"sla_breaches"`
+	Fairness             TeamFairness `json:"fairness"`
+}
+
+type TimeSeries struct {
+	Target     string      `json:"target"`
+	Datapoints [][]float64 `json:"datapoints"`
+}
+
+type ReviewerSuggestion struct {
+	UserId           string   `json:"user_id"`
+	Username         string   `json:"username"`
+	OpenReviews      int      `json:"open_reviews"`
+	LoadScore        float64  `json:"load_score"`
+	SkillMatchScore  float64  `json:"skill_match_score"`
+	MatchedSkills    []string `json:"matched_skills"`
+	PreferenceWeight int      `json:"preference_weight"`
+	OnRotation       bool     `json:"on_rotation"`
+	InCooldown       bool     `json:"in_cooldown"`
+	OverCapacity     bool     `json:"over_capacity"`
+}
+
+type ReviewerSuggestionsResponse struct {
+	PullRequestId string               `json:"pull_request_id"`
+	Suggestions   []ReviewerSuggestion `json:"suggestions"`
+}
+
+type SCIMEnterprise struct {
+	Department string `json:"department"`
+}
+
+type SCIMRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type SCIMUser struct {
+	Schemas    []string        `json:"schemas"`
+	ID         string          `json:"id,omitempty"`
+	UserName   string          `json:"userName"`
+	Active     *bool           `json:"active,omitempty"`
+	Groups     []SCIMRef       `json:"groups,omitempty"`
+	Enterprise *SCIMEnterprise `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+}
+
+type SCIMGroup struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	DisplayName string    `json:"displayName"`
+	Members     []SCIMRef `json:"members"`
+}
+
+type SCIMUserList struct {
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []SCIMUser `json:"Resources"`
+}
+
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+type PullRequestListResponse struct {
+	Limit        int                `json:"limit"`
+	Offset       int                `json:"offset"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	Total        int                `json:"total"`
+}
+
+type PullRequestFilter struct {
+	Status         string `json:"status,omitempty"`
+	AuthorId       string `json:"author_id,omitempty"`
+	ReviewerId     string `json:"reviewer_id,omitempty"`
+	TeamName       string `json:"team_name,omitempty"`
+	RepositoryName string `json:"repository_name,omitempty"`
+	Priority       string `json:"priority,omitempty"`
+	Label          string `json:"label,omitempty"`
+	MinAgeHours    int    `json:"min_age_hours,omitempty"`
+	Unreviewed     bool   `json:"unreviewed,omitempty"`
+}
+
+type SavedFilter struct {
+	FilterId int64             `json:"filter_id,omitempty"`
+	Name     string            `json:"name"`
+	UserId   string            `json:"user_id,omitempty"`
+	TeamName string            `json:"team_name,omitempty"`
+	Filter   PullRequestFilter `json:"filter"`
+}
+
+type PRTemplate struct {
+	TemplateId       int64    `json:"template_id,omitempty"`
+	Name             string   `json:"name"`
+	TeamName         string   `json:"team_name"`
+	NamePrefix       string   `json:"name_prefix,omitempty"`
+	Labels           []string `json:"labels,omitempty"`
+	DefaultReviewers []string `json:"default_reviewers,omitempty"`
+}
+
+type TeamReviewRotation struct {
+	TeamName  string         `json:"team_name"`
+	StartDate *string        `json:"start_date,omitempty"`
+	MemberIds []string       `json:"member_ids"`
+	Weeks     []RotationWeek `json:"weeks"`
+}
+
+type TeamReviewerPool struct {
+	TeamName string       `json:"team_name"`
+	Members  []TeamMember `json:"members"`
+}
+
+type TeamInactiveReassign struct {
+	TeamName string `json:"team_name"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type TeamStatsHistory struct {
+	TeamName string                  `json:"team_name"`
+	Points   []TeamStatsHistoryPoint `json:"points"`
+}
+
+type TeamStatsHistoryPoint struct {
+	Date          string `json:"date"`
+	ActiveMembers int    `json:"active_members"`
+	OpenReviews   int    `json:"open_reviews"`
+	MergedReviews int    `json:"merged_reviews"`
+}
+
+type RotationWeek struct {
+	WeekStart  string  `json:"week_start"`
+	UserId     *string `json:"user_id,omitempty"`
+	Username   *string `json:"username,omitempty"`
+	Overridden bool    `json:"overridden"`
+}
+
+type TeamSnapshot struct {
+	TeamName               string               `json:"team_name"`
+	Members                []TeamSnapshotMember `json:"members"`
+	RequiredReviewerRole   *string              `json:"required_reviewer_role,omitempty"`
+	ReviewerPreferences    []ReviewerPreference `json:"reviewer_preferences,omitempty"`
+	PrTemplates            []PRTemplate         `json:"pr_templates,omitempty"`
+	PrQuota                *TeamSnapshotPRQuota `json:"pr_quota,omitempty"`
+	ReviewerPool           []string             `json:"reviewer_pool,omitempty"`
+	InactiveReassignOptOut bool                 `json:"inactive_reassign_opt_out,omitempty"`
+}
+
+type TeamSnapshotMember struct {
+	UserId   string   `json:"user_id"`
+	Username string   `json:"username"`
+	IsActive bool     `json:"is_active"`
+	Role     *string  `json:"role,omitempty"`
+	Skills   []string `json:"skills,omitempty"`
+}
+
+type TeamSnapshotPRQuota struct {
+	MaxOpenPrs int     `json:"max_open_prs"`
+	Mode       *string `json:"mode,omitempty"`
+}
+
+type InjectedFault struct {
+	ID        int64  `json:"id"`
+	Query     string `json:"query"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error"`
+	Times     int    `json:"times"`
+	Hits      int    `json:"hits"`
+}
@@ -0,0 +1,1716 @@
+// Package integration runs the HTTP API against the real services backed by
+// the in-memory store, so behavior tests run in seconds without Docker. The
+// e2e package keeps covering the Postgres stack.
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/glebmavi/pr_reviewer_service/internal/app"
+	"github.com/glebmavi/pr_reviewer_service/internal/domain"
+	apphttp "github.com/glebmavi/pr_reviewer_service/internal/http"
+	"github.com/glebmavi/pr_reviewer_service/internal/notify"
+	"github.com/glebmavi/pr_reviewer_service/internal/storage/memory"
+)
+
+// slackSigningSecret signs the Slack slash commands sent to the server.
+const slackSigningSecret = "integration-slack-secret"
+
+// githubReplayWindow turns on the refusal of replayed GitHub deliveries, which
+// is off by default.
+const githubReplayWindow = 5 * time.Minute
+
+// selectionSalt keys random reviewer picks, fixed so that they are the same on
+// every run.
+const selectionSalt = "integration"
+
+// server is a service instance with its own empty store.
+type server struct {
+	url    string
+	client *http.Client
+	// inactiveReviews treats every unfinished review as inactive, so tests
+	// run the job on demand instead of waiting.
+	inactiveReviews *app.InactiveReviewService
+	statsHistory    *app.StatsHistoryService
+}
+
+// newServer wires the services the way cmd/server does, with the default
+// configuration and without the background jobs.
+func newServer(t *testing.T) *server {
+	t.Helper()
+	return newSaltedServer(t, selectionSalt)
+}
+
+// newSaltedServer is newServer with another salt for random reviewer picks.
+func newSaltedServer(t *testing.T, salt string) *server {
+	t.Helper()
+
+	log := slog.New(slog.DiscardHandler)
+	store := memory.NewStore()
+	uow := app.NewTimeoutUnitOfWork(store, 5*time.Second)
+
+	githubService := app.NewGitHubService(store, store, nil, uow, log)
+	settingsService := app.NewSettingsService(store, store, uow, app.DefaultSettings(), log)
+	notificationService := app.NewNotificationService(store, store, store, store, settingsService, app.NotificationRetry{MaxAttempts: 5, BaseDelay: time.Minute}, log)
+	notificationService.RegisterChannel("log", notify.NewLogChannel(log))
+	webhookChannel := notify.NewWebhookChannel(store, log)
+	notificationService.RegisterChannel("webhook", webhookChannel)
+	staffingAlertService := app.NewStaffingAlertService(store, notificationService, 0, time.Hour, log)
+	liveService := app.NewLiveService(store, store, store, "", log)
+
+	reviewNotifiers := app.ReviewNotifiers{githubService, notificationService}
+	pullRequestService := app.NewPullRequestService(store, store, store, store, store, uow, reviewNotifiers, liveService, staffingAlertService, settingsService, 5*time.Second, salt, log)
+	teamService := app.NewTeamService(store, store, store, pullRequestService, uow, log)
+	userService := app.NewUserService(store, store, pullRequestService, uow, domain.OpenReviewsKeep, log)
+	statsService := app.NewStatsService(store, log)
+	adminService := app.NewAdminService(store, store, store, store, pullRequestService, store, log)
+	archiveService := app.NewArchiveService(store, store, log)
+	healthService := app.NewHealthService(log)
+	healthService.Register("memory", true, store.Ping)
+
+	githubAppService := app.NewGitHubAppService(store, store, store, store, store, pullRequestService, githubService, uow, "", githubReplayWindow, log)
+	repositoryService := app.NewRepositoryService(store, store, uow, log)
+	reviewRuleService := app.NewReviewRuleService(store, store, store, store, pullRequestService, uow, log)
+	savedFilterService := app.NewSavedFilterService(store, store, store, uow, log)
+	prTemplateService := app.NewPRTemplateService(store, store, store, uow, log)
+	teamSnapshotService := app.NewTeamSnapshotService(store, store, store, store, store, store, pullRequestService, uow, log)
+	slackService := app.NewSlackService(store, pullRequestService, uow, slackSigningSecret, 5*time.Minute, log)
+	provisioningService := app.NewProvisioningService(store, store, userService, teamService, uow, "", log)
+	githubTeamSyncService := app.NewGitHubTeamSyncService(store, store, store, userService, teamService, nil, "", uow, log)
+	reviewBudgetService := app.NewReviewBudgetService(store, store, pullRequestService, notificationService, uow, 0.9, log)
+	teamReportService := app.NewTeamReportService(store, store, notificationService, uow, log)
+	webhookService := app.NewWebhookService(store, webhookChannel, log)
+	inactiveReviewService := app.NewInactiveReviewService(store, pullRequestService, notificationService, time.Nanosecond, log)
+	statsHistoryService := app.NewStatsHistoryService(store, uow, log)
+
+	handler := apphttp.NewHandler(teamService, pullRequestService, userService, statsService, adminService, archiveService, healthService, githubService, githubAppService, notificationService, repositoryService, reviewRuleService, savedFilterService, prTemplateService, provisioningService, githubTeamSyncService, reviewBudgetService, teamReportService, webhookService, liveService, teamSnapshotService, slackService, settingsService, nil, log)
+	router, err := apphttp.NewRouter(handler, apphttp.NewTunables(2*time.Second, 0))
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return &server{url: ts.URL, client: ts.Client(), inactiveReviews: inactiveReviewService, statsHistory: statsHistoryService}
+}
+
+func (s *server) doRequest(t *testing.T, method, path string, body interface{}) (*http.Response, []byte) {
+	t.Helper()
+
+	var bodyReader io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		require.NoError(t, err)
+		bodyReader = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, s.url+path, bodyReader)
+	require.NoError(t, err)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	require.NoError(t, err)
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	return resp, respBody
+}
+
+func unmarshalResponse(t *testing.T, data []byte, v interface{}) {
+	t.Helper()
+	err := json.Unmarshal(data, v)
+	require.NoError(t, err, "response body: %s", string(data))
+}
+
+func assertErrorCode(t *testing.T, body []byte, expectedCode string) {
+	t.Helper()
+	var errResp ErrorResponse
+	unmarshalResponse(t, body, &errResp)
+	assert.Equal(t, expectedCode, errResp.Error.Code)
+}
+
+// createTeam adds a team of the given members and returns it.
+func (s *server) createTeam(t *testing.T, teamName string, usernames ...string) Team {
+	t.Helper()
+	payload := Team{TeamName: teamName, Members: []TeamMember{}}
+	for _, username := range usernames {
+		payload.Members = append(payload.Members, TeamMember{Username: username})
+	}
+	resp, body := s.doRequest(t, "POST", "/team/add", payload)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var team Team
+	unmarshalResponse(t, body, &team)
+	require.Len(t, team.Members, len(usernames))
+	return team
+}
+
+func (s *server) createPR(t *testing.T, name, authorID string) PullRequest {
+	t.Helper()
+	resp, body := s.doRequest(t, "POST", "/pullRequest/create", map[string]string{
+		"pull_request_name": name,
+		"author_id":         authorID,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var pr PullRequest
+	unmarshalResponse(t, body, &pr)
+	return pr
+}
+
+// Tests
+func TestHealth(t *testing.T) {
+	s := newServer(t)
+	resp, _ := s.doRequest(t, "GET", "/health", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPRReviewCycle(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "backend-squad", "A", "B", "C")
+	author := team.Members[0].UserId
+
+	// 1. A PR by A is assigned to B and C
+	pr := s.createPR(t, "feat: new feature", author)
+	assert.Equal(t, author, pr.AuthorId)
+	assert.Equal(t, "IN_REVIEW", pr.Status)
+	assert.ElementsMatch(t, []string{team.Members[1].UserId, team.Members[2].UserId}, pr.AssignedReviewers)
+
+	resp, body := s.doRequest(t, "GET", "/pullRequest/get/"+pr.PullRequestId, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var fetched PullRequest
+	unmarshalResponse(t, body, &fetched)
+	assert.Len(t, fetched.AssignedReviewers, 2)
+
+	// 2. Merging is final
+	resp, body = s.doRequest(t, "POST", "/pullRequest/merge", map[string]string{"pull_request_id": pr.PullRequestId})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var merged PullRequest
+	unmarshalResponse(t, body, &merged)
+	assert.Equal(t, "MERGED", merged.Status)
+
+	resp, body = s.doRequest(t, "POST", "/pullRequest/reassign", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"old_user_id":     team.Members[2].UserId,
+	})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "PR_MERGED")
+}
+
+func TestPRReviewWithNotEnoughReviewers(t *testing.T) {
+	s := newServer(t)
+
+	team := s.createTeam(t, "frontend-squad", "D", "E")
+	pr := s.createPR(t, "fix: css bug", team.Members[0].UserId)
+	assert.Equal(t, []string{team.Members[1].UserId}, pr.AssignedReviewers)
+
+	solo := s.createTeam(t, "solo-squad", "F")
+	pr = s.createPR(t, "docs: update readme", solo.Members[0].UserId)
+	assert.Empty(t, pr.AssignedReviewers)
+	assert.Equal(t, "OPEN", pr.Status)
+}
+
+func TestApprovals(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "approval-squad", "author", "first", "second")
+	pr := s.createPR(t, "feat: needs two approvals", team.Members[0].UserId)
+	require.Len(t, pr.AssignedReviewers, 2)
+
+	approve := func(userID string) PullRequest {
+		resp, body := s.doRequest(t, "POST", "/pullRequest/approve", map[string]string{
+			"pull_request_id": pr.PullRequestId,
+			"user_id":         userID,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var approved PullRequest
+		unmarshalResponse(t, body, &approved)
+		return approved
+	}
+
+	approved := approve(pr.AssignedReviewers[0])
+	assert.Equal(t, "IN_REVIEW", approved.Status)
+	assert.Equal(t, pr.AssignedReviewers[:1], approved.ApprovedReviewers)
+
+	approved = approve(pr.AssignedReviewers[1])
+	assert.Equal(t, "APPROVED", approved.Status)
+	assert.ElementsMatch(t, pr.AssignedReviewers, approved.ApprovedReviewers)
+
+	// Only assigned reviewers can approve
+	resp, body := s.doRequest(t, "POST", "/pullRequest/approve", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"user_id":         team.Members[0].UserId,
+	})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_ASSIGNED")
+}
+
+func TestSelfReviewOverride(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "self-review-solo", "self-review-author")
+	assert.False(t, team.AllowSelfReview)
+	authorID := team.Members[0].UserId
+
+	// 1. By default the author cannot review their own PR
+	pr := s.createPR(t, "fix: solo hotfix", authorID)
+	assert.Empty(t, pr.AssignedReviewers)
+
+	assignPayload := map[string]string{"pull_request_id": pr.PullRequestId, "user_id": authorID}
+	resp, body := s.doRequest(t, "POST", "/pullRequest/assign", assignPayload)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	// 2. With the override the author can be assigned manually
+	resp, body = s.doRequest(t, "POST", "/team/edit", map[string]any{"old_team_name": "self-review-solo", "allow_self_review": true})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	unmarshalResponse(t, body, &team)
+	assert.True(t, team.AllowSelfReview)
+
+	resp, body = s.doRequest(t, "POST", "/pullRequest/assign", assignPayload)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	unmarshalResponse(t, body, &pr)
+	assert.Equal(t, []string{authorID}, pr.AssignedReviewers)
+
+	// 3. and is picked automatically when nobody else is available
+	pr = s.createPR(t, "fix: another solo hotfix", authorID)
+	assert.Equal(t, []string{authorID}, pr.AssignedReviewers)
+}
+
+func TestShadowReviewers(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "shadow-squad", "author", "reviewer", "trainee", "other-trainee")
+	authorID, reviewerID := team.Members[0].UserId, team.Members[1].UserId
+	trainees := []string{team.Members[2].UserId, team.Members[3].UserId}
+
+	for _, id := range trainees {
+		resp, body := s.doRequest(t, "POST", "/users/setInTraining", map[string]any{"user_id": id, "in_training": true})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var user User
+		unmarshalResponse(t, body, &user)
+		assert.True(t, user.InTraining)
+	}
+
+	// 1. Without a shadow share trainees are neither reviewers nor shadows
+	pr := s.createPR(t, "feat: no shadow", authorID)
+	assert.Equal(t, []string{reviewerID}, pr.AssignedReviewers)
+	assert.Empty(t, pr.ShadowReviewers)
+
+	// 2. With every PR shadowed one trainee follows each review
+	resp, body := s.doRequest(t, "POST", "/team/setReviewerRequirements", map[string]any{
+		"team_name":              "shadow-squad",
+		"required_reviewer_role": "",
+		"shadow_review_percent":  100,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	for _, name := range []string{"feat: first shadow", "feat: second shadow"} {
+		pr = s.createPR(t, name, authorID)
+		assert.Equal(t, []string{reviewerID}, pr.AssignedReviewers)
+		require.Len(t, pr.ShadowReviewers, 1)
+		assert.Contains(t, trainees, pr.ShadowReviewers[0])
+
+		resp, body = s.doRequest(t, "GET", "/pullRequest/get/"+pr.PullRequestId, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var fetched PullRequest
+		unmarshalResponse(t, body, &fetched)
+		assert.Equal(t, pr.ShadowReviewers, fetched.ShadowReviewers)
+	}
+
+	// 3. Shadow reviews are counted apart from reviews
+	resp, body = s.doRequest(t, "GET", "/stats/shadow?team_name=shadow-squad", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var stats ShadowReviewStatsResponse
+	unmarshalResponse(t, body, &stats)
+	assert.Equal(t, 2, stats.Total)
+	require.Len(t, stats.Users, 2)
+	for _, u := range stats.Users {
+		assert.True(t, u.InTraining)
+		assert.Contains(t, trainees, u.UserId)
+	}
+	assert.Equal(t, 2, stats.Users[0].ShadowReviewCount+stats.Users[1].ShadowReviewCount)
+
+	resp, body = s.doRequest(t, "GET", "/stats", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	for _, id := range trainees {
+		assert.NotContains(t, string(body), id)
+	}
+}
+
+func TestReviewRotation(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "rotation-crew", "author", "first", "second", "third", "fourth")
+	authorID := team.Members[0].UserId
+	first, second, third, fourth := team.Members[1].UserId, team.Members[2].UserId, team.Members[3].UserId, team.Members[4].UserId
+
+	now := time.Now().UTC()
+	monday := time.Date(now.Year(), now.Month(), now.Day()-(int(now.Weekday())+6)%7, 0, 0, 0, 0, time.UTC)
+	week := func(n int) string { return monday.AddDate(0, 0, 7*n).Format(time.DateOnly) }
+
+	// 1. The rotation starts this week with the first member listed
+	resp, body := s.doRequest(t, "POST", "/team/setRotation", map[string]any{
+		"team_name":  "rotation-crew",
+		"member_ids": []string{third, first},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var rotation TeamReviewRotation
+	unmarshalResponse(t, body, &rotation)
+	assert.Equal(t, week(0), rotation.StartDate)
+	assert.Equal(t, []string{third, first}, rotation.MemberIds)
+	require.Len(t, rotation.Weeks, 4)
+	for i, want := range []string{third, first, third, first} {
+		assert.Equal(t, RotationWeek{WeekStart: week(i), UserId: want, Username: map[string]string{third: "third", first: "first"}[want]}, rotation.Weeks[i])
+	}
+
+	// 2. The primary reviewer of the week is picked first
+	for _, name := range []string{"feat: one", "feat: two", "feat: three"} {
+		pr := s.createPR(t, name, authorID)
+		assert.Contains(t, pr.AssignedReviewers, third)
+	}
+
+	// 3. Overrides replace single weeks and can be dropped again
+	resp, body = s.doRequest(t, "POST", "/team/overrideRotation", map[string]any{"team_name": "rotation-crew", "week_start": week(1), "user_id": second})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, body = s.doRequest(t, "POST", "/team/overrideRotation", map[string]any{"team_name": "rotation-crew", "week_start": week(0), "user_id": fourth})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	pr := s.createPR(t, "feat: four", authorID)
+	assert.Contains(t, pr.AssignedReviewers, fourth)
+
+	resp, body = s.doRequest(t, "POST", "/team/overrideRotation", map[string]any{"team_name": "rotation-crew", "week_start": week(0)})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, body = s.doRequest(t, "GET", "/team/rotation?team_name=rotation-crew&weeks=2", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	rotation = TeamReviewRotation{}
+	unmarshalResponse(t, body, &rotation)
+	assert.Equal(t, []RotationWeek{
+		{WeekStart: week(0), UserId: third, Username: "third"},
+		{WeekStart: week(1), UserId: second, Username: "second", Overridden: true},
+	}, rotation.Weeks)
+
+	// 4. Invalid schedules are rejected
+	for _, req := range []map[string]any{
+		{"team_name": "rotation-crew", "member_ids": []string{first, first}},
+		{"team_name": "rotation-crew", "member_ids": []string{authorID, "ghost"}},
+	} {
+		resp, body = s.doRequest(t, "POST", "/team/setRotation", req)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assertErrorCode(t, body, "VALIDATION_ERROR")
+	}
+	for _, req := range []map[string]any{
+		{"team_name": "rotation-crew", "week_start": week(-1), "user_id": first},
+		{"team_name": "rotation-crew", "week_start": week(2), "user_id": "ghost"},
+	} {
+		resp, body = s.doRequest(t, "POST", "/team/overrideRotation", req)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assertErrorCode(t, body, "VALIDATION_ERROR")
+	}
+
+	// 5. An empty list removes the rotation
+	resp, body = s.doRequest(t, "POST", "/team/setRotation", map[string]any{"team_name": "rotation-crew", "member_ids": []string{}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	rotation = TeamReviewRotation{}
+	unmarshalResponse(t, body, &rotation)
+	assert.Empty(t, rotation.StartDate)
+	assert.Empty(t, rotation.MemberIds)
+	assert.Empty(t, rotation.Weeks)
+
+	resp, body = s.doRequest(t, "POST", "/team/overrideRotation", map[string]any{"team_name": "rotation-crew", "week_start": week(0), "user_id": first})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+}
+
+// slackCommand sends a /reviews command typed by slackUserID, signed with
+// secret, and returns the reply text.
+func (s *server) slackCommand(t *testing.T, secret, slackUserID, text string) (*http.Response, string) {
+	t.Helper()
+
+	body := url.Values{"command": {"/reviews"}, "user_id": {slackUserID}, "text": {text}}.Encode()
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+
+	req, err := http.NewRequest("POST", s.url+"/slack/commands", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := s.client.Do(req)
+	require.NoError(t, err)
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	if resp.StatusCode != http.StatusOK {
+		return resp, string(respBody)
+	}
+	var msg SlackMessage
+	unmarshalResponse(t, respBody, &msg)
+	assert.Equal(t, "ephemeral", msg.ResponseType)
+	return resp, msg.Text
+}
+
+func TestSlackCommands(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "slack-squad", "A", "B")
+	pr := s.createPR(t, "feat: slack", team.Members[0].UserId)
+	require.Equal(t, []string{team.Members[1].UserId}, pr.AssignedReviewers)
+
+	var added []User
+	for _, username := range []string{"C", "D"} {
+		resp, body := s.doRequest(t, "POST", "/users/add", map[string]any{"username": username, "team_name": "slack-squad", "is_active": true})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+		var user User
+		unmarshalResponse(t, body, &user)
+		added = append(added, user)
+	}
+	userC, userD := added[0].UserId, added[1].UserId
+
+	// 1. Slack users are linked one to one
+	resp, body := s.doRequest(t, "POST", "/slack/linkUser", map[string]string{"user_id": userC, "slack_user_id": "UCCC"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var account SlackAccount
+	unmarshalResponse(t, body, &account)
+	assert.Equal(t, SlackAccount{UserId: userC, SlackUserId: "UCCC"}, account)
+
+	resp, body = s.doRequest(t, "POST", "/slack/linkUser", map[string]string{"user_id": userD, "slack_user_id": "UCCC"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+	resp, body = s.doRequest(t, "POST", "/slack/linkUser", map[string]string{"user_id": userD, "slack_user_id": "not-an-id"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	// 2. Only requests signed with the signing secret are served
+	resp, _ = s.slackCommand(t, "wrong-secret", "UCCC", "mine")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	_, text := s.slackCommand(t, slackSigningSecret, "UCCC", "")
+	assert.Contains(t, text, "Usage")
+	_, text = s.slackCommand(t, slackSigningSecret, "UXXX", "mine")
+	assert.Contains(t, text, "not linked")
+
+	// 3. Commands act as the linked user
+	_, text = s.slackCommand(t, slackSigningSecret, "UCCC", "mine")
+	assert.Equal(t, "You have no open reviews.", text)
+
+	_, text = s.slackCommand(t, slackSigningSecret, "UCCC", "assign "+pr.PullRequestId)
+	assert.Contains(t, text, "You are now a reviewer")
+	_, text = s.slackCommand(t, slackSigningSecret, "UCCC", "mine")
+	assert.Contains(t, text, pr.PullRequestId)
+
+	_, text = s.slackCommand(t, slackSigningSecret, "UCCC", "decline "+pr.PullRequestId+" overloaded")
+	assert.Contains(t, text, "reassigned to "+userD)
+	resp, body = s.doRequest(t, "GET", "/pullRequest/get/"+pr.PullRequestId, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var fetched PullRequest
+	unmarshalResponse(t, body, &fetched)
+	assert.ElementsMatch(t, []string{team.Members[1].UserId, userD}, fetched.AssignedReviewers)
+
+	// 4. Errors the user can act on are replied with, not failed
+	resp, text = s.slackCommand(t, slackSigningSecret, "UCCC", "decline "+pr.PullRequestId)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, text, "not assigned")
+	resp, text = s.slackCommand(t, slackSigningSecret, "UCCC", "assign pr-missing")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, text, "pr-missing")
+	_, text = s.slackCommand(t, slackSigningSecret, "UCCC", "approve "+pr.PullRequestId)
+	assert.Contains(t, text, "Unknown command")
+}
+
+// githubWebhook delivers a GitHub event signed with secret.
+func (s *server) githubWebhook(t *testing.T, secret, event string, payload any) (*http.Response, []byte) {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	req, err := http.NewRequest("POST", s.url+"/github/webhook", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", uuid.NewString())
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := s.client.Do(req)
+	require.NoError(t, err)
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp, respBody
+}
+
+func TestGitHubIngestionTokens(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "ingest-squad", "A", "B")
+	other := s.createTeam(t, "other-squad", "C")
+	author, reviewer := team.Members[0].UserId, team.Members[1].UserId
+	resp, body := s.doRequest(t, "POST", "/github/linkUser", map[string]string{"user_id": author, "github_login": "octo-a"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// 1. A repository gets a token of one team at most
+	resp, body = s.doRequest(t, "POST", "/github/ingestionTokens/create", map[string]string{"repository": "acme/api", "team_name": "ingest-squad"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var token GitHubIngestionToken
+	unmarshalResponse(t, body, &token)
+	assert.Equal(t, "acme/api", token.Repository)
+	assert.Equal(t, "ingest-squad", token.TeamName)
+	require.NotEmpty(t, token.Secret)
+
+	resp, body = s.doRequest(t, "POST", "/github/ingestionTokens/create", map[string]string{"repository": "acme/api", "team_name": "other-squad"})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "REPOSITORY_EXISTS")
+	resp, body = s.doRequest(t, "POST", "/github/ingestionTokens/create", map[string]string{"repository": "acme", "team_name": "ingest-squad"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+	resp, _ = s.doRequest(t, "POST", "/github/ingestionTokens/create", map[string]string{"repository": "acme/web", "team_name": "missing"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// 2. Pull requests signed with the token are created in its team
+	opened := func(number int, login string) map[string]any {
+		return map[string]any{
+			"action":       "opened",
+			"number":       number,
+			"repository":   map[string]any{"full_name": "acme/api"},
+			"sender":       map[string]any{"login": login},
+			"pull_request": map[string]any{"title": "feat: ingest", "user": map[string]any{"login": login}},
+		}
+	}
+	resp, _ = s.githubWebhook(t, "wrong-secret", "pull_request", opened(1, "octo-a"))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, body = s.githubWebhook(t, token.Secret, "pull_request", opened(1, "octo-a"))
+	require.Equal(t, http.StatusNoContent, resp.StatusCode, string(body))
+	resp, body = s.githubWebhook(t, token.Secret, "pull_request", opened(1, "octo-a"))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "replayed delivery")
+	assertErrorCode(t, body, "UNAUTHORIZED")
+	resp, body = s.doRequest(t, "GET", "/users/getReview?user_id="+reviewer, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reviews struct {
+		PullRequests []PullRequest `json:"pull_requests"`
+	}
+	unmarshalResponse(t, body, &reviews)
+	require.Len(t, reviews.PullRequests, 1)
+	assert.Equal(t, author, reviews.PullRequests[0].AuthorId)
+
+	// 3. The token cannot act for another team or manage installations
+	resp, body = s.doRequest(t, "POST", "/github/linkUser", map[string]string{"user_id": other.Members[0].UserId, "github_login": "octo-c"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, _ = s.githubWebhook(t, token.Secret, "pull_request", opened(2, "octo-c"))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp, _ = s.githubWebhook(t, token.Secret, "installation", map[string]any{
+		"action":       "created",
+		"installation": map[string]any{"id": 1},
+		"repository":   map[string]any{"full_name": "acme/api"},
+	})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// 4. Tokens are listed without secrets and stop working once revoked
+	resp, body = s.doRequest(t, "GET", "/github/ingestionTokens?team_name=ingest-squad", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var list struct {
+		Tokens []GitHubIngestionToken `json:"tokens"`
+	}
+	unmarshalResponse(t, body, &list)
+	require.Len(t, list.Tokens, 1)
+	assert.Empty(t, list.Tokens[0].Secret)
+	assert.NotNil(t, list.Tokens[0].LastUsedAt)
+	resp, body = s.doRequest(t, "GET", "/github/ingestionTokens?team_name=other-squad", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	unmarshalResponse(t, body, &list)
+	assert.Empty(t, list.Tokens)
+
+	resp, _ = s.doRequest(t, "POST", "/github/ingestionTokens/revoke", map[string]string{"repository": "acme/api"})
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, _ = s.doRequest(t, "POST", "/github/ingestionTokens/revoke", map[string]string{"repository": "acme/api"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = s.githubWebhook(t, token.Secret, "pull_request", opened(3, "octo-a"))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestInboundWebhooks(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "inbound-squad", "A")
+	other := s.createTeam(t, "elsewhere", "C")
+	outsider := other.Members[0].UserId
+	resp, body := s.doRequest(t, "POST", "/github/linkUser", map[string]string{"user_id": outsider, "github_login": "octo-c"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, body = s.doRequest(t, "POST", "/github/ingestionTokens/create", map[string]string{"repository": "acme/inbound", "team_name": "inbound-squad"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var token GitHubIngestionToken
+	unmarshalResponse(t, body, &token)
+
+	listInbound := func(query string) []InboundWebhook {
+		t.Helper()
+		resp, body := s.doRequest(t, "GET", "/admin/webhooks/inbound"+query, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var list struct {
+			Webhooks []InboundWebhook `json:"webhooks"`
+		}
+		unmarshalResponse(t, body, &list)
+		return list.Webhooks
+	}
+
+	// 1. Verified deliveries are recorded with their outcome, unverified ones are not
+	resp, _ = s.githubWebhook(t, "wrong-secret", "ping", map[string]any{"zen": "Keep it simple."})
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp, _ = s.githubWebhook(t, token.Secret, "ping", map[string]any{"zen": "Keep it simple.", "repository": map[string]any{"full_name": "acme/inbound"}})
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, _ = s.githubWebhook(t, token.Secret, "pull_request", map[string]any{
+		"action":       "opened",
+		"number":       7,
+		"repository":   map[string]any{"full_name": "acme/inbound"},
+		"pull_request": map[string]any{"title": "feat: inbound", "user": map[string]any{"login": "octo-c"}},
+	})
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp, _ = s.githubWebhook(t, token.Secret, "pull_request", map[string]any{
+		"action":     "opened",
+		"number":     "eight",
+		"repository": map[string]any{"full_name": "acme/inbound"},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	webhooks := listInbound("")
+	require.Len(t, webhooks, 3)
+	assert.Equal(t, "ping", webhooks[2].Event)
+	assert.Equal(t, "processed", webhooks[2].Status)
+	assert.NotEmpty(t, webhooks[2].DeliveryId)
+	assert.Equal(t, "acme/inbound", webhooks[2].IngestionToken)
+
+	failed := listInbound("?status=failed&event=pull_request")
+	require.Len(t, failed, 2)
+	malformed, rejected := failed[0], failed[1]
+	assert.Contains(t, malformed.Error, "malformed")
+	assert.Contains(t, rejected.Error, "ingestion token")
+	assert.Contains(t, rejected.Payload, "feat: inbound")
+	assert.Equal(t, 1, rejected.Attempts)
+
+	resp, body = s.doRequest(t, "GET", "/admin/webhooks/inbound?status=pending", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	// 2. A failed delivery is processed again once the cause is fixed
+	resp, body = s.doRequest(t, "POST", fmt.Sprintf("/admin/webhooks/inbound/%d/reprocess", malformed.Id), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reprocessed InboundWebhook
+	unmarshalResponse(t, body, &reprocessed)
+	assert.Equal(t, "failed", reprocessed.Status)
+	assert.Equal(t, 2, reprocessed.Attempts)
+
+	resp, body = s.doRequest(t, "POST", "/users/moveToTeam", map[string]string{"user_id": outsider, "new_team_name": "inbound-squad"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, body = s.doRequest(t, "POST", fmt.Sprintf("/admin/webhooks/inbound/%d/reprocess", rejected.Id), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	reprocessed = InboundWebhook{}
+	unmarshalResponse(t, body, &reprocessed)
+	assert.Equal(t, "processed", reprocessed.Status, reprocessed.Error)
+	assert.Empty(t, reprocessed.Error)
+	assert.Equal(t, 2, reprocessed.Attempts)
+
+	resp, body = s.doRequest(t, "GET", "/users/getReview?user_id="+team.Members[0].UserId, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reviews struct {
+		PullRequests []PullRequest `json:"pull_requests"`
+	}
+	unmarshalResponse(t, body, &reviews)
+	require.Len(t, reviews.PullRequests, 1)
+	assert.Equal(t, outsider, reviews.PullRequests[0].AuthorId)
+
+	// 3. Only failed deliveries are processed again
+	resp, body = s.doRequest(t, "POST", fmt.Sprintf("/admin/webhooks/inbound/%d/reprocess", rejected.Id), nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+	resp, _ = s.doRequest(t, "POST", "/admin/webhooks/inbound/999999/reprocess", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestReviewTrailers(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "trailer-squad", "A", "B", "C", "D")
+	platform := s.createTeam(t, "platform", "P1", "P2", "P3")
+	author, d := team.Members[0].UserId, team.Members[3].UserId
+	p3 := platform.Members[2].UserId
+
+	createPR := func(description string) PullRequest {
+		t.Helper()
+		resp, body := s.doRequest(t, "POST", "/pullRequest/create", map[string]string{
+			"pull_request_name": "feat: trailers",
+			"author_id":         author,
+			"description":       description,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+		var pr PullRequest
+		unmarshalResponse(t, body, &pr)
+		require.Len(t, pr.AssignedReviewers, 2)
+		return pr
+	}
+	platformIDs := []string{platform.Members[0].UserId, platform.Members[1].UserId, p3}
+
+	// 1. Reviewers named in the trailers are picked first
+	pr := createPR("Adds trailers.\n\nReviewers: @d")
+	assert.Contains(t, pr.AssignedReviewers, d)
+
+	// 2. Review-Team moves the review to another team, whose members can be named
+	pr = createPR("Adds trailers.\n\nReview-Team: platform\nreviewers: " + p3)
+	assert.Subset(t, platformIDs, pr.AssignedReviewers)
+	assert.Contains(t, pr.AssignedReviewers, p3)
+
+	// 3. Trailers outside the last paragraph, unknown teams and names of other
+	// teams are ignored
+	for _, description := range []string{"Review-Team: platform\n\nThe description goes on.", "Review-Team: nowhere\nReviewers: P3"} {
+		pr = createPR(description)
+		for _, id := range pr.AssignedReviewers {
+			assert.NotContains(t, platformIDs, id, description)
+		}
+	}
+}
+
+func TestSettings(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "settings-squad", "A", "B", "C", "D")
+	other := s.createTeam(t, "other-squad", "X", "Y", "Z")
+	author := team.Members[0].UserId
+
+	// 1. Built-in defaults apply until settings are saved
+	resp, body := s.doRequest(t, "GET", "/admin/settings", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var org OrgSettings
+	unmarshalResponse(t, body, &org)
+	assert.Equal(t, Settings{MaxReviewers: 2, SelectionStrategy: "random", ReviewSlaHours: 48, Features: SettingsFeatures{AutoMerge: true, ShadowReviews: true}}, org.Defaults)
+	assert.Nil(t, org.UpdatedAt)
+	assert.Empty(t, org.Teams)
+
+	resp, body = s.doRequest(t, "PUT", "/admin/settings", Settings{MaxReviewers: 5, SelectionStrategy: "random", ReviewSlaHours: 48})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	// 2. Org defaults change the number of reviewers of every team
+	defaults := Settings{MaxReviewers: 1, SelectionStrategy: "random", ReviewSlaHours: 24, Features: SettingsFeatures{AutoMerge: true}}
+	resp, body = s.doRequest(t, "PUT", "/admin/settings", defaults)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	org = OrgSettings{}
+	unmarshalResponse(t, body, &org)
+	assert.Equal(t, defaults, org.Defaults)
+	assert.NotNil(t, org.UpdatedAt)
+
+	assert.Len(t, s.createPR(t, "feat: one reviewer", author).AssignedReviewers, 1)
+
+	// 3. Team overrides are layered on top for that team only
+	resp, body = s.doRequest(t, "PUT", "/admin/settings/teams/settings-squad", map[string]any{
+		"max_reviewers": 3,
+		"features":      map[string]bool{"auto_merge": false},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var teamSettings TeamSettings
+	unmarshalResponse(t, body, &teamSettings)
+	assert.Equal(t, Settings{MaxReviewers: 3, SelectionStrategy: "random", ReviewSlaHours: 24}, teamSettings.Effective)
+	assert.Equal(t, map[string]any{"max_reviewers": float64(3), "features": map[string]any{"auto_merge": false}}, teamSettings.Overrides)
+
+	assert.Len(t, s.createPR(t, "feat: three reviewers", author).AssignedReviewers, 3)
+	assert.Len(t, s.createPR(t, "feat: other team", other.Members[0].UserId).AssignedReviewers, 1)
+
+	resp, body = s.doRequest(t, "POST", "/pullRequest/create", map[string]any{
+		"pull_request_name": "feat: auto-merge",
+		"author_id":         author,
+		"auto_merge":        true,
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	resp, body = s.doRequest(t, "GET", "/admin/settings", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	org = OrgSettings{}
+	unmarshalResponse(t, body, &org)
+	require.Len(t, org.Teams, 1)
+	assert.Equal(t, "settings-squad", org.Teams[0].TeamName)
+	assert.Equal(t, 3, org.Teams[0].Effective.MaxReviewers)
+
+	// 4. The least loaded strategy spreads PRs over the members without reviews
+	balanced := s.createTeam(t, "balanced-squad", "L", "M", "N", "O")
+	resp, body = s.doRequest(t, "PUT", "/admin/settings/teams/balanced-squad", map[string]any{"selection_strategy": "least_loaded"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reviewers []string
+	for range 3 {
+		reviewers = append(reviewers, s.createPR(t, "feat: balanced", balanced.Members[0].UserId).AssignedReviewers...)
+	}
+	assert.ElementsMatch(t, []string{balanced.Members[1].UserId, balanced.Members[2].UserId, balanced.Members[3].UserId}, reviewers)
+
+	// 5. An empty override makes the team follow the org again
+	resp, body = s.doRequest(t, "PUT", "/admin/settings/teams/settings-squad", map[string]any{})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	teamSettings = TeamSettings{}
+	unmarshalResponse(t, body, &teamSettings)
+	assert.Empty(t, teamSettings.Overrides)
+	assert.Nil(t, teamSettings.UpdatedAt)
+	assert.Equal(t, defaults, teamSettings.Effective)
+
+	resp, body = s.doRequest(t, "GET", "/admin/settings/teams/nowhere", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+}
+
+func TestSeededSelection(t *testing.T) {
+	servers := []*server{newServer(t), newServer(t), newSaltedServer(t, "another salt")}
+	team := servers[0].createTeam(t, "seeded-squad", "author", "r0", "r1", "r2", "r3", "r4")
+	author := team.Members[0].UserId
+	// The other installations get the same users through a team snapshot
+	resp, exported := servers[0].doRequest(t, "GET", "/team/seeded-squad/export", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(exported))
+	for _, s := range servers[1:] {
+		resp, body := s.doRequest(t, "POST", "/team/import", json.RawMessage(exported))
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	}
+
+	picks := func(s *server, prefix string) map[string][]string {
+		t.Helper()
+		reviewers := make(map[string][]string)
+		for i := range 8 {
+			id := fmt.Sprintf("%s-%d", prefix, i)
+			resp, body := s.doRequest(t, "POST", "/pullRequest/create", map[string]string{
+				"pull_request_id":   id,
+				"pull_request_name": "feat: seeded",
+				"author_id":         author,
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+			var pr PullRequest
+			unmarshalResponse(t, body, &pr)
+			reviewers[id] = pr.AssignedReviewers
+		}
+		return reviewers
+	}
+
+	// 1. The same PR gets the same reviewers on every run
+	first := picks(servers[0], "random")
+	assert.Equal(t, first, picks(servers[1], "random"))
+
+	// 2. while different PRs still spread over the team
+	picked := make(map[string]bool)
+	for _, reviewers := range first {
+		for _, id := range reviewers {
+			picked[id] = true
+		}
+	}
+	assert.Greater(t, len(picked), 2)
+
+	// 3. PR IDs alone do not decide the picks: another salt picks differently
+	assert.NotEqual(t, first, picks(servers[2], "random"))
+
+	// 4. Weighted random selection is reproducible as well
+	for _, s := range servers[:2] {
+		resp, body := s.doRequest(t, "PUT", "/admin/settings/teams/seeded-squad", map[string]any{"selection_strategy": "weighted_random"})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	}
+	assert.Equal(t, picks(servers[0], "weighted"), picks(servers[1], "weighted"))
+}
+
+// racingOpenPRCount holds the first two counts of open PRs until both are
+// taken, so that concurrent creates both count before either commits.
+type racingOpenPRCount struct {
+	*memory.Store
+	counted sync.WaitGroup
+	first   atomic.Int32
+}
+
+func (s *racingOpenPRCount) CountOpenPRsByAuthor(ctx context.Context, tx domain.Tx, authorID string) (int, error) {
+	n, err := s.Store.CountOpenPRsByAuthor(ctx, tx, authorID)
+	if s.first.Add(1) <= 2 {
+		s.counted.Done()
+		s.counted.Wait()
+	}
+	return n, err
+}
+
+func TestPRQuotaConcurrentCreates(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.DiscardHandler)
+	store := memory.NewStore()
+	uow := app.NewTimeoutUnitOfWork(store, 5*time.Second)
+	settingsService := app.NewSettingsService(store, store, uow, app.DefaultSettings(), log)
+	prRepo := &racingOpenPRCount{Store: store}
+	prRepo.counted.Add(2)
+	prService := app.NewPullRequestService(prRepo, store, store, store, store, uow, nil, nil, nil, settingsService, 0, selectionSalt, log)
+	teamService := app.NewTeamService(store, store, store, prService, uow, log)
+
+	team, err := teamService.CreateTeam(ctx, "quota-race", []string{"author", "reviewer"}, false)
+	require.NoError(t, err)
+	_, err = teamService.SetPRQuota(ctx, "quota-race", 1, domain.PRQuotaBlock)
+	require.NoError(t, err)
+	author := team.Members[0].ID
+
+	// Two PRs of one author created at the same time cannot both pass a
+	// quota of one
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Go(func() {
+			_, errs[i] = prService.CreatePR(ctx, app.CreatePRInput{Name: fmt.Sprintf("feat: race %d", i), AuthorID: author})
+		})
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, countNil(errs), "%v", errs)
+	for _, err := range errs {
+		if err != nil {
+			assert.ErrorIs(t, err, domain.ErrTooManyOpenPRs)
+		}
+	}
+	open, err := store.CountOpenPRsByAuthor(ctx, nil, author)
+	require.NoError(t, err)
+	assert.Equal(t, 1, open)
+}
+
+func TestCreatePRInput(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.DiscardHandler)
+	store := memory.NewStore()
+	uow := app.NewTimeoutUnitOfWork(store, 5*time.Second)
+	settingsService := app.NewSettingsService(store, store, uow, app.DefaultSettings(), log)
+	prService := app.NewPullRequestService(store, store, store, store, store, uow, nil, nil, nil, settingsService, 0, selectionSalt, log)
+	teamService := app.NewTeamService(store, store, store, prService, uow, log)
+	templateService := app.NewPRTemplateService(store, store, store, uow, log)
+
+	team, err := teamService.CreateTeam(ctx, "input", []string{"author", "r1", "r2", "r3"}, false)
+	require.NoError(t, err)
+	author, preferred := team.Members[0].ID, team.Members[3].ID
+	template, err := templateService.CreateTemplate(ctx, &domain.PRTemplate{
+		TeamName:         "input",
+		Name:             "feature",
+		NamePrefix:       "[feat] ",
+		Labels:           []string{"feature", "backend"},
+		DefaultReviewers: []string{preferred},
+	})
+	require.NoError(t, err)
+
+	lines, files := 120, 4
+	in := app.CreatePRInput{
+		ID:          "pr-input",
+		ExternalID:  "gh-42",
+		Name:        "checkout",
+		Description: "Adds the checkout page.",
+		AuthorID:    author,
+		Labels:      []string{"backend"},
+		Priority:    domain.PriorityUrgent,
+		Size:        domain.PRSize{LinesChanged: &lines, FilesChanged: &files},
+		Draft:       true,
+		Template:    template,
+	}
+	pr, err := prService.CreatePR(ctx, in)
+	require.NoError(t, err)
+
+	stored, err := prService.GetPR(ctx, "pr-input")
+	require.NoError(t, err)
+	for _, got := range []*domain.PullRequest{pr, stored} {
+		assert.Equal(t, "gh-42", got.ExternalID)
+		assert.Equal(t, "[feat] checkout", got.Name)
+		assert.Equal(t, "Adds the checkout page.", got.Description)
+		assert.Equal(t, author, got.AuthorID)
+		assert.Equal(t, []string{"backend", "feature"}, got.Labels)
+		assert.Equal(t, domain.PriorityUrgent, got.Priority)
+		assert.Equal(t, domain.PRSize{LinesChanged: &lines, FilesChanged: &files}, got.Size)
+		assert.Equal(t, domain.StatusDraft, got.Status)
+		assert.Empty(t, got.Reviewers)
+	}
+	// The template was applied to a copy of the caller's labels
+	assert.Equal(t, []string{"backend"}, in.Labels)
+
+	// Without an ID one is generated, and the template's default reviewer is
+	// picked first once the PR is ready
+	in.ID, in.ExternalID, in.Draft = "", "", false
+	pr, err = prService.CreatePR(ctx, in)
+	require.NoError(t, err)
+	assert.NotEmpty(t, pr.ID)
+	assert.Equal(t, "[feat] checkout", pr.Name)
+	require.NotEmpty(t, pr.Reviewers)
+	assert.Equal(t, preferred, pr.Reviewers[0].ID)
+
+	_, err = prService.CreatePR(ctx, app.CreatePRInput{Name: "no author"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func countNil(errs []error) int {
+	n := 0
+	for _, err := range errs {
+		if err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// foreignTx is a transaction of some other storage backend.
+type foreignTx struct {
+	domain.TxMarker
+}
+
+func TestForeignTxIsRefused(t *testing.T) {
+	store := memory.NewStore()
+	_, err := store.CountOpenPRsByAuthor(context.Background(), foreignTx{}, "u1")
+	assert.ErrorIs(t, err, domain.ErrInternalError)
+}
+
+func TestAssignmentExplanations(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "explained-squad", "author", "A", "B", "C")
+	author, a, b, c := team.Members[0].UserId, team.Members[1].UserId, team.Members[2].UserId, team.Members[3].UserId
+	for _, step := range []struct {
+		path string
+		body any
+	}{
+		{"/users/setSkills", map[string]any{"user_id": a, "skills": []string{"go"}}},
+		{"/team/setReviewerPreferences", map[string]any{"team_name": "explained-squad", "preferences": []ReviewerPreference{{AuthorId: author, ReviewerId: b, Weight: 5}}}},
+	} {
+		resp, body := s.doRequest(t, "POST", step.path, step.body)
+		require.Less(t, resp.StatusCode, 300, "%s: %s", step.path, string(body))
+	}
+
+	// 1. Without explain=true the response has no explanations
+	resp, body := s.doRequest(t, "POST", "/pullRequest/create", map[string]any{
+		"pull_request_name": "feat: quiet",
+		"author_id":         author,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var pr PullRequest
+	unmarshalResponse(t, body, &pr)
+	assert.Len(t, pr.AssignedReviewers, 2)
+	assert.Empty(t, pr.AssignmentExplanations)
+
+	// 2. With it every assigned reviewer is explained
+	resp, body = s.doRequest(t, "POST", "/pullRequest/create?explain=true", map[string]any{
+		"pull_request_name": "feat: explained",
+		"author_id":         author,
+		"required_skills":   []string{"go"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	pr = PullRequest{}
+	unmarshalResponse(t, body, &pr)
+	require.Equal(t, []string{b, a}, pr.AssignedReviewers)
+	require.Len(t, pr.AssignmentExplanations, 2)
+	preferred, skilled := pr.AssignmentExplanations[0], pr.AssignmentExplanations[1]
+	assert.Equal(t, b, preferred.UserId)
+	assert.Equal(t, 5, preferred.PreferenceWeight)
+	assert.Empty(t, preferred.MatchedSkills)
+	assert.Equal(t, a, skilled.UserId)
+	assert.Zero(t, skilled.PreferenceWeight)
+	assert.Equal(t, []string{"go"}, skilled.MatchedSkills)
+	for _, e := range pr.AssignmentExplanations {
+		assert.Equal(t, "random", e.Strategy)
+		assert.Equal(t, 3, e.Candidates)
+		assert.False(t, e.Hinted)
+		assert.False(t, e.Rotation)
+	}
+
+	// 3. A reassignment explains the new reviewer only
+	resp, body = s.doRequest(t, "POST", "/pullRequest/reassign?explain=true", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"old_user_id":     b,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reassigned struct {
+		PR         PullRequest `json:"pr"`
+		ReplacedBy string      `json:"replaced_by"`
+	}
+	unmarshalResponse(t, body, &reassigned)
+	assert.Equal(t, c, reassigned.ReplacedBy)
+	require.Len(t, reassigned.PR.AssignmentExplanations, 1)
+	assert.Equal(t, c, reassigned.PR.AssignmentExplanations[0].UserId)
+	assert.Equal(t, 1, reassigned.PR.AssignmentExplanations[0].Candidates)
+}
+
+func TestTeamRenamePropagation(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "renamed-before", "A", "B")
+	a, b := team.Members[0].UserId, team.Members[1].UserId
+
+	resp, body := s.doRequest(t, "POST", "/savedFilter/add", map[string]any{
+		"name":    "team PRs",
+		"user_id": a,
+		"filter":  map[string]any{"team_name": "renamed-before"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var filter SavedFilter
+	unmarshalResponse(t, body, &filter)
+	// A PR picks its reviewer from the cached candidate pool of the team
+	resp, body = s.doRequest(t, "POST", "/pullRequest/create", map[string]any{"pull_request_name": "feat: before", "author_id": a})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+
+	resp, body = s.doRequest(t, "POST", "/team/edit", map[string]any{"old_team_name": "renamed-before", "new_team_name": "renamed-after"})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// 1. Users report the new name
+	resp, body = s.doRequest(t, "GET", "/users/get/"+b, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var user User
+	unmarshalResponse(t, body, &user)
+	assert.Equal(t, "renamed-after", user.TeamName)
+
+	// 2. Saved filters select the team by its new name
+	resp, body = s.doRequest(t, "GET", "/savedFilter/get?filter_id="+strconv.FormatInt(filter.FilterId, 10), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	unmarshalResponse(t, body, &filter)
+	assert.Equal(t, "renamed-after", filter.Filter.TeamName)
+
+	resp, body = s.doRequest(t, "POST", "/pullRequest/create", map[string]any{"pull_request_name": "feat: after", "author_id": a})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var pr PullRequest
+	unmarshalResponse(t, body, &pr)
+	assert.Equal(t, []string{b}, pr.AssignedReviewers)
+
+	resp, body = s.doRequest(t, "GET", "/pullRequest/list?filter_id="+strconv.FormatInt(filter.FilterId, 10), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var list PullRequestListResponse
+	unmarshalResponse(t, body, &list)
+	assert.Equal(t, 2, list.Total)
+
+	// 3. Stats are grouped under the new name
+	resp, body = s.doRequest(t, "GET", "/stats/fairness?team_name=renamed-after", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Contains(t, string(body), `"team_name":"renamed-after"`)
+	assert.NotContains(t, string(body), "renamed-before")
+}
+
+func TestReviewerPool(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "pool-squad", "A", "B", "C", "D")
+	a, b, c := team.Members[0].UserId, team.Members[1].UserId, team.Members[2].UserId
+	other := s.createTeam(t, "pool-other", "E")
+
+	resp, body := s.doRequest(t, "GET", "/team/pool-squad/reviewerPool", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var pool TeamReviewerPool
+	unmarshalResponse(t, body, &pool)
+	assert.Empty(t, pool.Members)
+
+	// 1. Only members of the team, once each
+	resp, body = s.doRequest(t, "POST", "/team/pool-squad/reviewerPool", map[string]any{"user_ids": []string{b, other.Members[0].UserId}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+	resp, body = s.doRequest(t, "POST", "/team/pool-squad/reviewerPool", map[string]any{"user_ids": []string{b, b}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+	resp, body = s.doRequest(t, "POST", "/team/no-such-team/reviewerPool", map[string]any{"user_ids": []string{}})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+
+	// 2. Reviewers come from the pool only
+	resp, body = s.doRequest(t, "POST", "/team/pool-squad/reviewerPool", map[string]any{"user_ids": []string{b, c}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	unmarshalResponse(t, body, &pool)
+	assert.Equal(t, "pool-squad", pool.TeamName)
+	require.Len(t, pool.Members, 2)
+	assert.ElementsMatch(t, []string{b, c}, []string{pool.Members[0].UserId, pool.Members[1].UserId})
+
+	for range 3 {
+		pr := s.createPR(t, "feat: pooled", a)
+		assert.ElementsMatch(t, []string{b, c}, pr.AssignedReviewers)
+	}
+	pr := s.createPR(t, "feat: by pool member", b)
+	assert.Equal(t, []string{c}, pr.AssignedReviewers)
+
+	// 3. An empty pool picks from everyone again
+	resp, body = s.doRequest(t, "POST", "/team/pool-squad/reviewerPool", map[string]any{"user_ids": []string{}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	unmarshalResponse(t, body, &pool)
+	assert.Empty(t, pool.Members)
+
+	pr = s.createPR(t, "feat: by pool member again", b)
+	assert.Len(t, pr.AssignedReviewers, 2)
+}
+
+func TestInactiveReviewReassignment(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "idle-squad", "author", "r1", "r2", "r3", "r4")
+	pr := s.createPR(t, "feat: waiting for review", team.Members[0].UserId)
+	require.Len(t, pr.AssignedReviewers, 2)
+	active, idle := pr.AssignedReviewers[0], pr.AssignedReviewers[1]
+
+	resp, body := s.doRequest(t, "POST", "/pullRequest/approve", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"user_id":         active,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// 1. Only the reviewer who has not acted is swapped out
+	reassigned, err := s.inactiveReviews.Reassign(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned)
+
+	resp, body = s.doRequest(t, "GET", "/pullRequest/get/"+pr.PullRequestId, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var updated PullRequest
+	unmarshalResponse(t, body, &updated)
+	require.Len(t, updated.AssignedReviewers, 2)
+	assert.Contains(t, updated.AssignedReviewers, active)
+	assert.NotContains(t, updated.AssignedReviewers, idle)
+
+	// 2. Teams can opt out
+	resp, body = s.doRequest(t, "POST", "/team/setInactiveReassign", map[string]any{"team_name": "idle-squad", "enabled": false})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var setting TeamInactiveReassign
+	unmarshalResponse(t, body, &setting)
+	assert.Equal(t, TeamInactiveReassign{TeamName: "idle-squad", Enabled: false}, setting)
+
+	reassigned, err = s.inactiveReviews.Reassign(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, reassigned)
+
+	resp, body = s.doRequest(t, "POST", "/team/setInactiveReassign", map[string]any{"team_name": "idle-squad", "enabled": true})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	resp, body = s.doRequest(t, "GET", "/team/inactiveReassign?team_name=idle-squad", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	unmarshalResponse(t, body, &setting)
+	assert.True(t, setting.Enabled)
+
+	resp, body = s.doRequest(t, "GET", "/team/inactiveReassign?team_name=no-such-team", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+}
+
+func TestStatsHistory(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "history-squad", "author", "r1", "r2")
+	pr := s.createPR(t, "feat: tracked over time", team.Members[0].UserId)
+	require.Len(t, pr.AssignedReviewers, 2)
+	reviewer := pr.AssignedReviewers[0]
+
+	// 1. The first run of the day records it, later runs do not
+	recorded, err := s.statsHistory.Snapshot(t.Context())
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	resp, body := s.doRequest(t, "POST", "/pullRequest/merge", map[string]string{"pull_request_id": pr.PullRequestId})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	recorded, err = s.statsHistory.Snapshot(t.Context())
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	// 2. The day keeps the counters as they were when it was recorded
+	today := time.Now().UTC().Format(time.DateOnly)
+	resp, body = s.doRequest(t, "GET", "/stats/history/user/"+reviewer, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var userHistory UserStatsHistory
+	unmarshalResponse(t, body, &userHistory)
+	assert.Equal(t, UserStatsHistory{UserId: reviewer, Points: []UserStatsHistoryPoint{
+		{Date: today, TeamName: "history-squad", TotalReviews: 1, OpenReviews: 1},
+	}}, userHistory)
+
+	resp, body = s.doRequest(t, "GET", "/stats/history/team/history-squad?from="+today+"&to="+today, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var teamHistory TeamStatsHistory
+	unmarshalResponse(t, body, &teamHistory)
+	assert.Equal(t, TeamStatsHistory{TeamName: "history-squad", Points: []TeamStatsHistoryPoint{
+		{Date: today, ActiveMembers: 3, OpenReviews: 2},
+	}}, teamHistory)
+
+	// 3. Days outside the range are left out
+	resp, body = s.doRequest(t, "GET", "/stats/history/team/history-squad?from=2020-01-01&to=2020-01-31", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	unmarshalResponse(t, body, &teamHistory)
+	assert.Empty(t, teamHistory.Points)
+
+	// 4. Invalid ranges and unknown users and teams are rejected
+	resp, body = s.doRequest(t, "GET", "/stats/history/team/history-squad?from=2020-02-01&to=2020-01-01", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	resp, body = s.doRequest(t, "GET", "/stats/history/user/no-such-user", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+
+	resp, body = s.doRequest(t, "GET", "/stats/history/team/no-such-team", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+}
+
+func TestAuthorStats(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "authors-squad", "author", "r1", "r2")
+	author := team.Members[0].UserId
+	merged := s.createPR(t, "feat: merged", author)
+	s.createPR(t, "feat: still open", author)
+
+	resp, body := s.doRequest(t, "POST", "/pullRequest/merge", map[string]string{"pull_request_id": merged.PullRequestId})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// 1. The author's PRs are counted with their reviewers and merge times
+	resp, body = s.doRequest(t, "GET", "/stats/author/"+author, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var stats AuthorStats
+	unmarshalResponse(t, body, &stats)
+	assert.Equal(t, author, stats.UserId)
+	assert.Equal(t, 2, stats.OpenedPrs)
+	assert.Equal(t, 1, stats.OpenPrs)
+	assert.Equal(t, 1, stats.MergedPrs)
+	assert.InDelta(t, 2, stats.AvgReviewersPerPr, 0.001)
+	require.NotNil(t, stats.AvgTimeToMergeSeconds)
+	require.NotNil(t, stats.MedianTimeToMergeSeconds)
+	assert.Equal(t, *stats.AvgTimeToMergeSeconds, *stats.MedianTimeToMergeSeconds)
+
+	// 2. Reviews do not count as authored PRs
+	resp, body = s.doRequest(t, "GET", "/stats/author/"+merged.AssignedReviewers[0], nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	stats = AuthorStats{}
+	unmarshalResponse(t, body, &stats)
+	assert.Zero(t, stats.OpenedPrs)
+	assert.Nil(t, stats.AvgTimeToMergeSeconds)
+
+	resp, body = s.doRequest(t, "GET", "/stats/author/no-such-user", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assertErrorCode(t, body, "NOT_FOUND")
+}
+
+func TestOrgSummary(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "summary-squad", "author", "r1", "r2")
+	solo := s.createTeam(t, "solo-squad", "loner")
+	merged := s.createPR(t, "feat: merged", team.Members[0].UserId)
+	s.createPR(t, "feat: in review", team.Members[0].UserId)
+	unassigned := s.createPR(t, "feat: nobody to review", solo.Members[0].UserId)
+	require.Empty(t, unassigned.AssignedReviewers)
+
+	resp, body := s.doRequest(t, "POST", "/pullRequest/merge", map[string]string{"pull_request_id": merged.PullRequestId})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	resp, body = s.doRequest(t, "GET", "/stats/summary", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var summary OrgSummary
+	unmarshalResponse(t, body, &summary)
+	assert.Equal(t, 2, summary.OpenPrs)
+	assert.Equal(t, 1, summary.UnassignedPrs)
+	assert.Equal(t, 4, summary.ActiveUsers)
+	assert.Equal(t, 1, summary.MergedPrs)
+	assert.NotNil(t, summary.AvgTurnaroundSeconds)
+	start, err := time.Parse(time.RFC3339, summary.WindowStart)
+	require.NoError(t, err)
+	end, err := time.Parse(time.RFC3339, summary.WindowEnd)
+	require.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, end.Sub(start))
+}
+
+func TestServiceStats(t *testing.T) {
+	s := newServer(t)
+	s.createTeam(t, "latency-squad", "u1", "u2")
+	resp, _ := s.doRequest(t, "GET", "/v1/team/inactiveReassign?team_name=latency-squad", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, body := s.doRequest(t, "GET", "/team/inactiveReassign?team_name=no-such-team", nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode, string(body))
+
+	resp, body = s.doRequest(t, "GET", "/stats/service", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var stats ServiceStats
+	unmarshalResponse(t, body, &stats)
+	assert.Equal(t, int64(3), stats.Total.Requests)
+	assert.Equal(t, int64(1), stats.Total.ClientErrors)
+	assert.Zero(t, stats.Total.ErrorRate)
+
+	// Versioned and unprefixed calls are counted as one endpoint
+	var setting *EndpointLatencyStats
+	for i := range stats.Endpoints {
+		if stats.Endpoints[i].Method == "GET" && stats.Endpoints[i].Path == "/team/inactiveReassign" {
+			setting = &stats.Endpoints[i]
+		}
+	}
+	require.NotNil(t, setting, "endpoints: %+v", stats.Endpoints)
+	assert.Equal(t, int64(2), setting.Requests)
+	assert.Equal(t, int64(1), setting.ClientErrors)
+	assert.LessOrEqual(t, setting.P50Ms, setting.P99Ms)
+}
+
+func TestUserDeactivationAndReassignment(t *testing.T) {
+	s := newServer(t)
+	team := s.createTeam(t, "deactivation-test-squad", "UserX", "UserY", "UserZ")
+	author, reviewer1, reviewer2 := team.Members[0], team.Members[1], team.Members[2]
+
+	pr := s.createPR(t, "feat: user deactivation test", author.UserId)
+	require.ElementsMatch(t, []string{reviewer1.UserId, reviewer2.UserId}, pr.AssignedReviewers)
+
+	// 1. Deactivating UserY removes them from the PR
+	resp, body := s.doRequest(t, "POST", "/users/setIsActive", PostUsersSetIsActiveJSONBody{UserId: reviewer1.UserId, IsActive: false})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var user User
+	unmarshalResponse(t, body, &user)
+	assert.False(t, user.IsActive)
+
+	resp, body = s.doRequest(t, "GET", "/pullRequest/get/"+pr.PullRequestId, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	unmarshalResponse(t, body, &pr)
+	assert.NotContains(t, pr.AssignedReviewers, reviewer1.UserId)
+
+	// 2. Nobody is left to take over UserZ's review
+	resp, body = s.doRequest(t, "POST", "/pullRequest/reassign", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"old_user_id":     reviewer2.UserId,
+	})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "NO_CANDIDATE")
+
+	// 3. and UserY cannot be assigned back while deactivated
+	resp, body = s.doRequest(t, "POST", "/pullRequest/assign", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"user_id":         reviewer1.UserId,
+	})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "USER_NOT_ACTIVE")
+
+	// 4. Once UserY is back, the review can move to them
+	resp, _ = s.doRequest(t, "POST", "/users/setIsActive", PostUsersSetIsActiveJSONBody{UserId: reviewer1.UserId, IsActive: true})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, body = s.doRequest(t, "POST", "/pullRequest/reassign", map[string]string{
+		"pull_request_id": pr.PullRequestId,
+		"old_user_id":     reviewer2.UserId,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var reassigned struct {
+		PR         PullRequest `json:"pr"`
+		ReplacedBy string      `json:"replaced_by"`
+	}
+	unmarshalResponse(t, body, &reassigned)
+	assert.Equal(t, reviewer1.UserId, reassigned.ReplacedBy)
+	assert.Equal(t, []string{reviewer1.UserId}, reassigned.PR.AssignedReviewers)
+}
+
+// getPR fetches the PR by ID.
+func (s *server) getPR(t *testing.T, prID string) PullRequest {
+	t.Helper()
+	resp, body := s.doRequest(t, "GET", "/pullRequest/get/"+prID, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var pr PullRequest
+	unmarshalResponse(t, body, &pr)
+	return pr
+}
+
+// deactivateTeam deactivates the team at once and returns the response.
+func (s *server) deactivateTeam(t *testing.T, teamName string) TeamDeactivateResponse {
+	t.Helper()
+	resp, body := s.doRequest(t, "POST", "/team/deactivate", map[string]string{"team_name": teamName})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var deactivated TeamDeactivateResponse
+	unmarshalResponse(t, body, &deactivated)
+	return deactivated
+}
+
+func TestTeamDeactivationPartialReassignment(t *testing.T) {
+	s := newServer(t)
+	home := s.createTeam(t, "partial-home", "author")
+	author := home.Members[0].UserId
+	away := s.createTeam(t, "partial-away", "x1", "x2", "x3")
+	x1, x2, x3 := away.Members[0].UserId, away.Members[1].UserId, away.Members[2].UserId
+
+	// Both PRs are reviewed only by the team about to go: one by two of its
+	// members, the other by the third
+	prs := []PullRequest{s.createPR(t, "feat: partial one", author), s.createPR(t, "feat: partial two", author)}
+	for i, reviewers := range [][]string{{x1, x2}, {x3}} {
+		require.Empty(t, prs[i].AssignedReviewers)
+		for _, id := range reviewers {
+			resp, body := s.doRequest(t, "POST", "/pullRequest/assign", map[string]string{"pull_request_id": prs[i].PullRequestId, "user_id": id})
+			require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		}
+	}
+
+	// The author's team has one spare reviewer, who may take one review
+	resp, body := s.doRequest(t, "POST", "/users/add", map[string]any{"username": "spare", "team_name": "partial-home", "is_active": true})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var spare User
+	unmarshalResponse(t, body, &spare)
+	resp, body = s.doRequest(t, "PUT", "/admin/settings/teams/partial-home", map[string]any{"max_open_reviews": 1})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	deactivated := s.deactivateTeam(t, "partial-away")
+	require.NotNil(t, deactivated.DeactivatedUsersCount)
+	assert.Equal(t, 3, *deactivated.DeactivatedUsersCount)
+	require.NotNil(t, deactivated.ReassignedReviewsCount)
+	assert.Equal(t, 1, *deactivated.ReassignedReviewsCount)
+
+	// The spare reviewer fills one of the two seats of one PR; the candidates
+	// run out before the other PR, which is left without reviewers
+	var reviewers [][]string
+	for _, pr := range prs {
+		reviewers = append(reviewers, s.getPR(t, pr.PullRequestId).AssignedReviewers)
+	}
+	assert.ElementsMatch(t, [][]string{{spare.UserId}, {}}, reviewers)
+}
+
+func TestTeamDeactivationExcludesDeactivatedUsers(t *testing.T) {
+	s := newServer(t)
+	s.createTeam(t, "excluded-away", "x1", "x2", "x3")
+	home := s.createTeam(t, "excluded-home", "author")
+	author := home.Members[0].UserId
+	resp, body := s.doRequest(t, "POST", "/team/setParent", map[string]any{"team_name": "excluded-home", "parent_team_name": "excluded-away", "escalate_to_parent": true})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// The author's team has no reviewers of its own, so the PR escalates to
+	// the parent team, whose members stay in the cached candidate pool
+	pr := s.createPR(t, "feat: escalated", author)
+	require.Len(t, pr.AssignedReviewers, 2)
+
+	// Deactivating the parent team searches it again for the orphaned PR. The
+	// pool still lists its members as active, but all of them are in the
+	// deactivated set, the one who did not review the PR included, so nobody
+	// is picked
+	deactivated := s.deactivateTeam(t, "excluded-away")
+	require.NotNil(t, deactivated.ReassignedReviewsCount)
+	assert.Equal(t, 0, *deactivated.ReassignedReviewsCount)
+	assert.Empty(t, s.getPR(t, pr.PullRequestId).AssignedReviewers)
+}
+
+// TestCandidatePoolInvalidation checks that user and team changes evict the
+// cached candidate pools at once instead of after their 5s TTL.
+func TestCandidatePoolInvalidation(t *testing.T) {
+	t.Run("user deactivated", func(t *testing.T) {
+		s := newServer(t)
+		team := s.createTeam(t, "cache-deactivate", "author", "r1", "r2")
+		author, r1, r2 := team.Members[0].UserId, team.Members[1].UserId, team.Members[2].UserId
+		require.ElementsMatch(t, []string{r1, r2}, s.createPR(t, "feat: warm the cache", author).AssignedReviewers)
+
+		resp, body := s.doRequest(t, "POST", "/users/setIsActive", PostUsersSetIsActiveJSONBody{UserId: r1, IsActive: false})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+		assert.Equal(t, []string{r2}, s.createPR(t, "feat: after deactivation", author).AssignedReviewers)
+	})
+
+	t.Run("user moved", func(t *testing.T) {
+		s := newServer(t)
+		from := s.createTeam(t, "cache-from", "author", "r1", "r2")
+		author, r1, r2 := from.Members[0].UserId, from.Members[1].UserId, from.Members[2].UserId
+		to := s.createTeam(t, "cache-to", "other-author")
+		otherAuthor := to.Members[0].UserId
+		// Both teams' pools are cached, the second one empty
+		require.ElementsMatch(t, []string{r1, r2}, s.createPR(t, "feat: warm the cache", author).AssignedReviewers)
+		require.Empty(t, s.createPR(t, "feat: warm the other cache", otherAuthor).AssignedReviewers)
+
+		resp, body := s.doRequest(t, "POST", "/users/moveToTeam", map[string]string{"user_id": r1, "new_team_name": "cache-to"})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+		assert.Equal(t, []string{r2}, s.createPR(t, "feat: after the move", author).AssignedReviewers)
+		assert.Equal(t, []string{r1}, s.createPR(t, "feat: after the move", otherAuthor).AssignedReviewers)
+	})
+
+	t.Run("team deactivated", func(t *testing.T) {
+		s := newServer(t)
+		s.createTeam(t, "cache-parent", "p1", "p2")
+		home := s.createTeam(t, "cache-child", "author")
+		author := home.Members[0].UserId
+		resp, body := s.doRequest(t, "POST", "/team/setParent", map[string]any{"team_name": "cache-child", "parent_team_name": "cache-parent", "escalate_to_parent": true})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		// The PR escalates to the parent team, whose pool is cached
+		require.Len(t, s.createPR(t, "feat: warm the cache", author).AssignedReviewers, 2)
+
+		s.deactivateTeam(t, "cache-parent")
+
+		assert.Empty(t, s.createPR(t, "feat: after deactivation", author).AssignedReviewers)
+	})
+}
+
+func TestTeamSnapshotRoundTrip(t *testing.T) {
+	pilot := newServer(t)
+	team := pilot.createTeam(t, "payments", "A", "B", "C", "D")
+	a, b, c, d := team.Members[0].UserId, team.Members[1].UserId, team.Members[2].UserId, team.Members[3].UserId
+
+	setup := []struct {
+		path string
+		body any
+	}{
+		{"/users/setRole", map[string]any{"user_id": b, "role": "senior"}},
+		{"/users/setSkills", map[string]any{"user_id": c, "skills": []string{"go", "db"}}},
+		{"/users/setInTraining", map[string]any{"user_id": d, "in_training": true}},
+		{"/team/edit", map[string]any{"old_team_name": "payments", "review_cooldown_prs": 2}},
+		{"/team/setReviewerRequirements", map[string]any{"team_name": "payments", "required_reviewer_role": "senior", "shadow_review_percent": 100}},
+		{"/team/setReviewerPreferences", map[string]any{"team_name": "payments", "preferences": []ReviewerPreference{{AuthorId: a, ReviewerId: b, Weight: 10}}}},
+		{"/reviewRule/add", map[string]any{"rule_name": "hotfix", "labels": []string{"hotfix"}, "team_name": "payments", "reviewers": 1}},
+		{"/prTemplate/add", map[string]any{"name": "feature", "team_name": "payments", "name_prefix": "[pay] ", "default_reviewers": []string{c}}},
+		{"/team/setReviewBudget", map[string]any{"team_name": "payments", "reviews_per_sprint": 5, "sprint_days": 7}},
+		{"/team/setReportSchedule", map[string]any{"team_name": "payments", "enabled": true, "weekday": "friday", "hour": 17, "timezone": "Europe/Moscow"}},
+		{"/team/setPRQuota", map[string]any{"team_name": "payments", "max_open_prs": 3, "mode": "block"}},
+		{"/team/setRotation", map[string]any{"team_name": "payments", "start_date": "2026-01-07", "member_ids": []string{c, b}}},
+		{"/team/payments/reviewerPool", map[string]any{"user_ids": []string{b, c}}},
+		{"/team/setInactiveReassign", map[string]any{"team_name": "payments", "enabled": false}},
+	}
+	for _, step := range setup {
+		resp, body := pilot.doRequest(t, "POST", step.path, step.body)
+		require.Less(t, resp.StatusCode, 300, "%s: %s", step.path, string(body))
+	}
+
+	resp, exported := pilot.doRequest(t, "GET", "/team/payments/export", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(exported))
+	var snapshot TeamSnapshot
+	unmarshalResponse(t, exported, &snapshot)
+	assert.Equal(t, "senior", snapshot.RequiredReviewerRole)
+	assert.Equal(t, 2, snapshot.ReviewCooldownPrs)
+	assert.Equal(t, 100, snapshot.ShadowReviewPercent)
+	require.Len(t, snapshot.Members, 4)
+	require.Len(t, snapshot.ReviewRules, 1)
+	require.Len(t, snapshot.PrTemplates, 1)
+	assert.Equal(t, &TeamSnapshotReportSchedule{Weekday: "friday", Hour: 17, Timezone: "Europe/Moscow"}, snapshot.ReportSchedule)
+	assert.Equal(t, &TeamSnapshotPRQuota{MaxOpenPrs: 3, Mode: "block"}, snapshot.PrQuota)
+	assert.Equal(t, &TeamSnapshotReviewRotation{StartDate: "2026-01-05", MemberIds: []string{c, b}}, snapshot.ReviewRotation)
+	assert.ElementsMatch(t, []string{b, c}, snapshot.ReviewerPool)
+	assert.True(t, snapshot.InactiveReassignOptOut)
+
+	// The snapshot recreates the team in another installation as it was.
+	org := newServer(t)
+	resp, body := org.doRequest(t, "POST", "/team/import", json.RawMessage(exported))
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var imported TeamSnapshot
+	unmarshalResponse(t, body, &imported)
+	assert.Equal(t, snapshot, imported)
+
+	resp, body = org.doRequest(t, "GET", "/team/payments/export", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var reexported TeamSnapshot
+	unmarshalResponse(t, body, &reexported)
+	assert.Equal(t, snapshot, reexported)
+
+	// The rule routes to the imported team and the members keep their roles.
+	pr := org.createPR(t, "fix: payout", a)
+	assert.ElementsMatch(t, []string{b, c}, pr.AssignedReviewers)
+	assert.Equal(t, []string{d}, pr.ShadowReviewers)
+
+	resp, body = org.doRequest(t, "POST", "/team/import", json.RawMessage(exported))
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assertErrorCode(t, body, "TEAM_EXISTS")
+}
+
+func TestTeamSnapshotImportValidation(t *testing.T) {
+	s := newServer(t)
+
+	resp, body := s.doRequest(t, "POST", "/team/import", TeamSnapshot{
+		TeamName: "ops",
+		Members:  []TeamSnapshotMember{{UserId: "u1", Username: "A", IsActive: true}},
+		ReviewerPreferences: []ReviewerPreference{
+			{AuthorId: "outsider", ReviewerId: "u1", Weight: 5},
+		},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assertErrorCode(t, body, "VALIDATION_ERROR")
+
+	resp, body = s.doRequest(t, "POST", "/team/import", TeamSnapshot{
+		TeamName:    "ops",
+		Members:     []TeamSnapshotMember{{UserId: "u1", Username: "A", IsActive: true}},
+		ReviewRules: []ReviewRule{{RuleName: "r", Labels: []string{"x"}, Reviewers: 1}, {RuleName: "r", Labels: []string{"y"}, Reviewers: 1}},
+	})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode, string(body))
+	assertErrorCode(t, body, "REVIEW_RULE_EXISTS")
+
+	// A failed import leaves nothing behind.
+	resp, _ = s.doRequest(t, "GET", "/team/ops/export", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = s.doRequest(t, "GET", "/users/get/u1", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,327 @@
+package integration
+
+// The subset of the e2e models the integration tests use.
+
+type ErrorResponse struct {
+	Error struct {
+		Code      string        `json:"code"`
+		Details   []ErrorDetail `json:"details,omitempty"`
+		Message   string        `json:"message"`
+		RequestId string        `json:"request_id,omitempty"`
+		DocsUrl   string        `json:"docs_url,omitempty"`
+	} `json:"error"`
+}
+
+type ErrorDetail struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+type Team struct {
+	AllowDuplicateUsernames bool         `json:"allow_duplicate_usernames,omitempty"`
+	AllowSelfReview         bool         `json:"allow_self_review,omitempty"`
+	Members                 []TeamMember `json:"members"`
+	TeamName                string       `json:"team_name"`
+}
+
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type PullRequest struct {
+	ApprovedReviewers         []string `json:"approved_reviewers,omitempty"`
+	AssignedReviewers         []string `json:"assigned_reviewers"`
+	AuthorId                  string   `json:"author_id"`
+	ChangesRequestedReviewers []string `json:"changes_requested_reviewers,omitempty"`
+	CreatedAt                 *string  `json:"createdAt,omitempty"`
+	MergedAt                  *string  `json:"mergedAt,omitempty"`
+	OptionalReviewers         []string `json:"optional_reviewers,omitempty"`
+	PullRequestId             string   `json:"pull_request_id"`
+	PullRequestName           string   `json:"pull_request_name"`
+	Status                    string   `json:"status"`
+	MergedBy                  string   `json:"merged_by,omitempty"`
+	ShadowReviewers           []string `json:"shadow_reviewers,omitempty"`
+
+	AssignmentExplanations []AssignmentExplanation `json:"assignment_explanations,omitempty"`
+}
+
+type AssignmentExplanation struct {
+	UserId           string   `json:"user_id"`
+	Role             string   `json:"role,omitempty"`
+	Hinted           bool     `json:"hinted"`
+	Rotation         bool     `json:"rotation"`
+	PreferenceWeight int      `json:"preference_weight"`
+	Cooldown         bool     `json:"cooldown"`
+	MatchedSkills    []string `json:"matched_skills"`
+	Strategy         string   `json:"strategy"`
+	OpenReviews      *int     `json:"open_reviews,omitempty"`
+	Candidates       int      `json:"candidates"`
+}
+
+type PostUsersSetIsActiveJSONBody struct {
+	UserId   string `json:"user_id"`
+	IsActive bool   `json:"is_active"`
+}
+
+type User struct {
+	InTraining bool   `json:"in_training,omitempty"`
+	IsActive   bool   `json:"is_active"`
+	TeamName   string `json:"team_name"`
+	UserId     string `json:"user_id"`
+	Username   string `json:"username"`
+}
+
+type ShadowReviewCount struct {
+	TeamName          string `json:"team_name"`
+	UserId            string `json:"user_id"`
+	Username          string `json:"username"`
+	InTraining        bool   `json:"in_training"`
+	ShadowReviewCount int    `json:"shadow_review_count"`
+}
+
+type ShadowReviewStatsResponse struct {
+	Total int                 `json:"total"`
+	Users []ShadowReviewCount `json:"users"`
+}
+
+type TeamSnapshot struct {
+	TeamName               string                      `json:"team_name"`
+	Members                []TeamSnapshotMember        `json:"members"`
+	ShadowReviewPercent    int                         `json:"shadow_review_percent,omitempty"`
+	RequiredReviewerRole   string                      `json:"required_reviewer_role,omitempty"`
+	ReviewCooldownPrs      int                         `json:"review_cooldown_prs,omitempty"`
+	ReviewerPreferences    []ReviewerPreference        `json:"reviewer_preferences,omitempty"`
+	ReviewRules            []ReviewRule                `json:"review_rules,omitempty"`
+	PrTemplates            []PRTemplate                `json:"pr_templates,omitempty"`
+	ReviewBudget           *TeamSnapshotReviewBudget   `json:"review_budget,omitempty"`
+	ReportSchedule         *TeamSnapshotReportSchedule `json:"report_schedule,omitempty"`
+	PrQuota                *TeamSnapshotPRQuota        `json:"pr_quota,omitempty"`
+	ReviewRotation         *TeamSnapshotReviewRotation `json:"review_rotation,omitempty"`
+	ReviewerPool           []string                    `json:"reviewer_pool,omitempty"`
+	InactiveReassignOptOut bool                        `json:"inactive_reassign_opt_out,omitempty"`
+}
+
+type TeamSnapshotMember struct {
+	UserId     string   `json:"user_id"`
+	Username   string   `json:"username"`
+	IsActive   bool     `json:"is_active"`
+	Role       string   `json:"role,omitempty"`
+	Skills     []string `json:"skills,omitempty"`
+	InTraining bool     `json:"in_training,omitempty"`
+}
+
+type ReviewerPreference struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+	Weight     int    `json:"weight"`
+}
+
+type ReviewRule struct {
+	RuleName  string   `json:"rule_name"`
+	Labels    []string `json:"labels,omitempty"`
+	TeamName  string   `json:"team_name,omitempty"`
+	Reviewers int      `json:"reviewers,omitempty"`
+}
+
+type PRTemplate struct {
+	Name             string   `json:"name"`
+	TeamName         string   `json:"team_name"`
+	NamePrefix       string   `json:"name_prefix,omitempty"`
+	DefaultReviewers []string `json:"default_reviewers,omitempty"`
+}
+
+type TeamSnapshotReviewBudget struct {
+	ReviewsPerSprint int `json:"reviews_per_sprint"`
+	SprintDays       int `json:"sprint_days,omitempty"`
+}
+
+type TeamSnapshotReportSchedule struct {
+	Weekday  string `json:"weekday"`
+	Hour     int    `json:"hour"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type TeamSnapshotPRQuota struct {
+	MaxOpenPrs int    `json:"max_open_prs"`
+	Mode       string `json:"mode,omitempty"`
+}
+
+type TeamSnapshotReviewRotation struct {
+	StartDate string   `json:"start_date"`
+	MemberIds []string `json:"member_ids"`
+}
+
+type TeamReviewRotation struct {
+	TeamName  string         `json:"team_name"`
+	StartDate string         `json:"start_date,omitempty"`
+	MemberIds []string       `json:"member_ids"`
+	Weeks     []RotationWeek `json:"weeks"`
+}
+
+type TeamReviewerPool struct {
+	TeamName string       `json:"team_name"`
+	Members  []TeamMember `json:"members"`
+}
+
+type TeamInactiveReassign struct {
+	TeamName string `json:"team_name"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type UserStatsHistory struct {
+	UserId string                  `json:"user_id"`
+	Points []UserStatsHistoryPoint `json:"points"`
+}
+
+type UserStatsHistoryPoint struct {
+	Date          string `json:"date"`
+	TeamName      string `json:"team_name,omitempty"`
+	TotalReviews  int    `json:"total_reviews"`
+	OpenReviews   int    `json:"open_reviews"`
+	MergedReviews int    `json:"merged_reviews"`
+}
+
+type TeamStatsHistory struct {
+	TeamName string                  `json:"team_name"`
+	Points   []TeamStatsHistoryPoint `json:"points"`
+}
+
+type TeamStatsHistoryPoint struct {
+	Date          string `json:"date"`
+	ActiveMembers int    `json:"active_members"`
+	OpenReviews   int    `json:"open_reviews"`
+	MergedReviews int    `json:"merged_reviews"`
+}
+
+type ServiceStats struct {
+	StartedAt     string                 `json:"started_at"`
+	UptimeSeconds float64                `json:"uptime_seconds"`
+	Total         ServiceLatencyStats    `json:"total"`
+	Endpoints     []EndpointLatencyStats `json:"endpoints"`
+}
+
+type ServiceLatencyStats struct {
+	Requests     int64   `json:"requests"`
+	ClientErrors int64   `json:"client_errors"`
+	ServerErrors int64   `json:"server_errors"`
+	ErrorRate    float64 `json:"error_rate"`
+	P50Ms        float64 `json:"p50_ms"`
+	P95Ms        float64 `json:"p95_ms"`
+	P99Ms        float64 `json:"p99_ms"`
+}
+
+type EndpointLatencyStats struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	ServiceLatencyStats
+}
+
+type RotationWeek struct {
+	WeekStart  string `json:"week_start"`
+	UserId     string `json:"user_id,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Overridden bool   `json:"overridden"`
+}
+
+type GitHubIngestionToken struct {
+	Repository string  `json:"repository"`
+	TeamName   string  `json:"team_name"`
+	Secret     string  `json:"secret,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+}
+
+type InboundWebhook struct {
+	Id             int64  `json:"id"`
+	Source         string `json:"source"`
+	Event          string `json:"event"`
+	DeliveryId     string `json:"delivery_id,omitempty"`
+	IngestionToken string `json:"ingestion_token,omitempty"`
+	Payload        string `json:"payload"`
+	Status         string `json:"status"`
+	Error          string `json:"error,omitempty"`
+	Attempts       int    `json:"attempts"`
+	ReceivedAt     string `json:"received_at"`
+	ProcessedAt    string `json:"processed_at"`
+}
+
+type SlackAccount struct {
+	UserId      string `json:"user_id"`
+	SlackUserId string `json:"slack_user_id"`
+}
+
+type SlackMessage struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+type Settings struct {
+	MaxReviewers      int              `json:"max_reviewers"`
+	SelectionStrategy string           `json:"selection_strategy"`
+	ReviewSlaHours    int              `json:"review_sla_hours"`
+	MaxOpenReviews    int              `json:"max_open_reviews"`
+	Features          SettingsFeatures `json:"features"`
+}
+
+type SettingsFeatures struct {
+	AutoMerge     bool `json:"auto_merge"`
+	ShadowReviews bool `json:"shadow_reviews"`
+}
+
+type TeamSettings struct {
+	TeamName  string         `json:"team_name"`
+	Overrides map[string]any `json:"overrides"`
+	Effective Settings       `json:"effective"`
+	UpdatedAt *string        `json:"updated_at,omitempty"`
+}
+
+type OrgSettings struct {
+	Defaults  Settings       `json:"defaults"`
+	UpdatedAt *string        `json:"updated_at,omitempty"`
+	Teams     []TeamSettings `json:"teams"`
+}
+
+type AuthorStats struct {
+	UserId                   string   `json:"user_id"`
+	OpenedPrs                int      `json:"opened_prs"`
+	OpenPrs                  int      `json:"open_prs"`
+	MergedPrs                int      `json:"merged_prs"`
+	AvgReviewersPerPr        float64  `json:"avg_reviewers_per_pr"`
+	AvgTimeToMergeSeconds    *float64 `json:"avg_time_to_merge_seconds,omitempty"`
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds,omitempty"`
+}
+
+type OrgSummary struct {
+	WindowStart          string   `json:"window_start"`
+	WindowEnd            string   `json:"window_end"`
+	OpenPrs              int      `json:"open_prs"`
+	UnassignedPrs        int      `json:"unassigned_prs"`
+	ActiveUsers          int      `json:"active_users"`
+	MergedPrs            int      `json:"merged_prs"`
+	AvgTurnaroundSeconds *float64 `json:"avg_turnaround_seconds,omitempty"`
+}
+
+type SavedFilter struct {
+	FilterId int64  `json:"filter_id"`
+	Name     string `json:"name"`
+	UserId   string `json:"user_id,omitempty"`
+	TeamName string `json:"team_name,omitempty"`
+	Filter   struct {
+		TeamName string `json:"team_name,omitempty"`
+	} `json:"filter"`
+}
+
+type PullRequestListResponse struct {
+	PullRequests []struct {
+		PullRequestId string `json:"pull_request_id"`
+	} `json:"pull_requests"`
+	Total int `json:"total"`
+}
+
+type TeamDeactivateResponse struct {
+	DeactivatedUsersCount  *int    `json:"deactivated_users_count,omitempty"`
+	ReassignedReviewsCount *int    `json:"reassigned_reviews_count,omitempty"`
+	ScheduledAt            *string `json:"scheduled_at,omitempty"`
+}
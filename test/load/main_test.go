@@ -0,0 +1,320 @@
+//go:build load
+
+// Package load benchmarks the hot write paths of a running service against a
+// seeded database. It is excluded from the regular test run by the "load"
+// build tag; see `make bench`.
+package load
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	defaultBaseURL = "http://localhost:8080"
+	startTimeout   = 60 * time.Second
+	seedWorkers    = 8
+	// minTeamSize is an author, two reviewers and a replacement.
+	minTeamSize = 4
+)
+
+var (
+	baseURL string
+	client  *http.Client
+	// maxP95 fails a benchmark whose 95th percentile latency exceeds it.
+	maxP95 time.Duration
+	teams  []Team
+)
+
+func TestMain(m *testing.M) {
+	var exitCode int
+	defer func() {
+		os.Exit(exitCode)
+	}()
+
+	baseURL = envString("LOAD_BASE_URL", defaultBaseURL)
+	client = &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{MaxIdleConnsPerHost: 256},
+	}
+
+	if err := setup(); err != nil {
+		log.Printf("ERROR: %v\n", err)
+		exitCode = 1
+		return
+	}
+
+	exitCode = m.Run()
+}
+
+func setup() error {
+	teamCount, err := envInt("LOAD_TEAMS", 20)
+	if err != nil {
+		return err
+	}
+	teamSize, err := envInt("LOAD_TEAM_SIZE", 10)
+	if err != nil {
+		return err
+	}
+	if teamSize < minTeamSize {
+		return fmt.Errorf("LOAD_TEAM_SIZE must be at least %d to leave candidates for reassignment", minTeamSize)
+	}
+	prCount, err := envInt("LOAD_SEED_PRS", 1000)
+	if err != nil {
+		return err
+	}
+	if maxP95, err = envDuration("LOAD_MAX_P95", 0); err != nil {
+		return err
+	}
+
+	if err := waitForService(baseURL+"/health", startTimeout); err != nil {
+		return err
+	}
+	return seed(teamCount, teamSize, prCount)
+}
+
+func envString(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func envInt(key string, def int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s value: %q", key, v)
+	}
+	return n, nil
+}
+
+func envDuration(key string, def time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s value: %q", key, v)
+	}
+	return d, nil
+}
+
+func waitForService(url string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("service at %s not ready after %s", baseURL, timeout)
+		case <-ticker.C:
+			resp, err := client.Get(url)
+			if err == nil && resp.StatusCode == http.StatusOK {
+				_ = resp.Body.Close()
+				return nil
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+		}
+	}
+}
+
+// seed creates teams under a run-specific prefix, so that benchmarks can be
+// repeated against the same database, and a backlog of open PRs so that
+// queries run against non-empty tables.
+func seed(teamCount, teamSize, prCount int) error {
+	run := strconv.FormatInt(time.Now().UnixNano(), 36)
+	log.Printf("Seeding %d teams of %d members and %d PRs (run %s)...\n", teamCount, teamSize, prCount, run)
+
+	teams = make([]Team, 0, teamCount)
+	for i := range teamCount {
+		team := Team{TeamName: fmt.Sprintf("load-%s-%d", run, i)}
+		for j := range teamSize {
+			team.Members = append(team.Members, TeamMember{Username: fmt.Sprintf("load-%s-%d-%d", run, i, j), IsActive: true})
+		}
+		var created Team
+		if err := post("/team/add", team, http.StatusCreated, &created); err != nil {
+			return fmt.Errorf("failed to seed team %s: %w", team.TeamName, err)
+		}
+		teams = append(teams, created)
+	}
+
+	jobs := make(chan int)
+	errs := make(chan error, seedWorkers)
+	var wg sync.WaitGroup
+	for range seedWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if _, err := createPR(fmt.Sprintf("load seed %d", i)); err != nil {
+					errs <- fmt.Errorf("failed to seed PR: %w", err)
+					return
+				}
+			}
+		}()
+	}
+	var err error
+	for i := 0; i < prCount && err == nil; i++ {
+		select {
+		case jobs <- i:
+		case err = <-errs:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+	if err != nil {
+		return err
+	}
+	return <-errs
+}
+
+func post(path string, body any, want int, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(baseURL+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != want {
+		return fmt.Errorf("POST %s: expected %d, got %d: %s", path, want, resp.StatusCode, respBody)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// createPR opens a PR by a random member of a random seeded team.
+func createPR(name string) (PullRequest, error) {
+	team := teams[rand.IntN(len(teams))]
+	author := team.Members[rand.IntN(len(team.Members))]
+
+	var pr PullRequest
+	err := post("/pullRequest/create", map[string]string{
+		"pull_request_name": name,
+		"author_id":         author.UserId,
+	}, http.StatusCreated, &pr)
+	return pr, err
+}
+
+// latencies collects per-request latencies of a benchmark, which ns/op of a
+// parallel benchmark does not show.
+type latencies struct {
+	mu sync.Mutex
+	d  []time.Duration
+}
+
+func (l *latencies) add(d time.Duration) {
+	l.mu.Lock()
+	l.d = append(l.d, d)
+	l.mu.Unlock()
+}
+
+// report adds latency percentiles to the benchmark result and fails the
+// benchmark if the 95th percentile exceeds LOAD_MAX_P95.
+func (l *latencies) report(b *testing.B) {
+	if len(l.d) == 0 {
+		return
+	}
+	slices.Sort(l.d)
+	at := func(p float64) time.Duration {
+		return l.d[int(p*float64(len(l.d)-1))]
+	}
+	p95 := at(0.95)
+	b.ReportMetric(float64(at(0.50))/float64(time.Millisecond), "p50-ms")
+	b.ReportMetric(float64(p95)/float64(time.Millisecond), "p95-ms")
+	b.ReportMetric(float64(at(0.99))/float64(time.Millisecond), "p99-ms")
+	if maxP95 > 0 && p95 > maxP95 {
+		b.Errorf("p95 latency %s exceeds LOAD_MAX_P95 %s", p95, maxP95)
+	}
+}
+
+func BenchmarkCreatePR(b *testing.B) {
+	var lat latencies
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			start := time.Now()
+			_, err := createPR("load bench")
+			lat.add(time.Since(start))
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	b.StopTimer()
+	lat.report(b)
+}
+
+func BenchmarkReassignReviewer(b *testing.B) {
+	// Every goroutine takes a PR from the pool, reassigns one of its reviewers
+	// and returns it, so that no PR is reassigned concurrently.
+	prs := make(chan PullRequest, 4*runtime.GOMAXPROCS(0))
+	for range cap(prs) {
+		pr, err := createPR("load bench reassign")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(pr.AssignedReviewers) == 0 {
+			b.Fatalf("PR %s has no reviewers to reassign", pr.PullRequestId)
+		}
+		prs <- pr
+	}
+
+	var lat latencies
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			pr := <-prs
+			oldUserID := pr.AssignedReviewers[rand.IntN(len(pr.AssignedReviewers))]
+
+			var resp ReassignResponse
+			start := time.Now()
+			err := post("/pullRequest/reassign", map[string]string{
+				"pull_request_id": pr.PullRequestId,
+				"old_user_id":     oldUserID,
+			}, http.StatusOK, &resp)
+			lat.add(time.Since(start))
+			if err != nil {
+				prs <- pr
+				b.Error(err)
+				return
+			}
+			prs <- resp.Pr
+		}
+	})
+	b.StopTimer()
+	lat.report(b)
+}
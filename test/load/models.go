@@ -0,0 +1,27 @@
+//go:build load
+
+package load
+
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+type PullRequest struct {
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+	PullRequestId     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	Status            string   `json:"status"`
+}
+
+type ReassignResponse struct {
+	Pr         PullRequest `json:"pr"`
+	ReplacedBy string      `json:"replaced_by"`
+}